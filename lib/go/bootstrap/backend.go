@@ -0,0 +1,138 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+
+	"github.com/onflow/flow-core-contracts/lib/go/client"
+)
+
+// Backend is the part of the Flow access API the deployment needs.
+//
+// It is implemented by the access clients of the Flow Go SDK,
+// e.g. github.com/onflow/flow-go-sdk/access/grpc.Client.
+//
+// An emulator can be adapted with its SDK adapter, with auto-mining enabled
+// so that every transaction is sealed in its own block:
+//
+//	type emulatorBackend struct {
+//		*adapters.SDKAdapter
+//	}
+//
+//	func (b emulatorBackend) GetLatestBlockHeader(ctx context.Context, isSealed bool) (*flow.BlockHeader, error) {
+//		header, _, err := b.SDKAdapter.GetLatestBlockHeader(ctx, isSealed)
+//		return header, err
+//	}
+//
+//	adapter := adapters.NewSDKAdapter(&logger, blockchain)
+//	adapter.EnableAutoMine()
+//
+//	result, err := bootstrap.Deploy(ctx, emulatorBackend{adapter}, config)
+type Backend interface {
+	GetLatestBlockHeader(ctx context.Context, isSealed bool) (*flow.BlockHeader, error)
+	GetAccount(ctx context.Context, address flow.Address) (*flow.Account, error)
+	SendTransaction(ctx context.Context, tx flow.Transaction) error
+	GetTransactionResult(ctx context.Context, txID flow.Identifier) (*flow.TransactionResult, error)
+}
+
+// Account is an account and a key that can sign for it.
+type Account struct {
+	Address  flow.Address
+	KeyIndex uint32
+	Signer   crypto.Signer
+}
+
+// submitter sends transactions paid for by the service account
+// and waits until they are sealed.
+type submitter struct {
+	backend        Backend
+	serviceAccount Account
+	pollInterval   time.Duration
+}
+
+// submit prepares the given transaction with the service account as proposer and payer,
+// signs it with the service account and the given authorizers,
+// sends it and returns its result once it is sealed.
+//
+// The authorizers are added to the transaction in order, after the ones it already has.
+// The service account can be an authorizer, it then only signs the envelope.
+func (s submitter) submit(ctx context.Context, tx *flow.Transaction, authorizers ...Account) (*flow.TransactionResult, error) {
+	err := client.PrepareTransaction(ctx, s.backend, tx, client.Account{
+		Address:  s.serviceAccount.Address,
+		KeyIndex: s.serviceAccount.KeyIndex,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, authorizer := range authorizers {
+		tx.AddAuthorizer(authorizer.Address)
+	}
+
+	for _, authorizer := range authorizers {
+		if authorizer.Address == s.serviceAccount.Address {
+			continue
+		}
+
+		err = tx.SignPayload(authorizer.Address, authorizer.KeyIndex, authorizer.Signer)
+		if err != nil {
+			return nil, fmt.Errorf("cannot sign transaction for %s: %w", authorizer.Address.HexWithPrefix(), err)
+		}
+	}
+
+	err = tx.SignEnvelope(s.serviceAccount.Address, s.serviceAccount.KeyIndex, s.serviceAccount.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("cannot sign transaction for the service account: %w", err)
+	}
+
+	err = s.backend.SendTransaction(ctx, *tx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot send transaction: %w", err)
+	}
+
+	result, err := s.wait(ctx, tx.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("transaction %s failed: %w", tx.ID(), result.Error)
+	}
+
+	return result, nil
+}
+
+// wait returns the result of the given transaction once it is sealed.
+func (s submitter) wait(ctx context.Context, txID flow.Identifier) (*flow.TransactionResult, error) {
+	for {
+		result, err := s.backend.GetTransactionResult(ctx, txID)
+		if err != nil {
+			return nil, fmt.Errorf("cannot get result of transaction %s: %w", txID, err)
+		}
+
+		if result.Status == flow.TransactionStatusSealed {
+			return result, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(s.pollInterval):
+		}
+	}
+}
+
+// createdAccount returns the address of the account created by a transaction.
+func createdAccount(result *flow.TransactionResult) (flow.Address, error) {
+	for _, event := range result.Events {
+		if event.Type == flow.EventAccountCreated {
+			return flow.AccountCreatedEvent(event).Address(), nil
+		}
+	}
+
+	return flow.EmptyAddress, fmt.Errorf("transaction %s did not create an account", result.TransactionID)
+}
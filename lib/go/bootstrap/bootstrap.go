@@ -0,0 +1,568 @@
+// Package bootstrap deploys the core contracts to a Flow network,
+// typically an emulator used by integration tests.
+//
+// Deploy creates accounts for the contracts, deploys them in dependency order
+// and returns the environment with the addresses of the deployed contracts,
+// along with the accounts that hold their admin resources:
+//
+//	config := bootstrap.DefaultConfig(templates.EmulatorEnvironment(), serviceAccount, adminKey)
+//
+//	deployment, err := bootstrap.Deploy(ctx, backend, config)
+//
+//	tx := templates.GenerateEpochRegisterNodeScript(deployment.Env)
+package bootstrap
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+	sdktemplates "github.com/onflow/flow-go-sdk/templates"
+
+	"github.com/onflow/flow-core-contracts/lib/go/contracts"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+// Config configures the deployment of the core contracts.
+type Config struct {
+	// Env holds the addresses of the contracts the core contracts depend on,
+	// e.g. FungibleToken, FlowToken, FlowStorageFees, Burner, ViewResolver, MetadataViews and EVM.
+	// The addresses of the deployed contracts are replaced.
+	Env templates.Environment
+
+	// ServiceAccount pays for all transactions.
+	// It must store the FlowToken administrator, which creates the minter of the staking contract,
+	// and it receives the LockedTokens admin resource.
+	ServiceAccount Account
+
+	// AdminKey is the key of the accounts created for the contracts,
+	// which sign the transactions that use the admin resources.
+	AdminKey crypto.PrivateKey
+
+	// AdminHashAlgo is the hash algorithm of the admin key.
+	AdminHashAlgo crypto.HashAlgorithm
+
+	Staking StakingConfig
+	Epoch   EpochConfig
+
+	// VersionBoundaryFreezePeriod is the number of blocks before a version boundary
+	// during which it cannot be changed in the NodeVersionBeacon contract.
+	VersionBoundaryFreezePeriod uint64
+
+	// PollInterval is the interval at which transaction results are polled until they are sealed.
+	// It must be positive.
+	PollInterval time.Duration
+}
+
+// StakingConfig configures the FlowIDTableStaking contract.
+type StakingConfig struct {
+	// WeeklyPayout is the amount of FLOW rewards paid each epoch
+	WeeklyPayout cadence.UFix64
+	// DelegatorCutPercentage is the part of delegator rewards that goes to the node operator
+	DelegatorCutPercentage cadence.UFix64
+	// CandidateNodeLimits is the maximum number of candidate nodes, by role
+	CandidateNodeLimits [5]uint64
+}
+
+// EpochConfig configures the FlowEpoch contract.
+type EpochConfig struct {
+	Counter               uint64
+	NumViewsInEpoch       uint64
+	NumViewsInStaking     uint64
+	NumViewsInDKGPhase    uint64
+	NumCollectorClusters  uint16
+	SupplyIncreasePercent cadence.UFix64
+	// RandomSource is the hex-encoded source of randomness of the first epoch
+	RandomSource string
+}
+
+// DefaultConfig returns a configuration with the values used by the core contracts tests.
+func DefaultConfig(env templates.Environment, serviceAccount Account, adminKey crypto.PrivateKey) Config {
+	return Config{
+		Env:            env,
+		ServiceAccount: serviceAccount,
+		AdminKey:       adminKey,
+		AdminHashAlgo:  crypto.SHA3_256,
+		Staking: StakingConfig{
+			WeeklyPayout:           cadence.UFix64(1_250_000_00000000),
+			DelegatorCutPercentage: cadence.UFix64(8_000000),
+			CandidateNodeLimits:    [5]uint64{10, 10, 10, 10, 10},
+		},
+		Epoch: EpochConfig{
+			Counter:               0,
+			NumViewsInEpoch:       70,
+			NumViewsInStaking:     50,
+			NumViewsInDKGPhase:    2,
+			NumCollectorClusters:  2,
+			SupplyIncreasePercent: cadence.UFix64(93871),
+			RandomSource:          "00000000000000000000000000000000",
+		},
+		VersionBoundaryFreezePeriod: 1000,
+		PollInterval:                100 * time.Millisecond,
+	}
+}
+
+// Deployment is the result of deploying the core contracts.
+type Deployment struct {
+	// Env is the configured environment with the addresses of the deployed contracts
+	Env templates.Environment
+
+	// IDTable is the account of the FlowIDTableStaking, FlowClusterQC, FlowDKG and FlowEpoch contracts.
+	// It holds their admin resources and the FlowFees admin.
+	IDTable Account
+	// FlowFees is the account of the FlowFees contract
+	FlowFees Account
+	// StakingProxy is the account of the StakingProxy contract
+	StakingProxy Account
+	// LockedTokens is the account of the LockedTokens and FlowStakingCollection contracts.
+	// The LockedTokens admin resource is stored in the service account.
+	LockedTokens Account
+	// NodeVersionBeacon is the account of the NodeVersionBeacon contract, which holds its admin resource
+	NodeVersionBeacon Account
+	// RandomBeaconHistory is the account of the RandomBeaconHistory contract, which holds its heartbeat resource
+	RandomBeaconHistory Account
+	// TransactionScheduler is the account of the FlowTransactionScheduler and FlowTransactionSchedulerUtils contracts
+	TransactionScheduler Account
+}
+
+// deployer deploys the contracts of a deployment.
+type deployer struct {
+	submitter
+	config   Config
+	adminKey *flow.AccountKey
+	signer   crypto.Signer
+	env      templates.Environment
+}
+
+// Deploy deploys the core contracts in dependency order:
+// FlowFees, FlowIDTableStaking, FlowClusterQC, FlowDKG, FlowEpoch, StakingProxy, LockedTokens,
+// FlowStakingCollection, NodeVersionBeacon, RandomBeaconHistory and FlowTransactionScheduler.
+//
+// Each transaction is sent once the previous one is sealed.
+func Deploy(ctx context.Context, backend Backend, config Config) (*Deployment, error) {
+	if config.PollInterval <= 0 {
+		return nil, fmt.Errorf("invalid polling interval %s: must be positive", config.PollInterval)
+	}
+
+	signer, err := crypto.NewInMemorySigner(config.AdminKey, config.AdminHashAlgo)
+	if err != nil {
+		return nil, fmt.Errorf("invalid admin key: %w", err)
+	}
+
+	d := &deployer{
+		submitter: submitter{
+			backend:        backend,
+			serviceAccount: config.ServiceAccount,
+			pollInterval:   config.PollInterval,
+		},
+		config: config,
+		adminKey: flow.NewAccountKey().
+			FromPrivateKey(config.AdminKey).
+			SetHashAlgo(config.AdminHashAlgo).
+			SetWeight(flow.AccountKeyWeightThreshold),
+		signer: signer,
+		env:    config.Env,
+	}
+
+	deployment := &Deployment{}
+
+	steps := []struct {
+		name   string
+		deploy func(context.Context, *Deployment) error
+	}{
+		{"FlowFees", d.deployFees},
+		{"FlowIDTableStaking", d.deployStaking},
+		{"FlowClusterQC and FlowDKG", d.deployQCAndDKG},
+		{"FlowEpoch", d.deployEpoch},
+		{"StakingProxy", d.deployStakingProxy},
+		{"LockedTokens", d.deployLockedTokens},
+		{"FlowStakingCollection", d.deployStakingCollection},
+		{"NodeVersionBeacon", d.deployNodeVersionBeacon},
+		{"RandomBeaconHistory", d.deployRandomBeaconHistory},
+		{"FlowTransactionScheduler", d.deployTransactionScheduler},
+	}
+
+	for _, step := range steps {
+		err := step.deploy(ctx, deployment)
+		if err != nil {
+			return nil, fmt.Errorf("cannot deploy %s: %w", step.name, err)
+		}
+	}
+
+	deployment.Env = d.env
+
+	return deployment, nil
+}
+
+// admin returns the admin account with the given address.
+func (d *deployer) admin(address flow.Address) Account {
+	return Account{Address: address, KeyIndex: 0, Signer: d.signer}
+}
+
+// createAccount creates an account with the admin key and the given contracts,
+// which must not have initializer parameters.
+func (d *deployer) createAccount(ctx context.Context, contracts ...sdktemplates.Contract) (Account, error) {
+	tx, err := sdktemplates.CreateAccount([]*flow.AccountKey{d.adminKey}, contracts, d.serviceAccount.Address)
+	if err != nil {
+		return Account{}, err
+	}
+
+	result, err := d.submit(ctx, tx)
+	if err != nil {
+		return Account{}, err
+	}
+
+	address, err := createdAccount(result)
+	if err != nil {
+		return Account{}, err
+	}
+
+	return d.admin(address), nil
+}
+
+// addContract deploys the given contract to the given account,
+// passing the given arguments to the contract initializer.
+func (d *deployer) addContract(ctx context.Context, account Account, contract sdktemplates.Contract, arguments ...cadence.Value) error {
+	parameters, initArguments := "", ""
+	for i, argument := range arguments {
+		parameters += fmt.Sprintf(", arg%d: %s", i, argument.Type().ID())
+		initArguments += fmt.Sprintf(", arg%d", i)
+	}
+
+	script := fmt.Sprintf(`
+		transaction(name: String, code: String%s) {
+			prepare(signer: auth(AddContract) &Account) {
+				signer.contracts.add(name: name, code: code.decodeHex()%s)
+			}
+		}`,
+		parameters,
+		initArguments,
+	)
+
+	tx, err := newTransaction(
+		[]byte(script),
+		append([]cadence.Value{cadence.String(contract.Name), cadence.String(contract.SourceHex())}, arguments...)...,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.submit(ctx, tx, account)
+	return err
+}
+
+// adminKeys returns the admin key as a Cadence array of key list entries.
+func (d *deployer) adminKeys() (cadence.Value, error) {
+	key, err := sdktemplates.AccountKeyToCadenceCryptoKey(d.adminKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return cadence.NewArray([]cadence.Value{key}), nil
+}
+
+// deployFeesScript deploys FlowFees with the account it is deployed to as the admin account.
+const deployFeesScript = `
+	transaction(name: String, code: String) {
+		prepare(signer: auth(AddContract, SaveValue) &Account) {
+			signer.contracts.add(name: name, code: code.decodeHex(), adminAccount: signer)
+		}
+	}`
+
+// deployFees deploys FlowFees to a new account. The fees admin is stored in that account
+// until it is transferred to the staking account, which needs it to pay rewards from fees.
+func (d *deployer) deployFees(ctx context.Context, deployment *Deployment) error {
+	account, err := d.createAccount(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := newTransaction(
+		[]byte(deployFeesScript),
+		cadence.String("FlowFees"),
+		cadence.String(hex.EncodeToString(contracts.FlowFees(d.env))),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.submit(ctx, tx, account)
+	if err != nil {
+		return err
+	}
+
+	deployment.FlowFees = account
+	d.env.FlowFeesAddress = account.Address.Hex()
+
+	return nil
+}
+
+// deployStaking creates the staking account with a FlowToken minter,
+// deploys FlowIDTableStaking to it and transfers the fees admin to it.
+func (d *deployer) deployStaking(ctx context.Context, deployment *Deployment) error {
+	keys, err := d.adminKeys()
+	if err != nil {
+		return err
+	}
+
+	code, err := cadence.NewString(string(contracts.FlowIDTableStaking(d.env)))
+	if err != nil {
+		return err
+	}
+
+	limits := make([]cadence.Value, len(d.config.Staking.CandidateNodeLimits))
+	for i, limit := range d.config.Staking.CandidateNodeLimits {
+		limits[i] = cadence.NewUInt64(limit)
+	}
+
+	tx, err := newTransaction(
+		templates.GenerateTransferMinterAndDeployScript(d.env),
+		keys,
+		cadence.String("FlowIDTableStaking"),
+		code,
+		d.config.Staking.WeeklyPayout,
+		d.config.Staking.DelegatorCutPercentage,
+		cadence.NewArray(limits).WithType(cadence.NewVariableSizedArrayType(cadence.UInt64Type)),
+	)
+	if err != nil {
+		return err
+	}
+
+	result, err := d.submit(ctx, tx, d.serviceAccount)
+	if err != nil {
+		return err
+	}
+
+	address, err := createdAccount(result)
+	if err != nil {
+		return err
+	}
+
+	deployment.IDTable = d.admin(address)
+	d.env.IDTableAddress = address.Hex()
+
+	_, err = d.submit(
+		ctx,
+		flow.NewTransaction().SetScript(templates.GenerateTransferFeesAdminScript(d.env)),
+		deployment.FlowFees,
+		deployment.IDTable,
+	)
+	if err != nil {
+		return fmt.Errorf("cannot transfer fees admin: %w", err)
+	}
+
+	return nil
+}
+
+// deployQCAndDKG deploys FlowClusterQC and FlowDKG to the staking account.
+func (d *deployer) deployQCAndDKG(ctx context.Context, deployment *Deployment) error {
+	tx, err := newTransaction(
+		templates.GenerateDeployQCDKGScript(d.env),
+		cadence.String("FlowClusterQC"),
+		bytesToCadenceArray(contracts.FlowQC()),
+		cadence.String("FlowDKG"),
+		bytesToCadenceArray(contracts.FlowDKG()),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.submit(ctx, tx, deployment.IDTable)
+	if err != nil {
+		return err
+	}
+
+	d.env.QuorumCertificateAddress = deployment.IDTable.Address.Hex()
+	d.env.DkgAddress = deployment.IDTable.Address.Hex()
+
+	return nil
+}
+
+// deployEpoch deploys FlowEpoch to the staking account,
+// without clusters, cluster QCs or DKG keys.
+func (d *deployer) deployEpoch(ctx context.Context, deployment *Deployment) error {
+	randomSource, err := cadence.NewString(d.config.Epoch.RandomSource)
+	if err != nil {
+		return err
+	}
+
+	d.env.EpochAddress = deployment.IDTable.Address.Hex()
+
+	tx, err := newTransaction(
+		templates.GenerateDeployEpochScript(d.env),
+		cadence.String("FlowEpoch"),
+		bytesToCadenceArray(contracts.FlowEpoch(d.env)),
+		cadence.NewUInt64(d.config.Epoch.Counter),
+		cadence.NewUInt64(d.config.Epoch.NumViewsInEpoch),
+		cadence.NewUInt64(d.config.Epoch.NumViewsInStaking),
+		cadence.NewUInt64(d.config.Epoch.NumViewsInDKGPhase),
+		cadence.NewUInt16(d.config.Epoch.NumCollectorClusters),
+		d.config.Epoch.SupplyIncreasePercent,
+		randomSource,
+		cadence.NewArray(nil),
+		cadence.NewArray(nil),
+		cadence.NewArray(nil),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.submit(ctx, tx, deployment.IDTable)
+	return err
+}
+
+// deployStakingProxy deploys StakingProxy to a new account.
+func (d *deployer) deployStakingProxy(ctx context.Context, deployment *Deployment) error {
+	account, err := d.createAccount(ctx, sdktemplates.Contract{Name: "StakingProxy", Source: string(contracts.FlowStakingProxy())})
+	if err != nil {
+		return err
+	}
+
+	deployment.StakingProxy = account
+	d.env.StakingProxyAddress = account.Address.Hex()
+
+	return nil
+}
+
+// deployLockedTokens deploys LockedTokens to a new account,
+// storing its admin resource in the service account.
+func (d *deployer) deployLockedTokens(ctx context.Context, deployment *Deployment) error {
+	keys, err := d.adminKeys()
+	if err != nil {
+		return err
+	}
+
+	tx, err := newTransaction(
+		templates.GenerateDeployLockedTokens(),
+		cadence.String("LockedTokens"),
+		cadence.String(hex.EncodeToString(contracts.FlowLockedTokens(d.env))),
+		keys,
+	)
+	if err != nil {
+		return err
+	}
+
+	result, err := d.submit(ctx, tx, d.serviceAccount)
+	if err != nil {
+		return err
+	}
+
+	address, err := createdAccount(result)
+	if err != nil {
+		return err
+	}
+
+	deployment.LockedTokens = d.admin(address)
+	d.env.LockedTokensAddress = address.Hex()
+
+	return nil
+}
+
+// deployStakingCollection deploys FlowStakingCollection to the LockedTokens account,
+// because it needs access to the LockedTokens contract functions.
+func (d *deployer) deployStakingCollection(ctx context.Context, deployment *Deployment) error {
+	d.env.StakingCollectionAddress = deployment.LockedTokens.Address.Hex()
+
+	tx, err := newTransaction(
+		templates.GenerateDeployStakingCollectionScript(),
+		cadence.String("FlowStakingCollection"),
+		cadence.String(hex.EncodeToString(contracts.FlowStakingCollection(d.env))),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.submit(ctx, tx, deployment.LockedTokens)
+	return err
+}
+
+// deployNodeVersionBeacon deploys NodeVersionBeacon to a new account.
+func (d *deployer) deployNodeVersionBeacon(ctx context.Context, deployment *Deployment) error {
+	account, err := d.createAccount(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = d.addContract(
+		ctx,
+		account,
+		sdktemplates.Contract{Name: "NodeVersionBeacon", Source: string(contracts.NodeVersionBeacon())},
+		cadence.NewUInt64(d.config.VersionBoundaryFreezePeriod),
+	)
+	if err != nil {
+		return err
+	}
+
+	deployment.NodeVersionBeacon = account
+	d.env.NodeVersionBeaconAddress = account.Address.Hex()
+
+	return nil
+}
+
+// deployRandomBeaconHistory deploys RandomBeaconHistory to a new account.
+func (d *deployer) deployRandomBeaconHistory(ctx context.Context, deployment *Deployment) error {
+	account, err := d.createAccount(ctx, sdktemplates.Contract{Name: "RandomBeaconHistory", Source: string(contracts.RandomBeaconHistory())})
+	if err != nil {
+		return err
+	}
+
+	deployment.RandomBeaconHistory = account
+	d.env.RandomBeaconHistoryAddress = account.Address.Hex()
+
+	return nil
+}
+
+// deployTransactionScheduler deploys FlowTransactionScheduler
+// and FlowTransactionSchedulerUtils to a new account.
+func (d *deployer) deployTransactionScheduler(ctx context.Context, deployment *Deployment) error {
+	account, err := d.createAccount(ctx, sdktemplates.Contract{
+		Name:   "FlowTransactionScheduler",
+		Source: string(contracts.FlowTransactionScheduler(d.env)),
+	})
+	if err != nil {
+		return err
+	}
+
+	d.env.FlowTransactionSchedulerAddress = account.Address.Hex()
+
+	err = d.addContract(ctx, account, sdktemplates.Contract{
+		Name:   "FlowTransactionSchedulerUtils",
+		Source: string(contracts.FlowTransactionSchedulerUtils(d.env)),
+	})
+	if err != nil {
+		return err
+	}
+
+	deployment.TransactionScheduler = account
+	d.env.FlowTransactionSchedulerUtilsAddress = account.Address.Hex()
+
+	return nil
+}
+
+// newTransaction returns a transaction with the given script and arguments.
+func newTransaction(script []byte, arguments ...cadence.Value) (*flow.Transaction, error) {
+	tx := flow.NewTransaction().SetScript(script)
+
+	for i, argument := range arguments {
+		err := tx.AddArgument(argument)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode argument %d: %w", i, err)
+		}
+	}
+
+	return tx, nil
+}
+
+// bytesToCadenceArray returns the given bytes as a Cadence [UInt8] array.
+func bytesToCadenceArray(b []byte) cadence.Array {
+	values := make([]cadence.Value, len(b))
+	for i, v := range b {
+		values[i] = cadence.NewUInt8(v)
+	}
+
+	return cadence.NewArray(values).WithType(cadence.NewVariableSizedArrayType(cadence.UInt8Type))
+}
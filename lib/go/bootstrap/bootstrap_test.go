@@ -0,0 +1,240 @@
+package bootstrap
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+// testBackend executes transactions by recording them,
+// and emits an AccountCreated event for transactions that create an account.
+type testBackend struct {
+	serviceAccount *flow.Account
+	transactions   []flow.Transaction
+	results        map[flow.Identifier]*flow.TransactionResult
+	pending        int
+	fail           int
+	nextAddress    uint64
+}
+
+func newTestBackend(serviceAddress flow.Address) *testBackend {
+	return &testBackend{
+		serviceAccount: &flow.Account{
+			Address: serviceAddress,
+			Keys:    []*flow.AccountKey{{Index: 0, SequenceNumber: 0}},
+		},
+		results:     map[flow.Identifier]*flow.TransactionResult{},
+		fail:        -1,
+		nextAddress: 0x10,
+	}
+}
+
+func (b *testBackend) GetLatestBlockHeader(_ context.Context, _ bool) (*flow.BlockHeader, error) {
+	return &flow.BlockHeader{ID: flow.HexToID("01"), Height: uint64(len(b.transactions))}, nil
+}
+
+func (b *testBackend) GetAccount(_ context.Context, address flow.Address) (*flow.Account, error) {
+	if address != b.serviceAccount.Address {
+		return nil, fmt.Errorf("unknown account %s", address)
+	}
+	return b.serviceAccount, nil
+}
+
+func (b *testBackend) SendTransaction(_ context.Context, tx flow.Transaction) error {
+	result := &flow.TransactionResult{
+		Status:        flow.TransactionStatusSealed,
+		TransactionID: tx.ID(),
+	}
+
+	if len(b.transactions) == b.fail {
+		result.Error = fmt.Errorf("execution failed")
+	}
+
+	if bytes.Contains(tx.Script, []byte("Account(payer:")) {
+		address := flow.HexToAddress(fmt.Sprintf("%x", b.nextAddress))
+		b.nextAddress++
+
+		result.Events = []flow.Event{{
+			Type: flow.EventAccountCreated,
+			Value: cadence.NewEvent([]cadence.Value{cadence.NewAddress(address)}).
+				WithType(cadence.NewEventType(
+					nil,
+					flow.EventAccountCreated,
+					[]cadence.Field{{Identifier: "address", Type: cadence.AddressType}},
+					nil,
+				)),
+		}}
+	}
+
+	b.transactions = append(b.transactions, tx)
+	b.results[tx.ID()] = result
+	b.serviceAccount.Keys[0].SequenceNumber++
+	// the first poll returns a pending result
+	b.pending = 1
+
+	return nil
+}
+
+func (b *testBackend) GetTransactionResult(_ context.Context, txID flow.Identifier) (*flow.TransactionResult, error) {
+	if b.pending > 0 {
+		b.pending--
+		return &flow.TransactionResult{Status: flow.TransactionStatusExecuted}, nil
+	}
+
+	result, ok := b.results[txID]
+	if !ok {
+		return nil, fmt.Errorf("unknown transaction %s", txID)
+	}
+	return result, nil
+}
+
+func newTestConfig(t *testing.T) Config {
+	env := templates.EmulatorEnvironment()
+
+	serviceKey, err := crypto.GeneratePrivateKey(crypto.ECDSA_P256, make([]byte, crypto.MinSeedLength))
+	require.NoError(t, err)
+
+	serviceSigner, err := crypto.NewInMemorySigner(serviceKey, crypto.SHA3_256)
+	require.NoError(t, err)
+
+	adminKey, err := crypto.GeneratePrivateKey(crypto.ECDSA_P256, bytes.Repeat([]byte{1}, crypto.MinSeedLength))
+	require.NoError(t, err)
+
+	config := DefaultConfig(
+		env,
+		Account{Address: flow.HexToAddress(env.ServiceAccountAddress), Signer: serviceSigner},
+		adminKey,
+	)
+	config.PollInterval = time.Nanosecond
+
+	return config
+}
+
+func TestDeploy(t *testing.T) {
+	ctx := context.Background()
+
+	config := newTestConfig(t)
+	serviceAddress := config.ServiceAccount.Address
+
+	backend := newTestBackend(serviceAddress)
+
+	deployment, err := Deploy(ctx, backend, config)
+	require.NoError(t, err)
+
+	fees := flow.HexToAddress("10")
+	idTable := flow.HexToAddress("11")
+	stakingProxy := flow.HexToAddress("12")
+	lockedTokens := flow.HexToAddress("13")
+	nodeVersionBeacon := flow.HexToAddress("14")
+	randomBeaconHistory := flow.HexToAddress("15")
+	scheduler := flow.HexToAddress("16")
+
+	env := deployment.Env
+	assert.Equal(t, fees.Hex(), env.FlowFeesAddress)
+	assert.Equal(t, idTable.Hex(), env.IDTableAddress)
+	assert.Equal(t, idTable.Hex(), env.QuorumCertificateAddress)
+	assert.Equal(t, idTable.Hex(), env.DkgAddress)
+	assert.Equal(t, idTable.Hex(), env.EpochAddress)
+	assert.Equal(t, stakingProxy.Hex(), env.StakingProxyAddress)
+	assert.Equal(t, lockedTokens.Hex(), env.LockedTokensAddress)
+	assert.Equal(t, lockedTokens.Hex(), env.StakingCollectionAddress)
+	assert.Equal(t, nodeVersionBeacon.Hex(), env.NodeVersionBeaconAddress)
+	assert.Equal(t, randomBeaconHistory.Hex(), env.RandomBeaconHistoryAddress)
+	assert.Equal(t, scheduler.Hex(), env.FlowTransactionSchedulerAddress)
+	assert.Equal(t, scheduler.Hex(), env.FlowTransactionSchedulerUtilsAddress)
+
+	// dependencies are kept
+	assert.Equal(t, config.Env.FungibleTokenAddress, env.FungibleTokenAddress)
+	assert.Equal(t, config.Env.StorageFeesAddress, env.StorageFeesAddress)
+
+	assert.Equal(t, idTable, deployment.IDTable.Address)
+	assert.Equal(t, lockedTokens, deployment.LockedTokens.Address)
+	assert.NotNil(t, deployment.IDTable.Signer)
+
+	// each step is authorized by the account it deploys to,
+	// and every transaction is proposed and paid by the service account
+	expectedAuthorizers := [][]flow.Address{
+		{serviceAddress},    // create FlowFees account
+		{fees},              // deploy FlowFees
+		{serviceAddress},    // create staking account and deploy FlowIDTableStaking
+		{fees, idTable},     // transfer fees admin
+		{idTable},           // deploy FlowClusterQC and FlowDKG
+		{idTable},           // deploy FlowEpoch
+		{serviceAddress},    // create StakingProxy account
+		{serviceAddress},    // create LockedTokens account
+		{lockedTokens},      // deploy FlowStakingCollection
+		{serviceAddress},    // create NodeVersionBeacon account
+		{nodeVersionBeacon}, // deploy NodeVersionBeacon
+		{serviceAddress},    // create RandomBeaconHistory account
+		{serviceAddress},    // create FlowTransactionScheduler account
+		{scheduler},         // deploy FlowTransactionSchedulerUtils
+	}
+
+	require.Len(t, backend.transactions, len(expectedAuthorizers))
+
+	for i, tx := range backend.transactions {
+		assert.Equal(t, expectedAuthorizers[i], tx.Authorizers, "transaction %d", i)
+		assert.Equal(t, serviceAddress, tx.Payer)
+		assert.Equal(t, uint64(i), tx.ProposalKey.SequenceNumber)
+		assert.Len(t, tx.EnvelopeSignatures, 1)
+
+		payloadSigners := 0
+		for _, authorizer := range tx.Authorizers {
+			if authorizer != serviceAddress {
+				payloadSigners++
+			}
+		}
+		assert.Len(t, tx.PayloadSignatures, payloadSigners, "transaction %d", i)
+	}
+
+	// the epoch contract is deployed with the addresses of the contracts deployed before it
+	epochTx := backend.transactions[5]
+	assert.Equal(t, templates.GenerateDeployEpochScript(env), epochTx.Script)
+
+	// the initializer arguments are passed to the contract
+	assert.Contains(t, string(backend.transactions[10].Script), "arg0: UInt64")
+
+	// the production FlowFees contract is deployed with its admin account
+	assert.Contains(t, string(backend.transactions[1].Script), "adminAccount: signer")
+}
+
+func TestDeployFailure(t *testing.T) {
+	ctx := context.Background()
+
+	config := newTestConfig(t)
+
+	backend := newTestBackend(config.ServiceAccount.Address)
+	backend.fail = 4
+
+	_, err := Deploy(ctx, backend, config)
+	require.ErrorContains(t, err, "cannot deploy FlowClusterQC and FlowDKG")
+	require.ErrorContains(t, err, "execution failed")
+
+	assert.Len(t, backend.transactions, 5)
+}
+
+func TestDeployInvalidPollInterval(t *testing.T) {
+	ctx := context.Background()
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		config := newTestConfig(t)
+		config.PollInterval = interval
+
+		backend := newTestBackend(config.ServiceAccount.Address)
+
+		_, err := Deploy(ctx, backend, config)
+		require.ErrorContains(t, err, "invalid polling interval")
+
+		assert.Empty(t, backend.transactions)
+	}
+}
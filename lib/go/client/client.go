@@ -0,0 +1,132 @@
+// Package client contains the building blocks shared by the typed clients
+// of the core contracts, e.g. the staking collection client in the stakingcollection package.
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+)
+
+// DefaultComputeLimit is the compute limit of the transactions built by the clients.
+const DefaultComputeLimit = 9999
+
+// Access is the part of the Flow access API the clients need.
+//
+// It is implemented by the access clients of the Flow Go SDK,
+// e.g. github.com/onflow/flow-go-sdk/access/grpc.Client,
+// and can be implemented on top of the emulator or by a mock in tests.
+type Access interface {
+	TransactionAccess
+	ExecuteScriptAtLatestBlock(ctx context.Context, script []byte, arguments []cadence.Value) (cadence.Value, error)
+}
+
+// TransactionAccess is the part of the Flow access API needed to prepare transactions:
+// the latest sealed block to reference and the keys of the proposer.
+type TransactionAccess interface {
+	GetLatestBlockHeader(ctx context.Context, isSealed bool) (*flow.BlockHeader, error)
+	GetAccount(ctx context.Context, address flow.Address) (*flow.Account, error)
+}
+
+// Account is the account and key that propose, pay for and authorize the transactions built by a client.
+type Account struct {
+	Address  flow.Address
+	KeyIndex uint32
+}
+
+// NewTransaction returns a transaction with the given script and arguments that is ready to be signed
+// by the given account: the transaction is prepared with PrepareTransaction,
+// and the account is also its authorizer.
+func NewTransaction(
+	ctx context.Context,
+	access Access,
+	account Account,
+	script []byte,
+	arguments ...cadence.Value,
+) (*flow.Transaction, error) {
+	tx := flow.NewTransaction().SetScript(script)
+
+	err := PrepareTransaction(ctx, access, tx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.AddAuthorizer(account.Address)
+
+	for i, argument := range arguments {
+		err = tx.AddArgument(argument)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode argument %d: %w", i, err)
+		}
+	}
+
+	return tx, nil
+}
+
+// PrepareTransaction sets the compute limit of the given transaction to DefaultComputeLimit,
+// references the latest sealed block, and makes the given account its proposer, with the account key
+// and its current sequence number, and its payer. The authorizers of the transaction are not changed.
+func PrepareTransaction(ctx context.Context, access TransactionAccess, tx *flow.Transaction, account Account) error {
+	header, err := access.GetLatestBlockHeader(ctx, true)
+	if err != nil {
+		return fmt.Errorf("cannot get reference block: %w", err)
+	}
+
+	flowAccount, err := access.GetAccount(ctx, account.Address)
+	if err != nil {
+		return fmt.Errorf("cannot get account %s: %w", account.Address.HexWithPrefix(), err)
+	}
+
+	var proposalKey *flow.AccountKey
+	for _, key := range flowAccount.Keys {
+		if key.Index == account.KeyIndex {
+			proposalKey = key
+			break
+		}
+	}
+
+	if proposalKey == nil {
+		return fmt.Errorf("account %s has no key with index %d", account.Address.HexWithPrefix(), account.KeyIndex)
+	}
+
+	if proposalKey.Revoked {
+		return fmt.Errorf("key %d of account %s is revoked", account.KeyIndex, account.Address.HexWithPrefix())
+	}
+
+	tx.SetComputeLimit(DefaultComputeLimit).
+		SetReferenceBlockID(header.ID).
+		SetProposalKey(account.Address, proposalKey.Index, proposalKey.SequenceNumber).
+		SetPayer(account.Address)
+
+	return nil
+}
+
+// ExecuteScript executes the given script with the given arguments at the latest sealed block.
+func ExecuteScript(ctx context.Context, access Access, script []byte, arguments ...cadence.Value) (cadence.Value, error) {
+	value, err := access.ExecuteScriptAtLatestBlock(ctx, script, arguments)
+	if err != nil {
+		return nil, fmt.Errorf("cannot execute script: %w", err)
+	}
+
+	return value, nil
+}
+
+// DecodeBool returns the value of the given Cadence Bool, e.g. the result of a script.
+func DecodeBool(value cadence.Value) (bool, error) {
+	b, ok := value.(cadence.Bool)
+	if !ok {
+		return false, fmt.Errorf("expected a Cadence Bool, got %T", value)
+	}
+	return bool(b), nil
+}
+
+// OptionalUInt32 returns the given value as a Cadence optional UInt32, e.g. for delegator IDs,
+// or nil if the value is nil.
+func OptionalUInt32(value *uint32) cadence.Optional {
+	if value == nil {
+		return cadence.NewOptional(nil)
+	}
+	return cadence.NewOptional(cadence.NewUInt32(*value))
+}
@@ -0,0 +1,150 @@
+// Package dkg is a client for the participants of the FlowDKG contract,
+// the consensus nodes that run the distributed key generation of the random beacon.
+//
+// The transaction methods return transactions that are ready to be signed by the machine account
+// of the participant, which stores its FlowDKG.Participant resource, and the read methods
+// execute the FlowDKG scripts and decode their results into Go structs.
+//
+//	c := dkg.New(templates.MainnetEnvironment(), accessClient, client.Account{Address: machineAccount})
+//	tx, err := c.PostMessage(ctx, content)
+package dkg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/onflow/flow-core-contracts/lib/go/client"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+// SubmissionKeyLength is the length of the hex-encoded BLS public keys of a result submission,
+// see FlowDKG.submissionKeyLength.
+const SubmissionKeyLength = 192
+
+// Client builds the DKG transactions of a participant and reads the state of the DKG through the access API.
+type Client struct {
+	env     templates.Environment
+	access  client.Access
+	account client.Account
+}
+
+// New returns a client for the DKG participant stored in the given machine account,
+// using the contract addresses of the given environment.
+func New(env templates.Environment, access client.Access, account client.Account) *Client {
+	return &Client{
+		env:     env,
+		access:  access,
+		account: account,
+	}
+}
+
+// Result is the result of the DKG computed by a participant.
+type Result struct {
+	// GroupKey is the hex-encoded group public key of the random beacon committee
+	GroupKey string
+	// PubKeys are the hex-encoded public keys of the participants, in the order of their DKG indexes
+	PubKeys []string
+	// IDMapping maps the node IDs of the participants to their DKG indexes
+	IDMapping map[string]int
+}
+
+// Validate checks the result like FlowDKG does when it is submitted:
+// the keys must have the length of BLS public keys, and there must be one participant per key.
+func (r Result) Validate() error {
+	if len(r.GroupKey) != SubmissionKeyLength {
+		return fmt.Errorf("group key has length %d instead of %d", len(r.GroupKey), SubmissionKeyLength)
+	}
+
+	for i, key := range r.PubKeys {
+		if len(key) != SubmissionKeyLength {
+			return fmt.Errorf("public key %d has length %d instead of %d", i, len(key), SubmissionKeyLength)
+		}
+	}
+
+	if len(r.IDMapping) != len(r.PubKeys) {
+		return fmt.Errorf("%d participants in the ID mapping for %d public keys", len(r.IDMapping), len(r.PubKeys))
+	}
+
+	return nil
+}
+
+func (r Result) arguments() ([]cadence.Value, error) {
+	groupKey, err := cadence.NewString(r.GroupKey)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeys := make([]cadence.Value, len(r.PubKeys))
+	for i, key := range r.PubKeys {
+		pubKeys[i], err = cadence.NewString(key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pairs := make([]cadence.KeyValuePair, 0, len(r.IDMapping))
+	for _, nodeID := range slices.Sorted(maps.Keys(r.IDMapping)) {
+		key, err := cadence.NewString(nodeID)
+		if err != nil {
+			return nil, err
+		}
+
+		pairs = append(pairs, cadence.KeyValuePair{Key: key, Value: cadence.NewInt(r.IDMapping[nodeID])})
+	}
+
+	return []cadence.Value{
+		groupKey,
+		cadence.NewArray(pubKeys).WithType(cadence.NewVariableSizedArrayType(cadence.StringType)),
+		cadence.NewDictionary(pairs).WithType(cadence.NewDictionaryType(cadence.StringType, cadence.IntType)),
+	}, nil
+}
+
+func (c *Client) newTransaction(ctx context.Context, script []byte, arguments ...cadence.Value) (*flow.Transaction, error) {
+	return client.NewTransaction(ctx, c.access, c.account, script, arguments...)
+}
+
+func (c *Client) executeScript(ctx context.Context, script []byte, arguments ...cadence.Value) (cadence.Value, error) {
+	return client.ExecuteScript(ctx, c.access, script, arguments...)
+}
+
+// PostMessage returns a transaction that posts a message to the whiteboard of the DKG.
+func (c *Client) PostMessage(ctx context.Context, content string) (*flow.Transaction, error) {
+	if content == "" {
+		return nil, errors.New("cannot post an empty whiteboard message")
+	}
+
+	message, err := cadence.NewString(content)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.newTransaction(ctx, templates.GenerateSendDKGWhiteboardMessageScript(c.env), message)
+}
+
+// SubmitResult returns a transaction that submits the result of the DKG computed by the participant.
+// The result is validated first, because an invalid result aborts the transaction.
+func (c *Client) SubmitResult(ctx context.Context, result Result) (*flow.Transaction, error) {
+	err := result.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid DKG result: %w", err)
+	}
+
+	arguments, err := result.arguments()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.newTransaction(ctx, templates.GenerateSendDKGFinalSubmissionScript(c.env), arguments...)
+}
+
+// SubmitEmptyResult returns a transaction that submits the empty result,
+// which records that the participant failed the DKG locally.
+func (c *Client) SubmitEmptyResult(ctx context.Context) (*flow.Transaction, error) {
+	return c.newTransaction(ctx, templates.GenerateSendEmptyDKGFinalSubmissionScript(c.env))
+}
@@ -0,0 +1,241 @@
+package dkg
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/client"
+	"github.com/onflow/flow-core-contracts/lib/go/client/internal/clienttest"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+const nodeID = "0000000000000000000000000000000000000000000000000000000000000001"
+
+func newTestClient(results ...cadence.Value) (*Client, *clienttest.Access) {
+	address := flow.HexToAddress("02")
+
+	access := clienttest.NewAccess(
+		&flow.Account{
+			Address: address,
+			Keys:    []*flow.AccountKey{{Index: 0, SequenceNumber: 5}},
+		},
+		results...,
+	)
+
+	return New(templates.MainnetEnvironment(), access, client.Account{Address: address}), access
+}
+
+func testResult() Result {
+	return Result{
+		GroupKey:  strings.Repeat("aa", 96),
+		PubKeys:   []string{strings.Repeat("bb", 96), strings.Repeat("cc", 96)},
+		IDMapping: map[string]int{"n2": 1, "n1": 0},
+	}
+}
+
+func TestTransactions(t *testing.T) {
+	ctx := context.Background()
+	env := templates.MainnetEnvironment()
+
+	t.Run("post message", func(t *testing.T) {
+		c, _ := newTestClient()
+
+		tx, err := c.PostMessage(ctx, "hello")
+		require.NoError(t, err)
+
+		assert.Equal(t, templates.GenerateSendDKGWhiteboardMessageScript(env), tx.Script)
+		assert.Equal(t, []cadence.Value{cadence.String("hello")}, clienttest.DecodeArguments(t, tx))
+		assert.Equal(t, flow.HexToAddress("02"), tx.Payer)
+		assert.Equal(t, uint64(5), tx.ProposalKey.SequenceNumber)
+
+		_, err = c.PostMessage(ctx, "")
+		assert.Error(t, err)
+	})
+
+	t.Run("submit result", func(t *testing.T) {
+		c, _ := newTestClient()
+
+		tx, err := c.SubmitResult(ctx, testResult())
+		require.NoError(t, err)
+
+		assert.Equal(t, templates.GenerateSendDKGFinalSubmissionScript(env), tx.Script)
+
+		arguments := clienttest.DecodeArguments(t, tx)
+		require.Len(t, arguments, 3)
+		assert.Equal(t, cadence.String(strings.Repeat("aa", 96)), arguments[0])
+		assert.Len(t, arguments[1].(cadence.Array).Values, 2)
+
+		mapping := arguments[2].(cadence.Dictionary)
+		assert.Equal(t, []cadence.KeyValuePair{
+			{Key: cadence.String("n1"), Value: cadence.NewInt(0)},
+			{Key: cadence.String("n2"), Value: cadence.NewInt(1)},
+		}, mapping.Pairs)
+	})
+
+	t.Run("submit empty result", func(t *testing.T) {
+		c, _ := newTestClient()
+
+		tx, err := c.SubmitEmptyResult(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, templates.GenerateSendEmptyDKGFinalSubmissionScript(env), tx.Script)
+		assert.Empty(t, tx.Arguments)
+	})
+
+	t.Run("invalid result", func(t *testing.T) {
+		c, _ := newTestClient()
+
+		result := testResult()
+		result.GroupKey = "aa"
+		_, err := c.SubmitResult(ctx, result)
+		assert.ErrorContains(t, err, "group key")
+
+		result = testResult()
+		result.PubKeys[1] = "cc"
+		_, err = c.SubmitResult(ctx, result)
+		assert.ErrorContains(t, err, "public key 1")
+
+		result = testResult()
+		result.IDMapping["n3"] = 2
+		_, err = c.SubmitResult(ctx, result)
+		assert.ErrorContains(t, err, "ID mapping")
+	})
+}
+
+func TestScripts(t *testing.T) {
+	ctx := context.Background()
+	env := templates.MainnetEnvironment()
+
+	address, err := common.HexToAddress(env.DkgAddress)
+	require.NoError(t, err)
+	location := common.NewAddressLocation(nil, address, "FlowDKG")
+
+	messageType := cadence.NewStructType(
+		location,
+		"FlowDKG.Message",
+		[]cadence.Field{
+			{Identifier: "nodeID", Type: cadence.StringType},
+			{Identifier: "content", Type: cadence.StringType},
+		},
+		nil,
+	)
+
+	message := func(content string) cadence.Value {
+		return cadence.NewStruct([]cadence.Value{cadence.String(nodeID), cadence.String(content)}).WithType(messageType)
+	}
+
+	t.Run("whiteboard", func(t *testing.T) {
+		c, access := newTestClient(
+			cadence.NewArray([]cadence.Value{message("a"), message("b")}),
+			cadence.NewArray(nil),
+			cadence.NewArray([]cadence.Value{message("c")}),
+		)
+
+		whiteboard := c.Whiteboard()
+
+		messages, err := whiteboard.Read(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []Message{{NodeID: nodeID, Content: "a"}, {NodeID: nodeID, Content: "b"}}, messages)
+
+		messages, err = whiteboard.Read(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, messages)
+
+		messages, err = whiteboard.Read(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []Message{{NodeID: nodeID, Content: "c"}}, messages)
+		assert.Equal(t, 3, whiteboard.Next())
+
+		assert.Equal(t, templates.GenerateGetDKGLatestWhiteBoardMessagesScript(env), access.Script)
+		assert.Equal(t, [][]cadence.Value{
+			{cadence.NewInt(0)},
+			{cadence.NewInt(2)},
+			{cadence.NewInt(2)},
+		}, access.Arguments)
+	})
+
+	t.Run("canonical final submission", func(t *testing.T) {
+		submissionType := cadence.NewStructType(
+			location,
+			"FlowDKG.ResultSubmission",
+			[]cadence.Field{
+				{Identifier: "groupPubKey", Type: cadence.NewOptionalType(cadence.StringType)},
+				{Identifier: "pubKeys", Type: cadence.NewOptionalType(cadence.NewVariableSizedArrayType(cadence.StringType))},
+				{Identifier: "idMapping", Type: cadence.NewOptionalType(cadence.NewDictionaryType(cadence.StringType, cadence.IntType))},
+			},
+			nil,
+		)
+
+		c, access := newTestClient(
+			cadence.NewOptional(nil),
+			cadence.NewOptional(cadence.NewStruct([]cadence.Value{
+				cadence.NewOptional(cadence.String("aa")),
+				cadence.NewOptional(cadence.NewArray([]cadence.Value{cadence.String("bb")})),
+				cadence.NewOptional(cadence.NewDictionary([]cadence.KeyValuePair{
+					{Key: cadence.String(nodeID), Value: cadence.NewInt(0)},
+				})),
+			}).WithType(submissionType)),
+		)
+
+		submission, err := c.GetCanonicalFinalSubmission(ctx)
+		require.NoError(t, err)
+		assert.Nil(t, submission)
+
+		submission, err = c.GetCanonicalFinalSubmission(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, submission)
+		assert.Equal(t, "aa", *submission.GroupPubKey)
+		assert.Equal(t, []string{"bb"}, *submission.PubKeys)
+		assert.Equal(t, map[string]int{nodeID: 0}, submission.IDMapping)
+		assert.Equal(t, templates.GenerateGetDKGCanonicalFinalSubmissionScript(env), access.Script)
+	})
+
+	t.Run("thresholds", func(t *testing.T) {
+		thresholdsType := cadence.NewStructType(
+			common.ScriptLocation{},
+			"Thresholds",
+			[]cadence.Field{
+				{Identifier: "native", Type: cadence.UInt64Type},
+				{Identifier: "safe", Type: cadence.UInt64Type},
+				{Identifier: "safePercentage", Type: cadence.UFix64Type},
+			},
+			nil,
+		)
+
+		percentage, err := cadence.NewUFix64("0.6")
+		require.NoError(t, err)
+
+		c, access := newTestClient(cadence.NewStruct([]cadence.Value{
+			cadence.NewUInt64(4),
+			cadence.NewUInt64(6),
+			percentage,
+		}).WithType(thresholdsType))
+
+		thresholds, err := c.GetThresholds(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, Thresholds{Native: 4, Safe: 6, SafePercentage: percentage}, thresholds)
+		assert.Equal(t, templates.GenerateGetDKGThresholdsScript(env), access.Script)
+	})
+
+	t.Run("has submitted", func(t *testing.T) {
+		c, access := newTestClient(cadence.NewBool(true))
+
+		submitted, err := c.HasSubmitted(ctx, nodeID)
+		require.NoError(t, err)
+		assert.True(t, submitted)
+		assert.Equal(t, [][]cadence.Value{{cadence.String(nodeID)}}, access.Arguments)
+	})
+
+	t.Run("unexpected result", func(t *testing.T) {
+		c, _ := newTestClient(cadence.String("true"))
+
+		_, err := c.IsEnabled(ctx)
+		require.ErrorContains(t, err, "expected a Cadence Bool")
+	})
+}
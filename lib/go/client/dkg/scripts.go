@@ -0,0 +1,148 @@
+package dkg
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-core-contracts/lib/go/client"
+	"github.com/onflow/flow-core-contracts/lib/go/events"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+// Message is the FlowDKG.Message struct, a message posted to the whiteboard of the DKG.
+type Message struct {
+	NodeID  string `cadence:"nodeID"`
+	Content string `cadence:"content"`
+}
+
+// Thresholds are the numbers of matching result submissions the DKG needs to complete.
+type Thresholds struct {
+	// Native is the threshold of the DKG protocol, see FlowDKG.getNativeSuccessThreshold
+	Native uint64 `cadence:"native"`
+	// Safe is the threshold the DKG completes at, see FlowDKG.getSafeSuccessThreshold
+	Safe uint64 `cadence:"safe"`
+	// SafePercentage is the percentage of the participants the safe threshold is set to, or zero if it is not set
+	SafePercentage cadence.UFix64 `cadence:"safePercentage"`
+}
+
+// GetLatestMessages returns the messages of the whiteboard from the given index,
+// the number of messages already read.
+func (c *Client) GetLatestMessages(ctx context.Context, fromIndex int) ([]Message, error) {
+	value, err := c.executeScript(ctx, templates.GenerateGetDKGLatestWhiteBoardMessagesScript(c.env), cadence.NewInt(fromIndex))
+	if err != nil {
+		return nil, err
+	}
+
+	array, ok := value.(cadence.Array)
+	if !ok {
+		return nil, fmt.Errorf("expected a Cadence array of whiteboard messages, got %T", value)
+	}
+
+	messages := make([]Message, len(array.Values))
+	for i, element := range array.Values {
+		err := events.DecodeValue(element, &messages[i])
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode whiteboard message %d: %w", fromIndex+i, err)
+		}
+	}
+
+	return messages, nil
+}
+
+// Whiteboard reads the messages of the whiteboard of the DKG in order,
+// each message once.
+type Whiteboard struct {
+	client *Client
+	next   int
+}
+
+// Whiteboard returns a reader of the whiteboard from its first message.
+func (c *Client) Whiteboard() *Whiteboard {
+	return &Whiteboard{client: c}
+}
+
+// Read returns the messages posted since the previous read.
+func (w *Whiteboard) Read(ctx context.Context) ([]Message, error) {
+	messages, err := w.client.GetLatestMessages(ctx, w.next)
+	if err != nil {
+		return nil, err
+	}
+
+	w.next += len(messages)
+	return messages, nil
+}
+
+// Next returns the index of the next message the whiteboard reads.
+func (w *Whiteboard) Next() int {
+	return w.next
+}
+
+// GetCanonicalFinalSubmission returns the result submission that reached the success threshold of the DKG,
+// or nil if the DKG has not completed.
+func (c *Client) GetCanonicalFinalSubmission(ctx context.Context) (*events.ResultSubmission, error) {
+	value, err := c.executeScript(ctx, templates.GenerateGetDKGCanonicalFinalSubmissionScript(c.env))
+	if err != nil {
+		return nil, err
+	}
+
+	optional, ok := value.(cadence.Optional)
+	if !ok {
+		return nil, fmt.Errorf("expected a Cadence optional result submission, got %T", value)
+	}
+
+	if optional.Value == nil {
+		return nil, nil
+	}
+
+	submission := &events.ResultSubmission{}
+	err = events.DecodeValue(optional.Value, submission)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode result submission: %w", err)
+	}
+
+	return submission, nil
+}
+
+// GetThresholds returns the success thresholds of the DKG.
+func (c *Client) GetThresholds(ctx context.Context) (Thresholds, error) {
+	var thresholds Thresholds
+
+	value, err := c.executeScript(ctx, templates.GenerateGetDKGThresholdsScript(c.env))
+	if err != nil {
+		return thresholds, err
+	}
+
+	err = events.DecodeValue(value, &thresholds)
+	if err != nil {
+		return thresholds, fmt.Errorf("cannot decode DKG thresholds: %w", err)
+	}
+
+	return thresholds, nil
+}
+
+// IsEnabled returns whether the DKG is running.
+func (c *Client) IsEnabled(ctx context.Context) (bool, error) {
+	value, err := c.executeScript(ctx, templates.GenerateGetDKGEnabledScript(c.env))
+	if err != nil {
+		return false, err
+	}
+
+	return client.DecodeBool(value)
+}
+
+// HasSubmitted returns whether the given node has submitted its result for the current DKG.
+func (c *Client) HasSubmitted(ctx context.Context, nodeID string) (bool, error) {
+	id, err := cadence.NewString(nodeID)
+	if err != nil {
+		return false, err
+	}
+
+	value, err := c.executeScript(ctx, templates.GenerateGetDKGNodeHasFinalSubmittedScript(c.env), id)
+	if err != nil {
+		return false, err
+	}
+
+	return client.DecodeBool(value)
+}
@@ -0,0 +1,67 @@
+// Package clienttest contains the mock access API shared by the tests of the typed clients.
+package clienttest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/require"
+)
+
+// Access is a client.Access that returns the given account and script results,
+// and records the executed scripts.
+type Access struct {
+	// Account is returned for every account address
+	Account *flow.Account
+	// Results are returned by the executed scripts, in order
+	Results []cadence.Value
+
+	// Script is the last executed script
+	Script []byte
+	// Arguments are the arguments of every executed script
+	Arguments [][]cadence.Value
+}
+
+// NewAccess returns an Access for the given account whose scripts return the given results, in order.
+func NewAccess(account *flow.Account, results ...cadence.Value) *Access {
+	return &Access{
+		Account: account,
+		Results: results,
+	}
+}
+
+func (a *Access) GetLatestBlockHeader(_ context.Context, _ bool) (*flow.BlockHeader, error) {
+	return &flow.BlockHeader{ID: flow.HexToID("01"), Height: 42}, nil
+}
+
+func (a *Access) GetAccount(_ context.Context, _ flow.Address) (*flow.Account, error) {
+	return a.Account, nil
+}
+
+func (a *Access) ExecuteScriptAtLatestBlock(_ context.Context, script []byte, arguments []cadence.Value) (cadence.Value, error) {
+	a.Script = script
+	a.Arguments = append(a.Arguments, arguments)
+
+	if len(a.Results) == 0 {
+		return nil, fmt.Errorf("unexpected script execution")
+	}
+
+	result := a.Results[0]
+	a.Results = a.Results[1:]
+	return result, nil
+}
+
+// DecodeArguments decodes the JSON-Cadence arguments of the given transaction.
+func DecodeArguments(t *testing.T, tx *flow.Transaction) []cadence.Value {
+	values := make([]cadence.Value, len(tx.Arguments))
+	for i, argument := range tx.Arguments {
+		value, err := jsoncdc.Decode(nil, argument)
+		require.NoError(t, err)
+		values[i] = value
+	}
+	return values
+}
@@ -0,0 +1,71 @@
+package qc
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/onflow/crypto"
+
+	"github.com/onflow/flow-core-contracts/lib/go/events"
+)
+
+// AggregateVoteData aggregates the vote signatures of a quorum certificate
+// into the vote data of the cluster for the recover epoch transaction.
+func AggregateVoteData(qc events.ClusterQC) (events.ClusterQCVoteData, error) {
+	if len(qc.VoteSignatures) == 0 {
+		return events.ClusterQCVoteData{}, errors.New("quorum certificate has no votes")
+	}
+
+	if len(qc.VoteSignatures) != len(qc.VoterIDs) {
+		return events.ClusterQCVoteData{}, fmt.Errorf("quorum certificate has %d signatures for %d voters", len(qc.VoteSignatures), len(qc.VoterIDs))
+	}
+
+	signatures := make([]crypto.Signature, len(qc.VoteSignatures))
+	for i, signature := range qc.VoteSignatures {
+		decoded, err := hex.DecodeString(signature)
+		if err != nil {
+			return events.ClusterQCVoteData{}, fmt.Errorf("signature of voter %s is not hex-encoded: %w", qc.VoterIDs[i], err)
+		}
+		signatures[i] = decoded
+	}
+
+	aggregated, err := crypto.AggregateBLSSignatures(signatures)
+	if err != nil {
+		return events.ClusterQCVoteData{}, fmt.Errorf("cannot aggregate signatures: %w", err)
+	}
+
+	return events.ClusterQCVoteData{
+		AggregatedSignature: hex.EncodeToString(aggregated),
+		VoterIDs:            qc.VoterIDs,
+	}, nil
+}
+
+// VerifyVoteData returns whether the aggregated signature of the vote data is a signature of the message
+// by all the voters, given the staking keys of the nodes.
+func VerifyVoteData(voteData events.ClusterQCVoteData, message []byte, stakingKeys map[string]crypto.PublicKey) (bool, error) {
+	if len(voteData.VoterIDs) == 0 {
+		return false, errors.New("vote data has no voters")
+	}
+
+	keys := make([]crypto.PublicKey, len(voteData.VoterIDs))
+	for i, nodeID := range voteData.VoterIDs {
+		key, ok := stakingKeys[nodeID]
+		if !ok {
+			return false, fmt.Errorf("missing staking key of voter %s", nodeID)
+		}
+		keys[i] = key
+	}
+
+	signature, err := hex.DecodeString(voteData.AggregatedSignature)
+	if err != nil {
+		return false, fmt.Errorf("aggregated signature is not hex-encoded: %w", err)
+	}
+
+	aggregatedKey, err := crypto.AggregateBLSPublicKeys(keys)
+	if err != nil {
+		return false, fmt.Errorf("cannot aggregate staking keys: %w", err)
+	}
+
+	return aggregatedKey.Verify(signature, message, NewVoteHasher())
+}
@@ -0,0 +1,151 @@
+// Package qc is a client for the voters of the FlowClusterQC contract,
+// the collector nodes that vote for the root quorum certificate of their cluster during the epoch setup phase.
+//
+// Votes are signed with the BLS staking key of the node under the collector vote domain tag,
+// and the transaction methods return transactions that are ready to be signed by the machine account
+// of the node, which stores its FlowClusterQC.Voter resource. The read methods execute the FlowClusterQC
+// scripts and decode their results into Go structs.
+//
+//	c := qc.New(templates.MainnetEnvironment(), accessClient, client.Account{Address: machineAccount})
+//	tx, err := c.Vote(ctx, stakingKey, nodeID, clusterIndex, message)
+package qc
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/crypto"
+	"github.com/onflow/crypto/hash"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/onflow/flow-core-contracts/lib/go/client"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+// CollectorVoteTag is the domain separation tag of the collector votes,
+// the one FlowClusterQC.Voter.vote verifies the vote signatures with.
+const CollectorVoteTag = "FLOW-Collector_Vote-V00-CS00-with-"
+
+// Client builds the QC transactions of a voter and reads the state of the QC voting through the access API.
+type Client struct {
+	env     templates.Environment
+	access  client.Access
+	account client.Account
+}
+
+// New returns a client for the QC voter stored in the given machine account,
+// using the contract addresses of the given environment.
+func New(env templates.Environment, access client.Access, account client.Account) *Client {
+	return &Client{
+		env:     env,
+		access:  access,
+		account: account,
+	}
+}
+
+// Vote is a signed vote of a collector node, encoded like the arguments of the submit vote transaction.
+type Vote struct {
+	// Signature is the hex-encoded BLS signature of the message with the staking key of the node
+	Signature string
+	// Message is the hex-encoded vote message
+	Message string
+}
+
+// NewVoteHasher returns the hasher of the collector votes.
+func NewVoteHasher() hash.Hasher {
+	return crypto.NewExpandMsgXOFKMAC128(CollectorVoteTag)
+}
+
+// SignVote signs the vote message with the BLS staking key of a collector node.
+func SignVote(stakingKey crypto.PrivateKey, message []byte) (Vote, error) {
+	if stakingKey.Algorithm() != crypto.BLSBLS12381 {
+		return Vote{}, fmt.Errorf("staking key must be a %s key, got %s", crypto.BLSBLS12381, stakingKey.Algorithm())
+	}
+
+	if len(message) == 0 {
+		return Vote{}, errors.New("cannot sign an empty vote message")
+	}
+
+	signature, err := stakingKey.Sign(message, NewVoteHasher())
+	if err != nil {
+		return Vote{}, fmt.Errorf("cannot sign vote: %w", err)
+	}
+
+	return Vote{
+		Signature: hex.EncodeToString(signature),
+		Message:   hex.EncodeToString(message),
+	}, nil
+}
+
+// VerifyVote returns whether the vote is signed with the staking key of the given public key,
+// like FlowClusterQC.Voter.vote checks when the vote is submitted.
+func VerifyVote(stakingKey crypto.PublicKey, vote Vote) (bool, error) {
+	signature, err := hex.DecodeString(vote.Signature)
+	if err != nil {
+		return false, fmt.Errorf("vote signature is not hex-encoded: %w", err)
+	}
+
+	message, err := hex.DecodeString(vote.Message)
+	if err != nil {
+		return false, fmt.Errorf("vote message is not hex-encoded: %w", err)
+	}
+
+	return stakingKey.Verify(signature, message, NewVoteHasher())
+}
+
+func (c *Client) newTransaction(ctx context.Context, script []byte, arguments ...cadence.Value) (*flow.Transaction, error) {
+	return client.NewTransaction(ctx, c.access, c.account, script, arguments...)
+}
+
+func (c *Client) executeScript(ctx context.Context, script []byte, arguments ...cadence.Value) (cadence.Value, error) {
+	return client.ExecuteScript(ctx, c.access, script, arguments...)
+}
+
+// SubmitVote returns a transaction that submits a signed vote for the cluster of the voter.
+func (c *Client) SubmitVote(ctx context.Context, vote Vote) (*flow.Transaction, error) {
+	if vote.Signature == "" || vote.Message == "" {
+		return nil, errors.New("vote signature and message must not be empty")
+	}
+
+	signature, err := cadence.NewString(vote.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := cadence.NewString(vote.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.newTransaction(ctx, templates.GenerateSubmitVoteScript(c.env), signature, message)
+}
+
+// Vote returns a transaction that submits the vote of the given node for the message,
+// signed with its staking key. The cluster is read first to check that the node votes in it,
+// because a vote from a node outside of the cluster aborts the transaction.
+func (c *Client) Vote(
+	ctx context.Context,
+	stakingKey crypto.PrivateKey,
+	nodeID string,
+	clusterIndex uint16,
+	message []byte,
+) (*flow.Transaction, error) {
+	cluster, err := c.GetCluster(ctx, clusterIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := cluster.NodeWeights[nodeID]; !ok {
+		return nil, fmt.Errorf("node %s is not in cluster %d", nodeID, clusterIndex)
+	}
+
+	vote, err := SignVote(stakingKey, message)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.SubmitVote(ctx, vote)
+}
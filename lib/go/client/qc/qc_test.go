@@ -0,0 +1,318 @@
+package qc
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/crypto"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/client"
+	"github.com/onflow/flow-core-contracts/lib/go/client/internal/clienttest"
+	"github.com/onflow/flow-core-contracts/lib/go/events"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+const nodeID = "0000000000000000000000000000000000000000000000000000000000000001"
+
+var message = []byte{0xde, 0xad, 0xbe, 0xef}
+
+func newTestClient(results ...cadence.Value) (*Client, *clienttest.Access) {
+	address := flow.HexToAddress("02")
+
+	access := clienttest.NewAccess(
+		&flow.Account{
+			Address: address,
+			Keys:    []*flow.AccountKey{{Index: 0, SequenceNumber: 5}},
+		},
+		results...,
+	)
+
+	return New(templates.MainnetEnvironment(), access, client.Account{Address: address}), access
+}
+
+func stakingKey(t *testing.T, seed byte) crypto.PrivateKey {
+	s := make([]byte, crypto.KeyGenSeedMinLen)
+	for i := range s {
+		s[i] = seed
+	}
+
+	key, err := crypto.GeneratePrivateKey(crypto.BLSBLS12381, s)
+	require.NoError(t, err)
+	return key
+}
+
+func qcLocation(t *testing.T) common.Location {
+	address, err := common.HexToAddress(templates.MainnetEnvironment().QuorumCertificateAddress)
+	require.NoError(t, err)
+	return common.NewAddressLocation(nil, address, "FlowClusterQC")
+}
+
+func clusterValue(t *testing.T, weights map[string]uint64) cadence.Value {
+	clusterType := cadence.NewStructType(
+		qcLocation(t),
+		"FlowClusterQC.Cluster",
+		[]cadence.Field{
+			{Identifier: "index", Type: cadence.UInt16Type},
+			{Identifier: "nodeWeights", Type: cadence.NewDictionaryType(cadence.StringType, cadence.UInt64Type)},
+			{Identifier: "totalWeight", Type: cadence.UInt64Type},
+		},
+		nil,
+	)
+
+	var total uint64
+	pairs := make([]cadence.KeyValuePair, 0, len(weights))
+	for id, weight := range weights {
+		pairs = append(pairs, cadence.KeyValuePair{Key: cadence.String(id), Value: cadence.NewUInt64(weight)})
+		total += weight
+	}
+
+	return cadence.NewStruct([]cadence.Value{
+		cadence.NewUInt16(1),
+		cadence.NewDictionary(pairs),
+		cadence.NewUInt64(total),
+	}).WithType(clusterType)
+}
+
+func TestVote(t *testing.T) {
+	key := stakingKey(t, 1)
+
+	vote, err := SignVote(key, message)
+	require.NoError(t, err)
+	assert.Equal(t, "deadbeef", vote.Message)
+
+	// the signature is encoded like the vote signatures in the QC tests
+	signature, err := key.Sign(message, crypto.NewExpandMsgXOFKMAC128(CollectorVoteTag))
+	require.NoError(t, err)
+	assert.Equal(t, signature.String()[2:], vote.Signature)
+
+	valid, err := VerifyVote(key.PublicKey(), vote)
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = VerifyVote(stakingKey(t, 2).PublicKey(), vote)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	vote.Message = "beefdead"
+	valid, err = VerifyVote(key.PublicKey(), vote)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	_, err = SignVote(key, nil)
+	assert.Error(t, err)
+
+	seed := make([]byte, crypto.KeyGenSeedMinLen)
+	networkKey, err := crypto.GeneratePrivateKey(crypto.ECDSAP256, seed)
+	require.NoError(t, err)
+	_, err = SignVote(networkKey, message)
+	assert.ErrorContains(t, err, "staking key")
+}
+
+func TestTransactions(t *testing.T) {
+	ctx := context.Background()
+	env := templates.MainnetEnvironment()
+	key := stakingKey(t, 1)
+
+	t.Run("submit vote", func(t *testing.T) {
+		c, _ := newTestClient()
+
+		tx, err := c.SubmitVote(ctx, Vote{Signature: "aa", Message: "bb"})
+		require.NoError(t, err)
+
+		assert.Equal(t, templates.GenerateSubmitVoteScript(env), tx.Script)
+		assert.Equal(t, []cadence.Value{cadence.String("aa"), cadence.String("bb")}, clienttest.DecodeArguments(t, tx))
+		assert.Equal(t, flow.HexToAddress("02"), tx.Payer)
+		assert.Equal(t, uint64(5), tx.ProposalKey.SequenceNumber)
+
+		_, err = c.SubmitVote(ctx, Vote{Signature: "aa"})
+		assert.Error(t, err)
+	})
+
+	t.Run("vote", func(t *testing.T) {
+		c, access := newTestClient(clusterValue(t, map[string]uint64{nodeID: 100}))
+
+		tx, err := c.Vote(ctx, key, nodeID, 1, message)
+		require.NoError(t, err)
+
+		assert.Equal(t, templates.GenerateGetClusterScript(env), access.Script)
+		assert.Equal(t, [][]cadence.Value{{cadence.NewUInt16(1)}}, access.Arguments)
+
+		arguments := clienttest.DecodeArguments(t, tx)
+		require.Len(t, arguments, 2)
+		assert.Equal(t, cadence.String("deadbeef"), arguments[1])
+
+		valid, err := VerifyVote(key.PublicKey(), Vote{
+			Signature: string(arguments[0].(cadence.String)),
+			Message:   string(arguments[1].(cadence.String)),
+		})
+		require.NoError(t, err)
+		assert.True(t, valid)
+	})
+
+	t.Run("node not in cluster", func(t *testing.T) {
+		c, _ := newTestClient(clusterValue(t, map[string]uint64{"other": 100}))
+
+		_, err := c.Vote(ctx, key, nodeID, 1, message)
+		assert.ErrorContains(t, err, "not in cluster 1")
+	})
+}
+
+func TestAggregateVoteData(t *testing.T) {
+	keys := map[string]crypto.PublicKey{}
+	qc := events.ClusterQC{Index: 0, VoteMessage: hex.EncodeToString(message)}
+
+	for i, id := range []string{"n1", "n2", "n3"} {
+		key := stakingKey(t, byte(i+1))
+		keys[id] = key.PublicKey()
+
+		vote, err := SignVote(key, message)
+		require.NoError(t, err)
+
+		qc.VoteSignatures = append(qc.VoteSignatures, vote.Signature)
+		qc.VoterIDs = append(qc.VoterIDs, id)
+	}
+
+	voteData, err := AggregateVoteData(qc)
+	require.NoError(t, err)
+	assert.Equal(t, qc.VoterIDs, voteData.VoterIDs)
+
+	valid, err := VerifyVoteData(voteData, message, keys)
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = VerifyVoteData(voteData, []byte{0xbe, 0xef}, keys)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	// a missing vote invalidates the aggregated signature
+	voteData.VoterIDs = voteData.VoterIDs[:2]
+	valid, err = VerifyVoteData(voteData, message, keys)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	_, err = VerifyVoteData(events.ClusterQCVoteData{AggregatedSignature: "aa", VoterIDs: []string{"n4"}}, message, keys)
+	assert.ErrorContains(t, err, "n4")
+
+	_, err = AggregateVoteData(events.ClusterQC{})
+	assert.Error(t, err)
+
+	_, err = AggregateVoteData(events.ClusterQC{VoteSignatures: []string{"aa"}})
+	assert.Error(t, err)
+
+	_, err = AggregateVoteData(events.ClusterQC{VoteSignatures: []string{"zz"}, VoterIDs: []string{"n1"}})
+	assert.ErrorContains(t, err, "hex")
+}
+
+func TestScripts(t *testing.T) {
+	ctx := context.Background()
+	env := templates.MainnetEnvironment()
+
+	t.Run("cluster", func(t *testing.T) {
+		c, access := newTestClient(clusterValue(t, map[string]uint64{nodeID: 100}))
+
+		cluster, err := c.GetCluster(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, events.Cluster{Index: 1, NodeWeights: map[string]uint64{nodeID: 100}, TotalWeight: 100}, cluster)
+		assert.Equal(t, templates.GenerateGetClusterScript(env), access.Script)
+	})
+
+	t.Run("has voted", func(t *testing.T) {
+		c, access := newTestClient(cadence.NewBool(true))
+
+		voted, err := c.HasVoted(ctx, nodeID)
+		require.NoError(t, err)
+		assert.True(t, voted)
+		assert.Equal(t, [][]cadence.Value{{cadence.String(nodeID)}}, access.Arguments)
+	})
+
+	t.Run("wait for cluster complete", func(t *testing.T) {
+		c, access := newTestClient(cadence.NewBool(false), cadence.NewBool(false), cadence.NewBool(true))
+
+		err := c.WaitForClusterComplete(ctx, 2, time.Millisecond)
+		require.NoError(t, err)
+
+		assert.Equal(t, templates.GenerateGetClusterCompleteScript(env), access.Script)
+		assert.Len(t, access.Arguments, 3)
+		assert.Equal(t, []cadence.Value{cadence.NewUInt16(2)}, access.Arguments[2])
+	})
+
+	t.Run("wait for voting completed", func(t *testing.T) {
+		c, access := newTestClient(cadence.NewBool(false), cadence.NewBool(true))
+
+		err := c.WaitForVotingCompleted(ctx, time.Millisecond)
+		require.NoError(t, err)
+		assert.Equal(t, templates.GenerateGetVotingCompletedScript(env), access.Script)
+		assert.Len(t, access.Arguments, 2)
+
+		c, _ = newTestClient(cadence.NewBool(false))
+
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		err = c.WaitForVotingCompleted(canceled, time.Hour)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("wait with invalid interval", func(t *testing.T) {
+		c, access := newTestClient()
+
+		err := c.WaitForClusterComplete(ctx, 2, 0)
+		assert.ErrorContains(t, err, "invalid polling interval")
+
+		err = c.WaitForVotingCompleted(ctx, -time.Second)
+		assert.ErrorContains(t, err, "invalid polling interval")
+
+		assert.Empty(t, access.Arguments)
+	})
+
+	t.Run("vote data", func(t *testing.T) {
+		qcType := cadence.NewStructType(
+			qcLocation(t),
+			"FlowClusterQC.ClusterQC",
+			[]cadence.Field{
+				{Identifier: "index", Type: cadence.UInt16Type},
+				{Identifier: "voteSignatures", Type: cadence.NewVariableSizedArrayType(cadence.StringType)},
+				{Identifier: "voteMessage", Type: cadence.StringType},
+				{Identifier: "voterIDs", Type: cadence.NewVariableSizedArrayType(cadence.StringType)},
+			},
+			nil,
+		)
+
+		key := stakingKey(t, 1)
+		vote, err := SignVote(key, message)
+		require.NoError(t, err)
+
+		qcValue := func(index uint16) cadence.Value {
+			return cadence.NewStruct([]cadence.Value{
+				cadence.NewUInt16(index),
+				cadence.NewArray([]cadence.Value{cadence.String(vote.Signature)}),
+				cadence.String(vote.Message),
+				cadence.NewArray([]cadence.Value{cadence.String(nodeID)}),
+			}).WithType(qcType)
+		}
+
+		c, access := newTestClient(qcValue(0), qcValue(1))
+
+		voteData, err := c.GetVoteData(ctx, 2)
+		require.NoError(t, err)
+		require.Len(t, voteData, 2)
+
+		// the aggregate of a single signature is the signature
+		assert.Equal(t, events.ClusterQCVoteData{AggregatedSignature: vote.Signature, VoterIDs: []string{nodeID}}, voteData[1])
+		assert.Equal(t, templates.GenerateGenerateQuorumCertificateScript(env), access.Script)
+		assert.Equal(t, [][]cadence.Value{{cadence.NewUInt16(0)}, {cadence.NewUInt16(1)}}, access.Arguments)
+	})
+
+	t.Run("unexpected result", func(t *testing.T) {
+		c, _ := newTestClient(cadence.String("true"))
+
+		_, err := c.IsVotingCompleted(ctx)
+		require.ErrorContains(t, err, "expected a Cadence Bool")
+	})
+}
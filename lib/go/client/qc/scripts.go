@@ -0,0 +1,152 @@
+package qc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-core-contracts/lib/go/client"
+	"github.com/onflow/flow-core-contracts/lib/go/events"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+// GetCluster returns the collector cluster with the given index.
+func (c *Client) GetCluster(ctx context.Context, clusterIndex uint16) (events.Cluster, error) {
+	var cluster events.Cluster
+
+	value, err := c.executeScript(ctx, templates.GenerateGetClusterScript(c.env), cadence.NewUInt16(clusterIndex))
+	if err != nil {
+		return cluster, err
+	}
+
+	err = events.DecodeValue(value, &cluster)
+	if err != nil {
+		return cluster, fmt.Errorf("cannot decode cluster %d: %w", clusterIndex, err)
+	}
+
+	return cluster, nil
+}
+
+// IsEnabled returns whether the QC voting is in progress.
+func (c *Client) IsEnabled(ctx context.Context) (bool, error) {
+	value, err := c.executeScript(ctx, templates.GenerateGetQCEnabledScript(c.env))
+	if err != nil {
+		return false, err
+	}
+
+	return client.DecodeBool(value)
+}
+
+// HasVoted returns whether the given node has submitted its vote for the current epoch.
+func (c *Client) HasVoted(ctx context.Context, nodeID string) (bool, error) {
+	id, err := cadence.NewString(nodeID)
+	if err != nil {
+		return false, err
+	}
+
+	value, err := c.executeScript(ctx, templates.GenerateGetNodeHasVotedScript(c.env), id)
+	if err != nil {
+		return false, err
+	}
+
+	return client.DecodeBool(value)
+}
+
+// IsClusterComplete returns whether the votes for one message in the given cluster reached the vote threshold.
+func (c *Client) IsClusterComplete(ctx context.Context, clusterIndex uint16) (bool, error) {
+	value, err := c.executeScript(ctx, templates.GenerateGetClusterCompleteScript(c.env), cadence.NewUInt16(clusterIndex))
+	if err != nil {
+		return false, err
+	}
+
+	return client.DecodeBool(value)
+}
+
+// IsVotingCompleted returns whether all the clusters are complete.
+func (c *Client) IsVotingCompleted(ctx context.Context) (bool, error) {
+	value, err := c.executeScript(ctx, templates.GenerateGetVotingCompletedScript(c.env))
+	if err != nil {
+		return false, err
+	}
+
+	return client.DecodeBool(value)
+}
+
+// WaitForClusterComplete checks whether the given cluster is complete every interval,
+// until it is or the context is done. The interval must be positive.
+func (c *Client) WaitForClusterComplete(ctx context.Context, clusterIndex uint16, interval time.Duration) error {
+	return poll(ctx, interval, func() (bool, error) {
+		return c.IsClusterComplete(ctx, clusterIndex)
+	})
+}
+
+// WaitForVotingCompleted checks whether all the clusters are complete every interval,
+// until they are or the context is done. The interval must be positive.
+func (c *Client) WaitForVotingCompleted(ctx context.Context, interval time.Duration) error {
+	return poll(ctx, interval, func() (bool, error) {
+		return c.IsVotingCompleted(ctx)
+	})
+}
+
+// GenerateQuorumCertificate returns the quorum certificate of the given cluster,
+// the votes for the message that reached the vote threshold.
+// The script fails if the cluster is not complete.
+func (c *Client) GenerateQuorumCertificate(ctx context.Context, clusterIndex uint16) (events.ClusterQC, error) {
+	var qc events.ClusterQC
+
+	value, err := c.executeScript(ctx, templates.GenerateGenerateQuorumCertificateScript(c.env), cadence.NewUInt16(clusterIndex))
+	if err != nil {
+		return qc, err
+	}
+
+	err = events.DecodeValue(value, &qc)
+	if err != nil {
+		return qc, fmt.Errorf("cannot decode quorum certificate of cluster %d: %w", clusterIndex, err)
+	}
+
+	return qc, nil
+}
+
+// GetVoteData returns the aggregated vote data of the quorum certificates of the first clusterCount clusters,
+// in the order of the cluster indexes, as the recover epoch transaction takes it.
+func (c *Client) GetVoteData(ctx context.Context, clusterCount int) ([]events.ClusterQCVoteData, error) {
+	voteData := make([]events.ClusterQCVoteData, clusterCount)
+
+	for i := range voteData {
+		qc, err := c.GenerateQuorumCertificate(ctx, uint16(i))
+		if err != nil {
+			return nil, err
+		}
+
+		voteData[i], err = AggregateVoteData(qc)
+		if err != nil {
+			return nil, fmt.Errorf("cannot aggregate the votes of cluster %d: %w", i, err)
+		}
+	}
+
+	return voteData, nil
+}
+
+func poll(ctx context.Context, interval time.Duration, done func() (bool, error)) error {
+	if interval <= 0 {
+		return fmt.Errorf("invalid polling interval %s: must be positive", interval)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		ok, err := done()
+		if err != nil || ok {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
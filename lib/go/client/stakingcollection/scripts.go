@@ -0,0 +1,253 @@
+package stakingcollection
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/onflow/flow-core-contracts/lib/go/client"
+	"github.com/onflow/flow-core-contracts/lib/go/events"
+	"github.com/onflow/flow-core-contracts/lib/go/staking"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+// DelegatorID is the FlowStakingCollection.DelegatorIDs struct,
+// which identifies a delegator in a staking collection.
+type DelegatorID struct {
+	NodeID      string `cadence:"delegatorNodeID"`
+	DelegatorID uint32 `cadence:"delegatorID"`
+}
+
+// MachineAccount is the FlowStakingCollection.MachineAccountInfo struct
+// of a collection or consensus node in a staking collection.
+type MachineAccount struct {
+	NodeID string `cadence:"nodeID"`
+	Role   uint8  `cadence:"role"`
+
+	// Address is the address of the machine account,
+	// taken from the capability to its FLOW vault
+	Address flow.Address
+}
+
+// HasStakingCollection returns whether the given account has a staking collection.
+func (c *Client) HasStakingCollection(ctx context.Context, address flow.Address) (bool, error) {
+	value, err := c.executeScript(ctx, templates.GenerateCollectionDoesAccountHaveStakingCollection(c.env), cadence.NewAddress(address))
+	if err != nil {
+		return false, err
+	}
+
+	return client.DecodeBool(value)
+}
+
+// GetNodeIDs returns the IDs of the nodes in the staking collection of the given account.
+func (c *Client) GetNodeIDs(ctx context.Context, address flow.Address) ([]string, error) {
+	value, err := c.executeScript(ctx, templates.GenerateCollectionGetNodeIDsScript(c.env), cadence.NewAddress(address))
+	if err != nil {
+		return nil, err
+	}
+
+	array, ok := value.(cadence.Array)
+	if !ok {
+		return nil, fmt.Errorf("expected a Cadence array of node IDs, got %T", value)
+	}
+
+	ids := make([]string, len(array.Values))
+	for i, element := range array.Values {
+		id, ok := element.(cadence.String)
+		if !ok {
+			return nil, fmt.Errorf("cannot decode node ID %d of type %T", i, element)
+		}
+		ids[i] = string(id)
+	}
+
+	return ids, nil
+}
+
+// GetDelegatorIDs returns the IDs of the delegators in the staking collection of the given account.
+func (c *Client) GetDelegatorIDs(ctx context.Context, address flow.Address) ([]DelegatorID, error) {
+	value, err := c.executeScript(ctx, templates.GenerateCollectionGetDelegatorIDsScript(c.env), cadence.NewAddress(address))
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeArray[DelegatorID](value, "delegator ID")
+}
+
+// GetAllNodeInfo returns the staking information of the nodes
+// in the staking collection of the given account.
+func (c *Client) GetAllNodeInfo(ctx context.Context, address flow.Address) ([]staking.NodeInfo, error) {
+	value, err := c.executeScript(ctx, templates.GenerateCollectionGetAllNodeInfoScript(c.env), cadence.NewAddress(address))
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeArray[staking.NodeInfo](value, "node info")
+}
+
+// GetAllDelegatorInfo returns the staking information of the delegators
+// in the staking collection of the given account.
+func (c *Client) GetAllDelegatorInfo(ctx context.Context, address flow.Address) ([]staking.DelegatorInfo, error) {
+	value, err := c.executeScript(ctx, templates.GenerateCollectionGetAllDelegatorInfoScript(c.env), cadence.NewAddress(address))
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeArray[staking.DelegatorInfo](value, "delegator info")
+}
+
+// DoesStakeExist returns whether the given node, or the given delegator of the node
+// if delegatorID is not nil, is in the staking collection of the given account.
+func (c *Client) DoesStakeExist(ctx context.Context, address flow.Address, nodeID string, delegatorID *uint32) (bool, error) {
+	id, err := cadence.NewString(nodeID)
+	if err != nil {
+		return false, err
+	}
+
+	value, err := c.executeScript(
+		ctx,
+		templates.GenerateCollectionGetDoesStakeExistScript(c.env),
+		cadence.NewAddress(address),
+		id,
+		client.OptionalUInt32(delegatorID),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return client.DecodeBool(value)
+}
+
+// GetLockedTokensUsed returns the amount of locked tokens
+// the staking collection of the given account is using for staking.
+func (c *Client) GetLockedTokensUsed(ctx context.Context, address flow.Address) (cadence.UFix64, error) {
+	value, err := c.executeScript(ctx, templates.GenerateCollectionGetLockedTokensUsedScript(c.env), cadence.NewAddress(address))
+	if err != nil {
+		return 0, err
+	}
+
+	return decodeUFix64(value)
+}
+
+// GetUnlockedTokensUsed returns the amount of unlocked tokens
+// the staking collection of the given account is using for staking.
+func (c *Client) GetUnlockedTokensUsed(ctx context.Context, address flow.Address) (cadence.UFix64, error) {
+	value, err := c.executeScript(ctx, templates.GenerateCollectionGetUnlockedTokensUsedScript(c.env), cadence.NewAddress(address))
+	if err != nil {
+		return 0, err
+	}
+
+	return decodeUFix64(value)
+}
+
+// GetMachineAccounts returns the machine accounts of the nodes
+// in the staking collection of the given account, by node ID.
+func (c *Client) GetMachineAccounts(ctx context.Context, address flow.Address) (map[string]MachineAccount, error) {
+	value, err := c.executeScript(ctx, templates.GenerateCollectionGetMachineAccountsScript(c.env), cadence.NewAddress(address))
+	if err != nil {
+		return nil, err
+	}
+
+	dictionary, ok := value.(cadence.Dictionary)
+	if !ok {
+		return nil, fmt.Errorf("expected a Cadence dictionary of machine accounts, got %T", value)
+	}
+
+	accounts := make(map[string]MachineAccount, len(dictionary.Pairs))
+	for _, pair := range dictionary.Pairs {
+		nodeID, ok := pair.Key.(cadence.String)
+		if !ok {
+			return nil, fmt.Errorf("cannot decode machine account node ID of type %T", pair.Key)
+		}
+
+		account, err := decodeMachineAccount(pair.Value)
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode machine account of node %s: %w", nodeID, err)
+		}
+
+		accounts[string(nodeID)] = account
+	}
+
+	return accounts, nil
+}
+
+// GetMachineAccountAddress returns the address of the machine account of the given node
+// in the staking collection of the given account, or nil if the node has no machine account.
+func (c *Client) GetMachineAccountAddress(ctx context.Context, address flow.Address, nodeID string) (*flow.Address, error) {
+	id, err := cadence.NewString(nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := c.executeScript(
+		ctx,
+		templates.GenerateCollectionGetMachineAccountAddressScript(c.env),
+		cadence.NewAddress(address),
+		id,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	optional, ok := value.(cadence.Optional)
+	if !ok {
+		return nil, fmt.Errorf("expected a Cadence optional address, got %T", value)
+	}
+
+	if optional.Value == nil {
+		return nil, nil
+	}
+
+	machineAccountAddress, ok := optional.Value.(cadence.Address)
+	if !ok {
+		return nil, fmt.Errorf("expected a Cadence address, got %T", optional.Value)
+	}
+
+	result := flow.Address(machineAccountAddress)
+	return &result, nil
+}
+
+func decodeMachineAccount(value cadence.Value) (MachineAccount, error) {
+	var account MachineAccount
+
+	err := events.DecodeValue(value, &account)
+	if err != nil {
+		return account, err
+	}
+
+	// DecodeValue succeeded, so the value is a composite
+	capability, ok := value.(cadence.Composite).FieldsMappedByName()["machineAccountVaultProvider"].(cadence.Capability)
+	if !ok {
+		return account, fmt.Errorf("missing machine account vault capability")
+	}
+
+	account.Address = flow.Address(capability.Address)
+	return account, nil
+}
+
+// decodeArray decodes a Cadence array of structs.
+func decodeArray[T any](value cadence.Value, name string) ([]T, error) {
+	array, ok := value.(cadence.Array)
+	if !ok {
+		return nil, fmt.Errorf("expected a Cadence array of %s, got %T", name, value)
+	}
+
+	result := make([]T, len(array.Values))
+	for i, element := range array.Values {
+		err := events.DecodeValue(element, &result[i])
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode %s %d: %w", name, i, err)
+		}
+	}
+
+	return result, nil
+}
+
+func decodeUFix64(value cadence.Value) (cadence.UFix64, error) {
+	amount, ok := value.(cadence.UFix64)
+	if !ok {
+		return 0, fmt.Errorf("expected a Cadence UFix64, got %T", value)
+	}
+	return amount, nil
+}
@@ -0,0 +1,296 @@
+// Package stakingcollection is a typed client for the FlowStakingCollection contract.
+//
+// The transaction methods return transactions with correctly encoded arguments
+// that are ready to be signed by the client's account, and the read methods
+// execute the staking collection scripts and decode their results into Go structs.
+//
+//	c := stakingcollection.New(templates.MainnetEnvironment(), accessClient, client.Account{Address: address})
+//	tx, err := c.StakeNewTokens(ctx, nodeID, nil, amount)
+package stakingcollection
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+
+	"github.com/onflow/flow-core-contracts/lib/go/client"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+// Client builds staking collection transactions for an account
+// and reads staking collections through the access API.
+type Client struct {
+	env     templates.Environment
+	access  client.Access
+	account client.Account
+}
+
+// New returns a client for the staking collection of the given account,
+// using the contract addresses of the given environment.
+func New(env templates.Environment, access client.Access, account client.Account) *Client {
+	return &Client{
+		env:     env,
+		access:  access,
+		account: account,
+	}
+}
+
+// NodeRegistration is the information needed to register a node in a staking collection.
+type NodeRegistration struct {
+	// ID is the node ID, a 32 byte hex string
+	ID string
+	// Role is the node role: 1 collection, 2 consensus, 3 execution, 4 verification, 5 access
+	Role uint8
+	// NetworkingAddress is the address the node is reachable at, e.g. flow-node.test:3569
+	NetworkingAddress string
+	// NetworkingKey is the hex-encoded ECDSA P-256 networking public key
+	NetworkingKey string
+	// StakingKey is the hex-encoded BLS staking public key
+	StakingKey string
+	// StakingKeyPoP is the hex-encoded proof of possession of the staking key
+	StakingKeyPoP string
+	// Amount is the amount of FLOW to stake
+	Amount cadence.UFix64
+	// MachineAccountKey is the key added to the machine account
+	// that is created for collection and consensus nodes
+	MachineAccountKey MachineAccountKey
+}
+
+// MachineAccountKey is a public key for the machine account of a node.
+type MachineAccountKey struct {
+	// PublicKey is the hex-encoded public key
+	PublicKey string
+	// SigAlgo is either crypto.ECDSA_P256 or crypto.ECDSA_secp256k1
+	SigAlgo crypto.SignatureAlgorithm
+	// HashAlgo is either crypto.SHA2_256 or crypto.SHA3_256
+	HashAlgo crypto.HashAlgorithm
+}
+
+// arguments returns the key, signature and hash algorithm arguments of the transactions,
+// with the algorithms as the raw values of the Cadence SignatureAlgorithm and HashAlgorithm enums.
+func (k MachineAccountKey) arguments() ([]cadence.Value, error) {
+	var sigAlgo, hashAlgo uint8
+
+	switch k.SigAlgo {
+	case crypto.ECDSA_P256:
+		sigAlgo = 1
+	case crypto.ECDSA_secp256k1:
+		sigAlgo = 2
+	default:
+		return nil, fmt.Errorf("unsupported machine account signature algorithm %s", k.SigAlgo)
+	}
+
+	switch k.HashAlgo {
+	case crypto.SHA2_256:
+		hashAlgo = 1
+	case crypto.SHA3_256:
+		hashAlgo = 3
+	default:
+		return nil, fmt.Errorf("unsupported machine account hash algorithm %s", k.HashAlgo)
+	}
+
+	publicKey, err := cadence.NewString(k.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return []cadence.Value{
+		publicKey,
+		cadence.NewUInt8(sigAlgo),
+		cadence.NewUInt8(hashAlgo),
+	}, nil
+}
+
+func (c *Client) newTransaction(ctx context.Context, script []byte, arguments ...cadence.Value) (*flow.Transaction, error) {
+	return client.NewTransaction(ctx, c.access, c.account, script, arguments...)
+}
+
+func (c *Client) executeScript(ctx context.Context, script []byte, arguments ...cadence.Value) (cadence.Value, error) {
+	return client.ExecuteScript(ctx, c.access, script, arguments...)
+}
+
+// Setup returns a transaction that sets up a staking collection for the account,
+// moving its locked tokens and existing stakes into it if it is a locked tokens account.
+func (c *Client) Setup(ctx context.Context) (*flow.Transaction, error) {
+	return c.newTransaction(ctx, templates.GenerateCollectionSetup(c.env))
+}
+
+// RegisterNode returns a transaction that registers a new node in the staking collection.
+// For collection and consensus nodes, it also creates the machine account of the node.
+func (c *Client) RegisterNode(ctx context.Context, registration NodeRegistration) (*flow.Transaction, error) {
+	strings := make([]cadence.Value, 0, 5)
+	for _, s := range []string{
+		registration.ID,
+		registration.NetworkingAddress,
+		registration.NetworkingKey,
+		registration.StakingKey,
+		registration.StakingKeyPoP,
+	} {
+		value, err := cadence.NewString(s)
+		if err != nil {
+			return nil, err
+		}
+		strings = append(strings, value)
+	}
+
+	keyArguments, err := registration.MachineAccountKey.arguments()
+	if err != nil {
+		return nil, err
+	}
+
+	arguments := []cadence.Value{
+		strings[0],
+		cadence.NewUInt8(registration.Role),
+		strings[1],
+		strings[2],
+		strings[3],
+		strings[4],
+		registration.Amount,
+	}
+
+	return c.newTransaction(
+		ctx,
+		templates.GenerateCollectionRegisterNode(c.env),
+		append(arguments, keyArguments...)...,
+	)
+}
+
+// RegisterDelegator returns a transaction that registers a new delegator
+// to the given node in the staking collection and stakes the given amount.
+func (c *Client) RegisterDelegator(ctx context.Context, nodeID string, amount cadence.UFix64) (*flow.Transaction, error) {
+	return c.newNodeTransaction(ctx, templates.GenerateCollectionRegisterDelegator(c.env), nodeID, amount)
+}
+
+// CreateMachineAccount returns a transaction that creates the machine account
+// of a collection or consensus node that is already in the staking collection.
+func (c *Client) CreateMachineAccount(ctx context.Context, nodeID string, key MachineAccountKey) (*flow.Transaction, error) {
+	keyArguments, err := key.arguments()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.newNodeTransaction(ctx, templates.GenerateCollectionCreateMachineAccountForNodeScript(c.env), nodeID, keyArguments...)
+}
+
+// StakeNewTokens returns a transaction that stakes new tokens for the given node,
+// or for the given delegator of the node if delegatorID is not nil.
+func (c *Client) StakeNewTokens(ctx context.Context, nodeID string, delegatorID *uint32, amount cadence.UFix64) (*flow.Transaction, error) {
+	return c.newStakeTransaction(ctx, templates.GenerateCollectionStakeNewTokens(c.env), nodeID, delegatorID, amount)
+}
+
+// StakeRewardedTokens returns a transaction that stakes rewarded tokens
+// of the given node or delegator.
+func (c *Client) StakeRewardedTokens(ctx context.Context, nodeID string, delegatorID *uint32, amount cadence.UFix64) (*flow.Transaction, error) {
+	return c.newStakeTransaction(ctx, templates.GenerateCollectionStakeRewardedTokens(c.env), nodeID, delegatorID, amount)
+}
+
+// StakeUnstakedTokens returns a transaction that stakes unstaked tokens
+// of the given node or delegator.
+func (c *Client) StakeUnstakedTokens(ctx context.Context, nodeID string, delegatorID *uint32, amount cadence.UFix64) (*flow.Transaction, error) {
+	return c.newStakeTransaction(ctx, templates.GenerateCollectionStakeUnstakedTokens(c.env), nodeID, delegatorID, amount)
+}
+
+// RequestUnstaking returns a transaction that requests to unstake tokens
+// of the given node or delegator at the end of the epoch.
+func (c *Client) RequestUnstaking(ctx context.Context, nodeID string, delegatorID *uint32, amount cadence.UFix64) (*flow.Transaction, error) {
+	return c.newStakeTransaction(ctx, templates.GenerateCollectionRequestUnstaking(c.env), nodeID, delegatorID, amount)
+}
+
+// UnstakeAll returns a transaction that requests to unstake all tokens of the given node.
+func (c *Client) UnstakeAll(ctx context.Context, nodeID string) (*flow.Transaction, error) {
+	return c.newNodeTransaction(ctx, templates.GenerateCollectionUnstakeAll(c.env), nodeID)
+}
+
+// WithdrawRewardedTokens returns a transaction that withdraws rewarded tokens
+// of the given node or delegator to the account's vault.
+func (c *Client) WithdrawRewardedTokens(ctx context.Context, nodeID string, delegatorID *uint32, amount cadence.UFix64) (*flow.Transaction, error) {
+	return c.newStakeTransaction(ctx, templates.GenerateCollectionWithdrawRewardedTokens(c.env), nodeID, delegatorID, amount)
+}
+
+// WithdrawUnstakedTokens returns a transaction that withdraws unstaked tokens
+// of the given node or delegator to the account's vault.
+func (c *Client) WithdrawUnstakedTokens(ctx context.Context, nodeID string, delegatorID *uint32, amount cadence.UFix64) (*flow.Transaction, error) {
+	return c.newStakeTransaction(ctx, templates.GenerateCollectionWithdrawUnstakedTokens(c.env), nodeID, delegatorID, amount)
+}
+
+// RestakeAllStakers returns a transaction that stakes the rewarded tokens
+// of all nodes and delegators in the staking collection.
+func (c *Client) RestakeAllStakers(ctx context.Context) (*flow.Transaction, error) {
+	return c.newTransaction(ctx, templates.GenerateCollectionRestakeAllStakersTokens(c.env))
+}
+
+// CloseStake returns a transaction that removes the given node or delegator
+// from the staking collection. All of its tokens must be withdrawn.
+func (c *Client) CloseStake(ctx context.Context, nodeID string, delegatorID *uint32) (*flow.Transaction, error) {
+	id, err := cadence.NewString(nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.newTransaction(
+		ctx,
+		templates.GenerateCollectionCloseStake(c.env),
+		id,
+		client.OptionalUInt32(delegatorID),
+	)
+}
+
+// TransferNode returns a transaction that transfers the given node
+// to the staking collection of the given account.
+func (c *Client) TransferNode(ctx context.Context, nodeID string, to flow.Address) (*flow.Transaction, error) {
+	return c.newNodeTransaction(ctx, templates.GenerateCollectionTransferNode(c.env), nodeID, cadence.NewAddress(to))
+}
+
+// TransferDelegator returns a transaction that transfers the given delegator
+// to the staking collection of the given account.
+func (c *Client) TransferDelegator(ctx context.Context, nodeID string, delegatorID uint32, to flow.Address) (*flow.Transaction, error) {
+	return c.newNodeTransaction(
+		ctx,
+		templates.GenerateCollectionTransferDelegator(c.env),
+		nodeID,
+		cadence.NewUInt32(delegatorID),
+		cadence.NewAddress(to),
+	)
+}
+
+// WithdrawFromMachineAccount returns a transaction that withdraws tokens
+// from the machine account of the given node to the account's vault.
+func (c *Client) WithdrawFromMachineAccount(ctx context.Context, nodeID string, amount cadence.UFix64) (*flow.Transaction, error) {
+	return c.newNodeTransaction(ctx, templates.GenerateCollectionWithdrawFromMachineAccountScript(c.env), nodeID, amount)
+}
+
+// UpdateNetworkingAddress returns a transaction that updates the networking address of the given node.
+func (c *Client) UpdateNetworkingAddress(ctx context.Context, nodeID string, networkingAddress string) (*flow.Transaction, error) {
+	address, err := cadence.NewString(networkingAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.newNodeTransaction(ctx, templates.GenerateCollectionUpdateNetworkingAddressScript(c.env), nodeID, address)
+}
+
+// newNodeTransaction returns a transaction for the given script,
+// with the node ID as first argument, followed by the given arguments.
+func (c *Client) newNodeTransaction(ctx context.Context, script []byte, nodeID string, arguments ...cadence.Value) (*flow.Transaction, error) {
+	id, err := cadence.NewString(nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.newTransaction(ctx, script, append([]cadence.Value{id}, arguments...)...)
+}
+
+// newStakeTransaction returns a transaction for the given script
+// with the node ID, optional delegator ID and amount arguments.
+func (c *Client) newStakeTransaction(
+	ctx context.Context,
+	script []byte,
+	nodeID string,
+	delegatorID *uint32,
+	amount cadence.UFix64,
+) (*flow.Transaction, error) {
+	return c.newNodeTransaction(ctx, script, nodeID, client.OptionalUInt32(delegatorID), amount)
+}
@@ -0,0 +1,273 @@
+package stakingcollection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/client"
+	"github.com/onflow/flow-core-contracts/lib/go/client/internal/clienttest"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+const nodeID = "0000000000000000000000000000000000000000000000000000000000000001"
+
+func newTestClient(results ...cadence.Value) (*Client, *clienttest.Access) {
+	address := flow.HexToAddress("01")
+
+	access := clienttest.NewAccess(
+		&flow.Account{
+			Address: address,
+			Keys: []*flow.AccountKey{
+				{Index: 0, SequenceNumber: 3, Revoked: true},
+				{Index: 1, SequenceNumber: 7},
+			},
+		},
+		results...,
+	)
+
+	return New(templates.MainnetEnvironment(), access, client.Account{Address: address, KeyIndex: 1}), access
+}
+
+func TestTransactions(t *testing.T) {
+	ctx := context.Background()
+	env := templates.MainnetEnvironment()
+
+	amount, err := cadence.NewUFix64("100.0")
+	require.NoError(t, err)
+
+	t.Run("stake new tokens", func(t *testing.T) {
+		c, _ := newTestClient()
+
+		delegatorID := uint32(2)
+		tx, err := c.StakeNewTokens(ctx, nodeID, &delegatorID, amount)
+		require.NoError(t, err)
+
+		assert.Equal(t, templates.GenerateCollectionStakeNewTokens(env), tx.Script)
+		assert.Equal(t, flow.HexToID("01"), tx.ReferenceBlockID)
+		assert.Equal(t, uint64(client.DefaultComputeLimit), tx.GasLimit)
+		assert.Equal(t, flow.ProposalKey{Address: flow.HexToAddress("01"), KeyIndex: 1, SequenceNumber: 7}, tx.ProposalKey)
+		assert.Equal(t, flow.HexToAddress("01"), tx.Payer)
+		assert.Equal(t, []flow.Address{flow.HexToAddress("01")}, tx.Authorizers)
+
+		assert.Equal(t,
+			[]cadence.Value{
+				cadence.String(nodeID),
+				cadence.NewOptional(cadence.NewUInt32(2)),
+				amount,
+			},
+			clienttest.DecodeArguments(t, tx),
+		)
+	})
+
+	t.Run("node stake", func(t *testing.T) {
+		c, _ := newTestClient()
+
+		tx, err := c.RequestUnstaking(ctx, nodeID, nil, amount)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			[]cadence.Value{
+				cadence.String(nodeID),
+				cadence.NewOptional(nil),
+				amount,
+			},
+			clienttest.DecodeArguments(t, tx),
+		)
+	})
+
+	t.Run("register node", func(t *testing.T) {
+		c, _ := newTestClient()
+
+		tx, err := c.RegisterNode(ctx, NodeRegistration{
+			ID:                nodeID,
+			Role:              1,
+			NetworkingAddress: "flow-node.test:3569",
+			NetworkingKey:     "aa",
+			StakingKey:        "bb",
+			StakingKeyPoP:     "cc",
+			Amount:            amount,
+			MachineAccountKey: MachineAccountKey{
+				PublicKey: "dd",
+				SigAlgo:   crypto.ECDSA_secp256k1,
+				HashAlgo:  crypto.SHA3_256,
+			},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, templates.GenerateCollectionRegisterNode(env), tx.Script)
+		assert.Equal(t,
+			[]cadence.Value{
+				cadence.String(nodeID),
+				cadence.NewUInt8(1),
+				cadence.String("flow-node.test:3569"),
+				cadence.String("aa"),
+				cadence.String("bb"),
+				cadence.String("cc"),
+				amount,
+				cadence.String("dd"),
+				cadence.NewUInt8(2),
+				cadence.NewUInt8(3),
+			},
+			clienttest.DecodeArguments(t, tx),
+		)
+	})
+
+	t.Run("unsupported machine account key", func(t *testing.T) {
+		c, _ := newTestClient()
+
+		_, err := c.CreateMachineAccount(ctx, nodeID, MachineAccountKey{
+			PublicKey: "dd",
+			SigAlgo:   crypto.BLS_BLS12_381,
+			HashAlgo:  crypto.SHA3_256,
+		})
+		require.ErrorContains(t, err, "unsupported machine account signature algorithm")
+	})
+
+	t.Run("transfer delegator", func(t *testing.T) {
+		c, _ := newTestClient()
+
+		tx, err := c.TransferDelegator(ctx, nodeID, 4, flow.HexToAddress("02"))
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			[]cadence.Value{
+				cadence.String(nodeID),
+				cadence.NewUInt32(4),
+				cadence.NewAddress(flow.HexToAddress("02")),
+			},
+			clienttest.DecodeArguments(t, tx),
+		)
+	})
+
+	t.Run("revoked key", func(t *testing.T) {
+		c, _ := newTestClient()
+		c.account.KeyIndex = 0
+
+		_, err := c.UnstakeAll(ctx, nodeID)
+		require.ErrorContains(t, err, "revoked")
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		c, _ := newTestClient()
+		c.account.KeyIndex = 5
+
+		_, err := c.Setup(ctx)
+		require.ErrorContains(t, err, "no key with index 5")
+	})
+}
+
+func TestScripts(t *testing.T) {
+	ctx := context.Background()
+	env := templates.MainnetEnvironment()
+	address := flow.HexToAddress("01")
+
+	location := common.NewAddressLocation(nil, common.MustBytesToAddress(address.Bytes()), "FlowStakingCollection")
+
+	t.Run("delegator IDs", func(t *testing.T) {
+		delegatorIDsType := cadence.NewStructType(
+			location,
+			"FlowStakingCollection.DelegatorIDs",
+			[]cadence.Field{
+				{Identifier: "delegatorNodeID", Type: cadence.StringType},
+				{Identifier: "delegatorID", Type: cadence.UInt32Type},
+			},
+			nil,
+		)
+
+		c, access := newTestClient(cadence.NewArray([]cadence.Value{
+			cadence.NewStruct([]cadence.Value{
+				cadence.String(nodeID),
+				cadence.NewUInt32(3),
+			}).WithType(delegatorIDsType),
+		}))
+
+		ids, err := c.GetDelegatorIDs(ctx, address)
+		require.NoError(t, err)
+
+		assert.Equal(t, []DelegatorID{{NodeID: nodeID, DelegatorID: 3}}, ids)
+		assert.Equal(t, templates.GenerateCollectionGetDelegatorIDsScript(env), access.Script)
+		assert.Equal(t, [][]cadence.Value{{cadence.NewAddress(address)}}, access.Arguments)
+	})
+
+	t.Run("machine accounts", func(t *testing.T) {
+		machineAccountInfoType := cadence.NewStructType(
+			location,
+			"FlowStakingCollection.MachineAccountInfo",
+			[]cadence.Field{
+				{Identifier: "nodeID", Type: cadence.StringType},
+				{Identifier: "role", Type: cadence.UInt8Type},
+				{Identifier: "machineAccountVaultProvider", Type: cadence.NewCapabilityType(nil)},
+			},
+			nil,
+		)
+
+		machineAccountAddress := flow.HexToAddress("03")
+
+		c, _ := newTestClient(cadence.NewDictionary([]cadence.KeyValuePair{
+			{
+				Key: cadence.String(nodeID),
+				Value: cadence.NewStruct([]cadence.Value{
+					cadence.String(nodeID),
+					cadence.NewUInt8(1),
+					cadence.NewCapability(1, cadence.NewAddress(machineAccountAddress), nil),
+				}).WithType(machineAccountInfoType),
+			},
+		}))
+
+		accounts, err := c.GetMachineAccounts(ctx, address)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			map[string]MachineAccount{
+				nodeID: {NodeID: nodeID, Role: 1, Address: machineAccountAddress},
+			},
+			accounts,
+		)
+	})
+
+	t.Run("machine account address", func(t *testing.T) {
+		c, _ := newTestClient(
+			cadence.NewOptional(nil),
+			cadence.NewOptional(cadence.NewAddress(flow.HexToAddress("03"))),
+		)
+
+		machineAccountAddress, err := c.GetMachineAccountAddress(ctx, address, nodeID)
+		require.NoError(t, err)
+		assert.Nil(t, machineAccountAddress)
+
+		machineAccountAddress, err = c.GetMachineAccountAddress(ctx, address, nodeID)
+		require.NoError(t, err)
+		assert.Equal(t, flow.HexToAddress("03"), *machineAccountAddress)
+	})
+
+	t.Run("does stake exist", func(t *testing.T) {
+		c, access := newTestClient(cadence.NewBool(true))
+
+		exists, err := c.DoesStakeExist(ctx, address, nodeID, nil)
+		require.NoError(t, err)
+		assert.True(t, exists)
+
+		assert.Equal(t,
+			[][]cadence.Value{{
+				cadence.NewAddress(address),
+				cadence.String(nodeID),
+				cadence.NewOptional(nil),
+			}},
+			access.Arguments,
+		)
+	})
+
+	t.Run("unexpected result", func(t *testing.T) {
+		c, _ := newTestClient(cadence.String("100.0"))
+
+		_, err := c.GetLockedTokensUsed(ctx, address)
+		require.ErrorContains(t, err, "expected a Cadence UFix64")
+	})
+}
@@ -0,0 +1,152 @@
+package contracts
+
+import (
+	"crypto/sha3"
+	"encoding/hex"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/onflow/cadence/parser"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+const modulePath = "github.com/onflow/flow-core-contracts/lib/go/contracts"
+
+// CatalogEntry describes a core contract rendered for an Environment.
+type CatalogEntry struct {
+	// Name is the name of the contract, e.g. FlowEpoch
+	Name string
+	// Source is the path of the contract source below contracts/, e.g. epochs/FlowEpoch.cdc
+	Source string
+	// Fields are the names of the Environment fields that are needed
+	// to resolve the imports of the contract
+	Fields []string
+	// InitParameters are the parameters of the contract initializer
+	InitParameters []templates.Parameter
+	// Code is the rendered code of the contract
+	Code []byte
+	// CodeHash is the hex-encoded SHA3-256 hash of the rendered code
+	CodeHash string
+}
+
+// Catalog returns every core contract of the sources, sorted by name,
+// with its code rendered for the given Environment the same way as Get.
+func (s *Sources) Catalog(env templates.Environment) ([]CatalogEntry, error) {
+	names := ContractNames()
+	entries := make([]CatalogEntry, 0, len(names))
+
+	for _, name := range names {
+		entry, err := s.CatalogEntryFor(name, env)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// CatalogEntryFor returns the catalog entry of the core contract with the given name,
+// with its code rendered for the given Environment.
+func (s *Sources) CatalogEntryFor(name string, env templates.Environment) (CatalogEntry, error) {
+	dependencies, err := s.Dependencies(name)
+	if err != nil {
+		return CatalogEntry{}, err
+	}
+
+	code, err := s.Get(name, env)
+	if err != nil {
+		return CatalogEntry{}, err
+	}
+
+	parameters, err := initParameters(code)
+	if err != nil {
+		return CatalogEntry{}, fmt.Errorf("contract %s: %w", name, err)
+	}
+
+	hash := sha3.Sum256(code)
+
+	return CatalogEntry{
+		Name:           name,
+		Source:         contractFilenames[name],
+		Fields:         dependencies.Fields,
+		InitParameters: parameters,
+		Code:           code,
+		CodeHash:       hex.EncodeToString(hash[:]),
+	}, nil
+}
+
+// Catalog returns every core contract embedded in this package, sorted by name,
+// with its code rendered for the given Environment the same way as Get.
+func Catalog(env templates.Environment) ([]CatalogEntry, error) {
+	return embedded.Catalog(env)
+}
+
+// CatalogEntryFor returns the catalog entry of the embedded core contract with the given name,
+// with its code rendered for the given Environment.
+func CatalogEntryFor(name string, env templates.Environment) (CatalogEntry, error) {
+	return embedded.CatalogEntryFor(name, env)
+}
+
+// initParameters returns the parameters of the initializer of the contract declared in the given code.
+func initParameters(code []byte) ([]templates.Parameter, error) {
+	program, err := parser.ParseProgram(nil, code, parser.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse contract: %w", err)
+	}
+
+	contract := program.SoleContractDeclaration()
+	if contract == nil {
+		return nil, fmt.Errorf("no contract declared")
+	}
+
+	initializers := contract.Members.Initializers()
+	if len(initializers) == 0 {
+		return nil, nil
+	}
+
+	list := initializers[0].FunctionDeclaration.ParameterList
+	if list == nil {
+		return nil, nil
+	}
+
+	parameters := make([]templates.Parameter, len(list.Parameters))
+	for i, parameter := range list.Parameters {
+		parameters[i] = templates.Parameter{
+			Label: parameter.Label,
+			Name:  parameter.Identifier.Identifier,
+			Type:  parameter.TypeAnnotation.Type.String(),
+		}
+	}
+
+	return parameters, nil
+}
+
+// Version returns the version of this module the running program was built with, e.g. v1.9.4,
+// as pinned in the go.mod file of the program.
+// It returns "(devel)" if the version is not known, e.g. when this module is the main module.
+func Version() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "(devel)"
+	}
+
+	for _, module := range info.Deps {
+		if module.Path != modulePath {
+			continue
+		}
+
+		if module.Replace != nil && module.Replace.Version != "" {
+			return module.Replace.Version
+		}
+		if module.Replace != nil {
+			return "(devel)"
+		}
+
+		return module.Version
+	}
+
+	return "(devel)"
+}
@@ -0,0 +1,76 @@
+package contracts_test
+
+import (
+	"crypto/sha3"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+
+	"github.com/onflow/flow-core-contracts/lib/go/contracts"
+)
+
+func TestCatalog(t *testing.T) {
+	env := templates.MainnetEnvironment()
+
+	catalog, err := contracts.Catalog(env)
+	require.NoError(t, err)
+	require.Len(t, catalog, len(contracts.ContractNames()))
+
+	entries := make(map[string]contracts.CatalogEntry)
+	for i, entry := range catalog {
+		assert.Equal(t, contracts.ContractNames()[i], entry.Name)
+		assert.NotEmpty(t, entry.Source, entry.Name)
+
+		code, err := contracts.Get(entry.Name, env)
+		require.NoError(t, err)
+		assert.Equal(t, code, entry.Code, entry.Name)
+
+		hash := sha3.Sum256(code)
+		assert.Equal(t, hex.EncodeToString(hash[:]), entry.CodeHash, entry.Name)
+
+		entries[entry.Name] = entry
+	}
+
+	epoch := entries["FlowEpoch"]
+	assert.Equal(t, "epochs/FlowEpoch.cdc", epoch.Source)
+	assert.Contains(t, epoch.Fields, "IDTableAddress")
+
+	// the initializer parameters are the ones of the rendered code
+	assert.Equal(t,
+		[]templates.Parameter{{Name: "adminAccount", Type: "auth(Storage, Capabilities) &Account"}},
+		entries["FlowToken"].InitParameters,
+	)
+	assert.Equal(t,
+		[]templates.Parameter{
+			{Label: "_", Name: "epochTokenPayout", Type: "UFix64"},
+			{Label: "_", Name: "rewardCut", Type: "UFix64"},
+			{Label: "_", Name: "candidateNodeLimits", Type: "{UInt8: UInt64}"},
+		},
+		entries["FlowIDTableStaking"].InitParameters,
+	)
+	assert.Empty(t, entries["FlowDKG"].InitParameters)
+
+	t.Run("hash depends on environment", func(t *testing.T) {
+		testnet, err := contracts.CatalogEntryFor("FlowEpoch", templates.TestnetEnvironment())
+		require.NoError(t, err)
+		assert.NotEqual(t, epoch.CodeHash, testnet.CodeHash)
+
+		dkg, err := contracts.CatalogEntryFor("FlowDKG", templates.TestnetEnvironment())
+		require.NoError(t, err)
+		assert.Equal(t, entries["FlowDKG"].CodeHash, dkg.CodeHash)
+	})
+
+	t.Run("unknown contract", func(t *testing.T) {
+		_, err := contracts.CatalogEntryFor("FungibleToken", env)
+		require.ErrorContains(t, err, `unknown contract "FungibleToken"`)
+	})
+}
+
+func TestVersion(t *testing.T) {
+	// this module is the main module of the test binary
+	assert.Equal(t, "(devel)", contracts.Version())
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/psiemens/sconfig"
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+type Config struct {
+	Network string `default:"mainnet" flag:"network" info:"Flow network to render the contracts for"`
+}
+
+const envPrefix = "FLOW"
+
+var conf Config
+
+var cmd = &cobra.Command{
+	Use:   "core-contracts",
+	Short: "Tools for the core contracts embedded in this module",
+}
+
+func getEnv(conf Config) (templates.Environment, error) {
+	return templates.EnvironmentForNetwork(conf.Network)
+}
+
+func init() {
+	initConfig()
+}
+
+func initConfig() {
+	err := sconfig.New(&conf).
+		FromEnvironment(envPrefix).
+		BindFlags(cmd.PersistentFlags()).
+		Parse()
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func main() {
+	if err := cmd.Execute(); err != nil {
+		exit(err)
+	}
+}
+
+func exit(err error) {
+	fmt.Println(err)
+	os.Exit(1)
+}
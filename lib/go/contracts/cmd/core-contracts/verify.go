@@ -0,0 +1,344 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/parser"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+
+	"github.com/onflow/flow-core-contracts/lib/go/contracts"
+)
+
+var errDrift = errors.New("deployed contracts differ from the contracts of this module")
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify <deployed.json>",
+	Short: "Compare the deployed core contracts of a network with the contracts of this module",
+	Long: `Compare the deployed core contracts of a network with the contracts of this module.
+
+The deployed contracts are read from a JSON file that maps account addresses
+to the contracts deployed to the account, by contract name:
+
+  {
+    "0x8624b52f9ddcd04a": {
+      "FlowIDTableStaking": "import FungibleToken from 0xf233dcee88fe0abe\n..."
+    }
+  }
+
+Every core contract is rendered for the network and compared with the contract
+deployed to its address in the environment of the network.
+A contract that only differs in formatting or comments is reported, but is not drift.
+FlowToken and FlowFees are deployed with an initializer that takes an admin account,
+so they match both with and without that rewrite.
+The command exits with a non-zero status if a contract drifted or is missing.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		env, err := getEnv(conf)
+		if err != nil {
+			exit(err)
+		}
+
+		deployed, err := readDeployed(args[0])
+		if err != nil {
+			exit(err)
+		}
+
+		results, err := verify(env, deployed)
+		if err != nil {
+			exit(err)
+		}
+
+		printResults(cmd.OutOrStdout(), results)
+
+		for _, r := range results {
+			if r.Status.failed() {
+				exit(errDrift)
+			}
+		}
+	},
+}
+
+func init() {
+	cmd.AddCommand(verifyCmd)
+}
+
+// deployedContracts are the contracts deployed to each account, by contract name.
+type deployedContracts map[flow.Address]map[string]string
+
+func readDeployed(filename string) (deployedContracts, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts map[string]map[string]string
+
+	err = json.Unmarshal(b, &accounts)
+	if err != nil {
+		return nil, fmt.Errorf("invalid deployed contracts %s: %w", filename, err)
+	}
+
+	deployed := make(deployedContracts, len(accounts))
+	for address, contracts := range accounts {
+		deployed[flow.HexToAddress(address)] = contracts
+	}
+
+	return deployed, nil
+}
+
+type status string
+
+const (
+	statusMatch      status = "match"
+	statusFormatting status = "format"
+	statusDrift      status = "drift"
+	statusMissing    status = "missing"
+	statusSkipped    status = "skipped"
+)
+
+// failed returns true if the status means that the deployed contract is not the expected one.
+func (s status) failed() bool {
+	return s == statusDrift || s == statusMissing
+}
+
+// result is the result of the verification of a core contract.
+type result struct {
+	Name    string
+	Address string
+	Status  status
+	Details []string
+}
+
+// variant is a rendering of a contract that the deployed code may match.
+type variant struct {
+	description string
+	code        []byte
+}
+
+// verify compares every core contract rendered for the given Environment
+// with the contract deployed to its address, in the order of the catalog.
+func verify(env templates.Environment, deployed deployedContracts) ([]result, error) {
+	catalog, err := contracts.Catalog(env)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]result, 0, len(catalog))
+
+	for _, entry := range catalog {
+		address, ok := env.ContractAddress(entry.Name)
+		if !ok {
+			results = append(results, result{
+				Name:    entry.Name,
+				Status:  statusSkipped,
+				Details: []string{"no address in the environment"},
+			})
+			continue
+		}
+
+		r := result{
+			Name:    entry.Name,
+			Address: address,
+		}
+
+		code, ok := deployed[flow.HexToAddress(address)][entry.Name]
+		if !ok {
+			r.Status = statusMissing
+			results = append(results, r)
+			continue
+		}
+
+		variants, err := contractVariants(entry, env)
+		if err != nil {
+			return nil, err
+		}
+
+		r.Status, r.Details = compare(variants, []byte(code))
+		results = append(results, r)
+	}
+
+	return results, nil
+}
+
+// contractVariants returns the renderings of the given contract the deployed code may match:
+// the code rendered like for a deployment, and, if the contract is rewritten when it is deployed,
+// the code with only its imports replaced, described by the rewrites it lacks.
+func contractVariants(entry contracts.CatalogEntry, env templates.Environment) ([]variant, error) {
+	variants := []variant{{description: "rendered", code: entry.Code}}
+
+	source, err := contracts.Source(entry.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	unpatched := []byte(templates.ReplaceAddresses(string(source), env))
+	if !bytes.Equal(unpatched, entry.Code) {
+		variants = append(variants, variant{
+			description: fmt.Sprintf("without the %s rewrite", transformNames(contracts.ContractTransforms(entry.Name, env))),
+			code:        unpatched,
+		})
+	}
+
+	return variants, nil
+}
+
+// transformNames returns the names of the given transforms, e.g. "FlowToken admin account".
+func transformNames(transforms []contracts.Transform) string {
+	names := make([]string, len(transforms))
+	for i, transform := range transforms {
+		names[i] = transform.Name
+	}
+	return strings.Join(names, " and ")
+}
+
+// compare compares the deployed code with each variant of a contract,
+// first byte by byte and then by their declarations.
+// The details of a drift are the differences to the first variant.
+func compare(variants []variant, deployed []byte) (status, []string) {
+	for i, v := range variants {
+		if bytes.Equal(v.code, deployed) {
+			if i == 0 {
+				return statusMatch, nil
+			}
+			return statusMatch, []string{v.description}
+		}
+	}
+
+	actual, err := parser.ParseProgram(nil, deployed, parser.Config{})
+	if err != nil {
+		return statusDrift, []string{fmt.Sprintf("cannot parse deployed code: %s", err)}
+	}
+
+	var expected *ast.Program
+
+	for i, v := range variants {
+		program, err := parser.ParseProgram(nil, v.code, parser.Config{})
+		if err != nil {
+			return statusDrift, []string{fmt.Sprintf("cannot parse %s code: %s", v.description, err)}
+		}
+
+		if i == 0 {
+			expected = program
+		}
+
+		if len(diffDeclarations("", program.Declarations(), actual.Declarations())) == 0 {
+			details := []string{byteDiff(v.code, deployed)}
+			if i > 0 {
+				details = append(details, v.description)
+			}
+			return statusFormatting, details
+		}
+	}
+
+	details := []string{byteDiff(variants[0].code, deployed)}
+	details = append(details, diffDeclarations("", expected.Declarations(), actual.Declarations())...)
+
+	return statusDrift, details
+}
+
+// byteDiff describes the first difference between the expected and the actual code.
+func byteDiff(expected, actual []byte) string {
+	expectedLines := strings.Split(string(expected), "\n")
+	actualLines := strings.Split(string(actual), "\n")
+
+	line := 0
+	for line < len(expectedLines) && line < len(actualLines) && expectedLines[line] == actualLines[line] {
+		line++
+	}
+
+	description := fmt.Sprintf("%d bytes expected, %d bytes deployed, first difference at line %d", len(expected), len(actual), line+1)
+
+	if line < len(expectedLines) {
+		description += fmt.Sprintf("\n  expected: %s", strings.TrimSpace(expectedLines[line]))
+	}
+	if line < len(actualLines) {
+		description += fmt.Sprintf("\n  deployed: %s", strings.TrimSpace(actualLines[line]))
+	}
+
+	return description
+}
+
+// declarationKey identifies a declaration among the declarations of a program or a composite,
+// e.g. "function getNodeInfo".
+// Declarations without a name, like imports, are identified by their code.
+func declarationKey(declaration ast.Declaration) string {
+	identifier := declaration.DeclarationIdentifier()
+	if identifier == nil {
+		return declaration.String()
+	}
+
+	return declaration.DeclarationKind().Name() + " " + identifier.Identifier
+}
+
+// diffDeclarations compares the declarations of the expected and the actual code by kind and name,
+// and describes the declarations that were added, removed or changed.
+// The members of changed composites are compared recursively.
+func diffDeclarations(prefix string, expected, actual []ast.Declaration) []string {
+	var details []string
+
+	actualDeclarations := make(map[string]ast.Declaration, len(actual))
+	for _, declaration := range actual {
+		actualDeclarations[declarationKey(declaration)] = declaration
+	}
+
+	expectedKeys := make(map[string]bool, len(expected))
+
+	for _, declaration := range expected {
+		key := declarationKey(declaration)
+		expectedKeys[key] = true
+
+		other, ok := actualDeclarations[key]
+		if !ok {
+			details = append(details, fmt.Sprintf("removed %s%s", prefix, key))
+			continue
+		}
+
+		if declaration.String() == other.String() {
+			continue
+		}
+
+		members, otherMembers := declaration.DeclarationMembers(), other.DeclarationMembers()
+		if members != nil && otherMembers != nil {
+			memberDetails := diffDeclarations(
+				prefix+declaration.DeclarationIdentifier().Identifier+".",
+				members.Declarations(),
+				otherMembers.Declarations(),
+			)
+			if len(memberDetails) > 0 {
+				details = append(details, memberDetails...)
+				continue
+			}
+		}
+
+		details = append(details, fmt.Sprintf("changed %s%s", prefix, key))
+	}
+
+	for _, declaration := range actual {
+		key := declarationKey(declaration)
+		if !expectedKeys[key] {
+			details = append(details, fmt.Sprintf("added %s%s", prefix, key))
+		}
+	}
+
+	return details
+}
+
+func printResults(w io.Writer, results []result) {
+	for _, r := range results {
+		fmt.Fprintf(w, "%-8s %s %s\n", r.Status, r.Name, r.Address)
+
+		for _, detail := range r.Details {
+			fmt.Fprintf(w, "           %s\n", strings.ReplaceAll(detail, "\n", "\n           "))
+		}
+	}
+}
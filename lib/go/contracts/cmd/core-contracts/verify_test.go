@@ -0,0 +1,183 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+
+	"github.com/onflow/flow-core-contracts/lib/go/contracts"
+)
+
+// deployAll returns the deployed contracts of a network that runs exactly the contracts of this module.
+func deployAll(t *testing.T, env templates.Environment) deployedContracts {
+	catalog, err := contracts.Catalog(env)
+	require.NoError(t, err)
+
+	deployed := make(deployedContracts)
+	for _, entry := range catalog {
+		address, ok := env.ContractAddress(entry.Name)
+		require.True(t, ok, entry.Name)
+
+		account := flow.HexToAddress(address)
+		if deployed[account] == nil {
+			deployed[account] = make(map[string]string)
+		}
+		deployed[account][entry.Name] = string(entry.Code)
+	}
+
+	return deployed
+}
+
+func resultsByName(results []result) map[string]result {
+	byName := make(map[string]result, len(results))
+	for _, r := range results {
+		byName[r.Name] = r
+	}
+	return byName
+}
+
+func TestVerify(t *testing.T) {
+	env := templates.MainnetEnvironment()
+
+	t.Run("no drift", func(t *testing.T) {
+		results, err := verify(env, deployAll(t, env))
+		require.NoError(t, err)
+		require.Len(t, results, len(contracts.ContractNames()))
+
+		for _, r := range results {
+			assert.Equal(t, statusMatch, r.Status, r.Name)
+			assert.Empty(t, r.Details, r.Name)
+		}
+	})
+
+	t.Run("drift", func(t *testing.T) {
+		deployed := deployAll(t, env)
+
+		idTable := flow.HexToAddress(env.IDTableAddress)
+		service := flow.HexToAddress(env.ServiceAccountAddress)
+		flowToken := flow.HexToAddress(env.FlowTokenAddress)
+
+		// a changed function
+		dkg := deployed[idTable]["FlowDKG"]
+		deployed[idTable]["FlowDKG"] = strings.Replace(
+			dkg,
+			"access(all) fun reset(nodeIDs: [String]) {",
+			"access(all) fun reset(nodeIDs: [String]) {\n            log(nodeIDs)",
+			1,
+		)
+
+		// only comments and formatting
+		deployed[idTable]["FlowClusterQC"] = "// deployed at genesis\n\n" + deployed[idTable]["FlowClusterQC"]
+
+		// deployed without the admin account rewrite
+		source, err := contracts.Source("FlowToken")
+		require.NoError(t, err)
+		deployed[flowToken]["FlowToken"] = templates.ReplaceAddresses(string(source), env)
+
+		// an added function
+		deployed[service]["RandomBeaconHistory"] = strings.Replace(
+			deployed[service]["RandomBeaconHistory"],
+			"access(all) contract RandomBeaconHistory {",
+			"access(all) contract RandomBeaconHistory {\n    access(all) fun backdoor() {}",
+			1,
+		)
+
+		// a missing contract
+		delete(deployed[service], "NodeVersionBeacon")
+
+		results, err := verify(env, deployed)
+		require.NoError(t, err)
+
+		byName := resultsByName(results)
+
+		assert.Equal(t, statusDrift, byName["FlowDKG"].Status)
+		require.Len(t, byName["FlowDKG"].Details, 2)
+		assert.Contains(t, byName["FlowDKG"].Details[0], "first difference at line")
+		assert.Contains(t, byName["FlowDKG"].Details[0], "deployed: log(nodeIDs)")
+		assert.Equal(t, "changed FlowDKG.SubmissionTracker.function reset", byName["FlowDKG"].Details[1])
+
+		assert.Equal(t, statusFormatting, byName["FlowClusterQC"].Status)
+		assert.Contains(t, byName["FlowClusterQC"].Details[0], "first difference at line 1")
+
+		assert.Equal(t, statusMatch, byName["FlowToken"].Status)
+		assert.Equal(t, []string{"without the FlowToken admin account rewrite"}, byName["FlowToken"].Details)
+
+		assert.Equal(t, statusDrift, byName["RandomBeaconHistory"].Status)
+		assert.Contains(t, byName["RandomBeaconHistory"].Details, "added RandomBeaconHistory.function backdoor")
+
+		assert.Equal(t, statusMissing, byName["NodeVersionBeacon"].Status)
+
+		assert.Equal(t, statusMatch, byName["FlowEpoch"].Status)
+
+		var out bytes.Buffer
+		printResults(&out, results)
+		assert.Contains(t, out.String(), "missing  NodeVersionBeacon 0xe467b9dd11fa00df\n")
+		assert.Contains(t, out.String(), "drift    FlowDKG 0x8624b52f9ddcd04a\n")
+	})
+
+	t.Run("without execution parameters fallback", func(t *testing.T) {
+		env := env
+		env.FlowExecutionParametersAddress = ""
+
+		deployed := deployAll(t, templates.MainnetEnvironment())
+
+		source, err := contracts.Source("FlowServiceAccount")
+		require.NoError(t, err)
+		deployed[flow.HexToAddress(env.ServiceAccountAddress)]["FlowServiceAccount"] = templates.ReplaceAddresses(string(source), env)
+
+		results, err := verify(env, deployed)
+		require.NoError(t, err)
+
+		serviceAccount := resultsByName(results)["FlowServiceAccount"]
+		assert.Equal(t, statusMatch, serviceAccount.Status)
+		assert.Equal(t,
+			[]string{"without the FlowServiceAccount FlowExecutionParameters fallback rewrite"},
+			serviceAccount.Details,
+		)
+	})
+
+	t.Run("no address", func(t *testing.T) {
+		env := env
+		env.CryptoAddress = ""
+
+		results, err := verify(env, deployAll(t, templates.MainnetEnvironment()))
+		require.NoError(t, err)
+
+		crypto := resultsByName(results)["Crypto"]
+		assert.Equal(t, statusSkipped, crypto.Status)
+		assert.False(t, crypto.Status.failed())
+	})
+}
+
+func TestReadDeployed(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "deployed.json")
+
+	b, err := json.Marshal(map[string]map[string]string{
+		"0x8624b52f9ddcd04a": {"FlowEpoch": "access(all) contract FlowEpoch {}"},
+		"e467b9dd11fa00df":   {"NodeVersionBeacon": "access(all) contract NodeVersionBeacon {}"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filename, b, 0644))
+
+	deployed, err := readDeployed(filename)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		"access(all) contract FlowEpoch {}",
+		deployed[flow.HexToAddress("8624b52f9ddcd04a")]["FlowEpoch"],
+	)
+	assert.Contains(t, deployed[flow.HexToAddress("0xe467b9dd11fa00df")], "NodeVersionBeacon")
+
+	require.NoError(t, os.WriteFile(filename, []byte("[]"), 0644))
+	_, err = readDeployed(filename)
+	require.ErrorContains(t, err, "invalid deployed contracts")
+}
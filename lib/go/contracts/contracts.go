@@ -1,12 +1,10 @@
 package contracts
 
-//go:generate go run github.com/kevinburke/go-bindata/go-bindata -ignore=.*_test\.cdc -ignore=.*.json -prefix ../../../contracts -o internal/assets/assets.go -pkg assets -nometadata -nomemcopy ../../../contracts/...
+//go:generate sh -c "rm -rf internal/assets/files && mkdir internal/assets/files && tar -cf - --exclude='*_test.cdc' --exclude='*.json' -C ../../../contracts . | tar -xf - -C internal/assets/files"
 
 import (
 	"fmt"
-	"strings"
 
-	_ "github.com/kevinburke/go-bindata"
 	ftcontracts "github.com/onflow/flow-ft/lib/go/contracts"
 	"github.com/onflow/flow-go-sdk"
 	nftcontracts "github.com/onflow/flow-nft/lib/go/contracts"
@@ -86,6 +84,15 @@ func withHexPrefix(address string) string {
 	return fmt.Sprintf("0x%s", address)
 }
 
+// mustGenerate returns the given contract code, or panics if generating it failed.
+func mustGenerate(code []byte, err error) []byte {
+	if err != nil {
+		panic(err)
+	}
+
+	return code
+}
+
 // FungibleToken returns the FungibleToken contract interface.
 func FungibleToken(env templates.Environment) []byte {
 	return ftcontracts.FungibleToken(env.ViewResolverAddress, env.BurnerAddress)
@@ -125,52 +132,44 @@ func CrossVMMetadataViews(env templates.Environment) []byte {
 // FlowToken returns the FlowToken contract.
 //
 // The returned contract will import the FungibleToken contract from the specified address.
+// Its initializer is rewritten to store the admin resources in an admin account passed as an argument.
+// It panics if the rewrite does not apply to the contract, Get returns an error instead.
 func FlowToken(env templates.Environment) []byte {
-	code := assets.MustAssetString(flowTokenFilename)
+	return mustGenerate(flowToken(assets.MustAssetString(flowTokenFilename), env))
+}
 
+// flowToken returns the FlowToken contract generated from the given source.
+func flowToken(code string, env templates.Environment) ([]byte, error) {
 	code = templates.ReplaceAddresses(code, env)
 
-	// Replace the init method storage operations
-	code = strings.ReplaceAll(
-		code,
-		"self.account.",
-		"adminAccount.",
-	)
-
-	// Replace the init method admin account parameter
-	code = strings.ReplaceAll(
-		code,
-		"init()",
-		"init(adminAccount: auth(Storage, Capabilities) &Account)",
-	)
+	code, err := flowTokenAdminAccount.Apply(code)
+	if err != nil {
+		return nil, err
+	}
 
-	return []byte(code)
+	return []byte(code), nil
 }
 
 // FlowFees returns the FlowFees contract.
 //
 // The returned contract will import the FungibleToken and FlowToken
 // contracts from the specified addresses.
+// Its initializer is rewritten to store the admin resource in an admin account passed as an argument.
+// It panics if the rewrite does not apply to the contract, Get returns an error instead.
 func FlowFees(env templates.Environment) []byte {
-	code := assets.MustAssetString(flowFeesFilename)
+	return mustGenerate(flowFees(assets.MustAssetString(flowFeesFilename), env))
+}
 
+// flowFees returns the FlowFees contract generated from the given source.
+func flowFees(code string, env templates.Environment) ([]byte, error) {
 	code = templates.ReplaceAddresses(code, env)
 
-	// Replace the init method storage operations
-	code = strings.ReplaceAll(
-		code,
-		"self.account.storage.save(<-admin, to: /storage/flowFeesAdmin)",
-		"adminAccount.storage.save(<-admin, to: /storage/flowFeesAdmin)",
-	)
-
-	// Replace the init method admin account parameter
-	code = strings.ReplaceAll(
-		code,
-		"init()",
-		"init(adminAccount: auth(SaveValue) &Account)",
-	)
+	code, err := flowFeesAdminAccount.Apply(code)
+	if err != nil {
+		return nil, err
+	}
 
-	return []byte(code)
+	return []byte(code), nil
 }
 
 // FlowStorageFees returns the FlowStorageFees contract
@@ -196,40 +195,26 @@ func FlowExecutionParameters(env templates.Environment) []byte {
 //
 // The returned contract will import the FungibleToken, FlowToken, FlowFees, and FlowStorageFees
 // contracts from the specified addresses.
+// If the FlowExecutionParameters address is not set, the contract is rewritten
+// to read the metering parameters from its own storage instead.
+// It panics if the rewrite does not apply to the contract, Get returns an error instead.
 func FlowServiceAccount(env templates.Environment) []byte {
-	code := assets.MustAssetString(flowServiceAccountFilename)
+	return mustGenerate(flowServiceAccount(assets.MustAssetString(flowServiceAccountFilename), env))
+}
 
+// flowServiceAccount returns the FlowServiceAccount contract generated from the given source.
+func flowServiceAccount(code string, env templates.Environment) ([]byte, error) {
 	if env.FlowExecutionParametersAddress == "" {
-		// Remove the import of FlowExecutionParameters
-		code = strings.ReplaceAll(
-			code,
-			"import \"FlowExecutionParameters\"",
-			"//import \"FlowExecutionParameters\"",
-		)
-
-		// Replace the metering getter functions
-		code = strings.ReplaceAll(
-			code,
-			"return FlowExecutionParameters.getExecutionEffortWeights()",
-			"return self.account.storage.copy<{UInt64: UInt64}>(from: /storage/executionEffortWeights) ?? panic(\"execution effort weights not set yet\")",
-		)
-
-		code = strings.ReplaceAll(
-			code,
-			"return FlowExecutionParameters.getExecutionMemoryWeights()",
-			"return self.account.storage.copy<{UInt64: UInt64}>(from: /storage/executionMemoryWeights) ?? panic(\"execution memory weights not set yet\")",
-		)
-
-		code = strings.ReplaceAll(
-			code,
-			"return FlowExecutionParameters.getExecutionMemoryLimit()",
-			"return self.account.storage.copy<UInt64>(from: /storage/executionMemoryLimit) ?? panic(\"execution memory limit not set yet\")",
-		)
+		var err error
+		code, err = flowServiceAccountWithoutExecutionParameters.Apply(code)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	code = templates.ReplaceAddresses(code, env)
 
-	return []byte(code)
+	return []byte(code), nil
 }
 
 // FlowIDTableStaking returns the FlowIDTableStaking contract
@@ -375,8 +360,10 @@ func TESTFlowStakingCollection(
 
 	code = templates.ReplaceAddresses(code, env)
 
-	code = strings.ReplaceAll(code, "access(self) fun getTokens", "access(all) fun getTokens")
-	code = strings.ReplaceAll(code, "access(self) fun depositTokens", "access(all) fun depositTokens")
+	code, err := testFlowStakingCollectionPublic.Apply(code)
+	if err != nil {
+		panic(err)
+	}
 
 	return []byte(code)
 }
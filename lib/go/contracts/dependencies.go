@@ -0,0 +1,219 @@
+package contracts
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/parser"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+// contractFilenames maps the name of every core contract embedded in this package
+// to the file its source is read from.
+var contractFilenames = map[string]string{
+	"FlowFees":                      flowFeesFilename,
+	"FlowStorageFees":               storageFeesFilename,
+	"FlowExecutionParameters":       executionParametersFilename,
+	"FlowServiceAccount":            flowServiceAccountFilename,
+	"FlowToken":                     flowTokenFilename,
+	"FlowIDTableStaking":            flowIdentityTableFilename,
+	"FlowClusterQC":                 flowQCFilename,
+	"FlowDKG":                       flowDKGFilename,
+	"FlowEpoch":                     flowEpochFilename,
+	"LockedTokens":                  flowLockedTokensFilename,
+	"StakingProxy":                  flowStakingProxyFilename,
+	"FlowStakingCollection":         flowStakingCollectionFilename,
+	"NodeVersionBeacon":             flowNodeVersionBeaconFilename,
+	"RandomBeaconHistory":           flowRandomBeaconHistoryFilename,
+	"Crypto":                        cryptoFilename,
+	"LinearCodeAddressGenerator":    linearCodeAddressGeneratorFilename,
+	"FlowTransactionScheduler":      flowTransactionSchedulerFilename,
+	"FlowTransactionSchedulerUtils": flowTransactionSchedulerUtilsFilename,
+}
+
+// ContractDependencies describes what a core contract needs to be deployed.
+type ContractDependencies struct {
+	// Name is the name of the contract, e.g. FlowEpoch
+	Name string
+	// Imports are the names of the contracts imported by the contract, in declaration order,
+	// e.g. FungibleToken or FlowIDTableStaking.
+	// Imports of built-in contracts like `import Crypto` are not included.
+	Imports []string
+	// Fields are the names of the Environment fields that are needed
+	// to resolve the imports of the contract
+	Fields []string
+}
+
+// loadDependencies parses the imports of every core contract, read with the given function.
+func loadDependencies(source func(name string) ([]byte, error)) (map[string]ContractDependencies, error) {
+	dependencies := make(map[string]ContractDependencies, len(contractFilenames))
+
+	for name := range contractFilenames {
+		code, err := source(name)
+		if err != nil {
+			return nil, err
+		}
+
+		program, err := parser.ParseProgram(nil, code, parser.Config{})
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse contract %s: %w", name, err)
+		}
+
+		contract := ContractDependencies{
+			Name:   name,
+			Fields: templates.RequiredFields(code),
+		}
+
+		for _, declaration := range program.ImportDeclarations() {
+			// only placeholder imports refer to deployed contracts,
+			// identifier imports like `import Crypto` are provided by the runtime
+			location, ok := declaration.Location.(common.StringLocation)
+			if !ok {
+				continue
+			}
+			contract.Imports = append(contract.Imports, string(location))
+		}
+
+		dependencies[name] = contract
+	}
+
+	return dependencies, nil
+}
+
+// ContractNames returns the names of all core contracts embedded in this package, sorted by name.
+func ContractNames() []string {
+	names := make([]string, 0, len(contractFilenames))
+	for name := range contractFilenames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Dependencies returns the imports of the core contract with the given name,
+// and the Environment fields needed to resolve them.
+func (s *Sources) Dependencies(name string) (ContractDependencies, error) {
+	dependencies, err := s.dependencies()
+	if err != nil {
+		return ContractDependencies{}, err
+	}
+
+	contract, ok := dependencies[name]
+	if !ok {
+		return ContractDependencies{}, fmt.Errorf("unknown contract %q", name)
+	}
+
+	return contract, nil
+}
+
+// Dependencies returns the imports of the embedded core contract with the given name,
+// and the Environment fields needed to resolve them.
+func Dependencies(name string) (ContractDependencies, error) {
+	return embedded.Dependencies(name)
+}
+
+// ImportCycleError is returned by DeployOrder when contracts import each other.
+type ImportCycleError struct {
+	// Cycle are the names of the contracts in the cycle,
+	// starting and ending with the same contract
+	Cycle []string
+}
+
+func (e *ImportCycleError) Error() string {
+	return fmt.Sprintf("import cycle: %s", strings.Join(e.Cycle, " -> "))
+}
+
+// DeployOrder returns the given core contracts and the core contracts they import,
+// ordered so that every contract comes after the contracts it imports.
+// If no names are given, the order of all core contracts is returned.
+//
+// Imported contracts that are not embedded in this package, like FungibleToken,
+// are not part of the order and must already be deployed.
+// Contracts are otherwise kept in the order they are given in,
+// and the imports of a contract in the order they are declared in.
+//
+// An *ImportCycleError is returned if the contracts import each other.
+func (s *Sources) DeployOrder(names ...string) ([]string, error) {
+	dependencies, err := s.dependencies()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(names) == 0 {
+		names = ContractNames()
+	}
+
+	return deployOrder(dependencies, names)
+}
+
+// DeployOrder is like Sources.DeployOrder for the embedded core contracts.
+func DeployOrder(names ...string) ([]string, error) {
+	return embedded.DeployOrder(names...)
+}
+
+// deployOrder sorts the given contracts and their imports topologically,
+// with a depth-first search of the import graph.
+func deployOrder(dependencies map[string]ContractDependencies, names []string) ([]string, error) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	var order []string
+	var path []string
+	state := make(map[string]int)
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			start := 0
+			for path[start] != name {
+				start++
+			}
+			cycle := append(append([]string{}, path[start:]...), name)
+			return &ImportCycleError{Cycle: cycle}
+		}
+
+		contract, ok := dependencies[name]
+		if !ok {
+			return fmt.Errorf("unknown contract %q", name)
+		}
+
+		state[name] = visiting
+		path = append(path, name)
+
+		for _, imported := range contract.Imports {
+			if _, ok := dependencies[imported]; !ok {
+				continue
+			}
+
+			err := visit(imported)
+			if err != nil {
+				return err
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[name] = visited
+		order = append(order, name)
+
+		return nil
+	}
+
+	for _, name := range names {
+		err := visit(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
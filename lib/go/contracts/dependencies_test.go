@@ -0,0 +1,113 @@
+package contracts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDependencies(t *testing.T) {
+	epoch, err := Dependencies("FlowEpoch")
+	require.NoError(t, err)
+
+	assert.Equal(t, "FlowEpoch", epoch.Name)
+	assert.Equal(t,
+		[]string{"FungibleToken", "FlowToken", "FlowIDTableStaking", "FlowClusterQC", "FlowDKG", "FlowFees"},
+		epoch.Imports,
+	)
+	assert.ElementsMatch(t,
+		[]string{"FungibleTokenAddress", "FlowTokenAddress", "IDTableAddress", "QuorumCertificateAddress", "DkgAddress", "FlowFeesAddress"},
+		epoch.Fields,
+	)
+
+	// the built-in Crypto contract is not a dependency
+	qc, err := Dependencies("FlowClusterQC")
+	require.NoError(t, err)
+	assert.Empty(t, qc.Imports)
+	assert.Empty(t, qc.Fields)
+
+	_, err = Dependencies("FlowContractAudits")
+	require.ErrorContains(t, err, `unknown contract "FlowContractAudits"`)
+}
+
+func TestDeployOrder(t *testing.T) {
+	// position returns a function that returns the position of a contract in the order
+	position := func(order []string) func(name string) int {
+		return func(name string) int {
+			for i, n := range order {
+				if n == name {
+					return i
+				}
+			}
+			t.Fatalf("%s is not in the order %v", name, order)
+			return -1
+		}
+	}
+
+	t.Run("all contracts", func(t *testing.T) {
+		order, err := DeployOrder()
+		require.NoError(t, err)
+		require.ElementsMatch(t, ContractNames(), order)
+
+		pos := position(order)
+		for _, name := range order {
+			contract, err := Dependencies(name)
+			require.NoError(t, err)
+
+			for _, imported := range contract.Imports {
+				if _, ok := contractFilenames[imported]; ok {
+					assert.Less(t, pos(imported), pos(name), "%s imports %s", name, imported)
+				}
+			}
+		}
+	})
+
+	t.Run("imports are included", func(t *testing.T) {
+		order, err := DeployOrder("FlowEpoch")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			[]string{
+				"FlowToken",
+				"FlowStorageFees",
+				"FlowFees",
+				"FlowIDTableStaking",
+				"FlowClusterQC",
+				"FlowDKG",
+				"FlowEpoch",
+			},
+			order,
+		)
+	})
+
+	t.Run("is deterministic", func(t *testing.T) {
+		first, err := DeployOrder("FlowStakingCollection", "FlowServiceAccount")
+		require.NoError(t, err)
+
+		second, err := DeployOrder("FlowStakingCollection", "FlowServiceAccount")
+		require.NoError(t, err)
+
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("unknown contract", func(t *testing.T) {
+		_, err := DeployOrder("FlowToken", "FungibleToken")
+		require.ErrorContains(t, err, `unknown contract "FungibleToken"`)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		dependencies := map[string]ContractDependencies{
+			"A": {Name: "A", Imports: []string{"B"}},
+			"B": {Name: "B", Imports: []string{"FungibleToken", "C"}},
+			"C": {Name: "C", Imports: []string{"B"}},
+		}
+
+		_, err := deployOrder(dependencies, []string{"A"})
+
+		var cycleErr *ImportCycleError
+		require.ErrorAs(t, err, &cycleErr)
+		assert.Equal(t, []string{"B", "C", "B"}, cycleErr.Cycle)
+		assert.EqualError(t, err, "import cycle: B -> C -> B")
+	})
+}
@@ -1,740 +1,95 @@
-// Code generated by go-bindata. DO NOT EDIT.
-// sources:
-// Crypto.cdc (4.588kB)
-// FlowExecutionParameters.cdc (1.073kB)
-// FlowFees.cdc (16.915kB)
-// FlowIDTableStaking.cdc (109.837kB)
-// FlowServiceAccount.cdc (8.339kB)
-// FlowStakingCollection.cdc (62.869kB)
-// FlowStorageFees.cdc (9.096kB)
-// FlowToken.cdc (13.75kB)
-// FlowTransactionScheduler.cdc (71.377kB)
-// FlowTransactionSchedulerUtils.cdc (43.667kB)
-// LinearCodeAddressGenerator.cdc (4.793kB)
-// LockedTokens.cdc (36.033kB)
-// NodeVersionBeacon.cdc (25.354kB)
-// RandomBeaconHistory.cdc (16.439kB)
-// StakingProxy.cdc (5.945kB)
-// epochs/FlowClusterQC.cdc (19.868kB)
-// epochs/FlowDKG.cdc (28.853kB)
-// epochs/FlowEpoch.cdc (65.512kB)
-// testContracts/RetrieveFraudulentTokensEvents.cdc (4.044kB)
-// testContracts/TestFlowIDTableStaking.cdc (9.238kB)
-// testContracts/TestFlowScheduledTransactionHandler.cdc (10.179kB)
-
+// Package assets embeds the Cadence files of this repository.
+//
+// go generate copies the files into the files directory of this package,
+// because go:embed cannot reach the source tree outside of the module.
+// The contracts and the templates modules share this file: go generate copies it
+// from lib/go/contracts/internal/assets to lib/go/templates/internal/assets, so edit it in the former.
 package assets
 
 import (
-	"bytes"
-	"compress/gzip"
-	"crypto/sha256"
+	"embed"
+	"errors"
 	"fmt"
-	"io"
-	"os"
-	"path/filepath"
+	"io/fs"
 	"strings"
-	"time"
 )
 
-func bindataRead(data, name string) ([]byte, error) {
-	gz, err := gzip.NewReader(strings.NewReader(data))
-	if err != nil {
-		return nil, fmt.Errorf("read %q: %w", name, err)
-	}
-
-	var buf bytes.Buffer
-	_, err = io.Copy(&buf, gz)
-
-	if err != nil {
-		return nil, fmt.Errorf("read %q: %w", name, err)
-	}
-
-	clErr := gz.Close()
-	if clErr != nil {
-		return nil, clErr
-	}
-
-	return buf.Bytes(), nil
-}
-
-type asset struct {
-	bytes  []byte
-	info   os.FileInfo
-	digest [sha256.Size]byte
-}
-
-type bindataFileInfo struct {
-	name    string
-	size    int64
-	mode    os.FileMode
-	modTime time.Time
-}
-
-func (fi bindataFileInfo) Name() string {
-	return fi.name
-}
-func (fi bindataFileInfo) Size() int64 {
-	return fi.size
-}
-func (fi bindataFileInfo) Mode() os.FileMode {
-	return fi.mode
-}
-func (fi bindataFileInfo) ModTime() time.Time {
-	return fi.modTime
-}
-func (fi bindataFileInfo) IsDir() bool {
-	return false
-}
-func (fi bindataFileInfo) Sys() interface{} {
-	return nil
-}
-
-var _cryptoCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x5d\x6b\xe4\x36\x14\x7d\xcf\xaf\xb8\x7d\x9b\xd0\xc1\x49\xa1\x94\x62\xf0\x86\x6d\xbb\x6d\x43\x5a\x28\xc9\x2e\xfb\x30\x0c\xbb\x8a\x7d\x6d\x8b\xf1\xca\x83\x74\x3d\x13\x13\xe6\xbf\x17\x59\x63\x5b\x92\x35\x1f\x59\x12\xbf\x8c\x3d\x3e\xf7\xeb\xe8\xdc\x6b\xe9\x82\xa5\x29\x2a\x35\x63\x55\x75\x09\x69\x2d\x48\xb2\x94\xe0\x77\xd9\xae\xa9\x86\xe7\x8b\x0b\x00\x00\x0b\xd2\x3d\xe7\x8d\x80\x92\xa9\x72\xf6\x05\x32\x46\x2c\x86\xc5\xa7\x5b\x41\xbf\x2e\xe7\xc0\xaa\xa2\x96\x9c\xca\x6f\x31\xfc\xcd\x54\xf9\xbe\x7f\xbc\x1c\x30\xf0\xdc\xb9\xd0\x97\x44\x6a\xa4\x18\x6d\xa2\xce\xa7\xf6\x68\xc2\xec\x8e\x47\xff\xcc\xa9\xfc\xc8\x8a\x69\x12\xc4\x8a\x18\x1e\x48\x72\x51\xbc\x46\x46\x7d\x1c\x1d\x65\xef\x9c\x58\x71\x34\x45\x45\xb2\x49\x09\xee\xb0\xfd\x87\x2b\xfa\x20\x48\xb6\x3d\x97\x21\xb8\xbe\x2a\x24\x58\x61\x7b\x2b\x32\x7c\x8a\xe1\x56\xd0\x69\xf8\xba\x79\xac\x78\x7a\x87\x6d\x0c\xff\xf5\xb7\xa7\xad\x4a\x9b\x05\x8f\x94\xd3\xd6\x5b\xe4\x45\x49\x31\x7c\xfa\x93\x3f\xfd\xf2\xf3\x69\x3c\x57\xf7\xb8\xa9\x57\x98\xc5\xf0\x5b\x5d\x57\xa3\x01\x17\x9c\x66\xc3\x93\xbe\x9c\xea\xe7\xce\xab\x50\xa5\x2e\xe2\x58\x55\x2e\xd2\xad\xc0\x7d\xe7\x67\xdb\xff\x7f\x69\x69\xa4\x5b\x5f\xac\xf2\xa8\xcf\x17\x92\x21\xf5\x29\x68\xc8\x1c\x92\xb1\x8a\x29\xcc\x49\x1f\x12\xb7\x9c\x29\xdc\xd4\x00\xc9\xbe\x98\x29\x60\x28\x04\x92\xb1\xa8\x01\xb6\x3b\x5f\xbb\x01\xd9\xea\x00\xee\x22\xa3\x20\xc9\x51\xc5\xb0\xb0\x15\xbf\xf4\x16\x3b\x48\xe2\xde\x14\x12\x58\x2c\xad\xfc\x86\xdb\xab\xab\x2b\x78\x9f\x65\x0a\x18\x08\xdc\x6a\xa2\x61\xcb\xa9\x04\x2a\x11\x0a\xbe\x41\xe1\x53\x10\x12\xa2\x9e\x18\x2c\xcb\x5c\xb9\x7d\x79\x43\x55\x8d\xc2\x89\x0f\x0e\x01\xf0\x9a\x1e\x12\x87\x91\xa8\x42\x51\x50\x39\x81\x63\xe7\x27\x71\xdc\xba\x85\x81\xd3\x4b\xfd\xdd\x7c\x82\xb1\xca\x5f\x87\xcb\x0f\x50\x50\x1e\xa6\xc0\xa6\xc1\xfc\x4e\xdf\x5b\x0d\x96\xb3\x4a\xa1\x03\xb8\x3c\x28\x8f\x88\xad\xd7\x28\xb2\x59\x57\xbc\x0b\xdb\x8f\xec\xee\xcd\x21\x01\xdd\x77\x18\xd5\x89\x46\x2b\x88\x91\xa5\x1f\xde\xb1\x03\x3c\x07\x4e\x80\x4f\x5c\x91\x8a\x3c\x6b\xd3\x49\x2b\x6c\x15\x30\x89\xc0\xaa\x2d\x6b\xd5\x3e\x32\x66\x73\x78\x6c\x3a\x87\x2d\x94\x6c\x83\x9d\xeb\xaf\x43\xa1\x5f\x21\xe7\x58\x65\xa0\x90\x80\x6a\x20\xd9\xe0\x49\xad\x16\x48\x33\x67\x1a\x7a\x32\xba\xf1\x3a\x89\xe7\xa3\x8a\xde\x05\x65\xe4\x19\x58\xc4\x09\x5e\x39\xaf\x76\x17\x21\x7a\x6d\x97\x8b\x3e\xd6\xc1\x86\xfd\x97\xc9\xd5\x31\xb6\x41\x1a\x6e\x0c\x73\x59\x8d\x0a\x44\x4d\x90\x61\x85\x84\xc0\x4f\x37\xb3\xb1\xf7\x38\x7a\x3d\x52\x8e\x11\xa2\x7b\x30\x6d\xa4\x44\xb1\x6f\xe9\xe4\x14\x39\xe0\x89\x79\x84\xbc\xa0\x8d\xed\x90\xd1\x59\x3d\xed\x58\x9c\xdf\xe0\x8e\xd9\x99\xdd\xee\xd8\x9c\xd1\xfa\x4e\x13\x80\xd3\xf9\x87\x3a\x57\x36\xa8\x57\x74\x14\x92\xe2\x85\x60\xd4\x48\x34\x3d\xb9\x61\x15\xcf\x20\xaf\xa5\x07\xc1\xac\xdb\x1d\x9e\x54\xd4\x06\x25\xcf\xbd\x15\x18\x42\x3c\x20\x8d\xdf\xb6\x87\xfe\xdf\xe5\x7c\x82\xc6\xec\x0f\x67\x2b\xea\x00\xb2\xfa\x1b\xe3\xe2\x01\xd7\x4c\x32\xe2\xb5\xf8\x38\x6e\x53\xed\xef\x85\xde\x78\xf8\xdf\x89\x0d\x93\xa6\xc2\xcf\x1d\xb9\xaa\xff\xce\x40\x02\xd7\xd1\xf5\x54\xa0\x0a\x51\xdc\x75\x22\xe1\xa9\xfe\x2e\x3f\xdf\x0a\x32\x9e\x77\x90\xc0\xb3\x27\x69\xcd\xda\x50\x2b\x70\xe1\x14\xee\xa7\x62\x56\x06\x3e\x08\xa5\xc1\x7d\x8f\x9b\xb6\xe6\xca\x64\x39\x5d\xfb\x7c\xf4\x19\xbd\xbc\x2b\x61\x1c\x44\xd3\xaf\x06\x4c\x7b\xd4\xcf\x91\x2b\x2b\xc9\x92\x99\x69\xc3\x2a\x89\x2c\x6b\xe1\x11\xb5\x54\x10\x45\x30\x6b\x87\xc8\xc5\xb4\x88\x25\xdc\xdc\x98\xa4\x5e\x2f\xef\x7b\x4c\x6b\x99\x79\xdc\x6e\x99\x0a\x67\x79\x46\x8a\x89\xe9\xb8\x50\xac\xbf\xd0\x4c\x67\x96\x52\xc3\x2a\x1d\x6f\x82\xda\x6f\x52\xfc\x41\x17\x08\x74\x96\x52\x0c\xfb\xd2\xdb\x92\x5a\x9c\xaf\xb0\xb5\xf6\xaf\xaf\xaf\x06\xb4\xc5\x7e\x44\xb1\x3f\xe8\x44\x86\xc1\x19\x85\x26\xc4\xb0\x06\xbd\xc3\xd8\xd2\xf9\x70\x37\x9d\x85\xe0\xcd\x8b\xf1\x3e\x8c\x0d\x8e\x8e\xc0\x9f\x61\x6b\x77\xc2\xeb\xaa\x0e\x1f\x2f\x20\x70\xda\xe9\xaf\x97\x92\x6e\x4f\x2c\x48\xdc\xc7\x1f\xbb\x65\x0e\x1c\x5f\xc2\xfb\x0f\xc7\xf6\x5d\x02\x3f\x45\xd7\xdf\x73\x9a\x19\x66\xf7\x1b\x9c\xc6\x2d\x0d\xec\xc7\xbf\x77\xfe\x71\xcf\xb7\x01\xfc\xf7\x9f\x32\x47\x41\x27\xa3\xdf\x09\x41\xbb\xff\x03\x00\x00\xff\xff\x8e\xe3\xad\x46\xec\x11\x00\x00"
-
-func cryptoCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_cryptoCdc,
-		"Crypto.cdc",
-	)
-}
-
-func cryptoCdc() (*asset, error) {
-	bytes, err := cryptoCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "Crypto.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x61, 0x6, 0x92, 0xc2, 0x9, 0x2b, 0xd2, 0x9d, 0x8f, 0x49, 0xae, 0xfc, 0x10, 0xb6, 0xe8, 0xff, 0x3d, 0x4b, 0x39, 0x9, 0x33, 0x1f, 0xec, 0xe9, 0x8f, 0x69, 0x66, 0xfb, 0xdc, 0xb4, 0xcd, 0xd4}}
-	return a, nil
-}
-
-var _flowexecutionparametersCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x90\x41\x4b\xc3\x40\x10\x85\xcf\xc9\xaf\x78\xf4\x62\x7a\xe9\x5e\xc4\x43\x11\x7b\xaa\x22\x28\x78\x11\xcf\xcb\x32\x9b\x2e\x24\xbb\x65\x77\x62\x2d\x25\xff\x5d\x76\x37\xc4\x5a\x5a\xaa\xa2\xce\x25\x21\x99\xf7\xde\xbc\x4f\x08\x81\xdb\xc6\x6d\x96\x6f\xa4\x3a\x36\xce\x3e\x49\x2f\x5b\x62\xf2\x01\x81\x9d\xa7\x00\x5e\x11\xd6\x1f\x5f\xb5\xf3\x48\xaf\xc6\xd6\x65\x94\xb3\x97\x36\x48\x15\xc5\xd0\x44\x79\x23\x7a\xee\xff\x09\x65\x29\x95\xa2\x10\x2a\xd9\x34\x53\x28\x67\xd9\x4b\xc5\x27\xb3\x77\x65\x51\x14\x45\x09\x00\x42\xe0\x8e\x38\x60\x5c\xc3\x52\x6b\xe7\x19\x2f\x64\xea\x15\x07\x68\xef\xda\xc3\x2b\xa5\x52\xae\xb3\x7c\x91\x5b\xc8\x9a\x92\xd5\xfe\x09\xaf\x86\x36\xd0\x9d\x45\x4d\x3c\x5a\x67\xe7\xc1\xb8\x9a\xce\xb1\x7b\xbe\xb7\x7c\x75\x39\x47\x7e\xf6\xd8\x25\xa3\x38\x9e\xb8\xf3\x16\x81\x1a\x3d\x1b\xe2\x66\x43\xd8\x4c\xb9\xf5\xf6\xfa\x50\x7b\x53\xc5\x4b\xe7\x10\xc3\x96\xa0\xa3\xb1\xd3\x31\x21\xce\x62\x81\xb5\xb4\x46\x55\x93\x71\x1b\x94\xfb\x6f\x86\xfe\xd6\x31\x02\x31\xb6\xc4\x93\x2c\xee\xcb\x13\xe4\x1e\xa9\x75\x7e\xfb\x17\xe4\xb2\xf3\xbf\x93\xfb\x14\x7b\x9e\x5c\x9b\xfb\xff\x9c\xdc\x83\x69\x0d\xff\x3e\xb7\x64\x1b\xa9\xe5\xc2\xdf\x61\x95\x15\xe7\x08\xa5\x80\x2f\xf3\x69\x52\xcb\x63\x74\xfa\xf7\x00\x00\x00\xff\xff\x2a\x96\xca\xce\x31\x04\x00\x00"
-
-func flowexecutionparametersCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_flowexecutionparametersCdc,
-		"FlowExecutionParameters.cdc",
-	)
-}
-
-func flowexecutionparametersCdc() (*asset, error) {
-	bytes, err := flowexecutionparametersCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "FlowExecutionParameters.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xae, 0x42, 0x45, 0xc5, 0x29, 0xa7, 0xc4, 0x9a, 0xd7, 0x3c, 0x59, 0xd0, 0x22, 0x3, 0x9e, 0x10, 0x61, 0xbb, 0xae, 0x6d, 0xf2, 0x78, 0xf8, 0xa0, 0x9e, 0xb, 0x89, 0xa5, 0xb0, 0xa9, 0x74, 0x29}}
-	return a, nil
-}
-
-var _flowfeesCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x3b\x5d\x73\x1b\x39\x72\xef\xfa\x15\xbd\xae\x8a\x8f\xbc\xa5\x29\xe7\xa3\xf2\xa0\x48\xf6\x69\x75\x56\xca\x95\x5c\x6e\xcb\x67\xfb\x1e\x1c\x97\x0d\xce\xf4\x90\x28\x0f\x01\x06\xc0\x48\xe2\xed\xea\xbf\xa7\xf0\x39\xf8\x1a\x8a\xb2\xbd\xa9\xca\xf1\x41\x22\x67\x80\x46\xa3\xbf\xbb\xd1\xa0\xdb\x1d\x17\x0a\x9e\x5c\x0f\x6c\x4d\x57\x3d\xbe\xe5\x5f\x90\x3d\x39\x09\x8f\x7b\x7e\x5b\x79\xf4\x17\xc5\x05\x59\xe3\x35\xa2\x7c\x72\x72\x42\x9a\x06\xa5\x9c\x91\xbe\x9f\x43\xc3\x99\x12\xa4\x51\xa0\x87\xe9\xf7\xf0\xcb\xc9\x09\x00\xc0\xe9\x29\xbc\xba\x41\xa6\x40\x6d\x88\x02\x2a\x01\xb7\x54\x29\x6c\xe1\x76\x83\x0c\x94\x5e\x43\x02\x11\x08\x2d\xee\xb8\xa4\xfa\x8d\xe2\xa0\x36\x08\x1d\x22\xdc\x90\xa1\x57\x06\x4e\xbc\x18\x1a\x80\x06\x3f\xf9\x47\x3f\x6d\x46\xb6\x7c\x60\xea\x0c\xde\x5d\xd3\xbb\x7f\xfd\x97\xf9\x23\x97\xbf\xa5\x6a\xd3\x0a\x72\xcb\xa0\x13\x7c\xfb\x08\x04\xfe\xea\x27\x7e\x0d\x02\x9d\xa6\x94\xdd\x7d\x3b\x34\x0a\xdb\x89\xa5\x34\x45\xff\xe8\x86\x64\xeb\x2c\x80\xb2\xa6\x1f\x24\xe5\xec\x55\xd7\x71\x11\xbd\xc0\x3b\x6c\x06\x55\xbc\x38\x16\x33\xd8\x11\x41\xb6\xa8\x50\x48\x68\x36\x84\xad\x71\x1a\xbb\x9f\xc3\xd0\x2b\x33\xb2\x9d\xc9\x41\xac\xf1\x9a\x34\x8a\x8b\x49\x54\xaf\xb8\x9c\x46\x37\x7e\x79\x1c\x37\x37\x08\x3d\x95\x0a\x78\x07\xcd\x86\xf6\xad\xd9\x04\x69\x1a\x4d\x2e\xbf\x05\xb9\xf4\x90\xae\x38\x53\x84\x32\x69\x79\x3d\xf4\x3d\x30\xbc\x9d\x06\x00\xa4\x6d\x05\x4a\x89\xd2\x03\x98\xe9\x89\x41\xde\xfd\x30\xaa\x24\xf6\x9d\x46\x8e\x71\x65\x37\xdc\x62\x3b\x9f\x20\xdd\x95\x5e\xe6\x1a\xf1\xd2\x61\xe9\xa9\x17\x16\x3b\x83\x0f\x97\xf6\xfb\xc7\x91\x08\x3f\x0b\x7a\x43\x94\x93\x4e\x23\xba\xb0\x1b\x56\x3d\x6d\xbc\x16\x41\x37\xb0\x46\x93\x32\x5e\x56\xe3\x35\x87\x1b\x22\xec\xbc\x33\xf8\x43\x50\xf3\xe5\x7b\x23\xe7\x05\x92\xdd\xc0\x3c\xc8\x99\xd6\x8b\x33\xf8\xc3\x2f\x89\xc9\xb0\x13\xef\xe7\xf0\x8b\x99\xab\x3f\x3d\x2a\xab\x43\xe7\xcf\xec\x7f\x22\x7f\x28\x97\x8a\x47\xaf\x48\x4f\x58\x83\x70\x61\xc6\x2f\xdd\xcf\x30\x44\xe3\xbd\x34\x28\x2f\x53\x5c\xce\x9f\xe9\xff\xf3\x30\x50\x4b\xc3\xa4\x59\x70\x50\xed\xe8\x7b\x4f\xca\x53\xf8\x77\x54\x46\x02\x3c\x12\xbc\x33\x3f\x0d\x4f\xdf\x57\xb5\x5f\x13\x65\x8d\xea\x1a\xf1\x27\x3b\x67\x36\xf7\x72\x9a\x91\xa1\xc9\xb8\x0b\x17\x76\x33\x2b\x2e\x04\xbf\xcd\x79\x3f\x73\x0c\x76\x4c\xee\x48\xdf\xaf\x48\xf3\x05\x28\x83\x86\x48\x04\xc6\x9d\x54\x06\x91\xbe\x45\x81\xd0\x08\x24\x5a\x07\xf6\x38\x52\x95\x76\x95\xb5\x2f\x80\xd1\x1e\x7e\xfd\xb5\x78\xf5\xc3\xb2\x47\xb6\x56\x1b\x3d\xe4\x79\xb4\x05\xfd\x11\xa8\x06\xc1\x62\x1e\xe4\xec\xb9\x1f\xb1\xd6\xb2\xa5\xb8\x22\xbd\xa1\xde\x05\x3c\x5f\x3e\x0f\xef\xe2\xe7\xe3\xf7\x1f\x6b\x90\xc3\x9c\x8e\x0b\xad\x82\x0e\xcf\x37\xd8\x19\x5a\xe4\xd8\xa7\x18\xd3\xce\x4a\x60\x18\xa0\xc5\x2a\x86\xe1\xa8\x3f\x9b\x67\x3b\x3d\x84\xe4\x08\x60\x49\x6e\x08\xed\xc9\xaa\xf7\xcc\x8f\x61\xdc\xd7\x88\xe2\x28\x18\xc0\xe5\x02\xf8\x93\x41\x47\x1e\x36\x5f\xd0\x90\x1d\x59\xd1\x9e\x2a\x8a\xd2\xaa\x95\xb4\xce\x78\x19\x00\xbd\x31\x0b\x49\xc3\x65\xda\x8d\xe2\x92\x58\x41\x23\x32\xa4\x6d\xad\xc0\x2c\x2b\xf6\x81\xe2\xad\x11\xf1\x29\x21\x3d\x03\x32\xa8\xcd\xcc\xc5\x02\x73\x78\xfa\xe1\xca\xe3\xb6\x3f\x8f\x5f\x2d\x8c\x89\xd5\x51\x81\x5c\xc0\x7f\xe0\x5e\x2e\xe0\x35\x5b\xf1\xbb\x05\x5c\x45\x9b\x99\xc3\x53\x07\xfb\xc5\xc7\x97\x11\x4b\x62\xc1\x73\xd8\x2f\xfd\x96\x2d\x6a\xe7\xbf\x19\x1e\x2f\x9c\x81\x39\x75\x0b\x9e\xe6\x54\x28\xac\x88\xa7\xbd\x66\x62\xb0\xdd\x9a\x93\xa4\xef\x47\xe2\x1b\xb7\xb5\x25\x7b\x10\xd8\x20\xbd\x41\x50\x82\x30\x49\x46\x4b\xad\x41\x69\x76\x39\x3b\x27\x17\x40\x97\xb8\x34\x50\x77\x5c\x4a\x6d\x76\xe1\xb3\xe2\x9f\xd3\x35\xf4\x6b\x6f\xf8\x8d\x5b\x91\x01\x58\x3d\xda\x68\x78\xdf\xa3\x8e\x25\xce\xa0\xea\xc3\x76\xfd\x20\x81\xb0\x7d\x00\x53\x0a\xd2\x28\x76\xff\xc5\x15\xda\x9d\x19\xf8\x6e\x6b\x2d\xac\xf6\x75\xe0\xce\x41\x6a\x3c\x36\xd8\xb7\x40\xc7\xad\xeb\xf1\x3e\x92\xfc\x9d\x04\xca\x14\x0a\x46\x7a\xeb\xaf\x16\xce\xa1\x5a\x1a\x5b\x60\xbf\x93\xd0\x62\x67\xbc\xe0\xf5\x7f\xfe\xf9\xaf\x01\x90\x9b\x20\x4d\x24\xb9\x37\x6b\xe9\xc9\x02\x3b\xbb\x6f\x0b\x86\xa8\x08\x8e\x33\x3e\xe3\xbe\xde\x49\xcc\xcd\xbc\x0e\x4d\xd7\xce\x5f\x18\x7d\x8e\xbd\x86\xe6\x74\x20\xac\xa1\xc5\xf2\x80\xeb\x78\x63\xc9\x24\x2e\x3d\x23\xb5\x62\x05\x57\x9f\xf9\x91\x5a\x34\x00\x17\xf0\x21\x51\x0e\x37\xe8\xe3\x49\x66\x09\x1f\xeb\x84\x32\xb3\xa8\x6d\x70\x06\xa2\x66\x85\x2b\xc6\x34\x60\xbd\x24\xbb\x1d\xb2\x76\x96\xcd\xf1\x18\xcf\x93\x99\xb1\x0d\xcd\x6c\x41\x1a\x81\xdd\x97\xe1\x8a\x40\xc9\x07\xd1\x20\x5c\xb6\x5b\xca\xa8\x54\x82\x28\x2e\x22\xd4\x4e\x4f\x43\xa0\x1f\x3d\x8b\x5f\x5f\xf6\xbd\x37\xc6\x24\x01\xa2\x78\x98\xea\xf3\x86\x89\x4c\xa1\xc6\x74\x3f\xd5\x46\x27\xd7\x82\x6f\xaf\x11\x4d\x78\x91\xa7\x0d\x53\x21\x56\x46\x60\xed\x6a\x05\x6e\x09\x65\x94\xad\x2f\xb7\xce\xd1\x59\x58\xc5\x40\xbf\x7a\x18\x17\x3b\x66\x27\x2a\x5e\x4f\x53\x5f\x0c\xe7\xc5\x2a\x25\xa3\x0b\xf0\x75\x58\x29\x9b\x01\x7b\x89\xc7\x00\xcb\xd6\x9f\x10\x16\x2b\x26\x39\x3d\xf2\x27\xcf\x32\xf0\x05\xa5\x6c\x44\x7d\xfe\x2c\xdf\x82\x9f\x16\xb8\x95\xc2\x89\x22\x37\x98\x8e\xfd\x02\xcc\x87\xe3\x3f\xf8\xf6\x18\x10\xbe\x6b\x1c\xe8\xa0\xe5\x04\x7d\xa1\x45\xa9\x32\x54\x7f\x76\x84\xd1\x66\xf6\xe4\x8a\x30\x6d\x7b\x47\xe5\xd9\x20\x08\xfc\x9f\x01\xa5\xc6\xd8\xd2\x53\xdb\x4f\xad\x44\x56\xb1\x96\xf0\x56\xab\x5f\x78\xa3\x4d\xbb\xfe\xff\xdf\x8e\xfa\xf3\x68\x7e\xac\x96\x54\xc2\xda\x10\x42\x68\xe3\xce\x22\x33\x6d\x3c\x93\x73\x1d\x41\x59\xe5\xf2\xc9\xbc\x40\xfc\xbe\xb6\xeb\x54\x25\x7e\xf0\x7a\x56\xdf\xf7\xe9\x29\x0c\x4c\x20\x69\x36\x3a\x4a\xac\x8d\x70\x84\x79\xc7\xf0\x6e\x67\xbd\x85\x33\x70\x56\xfa\xdc\x3a\x3f\x54\xb1\x2b\x1e\x45\x49\x4f\x59\x8a\x70\xf4\x2a\x26\xb9\xf5\xce\x9f\x65\x66\xab\xb2\x86\xd6\x0a\x27\x6c\xaf\x59\x8b\x77\xda\x7a\xfc\x5b\x32\xe2\x76\x43\x7b\x4c\xc7\x9c\x4f\xcb\xd6\xd3\xa7\x47\x0b\x51\x2d\x94\x2f\xe0\x7e\x88\x17\xfe\x78\x28\xb8\x87\xcc\x27\x1a\xbb\xaa\x93\x8a\x38\x41\xa8\xc6\x98\xa9\x3d\xf6\x64\x9e\xc3\xd3\x2c\xab\x2d\xe2\xc6\xce\xbf\x37\xaf\xa7\x90\x02\xef\xe6\xb3\xd4\x22\x45\xac\x48\x3c\x26\x61\x1d\x65\xf2\x33\xaa\x14\x6b\x1f\x63\xf8\x13\x29\xc8\x17\x3c\x94\x28\x25\x9f\x49\x57\x70\x10\xfc\x21\xb7\x90\x40\x9f\x7c\xf3\x8d\xee\x22\xfe\xd4\xab\x13\xa9\x98\x3d\xe8\x43\x4a\x35\xad\xe3\x5f\x3e\xc9\xf4\x33\xf9\xf9\x23\xfc\xe3\x21\xf5\x7e\x84\x4d\xff\x7f\x67\xcf\xef\xf3\x9d\x1e\x6d\xc7\x0f\xdb\xf0\xaf\xb3\xdf\x19\xe1\x1f\x6d\xb7\xa7\x6c\xf6\x7d\x5c\x35\x3a\x1c\xc0\xda\x12\xa8\xc9\x54\x3c\xe5\xa2\x1a\x2f\x51\xc0\x63\x3d\xcd\x63\x58\x69\x12\x97\xb1\xd2\xfb\x9d\x4b\xbc\x19\x17\xb4\x41\x64\x78\x3b\x2e\xa7\x03\xa8\xe9\xe5\xa3\x1f\x13\x38\x54\x1e\x4e\x20\x54\x79\x98\xb2\x22\x04\x72\x05\x49\x12\x8c\xe7\xdf\xc0\x23\xcf\x1f\xb3\x2f\xe8\xcc\xc6\x1e\xe0\xcc\x5f\x46\x12\xcc\x3e\x41\x85\x39\x35\x12\xf3\xbe\x4d\x49\xec\xb7\xb6\xce\xb7\x36\xff\x6d\xd9\x93\x20\xb2\x3c\x9e\x59\xe9\xbc\xdf\x9c\x75\x57\xc6\x20\x59\xde\xad\xe9\x0d\x32\x60\xc3\x76\x85\x42\xdb\x33\x86\xb7\x95\x4a\xc9\x02\x76\x84\xb6\x3a\x8f\x4e\x00\xad\xf6\xf0\x79\x47\xf6\x28\x3e\x2f\x80\xb0\x56\x27\xb7\x06\xea\xd6\x9f\x7f\xf9\x5a\x60\x51\x3d\x4a\xc0\x54\x2a\x49\x49\x05\x69\x99\x8c\xd6\x76\x58\x63\x19\x60\x6e\xc8\x8d\x49\x2a\xbe\xe0\x5e\x5a\x34\x04\x02\x67\xfd\xde\xc9\x98\xa9\x38\xa9\x8d\xe0\xc3\x7a\x93\x40\x8a\x8a\x30\x69\x6d\x52\x87\x3e\xbe\xc0\x52\x56\x7f\x16\x09\x90\xb8\x3e\xd3\x0d\x7d\xbf\xb7\xd5\x1f\xde\xf7\x95\xfa\x91\x2f\x0c\x2d\x27\xd5\xc0\x66\x41\x45\x5a\xd5\x58\x9b\xfa\x9a\x29\xcd\x8a\x3d\x0a\x57\xc1\xb4\x85\xd7\xf7\xa4\x1f\x10\x7e\x85\xb1\x8e\xe8\x26\xe6\xda\xb2\x13\xb5\x18\xa5\xf1\x2e\xf3\xac\xea\xbd\x9f\x04\x79\xab\x23\x77\x66\x59\x12\x44\xa8\x52\xb1\xd5\x36\xc1\xcc\x85\xed\x20\x15\xac\x4c\x29\x90\x2a\x7a\x83\x4f\x0e\xf9\x97\x87\x72\xd0\xbc\xa6\xda\x73\xd2\x9e\xff\x5f\x96\x50\xe1\xe5\x4b\xf8\xf0\x71\x02\xe9\xcb\x89\x8a\xd7\xc7\xa2\x36\x85\x77\x54\x2a\xca\xd6\x57\x5f\x55\xa3\x4a\x17\xf3\x85\xaa\x09\x98\x53\x05\xab\x22\x5f\xa2\x3a\xde\xae\xe4\x48\x54\x27\x46\x13\xb1\xb4\x9e\x43\x8b\x68\x0d\x46\x53\x3b\xe6\x40\xee\xdb\xcc\x09\xb3\xf9\x57\x06\x8f\x86\x96\x64\x07\x17\xd1\xe4\x65\xac\xaa\x41\x04\xa8\x94\x03\x7e\x1b\xbf\xf3\xda\x45\xa0\x6d\x44\xfe\x50\x06\x24\xbb\x12\xdb\x3a\x23\x1a\xb2\x3b\x8a\xe8\x93\x52\x2d\xc9\x0d\xe6\x75\x47\xb9\x00\xc5\x0f\x16\xf7\xcb\x40\xed\x88\x73\xda\x74\x07\xf3\xac\x8c\x19\x9d\x15\x5c\x82\x54\x62\x68\x14\x6c\x78\xdf\x52\xb6\xae\xc5\x62\x0c\xb1\xb5\xd1\x70\x43\xfa\x66\xe8\x89\x0a\x21\x81\x2c\xca\x9e\x0e\x5c\xe2\xce\x92\xa2\xa7\x35\xfc\x71\x28\xa1\x63\xeb\x41\xda\x15\xb6\xe4\x4b\xe1\x3f\x24\x08\x94\x3b\xce\xcc\x80\x0d\x5d\x6f\x40\xdb\x07\x09\xdc\x9a\x75\x86\xea\x96\x8b\x2f\x55\x4b\xac\x15\xa0\x12\x7c\x14\xe8\x98\x2c\xa0\xe1\xd6\xc9\x71\x86\x30\x30\x6a\xbe\x07\xdf\x0f\x68\x9c\xb8\x4d\x1f\xae\xdf\xff\x49\x23\x6d\xd1\xb2\x7e\x49\xab\xbf\xd9\xad\x26\x62\x31\x6b\x12\xb9\x03\x11\xea\xf1\x48\x86\x40\xe3\x51\x48\x16\xb3\x26\x91\x3c\x10\x29\x8f\xe2\x49\x19\x55\xdf\x39\x0a\x4f\x0d\x93\x39\x28\x88\x16\x80\x8b\x98\xb5\xe5\xd0\xca\xb2\xda\xac\x95\x4f\xcb\xa9\x15\xa4\xe0\xa2\x86\xea\x94\x62\xc1\x7b\x14\xb4\xdb\xff\xac\xad\xa1\x2b\x39\xbc\x41\xa9\x13\x32\xc3\x11\x9d\x3c\x8d\x51\xc5\xcd\x38\x56\xba\xc1\xd7\x5c\xbc\x1d\x95\xe0\x55\xe0\x54\xad\x95\x22\x56\xbb\xc9\x65\x93\x63\x87\xb7\x62\x40\x9d\x82\x9a\xa3\x3c\x3d\x16\x36\x44\x82\x1c\xba\x8e\x36\x14\xd9\xd8\x04\xa1\xe5\xc5\x24\xba\x91\x42\x8e\x62\xa3\x0d\x02\x67\x8a\xb2\xa1\x9e\xa7\x59\xa3\xef\xb0\xc7\x68\x3f\x67\xf0\x13\xe7\x7d\x82\xd1\x06\x41\xe7\x1e\xdb\x61\xeb\x30\xf2\x28\xe8\x6c\x9c\xea\x20\xee\x68\x5c\x96\x29\x60\x2a\xe1\xc6\x84\x55\xd4\x9c\xcf\x51\x47\xf8\xe3\x89\x5e\xd7\x0b\xbd\x39\x8f\x9b\x9b\x5f\x51\x5c\xbb\x31\x72\x67\x36\x56\x98\xb5\xd9\x68\x27\x3a\x7b\xb6\x3f\x6e\x48\x3f\x98\x17\x1b\x6e\x08\xd3\x12\x3c\xc4\xe1\x3b\xcc\xa8\x3b\xda\xf5\x45\xae\x42\xb5\xbd\x85\x9d\x4f\x6e\xc5\xe1\x18\xed\x5f\xbb\xb0\x09\x2d\x3f\xc0\xd4\xc5\x14\x51\x16\xf0\xc0\x1a\x35\x6d\xaf\x2e\x04\x17\x75\xa9\x2a\xa7\x67\xa8\x98\xa2\x5a\xf2\xa4\x9c\x52\xc7\x11\x2e\x26\x90\xcf\x33\xb3\xd1\x00\x3c\x42\xa9\xa9\xd4\x8e\x35\x4e\x33\xde\xff\x09\x56\xd8\x71\x11\x38\xc9\xd6\x40\x62\x41\x08\x8d\x63\xaf\x95\x5d\x89\xa2\xcb\xc9\x72\x89\x31\xda\x34\x1e\x29\xeb\xc5\x8c\x0b\x45\xa6\xd3\xa8\x58\x6f\xea\x9a\xb5\xf0\x2b\xe9\x84\x4c\x44\x9d\x05\x5e\xaa\x43\x2f\x40\x2e\xde\x01\xc7\x99\x0e\x84\x84\x8d\x2e\x6c\x3a\x99\xfb\x47\x03\xdc\x03\x2c\x84\xd7\x75\x15\x44\xf0\xe7\x1e\xf6\x49\xa4\x66\x39\xb7\xa3\x1d\x7b\xbd\x27\x0e\x75\x67\x69\x7c\xce\xe8\x47\xfe\xe8\xc1\x7d\xbd\xca\x82\x49\x8b\xef\x8a\x4d\xcc\xeb\xe7\xef\xc7\x4b\xc9\x2c\x88\xda\x27\xcb\xd3\xcb\xa6\x51\x65\xf2\x38\x86\xc1\x8b\x48\x67\xeb\x3d\x99\x61\xc0\x96\xdc\xbd\xaa\xb7\x67\x9a\x21\xf3\xb3\x23\xfd\x8a\x6f\x5f\xf3\xd4\x73\xb1\xdb\xb4\x01\x1f\x4d\xab\x0e\x33\xb6\xe4\x2e\x55\x2f\xdf\x1d\xd0\xf0\xed\x6e\x50\xa6\xd5\x77\x56\x6c\x26\x7b\x50\xe9\x34\x2d\xb7\x97\x36\xb7\x05\xb4\x9d\x58\x04\x8c\x73\xdd\x39\xb8\x03\x63\x45\x2d\x84\x37\x85\xdd\xc9\x1a\x96\x97\xae\x51\xc4\x94\x5c\xdf\xa0\x44\x71\x13\x46\xcf\x02\x77\xc7\x6c\x63\x64\x65\x37\xb9\xc6\x85\x63\xd9\xec\x79\x5e\x2c\xd0\x66\xa2\x0b\xa5\x71\xb3\xb3\x48\x37\xfe\x86\x82\xeb\xc4\x55\x01\x12\xd1\xef\x97\xb5\x3a\xef\x14\xfb\x67\x65\xea\x54\x77\x0d\x4a\x0c\xb8\xa8\x9c\xfc\x65\xae\xa2\xbe\xb7\x72\xe2\x94\x27\x29\x24\x28\x99\x39\x51\x47\x9b\xea\xba\x34\x7c\x18\x7b\x7a\x6c\xbd\x3e\x9e\xf7\xda\xe6\x1f\xd8\xae\xd1\x1e\x88\xdf\x6e\x50\x60\x14\x55\xb5\x1c\x25\x53\xb6\xae\x45\x7c\x1f\x90\x12\x48\xd2\xf5\x88\x84\xe7\xa9\x26\x8c\x4d\xa8\xf1\x49\x99\x3b\x2f\x54\xe1\x0c\x0f\x2e\x46\x53\x90\x9f\x15\x7e\xe3\x71\xe0\x88\xc1\xb8\xdc\xa1\x6e\xcb\x47\x09\x8a\x33\xd8\x79\x60\xb3\xf2\xee\x0e\x5b\xa0\x2a\xe6\x41\x24\xae\xc5\x21\x4c\xa1\xb5\x49\xf3\xd4\x03\x72\xe9\x01\xbf\xb8\x38\x4a\xf6\xbe\x4a\x60\x8f\x16\xd6\xa2\x91\xef\xca\x46\x05\xa6\x5f\x2e\x71\xff\x9a\x10\x72\x58\xb9\x7e\x3a\xc5\x5d\xcb\xbe\xcf\xca\x03\x80\xd0\x14\x74\x39\xa8\x8d\xaf\xd9\x98\x30\x61\x9c\x4d\xeb\x4d\xc5\x16\x62\x8a\xe0\xec\x93\x1e\x76\xd8\xeb\x3c\xfe\x06\x40\x24\x79\x5a\xf6\x3b\xc4\x70\x06\xfa\x3d\xac\x3f\x4e\x9a\x7e\xda\xc5\x8b\x1d\x61\x3f\x05\xba\xee\x3d\xeb\xd9\x02\xdd\x17\xd0\x72\xd3\xd3\xe7\x43\xa9\x45\x3e\xdf\x14\x97\xad\xda\x8f\x40\x06\xc6\x50\x13\x9d\x08\xda\xef\x43\x77\x64\xd4\x34\x99\x2a\x57\x4d\xed\x0a\x7b\x40\x2a\xa6\xe0\xbb\xb6\x0d\x24\x88\xbd\x7c\xe9\xcf\x1f\x43\xd1\xab\x26\x37\x67\xf0\x8e\x99\xbc\x44\x71\xd7\xc9\x0b\x02\x3b\x14\xa8\x55\xcf\x85\x84\xde\xcc\x9a\xdd\x58\x5b\xf9\x64\x7e\x32\xc1\xac\x17\x15\xab\x54\x61\x9a\x3b\x93\x1d\xd4\x60\xcc\xb2\x8e\xb3\x79\xab\x8d\x8a\xda\xc0\x2d\x35\x97\x2c\x6e\x74\xaa\xa9\x1d\x23\x69\x36\xd8\x16\x7c\x23\xd2\x9b\x20\xdb\xa1\x29\x10\x06\xd6\x62\xe8\x42\x09\xd6\xc7\x23\x61\xc1\x72\x67\xf4\xd5\x06\xa9\x88\x15\x57\x86\x4b\x18\x40\xb5\x4e\xbb\x1e\xcd\x3c\x7b\x89\xda\x00\x1d\x22\x57\x83\x10\xc8\x54\xbf\xb7\xfb\x70\x37\x3a\x7c\xd5\xac\x23\xb4\x2f\x22\xff\x19\x91\xe1\x6e\x8a\x75\xfe\xae\x10\x64\x4a\xea\xde\xcb\x4d\xc5\xc2\x3d\x5f\xd3\x66\x5e\x10\xe4\xa7\x41\xc5\xe5\x3a\xa1\xb9\x8d\x35\xef\x79\xe3\xb8\xa9\x3d\xd8\xc2\x17\x1b\xec\xc3\x0d\x91\xd0\xa3\x94\x51\xc2\xea\x27\x8f\x47\xeb\xd5\x64\xc2\x7f\x62\x1b\x71\x9c\x7f\x72\xbd\x3b\xef\x7d\x4b\x5d\x34\xab\x68\x85\x08\xd0\x23\x5b\xe1\x2c\x91\xe1\x96\x96\xf3\x3f\x33\x53\x85\x0d\xe7\x3a\xe7\xcf\x02\xf8\x34\xb8\x7c\xeb\xea\xa9\xbe\x74\x1a\x39\x3b\x81\x0d\x67\xb6\x70\x83\x2d\x0c\xd2\x97\x5f\x5b\xa2\x88\x37\xdd\x54\xba\xcb\x39\x3a\x43\x72\x77\x41\x7e\x4e\x8e\xc9\x0d\xed\x7b\xde\x7c\x19\x2f\x1e\xd9\x19\x1b\x53\xb7\x76\xd7\xb8\xc0\x17\x8f\xab\xb7\xb7\xc2\x8e\x2b\xe6\xfb\x2b\x2c\x2c\xa4\x6e\xcc\xd6\x7b\x77\xbb\x9e\x62\x1b\xae\x09\xf5\x3d\xac\xb9\x96\x0f\xce\xb0\x76\xc1\x29\x4f\x19\x1b\xab\x00\x87\x8f\xa7\x3c\x91\xfc\xe0\xc4\x65\xb2\x16\xef\xc6\x8e\x6a\x9a\x59\xf4\xb4\x93\x71\x11\x0a\xdc\x16\xd5\x55\xd4\xa7\xee\x8f\x13\xc7\xcb\x22\xb1\x03\xb5\x17\x17\xcc\x09\xe0\xb4\xb4\x7c\x0a\xb7\x9e\x8e\xba\xc1\xf4\x77\x70\x75\xe7\xc0\xbd\xa9\x9b\xd2\xaf\x3c\xe0\xf0\xee\x7c\x73\xd1\x1a\x63\x4f\x63\x9e\x46\xad\x01\xa6\x8d\x2d\xed\x46\x7a\xcd\xd4\xcc\x4f\xff\x07\x78\xf7\x9a\xa9\x7f\xfe\xa7\xe2\x48\xc6\xef\x63\x9e\xc6\x0a\xdf\xab\xfd\xcf\x81\x72\x87\xe5\x22\x6d\xac\x4b\xce\xc4\x7c\x28\x9f\x09\x89\x6f\xd7\xbf\x7f\x31\x3b\xb5\x57\xed\x46\x27\xed\xdf\xd5\xda\xfb\xfc\x8a\xc7\x70\xa0\xc2\x05\x48\x1b\xe2\xe1\xa0\x90\x59\xdd\xa2\x12\x08\xec\x04\x5f\xf5\xb8\x75\x9e\xdf\x96\xb2\x12\x01\x3c\x79\x9c\x90\x54\x7a\xed\xc3\x3d\xa1\xb2\x51\xe4\x6c\xf2\x28\xea\xd0\xa5\x9e\x86\xef\xf6\xe7\xc9\xc4\x22\x2e\x32\x71\xd3\x5d\x32\x66\x5e\x0b\x88\x72\x94\xce\xe0\x95\x10\x5c\x68\x54\x4d\x5d\x4f\xdd\x65\xe7\x6d\xe6\xf4\x66\x6f\x4e\xdd\x4c\xc0\x84\xa6\xe8\x4a\x49\x4f\xff\x86\x2d\x74\x54\x48\xe5\xdb\xb9\x52\x52\x8c\xa6\xa7\xe8\x27\xf9\xa4\xd7\x88\x71\xc8\xf0\x4e\xca\x38\xb8\xdd\xa9\xbd\xbf\xd2\xe5\x0b\x91\xb7\x82\x1a\x74\x19\xde\x66\x14\x55\xdc\xe7\x10\x81\x87\xd5\xf3\xfc\xc7\x93\xf3\x30\x50\x73\x9c\x9a\x6c\x6b\x91\x1c\xa5\x1e\x84\xe8\x7d\xe1\x03\x77\x85\x13\xf0\xcb\x87\x1b\x87\xd2\xf1\xc7\x37\x0e\xa5\xf3\x26\x1b\x87\xee\x4f\x42\x7d\xd9\x65\x45\xd5\x60\xc9\xde\xc2\x8d\x1d\x61\xde\x5d\xe7\xfc\xa4\x6d\x1a\xca\xd0\x34\x6f\x33\x14\xa6\x35\xee\x60\x76\xf6\x50\xea\x37\x71\x55\xd5\x60\x1a\xbc\x5c\xa5\xf9\x2b\xcb\x7f\xc6\x7b\x92\x76\x66\x72\x4a\xf8\x7b\x98\x15\x3b\xfc\xbd\x1f\x58\x3b\x23\xfc\x31\x47\x77\x1c\x5e\x3b\x17\x9c\xe7\x06\xa5\x7a\xb9\xd2\x9c\x9b\x64\x7a\x66\xdb\xb7\x80\x58\x9d\xf2\x16\xdc\x5e\xf4\x35\x4c\xd0\x12\x0e\xd4\xb4\x8f\x38\xa1\xae\x98\x4a\x7f\x41\xc4\xfa\x06\xeb\xc5\x5f\x69\x0d\xb6\x37\x7a\xcc\x98\xb7\xfb\x1d\x9e\x81\xfe\x7b\x9e\x5f\x7e\x7e\x31\x9b\xcf\xeb\xb7\xa2\x53\x3f\xda\x6e\xa9\xe9\xf7\x74\xed\x3f\xc9\xa5\xa6\xd9\x31\x9a\x7a\xfe\xcc\xc0\xc8\xfa\x1d\x3a\x67\x26\x0d\x3c\x2f\xe4\xf7\x27\xff\x1b\x00\x00\xff\xff\x3e\xfe\x4a\xec\x13\x42\x00\x00"
-
-func flowfeesCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_flowfeesCdc,
-		"FlowFees.cdc",
-	)
-}
-
-func flowfeesCdc() (*asset, error) {
-	bytes, err := flowfeesCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "FlowFees.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x34, 0xdf, 0xd8, 0xc3, 0xe7, 0x23, 0x36, 0x4c, 0x9e, 0xb5, 0xb1, 0xa9, 0xe5, 0x43, 0xc6, 0xd2, 0x1e, 0xa5, 0xe4, 0xdb, 0x44, 0x35, 0x58, 0x51, 0xb6, 0xaf, 0x90, 0x85, 0x3b, 0xfa, 0xdd, 0x11}}
-	return a, nil
-}
-
-var _flowidtablestakingCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\xeb\x72\x1b\x47\xb2\x30\xf8\xfb\xf3\x53\x94\xf4\x43\x02\x6c\x10\x24\x75\x1b\x9b\x21\x6a\x86\x26\xad\x73\xb8\xb6\x25\xad\x28\x8f\x77\x3f\xd9\x61\x17\xbb\x0b\x44\x1d\x35\xba\x31\x5d\xdd\x84\x70\x64\x45\xec\x43\xec\x13\xee\x93\x6c\x54\xd6\xfd\xd6\xdd\x00\x41\x59\x67\x66\x18\x61\x8b\x04\xba\xb3\xb2\xb2\xaa\xb2\xf2\x9e\xfb\x5f\x7e\xf1\x05\x42\x08\x3d\x2f\xaa\xd5\xf9\xd9\x1b\x7c\x59\x90\x8b\x06\xbf\xa3\xe5\x95\xf8\xfc\xcd\x9c\xc0\x77\xe8\xfc\x0c\xc1\xb7\x08\x97\x39\x92\x8f\xa0\xac\x2a\x9b\x1a\x67\x0d\x5a\xe0\x12\x5f\x11\x06\xaf\x94\x55\x4e\x50\xb5\x24\x35\x6e\xaa\x9a\xdd\x87\x17\x72\x52\x90\x2b\xf9\x37\x2d\x67\x55\xbd\xc0\x0d\xad\x4a\x78\x9e\x7f\x0f\x43\x34\xd5\x3b\x52\x32\xd4\xcc\x71\x83\x70\x4d\x10\x6b\xf0\x3b\x92\x23\xcc\xd0\x12\xd7\x0d\xaa\x66\xa8\x51\xd8\xbc\xaa\xab\xa6\xca\xaa\x62\x2a\xb0\x7c\x51\xe5\x84\x21\xd6\x5e\x2e\x68\xc3\x1f\xa2\xb5\x78\x19\x35\x15\xbc\xb3\x6c\x2f\x0b\x9a\x21\x9c\xe7\xfc\xc9\xf3\x72\x56\xa1\x59\x5b\x66\x1a\x85\xbc\xad\xf9\x74\xf8\xa3\x4c\x4e\x0d\xb7\xf0\x35\x5a\xce\x31\x23\x53\x45\x0c\xca\x50\x4d\xb2\xaa\xce\x99\x1c\x86\x4f\x06\x66\x90\x55\x8b\x05\x6d\x1a\x92\xcb\x69\x4c\x39\xe9\xd6\x08\x17\xac\x42\x2b\x5a\x14\xe8\x8a\x34\x08\x03\xa6\x00\xeb\xe5\xe5\x7f\x91\xac\x11\x93\x6d\xf8\x93\x19\x2e\x51\xcb\x00\x65\xc0\x7d\x82\xda\x52\xfe\xc2\xe1\xaf\x68\x33\xcf\x6b\xbc\x42\x35\x59\xe1\x3a\x67\x12\xa5\xef\x70\x36\x17\x14\x9f\x63\x86\x16\x6d\xd1\xd0\x65\x41\x04\x0e\xe8\xb2\xcd\xde\x91\x46\x52\x74\x5e\x15\xb9\x44\x5a\x60\x08\xef\x5f\x62\x46\x72\x54\x95\x86\x6a\x4d\xcb\x8e\xcc\x6c\x26\x72\x15\x14\x36\xb4\xbc\xd2\x88\xe5\x02\x33\x81\x10\xc9\x25\x46\x67\x7a\xa9\x61\x4a\x40\x80\x9a\x5c\x51\xd6\x10\x3e\xb2\xda\x0a\x04\x3d\xff\xe1\xe5\xcf\xfc\x03\xec\xee\x98\x41\x0b\x82\x2e\xd7\xa8\x65\xea\x11\x05\xfe\x05\x59\xe9\xd1\x47\x63\xbd\xc4\x53\xb5\x93\xd7\x68\x8e\xaf\x89\x80\x8a\x17\x51\x2a\xb9\x9b\x17\xe5\xd5\xd4\x9c\x83\x93\x7c\x41\x4b\xa0\x33\x87\x80\xdb\x66\x5e\xd5\xb4\x59\xf3\x39\xd4\x64\x51\x5d\x13\xf1\xb6\xdc\x20\x13\x78\xaf\x26\xb3\xb6\xcc\x11\x2d\x59\x3b\x9b\xd1\x8c\x92\xb2\x29\xd6\x6a\x67\xf3\xc7\xd9\x04\x2d\xf1\x5a\xad\xea\x44\x9f\x08\x80\x27\x4f\xc4\x25\x69\x56\xc4\x60\x0a\x5b\x8b\x11\xb1\xad\xe6\x78\xb9\x24\x25\xaa\xca\x8c\x20\x72\x4d\xea\x35\x22\xcb\x2a\x9b\x4b\xb4\x2f\x08\xe1\xbb\x9e\x72\x32\xe0\x42\xd3\x32\xaf\xb2\x76\x41\xca\x06\x0e\x21\x9a\x93\x9a\x1c\xa1\x79\xd3\x2c\xd9\xd1\xfe\x7e\x5e\x65\x6c\x5a\x95\xb3\xa2\x5a\x4d\xab\xfa\x6a\x5f\xbe\xb3\xff\xc5\x17\xe8\xcb\xfd\x2f\xbe\xa0\x8b\x65\x55\x37\xe8\xee\xf3\xb6\xbc\xa2\x97\x05\x79\xc3\x51\xbc\x6b\x3e\x2e\xaa\x95\xf7\xd1\xb7\x6d\x5d\x92\xda\x7d\xe4\x39\x21\x4c\x7f\x72\x5a\xaf\x97\x4d\xf5\xc5\x17\x38\xcb\x08\x63\x23\x5c\x14\x63\xc3\x59\x42\xc6\x84\x3e\x88\xb9\xed\xef\xef\xa3\xef\xf8\x5c\x05\xcd\xac\x97\xc9\x35\x29\x1b\xf4\x82\xac\xe0\xeb\x51\x53\x35\xb8\xb8\x00\x92\x1f\xa1\x9f\x9e\xd3\xf7\x4f\x1e\x4d\x10\x7c\xf8\x1a\xa8\xfe\x0a\xaf\xab\xb6\x31\x5f\x95\xf2\xc5\xd3\xaa\x2d\x1b\x52\x1f\xa1\x9f\xce\xcb\xe6\xc9\xa3\x71\x62\x1c\x78\xf6\x8d\x01\xc7\x5e\x61\x9a\x8b\x41\x0d\xcc\x59\x5d\x2d\xf8\xa4\xcd\x27\x0b\x5a\x36\x36\x42\x33\x42\x18\x90\xca\xfa\x8c\x58\x68\x3c\xaf\x6a\x09\xdf\x20\xa4\xe9\xa0\xd9\x4a\x94\x0c\xfc\xdb\xd3\x9a\xe0\x86\xe4\x23\xbe\xe7\xce\xcf\x8e\xd0\x45\x53\xc3\x59\xae\xab\x82\x08\x80\x5f\x4f\x10\x5e\xf0\xb1\x4e\xd5\xe1\x57\x88\xa4\x66\x0e\x0b\xcd\xf4\xe3\x01\x6c\x01\x6e\x18\x14\xb1\x3e\x5b\x82\xe0\x13\x14\x60\x5e\x93\x7f\xb4\x84\x35\x24\x7f\x53\xfd\x24\xb8\xd4\x8d\xb0\xfa\x49\x31\xbd\x1d\x40\xb9\xd1\xec\x5e\x03\x83\xc9\x4f\xca\xfc\x35\x70\x94\xad\x81\xd9\x7b\xb4\x1b\x82\xbb\xfd\x8e\x50\xcf\x31\x50\x93\x14\x53\xfe\x59\x5e\x57\xe5\x8d\xd0\xdc\x0d\xac\x17\xa4\x59\x55\x35\x5f\xc5\x93\x3c\xaf\x09\x63\x3f\x2d\xf3\xe8\x59\x28\xc9\x4a\x3e\xa1\x3e\xeb\x5a\x92\x9f\x09\xbd\x9a\x37\xa7\x73\x5c\x5e\xc5\x61\x89\x07\x22\xa7\x55\x5f\x52\xe9\x23\xab\x1f\x49\x9d\x5b\x2d\x51\xf1\x0f\xf9\x08\x0f\x1f\xa4\x90\xd5\xb0\xfa\xce\x6b\x04\xe6\x50\x1a\x7b\x63\x24\x4e\xf3\xee\x06\x18\x70\xce\x77\x37\x58\x9a\x0b\xec\x7a\x8c\x5b\xa2\x59\xd7\xa1\x1f\x00\x7f\x43\x4e\xa0\x87\x1d\xca\x12\x76\x37\xc5\x9d\x8c\xa4\xcf\xe9\xa9\x16\x42\x28\x29\x72\x36\xe4\xb4\xb6\xcd\x2b\x52\x67\x5c\xb4\xba\x22\xa3\x92\xac\x9c\x0f\xfa\x19\xd5\xea\x67\x42\xde\x15\x6b\x21\x91\xf0\xf7\x5d\xd9\xa4\xe3\x45\x29\x1c\xfd\x48\x4b\xba\x68\x17\x8c\xbf\xab\x7e\x3f\x42\x1f\xe0\x7e\x57\x50\x3e\x76\x80\xd1\x33\x71\xe1\x59\xe0\x22\x74\xfa\xcf\xaa\x10\x0a\x11\xa2\x39\x29\x1b\x90\x89\x41\x57\x9c\x55\x35\xc2\x45\x01\x5f\x81\xb0\x8b\x68\x29\xfe\x10\x2c\x79\xaa\x41\x9c\x97\x59\xd1\xf2\x07\xc4\x63\x4a\x07\x2c\xef\x37\x08\x67\x0d\xbd\x26\xc5\x1a\xb4\x40\x9a\xd1\x25\x6e\xb8\x7a\xaa\xde\x7c\x47\xd6\xe8\x58\x48\xde\xe7\x67\xf6\xc4\x94\x08\x39\x46\xd7\xb8\x16\x70\x8f\xd0\xdf\x3e\x88\xad\x70\x24\x6f\x55\x2e\xaa\x7f\x34\x33\xe1\x62\xfe\x42\xcc\x53\x6e\x0b\x50\x3b\x2d\xc5\x94\x70\x85\x0b\xb6\x75\x8d\x9a\xf5\x52\xe8\x5d\x4a\x67\xd3\x70\x68\x89\xaa\x3a\x17\x0a\xcf\x25\xe1\xd2\x2c\xa3\x39\xa9\x49\x8e\xae\x71\x41\x73\xfd\xdc\xf7\x64\xcd\x8e\xf4\x5f\x87\x68\x0f\x9d\x56\x45\x41\xb2\xa6\xaa\x85\x52\xab\xbf\x7b\x00\xdf\x95\x8c\x94\xac\x65\xde\x77\x0f\xd1\x1e\xfa\xee\x3d\xc9\x5a\x10\xe7\xdd\xef\x1e\xa1\x3d\xf4\x77\x52\xd3\x19\xcd\x70\xe4\xeb\xc7\x68\x0f\x9d\x00\xbd\xac\x2f\xd4\xce\xc8\x32\x4e\x01\x41\x3f\x49\x15\x60\xec\x9c\xf9\xd2\x9a\xcb\x87\xde\xc6\x72\x09\x09\x22\x70\x82\x8c\x96\x7e\x1f\x6c\x12\x0d\xa4\x9a\x09\x72\xc3\xe2\x02\xb1\x2d\xbd\x30\x6b\xeb\x9a\x6f\x5a\x12\xa8\x00\x36\xda\x80\x83\x7d\x27\x7d\xbb\x06\x61\x71\xbd\x24\x37\xc0\x1e\x34\xaf\x4b\x82\x96\x98\x82\x7d\x42\x6a\x6f\x12\xbd\x66\x03\xfc\x88\x50\x1e\xde\x91\xd2\x3d\xe9\x2e\x2e\x35\x5f\x3a\x65\x00\x59\x01\x8b\x40\x58\x0c\x69\x36\xa5\xa1\xd2\x15\x69\x0c\x11\x5f\xbc\x7c\xf3\xdd\x11\x3a\x15\xd8\x14\x6b\x44\xf9\x19\x6b\x50\xcb\x48\x9e\x3e\x2d\x62\x42\xaf\xf9\xb0\x21\xfb\x70\x71\x5b\x6a\xf6\xc6\x11\x54\x94\x10\x68\x81\x3e\xea\xa8\xd4\x88\x2f\x02\x03\x75\x48\x43\xe1\x93\x6a\x19\xa9\xad\xad\x21\xf9\x34\xac\x75\x85\x68\x93\xa4\x1f\x07\x7e\xa6\x1f\x16\x97\xc0\x69\x8c\x8c\xaf\x70\x33\x67\xb0\xd7\x58\x53\xc1\x26\x52\xca\x70\x4d\x58\xd5\xd6\x19\x09\xf9\x7b\x41\x84\xa4\x07\x3b\xa7\xbe\x68\xaa\x1a\x5f\x11\x0e\x88\x5f\x28\xfa\x8f\x9e\xd7\x5e\x81\xed\x49\xbc\x65\x7e\x8f\xbe\x24\x79\x2e\xd8\x19\x36\x1a\xcd\xe2\xda\x89\xb7\x04\x1d\xbe\x34\x3f\x71\x83\xde\x69\xb5\x58\x56\x8c\x36\x04\xf1\x13\x82\xce\xc8\x8c\x96\x60\x3c\x60\xe8\x4b\xfb\x67\xdf\xbd\x24\x31\x2d\x99\x6d\xd7\x13\x0b\x49\x19\x62\x4b\x92\x71\xd6\x63\xd9\x79\x68\x09\x3a\x7d\x30\x0f\xb5\x0c\x16\x63\x56\x9a\xbe\xbd\xdd\xda\x92\xfe\xa3\xe5\xac\x5e\x1d\x87\x52\xa9\xbe\xea\xa9\x0b\xd2\xa0\xd5\x9c\x94\xfa\x5b\x8e\x48\x26\xc4\x68\xfd\xa0\x4f\x41\x9a\x2b\x21\x21\x1c\x12\xce\x54\x35\x73\x47\xb2\x01\xc0\x89\x31\x7a\xf4\x17\xc9\x87\x4a\x5f\x13\xd1\x83\xf6\xbf\xf1\x3d\x59\xf7\x3e\x2d\xb7\xb4\xfd\x68\x38\x1b\xe0\x6b\x36\x37\xab\xca\x62\x8d\x9a\x39\x15\x97\xaf\x62\x5c\xc5\x1a\xae\x36\x65\xe9\xe3\x3c\x83\xc2\x25\x0d\x06\x24\x6d\xe4\xf3\xe0\x53\xc6\x6f\xb8\x96\xa0\x45\xcb\x1a\x84\x8b\x15\x5e\x33\xce\x29\xf1\x65\x25\x2d\x6f\xea\x7a\xad\xc5\x25\xb2\xe0\x3c\x52\xdc\x9f\xda\x2e\xc6\x2f\x84\x2c\x23\xcb\x26\x36\x8e\x9c\xf3\x02\x2f\x97\x1c\x93\x73\x29\x71\x28\x76\x6f\x38\xfd\x11\xfa\x9b\xb6\x46\x4d\xff\x8e\xdb\xa2\x89\x11\xc3\x90\xc1\x50\x80\xcf\xdb\xb6\xe7\x4a\x63\x32\x67\x1e\x42\x78\x79\xdf\x28\x2b\x9b\x0d\xf0\xc7\xea\x9a\x30\x65\x72\xb6\x31\x91\x86\x3b\x24\x4c\xbd\x88\x94\x39\xdf\x4e\xb8\xb4\xee\x86\x81\x33\xb3\x4c\x33\x37\x99\x9c\x32\xe0\x02\x1f\x16\x16\xf2\x9a\x5c\xd3\xaa\x65\x1e\x4a\x89\x69\x29\xc1\xbe\x7b\x62\xd3\x4d\x66\xa6\x75\xac\xde\x99\xf5\xcf\x8a\x73\x34\x7e\x8b\x00\x7b\x6b\x2a\x63\x3b\xe7\x6c\x81\x5f\x4a\xc2\xe2\xbe\xc2\x65\xb3\x39\x8a\xfd\xb4\xbf\xd0\x17\x8b\xb8\x0b\x39\x2a\x20\x29\x00\x0d\x29\x93\x54\xdb\x64\xe8\xd7\xd2\xc6\xde\x37\xf4\x0f\x94\x81\xb0\x62\xce\x8d\xdc\xb5\x8a\x4e\x8e\x95\x3d\xc6\x08\xcd\x9b\x5c\x58\x16\x2a\xd2\x91\xad\x66\x59\x12\xb3\xbd\xd9\x68\x99\x89\xc3\x0c\x53\x38\x03\xf9\x42\x58\xc6\xa5\xe9\xbf\x24\x2b\x0b\xb8\x7b\x72\x84\x8c\x62\x29\x67\x82\x68\x2c\xab\x96\x24\xe7\x12\x86\x40\xfe\xff\xfb\x7f\xfe\x5f\x21\x2c\x88\x3b\x40\x28\x1b\x33\x4a\x6a\x21\x43\x1a\x00\x88\xba\x7c\x09\x76\x38\xa6\x35\x92\x0a\xa1\xa3\x08\x8e\x05\x73\xb3\x3e\x41\xb8\xa8\x4a\x32\x45\x6f\x56\x95\x4d\x49\xdc\xa0\x9c\xce\x66\x84\x73\x47\x07\xbc\xd0\x59\x16\x9c\x81\xcd\x39\xde\xda\xc1\x60\xc3\xe4\x9b\xb0\x6a\x1b\x84\xcb\x35\xca\xaa\xa2\xa0\x8c\x5f\x94\x55\xcd\x95\x83\x59\x41\xb3\x26\x38\x2e\x9a\xad\x5b\x60\x1c\x9b\xf4\xc3\x07\xe1\x3a\xc4\x05\x56\xf7\xa0\xd4\xca\x78\xc2\x17\x48\x9e\x9a\x08\x77\x4b\xe2\xd3\x24\x4c\x30\xae\xd4\xa5\xb0\x12\x66\x30\x2e\x26\xe7\xa4\x21\xf5\x82\x96\x9c\x71\xac\x85\x13\x45\x60\xab\xce\xed\xac\x11\x67\x33\x70\xfa\x8c\xcc\xa5\x24\xef\x94\xc3\x83\x83\x71\x12\x41\x10\x5a\x70\xe1\x1a\xe0\x0c\x56\xfc\xeb\x91\xfe\x0b\x3e\xd1\xd7\xff\xc4\xf9\xdc\xb6\x8e\x3b\x5f\x24\x6f\xf2\xd4\x63\xd6\x9d\xec\x3e\x12\xde\xd9\xa9\xef\x5f\x55\xaf\xe2\x8f\x84\x77\xc3\x07\xc7\x3d\x23\x38\xc5\x47\xfd\xce\x18\x7d\x70\xde\x5f\xd6\xc4\xfb\x44\xd0\x64\x5a\x90\xf2\xaa\x99\xa3\xe3\x63\xf4\xe4\xd1\x91\xf0\xdc\xb8\xbe\x98\xa9\x11\xd6\xa6\x9c\xaa\x42\xad\xe6\xe7\x5f\xbe\x0a\x62\xc0\x25\x41\x0f\x1f\xa0\xcb\x75\x43\x18\x1a\x3d\x79\x84\xe6\xe4\x3d\xca\xe6\x98\xab\x1b\xa4\x66\x63\x74\xd9\x36\xe8\xaa\x6a\xd0\x2f\x23\x3d\xe6\xf8\x6e\x80\x4f\x64\x74\xca\xfe\xce\xd5\x69\x70\xea\x9e\x8d\x68\x3e\x1e\x88\xe4\x1b\x25\x19\x9e\x9f\x09\x0c\xc1\x2f\x08\x72\x50\xd9\x2e\x2e\xb9\x2a\xc2\xef\x90\xa2\x5a\x91\x3a\xc3\x8c\x78\x18\x3b\x08\x0f\xc3\x14\x58\xc4\x5b\x9a\xff\xca\x89\x59\xd2\x62\x0b\x44\x61\x30\x84\x8b\x9a\xe0\x7c\x8d\xc8\x7b\xca\x1a\x6d\x4f\xf1\xec\x2e\xe0\x90\xc6\x25\x67\x6b\x97\x44\xb0\x62\x7c\x85\x69\x19\xa2\xca\x37\x38\x7a\x76\x2c\xb6\xf8\xe8\x70\x8c\xee\xdd\x13\x9f\x3d\x55\x9f\x3d\xde\x84\xa8\xf0\xaa\x5a\xf3\xc3\x09\x7a\x30\x41\x0f\x27\xe8\xd1\x84\xf3\xb9\xc7\x16\xd9\xf8\x73\x9b\x2d\xb1\x7f\xde\x46\x58\x9d\xbb\xe0\x28\x6e\xb4\x0b\xfc\x97\x35\xf6\x58\x98\x6a\x50\x5e\x2d\x30\x2d\x51\xc9\xf9\x39\xe7\xe1\x08\x23\xf0\x5a\x8e\xc8\xf4\x6a\x3a\x81\xd5\x99\x82\xb3\x34\xab\x16\x47\x0f\x1f\x3f\xf9\x66\x3c\x11\x30\x38\xf5\xc9\xfb\x8c\x90\x1c\x3d\x3e\x3c\xb0\xb6\xcf\xc4\x5b\x1f\x5c\xa2\xf3\x57\x48\xce\x67\x62\x51\x29\x9c\x59\x48\x32\x87\xc3\x58\x07\xf6\xf0\xc1\xd7\x5b\x91\xe1\x7b\xb2\xf6\xcf\x2e\x79\x8f\x33\xce\x79\x9f\x3c\x52\x67\xf8\xf0\xc1\xd7\x1d\x87\x38\x86\xd2\xd0\xc5\xfe\x41\x1d\xb9\xff\x24\xef\x5d\x40\xdb\xad\x2a\x9f\x0e\xcc\x23\x13\x6a\xaa\x38\xe4\xc9\x83\x3d\x3a\xd8\xfb\x66\x82\xf0\xde\x2c\x82\xaf\x61\xc4\x36\x99\xbf\x79\xb0\x01\x5e\x06\x44\x8a\xc6\xdf\x3c\xd1\x34\xfe\xe6\x41\x07\x8d\x03\x64\xb6\x22\xb0\x81\xb2\x09\x75\xad\x59\xec\x88\xb4\x77\x22\x43\x5f\x91\x26\x38\xf4\xa7\x05\xa6\x0b\x92\xdf\xda\xd9\x8f\x1d\x39\x90\x98\x14\xd7\xbd\x24\xa4\x44\x99\xc0\x82\xcb\x30\xb8\xac\x9a\xb9\x12\x4f\x87\x73\xdd\xde\xf9\x7e\x4f\xd6\x6a\xae\xef\xb8\x60\xb0\xa3\x93\xe0\x9d\xcd\x4f\x39\xb7\x0b\xbd\x69\x9c\x89\xdd\x78\x07\xda\x47\x61\xe7\xf3\xb1\xf4\x1c\xfe\xc3\xf5\x23\x10\x55\xbf\x07\x9f\x83\xb0\xe6\x7d\x4f\xd6\xa3\x80\x08\x4b\xf5\x95\x3d\xc3\x69\x4e\xb2\x2a\x87\x83\x37\x9e\x84\xec\x85\x5e\x95\xb8\x69\x6b\x72\x52\x5c\x55\x35\x6d\xe6\x8b\x23\x74\x11\x7c\x36\xfd\xf6\x87\x8b\xdf\xbe\xfd\xe1\xe2\xf0\xc1\x6f\x0f\xbf\x3e\x74\x80\x8c\x5d\x64\xf7\xf7\x85\xdd\x7f\x2d\xf5\xfb\xaa\x9a\x71\xad\x60\x59\x31\x46\x84\xea\x31\x93\xdf\xd0\x6b\xdc\x18\x91\xfb\x1d\x59\x3b\x70\x30\x63\xa4\x6e\xc2\x29\x2a\x42\x4c\xaf\x61\x94\x57\xd5\xab\x91\x23\xaa\xda\xb3\x8d\x4c\x77\x41\x18\x03\x97\x58\xf0\x0d\xff\x19\xb4\x13\x4e\xc5\xfa\x09\xd3\x9e\x58\x56\xb8\xa0\x95\xac\x04\x71\x52\xe8\x95\x9a\xf9\x2b\x98\x39\x4c\x7d\xf4\x8b\x8b\xeb\x78\x6c\xd4\x9f\x2a\x27\xf7\x99\x4d\x0d\xf7\xe9\xf1\x18\x51\x2e\x76\x81\x70\x70\xb7\x6b\x01\xf8\x6e\x29\x49\xb3\xc9\x5e\x71\x6f\xce\x5d\x6c\x97\xef\x4e\xcf\x2e\x4e\x7e\x7b\xf5\xe0\xf1\x93\x2e\x54\x19\x29\x66\x53\x9a\xa3\x63\x44\xf3\xf0\x0b\x10\xea\x8e\x41\xb6\x0b\xbf\x0c\x99\xe8\x71\xc8\x58\xbb\x5e\x13\xf4\x71\xfe\x0e\x1f\xb7\x0e\xfc\xb1\x75\xa2\x22\x93\xb0\x35\x3f\x74\x8c\x0e\xc2\x47\x2c\x8d\xfe\xe9\x1e\xfa\xf0\xb1\xe3\x09\xad\x6f\x03\x24\xe7\xc1\xc8\x06\x6d\x21\xac\x44\xb1\xb7\x25\xd8\xe0\xf7\x99\x30\xc2\xef\x07\x34\x21\xea\x36\x9b\x84\xf4\x9a\x28\xbe\x75\x84\x9a\xba\x25\xe3\x1d\x8d\xfc\x3d\x59\xc7\x06\xfd\x9e\xac\x77\x3a\xa0\x59\x1f\x33\x9a\xf9\x2c\x18\x2a\xa4\xbf\xa7\xcf\xf2\x65\xf2\x3f\xc2\xec\x4e\x68\x0a\x4b\x00\x92\x46\xd8\xa7\x7b\xc8\xbc\x20\x98\xc6\x77\x8b\x65\xb3\x86\x77\x47\xd7\xfc\xff\xc2\x2d\xc8\xff\xff\xd4\x07\xfe\x6c\x34\x1e\x6f\x34\xaa\x36\x68\xfe\x39\x03\x7f\xfa\x09\x2b\x1b\xe5\xa7\x1f\xd7\xb7\x43\xf1\xd3\x3a\xf5\xce\x2b\x59\xd0\x64\x2c\xa4\x64\x7f\x2a\x18\x52\x33\xbd\x48\x40\x64\x6c\x7f\x4e\x2f\x71\x81\xcb\xcc\x3a\x35\x9e\x7d\xf4\xa7\x86\x16\x5c\x39\x7f\x2e\x03\x92\x85\x4d\x2e\x9b\x93\xec\x1d\x93\x6e\xb1\xfb\x0c\x55\xd7\xa4\xc6\x45\x61\x79\x1e\x24\x5c\x61\xa5\xa7\x0d\x43\x97\x55\x5d\x57\x2b\x92\xcb\x18\xe3\xc0\xfe\xa5\x3d\xa3\x94\xac\xd0\xac\x2d\x01\xf4\xf3\xb6\x28\x34\xae\xdf\x0a\x90\xa3\xb1\xb2\xd5\x79\x86\x1f\x3a\x43\xa3\xae\x39\xa2\xaf\xc2\x93\xa5\xe7\x8f\x9e\xf6\xac\x4b\x68\x64\xaa\x49\xd3\xd6\x25\x2c\x97\xfd\xf1\x47\x44\x0a\xd6\xf1\xfc\xb6\x28\xa2\xbd\x6e\x0c\x3d\xe9\x2f\xb1\x9e\x62\x19\x10\x46\x35\x01\x6b\x70\x26\x12\x0d\x2a\x54\x95\x44\x89\x55\x9e\x05\xde\x38\x3f\x45\xc0\xfa\xb0\xe5\x13\x03\x79\xc6\xf7\xd1\x6f\xd1\xe0\xbf\x23\x88\x48\x1f\xb9\x86\x3f\x15\x09\x35\x46\xf7\x3c\x28\x83\x4c\x80\xde\x7d\xf9\xd6\x1a\xf7\x57\x74\x47\x18\xb2\xb6\x91\xdf\xa2\xf3\x3a\x42\x17\xc2\x5b\x4c\xac\x7c\x11\x21\xcc\xd9\xd6\x7a\x94\x57\x84\x49\xe3\x0a\x65\x8d\x4b\x52\x5f\x7c\x8f\x6c\x9d\xd1\xbd\xce\x59\x71\x05\x62\x13\x3a\xfe\x75\x7c\x27\xb5\x4d\x4e\xf2\xdc\x71\x4a\x48\x17\x9a\x95\x24\x90\xdc\x01\x7c\xf1\x19\x69\x4c\x36\x43\x34\x70\x4d\x7f\x78\x84\xfe\xe6\xa1\xe5\x9b\x78\x3b\xe7\xfc\x74\xef\x8e\x81\x15\x9b\x4d\x1f\x7a\x5a\x4e\x1a\xfd\x86\x4a\xb2\xf2\x9c\x14\xdd\xb8\xd8\x32\x96\x79\x77\x03\x2c\x42\xd3\xe0\x6f\xb1\x48\xde\x18\x12\x71\xe1\x75\xe5\x4b\xad\x21\x0e\x26\x5a\x46\x22\x91\x8c\x4a\x05\x5c\xe4\x1f\xf2\x6b\x1d\x41\x17\x43\xa9\xe3\x4e\x0b\x00\x6d\x82\xa0\x90\x88\x05\x3a\x5e\x60\x72\x0c\x0d\x5f\x8e\xd6\x6f\x7d\xe1\x1e\x2e\x2b\x18\xe8\xa2\xa9\xdb\x0c\x7c\xf9\x52\x23\x6b\x2a\x48\x7a\xe2\xda\xf8\x1e\xd8\x86\x44\xb2\xd4\x25\x78\xc1\x4c\x34\x85\xed\xbc\x61\x02\x86\xce\xd2\xfa\x30\x24\x60\x23\xf5\x88\x1d\x92\x91\x7a\x66\xb3\x88\x0c\xf7\x8d\xbe\x88\x0c\x65\x2e\x18\xf8\xa8\x1b\xbb\x20\x3d\x69\xdd\x0f\x07\x99\x1a\x3d\xcf\x5b\x4e\xf6\x0d\x9e\x1f\x0c\xde\x78\xa9\x63\x7e\xc0\xc2\xf7\x4d\xa3\xf3\xb3\x1b\xb9\xa7\xef\xbd\x15\xfc\xe5\xd7\xfe\xa7\x43\xc7\x69\xdf\x4c\x92\xbe\xcd\xe4\x86\xec\xf6\x39\xc2\x9d\x0e\x8e\x47\x37\x26\xd9\x3f\x7d\xb0\xc5\x4c\x04\xd4\x71\x4c\xfb\x12\xd7\xa7\xb9\x4f\x25\xc8\xa4\x52\x5f\x5a\x96\x93\xb4\x7e\x6f\x3d\xb5\x81\xaa\x6f\x5e\xda\x46\xeb\x8f\xbd\xdd\x6f\x00\xb0\x5e\xeb\xb2\x05\x38\xda\x9f\xf3\x56\x4c\x34\xec\x57\x43\x23\x20\x02\xe1\xb3\x5f\x1b\x8c\x40\xd1\x5f\x0e\x83\x12\x47\x45\x7d\xd7\x07\x43\xab\x69\x11\x18\xea\xbb\x34\x0c\x4b\xac\x75\xde\xb7\x22\x3b\xde\x91\x75\x64\xe9\xe3\xf7\x7d\x04\x80\xfe\x7e\xb3\xbb\x31\x9c\x4a\xa7\x6c\x1f\xbf\xe4\x5c\x0b\xa3\xfe\x2a\x25\xe0\x9d\x91\x9a\x5e\x93\xdc\x4e\x0f\x40\x7e\x34\x82\x12\xe5\x21\xec\x4e\x6f\x17\x2e\x4f\x9a\x44\xd9\xa4\x36\xb6\x15\x33\x90\xe7\xde\xb5\xe1\x5c\xe3\xda\x28\x96\x17\xed\x02\x1d\x2b\x92\xfa\x68\x55\x6d\x63\x63\xf6\x45\x00\x86\x96\x39\x79\x1f\x98\xd6\x56\x73\x5a\x10\xf9\xdd\x53\x7f\xaf\x28\x87\x59\xa8\x61\x38\x5c\x5a\x21\x65\xc9\xa9\x00\xf0\xd7\x30\x36\x41\xe2\x20\xfe\xfd\x0a\x1d\xa6\x00\x6b\xca\x95\x3d\xda\x87\x91\xb0\xc7\x01\x2c\x8f\x70\xce\x9f\x5f\x99\x51\x0c\xde\x71\x95\xbb\x5f\x37\xb1\x21\x77\x49\xc0\x3d\x3b\xcb\x5b\xc2\x5b\xdf\x5c\x12\x7b\x9b\x9b\x27\xed\x0e\x9b\x4d\x4b\xb0\xe8\x3f\xe5\xb4\x08\x6e\xea\x1c\x15\x73\x69\xfc\xfb\x54\x20\x97\x40\xfa\x77\xfb\x3c\xf4\x5e\xb3\xd1\x43\xa0\x61\x6d\xb6\x55\x84\x06\x76\x5e\x4a\xc4\x53\x9b\x24\x34\x20\x49\x01\xe1\xab\xf8\xa5\xff\x55\xfc\x16\x8f\x7c\xec\x3f\xac\x2e\xd3\xb4\xc6\xf4\xda\x94\x92\xd0\x0e\x27\xa1\x23\x31\x56\x65\x14\xf3\xf1\x65\xc8\x89\xab\x9e\xeb\x58\x6b\x1d\x34\x4f\x19\xe4\x35\x90\x5c\xd9\x43\xac\x28\xfa\xca\x2a\x35\x41\x19\xba\x24\x56\x04\x37\xc4\x00\x06\x6a\x98\x06\x9b\x36\x1a\x39\xa1\xb8\x94\x59\xdb\xd5\x8d\x9e\xee\x0f\x2b\xdc\x4d\xbc\x73\x12\x15\x2b\xcb\x28\x9e\x96\x33\x10\x95\x81\x41\xe5\x49\x3c\xa2\x41\x97\xb8\xcc\xf9\x92\x14\x55\xb6\x13\x1c\x37\x0f\xa2\x0e\xd0\x04\xaf\xb9\x2a\xe9\xa1\xdc\xe4\x3a\x82\xba\x1f\x99\x98\x36\xb8\x61\x40\xb7\x41\xc8\x89\xe8\xde\x16\x8f\xa1\x61\xdb\x27\x89\xc8\x59\x32\x60\x21\xe3\xfc\x69\xa3\x50\x59\xd0\x0d\x3b\x4c\x42\x8e\x3b\xec\x53\xfa\x57\xfe\xc5\xdc\x67\x7f\x96\x1b\xeb\xcf\x73\xdb\x25\xdc\x67\xe6\xda\xda\xc4\x99\xa5\xcf\xca\x4e\x3c\x5a\x56\x56\xa2\xba\xec\x7b\x84\xec\x7f\xfb\xb5\xb6\xf3\x6b\x7d\xae\x86\x6d\x14\x37\x32\xc3\x9e\xc3\x25\xba\x24\x92\x74\x32\x51\x6b\x5e\xad\x74\x32\xb1\x63\x6e\xce\x93\x35\x37\xa4\xcd\xd9\x38\x33\xfa\x0d\xcf\x3d\x36\x44\xd7\xc2\xb7\x63\x1b\xee\x46\x06\xe2\x4d\x0d\xbe\x81\x05\x77\x08\xf8\x0d\xec\xc3\xfd\xd7\x60\xca\x4e\x1a\xaf\x78\xb2\x4b\xe3\xa9\x0f\x28\xf7\x64\xdf\x4d\xb4\x25\x1f\x9e\xb1\xc5\x5a\x8f\x44\xec\xa3\x80\x8a\x1c\x29\xf6\x40\x68\x92\xf4\xb0\xdc\xd4\x2e\xa9\xad\xa3\x71\x38\xc3\x4c\xa4\xb6\x71\x33\x0e\x67\x1b\x0b\x67\x17\xa4\x8d\xcc\x9c\x71\x40\xfd\xb6\xce\x0e\x8e\x95\x02\x99\xe4\xbb\xff\x8c\x2a\x6c\x54\x63\xa4\x65\x43\xea\x19\x96\x79\xdc\x76\x0a\xfc\x70\x67\x5e\x04\x3e\x88\xf5\x85\xc8\x16\xe6\x80\x5f\x2a\x47\x91\xa5\x4c\x4b\x0c\xb4\xd8\xee\x16\x1e\x80\x9b\xad\x2a\x54\x0d\x00\x51\xe3\x32\x39\x0d\x83\xfc\x51\x6c\x22\xae\x44\xa4\x53\xd2\xed\x20\xd2\x7e\x5f\x56\x2c\xe7\x1c\x38\x9f\xf9\x22\xee\x9b\xf5\x02\x35\xfd\x6c\x4c\x52\x14\x0c\xad\xe6\x04\xa2\x9d\xed\x24\x78\x0c\x49\x98\x22\x50\x76\x5e\x59\x89\xde\xb2\x26\x84\x53\x60\x45\x58\x1e\xa4\x2a\x24\x36\x87\x33\x0c\x57\xc9\xb8\x3a\x44\xca\xaa\xbd\x9a\x77\x6a\xfc\xf0\x11\x97\xf6\x1c\xbf\x92\x24\x07\x9f\x92\x75\x10\x28\xfb\xae\xa0\x10\x79\xf1\xbc\xaa\x4f\x71\x99\xd3\x1c\x37\x44\x2e\x41\xd3\x82\x73\x5f\xb3\xe1\x23\x74\xaf\x33\xd0\x64\x7c\x84\xbe\xad\xaa\x22\x72\x4b\xe8\xa9\x96\x0d\xe4\xcc\x46\xaf\x0a\x19\x69\x30\x95\x81\x8d\x92\xe9\x3f\xbd\xa7\x6b\xc8\x70\xe0\x1f\x9f\x8d\xb8\x34\x6b\xc5\x3f\xd2\x1c\xa0\xc8\x92\x1b\x1c\xbc\x15\x23\x82\x84\x30\xea\x8d\xff\xd6\x71\xd1\x41\x92\x58\x53\xb7\x1d\x82\xe2\x0c\x17\x6c\x80\x2d\xad\xc7\xe7\xc5\xc7\x39\x98\x1e\xa0\x7b\xf7\x82\x71\xa2\xc9\x23\xff\x01\xda\x48\xfd\x66\x8e\x4b\x59\x0d\xe8\x79\x55\xbf\xae\x0a\x32\x2a\xdb\x85\xe0\x5e\x47\x03\x9c\x64\x2a\xd0\xce\xf3\x38\x26\xe3\xe7\x44\x8d\x35\x3b\x3c\xdb\x78\x0b\x55\xde\x94\xac\x30\x41\x56\xa8\x2a\x83\x3d\x66\x73\x0c\x21\xd9\x8a\x00\xd6\xed\x42\x47\xe2\xb1\x52\x11\x82\x49\x2e\xf3\x5d\xc9\x3f\x04\x7e\x9e\x8a\x8b\x12\xec\x65\x9a\xc0\x4a\x87\xba\x8b\xef\x63\xb3\xa7\xb3\x68\xde\x2c\x65\xe5\x7d\x08\x91\x5a\xd6\xd5\x15\x7f\x70\x67\x39\x77\xab\xfe\x84\x9b\xbe\x49\x7d\x96\xf9\x77\x3b\x4d\x4e\xba\x35\x22\x49\xe4\x21\x6d\x35\x96\xef\xd2\x99\xc6\xb2\xbf\x8f\xbe\x15\x11\x8c\xd6\x89\x12\xfa\xb7\x29\x08\xc1\xbc\x12\xcf\x3b\xf4\xb4\x7c\xd1\xb3\xfd\xb6\x8f\xa3\xef\x08\x46\xb0\xc2\xce\x81\x77\x7a\x68\xd8\x21\x05\xb1\x90\x32\x6b\x2d\x6f\x33\x0f\x60\x95\x4a\x00\x70\xc6\x94\xb1\xcc\x3d\x55\x2d\x75\x54\xb3\xcd\xd1\xec\x79\x24\xb8\xed\x49\x9e\x03\x1f\x55\x56\x50\x11\xb5\xc8\xd6\xac\x21\x0b\x53\x0c\xc5\xaa\xc4\x95\x36\xed\x87\x7c\x17\x5e\x7e\x41\x84\x61\x8a\xb3\xdb\x46\xde\x1a\xf1\x94\xf5\x4f\xc7\x7a\x5d\xc4\x34\xc7\x15\x93\xdd\x8e\xbb\x7e\xce\xc7\x0e\xb6\x50\xaa\x1e\xa8\x23\x8c\x98\xfa\x88\xb2\xb0\xb9\x8e\x76\xf7\x67\xc7\x37\x8e\xd8\x0f\xce\xe6\x11\xbf\x69\xf9\x30\x75\xec\x7c\x59\x21\x27\x50\x89\x4a\x0a\x57\x4f\xf7\xc4\xf7\xe1\xa8\x2f\xcb\x62\xcd\x99\x37\x1f\x7a\x01\xe1\xbb\x9c\x3b\x0a\xb1\x51\x4a\xbe\x33\x51\xef\x25\xaf\xa0\x9c\xa0\x60\x97\x3e\x18\x51\x19\xdc\x96\x76\xad\x32\x32\x5e\xed\x22\x5f\x98\x13\x24\xee\x13\x5c\xcd\x5c\xfd\x5d\x9d\xd8\xc3\x38\xcf\xdf\x54\x0e\x24\x2e\x2a\xa6\x56\x89\x4b\x50\x6f\xaa\x93\x3c\xef\x90\xab\x22\xbb\x32\x32\xee\xa2\xca\xe9\x6c\xfd\x82\xac\xa0\xf2\xcf\x2b\x52\xe6\x76\xe9\x53\xcd\x56\x1c\x7b\x4c\x49\x8b\x89\x08\xc8\xa6\xe5\x15\x47\x13\x3e\x4a\xf2\x98\x0b\x59\x9d\xdf\x2d\x08\x28\x5d\x97\xd1\x1a\x43\x03\x59\x8b\x5b\x72\x74\xe4\x95\xf6\xfc\xb4\xdc\xc4\xc5\xe5\x36\x58\xca\x0e\x19\x82\xa8\xfa\xc7\x45\x2d\x7e\xa3\x08\x57\xd8\xb1\x3c\xfa\xc1\x99\x3b\x07\xe4\xf9\xd1\xe0\xff\x95\x6b\x67\x25\xe1\x24\x49\x37\x62\x47\x9d\x19\xc7\xc9\x39\xf1\x47\xc8\x38\x8b\x29\x50\x33\xaf\x18\x41\x33\x5a\x83\x4c\x38\xab\x6a\x43\x31\xce\x65\xb4\x7f\xd0\xea\x68\x60\x9d\x4b\x7f\x3e\x4f\x07\xc5\x89\x45\xb6\x84\x2b\x1d\x74\x56\x20\xee\x03\xbe\xe7\x63\x15\x06\x56\x84\xcb\x90\x70\x23\x44\xa6\xf8\x6c\xcb\x19\x86\x63\xfa\x9f\xec\x6d\x1c\x63\xb7\x01\xe5\x0e\xa6\x07\x9d\x5c\x0a\xea\x0c\x2e\x64\x77\x0f\x83\x9a\xaa\x1f\xa4\xaf\x4f\xb9\x0f\xdb\x04\xef\x40\x9b\x5d\x39\xe9\x18\x4b\xe5\x87\xd6\x0c\xc6\x5f\xd4\x1d\x5c\xb7\xc1\x3e\xf9\xf7\xcd\xf7\xaf\x71\xf3\x69\xd3\xf5\x46\x37\x9f\x7a\xeb\x73\xb8\xf9\x5c\x5c\x3e\xf3\x9b\xef\x26\x0c\x41\x3b\x11\x02\x86\x80\x77\xc6\x07\xf0\xbf\x8f\xff\x3f\xcf\xf1\x97\x77\x9f\xba\xb8\x8c\x9a\x04\x8e\x6c\xe8\xf1\x21\x6e\x33\x7d\x3c\x9c\xd2\x9a\x9b\xaa\xdb\x52\x02\x33\x0d\x0c\xfe\x2c\xb6\xe0\x23\x62\x4c\x9a\xe5\xe7\xc9\x15\xb4\x94\xab\x68\x88\x28\x43\x57\xc2\xfe\xcd\xf9\xb6\x62\xd8\x0d\x2e\x64\xcd\x38\x13\x39\xe6\x03\xb2\xa3\x15\x4c\xef\xac\x9a\x5c\x93\xda\x95\x4d\x44\xad\x15\x14\xd6\x08\xe9\xb3\xe3\x7f\xd5\xff\x46\xb7\x4b\x98\xff\x3c\x53\xf2\x3e\xfa\x6a\x88\xac\xd7\x51\xd3\x65\xe3\xcd\xf0\xa2\x6a\x94\x23\xc9\x1c\x09\x49\xaa\x3b\x82\xd3\x01\x9f\xfa\x25\x94\xb1\x7d\x4a\x0c\x98\xf9\x58\x37\xd6\x6a\x9b\xb8\x92\xf2\xcb\x68\x13\x4a\x28\x78\x9d\xe5\x60\x64\x97\x26\xab\xb9\xd8\x6a\x5e\x89\x59\x59\x49\x35\x92\x1b\x8b\x7f\x25\x45\xc4\xb9\xa7\x35\xaa\x56\x65\xc2\x21\xa7\x36\x5a\x85\x16\x84\x34\x36\xcb\x9e\x78\x85\x47\xcb\xa6\xa6\x97\xad\x28\xe9\x0d\x8c\x26\x52\x62\x79\xe8\x56\x0c\x43\xd9\x8f\x8f\xd1\x01\xfa\xe3\x8f\x1d\x7a\x93\x12\x3b\xe9\xbc\x9c\x55\xf1\x0b\x61\xdc\x9f\xd3\x82\xf6\xe4\x46\x4f\x39\xa2\x76\xb9\xb5\x3d\x3e\x77\x49\x0a\x69\x01\x54\x85\xb0\xc5\xe7\x76\x39\x6c\x6a\xab\xd7\x16\x91\x7b\xcb\x0d\x79\xe5\x2a\x86\x65\xac\xf5\xb0\x3d\xce\xb7\xb2\x6a\x21\x63\xf6\xac\xc6\x80\xba\xaa\xb2\xfb\x85\x75\x8c\x72\x5f\x5e\xf0\xf1\x33\x1c\xe7\xc3\x17\x01\xc9\xf7\xf7\xcd\x10\x16\x42\x5a\xdb\xf7\xc7\x65\x14\x0a\x31\xe8\x36\x7f\xc2\xbb\x43\x54\x42\x01\x5a\x7b\x8a\x20\x8a\x71\x49\xad\xe1\xf5\x8a\x7e\x86\x90\x03\x65\x3f\xe4\xca\x7f\x41\xc3\x1f\x2d\x5f\x74\x0a\x7e\xc9\x08\xc2\xdd\xac\xff\x3f\x03\xe1\xf5\x03\xb1\x15\xd8\xdf\x57\x5e\x54\xb5\xc1\x55\xd8\x20\xdc\xd2\x05\x99\x35\xd5\x35\xa9\xd5\xbe\xa4\x5e\xbc\xb7\x75\x83\xdf\xa6\x95\xe8\x2b\xa4\x2e\xa0\xbd\x21\x93\xba\x65\x59\x76\x17\xdb\xd8\xe0\x1f\x87\x36\xa8\x85\x5f\x00\x7b\xc8\xe5\xdc\x63\x57\x12\x9d\xf5\x4c\x9c\x4c\x97\x22\xb5\xe2\x4c\xb9\x2a\x89\xb2\x45\x72\xf9\x41\x76\x29\x2a\xab\x95\xcf\xed\xee\xdc\x92\x7a\x24\xf4\x84\xe7\x75\xb5\x48\xeb\x48\x5e\x49\xa3\x6e\xc5\x08\x21\xd4\xa3\xaf\x30\x27\x69\xa5\x28\xec\xe6\x1b\x96\x44\x63\x67\x6b\x78\xfd\x70\xdd\xd8\x21\x86\x56\xa4\x28\x80\xd6\x32\x58\xb7\xe3\x55\xe8\x29\xa0\xc7\xa4\x75\x47\x57\x88\x48\xa0\x87\x40\xfa\xa4\x28\x82\xe4\x8a\x5b\x8c\xe3\xd0\x83\x7e\xf6\x7a\x0e\x9d\x0d\xb8\x23\xd0\x33\x08\x13\x8a\x5c\xd2\x1b\x71\x82\x01\x92\x79\x8c\x61\xef\x2b\xa2\xed\x5c\x24\x51\xf0\xff\x87\x5e\x77\x03\xe8\xd9\xc9\xfd\x62\x6b\xef\xa9\x53\xc9\x85\xdf\xff\x0c\x2f\x52\x2f\x6d\xe3\x33\xb9\x27\xff\x9c\x9b\xed\x96\xef\x0d\xef\x9a\xf8\x59\x1f\x20\xeb\xc8\x88\x56\x1d\x1b\x7a\x71\xd5\x26\xef\x76\xe4\xa6\xe2\x45\x76\x9a\x11\x1e\x5a\x6e\xfb\x1a\x5a\x6e\x62\xc1\x95\x11\x9a\x4f\x23\x7e\xb5\xb4\x9b\x09\x7b\x2e\xc3\xa1\xcb\x50\x6f\xea\x52\x50\x23\x77\x7b\x15\xfe\xa4\x65\xe8\xee\xf5\xb9\xa3\x45\xe8\x35\xed\x5b\x8b\x80\xdc\x24\x25\x19\x26\x6e\x42\xe1\x9b\x0a\xfd\xa3\x25\xf5\xda\xe9\x91\x16\xcf\x4c\xe2\xef\xeb\x65\x93\x01\xc8\x88\x36\x6e\x82\xf7\x17\x68\x58\xf8\xbd\x36\x7a\x0c\x8a\xc0\xdf\x3c\xab\xa9\x27\x50\x5f\x0f\xff\x72\x55\x92\x58\xa8\xbe\x9d\x99\xee\x26\xda\xc2\x5c\x55\x7b\x0b\x5a\x6b\x4a\x34\x15\x5a\x92\x9a\x13\xd1\x88\x50\x20\x41\x85\xdd\xfb\x9c\x58\xfe\x33\x53\x2a\x2f\x4e\x98\x1d\x52\x46\x3f\xa7\x83\xf9\x55\xc1\xbe\xb2\xb3\xf4\x53\x77\x15\xe6\x54\x6e\x50\x50\x93\x46\xe4\xf5\x47\x42\xf8\x3a\xb3\x12\xdc\xb5\x1a\xdb\xa9\x96\x56\xbc\x9e\x90\x52\xfe\xb4\x58\x3d\x8d\xe4\x34\x40\x4d\x0b\xda\xea\x9b\x9d\x05\xed\x5d\xba\x41\x7b\x2a\x64\xcf\xd6\x80\xec\x76\xaf\x57\xc4\xdf\xcb\x5e\x6a\x2b\xba\x11\x47\xec\xc8\x56\x1b\x9e\xa7\xd6\xc1\x5b\x7b\x9b\x85\x5b\x58\x78\x62\x51\x20\xc3\xf0\xdd\x92\x0e\x17\xb4\x02\x3a\xc2\xbd\xda\x49\x3d\xbf\xc2\x48\xda\x61\xcb\xff\xed\x0f\xd8\x1d\x20\x03\x76\x4f\x78\xb8\xff\x4f\x1f\xcf\x1e\x41\xe9\xc6\x67\xf6\xcf\x0e\x84\x0b\x8f\x6a\x22\x1c\x6e\xb7\xe7\xf5\xb3\x3c\x58\xff\x22\xb1\x75\xfe\xb1\x1c\x14\x78\x66\x5e\xea\x52\xf5\x06\x80\xbe\xf5\xc0\xba\xad\xa6\x37\x24\xae\xae\x1f\xf0\x96\x54\xdb\x36\xaa\xae\x6b\xe9\x87\xf3\x5e\xff\xc9\x9b\x05\xcf\xed\xf2\x5e\x8a\xaa\x02\x9f\xe9\xbd\xa0\x9a\x88\xee\xe8\x5a\xf8\xb3\xa3\xc4\xc2\x6b\x21\x11\x2b\xf6\x2f\x70\x2d\x6c\x7f\x94\xb6\x0a\x3b\xfb\x27\x3f\x41\xaf\x8d\x15\x52\x5d\x87\x76\x8d\x33\x38\x55\x83\x92\x96\x62\xc7\xe7\xcf\x8f\xa3\x32\x07\x27\x19\x62\xa0\xec\xb0\xad\x4e\xf1\xff\xe7\x3d\x3b\xb2\xdb\xad\x51\x13\x4c\x1b\xcf\x54\x1c\x91\xf3\x7e\x32\xbc\xa5\xbb\x7a\x61\x24\xd0\x2a\x7d\x8a\x87\xc4\x59\xf5\xdf\xfd\x5b\xc4\xa2\x74\xed\x95\xe1\x91\x56\x7d\x94\xe8\x9f\xf9\x46\x71\x56\xfd\x94\x18\x1a\x66\x75\x5b\xde\x22\x3a\xeb\x9f\xf2\x9f\x1f\xd2\x92\x14\xbb\xfa\x2e\x95\x21\x01\x2d\xfe\x60\xb1\x8b\x25\xee\x07\xbc\xc1\xbd\x92\x0c\x79\xe1\x6c\xff\x3f\xa4\xb9\x45\xd1\x5f\x9a\x65\xfc\x00\x7b\x53\xeb\x22\x9e\x88\x88\x92\x11\x34\xfd\xe7\x3b\x00\x14\x8b\x74\x92\x7e\x34\xff\xd1\xdb\x5d\x31\x3b\x68\xc4\x1f\xf5\xe3\xa7\xf5\xe9\xed\x4c\xcf\xfb\x24\xa1\x31\x1b\x0b\x25\x9f\xe8\x64\xf4\x45\xd1\x78\x03\xf6\x39\x1c\x87\x0d\x3d\x80\x33\x7b\xf2\x43\x64\x8f\xdd\xc4\x4b\x18\x93\xc8\x3e\x13\x3f\xe1\x27\x33\xc7\x6e\xe8\x7a\xdc\x82\xcd\x1a\x5f\xd8\x70\xcd\xfd\x96\xfc\x91\x5d\x0b\xfe\x27\x7b\x24\x3f\xd9\x82\x6f\xe4\xe4\xdc\xe9\x72\xdf\xc0\xf3\x79\x5e\x66\x45\x9b\x13\x13\xd6\xa5\xcd\x17\x35\xc1\xef\xf2\x6a\x55\xca\x76\x68\xf2\x6b\x3e\x0d\xd1\x6b\x3f\xe8\x97\xc6\x44\x33\xb0\xcc\x52\xd2\xbc\xd0\x31\x93\x80\x81\x83\xd2\xbd\x70\x19\x2d\x68\xa9\x0a\x52\x49\x3c\x02\x27\xa1\x2c\xfe\xf8\x1d\x1f\x43\xcc\x9b\x5d\xb4\x8b\x05\xae\xd7\x1d\xce\x52\x18\x54\x3e\xdd\x5b\xfa\x50\xcf\xfc\x08\xbd\x95\xef\x7c\xab\x3e\xfa\x35\x56\xf7\x30\x06\x7d\xd2\x0d\x26\x51\x70\xd3\xc0\x41\xc7\x0e\xd2\xe1\xc3\x1a\x3c\x3a\x36\x43\xa5\xd7\xf9\x8c\x34\x98\x16\x2c\xbe\xc4\x62\x01\x4b\x44\xcb\x9c\x5e\xd3\xbc\xc5\x85\x8c\xac\x2c\x73\xa8\x02\xe6\x85\xef\x45\x96\xc3\x9f\x60\xc7\x5a\x44\xbc\xd2\xfe\x63\xd7\xb8\x96\xe7\x65\xd8\x8a\xe5\xee\x11\x64\x47\xe8\x83\x70\xe0\xaa\x37\x3f\x6e\xde\xd6\x67\x58\x79\x47\x0b\xcb\x88\x71\xda\xed\x02\x60\x9e\xfb\xd0\x55\xd0\x15\x66\xa5\x3a\xa5\x19\xf0\xa3\xdf\xd4\xba\x75\x16\x6d\x75\x11\xaa\xbd\xdd\xe3\xa7\xb6\x66\xb8\x20\xce\x96\xa8\x66\xfc\x20\xd3\xf2\xaa\xb0\x55\xf5\xcb\x35\xff\x34\xc3\x05\x2d\xaf\xd0\x0c\x67\xa9\x1a\x79\x80\x35\x87\xe9\xf1\x44\x16\xef\x86\x27\x21\x3e\x07\x80\xa9\x59\xd1\x99\x68\x0d\x06\x80\x82\xce\x0a\x12\xbe\xdb\x1d\x2f\x2e\xb4\x0f\x78\x51\x11\x0c\x7d\xe9\xe2\x96\x14\x96\xd4\x6f\xdd\xd4\x50\x81\x3a\x8a\x18\x83\xe6\x1d\x59\xcd\xe0\xa3\x14\x9a\x83\x96\xd9\x0e\xe3\xad\x59\x4f\xa3\x4b\x67\x3e\x27\x45\xb1\xf9\x54\x86\x50\xc2\xf9\xd3\xbd\xb1\xa1\x20\x21\x84\xd2\x44\xd7\x11\x7a\x18\xa5\xfa\x61\x0e\xd8\x92\xfc\xf2\x1d\x8e\x4c\x52\x5e\xbe\x20\x8d\xcd\x62\x75\xb5\x03\xf7\x7e\x34\x07\x0b\x74\x5f\xd9\x4a\xad\x8b\x0f\x78\xc8\xc7\x8f\xd3\x10\xf6\x30\x6c\xff\xb3\x2e\x79\x41\x87\x48\x71\x05\x13\xba\x04\x66\x55\xd9\x60\x5a\x32\xe9\xdf\x80\x4c\x35\xad\xc7\x2f\xb1\x4c\x99\xe4\x6f\x57\xca\xd0\x73\xd5\x16\xb8\x46\xb8\x6d\xaa\x05\x98\x7b\x67\xb2\x36\x39\x67\x30\xf2\x21\x51\x88\x72\x59\x57\x99\xea\x4c\x26\x9a\x68\x10\x26\x3d\xad\x40\x26\x2e\x91\x8a\x86\xf9\xbf\x73\x89\x10\xc4\x82\xdf\x75\x31\x26\xd4\xcc\x6b\xb0\x9c\x61\x94\xe1\x25\xbe\x84\x42\xe8\xc1\x1d\x16\x89\xc5\x02\x38\x2f\xcd\x74\xe2\xd7\x99\x5c\x1d\x78\x18\xe4\xbe\x57\x78\x5d\xb5\xb2\x67\xa3\xf8\x5d\xaf\x46\x17\x80\x8b\xa2\x6a\x7e\xa0\x0b\xda\xb0\x11\xd3\xbf\xca\x4b\xec\x6b\xb1\xc0\x87\x4f\x3e\x76\x82\xe0\x37\x85\xec\x18\x59\x7a\x65\x98\x57\x6e\xf3\xc8\x34\x94\x06\xd7\x8d\x94\xa5\x4f\x84\xe1\x79\x94\x7e\x9a\x94\xb9\xff\xec\x11\x7a\x2b\xc6\x8c\xf7\xf7\xe3\x6f\x2d\xf1\x5a\x9d\xbf\x59\x55\x03\xe1\x9c\x3e\x7f\x5c\x70\xaa\x1d\xa9\xee\x28\x26\xea\xa5\xd1\xca\x70\x91\xb5\x85\xf6\x52\x41\xef\xa1\x08\x80\xe4\xfb\x8b\xea\x5a\xc6\xfe\x8e\x4a\xb2\x8a\x21\x38\xb6\x8f\x44\x74\x1b\x9d\xe4\x0b\x5a\x1e\x45\xf6\x50\xc8\x2b\x44\x01\x4c\x46\x40\x14\xb6\xf3\x0b\xf9\x41\xb0\x32\x0c\x23\x42\xb8\x03\x8c\x2f\x3f\xbb\x2f\xfa\x08\x71\xf9\xbc\x26\x32\x0c\x0f\x5a\x05\x8a\x74\xe7\x78\xbf\x33\xb9\x7f\x64\x3e\xe7\x45\x23\x6b\xba\xab\x71\xc5\x5e\xb6\x3f\xb1\xf6\xa5\x90\xad\x86\xf9\x52\x3c\x28\xc0\xb0\xad\x24\xd4\xc7\xc3\xdb\x12\x03\x79\xa7\x1d\x38\x43\x0d\xc6\x9a\xe8\xc2\x94\x33\x0a\x4e\x86\xa6\xa6\x44\xd0\x11\x84\xdb\x64\x36\xa7\xb0\x7e\x5f\x55\x0d\xfa\x65\xd4\x81\xf5\xb8\xab\x75\x71\xcc\x88\x15\x62\x9b\x8b\x52\xfa\xf6\x08\xa1\x7e\xf9\x82\xac\x24\x08\x39\x5f\xd8\x99\xea\xf7\x23\x1f\x40\xba\x8e\xc9\xf6\xfb\x2d\x9d\xbe\x13\xbd\xa4\x12\xeb\x12\x6c\xa5\xd4\x4d\x35\xa0\xb0\x6f\x51\xe1\xfc\xa9\x78\x3b\xa8\xe6\xab\xb1\x75\xc9\x36\xde\x74\x0c\x86\xaf\x89\xb7\x01\x26\xa8\xa9\x86\x8c\x14\x5d\xc5\xb3\xf8\xd3\xd6\x6a\xfa\x8b\xd9\x53\x5f\xd7\x56\xa8\x57\x84\xbc\x2b\xd6\x9c\xbf\x56\x6d\x63\x4a\xeb\x5e\xe3\xa2\xed\x94\x2c\x06\xdd\x5d\xa1\x48\xae\x9f\x41\x77\xa2\xa6\x18\xe2\x81\x8d\x30\x04\x45\x96\x9f\x01\x73\x39\xba\x35\xb6\xfe\xb5\xab\x0d\xdf\x90\xa1\x8f\x0d\xa8\x01\x67\xc3\x08\x67\x59\xdb\xa0\x25\xa9\x33\x52\x36\xf8\x4a\xca\x3b\xc2\xfc\x21\x42\xb7\x9c\x5c\xfc\x40\x72\x8a\xd0\xfa\xb4\x6d\x5e\x69\x78\xb2\xf1\xb6\xfd\xd1\x66\xce\x69\xff\x6d\xe9\xb1\xb8\x77\x2f\xfc\xe6\x29\x3a\x9c\x1e\x6c\xc1\x60\x3d\xec\x4e\x5d\x82\x28\xf6\x7a\x49\x9a\x15\x21\x25\x3a\x00\x7b\xc1\xa1\xcb\x3d\x1d\x10\x9d\x2c\x53\xec\x2a\x17\xf1\xf8\xe6\x2a\x8d\xc7\x94\x96\x57\xe2\x62\x3f\xed\xdc\x63\xfa\xe8\xb9\x4b\x10\x2e\x40\x80\xf1\x66\x7b\x2f\x85\xd9\x71\x00\xb8\x73\x27\xf2\x7d\x58\x80\x10\xa8\xc3\x87\x74\xc1\x12\x37\xd1\x95\x29\xd3\x8d\x29\xdb\x6e\x29\x1a\xb1\xea\xed\xce\x86\x74\xb3\x8a\x16\xb4\x19\x59\x2d\xb8\xa1\x0e\x2d\x7c\x9a\x6a\x3c\x1e\xdf\x98\x20\x72\x3c\x3b\x16\x40\x46\x87\x63\xbe\x25\xe1\xb3\xa7\xea\xb3\xc7\x89\xb0\x09\xb3\xf1\x02\xc4\x44\x5d\x65\x00\xa3\xf6\xdd\xe1\x04\x3d\x98\xa0\x87\x13\xf4\x68\x82\xaa\x1a\x3d\xb6\x36\x1e\xa4\x3e\xf5\xc6\x48\x64\xc1\x28\x6c\x58\x59\x79\xb8\x7d\x6c\xe9\xfc\xc9\xa3\x74\x51\xf9\x70\x10\xaf\xb8\x7c\x04\x8d\xb7\x7c\x02\xbf\x8a\x6d\x03\x9f\x6c\x73\x77\x85\x18\x46\x46\xf2\x6e\xb4\x0e\xa4\x3b\x76\x2c\xe2\x5a\x0b\x1a\xc9\x2e\xff\x72\xe7\xf2\xbd\x48\x70\x36\x97\x31\xf8\xf6\x56\x94\x8a\x9c\x78\x0d\x1e\xd7\xdb\xdd\xd9\xeb\x56\xcd\x7d\xb9\xdb\x45\x8b\x83\x39\x41\x57\xf4\x9a\x94\x62\x3f\xac\xe6\x34\x9b\xa3\x05\x5e\x43\x69\xf0\x3c\x17\xae\xcd\xc6\x94\xe1\x9e\x3a\x03\x9f\x4b\xad\x75\x59\x93\x6b\x90\x74\x82\x13\xc5\x19\xfb\x7f\x55\x10\xdf\x38\xe5\x8f\xe7\x15\x11\xed\x16\x32\xdc\x32\xa2\x9d\x89\x11\xf4\x4c\x20\xc2\xc4\x19\x93\x8f\xa4\xa2\x1c\xba\xa6\x27\xaa\x9f\xcb\xfe\x92\x9a\x38\xd3\x1d\xe8\x8e\x83\x4e\xae\x01\x10\x8a\xe7\xdd\x07\xf6\xc2\x1a\x9a\xff\x8e\xe4\x71\x3a\xa3\xa0\x1b\xe2\x7a\x0d\x51\x1d\x95\x8e\x56\x79\xac\x05\x72\x73\x6a\xe3\xc3\x8f\xc3\x02\xef\xe6\xc1\xb7\x87\xbf\xf2\x4b\xa2\xa4\xc5\x06\x08\xbe\x20\x62\x8b\x88\x62\xec\x72\x03\x72\x99\x78\x06\x5d\x47\x8a\x82\x64\x8d\xd4\x12\x22\xd5\xe5\xad\xc1\x1f\xec\x7e\xf0\x92\x91\x92\xb5\x6c\xc0\xe0\x0f\x77\x3d\x38\x79\x4f\x32\xa8\x33\x34\x60\xf0\x47\xbb\x1e\xfc\x9a\xd4\xfc\xca\xc2\x03\xc7\x7f\xbc\xeb\xf1\xc5\xc9\x8a\x8d\xdc\x9f\xa6\xdb\x7b\x3d\x1c\x3e\x09\xaf\x87\x59\x51\x29\xbd\xce\x20\xb9\x9d\x8e\x62\xe8\xe2\x71\xf3\x9e\x31\x52\xc6\x4b\xc3\xa1\xaa\x25\x11\xab\x01\xb4\x17\x2d\x3b\x8a\xa2\x5a\x71\x31\xd0\x8b\xc5\xdc\xd7\x05\x0e\x2d\x4a\x82\x21\xa2\x65\xba\xc7\x0c\x65\x26\xae\xc7\xe5\x90\x9c\x0b\x54\xd0\xff\x06\x86\x6b\xd6\x4b\xe0\xf6\x45\x61\x98\xba\xb4\x50\xcf\xda\xa6\xad\x3b\xc5\x9a\x97\x4b\x52\x42\x6d\x07\x8e\xf3\x88\xcf\x01\x7e\xdb\x92\x29\xea\xf7\x07\x6f\x3a\x67\xfc\x60\xdf\x81\x4a\xf6\x59\xec\x3b\x07\xcf\xed\xb6\x9e\x26\x4e\x7a\xe7\x25\x46\x89\x6b\x60\x05\x65\x60\x9a\x80\x4d\x70\x7e\x26\x4a\xae\xc1\x36\x10\xf1\xb2\x19\xa1\xd7\xc6\x8d\xd1\xdd\x7e\x57\xf7\x5c\xa6\x4c\xec\x41\x19\x4c\x0c\x4f\xc1\x5e\x5e\xb6\x25\x65\x73\x25\x5a\xa8\xe2\x6e\xcb\xaa\xaa\x51\xbb\x6c\xe8\x82\x20\x07\x58\x55\xc3\x43\x79\x85\xac\xd6\x2e\x4d\x85\x0a\xdc\x10\xd6\xc8\x73\x52\xcd\x9a\x15\xdf\xf5\xff\x68\x69\xc6\x35\x72\x11\xba\x19\x88\x3e\x4b\x5c\xe3\x05\x69\x48\x2d\xda\x3c\xe5\xe6\x9e\x54\x9d\x71\x15\x09\x9c\x57\x41\xb1\x37\x3a\xd8\x44\x0a\x3f\x54\x1c\x5c\x4b\x39\xd3\xa5\x57\xc8\xfb\x25\xc9\x1a\xe8\xd7\x29\x88\xc6\xf5\x58\x17\x26\x14\xca\xe1\x34\x56\xf4\xa5\x25\x6b\x08\xd6\x19\x93\xb3\xb6\x50\x1e\xfb\x6e\x13\x74\xa9\xad\x9d\xb8\x00\x8b\x24\x54\x2a\xf8\x4d\x7a\x4f\xf9\x01\x54\x7d\x98\x12\xa6\x43\xbe\xa0\xd6\x24\x68\xa9\x5e\x9d\xc2\xa9\x89\x79\x78\x44\x5c\x70\x18\x16\x0c\x07\xda\x52\x8e\x55\xdf\x24\xfb\x43\xd0\x8b\xc3\x70\x5d\xd4\x17\xb2\xab\x4f\x7a\x62\xca\x47\xc8\xd2\x61\xc5\x79\x6f\x2a\x94\x93\xac\x26\x98\x99\xed\x2b\x8d\x35\x6c\x5e\xb5\x45\xde\xad\x49\x2f\x53\x3a\x34\x82\x80\x5a\xfb\xaf\x5e\x25\x35\xce\x36\xbc\x95\x49\xa9\x33\x89\x19\x6f\xc5\x3d\x22\x83\xca\xe5\x8e\x6b\x25\xbd\x63\xfb\x7d\x51\xf8\x55\x25\x4f\x46\x5d\x35\x55\x56\x15\x10\x1e\x49\x1a\x5b\x49\x16\xee\x11\xa9\x3a\x3b\x8e\x38\x53\xc8\x86\xd6\xba\xdc\x91\xf0\xe8\x65\xd2\x00\x57\xd5\xba\xd4\x14\x3f\xf4\xb2\x20\xec\x6e\x1c\x35\xa1\xd5\x4d\xa2\xfa\x0c\x1d\x1e\xc4\x42\x53\x97\xb8\xa4\xd9\xa8\x67\xc3\xaa\xd1\x8f\xd0\x85\xa0\x00\xc9\x65\x83\x39\x01\x9c\xef\xc8\x6a\x86\x6a\x3e\xbf\x29\xfa\x31\x6e\xe3\x39\x38\xb0\xf6\xa6\x78\x71\x7c\xb7\x33\x73\x6d\x67\x8d\x3e\xdd\x52\x33\x92\x92\x12\x85\x88\xd9\x55\xcf\x57\x98\x4c\xdd\xda\xcd\xe7\x67\x60\xe3\x50\x04\xf1\xa1\x74\x5f\x51\x78\xb9\xac\xa1\xfa\xaf\xbe\xab\x3a\x3a\xe7\x6b\x1f\x0d\x5c\x1d\xd0\x83\x99\x32\x01\x4a\x09\x39\xa6\x75\x7a\x50\x40\xd8\x4b\x07\x71\xa0\xf2\xf5\x00\xdd\xb4\x2a\x19\xcd\x49\xad\x03\x7b\x31\xdf\xf4\xcb\x8a\x91\x7c\x5f\x02\xee\xf3\x32\x9f\xc8\x19\x29\xae\x4d\x56\xf2\x13\xc1\xd3\xdc\x26\x7a\xb1\x4e\xae\xf2\x1e\xb6\xde\x8a\x2f\xf4\x95\x37\x56\x18\x1b\xfb\xd7\xbf\x0e\xd9\xcd\x36\x90\x23\x74\x0a\x6c\x94\xd3\x82\x73\x34\xb5\x3e\x82\xc8\xb2\x42\x33\xb0\x93\xbb\x9e\x7d\xde\x84\x16\xb8\x33\x8e\x9c\x30\x3a\x4b\x59\xfd\xd8\x5b\xd9\x05\xb0\xa4\x7e\xf7\xc2\x0d\x8e\xa7\x3b\xa1\x13\x67\x87\xfd\x32\xa2\xf9\xd8\xd8\x22\x54\x13\x31\xb0\x46\x28\xd9\x98\xca\x6e\xfa\xa8\xe1\xe0\xef\xc6\x62\xc8\xdd\xbf\x9c\x3f\x45\x2e\x73\x55\x12\xbb\x54\x02\x83\x9e\x91\x32\x83\xd9\x2a\x77\x0d\xd5\x4e\x2c\x12\xfb\x90\x68\x83\x4a\xa2\x37\x23\xf0\x5c\x06\xde\x55\x15\xbf\x8d\x58\xc5\x1f\x52\xdb\x3f\xc3\x2d\x67\x3f\x72\x22\xbf\x8b\xb1\xce\x4b\x68\x29\x07\x27\xe7\x77\xb4\x20\xcd\xbc\x0a\x4a\xe8\x9e\xcb\x18\x8a\x39\x5e\x2e\x49\x29\x88\x63\xa5\x8d\x79\x67\x67\x62\xb2\x31\xcd\xbd\xab\x26\xc6\xcf\xd2\x02\xd7\xef\xdc\x94\x17\xba\x58\x90\x9c\xe2\x86\x14\xeb\xc4\xbe\x89\xec\xfb\xe8\xde\x71\xf7\x57\xff\x8e\x89\x6f\x37\x3f\x05\x2d\xf1\x32\xda\x26\x96\x7e\xe3\x6a\xe9\xce\x76\x4a\xa5\x7d\xa8\x1f\x08\x41\x69\x4b\x86\x67\x44\x14\x71\x3c\x29\xf3\xd7\x64\xd6\x96\xb9\xc5\xf4\x69\x24\x54\x1e\x05\x5b\x37\xfc\xc4\xfd\xcb\x1a\xea\xc2\xe3\x36\xee\x2a\xf4\xab\xc3\x9a\xd1\x4b\x76\x2d\x8a\x33\xc3\xc6\x14\x7d\x57\x69\x83\x46\xd2\x77\xca\x50\x86\x8b\x42\xd4\x15\x91\x4f\x90\x20\x58\x41\x34\x50\x95\xb5\x0e\x23\x08\xde\x26\xeb\xed\x16\xfe\x3a\xdb\xa3\x46\x89\xa6\x7e\x06\x30\xec\xe8\x64\x03\xb6\x6d\xeb\x73\x5d\x2c\xdc\x1e\x7c\x63\x79\x53\xce\xd3\x25\x73\x5c\xe8\x1c\xb2\x55\xc4\x66\x16\x61\xd1\x35\xec\x67\x16\x54\x9f\xd1\x45\xbd\x0b\x56\x09\x86\x23\x39\xd4\x22\xe0\xa7\xf9\x9e\xc3\x50\x13\xbb\x26\x7d\x82\x7e\xeb\x29\x5b\x74\x23\x59\x2c\x2e\x63\x09\x64\x72\x8d\x4d\x7f\x93\x8c\xa0\x7c\x57\x98\x99\x17\x29\xe5\xde\x59\x30\x70\x7f\x1f\xa9\xca\xb0\xb4\x0e\x8b\x92\x5e\xe2\xec\x9d\xf4\x0c\xd0\xba\xb3\x64\x42\xba\xbe\xdb\xad\x17\x7f\x8c\xde\x6e\xa6\x21\xb4\xc9\x8a\x53\x4d\x09\xf4\x2d\xd5\xc0\xf6\x63\xed\xa5\x88\x7c\xe3\xd7\xb8\xde\x58\x99\xa3\xbb\x23\x9d\x75\xa9\xd3\x8e\x6d\xc8\x39\x65\x70\xb3\xc0\x55\x59\x0a\xbf\xf7\x82\x60\x15\xd4\xa7\xd0\xf1\xc1\xcd\xa1\x36\xac\xd5\xd9\xd4\x75\xc5\xf0\x09\x00\x1a\xe2\x98\x94\xc2\xcf\x21\x84\x03\x89\x33\xbf\x81\x0b\x56\x05\x5e\xe1\x41\x15\x2f\xff\xf8\x23\xda\xf7\x75\xc0\x65\x29\x7d\x58\x32\x5e\x2a\x4c\x4a\x27\xff\x68\x71\x21\x4d\x9f\xae\x32\x18\xd9\x3a\x12\xa0\x95\x07\xca\xf5\xc2\x59\x55\x67\x8e\x50\x11\x7a\xf3\x6f\xab\x82\x26\x9f\x5d\x7b\x09\x5b\xe2\xd0\xdb\x10\xcc\x58\x65\x4b\xd9\xdc\xd2\xf6\xd2\xd9\x10\xcc\x74\xb4\x31\x6e\xc6\x7f\xc1\xa5\xb0\x11\x83\xb3\x0a\x97\x6b\xb4\xa8\xea\x10\xc2\x35\xae\xd5\xfe\x7a\x5d\x15\xe0\x54\x84\xa0\xba\xd0\x24\x9b\xd4\x17\x4e\x63\xaf\x47\x14\x07\x6f\xa8\x53\x59\xc3\x25\x3a\xba\xdd\xc3\x1b\x1c\xae\x77\x62\x42\x5b\x00\xed\x19\x4a\xe5\x85\x0e\x1b\xc5\x45\xe7\x54\x66\x46\x1e\xf6\x88\x37\xe8\x76\x6c\xc0\x2e\xae\x21\x3d\x87\x1a\x82\xa3\x53\x4f\x1b\x85\xbb\x86\xfd\x18\xd6\x62\xb2\xf5\x86\x8d\xbb\xbf\xe3\xb6\x99\x8f\x7e\x6c\x1b\x2e\x8a\x21\xd3\x0a\x5e\xa7\x88\x80\x30\x90\x94\x7a\x7e\xf4\x86\xbe\x81\xe8\x93\xbc\xb1\x8f\xd0\x8b\x4a\xcc\x11\x2d\xa5\x72\x54\x48\xad\x4e\x95\x51\x4c\xe8\xae\xe0\xcf\x26\x35\x18\xa2\x55\xa8\x73\x51\xd8\xb9\x59\x9c\xd9\x2a\x4e\x26\x98\x57\xbc\xe5\x8d\xe6\x58\x54\xba\x60\x38\x3f\x8f\x26\x07\x73\xf1\x01\xc2\xed\x8f\x13\xad\x45\xf8\x97\xc1\x12\x42\x68\x2a\x3a\x46\x6e\x86\xcc\x6a\x4e\x0b\x22\xbf\x7b\x8a\x6c\xa7\x73\xbc\x5f\x82\x89\xb7\x3a\x86\xa7\xdf\xc2\xab\xbf\x86\xe7\x56\x8e\x26\xfe\xfd\x0a\x1d\x86\x8c\x71\xe3\x1c\x40\x3d\x76\x84\xcb\x0e\xab\x34\xd0\x91\x52\x3e\x28\xfb\xb8\x2b\x87\x50\xff\x91\x4e\x03\x1e\x52\xbc\x1b\xed\x52\x8e\x52\x3f\xb7\x91\x2f\xdf\x3f\xb9\x81\x19\xf4\xb1\x3a\x34\x70\xd3\xc7\xe7\x13\x59\xe9\x44\x05\xee\x6e\x4a\x6c\x92\x54\xef\x5f\xf2\x31\xc8\x1b\xab\xfa\x03\x37\x93\xab\xfb\xfb\x8c\x78\x43\x0b\xd3\x4e\x91\x8c\x98\x26\x42\xf4\xfa\x86\x1f\x52\x62\xbb\x0c\xdb\xd7\x45\x0a\x6d\xfb\x0c\xf5\xb4\x20\xc0\xf6\x68\x43\x21\x3e\x17\xac\xeb\x97\x44\xc4\x10\xd9\x32\xbd\x6e\xb4\x98\xc7\x25\xa8\xa8\xc5\xfb\xa0\x57\x19\x15\xc6\xbb\xcb\x35\x62\xa4\x69\xa4\xae\x49\x6b\x85\x48\x53\xa1\xff\x26\x75\x65\xa9\xac\x76\x32\x26\x87\x63\xf5\x77\xf0\xcb\x32\xa5\x03\x92\xea\xad\x95\xd2\xfd\x7d\xf9\xb2\xcc\x10\x12\x5a\xa4\x20\x52\xbf\xc1\x11\x2a\x81\x7c\x12\xb3\x73\x72\x86\x1b\x19\xa0\xed\x41\x2d\xbc\x25\xf4\xd1\x3b\xb2\x56\x1e\x8a\xf1\x50\xb3\x16\x4e\x99\x67\x06\x59\xdd\xfc\xc1\xc2\x26\xa8\xb5\x34\x87\x45\x0d\xaa\x4b\x52\xd3\x2a\x47\xab\xb9\x8c\x17\xf1\x53\xc4\x5d\x97\x45\x4d\x44\x18\x89\x50\x89\xfc\xf2\xd0\x35\x29\x60\xaf\x99\x6c\xb2\xb4\xd3\x22\x96\xb2\xb4\x65\x36\x01\x17\x03\x03\x21\xd0\x55\x1f\xb7\x8b\x91\x68\xea\x96\x78\x92\x70\x0a\xac\x47\xf5\xef\x94\x21\x49\x91\x46\xce\x91\x9f\x6a\x6d\x42\xe2\x3c\xa3\x2d\x1d\x6f\x94\xa0\xbe\x3a\xf7\xee\x60\x9c\xe2\x33\x5c\x30\xd7\xcd\x29\x6a\x0b\x38\xee\x2d\x99\xfb\xa1\x9c\x48\x83\xdc\x5c\x1b\x25\x89\x79\xeb\xc4\x85\x44\x35\x98\x62\xc1\x2a\xe9\x35\xf4\x06\x78\x87\x17\x32\xc6\xc9\x4a\xb8\xd8\xe4\x4b\x5c\x41\x85\x1d\x5e\x15\x32\x14\x28\x95\x52\xaa\xdf\x8c\xf8\x54\x3d\x8c\x84\xd1\x1e\xf1\x35\xdd\xf0\xa2\xfb\xb4\x9b\x0e\x96\xb8\x67\xd7\x39\x80\x65\x75\x09\x7f\xbe\xae\x30\xef\xed\x4f\xa9\x7a\x30\x47\xf7\x90\x09\x9e\x94\x35\xe0\x8c\x34\xfb\x91\xce\x10\x6d\xd0\x8c\x96\xb9\xc7\x0e\x64\xc8\x97\x28\x97\xcb\x10\x2d\x59\x3b\x9b\xd1\x8c\x12\xd3\x6f\xd5\x08\xa1\xe1\xee\x03\x27\xbc\x2a\x90\x17\x18\xec\x5d\x6f\x7e\x23\xd5\xac\x84\x68\x1b\x4a\xb5\xf1\x72\x27\xde\x7d\xe7\x6e\x4c\xdf\x5c\x1f\xb1\xbe\x2a\x0c\x5f\x88\x70\x87\x5b\xbf\xae\x6c\x04\xed\x7b\xaa\x26\xd2\xe2\x3e\xd8\x59\xca\xb1\x1f\xa6\x8d\x5f\x91\xc6\xd7\x9d\x6f\x63\x0a\x59\xb5\x5c\xc7\x74\xe7\xbe\x49\x58\xc1\xd7\x9d\x73\x78\x65\x9e\xd3\x82\xe1\x6d\x4d\xc3\x0e\x08\x8f\x4c\x82\x0f\x14\x88\x99\x3f\x13\x11\x55\x4d\x3d\x33\x40\xb0\x48\x90\x12\xa9\xe4\x4f\x7e\x69\xc4\x8c\xb8\xf1\x43\x28\x94\x22\x73\x5a\x94\xcf\x56\x41\xa3\xe2\x1d\xd6\x5e\x46\x0a\xb5\xc1\x09\x57\x17\x98\xbe\xd3\xc5\x48\x2b\x98\x3f\x44\xdd\x5d\x55\x4d\x43\x4a\x68\x9c\x51\xc2\x31\xc4\x30\xae\x88\xb0\xbb\x0c\x43\x6b\x14\xe8\x32\x0f\x02\xf8\x01\x3d\xf0\xb3\x5c\x12\x11\x6b\x41\x02\x72\x04\x13\xd7\x7d\x00\xa8\x16\xda\x85\xa8\x0d\xbe\xeb\xa6\x42\x87\x07\x07\xfe\x4b\xe2\xd2\xe8\x74\x8b\x4b\x61\x47\x98\xc5\xb5\x54\x2b\xcd\xe8\x0d\x5d\x04\x2b\x50\xcd\x04\x40\x5c\x28\x76\xe6\xc5\x05\xc4\xdc\xe0\x2a\xa9\xf5\xfc\x8c\xbf\x14\x2c\x7d\xdc\x96\xb2\x1b\x17\x90\x82\x06\x69\x88\x5e\xa1\x5a\xd7\xaa\xc2\x7f\x7d\xde\x16\xa6\xd1\xeb\xb7\x42\x9f\x1d\x25\x20\x5e\x39\xdd\x66\xe3\x38\x0e\xec\x49\x1b\x43\x6e\x50\xa7\x3d\x9b\x56\xe7\x4c\x2d\x83\x60\xec\xe8\x18\xf9\x8c\xfc\xad\x20\xd1\xaf\x9c\x1f\x08\x21\x2b\x66\x70\xc0\x9c\x1f\xec\xb9\xf1\x43\x7c\x68\x86\x46\x3a\x06\x7f\x5f\xe6\x21\x88\x5f\x65\x56\xc0\xbe\x1d\x25\x1f\x22\xba\xbf\xaf\x73\xa3\x34\xf0\x93\x17\x67\xe2\x7c\xf9\xc7\x39\x1a\x9f\xe0\x92\x02\xdd\x31\x09\x5b\xe8\xde\x3d\x34\xba\xe3\x2d\xc9\x1f\x7f\xa0\x3b\x2e\x65\x52\xc1\x08\x96\x14\x37\x44\xfb\xb0\x7f\x6e\x55\x69\x8f\x0d\xe8\xdd\x0c\x81\x4e\xa6\xa1\xc6\x5f\xcf\xaa\xb2\xa1\x65\x1b\x12\x38\x6e\x7d\x5a\x92\x7a\x41\x19\xa3\x55\x59\xa8\x40\x70\xb5\x19\x84\xb0\x11\x5d\xe7\x17\x2f\xdf\x7c\x77\x84\x4e\xec\x50\x7b\x11\x09\x6c\x49\x5e\xcb\x9a\x56\xb5\xf2\xbf\x1d\x1e\x1c\xec\x3d\xff\xe1\xe5\xcf\x61\xba\x7b\x0c\xfc\x48\xe6\x54\x79\xbb\x69\x0c\xdc\x4c\x08\x0e\x82\xe7\xc9\x40\x1b\x8c\x1a\xb2\x58\x56\x35\xae\xd7\xe8\xaa\xc6\x99\x56\x08\xe5\xf7\xb1\x31\xc4\x10\x0d\xd4\xf3\xb8\xaa\x71\x99\xcf\x30\xb4\x51\xc8\xfd\x1e\x95\x0b\xbc\x96\x57\x8b\x14\xfc\xf8\xa4\xf9\x48\xc1\x54\xa6\xb1\x71\xa0\x16\x00\x34\x4b\x70\xc8\x05\x73\x23\x14\x52\x0e\xec\x03\xf3\xf2\x75\xfc\xbc\x84\xe9\xb3\xfb\xfb\x08\xed\xa1\x97\x05\x3f\x64\x2c\xa0\xd5\x44\xf5\x1a\xb5\x55\x5d\x80\x0c\x76\x97\xf8\x19\x14\x20\x5f\x90\x95\x00\x29\x52\xda\x8c\x72\x27\x60\x9a\xc2\xc9\xfa\xc2\xdd\xea\x74\x1f\xbb\xa7\xdb\x3f\xdc\xfc\x23\xf7\x70\xef\xfe\x6c\x7f\xc2\xa3\x16\xb5\xa2\x1d\x1e\x74\xf7\x5b\x50\x4a\x91\x8b\x66\x52\x5d\x77\xb2\x1d\x55\xb2\x1b\x74\xa7\x25\x75\x83\xb9\xa2\xa9\x33\x6b\x44\x3e\x0d\xbe\xc6\xb4\xe0\x9c\x2d\x91\x50\xc3\x2a\xb7\xb5\xf8\x82\x6f\xe3\x58\x06\x30\x88\x4b\x30\x6a\x33\xc7\xa5\xf5\x06\x8c\xe3\xc0\x34\x89\x39\xfc\xd0\x55\x8b\x62\xcd\x57\x50\xe4\x08\x68\x11\x06\x44\x17\x21\x78\x51\x51\x21\x30\x77\xb3\x28\x5f\x58\x3c\x47\x31\x05\x03\x86\x6b\x64\xfc\x35\x06\x6d\xd3\x6a\x37\xc2\x4e\x4b\x41\x54\x86\xc1\xaa\xe2\x3f\xee\x08\x27\x45\x11\x4c\xb5\x20\x33\x63\x2b\x9d\x35\xa4\x76\x01\x20\xf2\x9e\x36\x62\x6e\x74\xb1\x2c\x68\x46\x1b\x7b\x76\x4d\x25\x9c\xd6\x7c\x82\xae\x06\xaa\x97\x81\x89\x5c\x16\x8e\xa6\x14\x72\xa1\x10\xb5\xb5\x01\x98\x12\xde\x2c\x05\xb4\xa9\x71\xc9\x68\x38\x85\xa4\xda\x18\x31\x4d\x38\x76\x91\xc0\xb6\x21\xdd\xab\xda\x65\x7a\x73\xc7\x79\xa0\x10\x75\x64\x8f\x26\x81\x2b\xfc\x65\x02\x6a\x04\xaa\x4e\x10\x4a\xc2\x70\xb3\xb5\xdc\xe3\x18\x80\x83\x6d\xf0\xa6\x3a\xc9\x73\x8b\x5e\xc7\xe8\xed\xaf\x81\x5e\xf4\x03\x98\x60\x61\xbb\x31\x59\x71\xda\xeb\x11\x1d\x35\x22\x3b\x29\xd2\xdb\xa4\x83\xbb\xba\xff\xb0\xb4\x70\x36\xe6\x02\xe3\x87\x8f\x01\x36\x64\xb1\x6c\xd6\xee\xa3\x66\x71\xbc\x91\xd0\x31\xfa\x70\x78\x84\x3e\x7c\x9c\xa0\x07\xe2\x9f\x87\xe2\x9f\x47\xe2\x9f\xc7\xfc\x9f\x8d\x93\x43\xc0\x98\x14\x41\x63\x40\xde\x38\x8b\xc4\x59\x03\x8b\x32\x11\xb3\x7a\x47\xaa\xfa\x6d\x51\xe1\xdb\x5d\x13\x29\xde\xa3\x63\xef\xf3\xb7\x89\x10\x0e\x6b\xdb\xbc\x8e\xcb\x8d\xac\x73\x2f\x21\x27\x0c\x44\x63\x2c\x87\x43\xcf\x8e\xed\xbc\x54\xf9\x61\xfc\xa2\x14\x31\x57\xb8\x28\x14\xf7\xaf\x45\x6e\xd5\x44\x79\x3e\x0c\x77\x84\xa7\x94\x3d\xc3\x2d\xf7\xe4\x93\xd4\xe8\x7d\x71\x3a\xf5\x84\xe3\x6e\x77\x71\x47\x82\x71\x75\x23\xa6\x14\xa5\xbe\x92\xec\x64\x14\xc5\xd3\x49\xf9\x46\xcf\x06\x13\x35\x45\xe9\x17\x10\x23\x1f\x92\x1a\xa4\xb2\x3c\x07\x6e\x6d\xdb\xb9\xfd\xab\x26\x9e\x8a\x9f\x1a\xed\x54\x95\x1d\x43\xf3\x6a\x85\x16\xca\xbc\x02\x16\x45\xb9\xb8\x26\xf6\x4a\x8f\x24\x6c\x3c\x2a\x06\x2b\x1a\x7c\x85\x94\x71\xbb\x5d\xbc\xb0\x37\xb1\xe2\xcd\x26\x98\xe8\x46\xf4\xde\x0b\xe9\x3d\x9c\xda\x70\xc2\x34\x7e\x27\x39\xd7\xfb\x37\x19\xda\x9f\x5b\x32\x2a\xe1\xbc\xcc\xb9\xf2\xab\x05\x0f\xca\xff\xa6\xba\xeb\x68\xb0\x86\xb8\xae\xf1\x5a\x5b\xab\x62\x16\x24\x9b\xc4\x39\x29\x08\xdf\x15\x32\xe4\x5a\xcc\x40\xd9\x70\x8f\x7d\xf6\xdc\x49\x91\xfd\x7d\xf4\xda\x15\xae\x7c\x8c\x87\x20\x25\x82\x63\x7c\xea\x74\xc4\x9f\xa9\xc5\x10\x43\xf2\x0d\x7e\x8c\x6a\x72\x4d\xea\x86\x5e\x16\x44\x60\xf4\x54\xcc\xeb\xd9\x68\x51\xe5\x6d\x51\x1d\x0d\x5f\xa9\x38\x27\x40\x4e\x9c\xaa\x88\xb7\xb1\x42\x41\x27\xb2\xfd\x85\x94\xc1\x26\xa8\xa9\xd7\x08\x5f\x61\x5a\x76\x41\x13\xae\x02\x0e\xa6\xac\x9a\x09\xe4\x64\xf0\x0f\xd2\x96\x40\xfb\x47\x44\x68\xe8\xa5\x7c\xab\xa9\xd1\x93\x6d\xa1\x7e\x92\x2f\x87\x5e\x1f\xff\x27\x58\xab\xe3\xf0\xa3\x58\xa8\x9f\xfa\x89\x6f\xb1\x88\x36\x83\x54\xe8\x8a\xbe\x37\x04\xfb\x96\x06\x43\x29\xf0\x0e\xb8\x38\x60\xbd\x68\xe9\x4c\xba\x67\x77\x95\xaa\x28\x72\x7a\xcb\xbc\xd5\xb0\xc3\xd0\x2c\xf5\x73\x83\xdb\x07\x49\x7d\xae\xe3\x4a\x9f\x42\x36\x50\xdf\x25\x96\xa2\xeb\x85\x92\x18\x65\x22\x82\x89\x5e\xd6\xfe\x27\xa1\x6c\x55\x52\x5b\x5a\x50\xf7\x9b\x89\x1d\x01\xbc\x22\xc2\x0a\x00\x62\x7f\x62\xe3\xc6\x99\x78\x27\x77\x77\x58\x6e\x38\x95\xce\x6c\x1c\xeb\x82\x54\x17\x9c\x7d\x49\x62\xb9\x93\x06\x5c\x94\x29\xf0\x3a\x51\x4d\x5c\xa4\x03\xef\xd1\x17\x95\xec\x0e\x0d\x81\x31\x84\xc8\x08\x72\x60\x00\x56\xf9\x79\x26\x83\xcb\xad\x70\x9a\xb5\x63\x69\x57\x4a\xeb\xae\x68\x3d\x98\x49\x0e\xb4\xfa\xbd\x4e\x84\xba\xb0\x98\x98\x20\x75\x5f\x5f\x5d\x41\xd6\x39\x56\xa9\xf2\x1d\x27\x22\x15\xb3\x1c\x9d\x92\x6f\x88\x19\x34\x2f\xa3\x9c\xc9\xd3\x77\x52\x14\x1d\x24\x8b\x07\xab\x73\xf1\x41\xd4\x9c\x64\x50\x21\x53\x87\x97\x5b\x15\xa5\xec\xb3\x17\x1e\xd1\x18\x54\xd8\x43\x22\x02\xd6\xda\x85\x6c\x2a\x0a\x44\x64\xe0\x86\x2a\xaa\x15\xa9\xfd\x27\xa4\xfd\x25\x06\xb3\x24\x2b\x1b\x3b\xbe\xfd\x14\x08\x61\x82\x31\x95\xa2\x2c\x80\x31\xdd\xc2\x51\x94\x8d\x7e\x63\x2a\x8f\x88\x0d\x9a\x30\xbf\x79\x82\x5b\xe7\x3e\xf6\x47\x89\x2c\x6b\xc7\x22\x7f\xe2\xf8\xf3\x0d\x63\xcf\x35\x98\xe1\x61\xe7\xce\x70\xa2\x56\x78\xb2\xa2\x16\xb3\x2a\xe8\xc4\x8c\x84\x66\xf7\xa7\xec\x83\xa7\x98\xb3\xff\x48\x7e\xb7\xb4\x22\x55\x68\x89\xd7\xa6\x4e\x47\xe5\x19\xc2\x1d\x58\x97\x98\xf1\xc3\x51\x06\xbd\xb3\x4c\xef\x31\x2f\x74\xfb\xb6\x4a\x31\xfb\x5a\xbb\xa9\xd6\x6f\xda\x41\x9c\x80\x20\x7e\xec\xc1\x9b\x86\x7d\x2b\x90\xed\x4d\x0c\xfa\x18\xa8\xf7\x92\x9d\x31\x7c\x23\x90\xd3\x9a\xb9\xf4\x09\x1a\x52\x7c\xa2\x8a\xbb\x2c\x28\x67\x29\xba\xc9\xb9\x03\x17\x7a\x0d\x47\xe6\x67\x95\x4b\x8b\x09\xe8\x10\xe1\x2d\xcb\xad\x1a\xf4\x5f\x61\x9a\x8b\x06\x22\x47\xce\xa4\x27\x70\x11\x3c\x27\x84\x1d\xa1\x83\xe9\xc1\x44\xb6\x46\x91\x7f\xcc\x08\x61\xdf\xb6\x75\xa9\x3f\x20\xd6\xf2\x3d\xb7\xfa\x60\x78\x2b\x1b\x67\xb9\x22\x52\x55\x5c\xaf\xe5\x5e\x65\x4a\x6c\x18\x3b\x99\x4c\xd6\xe6\x97\x7a\x79\x1f\xaa\x80\x32\x69\x21\xd6\xa6\x50\xab\xaa\x49\xd4\xad\xa4\xed\x59\xca\x55\x17\x96\x83\x89\x6b\x59\xea\x5c\xa6\xea\xcb\x38\x40\x23\x33\x14\xe7\xd3\xe5\x6b\x28\xdc\xb3\x33\x42\xa4\x43\x5a\x5a\xfd\x38\xf1\xa7\x57\xa4\x79\xae\xbf\x88\x04\x9d\x89\x65\xf1\x1a\x95\x44\x5a\x81\xd0\x99\x3d\xc0\x53\x77\x83\x87\x9b\xc5\x01\xeb\xf5\x82\x41\x7b\x16\xa8\x2e\x76\xbd\xbf\x8f\xbe\x05\x1f\xbe\xa8\xe2\x43\x88\xf0\x3c\x4b\xeb\xb6\x6c\xf4\xa4\xc4\x3a\xbe\xa5\xfc\x96\xe5\xd2\x07\x6d\xf7\x35\x44\x05\x66\xdd\x6b\x2d\x69\x09\x41\x2f\x5d\x81\x05\x9c\xbc\xf0\xd0\x28\xec\xcd\x24\xe1\x43\x27\x28\xf4\x74\x4f\xc3\xd3\x69\x00\xc2\x97\xff\x5c\x1c\x11\x78\x4c\x27\x06\x18\xda\x84\x31\xd9\x3f\xf2\x63\xed\xb6\xce\x06\xa7\xa8\xdf\x70\xc8\x5a\x35\x77\x25\x52\xb1\xfd\x30\xe9\xa2\x12\x78\xfd\x08\x7d\x04\x36\xca\x51\xba\xf7\x5c\xbd\x3c\x15\x6f\x47\xef\x4c\x0b\x7a\x5c\xa3\xe9\x0c\x43\x02\x23\x3b\x74\xfb\x98\x1a\xde\x6f\xc7\x20\x09\x5c\xc4\x8c\x39\x3d\x66\xa4\x26\x65\x16\x2b\x23\x61\xaf\x4f\x90\xc4\xe1\x61\x3a\xe5\xf0\xbc\xde\x5f\x0e\x51\xc7\x9d\x2e\x38\xb3\x34\xa6\xb9\x10\x2d\x13\x37\xcc\x8e\x02\x5d\xbc\xf1\xa2\x2d\xc4\x5c\xe0\xb2\x39\x4d\xec\xc5\xd8\x3d\x85\x62\x86\xa3\x20\xb7\x53\xb7\xf5\xf2\x29\xec\x90\x3f\x91\x67\xcc\x9f\x88\xb5\x57\xe4\xf4\x34\x59\x54\x9a\x92\x06\xe7\xa1\xad\x55\xd0\x0d\x73\xa8\x3a\xe0\xd9\xe3\x47\xa8\x9a\x6e\x62\x92\xb0\x5a\x46\xc9\x8d\x22\x89\x49\x5b\x52\xdc\x43\x28\x61\x29\x8b\x75\x8b\x13\xf7\xbf\x8a\x59\x89\xef\xbb\x41\x89\x5e\x36\x50\x57\x1c\x88\x08\x01\x3e\x66\x11\x5d\x0e\x90\xdd\x04\xc7\x70\xf7\x6d\x84\x46\x24\xe9\x53\x09\x40\xe8\x38\x75\xdf\xb9\x97\xea\xb3\xe3\xbe\x5b\xd5\x82\x98\x14\x21\x5d\xf9\x63\x7b\xa1\x4d\xfd\x66\x24\x37\x87\xe9\xb9\x32\x9c\xb3\xbd\x64\x9a\xd7\xb6\x42\xdd\x27\x11\xe8\xb6\x12\xe6\x6e\x22\xc8\x41\x0b\x3b\xd6\xd4\xd5\xda\xbb\xbf\x67\x40\x63\xab\xc0\xb2\x0a\x7c\xa8\x16\x04\xe2\x05\x1c\x30\x40\xf0\x7a\x7a\xd9\xd6\xe5\xc8\x3d\xd5\xe9\x5e\x0b\xca\xb5\xc3\x9c\xba\x93\x4e\xff\xac\x20\x66\x29\x91\xfd\xb2\x65\xb7\x9a\x48\x04\xb9\x18\xf6\x45\x50\x58\x51\x79\x2b\x36\x08\x66\xbe\x13\x50\x5a\xb5\x68\x66\xed\x42\x5b\xfe\x84\x8a\x19\x49\x07\xe6\x47\x15\x0e\x81\xc8\x52\x4c\x8e\xfd\xc6\x3c\xe3\x49\x7c\x7c\xdd\x6c\x00\xc7\x09\x21\x4b\x2a\xda\x11\x02\x79\x2d\x18\x41\x25\xb2\xfb\x2f\xfe\xda\x55\x22\xd1\x71\xdf\xc1\xb4\x45\xbf\x34\xd1\x91\xb2\x2d\x1a\xba\x2c\xa8\xe8\x33\x65\xe2\x77\x1c\x7a\x40\xf4\x4e\x10\x4e\xad\x09\x23\xf0\x12\x5d\xd8\x86\x75\xcb\xd8\xb7\x09\x12\x72\xc6\xa8\xf0\x73\x84\xde\x46\x40\x07\x9d\x27\xe3\xd1\x12\x6f\x74\x23\x11\xbb\x4c\x28\x1c\x24\x7e\xdb\xcd\x49\x61\x05\x07\xc5\x98\x4a\x98\xf0\xcd\xda\x85\x1c\xfc\x67\x05\x41\xa8\x45\xe9\xb1\x4d\x57\x50\x66\x5a\x6c\x44\x47\x4b\x1d\x30\x6b\x70\x20\x1e\xd7\x10\x5c\x76\x73\x21\xfb\xdd\xc5\x71\x89\x25\xd3\x27\xa7\x2c\x83\xcf\xe5\xd6\xf1\x41\xa9\x7a\x22\x16\x39\x95\xd3\x4f\x91\x34\x38\xd5\x65\xc8\x19\x93\xe7\x29\xc5\x45\xc3\x24\x27\x13\x21\x10\x83\x7f\xdb\xc1\xe1\x76\x60\xdc\x7d\xc3\x43\x33\x5c\x72\x52\xa8\xa2\xa8\xb9\x28\x6d\x9b\xd3\x19\x68\x1d\x76\xa3\x91\x18\xc4\x73\xbb\x2b\xa9\x05\x55\xf8\x00\x58\xe5\xc2\x85\x43\x8d\x17\xa4\x0b\xa8\x51\x3b\xcf\xe4\xab\x6f\x2a\xab\x8a\xeb\x71\x8c\x76\x2a\xdc\xfb\x4e\x38\xeb\x21\x1b\xb0\xff\x99\xde\x0a\x48\x71\x7b\x8e\xc3\xcd\xca\xaa\x5e\xe8\x73\x2d\x8f\x98\x2e\xed\x63\x8a\xd1\x42\x68\x9d\x8a\xa9\x33\xeb\x12\xc0\x77\x7b\xb3\x9e\x2c\x64\x81\x95\xbe\x22\x3d\x5f\x06\xac\xb0\x07\x32\x3b\xe1\xb8\x00\xeb\xa8\x0a\x08\x83\x39\x0e\xc7\xfd\xb2\x63\xc9\x3a\xbd\x0b\x46\x7e\x90\xdb\x43\x30\x20\xc3\xdf\x15\x59\xa2\x47\x55\x2e\x70\xc8\xde\x22\x1f\x7e\x85\x46\x01\xda\x7b\x5d\xf3\x4c\x64\x43\xf8\x3c\x3f\x7e\x2c\x7d\x76\xef\x95\x3b\x8d\x1f\xcf\x14\xd3\xd3\x9c\x5f\x1e\xdd\x04\xbf\x45\x76\x26\x8e\xb5\xf3\x68\x6d\x9d\x42\x7d\x40\x45\x37\xe8\xe8\x14\x37\x2b\x2a\x82\x3a\x0b\x8b\xa0\x0d\x8b\x8b\xa0\x0d\x0b\x8c\xa0\x61\x45\x46\xd0\xae\x0b\x8d\xa0\x1d\xf2\x96\xee\x32\x13\x49\xe7\xb8\xcb\x5e\xc2\x4e\xde\xc2\x0b\x41\x99\xd3\x1c\x0b\x0a\x8b\xa7\x9d\xc5\x3e\xb7\xb6\x8a\x74\x77\x8a\x1d\x51\x80\x2a\x98\xc7\x52\x89\x35\x9f\xea\xa9\xdd\x31\x80\x4d\x25\x46\x88\xf1\xab\x38\x12\x9b\x31\x2d\xe4\x32\x2e\x7d\x26\x2d\x37\x91\xe1\x5f\xac\x5d\xa4\x76\xcc\x50\x66\x15\xc7\x79\xaf\x77\xba\x89\xbd\x2a\x83\x71\xba\x09\x25\x05\x7e\x1d\xcf\xdf\x11\x99\xa1\xea\x18\xb9\x0a\xd7\x7d\x86\xb2\x36\x1e\x54\x40\x67\xc1\x9c\x42\x0c\xbe\xdc\xa0\x99\xd7\x58\x1b\x81\x93\xb6\x26\x25\x40\x9d\xb6\x8d\xbd\xf1\x76\x87\x43\x7a\xe8\xa1\x17\x68\xf8\xed\x57\x2e\xca\xe9\x21\x36\xdf\xf8\xe1\x23\x7b\xde\x60\xb1\x71\xe2\xc1\x50\xfe\x3d\x38\xed\x6b\x8f\x6c\xd9\xcb\x74\x87\xe4\xfe\xcd\x1c\x22\x93\x32\x82\x3b\x98\xd8\x0d\xdb\x3b\xef\xf8\x3e\x60\xc2\xa3\x28\x83\x98\xfc\x2f\x3b\x4d\x67\xc2\xed\x09\xa1\xaf\xc6\x34\xc1\xa4\x07\xd4\xe1\xa0\x13\x29\xe9\x95\xd5\x82\x96\xc2\x1c\xcc\x4c\x55\x18\x6d\x4a\xf1\xa1\x97\x15\x0a\x55\x21\x88\x73\xcd\x29\x6b\x6a\x7a\xd9\x36\x51\x3e\x35\x41\xac\xb2\xf4\x6b\x39\xd6\x34\xd0\xdd\xd4\xab\x4a\xc5\x97\x7a\xb3\xef\x51\x93\x41\x13\xce\xb5\xa6\x0c\x7b\xd2\x92\xb0\xd7\x7f\x0d\xfa\x86\x88\x00\x62\xca\xe3\x93\xc0\x32\xc2\x54\xf7\x03\xa0\x1d\xc6\x08\xdf\xf9\xfd\xaa\x68\x6d\x9e\x1d\xd8\x13\xbe\x8a\x22\xe2\xef\x88\xfd\x20\x3d\xdc\x69\x25\x2c\x1d\xe1\x1d\x8a\xa3\x63\x6e\x4a\x14\xb7\x4e\xeb\x47\xb2\x6d\x4e\x0f\x87\xdf\xad\xda\xb9\x0b\x3d\xc5\xa1\x7e\x34\x2b\x20\x1c\xe1\x58\x55\x23\xed\x4a\xb7\x1b\x40\x89\x4f\x2c\xef\x7b\x15\x03\xa5\xb6\x08\xe5\x89\x65\xc9\xd3\xb9\xcf\x0a\x90\x4e\x58\xb7\xf5\x48\x15\x05\xa9\xa5\x41\x37\x1c\xc5\x2d\x39\xfa\x6f\x35\xe0\x06\x6a\xc0\xfe\x2d\xc8\xe2\xbb\x12\x9d\x7b\x4e\x0e\x8a\x4a\x86\xee\x11\xfa\x64\xe2\xa0\x16\xcb\x3f\xb5\x0c\xb8\xc5\xc0\x43\x04\x3f\x8f\xd7\x59\x1f\x6d\x2b\xe2\xf5\x4c\xe0\xf3\x12\xe6\x04\x12\x7d\x85\x09\x51\xcc\x25\x3c\x5c\xa2\x4b\x0d\xb2\x3b\x31\x0e\x9c\x3c\xd2\xfd\x73\x3c\xc0\xe7\x31\xc0\xad\xe0\xf8\x44\xa2\x98\xc6\x23\x1b\x25\x1a\x1d\x0e\xb2\x9e\xb0\x46\x11\xeb\x2c\xab\x87\xca\x4e\x42\xa2\xb0\x92\x1b\xd4\xc8\xef\x06\xe6\xc9\x65\xd0\x30\xcd\xe2\x66\x76\x74\x92\x2a\x33\x22\x52\xa3\xaf\x2d\x63\x6f\xb4\x78\x93\x0f\x0a\xe2\xf2\x45\xa5\x27\xae\x93\x58\x4d\x43\x20\xc8\x49\xa0\xaf\x82\xe2\xd7\x61\x31\x5c\x0e\xef\xa7\xd2\xee\x9e\x4f\x98\x0a\xa3\x87\x48\x7f\x74\x09\xa5\x89\x04\xd4\x8a\x69\x1a\xc8\xb2\x3e\xd8\x69\x34\x62\xd7\x5f\xf4\xfd\x84\x7c\x72\x32\x84\xa6\x24\xab\x58\xac\xe6\xb0\x5e\x86\x43\x2a\xa4\xc7\x5b\x9e\x59\xc1\x43\x06\x99\x23\x74\x8a\x4b\x08\x97\xb4\x16\x98\x46\x7b\x16\x71\x71\x9f\x35\xb4\x80\xaa\x36\xcb\xba\xba\xaa\x09\xeb\x6e\x78\xf8\xa9\xea\x64\xa5\x66\xb6\xc3\x22\x59\x9d\xe8\x77\x77\xe4\xf8\x34\x55\xaa\x13\x34\xb8\x41\x55\xea\xd7\x3a\xeb\xba\xb3\xf2\x12\x97\x37\xc0\xed\xef\x4f\xc0\x72\x69\x7b\x24\x08\xe3\x0a\x36\x4a\x63\xf6\x07\x8a\x27\x32\x77\xd3\x13\xee\x76\xb7\x62\xed\xff\xac\xaa\xe4\x3b\x5e\xef\xdb\x0d\x48\x48\x17\xb7\x4a\xeb\xa3\xbb\xaf\x6f\xa5\x0b\xc4\x1c\x6f\x57\xf4\x49\xa7\x30\x05\x45\x07\x71\x34\xac\x02\x25\x9a\x5f\xa4\xca\x8a\xff\xf1\x87\x41\x31\xae\xd7\x44\x48\x60\xd5\xc5\x12\xd2\xfc\xb7\x10\x7f\xf3\x66\xbd\x24\xb1\xb6\x05\x37\x84\x70\x27\xe6\xcf\x0c\xe6\x13\xc5\x1d\xe2\xb0\xec\x61\x6e\xde\x5e\x26\x1e\xa4\x97\xb2\x0f\xdc\x7a\xe3\x97\x18\x32\xce\x99\x7a\xeb\xcc\x34\x99\x8e\x1a\xcf\x37\x53\x5d\xcd\xe6\xb6\xc0\x63\xf6\x5f\xb4\xce\x7f\x6a\x07\x6a\x91\x67\x78\x61\xfb\x61\xf5\xec\x93\xf4\x0a\x46\x1c\xb8\x76\xc3\xdb\xf6\x98\x11\xfa\x57\x2f\xc4\x66\x68\xd6\x9f\xd5\x87\x41\x89\x8b\x16\x1b\x28\x73\x5d\x76\x7f\x01\x82\xac\x3b\xda\xa0\xb5\x09\x8b\xc9\x6f\xb6\x38\x1d\xb5\xd7\x93\xf4\x08\xc7\xdc\x68\x79\x38\x21\x7b\xd7\x47\x9e\xc2\xfe\xc5\x89\x20\x13\xc7\xc6\xeb\xad\x54\x56\xa8\xa8\xca\x2b\x52\x8b\xe4\xf5\x4b\x95\x9d\xb9\x10\x35\x05\x27\x56\x71\xf2\x85\x57\x26\xa9\x9a\x41\xce\xea\x75\x57\x42\x2c\x9d\x45\x65\xef\x81\xe5\x0b\x7b\x8c\x96\xa9\xea\x76\x5d\xa5\x3f\x6c\xce\xb2\x69\xd9\xad\xb8\x2e\x6f\xf6\xb7\xd9\xd2\x6d\x53\x2d\x70\x43\x33\xcc\xf5\x27\x27\x89\x97\x73\x1d\x29\x65\xa4\xa0\x39\xc9\xbd\x10\x3a\x05\x2b\x20\x6b\x3d\x44\xaa\x4f\xdb\x3f\x5b\x75\x1a\x08\x62\xad\x63\xdd\x0f\x43\xb9\x6f\xd0\xf1\x87\x9a\xb3\xe2\x1d\x6d\xe1\x60\x52\x6a\x8c\xc8\x34\x1d\xa1\x45\xaa\x3d\x87\x32\xe1\x1b\xeb\xe8\xfd\x3e\x25\x5b\xfd\x04\x49\x00\x71\xcc\x76\x1f\xf4\xdf\x73\x14\xed\x90\xae\x40\x4e\xea\xa8\x3b\xee\x41\xa3\x0c\x41\x31\x45\x9d\x88\x6b\xe6\xaa\xcf\xb3\xd3\xea\x23\xd5\x17\xc7\x9e\x32\xce\x9a\x16\x9b\xe2\xa5\xcf\xab\xfa\x05\x79\xdf\x80\x2e\x1e\x31\x8e\xc6\xba\xd9\xf5\x18\x50\xf7\x22\xe9\x08\x3e\x33\x4b\x31\x97\x2e\xe4\x9e\x26\x64\x6f\xbd\x4c\x92\xf1\x00\x3a\xaf\x4d\x35\xc5\xce\xce\xa2\x9f\x4b\x4b\x97\x10\x9f\x5d\x37\x56\x49\x18\x9f\x3f\x0b\x69\xba\x97\x62\xe9\x06\x3f\x92\x19\xc7\xaa\xa0\xa7\x15\x12\xb4\x8b\x56\x47\x28\xd1\xee\xa8\x5b\xb0\xdf\x41\xb3\xa3\xfe\xbd\xd3\x29\xf2\x7f\x82\x9d\x9c\x76\x74\x6c\x2b\xc0\x27\x56\x6c\x33\x19\x3e\xb5\x62\xbb\x6e\x50\x35\x50\xce\x8f\xad\xd9\x60\xfe\xd3\x21\xe9\xf7\xa3\xb3\xe9\xaa\xed\x56\xe0\x4f\xac\xe5\x86\x32\x7f\xf7\x62\x6e\xd1\x21\x2a\x45\xbe\xa1\x7a\x41\x7a\x39\x63\xaa\x41\xe2\xb0\xf6\x2e\xe6\x60\xc5\x00\x6d\x24\xcb\x1a\xc9\xa2\x47\xa0\x45\x3b\x10\x6a\xd1\xce\x04\xdb\x64\x83\xaf\x41\xe2\x2d\xea\xdc\xf4\xba\x05\xad\xd3\x51\xd5\x92\xe3\x94\xf6\x24\x63\x16\xed\xb8\x00\xdd\x7d\xb0\x59\x2f\xe3\xc2\xce\x67\x70\xef\x0e\x12\xd4\xa2\xc8\x0f\x93\x52\x0e\xa6\x07\x43\x2d\x0a\xb7\x47\xeb\xcf\x82\xce\x03\xd4\xfb\x44\x79\x25\xe5\x7d\x90\x74\x30\x84\x99\x51\x52\xe4\x32\xfb\x51\xe6\xa0\xb4\x2c\xda\x57\x26\x00\x5c\x0e\xeb\xd1\x1b\x2c\x5f\x18\xa0\x07\xed\xb4\xe4\x70\x2b\x31\xda\x7d\xe6\xbb\xcc\x9c\x97\x44\xee\x64\xac\xdd\x55\x08\xdc\x2b\x5e\x26\xb4\x00\xbb\xb7\x48\x19\x2d\x7f\x1b\xf3\x0d\xc6\x2d\xf4\x8e\xf5\x20\xd2\x91\x54\x97\x69\xd4\x0d\xa3\xe5\x32\x80\xf3\xd6\x54\x82\x36\x4e\xe2\x39\x66\x88\x94\x7e\xc1\xae\xfd\x7d\xf4\x86\x14\x05\x43\x2b\xdd\x78\x9a\xac\x54\x6c\xd2\x52\xa4\xca\xc9\x9c\xaa\x29\xa4\x91\xf1\xaf\xe5\xe7\x94\x99\x6c\x04\x31\x5a\x36\xc7\xe5\x55\x38\xc2\xa5\x28\x0e\x0f\x51\x32\xa2\xb5\x06\x7f\x57\x34\x62\x90\x6f\xea\x9e\xc7\x97\x44\x41\x31\xd5\xde\x88\x30\x01\xe9\xb9\x38\xf0\x45\x83\x74\xe9\xb2\x1d\x59\xb1\x89\x51\x87\xbd\x9f\x25\x39\x49\xde\x02\xc8\x0e\xb6\x11\xde\xfd\x61\x21\x00\x1d\x10\x03\xcf\xb2\xf7\x81\x9d\x21\x6b\x6d\x6b\xa8\xd5\x5d\xae\xf9\x29\xaa\xe1\x44\x65\x42\xa5\xb6\xeb\x72\x43\x74\xa8\x68\x4c\x80\x30\xac\xd2\x0b\x15\xfd\x25\xfa\x48\xe9\x06\x64\x92\xa4\x55\x5b\x67\x44\x7b\x7e\xec\xd2\x46\x7c\x04\xd6\xf0\x15\x13\x77\x26\xad\x7d\xef\x14\x80\xf5\x3d\xe8\x38\xb7\x8b\x0b\xd2\x5c\xb5\x21\xec\x22\x88\xb0\xaa\x41\xd0\x5e\x37\xdd\x9a\x55\x55\xc3\x99\xcc\xf3\x9a\x30\x36\x04\xb6\x79\xe9\x7b\xb2\x1e\xf2\x82\x64\x0e\x1b\x3f\xfd\xaa\x7a\x35\xe4\x05\x4f\x4f\x39\x42\x7f\xfb\xf0\xbc\x2d\xaf\xe8\x65\x21\xbc\x83\x53\x48\x92\xfe\x38\x3e\x42\x7f\x33\xce\x43\x80\x67\xa4\x4c\xcc\x18\xa9\x1b\x34\xea\xe5\x2a\x5e\xc4\x81\x8b\xd7\x82\x30\x86\xaf\x48\x6f\x18\x82\xb3\xa2\x3a\x12\xc1\xde\x66\x76\x48\x58\x3a\x2c\x41\x36\x0e\x0b\x63\x12\xc6\x76\x98\x8b\xea\xb8\x51\x0a\x77\x35\xba\x86\xd2\x34\x94\x21\x5a\x36\xa4\x14\x31\xaf\xc5\x1a\x2d\x39\x0d\x20\xa8\xc3\xe0\x06\x9d\x97\x04\x7f\xe2\x47\x83\xd4\x7b\xcb\xba\xba\xa6\xb9\x13\x49\xe2\x2f\x80\x19\x00\xfa\x45\x09\x26\x76\x85\x69\xc9\x1a\xdb\x2e\x1d\xf6\xc0\x40\xb8\x41\x05\x2d\x09\xba\x24\x45\xb5\x9a\x38\x43\xcc\x49\x89\xa4\x3c\xcd\x47\xa0\xa2\x2f\x13\x79\x41\x64\x3d\x9d\xd1\x18\xd1\xc5\x82\xe4\x14\x37\xa4\x58\x5b\xd9\x80\xa5\x6c\x55\x0a\x07\x2f\x17\x73\x31\x13\xb4\xc7\xe0\x6c\x17\x4c\xda\xfc\xa6\xad\x75\x9d\x01\x10\x32\x99\x88\x24\x86\x43\xaa\xd9\xae\x94\xae\x6b\xd7\x31\x8a\xa8\xd5\xcc\x43\xba\xdb\xf9\x80\xe8\xe9\x9e\x84\x8f\xbc\xe3\x4c\xf3\xae\xed\xed\xfd\x88\x93\x0d\x85\x3a\x87\xbf\x14\x39\xe7\xc1\x47\x5b\x81\x83\x33\xed\xfc\xb9\x01\x18\x9b\x2f\x98\xdf\xb7\x02\x00\xac\xc2\xf9\x73\x03\x30\x01\x03\x79\xba\x67\x6a\x1a\x89\x25\xfb\x8e\x9f\x1c\x51\xac\x09\x76\x37\x17\xfb\x8e\x10\xff\xff\xd3\xbf\x99\x67\xe1\x81\x67\xa3\xb1\x5d\xbc\x06\x02\x6a\xe4\x8e\x97\xdd\x1d\x17\xa1\xd1\x32\x57\x95\xab\x3d\x8e\x34\x0a\x25\xa9\xc1\x7d\xa3\xa2\xd6\x9b\x89\xb5\x81\x76\xc3\xbd\xde\xc4\xa2\x77\xdd\xa6\x87\x82\xb3\xc9\xee\x69\xd5\x0c\xfd\x32\x4a\xd9\x96\x54\x53\xe1\xc0\x8b\x85\x46\xbf\x8c\xe4\xaf\xe3\xb1\x96\x60\xb2\x79\xc5\x64\xdb\x56\x51\x59\x73\xf4\xcb\x08\x66\x36\x8e\xf2\xc2\x44\xb0\x2a\x83\xce\x98\x4f\xf7\xee\xa8\xa3\xea\x70\x4f\x19\x49\x68\xee\x7e\x41\x06\x54\x5d\xfe\x17\x01\xed\x45\xc9\x77\x0e\xcf\x06\x76\xc3\x82\x8b\xde\x65\x0c\x06\xd8\xd3\x3d\x8b\x35\x88\xcf\x24\x6b\xb0\xd0\x37\xcf\x4f\x3d\xe6\xf7\x74\xcf\x23\xa8\xf5\x96\xc4\x5f\x78\x26\xad\xcb\xcf\x92\x81\x5e\xcb\x9b\x87\xc9\x59\x1a\xcb\xc0\x8a\x36\x73\x84\x51\x5b\xd2\x7f\xb4\x04\x9d\x9f\x69\xca\xb3\x25\xc9\xe8\x8c\xfa\xe5\x43\x34\x48\x11\x89\xaf\x3a\xb3\x1a\x80\x8a\x6a\xb2\x02\x2a\x5c\x2a\x51\xa9\x47\xdd\x86\x2f\x88\x71\xca\x68\xc3\x80\x14\x0a\x36\xbd\xfa\x35\xa0\x3f\xed\xe6\x8f\xcd\x2a\x26\x00\x58\x1e\xae\x9b\x5c\xfe\xbb\x89\x25\x8a\x92\xc8\xcf\x11\xb1\x1a\xae\x7d\x2a\xd2\x40\x2d\x9b\x52\xee\x1c\x40\x28\x49\x04\x8f\x03\x0f\x72\x21\x75\xb2\xe2\xa4\xf7\xe0\x58\xfb\xe9\x76\x48\x85\x3f\x8b\xc7\x1a\xff\x6c\x94\xb2\x43\xcf\xce\xe6\xb1\x02\x65\xb2\xe1\x62\x2a\x62\xe0\x53\x6d\xba\xa0\x0d\xbe\x66\xf9\x9c\x60\xe7\x67\xe8\x17\x75\x80\x38\x9d\x41\x92\xed\x91\x7a\x3d\x93\x96\xba\xc7\xfc\x09\xc6\xaf\x34\x2e\xb7\x96\x99\x84\xe4\x5a\x75\xcf\xcf\x44\x51\x69\x52\x27\x32\x97\x5c\xa3\xd0\x99\x31\xb2\x4a\xad\x79\x14\xcb\x63\xd2\xdf\xca\xd2\xe2\x0f\x1f\x8c\x0e\xc7\x2e\x42\xa7\xe2\x2a\xf3\xef\x92\x5a\x30\x21\xe8\xef\x2d\xb4\x60\xdd\xf6\x38\xab\x4a\xb0\x05\xf6\xa1\xe6\x66\x54\x74\xa2\x67\x59\x8d\x8f\x2c\xd1\xdb\x77\xf0\xdb\xa8\x2b\x8b\x87\x7e\x46\x4c\x64\x98\x8f\xa8\x13\x99\x0e\xfa\xb8\xf7\x92\x2f\x54\x54\xab\x92\x0c\x14\x26\x2c\xbc\x5d\x71\xc2\x50\x8f\x4b\x14\x3d\x44\x8b\x4e\xd5\x96\x41\xac\x61\x14\x00\x4b\x10\x51\xae\x8e\x21\xe2\x88\x05\xc9\x17\x48\x64\x39\x4f\x6c\x2a\x79\x0a\x27\x13\xaa\x4a\xa2\x2c\x56\xc2\xba\x42\x55\x29\x18\xad\xcd\x29\x41\x42\xd0\x68\x8c\xae\x29\x59\x81\x44\x11\x5c\x74\xbf\x21\x57\x9e\x18\x1f\x21\x08\x6d\x76\x45\x88\x9f\xa5\x7b\x66\x8c\xee\x59\x8a\xb1\x11\x20\xc2\x74\x85\xa4\x98\xe9\xe6\x94\x86\x6d\x19\x7b\x98\x95\x3f\x81\x23\x74\xe1\x4a\x61\x0e\xf7\xb1\xba\x18\xc8\x50\x68\xe8\x72\x92\x73\x95\xbf\x59\xa3\x86\x0f\x72\xd7\xb3\x8b\x59\x0b\x34\xba\xd7\x3b\x0d\xcc\x06\xd3\xeb\xaf\xe3\x3b\x03\xd7\x78\x4e\xd0\xef\xaa\x84\xf1\xef\xb2\xea\xaf\x63\x55\x5b\xe2\xb5\xc8\x59\x31\x05\x10\x7b\x96\xdc\x2a\xd7\x7b\x84\xee\xe9\xfa\xc8\xf0\x91\xb8\x33\x1d\x89\x50\x56\x01\x66\xaa\x0c\x30\x48\x0c\x5d\xc5\x6f\x43\x68\xd1\x12\xb8\x1a\x0d\xd7\xc4\x3e\x34\xda\xdd\x9b\x4b\xa4\xfa\x6d\x84\x94\x0a\x3d\x24\x26\x23\xd8\xcb\xdd\xf0\x38\xea\xf9\xfa\xab\xf4\xd3\x32\x07\x73\x38\x46\x59\x81\xe9\x82\xe4\xe8\xb2\xaa\x0a\x82\x4b\x21\x7d\x29\x3d\x20\x43\x4b\xdc\x40\xc2\x14\x95\x16\x74\xe0\x61\x46\x1d\x40\x4b\x4a\x32\x38\xbb\xb0\x53\x17\xa4\xc1\x39\x6e\x30\x58\xcf\x45\x4a\x94\x02\xbf\x96\xf6\xaf\xe8\xca\xf2\x45\x6d\x01\xa5\x53\xf1\xfc\x88\x8f\xcb\x0f\x30\xd0\xf9\x15\x6e\xe6\x13\xf4\x1b\x7a\x67\x99\x1a\x15\x64\x11\xcf\x3f\xf6\x16\x5a\x7e\x79\x46\x41\xac\x16\x19\x6b\x5d\x0b\x9e\xc8\x7d\x80\x54\x01\xb5\xea\x1c\xa5\xed\xd6\xd8\x99\xda\x11\x92\x1d\xd7\x05\x6d\x41\x1e\xd3\x68\xda\x6b\x48\x67\x9a\x7a\x2e\x23\x0a\x26\xf7\xf6\x1d\x59\x07\xe1\xd7\xd1\x6e\x2b\xc1\xab\x4e\xa4\xe5\x3b\xb2\xee\x30\xa9\x5f\x90\x86\x6f\x18\x1d\x61\xea\x74\x8b\x3e\x3f\x33\xe1\x70\xca\xcd\x63\x9c\x0f\x72\xbd\x95\x3c\x20\x16\x3c\xe1\xca\x51\xec\x3b\x48\xd9\xf0\xd7\xb8\x10\xa1\x8a\xd1\x65\x75\x53\x96\x9c\x65\x0c\x3a\x04\x0a\x6c\xbd\x0a\x9f\x51\xa8\x0c\x5f\x93\x00\xaa\xc4\x36\xd1\x7e\xd0\x06\xee\xd1\xf3\x3f\x38\x3d\x6d\x7a\x29\xca\xda\x8e\x31\xe9\xd9\xd2\x12\x95\x4d\xda\xb1\x2a\xa4\x01\xba\xb8\x59\xd2\x40\xf1\xd6\x8c\x33\x95\xdf\xe2\x93\xfa\xaf\x16\xa9\x55\xca\x65\x8c\x20\x59\xb5\x5c\x6f\x43\xe6\x6d\x28\x21\xe6\x3a\x2a\xe8\x3b\x82\x4c\x17\x51\x5d\x9c\x55\xb4\xd1\x53\x1b\x54\x83\xfe\x9e\x2c\x85\x28\x7e\x89\xb3\x77\xb2\xce\x5f\xb5\x58\xe2\x86\x5e\xd2\x82\x36\xeb\xa8\x91\xe2\x8a\x34\x17\xb6\x43\xd1\xeb\x85\x6a\x6f\xc2\x52\x27\xd2\x29\x0d\x34\x91\x40\xe4\x91\x53\xbe\x67\xaa\x07\xd8\xbe\xab\x3c\x67\xca\x5b\x80\xcb\x7c\xdf\x09\x8d\x95\x37\x40\x98\x15\x15\xb5\xd1\xa8\x90\x70\xe3\xd8\x9a\xa1\xdf\xed\xf8\x8a\xdf\xb9\x3a\xf3\x7b\x49\x8b\xdf\x27\x42\x95\xe0\x27\x27\xb2\x18\x76\x72\xa1\x8c\x13\xca\xb9\x88\xc2\x10\xed\x01\x5e\x56\xe5\x9e\x3d\x80\x7e\x91\x8f\x71\x45\xaf\x49\xa9\x92\xba\x58\x43\x30\xa4\xea\x72\x1c\xa0\x92\xae\x35\x68\x9a\x8f\x74\x07\x9b\xf4\x3b\x9a\xa2\x3f\x8e\x12\x20\x14\xa2\xbf\x6e\x0c\xc4\x0d\x64\x19\x96\x5f\xf7\xd7\xb1\xe7\xc3\xf2\x53\x37\x65\x84\xb6\x0d\x9b\x5f\x44\xa3\x2d\xae\xb7\x9d\xa4\xf6\x0d\x4f\xeb\x8b\x2c\xd3\x26\x19\x7e\xae\xb6\xa5\x1b\xa9\x50\xdd\xd9\x4f\x5d\x4e\xea\x1e\x12\x9b\x4b\x5e\x4f\x13\x54\x5d\x93\x7a\x55\x53\x59\x99\x42\xf9\x84\xaa\x92\xd8\x37\x2e\x94\x91\x96\xa4\xd5\x7a\x4c\x24\x28\x3e\xda\x1c\x9c\xcb\xe7\x1f\x62\xb5\x8f\x5c\xdf\x33\xb8\xa3\xa9\xac\x50\x24\x94\x67\x27\xce\x9c\x03\xe5\x8a\xff\xc4\x87\x24\x9f\xe6\xe8\x9f\x9f\x39\x5f\x4a\xcc\xdb\x72\x55\xe3\xe5\x92\xe4\x96\xd6\x6f\x17\x49\x38\x3f\x8b\xb5\x81\x89\x4d\xf7\x6d\x0c\x54\x34\xc5\xcb\xcd\xbd\xf0\x69\xa4\xb5\x8a\xe3\xf8\x38\xdd\xe2\x8a\xa5\x55\x2b\x37\xa7\xba\x1a\xac\xf2\x30\x60\xcd\x56\xdb\x47\x6c\x26\x1d\x3b\x23\x16\xdf\x01\xea\x54\x14\xb1\x5a\x6b\xea\x83\x10\xe6\xf3\xfe\x0f\x58\xc5\xfc\x96\x57\x2f\x8f\xaf\x5a\xf4\x2a\x37\x42\xa2\x96\x0d\x57\xf3\x4a\xc4\xda\xa8\x75\x02\x53\x82\x26\x12\xd3\x00\xaa\x9a\x3f\xeb\xd4\xf6\x74\x5f\x74\x5f\x49\x89\x39\x3e\xcf\x72\x64\x1c\x9f\xe5\x6e\x25\xed\xdc\x88\x79\x5a\x14\xd3\x3d\xf1\x64\x07\xfb\x1f\x21\xe2\x47\x13\x24\xf9\x1c\x94\x4f\x23\xab\x62\x6d\x59\x8c\x4b\xd9\x03\xbf\xe2\xdf\x96\xf7\x1b\xcd\x19\xad\x58\xec\x7d\x51\xe0\x5a\x72\x4e\xd9\x29\x52\x55\xd9\xd7\xd5\x66\xfd\x5e\x7f\xaa\x9e\xb8\xb0\x63\xf2\xbf\x8c\x74\x2f\x21\xc6\xba\x99\x8b\x31\x8c\x6c\x2a\x4c\x60\x0b\xbc\x64\x06\x9a\x59\x7b\x28\xb8\x1c\x6c\x1d\x30\x0c\xe1\xc6\x74\x23\x76\x45\xc6\xc8\xa0\x8c\xfe\x37\x51\xdd\xf2\x9c\x16\x7d\x29\x69\xcf\xe9\x4b\xa8\x5a\xc7\xbb\x4d\xe7\x9f\x3c\xda\x70\x9f\xb8\xef\x0e\xea\xba\xae\x9a\xb9\xa1\x88\x3c\x08\x35\x48\x65\x94\x86\x36\x0b\x69\x7f\x5b\xbc\x8d\x7c\x54\x5c\xc2\x79\xfe\xa6\xf2\x86\x65\x4d\xe0\x83\xe3\xe4\x92\x6d\xed\x61\x26\xe3\x4e\xdb\x98\x7e\x1a\x3d\x53\x0e\xa3\xc3\x31\xba\x77\xcf\xfa\xe2\xa9\xf1\x24\x0d\xf1\x45\x47\x70\x14\x0e\x13\xd8\x80\x8b\x96\x41\xec\xdb\xe1\x04\x3d\x98\xa0\x87\x13\xf4\x68\xc2\x39\xc7\x63\xd0\x02\xae\xaa\x06\x09\x87\x31\x8c\x3c\xb6\xcd\x61\x5f\xd8\xb7\xc0\x36\xad\xf5\xe3\x12\xd5\x8d\x5a\xed\xdf\xe9\x40\x2a\xd5\x5b\xde\x91\x3d\xcc\x54\xb7\x92\xcc\x52\xd4\x36\x96\xa8\x2b\xbb\xe7\xbd\xe4\x08\xaa\x79\xfe\x91\x4b\x6b\xd7\x80\x21\x76\xff\xa0\xfe\xea\x46\x83\x8a\x9e\xc6\x3b\x6f\xf5\x20\x41\xe7\xf5\x9b\xcd\xd2\x3f\x3c\x9c\x1f\x82\xa7\x6d\x38\x3e\x63\xd9\x53\x56\x3a\xed\x0c\xa3\xf4\x48\x13\xd9\x87\x51\xd2\x58\xf7\xae\x73\x63\xbb\x0f\x5b\x18\xa4\x7a\x1f\xfb\xac\x44\xb4\x62\x4d\x70\x93\x44\xa3\xd7\x1e\x7e\x52\xc7\xbb\xbb\x26\x99\xca\x60\x7e\x12\x63\x25\x1b\x71\x91\x0e\xcc\x36\x67\x25\x9f\x3d\x17\xd9\xea\xe8\x77\x92\xc8\x9c\x7f\xae\x90\x47\x6f\x5a\xaf\xe6\xd0\x0e\xf8\xd8\xad\xce\x63\x08\x1f\xb3\xcf\x69\xf7\x29\x8d\xe9\x7f\x5d\x47\x75\x83\x53\x6a\xac\x37\xca\x0a\xd3\x73\x26\x3b\xa4\x19\x29\xfc\xc6\x37\x57\x28\xd2\x0c\xd8\xbd\x8e\x80\xb3\x9b\xdd\xfa\xe1\xf0\x08\x7d\xf8\x38\x41\x0f\xc4\x3f\x0f\xc5\x3f\x8f\xc4\x3f\x8f\xf9\x3f\x31\x55\x43\xb4\x31\x1e\x49\x2f\x82\x2f\xf1\x69\x36\x9c\x22\x14\xa7\xbd\xd5\x5b\xd7\x93\xf8\x0e\x9f\xec\x80\x3a\x03\x1a\x0e\x5f\x58\x6d\x7c\x23\x54\x39\x00\xa2\x1c\x00\x4d\x0e\x80\x24\x07\x40\x91\x83\xb8\xee\xe5\x8a\xf1\xb8\x6d\xaa\xbd\x6a\x49\x4a\x92\xfb\x2d\xab\x35\x75\xa6\x28\x45\x9f\x97\x4b\x52\xc2\x21\xe3\x6f\xfe\x59\xe4\x71\x90\x08\x28\x34\xfa\xf0\x11\x61\xe6\x23\x16\x88\xd0\xaf\x4d\xcc\x9a\xa7\x8b\x50\x9d\x1a\x32\xaf\x56\x68\x81\xcb\x75\xc4\xea\x6c\x9a\x78\x0d\x53\x27\xc4\x99\x75\x7b\x3b\x77\xd3\x4f\xaa\xfe\xf2\xb4\x8b\x37\x86\x5d\x25\x9b\xd2\xd3\xeb\x38\xed\x5f\xbc\x62\x1d\x1d\x3c\x7a\x6d\x34\xb2\x56\x82\x92\x0b\x38\xaf\xba\x6f\x55\xa9\xbb\xc6\x45\x4b\x9c\xab\x62\xc2\x9f\x65\x4d\xdd\x66\x0d\xa2\x61\x1f\xb7\xa5\x6b\x42\xef\xaa\xb3\x37\xa4\x5c\x17\x14\x2f\xae\x0a\x39\xe3\x70\x15\x8e\xfb\xce\x99\x03\xcd\x2d\xfe\x15\x41\x35\x5d\xfb\xeb\xbc\x9c\x55\xf1\x99\xbc\x90\xdf\x06\xd5\x92\x7d\x50\x66\x1e\x6f\x15\x44\x9d\xde\x96\xfc\xee\x0e\xd4\x00\xb6\xc1\x7c\x8c\x2d\xba\x79\x3f\x6d\xdf\x39\x9d\x93\xec\x9d\x2e\xa9\x28\x2c\xac\x0c\x2e\x00\x58\x7e\x4c\x4b\x86\xaa\xb2\x58\x8b\xc6\xf3\x19\x66\x04\xcd\xc9\x7b\x94\xcd\x31\xdf\x19\xa4\x66\x68\x74\xb0\xf7\xcd\x04\xe1\xbd\xd9\xd8\x78\xa3\x65\x86\xc2\xb2\x26\xd7\xe2\xae\x63\x64\x0f\x17\x14\x33\x88\x8d\x6c\x1a\xcc\xc7\x5c\xc1\x21\x34\xfd\x98\x38\x5c\x31\xb4\xb1\x20\x8c\xc8\xf4\x6a\x3a\x41\x77\x31\xbe\xbc\xbc\x8b\xae\x19\xba\x7b\x72\xf2\xed\xb7\x77\xc7\x28\x27\x19\x24\x16\x5a\xad\x97\x2e\xd7\x0d\xe9\x30\x22\x51\xf6\x83\x9a\xc2\x7f\x92\xf7\xa3\xdf\x10\x2d\x97\x6d\x63\x05\x95\xf0\xfb\xce\xb3\xd3\x73\x90\x7f\x27\x35\xa3\x55\x09\xe5\x97\x97\x6d\x33\x6d\x9b\xd9\xd7\x66\x07\xf1\xdd\xa3\x89\xc1\x37\x90\xfd\x4a\x70\xb0\x5e\x72\x4a\xe2\xa2\xa8\x56\xe8\x60\xef\x1b\x34\x3a\xb9\x38\x3d\x3f\x47\x8f\xbe\xde\x7b\xfc\x97\x31\x78\x61\xf0\xde\x4c\x7d\xfa\xcd\x5f\xf6\x0e\x0f\x1e\x04\x7d\xec\x46\x23\x33\xdc\x53\xf4\xe8\xeb\x31\xfa\xe3\x0f\x64\x7d\xf6\x0c\x3d\xfe\x0b\x97\xae\xed\xa7\xbe\xf9\x4b\xf8\x14\x87\x1d\x2b\xbf\xa1\x22\x0d\xa0\xcc\x5c\x7c\x8b\x7d\x0c\xe2\x12\xb4\x52\xd3\xb3\xb3\x94\x66\x02\x15\x90\x0a\xd5\x0d\x89\x71\xf9\x3c\xb5\xbf\xba\x16\xf4\xef\xb8\xa0\xd2\xa7\xd7\xbf\x9e\xb6\x85\xc7\xdb\x0b\xf0\x66\x70\xc7\xfc\x5d\xa6\xc5\xa8\x4e\x75\x56\x02\x05\xc2\x22\x19\x03\x51\xc6\xd5\xae\x25\xa9\x8b\x35\xdf\x0a\x0b\xdc\x34\x96\x59\xce\x8a\x53\x65\x47\xfa\xd3\xc3\x29\xfa\x91\xeb\x28\x32\xdd\x0f\x97\xe8\xfc\x95\x02\xa8\x1f\x7a\x20\x1f\x92\x87\x10\x61\xb4\xac\xea\x46\x49\x02\x22\x6f\x06\xa3\xac\x2a\x64\x16\x27\x7f\xe7\xa1\x7c\x87\x03\x15\x49\x3d\x28\xaf\x16\xfc\xed\x92\x9f\x0f\x81\x5f\x3f\x3d\xfd\x8c\x93\x11\x76\x93\xce\x42\xd2\xaa\xd5\x46\x42\xf9\xb7\x2f\x41\x35\x31\xa7\x75\xfd\x1f\x7f\xf8\x1f\x3f\x43\x8f\x0f\xfd\x72\x02\xd1\x9d\xe8\x54\x06\x46\x17\xcb\x82\x8a\x08\x3c\xbd\x20\x65\x53\xa9\x59\xf3\x03\xc5\xa9\xe6\x1c\x69\xce\xe0\xf9\xf5\xa3\x10\x60\x1c\xc4\x88\x91\x25\xae\x45\x10\xe2\xdd\x23\x4b\x61\xd0\x33\xa3\x33\x7b\xcd\x21\x2e\x97\xaf\xdb\xf5\x13\x67\xe5\xe4\xa4\x61\x0c\x35\xb5\x3b\xc7\xe8\xc1\x86\x33\x83\xfa\x47\x62\x0e\xc7\x02\xd8\xdb\x83\x5f\xdd\x59\xf0\xcd\xa0\xbe\x3b\xfc\xf5\x8b\x28\xc2\xf0\x10\x18\x6c\xc5\x66\x90\x9b\x47\x95\x6e\x3a\x04\x02\x3d\x79\xfc\xf8\xe1\xe3\x00\xf6\x0b\x08\xc2\x16\x17\xea\x94\xdf\xf4\x62\xe9\x47\xfc\xbb\xb1\x33\x55\xf5\xb0\xe8\xbf\xf0\xc7\x1f\xea\x93\x3b\xe8\x29\x3a\x74\xfe\x7e\x26\xc6\xda\x7c\x95\xf5\x84\x24\x4d\xbc\x8b\x89\x34\x9c\x4f\x4c\x50\x4e\xaf\x68\xc3\xff\xad\x9a\x09\xca\x31\x9b\x4f\xd0\x7c\xbd\x9c\x93\x92\xb3\x97\xb6\xcc\x49\xcd\xb2\xca\xea\x30\xc0\xe7\x0a\x84\x39\x9d\x63\xe8\x68\x75\xf7\xe4\xdb\xd3\xb3\xef\x9e\xff\xc7\x7f\x9e\xff\x1f\xdf\xff\xf0\xe3\x8b\x97\xaf\xfe\xcf\xd7\x17\x6f\x7e\xfa\xfb\xcf\xff\xd7\xff\xfd\xbf\xf1\x65\x96\x93\xd9\xd5\x9c\xfe\xd7\xbb\x62\x51\x56\xcb\x7f\xd4\xac\x69\xaf\x57\xef\xd7\xff\x7d\x70\xf8\xe0\xe1\xa3\xc7\x4f\xfe\xf2\xf5\x37\xd3\xbd\xdf\xee\x8a\xdb\x41\x8d\xa0\x2e\x07\x7e\x2f\x08\xdc\xe1\x7b\x8f\x02\x74\x86\xee\x18\x3c\xa6\x6a\x76\xc0\xad\x6f\xce\xa1\x21\xb2\x06\x5f\x92\x82\xcf\x50\x22\x11\xee\xf9\xa9\xb5\xe7\xa1\x41\x08\xf4\x02\x11\xef\xbd\x15\xff\xa8\xfd\xbc\x87\x0e\x7f\x75\x0c\x03\x73\xcc\x7e\x80\x35\x40\xc7\x1e\x52\x30\x7f\x08\x75\x29\xf2\xd8\xcc\xf7\xf7\xd1\x9b\x1f\xce\x84\xc5\x46\x71\xc3\xa2\x90\x0b\x89\xe8\x94\x4c\x23\xdc\xd1\x46\x93\xb2\x9f\x96\x4b\x18\x38\x43\xcf\x8e\xd1\x93\xc7\x70\xf3\xa1\xa7\xc7\xe8\x9b\x03\x01\xff\xfe\xc9\xfd\xbd\xfb\xff\xfb\x7e\xe4\x4d\xb8\x01\xd4\x9b\xdf\xfc\x45\xbf\x79\xf8\xe0\x81\x78\x13\xdf\xdf\xbb\xff\xdf\xf7\xfd\xa5\x52\x43\xfe\xf1\x87\x86\x11\x2e\x91\x4d\x92\x68\x0d\x4d\x28\x93\xde\xbf\x78\x7c\x6b\x18\x58\x9b\x1d\x9c\x8e\xab\xd9\xa4\xcf\xa7\x4b\x69\x4b\x8d\xa1\x58\x23\x22\x72\x69\xd2\x17\x47\x58\xdf\xbb\xe3\xea\x8d\xaa\x3b\xfc\xf9\x40\xc7\x71\xc1\x8e\x4d\xf1\x5b\x14\x73\x51\x96\x08\x43\x83\x7e\x2b\xec\x18\xbc\x4d\xba\xcb\x2c\xbf\xa7\xab\x8e\x3a\x0c\x1c\xd4\x99\x29\xd0\xee\x91\x44\x06\xbb\xe8\x3c\x7d\x5a\x66\x45\x9b\x13\x3b\xab\x05\x1c\x75\x1a\xd4\x1c\x5f\x83\xc7\x0e\xa2\x26\x19\x29\x48\xd6\x88\xb0\x49\x00\x5e\x54\x8d\xfc\x90\x93\x1b\x17\x57\x55\x4d\x9b\xf9\x02\xad\x89\x89\xbe\x39\xd1\xc9\xb1\xfe\xf2\x90\x32\x67\x13\x1d\xd3\x09\x69\xc1\x42\x22\x68\x4b\x27\x8a\x8f\x99\x5a\xf6\x1a\xaa\xb0\x71\xc9\xf8\x7e\xb0\x20\x98\x7a\xf7\xb2\xa6\x3c\xff\x2a\x59\x7c\x60\xa2\x21\xbd\x81\xf0\x22\xca\x64\x85\x04\x9c\x65\x6d\x2d\xd2\x7a\x6b\xb2\xac\x09\x53\xa9\x0e\x56\x7e\xbd\x6a\x4f\x1b\x2f\x46\x13\xd1\xbc\x5f\xc9\x45\x8b\x07\x6e\x39\x2c\xab\xec\xd1\x2c\x35\x08\xe7\x2d\xaf\xd2\xf2\xe0\xb2\xd2\x1d\xf5\xe0\x87\xda\x38\xc3\xe9\x6d\x5c\x13\xde\x9e\xc9\xd2\x87\x15\xcc\xe4\xc3\x47\x57\x6f\x29\xad\xae\xbd\x4a\xd5\x0d\x18\xe5\xcd\xf3\xd2\x90\xdd\x74\xea\x8d\x97\x84\x7e\x3c\x2c\x9d\x28\x84\x76\xe5\xa4\x2a\xc5\x71\x1b\x9c\x83\x1b\x22\x96\xca\x5f\x8a\xd2\xe7\x9c\xa9\xed\x20\x59\xdf\x31\xea\x2f\xe1\x1d\x03\xf4\x33\xa1\x57\x73\xaf\x89\x14\x2d\x69\x43\x71\x21\xbe\x0a\x82\x91\x20\xf2\x7e\xcf\x8d\xdd\x05\x27\x3c\x1a\x89\x02\x23\xb4\x2a\xf7\xb3\xaa\x90\xac\x66\x3f\xab\x4a\x46\x4a\xd6\xb2\xfd\x6b\x52\x03\xf7\xe0\x1f\x8f\x7d\xb0\xca\x89\xa2\x01\x9f\xbc\x38\x93\x4d\x96\xda\xd9\x8c\x66\x94\x94\xb2\x20\xbb\x7f\x3f\x76\x24\x1c\xf2\x2b\xd6\x5b\xb6\x7b\xf7\x3c\x02\x46\x2e\x53\x6f\x63\xa7\x7c\x69\xea\x47\xf5\xf4\xf1\x6e\x57\x7f\x86\x4b\x52\x2f\x28\xe3\x6a\x7a\xa1\x18\xb8\x22\x9c\xe0\x43\x01\x4d\x64\x7d\x06\x97\xe1\xd3\x6c\xae\x13\xd0\x48\x8e\x96\x35\x35\x31\x9d\x87\x07\x07\x7b\xcf\x7f\x78\xf9\x73\x98\x4c\xe6\x83\x1e\x09\x40\x3e\xd5\xc7\xb2\xa7\x5e\xa3\xb9\xb6\xec\x20\x82\x51\x43\x16\xcb\xaa\xc6\xf5\x1a\x5d\xd5\x38\x83\x08\x0e\x5a\xe9\xef\x7d\xf8\x02\x7c\x33\x27\x8c\xf0\xe7\xcb\x7c\x86\xc1\x04\xea\xa5\x05\x33\xb4\xc0\x6b\xc4\xda\xcb\x05\x55\xc5\x70\xf8\x64\xf9\x28\xc1\x14\xa6\x81\x24\xc7\x01\x42\xc5\x05\x87\x44\x30\x27\x42\xf9\x70\xce\x86\x7a\xf9\x3a\xbe\x9f\x8e\x7c\xb8\x68\x0f\xbd\x2c\xf8\x06\x64\x01\x7d\x26\x22\x48\x18\x3a\x72\x17\xd5\x4a\x18\x9d\x00\x2a\xf4\x18\x0c\xf7\xa7\x00\xf7\x82\xac\x04\x38\xbc\x86\xe2\x4c\xa5\x0b\x0f\x46\x91\xb8\x5d\x4a\x36\xb5\xe9\xae\x3f\x76\x76\xbd\xbf\x47\x47\xde\x29\x90\xbd\xdc\xcc\x29\x88\xbd\x64\xf1\x87\x67\x5e\x0f\xb2\xdb\x3a\x33\xe1\x6f\x52\x92\xf3\xc0\x47\xa3\x9e\x03\xb1\xcc\x6e\x08\x68\x44\x33\x20\x34\xb9\x26\xb5\x75\x8c\xd2\xa2\xa6\x7d\x8f\x47\x63\xb8\xd3\x7e\x0b\xd8\x8e\x51\x4c\x5d\x4b\x55\x90\xc0\x4b\x99\xe2\x5a\xa6\xdc\xaf\x9d\x26\xaa\x5a\xf3\xd8\x67\x5b\xf5\x1a\x8a\xe4\xe0\x47\x1d\x0d\x96\x11\x66\xd0\x95\xf5\xd3\x73\xfa\xfe\xc9\x23\xd7\x01\x1f\xb5\x60\xda\x45\x2c\xfa\x0b\x5b\x6c\x25\xc7\x74\xa0\x7c\x84\xce\xcb\xac\xaa\x6b\x92\x35\x32\xd0\x4c\xc5\x28\xcc\x4c\xa5\x64\x71\xa2\x8c\xed\xaa\xd7\x71\x1f\xe6\x45\x69\xca\x58\x89\xdd\x17\xfa\x9c\x76\x6a\x3e\x66\x71\xa2\xd6\xbd\xee\x7c\xa7\x60\x6f\xfa\xb6\x33\x95\xfe\xd4\x6b\x42\xb3\x55\xa4\x2b\xd2\xb8\x69\x53\x5a\x25\x0a\xaa\xc1\x10\x35\xc2\x44\x24\x53\xc9\x71\x02\x5b\xe6\xb0\x79\xbf\x23\xeb\x9b\xcc\xf9\x7b\xb2\x56\x78\x5b\x99\x5d\x37\x9f\xeb\xf7\x64\xed\x4d\x53\x27\x36\x0d\x9b\xa2\x3a\xa5\x5b\xcd\xef\x42\x97\xab\xd9\xe1\xe4\x4c\x0d\x9c\x01\x33\x33\xb1\x91\x12\x71\xd6\xe0\xa6\x85\x40\x4a\x2c\x1d\x56\x6d\x81\xeb\x44\xee\x9e\x33\xb9\x20\xf5\x32\x40\xd1\x49\xd1\x8b\xce\xf4\x7f\xfd\xaf\x6d\x32\xf2\x76\x9e\x84\x67\x30\xef\xcb\xc0\xf3\x96\x26\x91\x6e\x97\x32\x2f\xbc\xf6\x52\x6f\xe2\xb9\x72\x3a\x15\x5c\xa4\xc2\xce\x31\x43\x8c\x74\x18\xdb\x03\x15\xf2\x13\xa4\x6c\xc9\x01\xa3\x51\xcb\xb1\x59\xda\xc1\xde\xcc\xf4\x5f\x55\x4a\x7c\x4d\xf2\x36\x33\xa9\x6c\xa0\xcc\x2f\xf1\x5a\xdf\x81\xe9\x0b\x3c\x68\xe3\x1b\x90\x40\xdc\x6f\x91\x80\x84\x01\x34\x90\xef\xa6\xa8\x90\x18\x7e\xeb\x4d\x98\x80\xe7\x87\x29\x79\x9d\xb1\x4d\xd4\xcf\xdd\xf4\x79\x4f\x16\xa3\x10\x61\x1f\x8e\x40\xd5\xac\x97\x5d\xde\xcf\x2b\xd2\x24\x2a\xa8\xb8\x81\x0c\x9d\x74\x8f\x09\x0e\x9b\x23\xef\x95\x2c\xe9\xc4\xb9\xbf\xfc\x8b\xc2\x79\x93\xad\x22\xde\x08\x36\x88\xc6\x49\x2e\xb4\x1c\x33\xd8\x19\xaa\x4f\x78\x98\x26\x91\x0e\x46\xb1\x43\xbf\x4c\x38\x8f\x94\x30\xbd\x68\xfe\xcb\xb5\xd7\x3c\xbb\x9a\x89\x18\x15\x5d\x46\x37\x45\xae\x37\xe9\xea\xb7\xc3\x57\xb9\xa3\x84\x6e\x72\xb1\x45\xf1\x5f\x33\x31\x50\x7b\x05\x09\x6c\x53\x72\x7c\xa2\x95\x31\x42\x6b\xc3\x65\x2c\x3f\xb6\x7f\xea\xaa\xac\x69\x64\x53\x5c\xe3\x1a\x39\xd5\x51\xe5\x13\x6e\xdb\x77\x65\x16\xe3\x93\xe5\x38\x6c\x56\x61\x18\xb4\x8b\xd0\xc7\x71\x56\x01\x17\x10\xe9\x6f\xb6\xc1\x38\xa6\x45\xc2\xc8\xb6\xd1\x24\x54\xee\xec\x06\xf4\x6e\x3b\xfa\xaf\xb6\x29\x89\xcc\x7f\xf9\xf5\xce\x50\xd5\xcf\x1a\xae\x63\x37\xbc\x23\xa5\x2a\x93\x2b\xa3\x85\xfa\x72\xc9\x83\x05\xfd\xce\x2b\x2c\xdb\x7b\xd4\xfd\x4a\xb4\x69\x31\xaa\x6d\xd0\x92\xd4\x19\x29\x1b\x7c\x45\xbc\x56\x35\xea\x96\x5b\x62\x0a\xe6\x04\xd7\x40\xd2\x89\xb1\xee\xa1\xfc\x4a\x03\xef\x45\xba\x4c\x74\x61\x4e\xe1\x0e\xf7\x07\x70\x04\x85\x28\x9c\x32\x13\x45\x63\x19\xb2\x6a\x92\x51\x72\x6d\x72\x6c\x84\x01\xeb\x54\x9f\x46\xe9\xc8\x6e\x59\x8f\xc6\x2d\xb0\x7a\x0d\x23\x0f\x67\x23\xb5\xf5\x96\x3d\x1d\x5a\xd2\x66\xf4\x1b\xf2\x57\xcb\xe8\xb4\xbf\xc9\xa9\x9d\xba\x1f\x66\x61\xd4\x7e\x90\xd1\x63\x9f\x96\x64\xc6\xff\xa8\xa9\x5b\xe2\xa5\xf7\x7b\xbe\x26\xaf\x6e\x80\x60\x49\x4f\xf7\x1c\x0b\x7a\x54\x08\x8e\x5b\xdd\xfb\x51\x0a\x00\xc5\xf1\xb3\xf4\x85\xf1\x0e\xa0\x46\x55\xac\xdd\x02\xf6\xf5\x54\x9f\xb4\x46\x96\xb2\x14\x0f\x74\x1c\x46\x3a\xca\xfa\x83\x91\x17\x5f\xb5\x97\x05\xcd\xd4\x7b\x4b\xf8\x2b\xf9\x9a\xaa\x6d\xce\xa5\xf4\x9e\x11\xd5\x63\x2e\x80\x33\x23\x24\xf4\xbd\x6d\xea\x26\xb9\x20\x62\x62\x14\xdf\x28\x2a\x39\xe2\x08\x3d\x78\x7c\x70\x70\x70\x30\x3d\x98\xc8\x6b\xe0\xc1\xf8\x08\xc1\x47\xd6\x67\x0f\xc7\x47\xe8\xd0\x7f\xf0\x11\xff\xf0\xe1\x63\xe7\xb3\xc7\xfc\x33\xfe\xc1\x90\x8d\xf8\xf8\x60\x7a\x80\x30\xd3\xe7\xce\x59\xd6\x5e\x01\xa9\x4f\x7e\x70\x67\xe9\x4d\xd0\x9b\x9b\x37\x2b\x6f\x42\xe1\x74\x7c\x86\x82\x8e\x03\x1e\x13\x9e\xea\x08\xeb\x45\xc7\x86\xff\xb8\x2f\xd8\x1c\xcd\x9f\xc9\xe1\x93\xaf\xdd\xb9\x3c\x3e\xfc\xda\x9b\xcd\x5f\xbe\x76\xe7\xf3\xe0\xe1\x93\x60\x46\x31\xdf\xa4\xd7\xac\x38\xc5\x57\x12\xe5\x57\xb0\xaf\xf4\x75\xae\x7f\xa0\x49\x5a\xaf\xc7\x2b\xa2\x38\x4a\xa5\x83\x7e\xe9\x28\x46\xde\x2c\xd4\xdd\x31\x8c\x3f\x46\x94\xbb\x10\x7a\x1c\xbf\xa4\xba\xe7\x9a\x49\xb7\x6c\x0e\x3d\x00\xe3\x20\xaf\xd8\x1f\x6b\x68\x7f\x68\x07\x61\x48\x9e\x77\x73\x30\xd2\x39\x21\xc7\x83\xd3\x31\xd0\xa6\xcd\xae\x5d\x14\x12\x45\x73\xbc\x54\x11\x3d\xd2\xfe\x3e\xba\xc0\xb2\xb8\xa5\x9f\xc6\x03\xc9\x1f\x9b\xe2\x14\x66\xe9\x46\xa4\x86\x01\x48\xaa\xf4\x0d\x87\xe4\x4c\xe7\x75\xa4\x42\xce\x0f\x39\x33\x06\x22\xcb\xdf\x1e\xea\xdf\x1e\xe9\xdf\x1e\xcb\xdf\x3e\x06\x64\x80\xa8\x10\x91\xf4\xb2\xf1\x62\x18\xe4\xbc\xe9\x25\x12\x53\x82\x99\x6d\x1d\x4c\x1f\x9d\x45\xe6\x86\x9d\x6f\x32\x0b\x81\x48\x7a\x16\x5e\xbe\xc3\x80\x12\x4f\x23\x5d\x67\x51\x96\x97\x13\xc0\xbb\xe4\x01\x65\x93\xf9\xf8\xff\x07\x00\x00\xff\xff\x8a\x69\x77\x8e\x0d\xad\x01\x00"
-
-func flowidtablestakingCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_flowidtablestakingCdc,
-		"FlowIDTableStaking.cdc",
-	)
-}
-
-func flowidtablestakingCdc() (*asset, error) {
-	bytes, err := flowidtablestakingCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "FlowIDTableStaking.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe0, 0x19, 0x7d, 0xac, 0x5, 0xb7, 0x9d, 0x58, 0xa5, 0xf9, 0x40, 0x6e, 0x73, 0x8b, 0x8c, 0x1b, 0xf0, 0x71, 0xab, 0x79, 0x0, 0xb5, 0xb5, 0xa0, 0xed, 0xee, 0x68, 0x70, 0xb7, 0xb4, 0x68, 0x76}}
-	return a, nil
-}
-
-var _flowserviceaccountCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x59\x5f\x6f\xdb\x38\x12\x7f\xcf\xa7\x98\xe6\x61\x4f\x06\x12\xa5\x0f\x8b\x7d\x30\xe2\xee\xb9\xd9\x76\x11\xe0\xee\x70\x48\xdb\xed\xc3\xde\xa2\x4b\x4b\x23\x8b\xa8\x4c\x1a\x24\x65\xd7\x0d\xf2\xdd\x0f\xfc\x27\x91\xa2\xe4\x38\x4d\xef\x36\x0f\x4d\x43\x91\x3f\xce\xfc\xe6\x0f\x67\x48\xba\xd9\x72\xa1\xe0\xfc\x6d\xcb\xd6\x74\xd5\xe0\x7b\xfe\x19\xd9\xf9\x59\x37\xdc\xf0\xfd\xc8\xd0\x5b\x44\x19\x8f\xbc\x53\x5c\x90\x35\xa6\x1f\xde\x7c\xc1\xa2\x55\x94\xb3\x7f\x13\x41\x36\xa8\x50\xc8\xf3\xb3\x33\x52\x14\x28\x65\x46\x9a\x66\x06\x05\x67\x4a\x90\x42\x81\xc1\x41\xb1\xa3\x05\x2e\x8b\x82\xb7\x4c\xc1\xfd\xd9\x19\x00\x40\x38\x1d\x77\xc8\x14\xbc\x17\x84\x49\x52\x68\xe0\xb7\x88\x1f\xb6\x25\x51\x58\x66\x0c\xb5\x68\x73\xf8\xf0\x96\x7e\xf9\xe9\xc7\xd9\xd4\x62\x87\x7e\x23\x90\x3c\x1f\x80\x8b\x65\x59\x62\x99\x91\x68\x6c\x0e\xcb\xb2\x14\x28\xe5\x89\x18\x77\xb8\xe1\xbb\x6f\x40\xb9\x95\x03\x65\xee\x50\x2a\x41\x0b\x85\xa5\xd7\x89\xca\x7e\x6c\x0e\xaf\x39\x6f\x1c\xda\xd5\xd5\x15\x2c\xa1\xa2\x5f\xb0\xbc\x14\x44\x21\x54\x88\x50\xd4\x44\xac\xb1\x04\xc5\x01\x8d\xe9\x10\x08\xa8\x9e\xed\x44\x8e\x1d\x11\xe1\xf7\x80\xbe\xd3\x76\x29\xb4\xe0\x7a\x13\x86\x7b\x70\xea\x8f\x6e\x42\x12\xab\xa5\x1b\xbd\xaf\x11\x1a\x2a\x15\xf0\xca\xcf\x07\x62\x29\x44\x09\xaa\x26\x0a\x6a\xb2\x43\xd8\xa2\xd8\x50\x29\x29\x67\xa1\x08\x76\x81\x0c\x77\xf7\xce\x99\x8a\xc0\x85\x9c\xc3\xbd\xb3\x8f\xe5\xf5\xa1\x17\xe4\x96\x51\x45\x49\x43\xbf\x22\x10\xd6\x89\xb2\xa7\xaa\x06\x02\x5d\x54\xc1\x6f\xa4\x6d\x14\x10\x56\xc2\xb6\x5d\x35\x54\xd6\x50\x90\x2d\x59\xd1\x86\x2a\x8a\x32\x4f\x78\xa8\x5a\x06\x94\x51\xf5\x0b\x56\x7a\xa5\x01\xc9\x3e\xe9\x29\x6a\x0e\xa4\x55\x75\xf6\x8e\xec\xf0\x37\xd2\xb4\x78\x01\x37\x01\xd4\x0c\x7e\x70\x8e\x32\x83\x7b\x03\x6b\x25\x85\x9b\x90\xfe\x31\xc1\xa4\xe6\x8b\x2a\xa0\x0c\xa4\x0d\xf2\x6e\xb9\xde\x36\x77\x83\xb9\x9e\x97\x5d\x5f\x76\x10\xb9\x65\xf5\xcd\x66\xab\x0e\x06\x2d\xdb\x19\x91\x0f\x5b\x9c\x83\xfe\xf7\xfa\xef\xfd\x5c\x33\xe1\x55\x36\x9b\x5d\x80\xe2\x73\xb8\x72\xa0\x57\x95\x9f\x61\x26\x38\xbf\x1d\x4a\x6e\xa8\x2b\x7a\xe6\x0e\xda\xa6\xaa\x46\xa7\x84\xb1\x3a\x67\xcd\x01\xf0\xcb\x96\x4b\x94\x21\x88\x9e\x56\xe2\x96\x4b\xaa\x34\xb9\xc6\x85\x41\xd5\x82\xb7\xeb\xda\x7c\xbc\xc3\x02\xe9\x0e\x05\x50\xa6\x50\x54\xa4\xe8\xd5\x6f\x50\x81\x70\x9f\x6f\xfa\xcd\x17\x96\x97\xc8\x90\x9e\x24\x2a\x65\x8b\xd7\x3f\x24\x8a\x4f\x2a\x1c\x51\x1d\x41\x3a\x87\xc9\x52\x09\x2e\x80\xa8\x39\x5c\x59\x5a\x7a\x44\xaf\xc9\xff\x88\xc5\x15\x69\x08\x2b\x10\x2a\x8a\x4d\x19\x51\xf8\xda\x7d\x19\x67\xd0\xad\xfb\xeb\x08\x4c\x04\x98\xe0\xcf\xa9\x61\x21\x83\x50\xff\x15\x95\x73\xa3\xca\x32\x65\x02\xc8\xd3\xc1\x59\x90\x00\xfc\x9a\x6e\xed\x1d\xaa\x56\x30\x09\x2f\x81\x56\x06\xc4\x67\x8a\x9a\x48\x60\xbc\xc3\x74\x68\x69\x5e\xa4\xb8\x37\x49\xa1\x0c\x12\x82\x13\xb4\xcb\x0b\x5d\xe4\xfb\x7c\x19\x64\x00\x9d\xd5\xbc\xa8\x0b\x78\x99\xbf\xec\xbe\xd0\x2a\x34\xcf\x1d\x56\xa3\x76\x59\x71\x21\xf8\x7e\xcc\x20\x53\xf4\x05\x9b\xeb\x9f\x7e\xf3\x7e\xa7\x3c\x54\x37\x60\x5b\xff\x08\xc3\x58\x44\x48\x60\x0b\xcb\x27\x10\x10\x58\xa1\x40\x0d\xec\xbc\x38\xb6\x8e\x49\x45\x23\xb6\x79\x94\x5b\x9b\xc9\xa2\x8c\xfb\xda\x50\x60\x72\xee\x2c\xe4\xda\x7c\x8c\xea\xaa\xfc\x23\x55\x75\x29\xc8\x7e\x06\x43\xc2\x02\x56\x9c\x86\x51\x72\x75\x34\x3f\x09\xf2\x55\x56\x09\xbe\x39\x92\x4c\x43\x33\xfc\xfc\x33\x6c\x09\xa3\x45\x76\x9e\x56\x61\x79\x42\xc0\x1c\x3e\x30\xb2\x6a\x0c\xb9\x56\xb4\x93\x08\x3f\x4f\x62\xe7\x23\x6d\x1a\x58\x99\x14\x2c\xb0\xd0\xe5\x8a\x39\x73\x0a\xc2\xec\x68\x83\x66\xa8\x52\x28\x0c\xa8\xdc\x53\x55\xd4\x5c\xe7\x63\xc5\xc1\x97\xa2\x79\x89\x65\x5b\xa8\xb8\x26\x4c\x62\xed\x86\x34\x0d\x96\xb0\xaf\x91\xc5\x25\x0d\x50\x09\xb2\x5d\x6d\xa8\x52\xb6\x24\xb1\x70\x66\xc3\xaa\x07\x02\x4d\xa7\x19\x5c\xb6\xaa\xf6\x15\xaa\xc9\x8b\xfd\x6a\x9a\xfa\x91\x75\xa1\x54\xc0\x53\xbc\x28\x70\x0b\x5a\x81\xc4\xa6\xca\xe3\x5a\x0b\x16\x0b\x17\xd4\xd9\xcb\x61\x68\x59\x47\x1a\x0b\x22\x1d\xd8\xaa\xb3\x26\x2c\x2c\x72\xea\xe7\x5a\xbe\x59\x94\x2b\x2a\xc4\xe5\xc6\x28\xbe\x18\x13\xe7\x31\x69\x5f\x05\xdb\xfa\x28\x1f\x48\x1d\xee\x90\x4e\x0e\xb4\xf1\xff\x8b\xb4\xaa\x10\xad\x4e\xd7\x97\xe1\xea\xbd\x8b\x92\x8c\x18\xe8\x79\xbf\x4b\xaf\x5e\xe0\x4d\xa6\x1e\x70\xe1\x73\x7d\xe9\x31\x13\xef\xbd\x84\x5f\x8c\x5d\x65\x94\xba\x0b\x57\xa0\x9a\x42\xd7\xf8\x0c\x81\x2d\x39\x60\x57\x3e\xe6\x01\x80\xae\x13\x65\x1a\x2f\xe9\x14\x8f\xee\x82\xd9\x9c\xd3\x05\x55\x87\xf1\x1a\x51\xa2\x6a\xb7\xff\xc2\xbd\xf3\xa3\xac\x53\x92\x75\x63\x69\xbd\x18\xb8\xe0\x54\xf1\x78\xd1\x01\x19\x8d\x8e\xf9\xae\x99\x39\xf3\x1d\x9c\xf3\x89\x17\x23\xd9\x85\xca\xb8\x15\xca\x0c\x74\xee\xca\xf6\xa1\x53\x4f\x67\xa9\x81\xce\x73\xdf\x61\xc1\x7f\x86\x88\x54\x9f\xad\xca\xc8\xce\x05\xfd\x1a\xf7\x21\xae\x09\x38\x9f\x85\x81\x93\xf8\x75\xda\x8c\xc0\x35\x0c\x1a\xe1\x7c\x43\x19\xdd\xb4\x1b\x37\x74\x87\x12\xc5\xce\xba\xc6\xb3\x75\x0a\x9d\x4c\x5a\x73\x03\x65\x05\x17\x02\x0b\xd5\x1c\x62\xe9\x9f\x1a\xf6\x86\xae\xd9\x37\xc5\xd5\x04\x39\x31\x98\xec\x38\xea\x30\x33\xbf\x41\x0a\x79\x32\xab\x33\x20\xf2\x05\x0c\xbb\x8a\x27\x05\x78\x34\x77\xe8\xa7\xc3\xbe\xab\x8f\xa5\x59\xcc\xb1\x39\xec\x6c\xc5\x74\xca\x69\x3a\x0e\xe4\x41\x12\x69\x07\xec\x25\x59\xc9\xd7\x94\x4a\xb4\xe8\xcb\xca\x35\xdd\xe9\x53\xcf\x06\x80\xf6\x7f\xd3\x00\xfb\xf3\x6e\xe0\xfa\x17\x50\x91\x46\x22\x70\x55\xa3\xd8\x53\x79\xa4\xec\x4c\x62\xf7\x93\xdf\xa4\xbf\xba\xb0\xbd\x71\xdc\x75\xde\x56\x69\xba\x74\x51\x29\xba\xcb\x8a\x0b\x2d\xba\x2e\xd1\x0e\x9c\xa1\xa9\x09\xbc\xa0\x71\xd1\xe6\x73\x8b\xf1\x3d\x3a\x7d\x21\x92\x8d\x1f\x90\x86\xa8\x91\x73\xc5\x7d\x4d\x3c\x9a\x0b\xf9\xbb\x53\xf2\x0f\x5d\x37\x19\xb2\x86\x46\xb8\xed\xf9\xf7\xf7\x1a\x4c\x49\xa3\x84\x69\xa2\x56\xe8\x94\x29\x61\x75\x00\xb2\xdd\x0a\xbe\xd3\x95\x8e\x33\x01\xfc\x39\xb6\xed\x9f\xa7\x1a\x62\xa0\x76\x62\x81\x11\xd5\xba\x52\xb3\xe0\xdb\xc3\xb5\x9e\x9f\xd4\x8f\x47\xf6\x98\x4d\x10\x61\x4a\x25\x9d\x63\xad\x89\x05\x4a\xde\x0a\x5b\x23\x16\x35\x61\x6b\xb4\x45\x96\x6e\x19\x25\x70\xeb\xab\xfe\xca\x25\x71\x68\xd2\x34\xc1\x65\xce\x31\x0f\x9e\xe6\x69\x8d\x2a\xf6\x58\xa9\xe9\xf9\x7d\xe9\xed\x79\x94\x23\xbf\x24\xff\x8c\x07\x39\x50\xf4\x57\x54\x12\xba\xcb\x4e\x78\x53\x55\x5c\x28\xf8\x88\x74\x5d\x2b\xd9\x97\x8e\xd2\xe6\x02\x2f\xe9\xdf\x64\x77\xa0\x1f\x95\xb9\x03\xb6\xb8\x0e\x56\x8b\x7e\xff\xe1\x96\xa9\x9f\x7e\x9c\x83\xfd\xfd\x90\x6a\x30\x71\x0b\x9b\x1f\x81\x3d\xae\xdb\x3f\x71\xc3\xc5\xe1\xfb\xeb\x66\x71\xbf\xbb\x6e\x03\xd8\x93\x74\xfb\x07\xdd\x50\x75\x82\x66\x4f\x51\xcc\x60\x6a\xb5\xac\x36\xcf\x51\xc6\x41\x0d\x93\xce\x73\x83\x2d\x54\xa4\x5b\xbd\x2c\xf5\x81\x2b\x95\xd0\xae\x1f\x96\x73\x7a\xc7\x77\xe8\xaa\xd7\xb0\x9d\xaa\x30\xba\x22\x1c\x16\xa6\x49\x17\x34\xb8\x74\x1f\x24\x69\x9b\x41\x75\x81\xf5\x62\xf4\x38\x1d\x74\x18\xf1\x62\xfd\x83\xda\x94\xc7\xdf\x0b\xec\xef\xb8\x23\x7e\x88\xfe\x7a\x7c\xe3\x85\x43\x19\x2b\xbc\x22\xaa\xc6\xfa\x84\x63\x7c\xa5\xaf\x15\xcf\xe6\x6c\xa4\x7a\x9d\xe0\xed\xf1\xa7\x92\x6f\xe2\x6e\x44\x80\xc7\xf8\x5b\x96\xa5\x0c\xef\xd2\x7d\x31\x43\xec\x68\x5f\xcb\x47\xfc\x72\x31\x49\x2d\x29\xcb\xb4\x78\x99\x78\x7e\x49\xf9\x7d\x44\x29\x53\x24\x44\x7f\xff\xa1\x5b\x75\x46\x9b\x53\x98\x9e\x7a\x53\x8a\xff\x7e\x06\xe7\xa3\xe2\x0d\x0b\xa1\x88\x7e\xfb\x40\xf5\x7d\x2d\x20\x0c\xe6\xff\xd7\x08\x2f\x9e\x60\x84\xa9\x47\xb9\xef\x69\x86\xdc\x92\x90\x7d\xc6\xc3\x34\x70\x60\x8b\xb1\x0c\x71\xe4\x11\x30\xfb\x04\x68\xae\xea\xfc\xd3\xdf\x40\x73\xdd\xae\x6c\x89\xaa\x61\x71\x52\x9d\x97\xac\xe5\x4d\x69\xfa\xfe\xf1\x56\x67\x58\x5b\x36\x9c\x94\x51\x6d\xa9\xb7\x9e\x9d\xc8\x58\xf4\xd2\xe4\x50\x9c\x6e\xf6\xd5\x28\x05\xa3\x95\xd7\x5e\xdb\xbd\x93\x75\xc2\xf8\x4f\x7e\x4b\x75\xd8\x53\x0e\xf0\x10\x9e\xcf\xba\x7b\x8c\x1a\x90\xd1\xcb\xbc\xe8\x06\x7e\xea\xa2\xc1\xce\x9a\x9e\xc6\x85\x84\x05\xdc\x0f\x1a\x7f\xa2\xcf\x71\xdd\x6a\xbb\x5a\x39\x3a\xd7\xb3\xe0\xa1\x44\x8f\xe7\x69\x72\x8c\x9a\x05\x12\x3d\x4b\x0f\xa5\x18\xbe\x09\x1a\xc8\x91\x97\x3d\x6f\x67\xfd\xd9\x17\x33\x0f\x67\xff\x0d\x00\x00\xff\xff\xcf\x7d\x98\x2f\x93\x20\x00\x00"
-
-func flowserviceaccountCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_flowserviceaccountCdc,
-		"FlowServiceAccount.cdc",
-	)
-}
-
-func flowserviceaccountCdc() (*asset, error) {
-	bytes, err := flowserviceaccountCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "FlowServiceAccount.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd3, 0xd0, 0x4a, 0x16, 0x6c, 0xb, 0x15, 0xe3, 0x5e, 0x81, 0x3, 0x42, 0x66, 0x1a, 0xa, 0xba, 0x5, 0x11, 0x16, 0x1b, 0x75, 0xa5, 0xe3, 0x20, 0xdc, 0xe0, 0xbc, 0x74, 0xda, 0xa2, 0xe8, 0x54}}
-	return a, nil
-}
-
-var _flowstakingcollectionCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x5b\x93\x13\x47\xd2\xe8\xfb\xfc\x8a\x84\x07\x2c\xad\x35\x1a\xc7\x39\x27\x4e\x7c\x31\xc1\x18\x63\x06\xd8\x09\x6c\x60\xb9\xac\x1f\xcc\x86\x5d\xea\x2e\x8d\x6a\x69\x75\xc9\x5d\xad\x91\xb5\x2c\xff\xfd\x8b\xba\xdf\x5b\xdd\x1a\xcd\x00\x81\xf5\x00\x23\x75\xd7\x2d\x2b\xef\x95\x99\x75\xf2\xb7\xa3\x23\x00\x80\x27\x15\xdd\xbc\x6e\xd1\x7b\x52\x5f\x3e\xa2\x55\x85\x8b\x96\xd0\x5a\x3e\x7a\xb3\x20\x0c\x0a\x5a\xb7\x0d\x2a\x5a\x28\xf1\x9c\xd4\x98\x01\x82\xc2\xbc\x07\x73\xda\x00\x93\xad\x01\xd5\x25\x94\xb8\xc2\x97\xa8\xe5\x5f\xe9\xec\xdf\xb8\x68\x99\xe8\x69\xb3\x20\xc5\x02\x50\x55\xd1\x0d\x83\x35\xc3\x0d\x83\x96\x8a\x86\xd8\x6d\x86\x45\x7f\x88\xc1\x12\xd5\x5b\xa8\x69\xc9\x87\x63\xd0\x2e\xf0\x16\x36\xa8\x6e\x81\xd4\x80\x80\x91\xfa\xb2\xc2\x80\x8a\x82\xae\xeb\x76\x2a\x06\xb8\x68\x41\xcc\x75\xb9\x42\x2d\x99\x55\x18\x36\xa4\x5d\xf0\x86\x50\xd1\xe2\x3d\x2e\xa1\xa5\xef\x71\xad\xdb\x00\xc3\xed\x7a\x35\x95\xab\x7c\x8d\xb1\x78\x91\xd6\xf3\x8a\x6e\x4e\xf8\x3f\xc7\x05\x6d\xf0\xb1\x5e\x39\x83\x57\x8f\x1f\x9e\xff\xfc\x58\x4c\x6e\x49\x1b\x0c\x0b\x72\xb9\x80\x0a\x5f\xe1\x0a\x48\x3d\xa7\xcd\x12\x09\x60\xa0\x19\x5d\xb7\xa2\x2f\x0d\x12\x0b\x29\x3e\xd8\xdf\x4e\x8e\x8e\xc8\x72\x45\x9b\x16\xee\x3e\x59\xd7\x97\x7c\xa2\x6f\xf8\xbc\xee\xda\x9f\x2b\xba\x49\xfc\x74\x71\xfe\x06\xcd\x2a\xac\xf6\xc9\x3e\xfb\x49\x2c\x4e\x34\x60\x7e\x8b\xd7\x2d\x6d\xd0\x25\x7e\x82\x71\xf0\xe0\x51\xb5\x66\x2d\x6e\xfe\xf1\xc8\xff\xf9\xfc\xd9\x53\xff\x87\xc7\x2b\x5a\x2c\xec\x4f\x3f\xae\x9b\x1a\x37\x77\x8f\x8e\x50\x51\x60\xc6\x46\xa8\xaa\xc6\x16\x37\x92\x48\x04\x1f\x24\x80\x4f\x4e\x4e\xe0\xa1\x02\xfc\x0a\xb5\x0b\x89\x12\x6e\x3f\x15\x6e\x21\x6a\xae\x56\xf0\x12\xb5\x8b\x53\x70\xbe\xf4\x6b\xfd\xb2\x21\x57\xa8\x55\xad\x9d\x2f\x3d\x5b\xaf\x67\x15\x29\x54\x63\xf3\xb7\x5d\xce\xe3\x2b\x5c\xb7\xf1\x3a\x30\xff\x19\x9e\xd3\x12\x3f\x2c\x4b\xbe\x2f\x51\xc7\x23\x8e\xd4\x17\xe7\x7c\x41\x0d\xa9\x2f\x27\xd0\xd0\x0a\x9f\xc2\xdb\x8b\xba\xfd\x9f\x09\xa0\x25\x07\xd2\x23\xba\x5c\x92\xb6\xc5\xe5\x29\xbc\x7d\x42\xfe\xfc\xff\xff\x6f\x02\xa8\x2c\x1b\xcc\xd8\x29\x3c\x94\x7f\x3c\x18\x67\xc6\x3e\x97\x84\x44\x9b\xde\x13\x28\x75\x0b\xfe\x23\x9f\xc7\xff\xfd\x3f\xc3\x26\xd2\x01\x85\x57\x78\x49\xaf\x70\xf9\xa4\xa1\xcb\xa1\x90\x18\xbc\xe0\x41\x63\xa5\x17\xdd\x7b\x6d\x3f\xa3\x62\x41\x6a\xac\x90\xfa\x51\x83\x51\x8b\xcb\x61\x0b\x1a\x5b\x64\x7a\xdd\x36\xeb\x82\xf3\x0d\xd4\x02\x6b\x69\x83\x99\x9d\x1f\x5c\x9c\x0b\x16\x13\x4d\x84\xc9\x46\xe7\x76\x21\x0c\x3e\x88\xb7\x52\x08\x6e\xfa\x7b\xee\xcd\x71\xf7\xfb\x16\x3e\x47\xe6\xe5\x2b\x82\x37\x40\x6a\xd2\xf6\x01\xeb\xd8\x99\x15\xff\x30\x5c\xcd\xa7\xc1\x74\xe0\x0c\x64\x4f\x1d\x6f\x8a\xb7\x9c\x6f\xe6\xd5\x8f\x47\xf2\x5f\x03\xce\x47\xb4\x6e\x11\xa9\x59\x82\x35\x23\x31\xce\x37\x5c\xbe\x88\x0d\xd4\x6c\xc9\xb4\x95\x72\x8a\x70\x21\xc7\x70\x41\xeb\x12\x35\x5b\x23\x34\xc4\x06\x11\x06\xb4\xae\xb6\xb0\xc4\x5c\x1e\xb5\x14\x16\xb4\x2a\x4d\x7b\xce\xfb\xff\xf1\x08\x68\x03\xe7\xcf\x9e\x2a\x09\x28\x04\xdc\x93\x9f\x5e\xfc\xc2\xdf\x46\xeb\x96\xf2\x29\x15\xa8\xaa\xb6\xb0\x42\x5b\x21\x54\xda\x06\xd5\x0c\x29\x89\x8a\x31\x33\xfd\x35\xb8\xe2\xa8\xc5\x5b\x3a\xdd\xae\x70\x23\x56\xc5\xa6\x39\xb4\xf0\x11\xf4\xa2\x9e\xd3\x0e\xe4\xa8\xfb\xe1\x84\x83\xce\xe6\x1d\x0e\x6e\xb4\x42\x33\x52\x91\x76\xcb\x67\xc9\x01\x20\xd6\xfa\x4f\xb4\xae\x04\x7c\x84\xd0\x97\x02\x76\x53\xe3\xc6\x6d\xda\x52\x21\xa6\xcb\x06\x6d\xf8\xda\x4a\xbc\xa2\x8c\xb4\xaa\x1b\xd2\x98\x5d\xd2\x1b\x40\xe6\x50\x63\x5c\xe2\x32\x9c\xa3\x96\x43\x72\xa2\x4b\x6f\xf1\x62\x22\x2f\x1b\x7a\x45\x4a\xdc\x9c\x3a\xd3\xbd\x8f\xd6\xed\x62\xe4\xc9\xe1\xe9\x2f\x6a\x3a\x63\xb8\x67\x24\xf1\x54\xf4\xf0\xbd\xc5\xff\x24\xea\x7b\xc4\x7e\x33\x33\x08\x49\x69\xd5\xe0\xe0\x17\xfe\xe9\x18\x7b\x5a\x2c\x70\xf1\x7e\x34\x3e\x8d\x1a\xf1\xcf\xdd\xa4\x10\x9f\xc6\xa8\x34\xe5\x00\x38\x85\x8b\xfa\x0a\x55\xa4\x14\xb2\x1f\xc4\x3c\xd5\xa6\x9b\xe1\xee\x7a\xe3\x7c\x8c\xa9\xbb\xde\x45\xfe\x1c\xaa\x70\x26\x80\x1b\x3f\xec\x58\x29\x9c\x75\xc1\xc1\xe1\x1d\x2e\x3a\x3e\xc5\xad\xd0\x35\x35\xaf\x06\x3a\x17\x5f\x03\x34\x4c\x12\x88\xe0\x89\xf3\x75\x0d\x97\xb8\x55\x1c\x7e\x34\x36\xcc\x3e\xd8\xa6\x06\xb7\xeb\xa6\xde\xb9\x88\xe9\x8c\x36\x0d\xdd\x8c\xc6\x77\xa6\x82\x74\xee\x4c\xd5\xc4\xf2\xbc\x4f\xea\x2a\x40\xea\x16\x37\x73\x54\x60\xc9\xb3\xa4\xc2\x5d\xa0\x1a\x56\xfc\x39\x5b\x48\xa6\x23\x68\x2c\xd6\x54\x4d\x67\x8c\xca\xe6\xb4\x5d\xe8\xf6\x7f\xac\x71\xb3\x0d\x5a\x26\xc5\x53\x83\x19\x5d\x37\x05\x76\xa6\x92\x51\xb2\x32\x8c\xe9\x0a\x35\x4a\x75\x97\xda\xed\x5b\x66\xd5\x90\x6c\x83\x75\x3d\xa8\x09\xdf\x2f\x54\x96\x5c\x08\xbd\x10\xac\x7a\xf4\x9b\xc0\xc4\x53\xf8\x21\x56\xba\xa7\xfc\x35\xfe\x37\x6e\x42\x02\xe7\x44\x71\x9a\xe0\xb9\x4a\x69\xc9\x0c\x6b\x44\xb7\x19\xdb\x88\xb7\xfc\x04\x4c\xa3\x74\xd7\x06\x0d\x4b\x8a\x99\x98\xec\xe3\x3f\x09\xeb\x25\xa8\x1f\x8c\x4f\xe1\x47\x4a\xab\xec\x94\x2f\x71\x2b\xc5\xb5\xc0\xec\x5f\x65\x57\xff\xea\x7a\xdd\x55\x4e\x44\x1b\xf7\x87\xce\x96\x0f\xab\x4a\x8c\x55\xcf\xa9\x68\x98\x81\x06\x7f\xbe\xab\x1f\x3b\x66\x47\x67\xde\x4b\x9d\x3d\xfa\xbb\x2c\x96\xf5\x41\x82\x22\x85\x01\x1e\x81\x7a\x7a\x64\xdd\x92\xb6\xc2\x4b\xae\x4d\x5a\x8a\x78\x21\x24\xa4\x21\xc0\x37\x0b\x6c\x09\x49\xa9\x87\x1c\xff\x98\x54\x13\xb9\x35\x2c\x49\x51\x71\x26\x65\x54\xd3\x06\x9b\x2e\x50\x55\x05\xe4\xaa\xcc\x72\xa1\x95\x14\x91\xec\x56\xd6\xb2\x96\xb8\xf2\x65\xd3\xdb\x33\x8c\x57\x8c\x6b\x2b\xc5\x7b\xce\x1c\x17\x74\x23\x2d\x75\xdd\xaa\x2e\x0d\x09\x4a\x83\x9b\x01\x6a\xa4\x35\x8c\x4b\x97\xaf\x90\x16\xde\xd7\x74\xc3\x94\xc2\xa5\xde\x6d\x29\x5c\x92\x2b\xac\xe7\xc2\xd9\x16\x6c\x16\xb8\x96\x0e\x00\xad\x1e\xf0\x51\xb4\xda\x60\xfa\x2c\xc9\x7c\x8e\x1b\x0e\xcd\x76\xbb\xc2\x92\x75\x8b\x4e\xcd\x1b\xe6\x8f\x5f\x1e\xbe\x7a\x7e\xf1\xfc\xe9\x29\x5c\xcc\x61\x4b\xd7\x50\x62\xd6\x36\x74\xcb\x55\xbe\x88\x17\x5a\x37\xc2\xef\xea\xb5\xdf\xa1\xa0\xcb\x25\xaa\xcb\x89\xe9\x90\x77\xb2\x21\x55\x05\x15\x65\x58\xed\xb2\xd2\x7c\xf8\xb3\x46\x3b\x32\xac\x9f\x83\x36\x4c\xee\x67\xda\x57\x60\x7a\x5e\xa2\x1a\x5d\x62\x36\xd5\x53\xdd\x28\x9d\x53\x4f\x99\xb4\x13\xf1\xfb\x72\xcd\x5a\xc0\x84\xf3\x69\xa9\x4b\xce\x71\x63\x87\x16\x73\x71\x20\x84\x02\xa5\x77\x5d\x2b\x47\x8c\x9e\xaf\xde\xba\x14\xa0\xc5\x0b\xaa\x45\xe9\xbe\xd9\xe0\x0d\x6a\x4a\x06\x73\xd2\xb0\x16\x66\x78\xce\x31\x54\x4d\x94\x93\x98\x8b\xd5\x35\xdf\x5b\x0f\xac\x91\x70\xf8\x1d\x96\xb8\x5d\xd0\xd2\x5f\x6e\x5e\xce\x44\x1d\x9c\xe6\x04\xce\x04\xa4\x2f\x63\xca\xff\xe3\x4c\x40\xfb\x29\x2c\x3c\x14\x0a\x5f\x71\x69\x1c\x32\x03\x2e\xb5\x7d\x69\x23\x84\xf6\x41\x34\x4b\x3e\x7a\x72\x6c\x61\x9b\x70\x14\x9b\x61\xa8\x49\xc5\x35\x61\xa1\xa8\x28\x32\x5d\x20\x06\x35\x85\x82\x36\x0d\x66\x2b\x5a\x97\x1c\x9f\x7c\x52\xce\x2f\xe3\x30\x8b\x78\xe0\xaf\xe2\xb5\xb4\x63\x43\xa6\xc3\xf5\x8e\x14\x35\x78\x6d\x1f\xa1\x5a\xda\x58\x1c\x49\x68\x8d\x1d\x6b\x78\x85\x15\x4a\x3b\xca\x22\x6f\xc2\xcd\x02\x8e\x29\x33\x0c\x2b\xe9\xeb\x51\x43\x6d\x60\x86\x0b\xa4\xb0\x6d\x0b\x05\x5d\x57\x25\x7f\x6b\xcd\x1c\x10\x39\x9c\x22\x09\xa1\xda\x48\x7e\x76\x0a\x3f\x18\x76\xdf\xa9\x23\x7c\xec\xee\xce\x08\x9c\x3e\x3d\x9a\x97\x3f\x1e\x05\x80\x12\x9b\x65\xb9\xb7\xd8\x90\xbf\xd3\x8a\x6b\xc0\xca\xf8\x94\x32\xc2\xe2\x74\x88\x0e\x8e\x73\x8e\x09\x6a\x97\x76\x72\x20\x07\x04\xee\xd5\x54\xf9\x27\xa0\x5d\x10\x36\xe1\x4c\xdc\xc1\xc9\xfc\x7a\x5b\x3b\xab\xbe\x08\x36\x01\xd7\xb3\x39\x15\xff\xbd\x30\x66\xef\x18\xee\xc5\x8f\xe5\x00\x21\x1e\xbe\xe1\xb2\x8a\xf5\x92\x54\x9a\x17\xe3\x86\x1b\xf9\x12\x41\x84\x3b\xda\x88\x50\x83\xb9\x27\xc2\x5a\x4d\x22\xef\x2f\x9c\xbb\x15\xd2\x71\xc6\x7b\xa8\xf1\x46\x0d\x30\x81\x58\x32\xae\x19\x2e\x25\xc7\x9c\xc0\x9c\x72\x4b\x19\x97\x30\xdb\x86\x73\x8b\x47\xd0\x7c\x99\x0f\xa1\xbb\x4f\x89\x5e\xfd\x5e\x9d\x1a\xa5\x13\xfd\x6f\x44\x0b\x4f\xed\x4d\xc2\xc4\x62\x80\x18\xa3\x05\x11\xbe\x0f\x21\x82\x05\xe8\xf3\x28\xe6\xeb\xe3\x6c\x87\x4e\xe6\x5b\xf2\x9e\x69\x76\x28\x9e\x3e\xf1\x7a\xbd\x65\x0a\xd0\xc3\xf6\x73\x18\x78\x4b\xde\xcb\x45\x10\xff\x22\x3d\x04\x8f\x50\xcd\x79\xc6\x45\x4d\x5a\x82\x2a\xf2\x1f\x0c\x48\x0b\x65\x47\xe9\xbd\x23\xf4\xdc\x95\x34\x7a\xa5\x3b\xc1\xf5\x26\x38\x3a\xaa\xc0\x04\xe3\x31\x72\x54\x68\xc6\x29\x96\x48\x7f\xc4\x6e\xaf\x83\xb7\x60\x38\xf3\x01\x70\x94\xf6\x52\x28\xf6\x0f\xf7\x8f\xe1\x43\xc6\x91\x61\x59\xba\x7a\x2b\x7e\x2d\xa4\x0b\x38\x83\xef\xa6\xdf\xe5\x67\x18\xbd\xe9\xbd\x7a\x72\xc2\xf5\xc2\x50\x0f\x08\x79\xf7\x44\x60\xb9\x82\x3f\x11\x36\x02\x07\xee\x42\x20\x4b\xd8\x1f\xe7\x8b\xae\x02\xe2\x40\xdf\x7b\x95\xcc\x85\xa7\xcd\xc1\xeb\x17\xb3\x7f\xc3\x99\xfb\x43\x02\xd3\xc4\xda\xdc\x57\xbc\x06\x47\xd1\xfb\x27\x27\x20\x5d\x20\x8a\x47\x70\x59\x26\x26\x2f\xf5\x62\x23\xe3\xe6\x0d\x5d\xa6\xac\x97\x54\x87\xdc\xc6\xc0\xad\xa3\xa5\x3b\xed\x3a\x97\xcc\x3f\x7c\xcd\xce\xce\xfc\xac\xa6\x71\x16\x00\xc2\x71\xdc\xc8\xbf\xdc\xb7\x47\xe3\x34\x5c\x7c\x9c\x8c\x47\x99\xfa\x1a\x21\xff\x7c\x04\x5c\xb1\x14\x49\x27\x00\xed\x4a\xe8\x8e\x71\xc3\xd7\x52\x58\x1c\x30\x5b\x38\x73\x49\x22\x50\x51\x84\x4f\x4d\x58\x56\x75\x89\x9a\x12\x70\xd3\xd0\x06\x96\x98\x31\x74\x29\x0c\x13\xc6\x45\xb3\x36\xf2\xa0\xc1\x7f\xac\x31\xe3\x5c\x5f\x4a\x62\xaf\x2f\xc2\x84\x0a\x22\x9c\xbd\x9c\x7b\x08\x19\xd6\x61\x41\xa9\x76\x5e\x1f\x3f\xac\x50\x83\x96\xc6\xe5\xcd\x39\xcf\xc5\xb9\x76\xf4\xd9\xd1\xf9\xf3\x54\x3b\xcf\x6f\x92\x6b\x6c\x5e\xca\xcf\x42\x79\x00\xb5\x90\xe2\x3d\xcd\xd7\x55\x15\x83\x67\xd5\x90\x1e\x1e\x47\xc9\x9d\xce\x29\x66\x75\x2b\x1c\x3e\x17\xb5\xe5\xaf\x8f\x79\x9f\xa3\xf9\xba\x2e\x9e\xa3\x25\xb6\x3e\xa0\x9e\x3e\x21\xf9\x38\x40\x32\x4e\x08\x62\xae\x2f\x1b\x3c\x27\x7f\xc2\x59\x7f\xd1\xf0\xce\x4c\x65\x7c\x0a\x77\x23\x8e\xc6\x41\xe1\x43\x81\x30\xc7\x76\x25\x73\xae\x76\x8a\x03\x1a\x6b\x15\x5c\x31\x75\xb2\x93\xe2\x51\xf6\x35\xef\xfc\x28\x41\x35\x6a\x53\xee\xbe\x1b\x39\x4b\x1b\xf3\x09\xb1\x15\x2e\xc8\x9c\xb8\x7b\x6b\xd5\x12\x8e\x03\xef\x94\x87\x6d\xec\xdb\x35\xe2\x89\xf9\x36\x16\x5e\x39\x81\xc3\x98\x6f\x92\xd6\xcd\x85\x73\xf7\x1b\xe6\x06\x0b\xc0\xcf\xdc\x20\x67\xeb\x06\x5b\x3f\xc1\xc5\x39\x13\xc6\x3e\xae\x5b\xdc\xe0\xd2\xb1\xf6\x94\x81\x6f\xa6\xa6\x0f\xaa\x42\x4a\x21\x2c\x15\x98\x70\xb7\x1f\x4f\xe9\x03\x1d\x01\x0e\x01\x18\x0f\x26\xd7\x5c\x76\x66\xd5\xd2\xc7\xa2\x3c\x1c\xd7\x59\x71\x8e\x77\x3d\x42\xa2\x23\xc3\x9c\x1c\xb7\x10\xc7\x49\xe9\x94\xe0\x62\x83\x20\xf8\x5d\xf9\x14\x66\xeb\xa6\x1e\x8d\x7f\xcf\x1f\x50\x71\x8a\xe5\x2f\xf1\xce\x67\x88\xab\x5a\xae\xfb\x41\xd3\x96\x84\x1c\x67\xad\x82\xe3\xba\xae\xd7\xe8\xdd\xd2\x3d\x02\xb6\x0d\x7c\xe7\xab\x3f\x84\x36\xc3\xc5\xe1\xb2\x19\x2c\x23\x47\x8a\x8a\x32\xa9\xff\x18\x37\xb2\xfc\x3f\x60\x19\x35\xa9\xc6\x5d\xb2\x63\xee\x1a\x4d\x72\xe4\x32\x7d\x7a\xbd\x6b\x78\xa7\x59\x78\x94\x1c\xcc\x29\xf9\xe6\xc5\x79\x38\xcf\x1e\x08\xe0\x98\x74\xca\xbc\x72\x42\x9f\xa6\xea\xec\x48\x3d\xe0\xea\x88\xf2\xc6\xd1\x06\x66\xb4\x5d\x48\xd5\xc2\xb7\xe3\xde\x32\xcc\x02\x93\x50\xd9\x68\xad\xf0\x90\x69\x6b\x8e\xcc\x81\x5b\xae\x22\xf0\x48\x7a\x53\x85\x31\x9e\x3c\x08\x95\x16\x91\x92\x0a\x52\x7b\x1c\xc9\x58\x0e\x6d\x82\x8d\x4f\xe1\x87\x0f\xbe\xc9\x21\x64\xff\xc7\x14\x1a\x86\x9a\x72\xac\x3e\x5b\x55\x27\xdb\xf8\x47\x54\xa1\xba\xc0\xa1\xa2\x3d\x9d\xc9\xdf\xa7\x0c\xb5\xeb\x46\x84\x8c\xbd\x5e\xcf\x04\x95\x8c\x82\x00\xa6\xe9\x92\xd4\x64\xb9\x5e\xaa\x9f\x5e\x61\x86\x9b\x2b\x61\x08\x8d\x33\x1a\x71\x83\xa5\x8c\x08\x55\x61\xed\x76\xf4\x14\x3e\x01\xf5\x50\x74\x44\xba\xd1\x1d\xa1\x1c\x85\x50\x02\x4f\x2b\xf4\xe0\xe4\xfc\x74\x27\x03\x26\xbf\xb5\x05\xd4\x0d\x83\x49\x20\x0c\x63\xb8\x09\x8c\x5f\xf3\x4c\x60\x0c\xdc\x3f\x0b\x26\xf6\x6d\xb8\xa7\x93\x64\x73\x25\xb8\x4f\xfb\xeb\x04\x06\x59\x8d\xcd\x78\xa9\xad\x0b\x1b\x28\x28\xed\x44\x35\x37\x3a\x97\x61\x06\x56\xeb\x6a\x29\xdf\xdd\x09\xbc\x53\x08\x3f\x9e\x70\x14\x58\x4a\x77\x15\x92\x1c\x9c\xad\x97\xbc\x65\xca\x09\xc4\x7b\xe3\x8d\x77\xac\x98\xf7\xea\x8b\x2f\xed\xb2\x08\xa4\x0a\xff\x24\xc0\x2e\x31\x54\xb9\x94\xf4\x0a\x0b\x54\xc3\x8c\x6b\x90\x22\x82\x49\xf2\x0f\x39\xea\xc4\x9a\xbb\xd2\xc7\x2e\x64\x70\xbb\xc0\xcb\xa8\x67\x32\x87\x51\x66\xe3\x42\x3f\x80\xfe\xe4\x6c\xd2\xf4\xef\xdf\x2a\xd8\xc7\x8b\x02\xab\x1e\xdc\x3f\x76\x71\x57\x4f\xde\xf0\x20\xb5\x35\x51\x0f\x19\x38\x71\x4c\xe0\xb0\xea\x02\x90\xfc\x11\x36\x5c\x03\x90\xef\x29\x06\xaa\x1e\x48\xdd\x9c\x29\x2b\x51\x6f\x67\x34\xa0\xd1\x7b\x92\xab\x3b\x39\x81\xf5\xaa\x44\x2d\x0e\x18\xb6\xf0\xdf\x35\xb8\xa0\x8d\x50\x38\x50\x29\x3c\xef\x66\x48\x65\x1a\xa8\x36\x8a\x92\x0f\xb2\x15\xde\xfe\xa6\xe7\x2c\xf4\x88\xf5\xf2\xad\xe7\x4b\x78\x43\xdf\x32\xce\x61\x14\xa6\x1c\xf7\xe9\x88\xcb\x2a\xb9\x78\xcf\x93\xbc\x13\x04\x6a\x0c\x43\xa2\xb9\xce\x97\xa4\x5e\x33\xb1\x81\xf8\x0a\x37\xb0\x41\xaa\xd3\xd0\xac\x0f\xfc\x94\x11\xf8\x92\x5e\x93\xdc\x93\x6f\x33\xb0\xc9\xc3\x52\xad\xf7\xfe\xb1\x75\x51\x4d\x0b\x11\xfa\xf7\x78\xb9\x6a\xb7\x02\xe3\x47\x42\xa2\xbc\xd9\xae\xf0\x29\xf0\x7f\xef\xff\x10\x3a\x06\x47\xe3\x04\x57\x00\x13\x80\xa2\xdc\x39\xed\x1a\x55\xbe\x3a\x81\x8a\x05\xc4\x1e\x00\x77\x7e\x72\x29\x2f\x69\x23\x34\xd4\xfb\xc7\xd0\x49\x89\x3e\x83\xc8\x76\xaa\x21\xe7\x74\xeb\x4b\xf1\xa8\xe3\x34\x58\xf3\x8b\x3e\xd7\x51\x5f\x0b\xbc\x04\x52\x2b\x8f\x0c\x43\x4b\xdc\xb1\x5e\x09\x9a\xa9\x3a\x12\x1e\x71\x10\x9d\x1a\xde\xa3\xe6\x9a\x5e\x54\xa6\x65\xb0\xce\xcc\x74\x0d\x93\xcb\xa0\xe2\xc7\x7e\xa6\xd4\xc9\x09\xbc\x26\xb5\x38\xd3\x57\x9a\x4a\x4d\x23\x55\x45\xc8\x08\x89\x01\x0b\x24\x0f\xc5\x0b\xba\xc4\x96\x2a\x6a\xda\x2c\x51\x65\xe9\x71\x96\xa3\xe1\x7e\xa2\xfe\x0b\x17\xed\x7e\x63\xfe\x6e\x5f\xc9\xdd\x4f\x70\xef\xc3\x61\x72\xf2\xd2\xa0\xd1\x0e\x52\x4a\x49\xcb\xac\xb1\xa2\xe8\xc8\x58\x21\xdc\xb8\x94\x16\xb2\xd4\x72\xd1\xbc\xc5\x0d\xcc\x30\xe7\xce\xce\xa9\x10\xc7\x26\xe9\x95\xc7\x22\xbe\x54\xe5\x6f\xd0\x7a\x9a\xeb\x3d\x92\x00\xca\x6a\x21\x73\x58\x51\xc6\xb8\x5d\x31\xe0\x98\xc9\x5a\x2c\x8a\x24\x95\xd5\x22\x09\x33\x6d\xaa\xf4\x3b\xd7\x10\xce\x24\xc2\x94\x37\x89\x2d\xc4\xd1\x6f\x2d\x44\xcc\x0c\x43\xdb\x90\xcb\x4b\xe1\x51\x20\x35\xac\x1a\x5a\xae\xa5\x65\x3f\xc3\x05\x62\x6b\xec\xaa\x67\xea\x90\x0e\x57\x65\x4c\xf1\x27\x27\xba\x67\x71\xf4\x4c\x57\xb8\xa9\xb6\xca\x3f\x2d\xe5\xa1\x52\xf5\x44\x10\x1d\x5f\xa7\x18\x26\xee\x88\xaf\x57\xeb\xfa\x9c\x1c\xf3\x58\x95\x54\x09\xae\x79\x5e\xe3\xc1\xde\x50\xaa\xc2\x53\x41\x68\x3a\x80\xd9\x3d\x6e\x10\xc4\xc7\xed\xac\xaa\xc1\xa8\xdc\x72\x48\x72\x03\xcb\x35\x8c\x43\x57\xcb\xf5\x4c\xcc\xc0\xd8\xdb\x65\xed\x5d\x2a\x79\xea\x59\x7b\xf2\xe0\x43\x79\x23\xc4\xaa\xdd\xa0\x67\xd8\x65\xfd\x25\x74\x55\xae\x79\xf7\xdc\xbe\x4e\x4d\x7c\x10\x77\x39\xf6\x30\x26\x2d\xae\x7c\x30\x86\x22\x8f\xff\x1f\xcb\xc8\xac\xd0\x92\x20\x87\x57\x12\x27\x92\x3c\x60\xcf\x49\xc4\x8c\x2f\x07\xbd\xb4\x48\x1f\x70\x64\xe6\x35\xe9\xad\x6e\x07\xb0\xce\x40\xc6\x65\x79\x92\x72\xb4\x22\xac\xdd\xd7\x05\x06\xc4\xfa\x6a\xb3\x69\xf7\x41\xdf\x5d\x1c\x70\x4e\x74\x1d\xb4\x0b\x3b\xcc\xd8\x71\x5d\x7a\x8e\x26\x52\x19\xa4\x1c\x1e\xc1\x29\x7f\xba\x4e\x0c\xd0\x5a\x03\x69\xa7\x3b\xce\xb1\x77\xc3\x29\x2b\x49\x25\x8a\x33\x68\x9b\x35\x16\xde\xb7\x94\x78\xd4\x26\x8f\x70\x69\x33\xad\x5a\xc4\x39\x70\x22\x82\x44\x84\x35\x6a\x47\xab\x58\x12\x5d\xf1\xa7\xa8\x72\x3d\x94\x13\x29\x24\x36\x84\xf3\x50\x54\x31\x3c\xed\x3e\xf8\xd9\x3f\xc6\x37\x40\x85\x20\x48\xc7\xcf\x11\x7a\x90\x38\x15\x0c\x1a\x9c\xa7\xb3\x8b\x86\xb4\x74\xe6\x08\xaa\xd9\x30\xcf\x9e\x46\x23\x37\x2a\x5d\xeb\xca\xa8\x8d\xc2\x9e\xc0\xe1\xf3\xee\x41\xa9\xc3\xe7\xfd\x8f\x3a\x4d\xfa\xcd\x3f\x55\x0d\xdb\x5b\x4a\xcd\x70\xd0\x08\xcc\x70\xe6\xf5\x69\x5d\xfd\x89\x73\xe2\xa0\x83\xf3\x28\x8b\x2a\xec\x29\x78\x63\x40\x97\xdd\xdd\x25\xbb\xfa\xd8\x25\xea\x6d\xac\x82\x52\xeb\xf9\x2e\x4a\xda\x30\x78\x3a\x01\x11\x7c\x22\x54\x26\xad\x49\xba\x11\xb3\xfc\x75\xf3\xfb\x12\xb5\xc5\x02\xb3\xce\x13\xbf\x30\x67\x2c\xbd\xad\xa3\x0e\x90\xde\x49\x1f\x9c\xf3\xcf\xbd\x7b\x39\xc0\x0d\x6f\x24\x47\xbb\x03\x67\xc9\x4c\x98\x9d\x23\x8a\x86\xd9\xe3\x0d\xfe\x49\x23\xa3\x76\x27\x36\xeb\x98\x93\x7f\x4c\xfa\x2e\x7f\xa2\xf4\x7d\xb8\x6d\x36\x46\x3a\x38\x08\x54\xac\xce\xcd\x65\xcc\x99\x42\x82\x8e\x24\xe9\x98\x75\x75\x9f\x36\xb9\xcb\xd5\x54\xdb\x4f\xe8\x05\x5b\xee\xed\x74\x00\xe3\x68\x5f\x72\x64\x3d\x10\x92\xf1\xaa\xf9\x48\xe6\xbc\x34\x81\x40\x59\x61\xf5\x44\x59\x14\xc2\xd4\x2b\x4b\x40\xb5\x14\x49\x9c\x07\xda\x68\x54\x37\x90\x1f\x6e\x3d\x0b\x26\x11\x3c\x40\x4a\x95\xf5\x35\x25\x65\xf4\x50\xf1\x70\x91\xb6\x78\x96\x0b\x8a\x15\xe9\x1c\x1a\x45\x48\x19\x9f\x8b\xb6\xb4\x45\xd5\x6b\x19\xa7\x7e\xe6\xf6\x39\x15\x4f\xa4\x52\x73\x51\xbf\x12\x72\x7c\x34\x86\xe3\xe0\x1d\xfe\xf8\x95\x08\x6a\x0f\x5c\x97\xfb\xb8\x02\x9c\xc9\xf8\xe8\x80\x97\x64\x47\xc6\x78\x84\x3d\x7a\xd1\xee\x74\x49\x19\x3b\x6c\x64\x4a\xa2\xfb\x16\xff\x25\x7e\x2f\x4a\xf6\x8e\x60\x65\x9e\xfd\x22\x03\x84\x6d\xa8\xdb\x68\x9c\xe8\x4f\xa7\x3a\x0b\x70\x08\x6f\xcb\x83\x28\x75\x0d\x62\x37\x4b\x18\x6c\xf7\x2b\x29\xff\x05\xf7\x8f\xef\xc4\xa1\x1d\x27\x27\xf0\x5a\xc9\xfb\x30\x47\x54\x65\xb6\x59\x22\x48\x35\x56\xe7\x09\xc6\xd1\x28\x6b\x4c\x00\xad\xca\x8e\x5c\x53\xc8\xc4\x3f\x89\x69\x86\xf9\x86\x17\x3a\x29\x0e\x86\x93\xac\x81\x6e\x0f\xaa\xbd\x66\x12\x59\x82\x32\x77\x12\x9f\x9f\x50\x15\x9d\xc9\x4f\xbb\xd9\xf5\xf4\x8b\x22\x55\xb7\x2f\x41\xa9\xbb\xeb\x2b\xf4\x22\x57\x05\xa3\xe8\x5d\x0f\x66\xbb\xe8\x7b\x07\xdd\xf2\x25\x29\x98\x7e\x9b\x78\x64\xda\x65\xa0\xa9\x9c\xaf\x6f\xe8\x5b\x99\xec\x73\x2d\x3a\x8f\xf6\xc4\x0f\x98\xfd\x35\xc4\x1e\x49\xf7\x71\x20\x5d\x07\x25\xa9\xe3\xbe\x4e\xf9\xe7\xfb\x34\x95\x42\x2a\xd2\xcc\x16\xfa\xd0\xc9\x49\x12\xd0\x71\xf5\xa2\xe7\x64\x7f\x4c\xc7\x24\x22\x69\xfa\xfb\xfd\xff\x82\xa1\xa4\x32\x64\xb1\xc2\xa8\x49\xb3\x2b\x82\xab\x52\x31\x2d\xd1\x57\x89\x81\x1b\x39\x5e\x47\x4e\x0a\x8b\x4a\xfe\xa7\x0d\x2c\xd1\xd6\xe4\x83\xbd\xc7\x78\x05\xa4\x35\x0c\x2d\xe4\x18\x41\x86\xa1\xe4\x1e\x12\x60\x8e\xe2\xa1\xed\xb6\xf1\x2e\xc9\xff\xa0\x97\xff\x55\xd6\x6f\x48\xdb\xa8\xd9\x00\xa2\xb4\x1f\x53\xc7\x34\x0d\x8a\x73\xbc\x6b\x17\x78\xd7\x46\x3b\xf6\x0b\x5d\x82\xbc\x8f\xe8\x4c\x05\xd2\x8c\xbe\x9b\x7e\x77\xdd\x30\x79\x3b\x43\xc7\xe7\x2a\xd1\x58\xa2\x82\x39\xec\x71\x62\xcf\x5c\x6c\x70\x7e\x96\x61\x41\x2a\x63\x10\xde\x8d\x92\xd3\x1f\xfb\xb8\x3d\x95\x1e\x72\xc2\x64\xa8\xa9\x2c\x6e\x12\x38\xf6\xf8\x0c\xe4\xa9\x81\xcd\x21\x72\x12\x03\x6b\x9d\xea\xa7\x32\x51\xab\x8a\x0a\xa6\x22\x5f\x16\x1e\x30\xb2\xc4\x9d\x41\xf9\x49\xa3\xdc\xd5\x03\x34\x4f\xc8\x1a\xe7\xfb\x29\x8d\x4a\xdd\x4e\xf6\x76\x50\x89\xd4\x79\x00\x18\x73\x15\xbe\x1f\x12\xe2\x2a\xe0\x61\xa2\x4e\x23\x5a\x54\x41\xbd\x5e\xce\xf8\x9b\xf3\xc8\x05\x6b\x52\x32\x55\x84\x6c\x89\xcb\x75\xd1\xba\xe7\xe8\x82\xe5\xe0\x26\x76\x9d\xed\xe3\x07\xcc\x6a\xb4\xa0\x1d\xc5\x7c\xee\x2c\xb3\x44\x33\xa7\x38\x1f\x84\xc9\x9a\x01\x35\x5f\xac\x93\x79\xb1\x44\xab\x78\xe2\x3a\x56\x52\x75\x7e\xff\x38\x8f\x3c\xf7\x8f\x63\xaf\x92\x9a\xea\xa3\x2c\x7b\x76\xbd\x48\x58\xb9\xfc\xd2\xd0\x0b\x95\x42\x3d\x6c\xc2\x97\x05\xae\xda\xdf\xab\x6c\x91\x66\x5a\x19\x8d\xbe\x53\x12\xf7\x08\xb6\x31\xe7\x91\x0e\x2c\x7b\x9f\x64\xcb\xd8\x75\x25\x87\x4d\xa4\xaf\xac\xfa\xc2\xe5\x9a\x91\x9e\xc8\x45\x03\x91\x47\x58\x6a\xb7\x69\x94\xb1\x32\x17\x79\x9f\xd2\xb7\x1a\xef\xfa\x0a\xd5\xa4\x18\x1d\x80\xcb\x0a\x1e\x6b\xe3\x94\x35\x67\x95\xf4\xd3\x35\x45\xc1\x02\x45\xd6\xf5\x0c\x9b\xd3\xbe\x76\xd1\xd0\xf5\xe5\x22\xf5\xba\xa9\x82\x31\xbd\xdb\xd3\x09\xdd\x43\xb5\x09\xed\x84\x03\x68\x37\x61\x97\x79\x05\x67\xb7\x66\x11\x3b\x72\xba\xca\x44\xf5\xb0\x56\x6e\x48\xed\x70\x3d\x49\x87\x57\x3f\xcc\xec\x77\xe9\x20\x9d\xfe\xbb\x5b\xd3\x45\xce\xad\xf5\x18\x2a\x24\xd6\x8f\xf7\x75\x6b\x25\x8e\xd9\xd2\x4b\x31\x29\x6d\x85\xba\x39\x9c\xc1\xe8\x5e\x57\x4f\x88\xc9\x5c\xd2\x4e\x3a\x48\x44\x11\x93\xb9\x37\xce\x94\x94\x81\x4f\x18\x3e\xc4\x7c\x1f\xae\x67\xf2\x0f\x47\x63\xb8\x15\xed\x0a\x52\x1a\x56\xc8\xd9\xf6\x54\xb2\x74\xa1\xd2\xfd\xf4\x2c\xb8\x09\x5d\x0b\xd2\xfa\x56\xb4\xe0\xfe\x2a\x97\x93\xc9\x9a\xd4\xba\xc0\xd1\xbc\xec\x30\xae\xf2\x95\xc0\xec\x9c\xfe\x05\x91\x6b\xa5\x53\x25\x4a\xb6\x07\xc7\xcd\x92\x73\xad\xe8\x4f\x0e\x5b\xf3\x2d\x7a\xfb\x39\xf4\x67\x57\xf4\xa0\x0f\xb8\xf8\xc0\xa0\x2b\x04\x63\x2f\xdd\xc7\xe1\xea\x8f\xff\x5c\xe1\xc2\xcb\x52\xf4\x93\xd4\xbc\x7c\x2d\x3f\xc5\x2c\x8d\x3b\xdf\x04\xd5\x2a\x9d\xbe\x24\x23\x1a\xdf\xdd\x3b\x3e\x61\xb8\x7a\x69\xd1\xf1\xf6\x35\xcc\x5e\xb2\xd3\x66\xc7\x79\x20\xef\xaf\x7a\xf6\xd6\x20\x6d\xb5\x00\xa9\x43\x5e\x12\xd6\xe2\x46\x14\xa4\x08\xca\xb2\x74\x69\x9e\xaa\x15\x12\xed\xf4\xee\x4b\xc6\x9c\xc7\x89\x9e\x5a\xa2\xec\x5b\x78\xa0\x7c\x69\x5b\x1a\x5d\xd1\xfb\xdd\xad\xb1\xe8\x3d\xa8\x71\xbb\xa1\x0d\x9f\xc4\x43\x4d\xab\xa9\xf6\xf6\xb5\x67\x78\x9b\x7e\x45\x41\xa6\xc7\xf3\x97\xf4\x65\xfa\x15\x3f\xdf\xca\x7f\xb6\x42\x5b\xdc\x9c\x82\xa8\xfb\xf0\xa3\x38\xf8\xfb\x27\xaa\xd6\x78\x0c\xf7\x1e\x06\x01\x0a\x63\xf5\x96\x4a\xe6\x99\xd8\xb2\x11\x04\xb3\x89\x28\x72\x2a\x6a\x53\x4f\xe0\x19\xde\xb2\x09\x5c\xd4\x33\xfa\xa7\xed\xe7\x41\x2a\x9d\xcb\x46\xb0\x6b\x8d\x36\x48\x11\xd3\x01\xa7\x51\x4b\xdf\xc6\x4e\x68\x08\xea\x00\x51\x49\xec\x58\x3d\x29\x4f\x21\x7f\x44\x95\x3e\x96\x4a\x6c\x6a\xf4\x53\x57\x2b\xb1\x87\xde\xd7\xf8\x6d\x77\xbb\xed\xdf\x5d\xef\x89\x6d\xf7\xbe\xc6\x6f\x07\x0e\xf6\xd3\x74\xc0\xf8\xf8\x60\x07\x81\x76\x77\x3a\xce\x01\x7b\x9e\xfd\xc9\x1f\x6e\xe9\x50\x4f\xb9\x1a\x92\xe8\xf6\x0a\xeb\x98\xb9\xb3\xe8\xa0\x3c\x3c\x43\xe2\x9f\x07\x0f\x86\x73\x6d\xcb\x80\x4e\xe1\x91\x8c\x0f\xa6\xad\xae\x39\x81\xa0\x31\x53\x50\x9c\xae\xc6\x9b\x6a\x0b\x32\xe9\x23\xca\x76\x26\xe5\x98\xf3\xe6\xe4\x62\x06\x7a\x25\xcd\xda\xc5\x69\x59\x78\x7a\xf9\x4a\x33\xe5\xae\xf3\xcf\xe4\xb1\xa7\x98\x38\x03\x54\x0b\xde\x12\x54\xf9\x73\x32\xcc\x18\x98\x32\xbc\x50\xa0\xaa\x0a\xdc\x41\x64\x6e\x16\xa5\x2a\xb4\xca\xa5\x89\x92\xf1\x62\x45\xaf\x68\x85\xa7\x0a\xd4\xb4\x99\x36\x48\xf2\x39\xf8\xef\x7f\x7b\xb6\xac\x19\xae\xd9\x9a\xd9\x96\xd9\x84\x74\x59\x27\x56\x81\xc4\x8f\x3e\x18\x79\xb0\x0c\x40\x3b\xd1\xcc\x58\xfc\x37\xee\xa7\x90\xa8\x31\x7b\x47\x67\xe8\xad\x52\xd5\xa5\x4c\x49\xe9\xd4\xae\xa1\xb8\xea\x84\x2e\xe7\xa8\xcc\x58\xcc\xa1\x74\xac\x6b\x42\xbb\xf7\x2b\xe8\x06\x2f\xea\x6a\x6b\xf6\x10\xd5\xf0\x70\xdd\x2e\x1e\xfa\xbb\xac\x4a\xc7\x09\xe4\x25\x26\xfb\x9e\x8a\x54\xe5\x42\xc3\xdd\x0d\x45\xd4\xdd\xb9\x8b\xd6\xd5\x29\x25\x72\x7c\xc3\xe0\x8f\x42\x54\x70\x7e\x7f\xe9\x98\x57\xbe\x16\xc3\xf5\x87\x54\xf1\xb1\xd9\xba\x55\x5a\x4f\xeb\x76\x28\xf5\x4e\x46\x6c\x79\x4a\x54\x96\xb2\x7e\x6d\x01\xef\xf1\x56\x85\x81\x86\x9a\x86\x4d\x27\xc8\xa0\x84\xaf\x10\xf8\xf8\x91\xb3\xbc\x55\xbc\xcb\x67\x24\xc9\x0f\xce\x53\x64\x0d\xfb\xec\x46\xe9\x71\xad\x97\xbb\x85\x33\x5d\x37\x6e\xe4\x13\x52\xd8\xb5\xce\x6a\x4b\x86\xf6\x16\x76\xdc\x38\x80\x42\x55\x33\xef\x98\x45\x77\xe1\xe7\x76\x5a\x38\xa0\x9e\x32\xb9\x01\x11\x51\x4f\x09\x63\x6b\x3c\xb0\xc0\xd7\xe8\x44\x75\x27\x2e\x0c\x11\x4f\xc4\x83\x30\x4b\x21\x9e\xaf\xda\xb7\x38\x50\xaa\x5b\xc0\xef\x08\xf3\xf1\xd8\x6a\xfc\x52\x67\x45\xf2\x3e\xa5\xb2\x21\x3a\xc7\x0f\xf7\x59\x3a\x9c\xb7\xa2\x46\x01\x72\xf8\x0a\x9f\xd9\x44\x6f\x34\x82\x7f\x3c\x82\x7f\xd2\xd6\x9e\x04\x71\x2c\x50\xe5\x6c\x4d\x69\x90\x4c\xb4\xee\x9e\x42\x27\x91\xd3\xeb\xa2\x65\x6e\x32\x49\x67\xde\x1f\x85\x9c\xbc\xd2\x82\xe5\xe0\x97\xb8\x35\xb7\xaa\x88\xc7\x23\xab\xdc\x04\xc4\x17\xeb\x2d\x2e\xba\x2a\x94\x9a\x32\x74\x85\x47\xf7\x8f\xd5\x60\x13\x68\xa9\xac\x0d\x69\x46\x99\x8a\x07\xce\xa5\x28\xe9\x54\x73\x26\xd6\xa8\xe2\x0a\x9c\x6b\x0f\x52\xf0\x85\xce\x93\x2c\x89\x7c\x99\x38\xa7\xf4\xe9\x56\xfa\x92\x8c\xa4\x53\xa3\x17\x9e\x83\xc1\xf5\x5e\xdb\x9e\xee\xc0\xa8\xb1\xd7\xaa\xbb\xae\x3f\x09\xa8\xeb\x9c\x29\xbb\xab\x99\x40\x68\x4b\x28\x4a\xe6\x86\x84\x72\xfe\xec\x29\xbc\x44\x4d\x4b\x0a\xb2\x42\xbe\x96\xd6\x87\x5e\x94\x0e\xd3\x9b\x6c\x12\x1a\x57\x0a\xa5\x74\x9c\xfd\xaa\x7b\x66\x69\x4f\xf8\xfb\x4b\x77\x41\x21\x0d\x9d\x3f\x7b\xea\x3c\x3e\x10\x0d\xf9\x63\x5a\x52\x3a\x7f\xf6\x74\xea\x3c\xf8\x8b\x94\x3a\x50\xe1\xf3\x21\x25\xf7\x71\x10\x57\x9d\x50\xcd\x03\x65\xfc\xa1\xbc\x7e\xcc\xa4\x00\x0b\xf5\xba\x23\x8c\x94\xd6\x58\x55\x70\xd0\xb5\x5f\x03\xfd\x57\x1e\x52\x89\x83\x55\xa5\x55\xcb\x7e\x4b\x5c\x90\x12\xcb\xea\x82\xba\x1a\x77\x6a\x10\x5b\xdb\x9d\x02\xaa\x65\x86\x68\x4a\x45\x36\x65\x79\x2b\x66\x7b\x6c\x74\x76\x19\xad\xca\x44\xcf\x05\x6d\x9c\x8d\xe7\xbd\xd0\x46\xcd\x72\xab\x26\x08\xf6\x5e\x35\x99\x61\xed\x55\x09\xdf\x03\x22\x9a\xb1\xf5\xec\x72\x62\xf5\x7e\xd2\xc4\x0a\xbf\x6f\x09\xb9\x70\x8c\x8c\x0d\x6b\x9e\x77\x5e\xd2\xa1\x62\x66\x7d\x6a\x4a\xb9\xac\x82\x03\x6b\xef\x99\x8f\xed\xb1\x05\x30\xd1\x77\x95\xb9\x6a\x7e\xca\x2c\x08\x19\xec\x67\x1a\x45\x97\x01\xd8\xe0\x90\xba\x44\xee\xef\x1e\x56\xcc\xc5\x79\x6c\x60\x24\x8a\xc6\x45\x77\x30\x29\x41\x19\xc5\xf7\x30\x79\xc6\x51\xb4\x41\x0c\xe6\xc5\xf9\xd1\xc1\xb4\xcf\x70\x1f\x1c\x3d\x52\x1e\x09\xfb\x28\x65\x24\x98\xe4\x9d\xf7\xef\x25\x34\xbf\xef\x55\x5e\xe3\x2e\xa5\x30\x85\x06\xc3\x1d\x65\x19\x14\x70\x7d\x66\xaa\x9e\x6a\xac\xde\x9b\x53\x47\x53\xe0\x37\xdc\x03\xe1\x41\xd3\x57\xee\xbc\x1b\x05\xc0\xd0\x51\x4c\xa1\x67\x0d\x76\x14\xb6\x50\xb9\x96\x67\x67\x0e\xa8\xb3\x61\xd7\xb0\x57\x69\x8b\x2c\x58\xde\x48\x0c\x94\x70\x78\xd1\x89\x80\x41\x15\xc9\x68\xae\xce\x61\x9c\xc8\x78\xcb\x1f\xc2\xc5\xc9\x57\x26\xb0\x29\x55\xe0\xc2\x23\xcd\xeb\xab\x8a\x29\x1c\xf7\x55\xaf\xde\xa8\x1e\x68\x66\x2e\xaa\x77\x29\x6d\xb7\x8f\xea\x19\x05\xfd\xd3\x63\x7c\x04\xf8\xdb\x44\xfc\x10\x2a\x83\xf0\x3f\x37\xf3\xdb\x21\x83\x3e\x92\x25\xf2\x62\x71\x11\x22\x15\xf2\xbd\xfd\x54\x62\xdb\x3f\xbd\xab\x2a\x76\x03\xfe\x9c\x0a\x87\xdd\x65\xed\x1d\xce\xe3\x95\x40\xd8\xdb\x74\x76\xc5\xe7\x58\xf9\x20\xdf\xfe\xd9\x5f\x17\x73\x40\x36\x2a\x53\x9f\x25\xa9\x78\x7f\x15\xb5\x26\x1c\xfd\x0c\x36\x98\xff\x2d\xee\xa8\x91\xe1\x49\xdb\x6f\x54\xe5\x4f\xad\x5d\x0b\x85\x45\x87\x47\x84\x47\x07\xd6\xb7\x9a\xb8\x4b\x51\x27\xed\x2a\x45\x1e\x42\x85\x39\x79\x64\x2f\x7b\xf4\xf7\xf1\x09\x6d\x1e\xab\xa8\xd4\x44\x2e\xc9\x64\xb7\xa3\xfc\x00\xfe\x71\x77\xa3\x3e\x53\x35\xba\x07\xe8\x3e\x17\x95\x5a\xa5\xd1\xef\x3a\x84\xad\xb3\x19\xd1\x9f\xe2\x64\x2e\x77\x23\x41\x54\xe1\x21\x5f\xe1\xf1\xb0\x77\x0d\xc0\x01\xee\x1b\x80\x9b\xbb\x73\x60\xc7\x58\xcf\x83\xcd\x4f\xdc\x47\xe0\xa0\x42\x5a\xfb\x82\xbd\x34\xb0\x1e\x84\x92\x3c\xac\x17\x5c\xcc\x9e\xd8\x67\xf6\x29\x3a\xa1\xd7\x9f\xe1\x38\x9b\x00\x54\x17\xe6\xc2\xae\x12\x19\xfb\x39\xb0\x9c\xfa\xb0\x5b\x40\x57\x88\x54\xe2\x4a\x33\x51\xae\x4b\xc3\x20\x66\xff\xbd\xb8\xbd\xee\xfa\x49\x43\x97\x09\x50\xb8\x4c\x3e\xa8\x31\xfd\x65\xb0\xe4\xfc\xfa\xf6\xe0\xc4\xee\x37\xc5\x8b\x92\x4a\x50\xa7\x1e\x91\xb6\xa3\x04\x9b\x48\x99\x4f\x42\x05\xea\xe3\x7d\x3d\x90\x71\x94\x07\x58\x82\x22\xbd\xe0\x99\x50\xfb\x10\x4b\x4a\xd2\xa2\x1f\xa7\xa6\x97\x9e\xaf\x8d\x98\xec\x60\xef\xba\xe5\xb0\xb3\x8e\x94\x9c\x5b\x30\x6e\x56\x2a\xdd\x08\x80\xe7\xa4\xe6\x0a\x63\x2e\xf2\xa7\xc3\x02\xda\x33\xfb\xc8\x8b\x01\xb5\x11\xb6\x07\x8d\x02\xcd\x67\x0b\x75\xf3\x96\xfe\x37\x20\xb8\xad\x38\xac\x48\x29\x48\x72\xf7\x35\x04\x64\xae\xde\x8d\xef\xad\xd7\x75\x61\x0e\x15\xac\x1d\xc0\xc2\x09\x24\x36\x7b\xe0\xa4\xe1\xa8\xc3\xf5\x44\x06\x9b\x30\x57\x75\x88\x8f\x2e\xcf\x28\xd3\x1d\xbc\x0c\x06\x25\x21\x08\x4b\x6c\x5f\x88\x2c\x90\x10\x60\xee\xb7\x68\x5b\xae\x17\x6e\xea\x25\x16\x24\x74\x59\x13\xbd\x87\x3b\x4a\x06\x65\x23\x31\x53\xc1\x97\xd7\xa8\xed\xe0\x4d\xb8\x5f\x75\x07\xbf\x49\xaf\xfa\x0e\x37\x16\x9b\x19\xa4\x6b\x84\x2b\xb1\x01\x9b\x71\xd2\x42\xc0\x38\xa4\x85\xc7\xc2\xe0\x49\xa5\x9e\x91\xf0\x22\x95\x90\x45\xa8\x1b\xfd\x74\x85\x3b\x47\xc5\xfc\x2d\x38\x94\xd1\x56\x63\xc6\xca\x79\xa1\xca\x24\x8c\x77\x04\x75\x85\xd6\xe3\x5e\xe9\xe7\x4a\x73\xbb\x97\x6d\x89\xd8\x61\x26\xdb\x4f\xe8\x0c\x0d\x52\xcc\xee\x99\x65\x35\xfb\x6d\xdc\xd0\x0c\xd0\x1c\x8c\x6c\x91\x1b\x29\x37\x76\x67\xc8\x75\xec\xea\x4d\xa7\xef\x1d\x6e\x11\x7b\xa6\xf9\xa9\xed\xf7\xe6\xad\x71\xc5\xc1\x8d\xbe\x39\x38\xc3\xb0\xc9\xc8\x11\x9b\x7d\xe2\x3d\x7e\x63\x6c\x97\x02\x55\x15\xb3\x25\x98\x4c\x8e\xcf\x66\x81\xf5\xcd\x39\x5c\xf2\x99\x48\x19\x9d\xb3\x62\x43\x65\xc1\x5e\x28\x64\x2e\x98\x86\x38\xc4\x26\x6e\x28\x7d\x69\xda\x3f\xef\x7a\xc1\x54\xc9\xba\xdf\x6b\x52\xfd\xae\xab\x42\x25\xeb\xd7\x75\x8e\xe1\x54\x34\x0c\x07\x32\xc3\xb8\x71\xda\xb6\x1f\xbb\x1c\xff\x00\x5c\xcc\x4a\xce\xd3\x4b\xb6\x6a\xa9\x52\xf7\xb6\xea\x0a\x2c\x53\x57\x31\x7c\x59\x95\x92\xf2\x08\x5f\xde\xea\xa0\x6f\xbe\x15\xc1\xba\x36\x5f\xc2\x1c\x50\xa4\xf5\xca\x5e\xca\x9d\xbc\x33\xe3\x79\x98\xb5\x11\xb1\x83\x1a\x6f\x82\xc4\x9d\x2f\xc4\x84\xcc\x2c\xf0\xba\x9e\x3c\x9b\xbe\xaf\x75\x38\x3f\x96\xda\xf2\xe1\x89\x36\xb7\x48\x1b\x65\x2c\x6b\x4d\x6a\x90\xef\x8e\x3f\x99\x66\xf7\xcd\xec\x53\x4f\xaf\x9c\xbc\xe6\xca\xae\xa4\x2b\xb1\x2d\xc9\x7d\xdd\xf9\x27\xeb\xac\xea\xbf\xe4\xbe\x25\x4c\xdd\xfd\x16\xd4\xc7\x30\x92\xb7\xe6\xdb\x0b\x9e\x25\xf5\x3b\x45\x1a\x3a\xca\x06\xe7\xea\x05\xf7\x22\x2b\x31\xf0\x73\xbc\x51\xea\x74\x0f\xe1\xfa\xe0\x96\x5c\x34\x5e\x2d\x85\xb4\x8f\x71\x3f\x42\xf3\x97\xbc\x77\x2d\x85\x24\x9d\x69\xe6\x8d\x98\xcf\xbb\x75\x39\x03\x6d\x31\x19\x19\x85\x72\xf7\xe1\x76\x54\x9e\x55\x2f\xa6\x28\x44\x91\xba\xa3\x6d\xf5\xa3\x77\xc8\xf5\x99\xb8\xf9\xf2\x9a\x05\x56\xd3\xe6\xad\xad\xda\xa6\x41\x64\x91\x52\xfb\x4b\x76\xd8\x7e\x03\x0b\xd0\x07\x2e\xfe\x6e\xce\xd0\x79\xcf\x0e\xaa\xb7\xaa\xc0\x63\x98\xe3\x3f\xf1\x6b\x8e\x2f\xf0\x32\xe5\xb6\xcf\x67\xc5\x87\x97\xb9\x75\x55\x73\x3f\xcc\xe5\x6e\xa9\x99\x38\xd7\x81\x7d\xdf\xef\x36\x30\xd8\xf3\xce\xa8\x5c\x5f\x6e\xf1\xe8\xd0\x8b\xd6\xe9\x91\xeb\x7d\xa7\x50\x7a\xe5\x89\x7a\xbb\xe0\x8b\xa2\xb2\x57\x92\x78\x76\x83\x5d\xba\x72\x17\x19\x92\x57\x7a\x7a\x5d\x44\xb3\xeb\x9a\xb4\xc3\xab\x09\x36\xf4\x67\x7f\x6d\x21\x10\x47\xf7\x8f\x87\x90\x7c\x97\xea\x70\x33\x74\xfa\x79\xd0\xe8\x70\xfa\x3c\x14\x6d\x76\xe9\x52\xb7\x45\xa7\xe9\xea\xe2\x9a\x3e\xd9\xee\x1a\x61\xc9\x5d\x55\xed\xae\xa5\x2e\xca\x3e\x42\x9c\xde\xeb\x46\xa6\x58\x57\x5c\xd7\xa6\x48\xdf\xed\x2b\x8c\xaa\x8e\x6b\xf9\x99\x6b\x8d\x87\x56\x1a\xfd\x65\x1f\x4a\x73\xdc\xef\xaa\x81\x4f\xa4\x95\x05\x3b\xbf\xf3\x08\x6d\xa7\x0a\x16\xad\xa1\x93\xd6\x86\x4b\xc4\x1d\x13\x8e\xe6\x7b\x33\x22\x6d\xc0\x2c\xb2\x10\xbb\x09\xb6\x34\x64\x5e\xfd\x79\x53\xa3\xea\x69\x7d\x12\xde\xa4\x8b\x79\x7d\x65\xbc\xc9\x5f\xf6\x57\xc0\x9b\x64\x55\x6c\x54\x96\xe0\xdc\xd9\xa8\xb4\xb6\xe4\xc5\xb2\xfa\x40\x53\x62\x27\x13\x4e\x52\x59\x85\x63\x49\xea\xb6\xfb\x1a\x2d\xe5\x36\x45\x0d\xae\xbf\x69\x81\x2c\x97\xb8\x24\xa8\xc5\xa2\x42\xc2\xbc\x92\x55\xb0\x14\xea\xf6\xb8\xe9\x0f\xae\x77\x09\x64\xaa\xbb\x98\xf1\x05\x74\xb0\x93\xf1\xed\xb8\xac\x4d\x3b\xe3\xd5\xf2\xd2\xa5\x4c\x83\x62\x50\x12\xc4\xe2\xcc\x79\x27\x90\xd2\x01\xe5\x27\x27\xc0\xa8\xf5\x69\xf3\xbe\xa4\xff\xad\xf4\x4b\x9b\xbb\x9f\xdb\x90\x2a\x43\x81\x7b\x48\xa9\x72\x40\xcc\xb1\x02\x6a\xc0\x82\x6e\x55\x40\x0d\x99\x57\x1f\x01\xa5\xaf\x8c\xb2\xf7\xbf\xcd\x1c\x5d\xfa\xb6\x04\x95\x9a\x85\x94\xbe\xa4\xbe\xdc\x57\x4a\xc1\x97\x24\xa6\xc2\x45\x7f\x05\x32\xca\x32\x8e\x68\xc7\x3f\x5f\xcd\x79\xe7\x54\xa3\x99\x1e\x5c\x67\x1e\x34\x85\xdb\xe2\x47\xc3\x26\xd5\x87\x19\x29\xbe\xe3\xde\xcf\x1d\x72\x20\x75\xd4\x25\xc5\x6a\x5f\xd6\x63\xca\x37\xd9\x20\x5e\x13\xe0\xa5\x2f\xfd\x88\x6e\x4f\x4e\x9f\xba\xca\x19\x3e\xac\xaa\xf0\x62\x8f\x2f\xe4\x50\xd5\xcc\x7f\xcf\x38\xdc\x03\x1e\x84\x5a\x50\x7e\x1a\xfc\xcd\x4f\xa0\x0f\xae\x9a\xe0\xf0\x4f\xe5\x78\xd2\x13\x38\x8c\xef\xe9\x8b\x92\x9c\xe9\xa5\x7f\x05\xf2\xd3\x0f\xfd\xb4\x22\x2a\x83\x0b\xbb\x04\x95\xdd\xd4\xc4\xfd\xf0\x36\x9c\x33\x6c\xf2\xc9\x24\xf1\x9e\xcb\x3c\xa8\x3c\xf6\xb7\x40\x70\xb2\x3d\xa7\x35\x10\xf2\xb7\xc5\x16\xf7\x58\xcd\x30\x86\xf9\xa9\xbc\x61\x7a\x02\x5f\x9f\x43\x2c\xbd\xf2\xaf\x80\x5f\x4a\x9f\x98\xf4\x90\xe4\x1d\x61\xf2\xa2\x38\x95\x1f\xac\x61\xc5\x31\x51\x7b\xc8\x72\x7d\x67\x3d\x67\xa8\x96\xe9\x24\x9f\xd8\x31\xb6\x97\x0c\xd9\xe1\x61\xf8\x42\x85\x48\x4f\xc9\xb2\xcf\xe2\x4f\x4e\x40\x94\xa2\xf7\xf0\x4b\xa3\x46\x2e\xa7\x35\x74\xcc\xee\x0a\x8c\xd0\x2f\xbe\x52\xfd\xea\xf3\xe3\x0c\x50\xb2\x39\x5d\xd9\xdd\xcb\x1d\x44\x87\xc7\xd6\xc1\x44\x3e\x5f\xdf\xde\x10\xc9\x3d\x74\xdb\x3f\x37\xd1\xbd\x4b\x96\x0f\x5d\xdf\x8d\xa2\xf4\x4d\xa2\xf3\xe1\x50\x39\x17\x84\xff\xa8\xa2\x0c\x37\xc1\xbd\x0e\xe2\x47\xe6\x69\x31\x4c\x6b\x31\xa5\xd1\x62\x26\xa0\xef\xd3\xa8\x2a\x23\x67\x84\x37\x42\xa1\xe5\x0c\x15\xef\x0d\x18\xf9\x28\xde\x9d\x68\xe6\x3a\x0e\x13\xc7\xe1\xcd\x81\xc6\x21\xe9\xd1\xbd\x34\x4e\x06\x5a\x2f\x95\xa9\xe0\xeb\x12\x58\xd7\x4b\x4d\xfa\xc2\xd4\x22\xbb\xba\x4f\xa8\x0a\xf9\xef\x1f\xfc\x9a\xa8\x68\xbc\xae\x32\x47\xde\x3c\xc2\x6b\x8e\x53\x0f\xed\x45\xc7\xe9\xe7\xc6\x37\x08\x67\x67\xf0\xdd\xf4\xbb\x43\x55\x49\xb2\x3b\x67\xd2\x38\xc5\x4f\x36\xc8\x98\x1b\x0d\xeb\xba\x25\x95\xeb\x4c\x5c\xa0\x2b\x0c\x33\x8c\xad\x2d\x50\x4f\x38\xdd\x88\x3b\x91\x64\x5e\x96\x47\x96\xac\x45\x6d\x78\x93\x19\xa4\x0b\x6a\xba\xd9\x43\x11\x00\x70\x09\xdf\xf3\xe5\x67\xb4\x1d\x81\xbd\x3b\xdc\x39\xbb\x76\xda\x5a\x28\x5d\xd3\xe8\x15\x2d\x96\x59\x8a\x96\x21\xfd\x97\x92\x31\xb4\xae\xb7\x14\xdd\x69\xdf\xa5\xdc\x9a\x5f\xc6\x0e\xd2\xef\xb6\xae\x54\x3f\x25\x66\x6d\x43\xb7\xb6\xaf\x9c\xda\x1c\x97\x64\x79\x64\xcb\x66\x25\x2b\xb3\xe4\xa6\xdd\x66\x2b\x9f\xd8\x1e\x87\x16\x40\x49\x82\xc4\x1d\x28\xbc\xd5\x29\xeb\xf9\xe9\x09\x8e\x83\xa4\x88\xbb\x4c\x45\x2c\x10\x14\xf0\x9c\x8a\x64\x2a\x5c\x56\xdb\x77\x73\xba\xae\xcb\x74\x32\x77\xf4\xd3\x80\x8b\xd8\x06\x50\x4a\xe7\xed\xb4\x03\x95\xd0\xeb\x16\x37\x02\xa5\x89\xbc\xee\x28\xfc\x6b\xcb\x80\xb6\x54\xe5\x02\x3a\xf7\x73\xd5\x14\x2a\x5a\x73\x4c\x94\xb7\x87\xe5\x08\xfa\x10\xe5\x3f\xe0\x66\x4b\x80\xc0\x7e\x15\x7a\x5c\xc1\x16\x96\xfd\x90\x35\x8d\x1a\x3c\x97\x7a\x5d\x00\xde\x6c\xf9\x9d\x7e\x55\x3b\xee\x74\x94\xed\xe0\x9f\x2b\xd4\xd8\xe3\x33\x15\x03\xad\xdd\x61\x20\x84\x7b\xb2\x19\x99\x43\x18\xc9\xad\x2a\xe6\xfd\x8c\x2f\xd1\x8f\xdb\x16\xb3\x97\xb8\x91\xe1\xdc\xb8\x14\xa5\x75\xee\x9c\x81\xb8\x74\xb5\x23\x97\x22\x9c\x88\xc2\x5a\x77\x94\x12\xcf\xf9\xb2\x04\x21\x3f\x0c\x5e\x1f\xed\xb9\xd1\x61\xa5\xed\x4c\x92\x44\x17\x4f\xca\xcc\xde\x14\x63\x99\x75\x44\x97\x7f\x4c\xfe\xda\x5d\x53\xa5\xa3\xca\x4b\x30\x8b\x71\x97\x6f\xa7\xfb\x0a\xee\x78\xa2\x83\xd4\x4e\xc3\x7c\x42\x95\x33\x7c\xe0\xaa\x9b\xe1\xb3\x4f\xa0\x6a\xea\xc0\xf3\x5b\xd5\x32\xd3\xeb\xbe\x55\x0d\x33\x37\x85\xbe\x2a\x59\xd8\xfe\x13\x68\x96\xb9\x29\x0c\xd1\x2a\x07\x3b\xb3\xc0\xf7\xf8\xf4\xb8\x51\x3f\xd5\x83\x56\x8a\x64\x2f\x5f\xb6\x82\x68\x21\x91\xd0\x0e\x3b\xca\xdf\xf5\x83\xc1\x17\xa3\x15\xca\x42\x77\x03\x14\x42\x59\xaa\xd5\x22\xb1\xa8\xd5\xba\x43\x19\xf4\x27\x92\x98\xd2\x89\x4c\x0b\x6b\x23\xef\x96\x7b\x8a\x27\x0a\x2e\x56\x95\xca\x8a\x2b\xd5\xe9\x5d\x55\x99\x78\x58\x79\x52\xc7\x7a\x1f\xd5\x99\xdb\x03\x2e\x71\x2b\x4b\x3f\xb1\xd1\xf8\x14\x7e\x95\x7e\xa6\x50\x71\x33\x75\x38\xcf\x99\xf3\xce\x59\x44\x48\xd3\xf7\x78\xcb\x92\xbe\x99\x6b\x50\x04\x6f\xfe\x9b\x9f\x22\xbb\x83\x1a\x3a\x9c\xd0\xb2\x91\x2e\x76\xe5\xf5\x3b\x35\xa0\x48\xa0\xbf\x5a\xc6\x6f\xa9\x4e\xe2\xdf\xd2\x24\xa0\x20\x38\x45\xab\x15\xae\xcb\x51\xdc\xd7\x9e\xe5\x15\x65\xb7\x7d\x0e\x83\x35\x1a\x39\x89\xd9\x0e\x2e\x45\x07\xbf\xfb\xa1\x93\x5f\xa4\xec\x14\x7e\x75\x7f\x18\x8c\x59\xd6\x92\x97\xc8\x15\x36\x76\xe4\x0c\x8b\xc6\x3a\x83\x5f\xff\xe5\xc3\x4c\x07\xa0\x5d\x9c\xf3\xb5\xa9\x91\xf3\xfe\x41\xb1\xbf\x1d\x5e\x85\x07\x53\x92\xb8\xbf\xdc\x9d\x93\xde\x6d\x0f\x2a\xbb\x24\xe7\xc5\xf9\x9d\x30\xa9\xf4\x0b\xa0\x2a\xb3\xc4\x2c\x79\x05\x6f\x24\xe8\x2c\xd7\x65\x77\x77\xa2\xab\x3e\x34\x1b\x4f\xb1\xe3\x61\x9a\x8a\xbb\x7a\xcd\xf6\x98\xed\x6d\x10\xba\x74\xac\x24\xc0\xa1\xcc\xec\xb2\xf9\xe5\xdd\xbf\xa4\xf9\x8e\x3b\xed\x21\xcc\xe7\xb9\xa8\x17\x54\xcf\xa9\xe1\x32\x87\x14\x66\x0f\xab\xca\xb8\x4d\x38\xf3\xe9\x70\xab\x64\x79\x51\x3d\xa7\xbb\x5a\xc6\x9c\x65\x98\x8c\x74\x5b\xf6\xe5\x49\x7a\x6e\x1a\x47\x06\x78\x8c\xbe\x3c\x66\xf2\x09\x45\xf4\x40\x10\x27\xe4\xf8\x35\x04\x79\x47\x9d\xfd\x14\x31\xd9\x9a\x92\x49\x8a\x3a\x90\x48\x7f\x58\x55\xfe\x49\x58\x86\xb2\xbc\x97\x52\xe4\xe5\x1d\x2d\xf4\xea\x63\x0f\x42\x0b\x55\x86\x5e\xc4\xb6\xe3\x84\x70\x2f\x3d\x80\x77\x41\x0e\x7c\xb6\x78\x27\x21\xe8\xfc\x03\x1b\x85\xba\x7c\xe0\x2f\x8f\xec\xff\xd2\x21\xf6\xd3\x21\xf6\x41\xb5\xeb\xab\x13\x69\xd7\x25\xf4\x47\x49\xfd\xb9\xae\x06\xd2\xc1\x35\x9f\xe2\x96\xa9\xfb\x49\x18\x54\x84\xb5\x40\xe7\xf1\xad\x69\xf5\x9c\x36\x4b\xb4\x8b\x0f\xfa\x15\xac\x85\x75\xf3\x41\x32\xa0\xd3\xc4\x5d\x30\x1f\x83\xfd\x72\x0b\xf2\x07\x9e\xdd\x70\xf2\x1f\x41\xfe\x6f\x7c\x03\xa6\x52\x97\xe2\xed\xaa\x5d\xcc\xc6\xbd\xa5\x1c\x41\x46\x74\x30\x2c\x7c\x55\x51\xd5\x49\xaa\x8f\x80\x38\x73\x44\xb5\x1d\x47\x7b\x79\x83\x7a\xa9\x2e\x8c\x4c\xa9\xd4\x20\xc8\xc4\xf8\x47\x54\x5d\xba\x09\xf4\x0a\x6a\x39\x85\x1f\x29\x75\x4b\x98\x56\xf2\xd2\x09\xb1\x5f\x67\x42\x2a\xa9\xd2\xfd\xf1\xc1\x9b\x76\x72\x79\x90\x91\x87\x4d\x28\x75\x39\x91\xbe\xac\x2b\x82\xe6\xf7\xa2\x0c\x6c\xec\x9f\x7a\x29\x6e\x6d\x8f\xaf\xe9\x32\x47\x4f\x3a\x34\xc6\x36\xf9\x99\x30\x46\xea\x4b\x19\x0e\xa3\xa7\xec\xcc\x59\x63\x47\x62\xda\x7b\x87\xed\x1c\x39\x28\x95\x42\x83\x6c\x6c\xae\xca\x63\x57\x31\xe2\x6e\x48\x59\x72\xc7\x2f\x71\xfb\x36\x8a\x09\x8c\x36\x7e\x6c\x0e\xae\xfe\xda\x54\x9c\x8a\xa2\xec\xb3\x5d\x07\xd9\xac\x9f\xfe\xda\xaa\x41\x5b\x35\x74\xa3\xfa\xba\x6a\xb5\x58\x8a\xd6\x37\xe1\xdc\x99\xd6\x58\x71\xe3\x69\xb4\x9b\x81\xeb\x36\xb1\x7f\x09\x57\xee\x57\xbc\x83\xae\x9b\xbb\xcf\xee\x0d\xf1\x90\x5e\x7b\x17\x3d\x67\x4f\x6a\x2b\x73\x1e\xd4\xaf\x7b\x43\x13\xb7\x61\xec\xd8\xd5\x41\xae\xa7\x6b\x6f\xab\xeb\x8a\x4a\xed\x6a\x4f\xd7\xd4\xd7\xbd\xc9\x9e\x3f\xaf\xcf\x1e\xef\xe5\x11\x39\xc4\x5e\xfb\x46\x56\xcf\x0d\xcf\x7a\x4c\xbe\xfa\x5d\x0f\x1c\x4e\xe1\xd6\x3f\xad\xe8\x0c\x55\xb6\xc8\xbe\x83\x01\xc9\x6b\xb1\x39\x2e\x68\x1c\xd0\xf5\x45\x99\xba\x0a\xaf\x84\xd9\x56\xa8\x4e\x72\x9e\xdf\xf4\xb1\x76\x32\x16\x61\x62\xdb\x7b\x5a\x88\x5f\xf7\x86\x47\x96\x75\xb8\xdf\xe7\xb8\xc5\xcd\x92\xd4\x98\x09\xc3\xb5\x76\x2f\x3c\x67\xb8\x85\xf5\x4a\x5d\x42\x9c\xbb\xb6\x29\x6b\xaa\xaa\x31\xff\x8e\xae\x70\x1c\x02\x90\xd8\xcf\xc1\x66\x69\x00\x82\xd4\x16\x79\x50\xe6\xf0\x38\xc0\x66\x4d\x8b\x05\x2e\xde\x27\x28\x47\x3a\x44\x58\x8b\xea\x12\x35\x25\x60\xbe\x65\x3a\x44\x4b\xd0\xc8\x66\x81\x39\x4b\x64\xe4\xb2\xc6\x8d\xbd\xa7\x58\xde\x8d\x8b\x72\xd4\x71\x72\x72\x62\xc6\xf8\x61\x85\x1a\xb4\xd4\x4b\x95\xd7\x28\x9b\xa4\x1a\x75\x0b\x03\x99\x43\x8b\x2a\x59\xa7\x7c\x46\xd7\xad\xbb\xa9\xf2\xea\x07\xa6\xef\xcb\x50\x53\x99\x9a\xfe\xc3\xcf\xc5\x5c\xde\xf4\xd2\xc8\xda\x60\x4e\x9b\x09\x54\x18\x5d\xa9\x7b\x65\x51\x73\xb9\x5e\x62\x3e\x07\x26\xa3\x60\xa7\xf1\xcc\xd5\x2e\x69\xa2\xe5\x33\x9f\xaf\xab\xca\x07\x53\xa7\x75\x95\x21\x8a\xdf\x2c\x38\x14\x32\x3d\x18\x6b\x1f\x88\x83\x4f\xca\x37\xa8\xa1\x64\x48\x3a\xed\x4c\x4a\x07\xd4\xf8\x00\x7f\x67\x50\x31\xde\xcb\x98\x58\xcc\x6d\xc8\x12\xf2\x2b\xd4\x2e\xe0\xdd\xa8\x67\xdc\x8e\x7b\x7f\xf9\x94\x4f\x62\x0b\xcb\x35\xe3\xec\x97\xb4\x04\x55\xe4\x3f\xfa\x6e\x92\xe0\xd2\x5e\xc2\x4c\xee\x14\x69\x58\x7b\xc7\x86\xe5\x25\x83\x8a\x7a\x2c\x3e\x87\xbc\x37\xbd\x60\x3d\xf0\x75\x97\x1d\x52\xad\xbc\xc7\x9a\x13\xee\xac\x11\x11\x4f\x78\x03\x78\xb9\x6a\xb7\x09\x72\x84\x06\x33\xba\x6e\x0a\xac\x12\xd8\x38\xb0\x18\x10\x93\x2b\x58\xa0\xaa\x52\xf1\x5b\xa1\x48\x93\x77\x95\xc6\x7c\xd0\x4c\xcc\x8b\x7b\x3d\xb5\x37\x1b\x6f\x07\x5e\xe1\x6d\x03\x45\x1d\xdf\x72\xdf\xfe\x26\xe0\x7a\x30\xa6\xe2\x3f\x7b\x3d\xcf\x18\xee\xc5\x8f\xe5\x00\xdf\xcb\x0b\xa7\xc6\xa7\xf0\x43\xec\x39\xfd\x10\x72\xea\xfb\xc7\xfa\xc6\xe9\x18\x20\x01\x1c\xbc\xaf\x13\x7f\x4d\xce\x17\x8f\x19\x73\xec\x18\xb9\x11\x8b\x69\xfe\xee\xa0\x18\x9c\x81\xb9\xf9\x3c\x92\xa4\x3b\xba\x79\xd9\x90\x2b\xd4\x9a\x6e\x56\xf2\xeb\xf0\x6e\x8c\xb4\x11\xbd\x88\x6f\x99\x4e\x3e\x1e\x7d\x3c\x82\xff\x0d\x00\x00\xff\xff\x0e\x8a\x26\xfc\x95\xf5\x00\x00"
-
-func flowstakingcollectionCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_flowstakingcollectionCdc,
-		"FlowStakingCollection.cdc",
-	)
-}
-
-func flowstakingcollectionCdc() (*asset, error) {
-	bytes, err := flowstakingcollectionCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "FlowStakingCollection.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9e, 0x1c, 0xc, 0xb1, 0x20, 0xef, 0x79, 0x18, 0x45, 0xd3, 0x11, 0x97, 0x8b, 0x4e, 0x3c, 0x1f, 0x93, 0x99, 0xe8, 0x17, 0x26, 0x15, 0x45, 0x22, 0x71, 0xce, 0x7, 0xcc, 0xc, 0xe6, 0xa5, 0x37}}
-	return a, nil
-}
-
-var _flowstoragefeesCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\xeb\x6b\x1b\xcb\x15\xff\xae\xbf\xe2\x60\x68\xaf\x94\x3a\x2b\x1b\x2e\xa1\x18\xbb\xe0\x7b\x5b\x97\x40\x4d\x4b\xe2\xdb\x7e\x08\x69\x3c\xda\x3d\xab\x1d\xb2\x3b\x23\x66\x66\x25\x8b\x90\xff\xbd\x9c\x79\xed\x5b\x0f\x17\xc2\xd5\x97\xac\x77\x66\x7e\xe7\xfd\x9a\xcd\xf2\xcd\x0c\xde\xc0\x53\x81\xf0\x50\xca\xdd\x47\x23\x15\x5b\xe3\x03\xa2\x06\x5d\x31\x65\x20\x95\xc2\x28\x96\x9a\x19\xd8\x8d\xf7\x02\x58\x9a\xca\x5a\x98\x9f\x34\x68\xb7\x1b\x52\xb6\x61\x29\x37\x7b\xc8\xd0\xa0\xaa\xb8\x40\x0d\xf5\x06\x8c\x84\x42\xee\xa0\xaa\xd3\x22\x6e\x95\x02\xd2\x82\x71\x01\xdc\x40\xca\x04\xd4\x1a\x13\xb0\xc0\x43\x34\xae\x21\x65\x65\x5a\x97\xcc\x60\x06\xab\x3d\x54\x75\x69\xf8\xa6\xdc\x73\xb1\x06\x53\x20\xb0\x8a\x18\x01\x99\x83\x42\x8d\x6a\x8b\x19\xe4\xa5\xdc\xc1\x8e\x9b\x02\x9e\x1b\x59\x12\x0f\xfd\x88\x6b\xf6\xcb\xde\xa0\xfe\x17\xaa\x0f\xfe\xc4\xc3\x3f\xfe\xf9\x9f\xe7\xa0\x82\x8a\x0b\x5e\xd5\x55\x0b\xd8\xe2\x19\xf9\x15\x85\x6e\x11\x91\x6a\x94\xdb\xe7\x9e\x0a\x13\x8f\xe7\x5f\x39\x92\xcc\x70\x29\x9e\xc1\x14\x5c\xd3\x99\x0d\xe3\x19\x64\xb5\x22\x99\xbc\x66\x21\x55\x68\x77\x5d\x92\xd0\x24\xa8\x7d\x21\x55\x42\x7c\x5a\x65\x19\xfb\x1a\x45\x46\x3c\xb2\xb2\x04\xa3\x98\xd0\x2c\xa5\x53\xfa\x12\x98\xd8\x47\x30\x53\x30\x03\x05\xcb\xec\xcb\x2d\x2b\x6b\x24\x0b\x88\x35\x66\xc0\x05\xc1\xf0\x46\x18\xc2\x2e\x98\xee\xbd\x8d\x22\xa6\x05\xa6\x5f\x31\x03\xb6\x66\x5c\x68\xd3\xdb\x56\x6b\x5a\x12\x99\x7f\x5d\x91\x95\x1b\xf5\xc1\x96\xd5\xa5\x69\x1f\x8d\xda\x56\x8d\x5e\xac\x84\xef\xf3\x8e\x00\x39\xe3\xa5\x76\xfa\xb2\x0c\xd8\xb3\x2d\x79\x61\xc7\x4b\xbb\xa9\x51\x4f\x74\x52\xa8\xe4\x96\x8b\xf5\x32\xc3\x12\x0d\xa9\x98\x1b\x6f\xa6\x27\x62\x2a\xf9\x37\x31\xf5\x4c\x1c\xc8\x5a\xa5\x48\x50\x25\xfd\x45\xac\x12\x92\xd3\x50\x87\x1a\x51\x22\xa4\x15\xa6\xac\xd6\xe8\x1c\xd1\x53\xb3\xc7\x0b\xb6\x45\x10\x72\xa0\xbe\xc0\xde\x72\x36\xe3\xd5\x46\x2a\x03\x17\x0f\xb5\x58\xf3\x55\x89\x96\x99\x8b\xe6\x75\xe0\xef\x62\x36\x63\x69\x8a\x5a\xcf\x59\x59\x2e\x62\x2c\x0e\x42\xf5\xdb\x6c\x06\x00\xb0\x5c\xc2\xdf\x2a\x6e\x28\x5a\x76\x05\x8a\x5e\x8c\x0c\xcc\xc9\x1a\x35\x91\xd1\x37\xa8\x1a\x17\x7f\x68\x0c\xe7\xbc\x45\x5b\x0a\x6d\x6e\x70\x8b\xc2\xc0\xc7\xc3\xa1\xf5\xab\x73\xb5\xf9\x17\x38\x12\x83\x37\xf0\xdb\x03\x7f\x79\xf7\xf3\x62\x5a\x94\x61\x74\x3e\xb4\xa2\xd3\xfa\x79\x4b\x24\x81\x98\x69\x9b\x83\xc8\x1e\x87\x63\xd7\x8b\x98\x4c\xc8\xf8\x38\x15\xc6\x8d\x74\x93\x91\x3e\x94\xeb\xaf\x98\xdb\x0c\x39\xc8\x8d\x91\x1d\xdc\xa2\xda\x9f\x62\x9b\x24\x60\x66\x84\xc9\xad\x7f\x72\x0d\x3b\x45\x9a\x13\xf6\x54\x2d\x78\x93\xc8\x28\xf4\x90\xd9\xa4\x41\x0a\xe5\x62\x8b\x4a\xe3\x25\x04\x18\x2d\xe9\xbd\x42\x02\x11\x12\x4a\xa9\x35\xed\xdd\x28\x4c\xb9\x26\xf0\x90\x8f\x29\x00\x02\xdb\xb9\x92\x95\x85\x6f\x70\x56\xb5\x69\x80\x86\x28\xd6\xa2\x6d\x28\xcb\x1c\x71\xeb\x31\x87\x86\xd8\x32\x75\xaa\x07\x0d\x14\x7d\xa2\xef\x74\xb5\x7e\x86\xfb\x44\x2b\xd8\xc4\xd5\xb1\x5b\x89\xda\xd2\x17\x2e\x7f\x79\xea\x11\xcd\xa7\xf7\x90\xc7\xc5\xbe\x93\x69\xb8\x81\x0d\x53\x86\xa7\x7c\x63\x2b\x20\x17\x97\x63\xb9\x2f\x26\xbf\x11\x95\x1d\x75\xcb\xa8\x2c\xca\x99\x19\x6d\xd7\x46\x51\xad\x69\x72\xa2\x55\x0e\xd5\x6a\x17\x25\x96\x85\x0d\x53\xac\xa2\x62\xaf\x83\x2f\x1d\xee\x1e\x86\xdc\x45\xf8\xfb\x0e\x55\x9f\xc9\x3c\x53\x2e\xbe\xf4\x2b\x12\x99\xff\x5b\xff\xd4\x28\x3b\x1c\x23\x5f\x49\x22\x95\x36\x4f\x79\x2d\x40\xa3\x39\x92\xd2\xce\xc8\x65\xf0\x2d\xd2\xa1\x1f\xcf\xfb\x6a\x3a\xd6\x99\xc0\xdd\xdd\x31\x62\x3d\x1a\x00\x0a\x4d\xad\x44\xe7\xe5\xf7\xce\x5f\x67\xf3\x70\x8c\x85\x0e\x3a\x56\xfc\xe4\xaa\x70\x04\x77\x31\x6b\x04\x98\x72\x8b\x91\xc0\x26\x9e\x7b\x2e\xd1\x8f\xe3\x83\xf6\x9f\x4c\xf7\x27\xe5\xf9\xe3\x36\x9f\xc4\x20\x6b\x4f\x2f\xfe\xdf\x76\x3e\x40\x77\x9a\xec\xd0\xb6\x47\xab\xe1\x24\xd6\xa8\x3d\xbd\x01\x42\xa6\x58\x00\x15\xb2\xf9\xe2\x9b\x93\xe5\x7b\x48\x50\xcb\x66\x16\xb8\x77\x86\xfd\x35\x84\xbf\x53\x84\x73\x87\x41\x6e\x90\xed\x9c\x1c\xb0\x22\xe6\x07\x7f\xb4\xc2\x35\x5b\x91\x07\xc6\x95\xf7\x79\xa7\xb3\x23\x27\x12\x92\xea\xac\x6d\x63\x57\xac\x64\x22\x45\x4a\xd1\xd4\x96\xd2\x16\x6a\x7e\x35\xb0\xb8\x24\x73\xb8\x4a\xae\x20\xc6\x47\xdf\xd1\xa6\xc4\x99\x7f\x09\x44\xef\xb3\x4c\xa1\xd6\x37\xe0\x1f\x16\xc1\xc7\x5a\xae\x40\x49\x3e\x50\xbc\x23\x82\x71\xa5\x44\x43\x40\x06\xee\x60\x8d\xc6\x13\x99\x77\xa1\x17\x8d\x19\x78\x6e\x4f\x78\xac\x0f\x98\xc3\x9d\x3d\x9e\x90\x26\x57\xbc\xe4\x86\xa3\x4e\x56\x52\x29\xb9\xbb\xfd\x63\xaf\x83\xfe\xcb\x7c\xb9\xa9\x57\x25\x4f\x97\x79\x58\xf8\xc5\x01\xf5\xc3\xa1\xe1\xb5\xa1\x94\xf8\xc7\x31\xef\x70\xb6\x05\x8d\x65\x9e\x78\xde\x3d\xf2\x93\xf4\x32\x79\x3f\x8b\xfa\xf3\x68\x8b\x63\xfe\xa3\x4f\x77\x20\x58\x31\x93\x16\xf6\xd1\x9f\x3d\xc9\xa4\x7a\xd2\xa6\xa8\x6f\xe0\x93\x7f\xfe\xbc\xb8\x81\x4f\xce\xb0\x9f\x5b\xda\x22\x6b\x78\x26\xb8\xdd\x1e\xb6\xdc\xc1\xa7\xcf\x71\x17\xf5\x23\x5d\x68\x9a\x59\xfa\xc4\x7a\x46\x68\x41\xef\x29\xbb\x93\x72\x27\xdd\xb1\xef\x31\x6d\xa0\x86\xbf\x84\x6d\x36\x28\xb2\x79\x80\x6d\x87\x7a\xcf\x96\xcd\xa1\xae\x85\x5a\x6e\x1a\x15\xf7\x20\xd5\x53\xd3\xed\x04\x4b\xdb\x29\xf0\xb5\x46\x5b\x2e\xe1\xc9\xcf\xde\x76\x62\x35\xd2\x8f\x95\x9c\xce\x8c\xf6\x56\x71\xd6\x93\xdd\xc9\x74\x85\xd4\xbc\xca\x2d\xaa\x51\x2e\x1a\x72\xd4\x30\xed\x51\x11\x4d\x26\x00\x5f\x52\xdc\x58\x0a\x4c\xdb\x81\x74\xec\x2e\x21\x43\xc5\x6d\xc3\x49\xdd\x35\x6d\x0a\x91\x53\x71\x51\xfb\xd2\xc7\x5e\x6c\xe9\xdb\x48\xad\x69\x8a\xec\x8e\xa9\xd4\x84\x05\x0e\xe6\x83\x15\xee\xd2\x1b\xbe\x60\x5a\xdb\xb7\x98\xe7\x34\x7b\x12\x87\x66\xb0\x44\x2b\x25\xaf\xb8\xb9\x04\x96\x7c\x4d\x58\x72\x03\xa9\xac\x36\xb5\x71\xe5\xa3\xb7\xb4\xa6\xc6\x97\x5e\x2d\x46\xa3\xe4\x2c\x33\xcf\x0f\xc4\xcd\xa5\xd3\x6a\xcc\x8f\x97\xa4\x91\xa7\x17\xaa\x75\xb1\x1a\xff\xe8\xd0\x9a\xce\xc7\xaf\xc8\xc9\x3f\x30\x2f\x7b\x52\x3d\x79\xef\xee\xbc\xe3\x0e\x37\x7b\xcf\xe2\xdd\x32\xc9\xb5\x1f\x0f\xf6\xa8\x2e\x21\xc3\xac\x4e\xcd\x89\xbe\x6a\x1d\x9d\xb6\xf6\xab\x41\xfb\x77\xa8\x7c\x24\x9a\x99\x5a\xd9\x81\xf2\x63\xbd\xb2\xcd\xc4\x3c\x3a\xc4\x62\x00\xf7\x1d\xb0\xd4\x38\x21\xd9\x49\x65\x2a\x22\xf5\x7a\xb0\x23\x29\xf2\x35\xb5\x6c\x01\xaf\xc8\xa7\xa7\xd1\x38\x98\x48\x23\x54\xd3\x4e\xef\x64\x5d\x66\xae\x9b\xb6\xf7\xac\x6b\xbe\xf5\xf7\x33\x76\x8a\x6f\xb5\x3f\x2d\xc7\x18\x99\x4e\x39\xee\x6c\x3a\x38\x51\x13\x5f\x02\x72\x2b\xb4\x07\xdd\x90\x2b\x22\xc0\xbc\xfb\xba\xfb\xab\xbe\xd9\x78\x1e\x99\xbc\x75\xb5\xef\xd4\x9e\xdb\xf9\xfb\xad\x1b\xe8\x9b\xdb\x4b\x6b\x85\x6e\xa0\x87\x97\xad\xf8\xef\x8e\x30\x7e\x43\x4c\xea\xee\x66\x9b\x63\xe6\x94\x1a\xfb\x51\x3b\xcc\x5a\xc5\x8e\xb6\x44\x2e\xac\x4f\xeb\x80\xe0\xde\x8d\x48\x5c\xb4\xef\x3e\xc2\xe2\x78\x27\x3c\x6a\xb1\x71\xa2\x5f\xfc\x08\x76\xc8\x3e\x9e\x75\xb7\xb1\x15\xaf\x8f\xfe\x62\x7f\x7e\xe6\x68\x3a\x2d\x61\xbf\xa1\x8f\xf2\xf5\x45\x1f\x95\x50\x77\x65\x7b\x92\x74\xea\x24\x09\x5f\x37\xe2\xd3\xa0\xf0\xed\xb8\x03\xb5\xfc\x27\xe6\xd1\xc1\xed\x5a\x67\x57\x6d\x4c\xf8\x58\xe2\x6f\xb5\x57\x8c\x5a\x1d\x61\x24\x3c\x8f\xda\xf1\x19\x2a\xbe\x2e\x0c\x08\x69\x60\xcf\xb1\xcc\x5c\x5e\x60\x55\x40\x18\x37\x26\x2c\xcf\x95\xbb\x67\xba\x54\x8a\x2d\xaa\x56\x33\x64\x9b\x33\x5b\x14\x7e\x7b\x2f\xcc\xbb\x9f\xc1\x82\x50\xbb\xe6\x95\xfe\x18\x4c\x7c\x20\xb9\x78\x54\x87\xe0\x99\xb3\x38\x4f\xd2\xa1\x44\x90\xe6\x56\xe7\xc6\x13\x9c\x48\x30\x9a\xe5\x18\x70\x03\x6b\x0d\x53\x73\x0a\x5f\x59\x1b\xdb\x15\x92\x82\x17\x9d\xae\x83\xaa\xb7\xdb\x19\xee\x3e\xe0\x0f\x70\x7d\xe5\x7f\x0b\x78\x43\x16\x77\xbf\x6b\xf8\x53\x7f\xeb\xb2\xb5\xb5\xcd\x52\x86\x29\xaf\x58\x09\x1b\xc9\x85\x81\x54\x2a\x85\xb6\xb0\x26\x8d\x8e\x88\x45\xf7\x60\x33\x37\xf3\x12\xd8\xeb\x58\x99\xc3\xf5\xd5\x7f\xdf\xbe\x83\x5d\xc1\x4b\x0c\x92\x84\xfc\xe6\x3e\x19\x71\x6d\xf7\xfc\xb9\x23\x4d\xb0\xf0\x0a\xee\x20\x1f\x0b\xe7\xeb\xab\xab\xa4\xc5\x6a\xc8\x5c\xe1\x58\x7f\x42\xfb\x3b\x1a\x0d\x17\x6c\xcb\x78\xc9\x56\x25\x5e\xb4\x8b\xc9\x81\x21\x9e\xfa\xeb\x78\x68\xfc\x0c\xf1\x4f\x4d\x74\x98\xdf\x3b\x95\xc1\xb7\xd4\xbb\x82\xd9\x7d\x63\x77\xbd\xc9\x8f\xbb\x17\xf0\x50\xb6\x59\xbb\x0f\x52\xf9\xea\x78\xde\xdd\xc0\x72\xb9\x6e\x3a\xc7\xd6\x1c\x74\xe6\x15\x41\xd8\x3d\x6c\x6d\x7f\xef\x97\x07\xbe\x1d\x68\x3e\xb4\x14\xb6\x77\x59\x8d\xdf\xe7\x77\x04\x8d\x1b\xfc\x78\xdc\xb6\x4a\xc8\x62\xc1\x28\x93\xfd\x7b\xb7\xc8\x8f\xf5\xa7\x81\xcc\xe0\xb2\xc2\x85\x42\x58\x3e\x27\x12\x9a\x8f\x0b\x87\x02\xa1\x93\x65\x5b\xdd\x87\xff\x22\x11\xfb\x40\xa9\x4d\xd3\x82\x74\xbe\x39\x4d\x50\x73\x03\x64\x89\xac\xf7\x91\x37\x20\x76\x3f\xf6\x4e\xe5\xed\x43\xea\x3e\x2b\x06\xce\x77\xf1\xbe\xe5\xc7\x7b\x01\x77\x67\x72\x7a\x75\xb1\xd1\x10\x92\x09\x29\x7d\xd1\xc9\xe0\x51\x65\x47\xfa\xd1\xa1\x07\xb7\x63\x30\xb2\x7e\x66\x5f\xdb\x17\xf9\xf8\x65\xf0\xf0\x86\xae\xc3\x8f\x5f\x76\x37\xb9\x2d\x62\x6d\x85\x1e\xf8\xdc\x70\x9d\x5c\x91\x52\xae\xe1\x71\x65\x9d\xef\xba\xd5\xb5\x75\xb1\x0e\x5d\x69\x5f\x39\x14\xa9\xe0\x0a\xbe\xae\x28\x0c\x0e\xf8\xe2\xac\xeb\x31\x59\xc5\x05\xdc\xbe\x75\xff\xf1\xa2\xf7\x8d\x6a\xbe\xe8\xf2\x10\x46\x9c\x60\x5d\xcd\xb6\x38\xbf\x7d\x6b\x31\x2e\xc1\xc8\x1b\x58\xfa\xa5\xf0\x2f\xb5\x47\x16\x32\x84\xfd\xf7\x19\xfc\x2f\x00\x00\xff\xff\xa3\x75\x90\x32\x88\x23\x00\x00"
-
-func flowstoragefeesCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_flowstoragefeesCdc,
-		"FlowStorageFees.cdc",
-	)
-}
-
-func flowstoragefeesCdc() (*asset, error) {
-	bytes, err := flowstoragefeesCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "FlowStorageFees.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa9, 0x2c, 0x26, 0xfb, 0x2e, 0xa5, 0x97, 0x25, 0x44, 0x1f, 0xa7, 0x3, 0xaa, 0x4c, 0xd8, 0x11, 0xe0, 0xfc, 0x56, 0xac, 0x73, 0xd6, 0x49, 0xa8, 0xe1, 0x2c, 0x1e, 0x72, 0xb6, 0x7a, 0x84, 0x73}}
-	return a, nil
-}
-
-var _flowtokenCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x5b\xef\x6e\x1c\x37\x92\xff\x1c\x3d\x45\x45\x1f\xbc\x33\xc8\x78\xa4\xf3\xda\x8e\x33\x90\x2c\xcb\x8e\x75\x67\xc0\x76\x02\x47\xde\x3d\x60\x37\x58\xb3\xbb\xab\xa7\x79\x62\x37\x3b\x24\x7b\x46\x13\x43\xc0\x3d\xc4\x3d\xe1\x3d\xc9\x81\x45\xb2\xff\xb7\x34\x72\x12\xe0\x80\xe8\x43\x2c\x75\x77\xfd\x61\x55\xb1\xea\x57\x45\x86\xe7\xa5\x54\x06\x0e\x2f\xaa\x62\xcd\x23\x81\x97\xf2\x0a\x8b\xc3\x83\xf0\xf8\x1d\x1a\x96\x30\xc3\xfe\xc6\x71\xab\x9b\xc7\x9d\xaf\x7b\xdf\x1c\xb0\x38\x46\xad\x67\x4c\x88\x39\xc4\xb2\x30\x8a\xc5\x06\x2e\x84\xdc\xd2\xd7\x2b\xe8\x10\xc3\xe7\x83\x03\x00\x80\xa3\x23\xb8\x94\x86\x09\xd0\x55\x59\x8a\x1d\xc8\x94\x48\xc0\xd8\x8f\x34\xf0\x02\xf0\x9a\x6b\x83\x45\x8c\xf4\x7d\x5b\xc8\x86\x29\x30\x96\xf8\x27\xa2\x5d\xc1\xc7\x0b\x7e\xfd\xf4\x71\xcd\xf8\xf5\x06\x0b\x03\x26\x63\x06\xb8\x06\xcc\xb9\x31\x98\xc0\x36\xc3\x22\xb0\x67\x0a\x61\xcb\x4d\x96\x28\xb6\x2d\x20\x55\x32\x07\x06\x7f\x63\x95\x30\x03\x61\x48\xcc\x48\x77\xfd\xf7\x40\x32\x63\xb9\xac\x0a\x13\x24\x2f\x88\xc5\x0a\xce\x93\x44\xa1\xd6\x67\xf3\x7b\xaa\x92\x60\x29\x35\xb7\x6f\x8c\xdc\x4b\x91\xef\x03\xc1\x40\x11\x23\xef\xa9\x46\x81\xdb\xb6\x2a\x39\x2f\x0c\x26\xb7\x0a\x7f\x47\x9f\xf4\x24\xef\x25\x8b\x91\x34\x12\xa1\x40\xa1\x96\x95\x8a\xd1\x7e\x15\x2b\x64\xd3\x62\x49\xa0\x7a\xe5\xbe\xb1\x2f\xe4\x16\x93\xf3\x2f\x15\x1f\x55\xaa\xb8\x97\xf8\x97\x44\x10\xc4\x37\x92\x1a\x37\x1d\x1d\xd5\xd2\x59\x9c\x41\xa5\x51\x81\x36\x52\xa1\x06\x56\x00\x2f\xb4\x61\x45\x8c\x36\xc6\x65\x21\x76\x60\x32\x74\xc4\x36\xcc\x4d\x86\xdc\x7d\xcd\xd6\x58\x6f\x8d\x0c\x21\xad\x8a\xd8\x70\xe9\x36\x43\x43\xc2\x8a\x04\xd6\x72\x83\xaa\xc0\x04\x22\xc7\xac\x54\x48\xcf\x4b\xa9\x8d\xdd\x82\x09\x27\xc2\xc0\x8d\x17\xbd\x3d\xe8\xe2\x2f\xc3\x1d\xb9\x3c\x66\x42\x60\xb2\x6c\xcb\x8e\x33\x8c\xaf\x34\x64\xac\x2c\xad\xdd\x0c\xa8\xaa\x30\x3c\x47\xa2\xc4\x0d\x2a\x60\xb5\x7e\x64\xc0\x0e\x8b\xc0\xe9\x83\x37\xb1\x7d\x5f\xb8\xa5\x47\x18\x8c\x1d\x56\x65\x33\x06\x5e\x1b\x6b\x9c\xf0\x27\x25\x10\xf2\xa0\xd5\x31\x70\x73\x1b\x25\xe5\x05\xd1\x2e\x40\x4b\xfb\x5a\x91\x03\x0b\x09\x5b\xb6\x83\x54\x5a\xc5\x72\x26\x78\xcc\x65\xa5\x9d\x23\x8c\xf4\x22\x9d\x01\x6b\xab\xc8\xca\x0b\xe5\x05\x30\xae\x96\x70\x0e\xba\xc4\x98\x33\xe1\x03\xae\x09\x91\x02\x31\xd1\x96\x51\xd4\xa8\x60\x24\x05\x72\xe0\xd6\x6c\xa3\x8e\x15\xda\xd1\x54\xb3\x23\x3d\x7a\x79\x71\xe9\xbc\xeb\xb3\xa3\x67\x9a\x49\x61\xe5\x66\x08\x11\x13\x21\x84\x18\x2d\x4b\x7b\x69\xf5\xe7\xfd\x04\xe9\x29\xba\xc9\xb1\x0e\x08\x6e\x38\x13\xfc\x57\xec\x30\xb7\x7e\x0e\x3a\x92\xc9\xac\x77\xad\xdb\x6b\x5a\x4b\x38\xeb\x71\x9e\xc3\xe7\xfa\xbd\xfd\xd1\x28\xd2\x65\x60\x79\x1a\x98\xd7\x9f\xdc\xb4\x35\x39\x82\x57\x14\x39\x61\x7f\xa6\xde\x24\x6e\x71\xd6\xb3\xb4\x5b\x13\xd8\x70\x46\xaa\x7e\x72\x9b\x71\x69\x1f\xcf\xe6\x9f\x20\x47\x93\xc9\xa4\x6f\x84\x10\x44\x73\xcb\x90\x58\x58\x31\x11\x8b\xaf\x66\x7d\x6d\x79\xda\x55\xf8\x39\x1c\x2f\x8f\x7b\xdf\xd8\x9f\xba\x9e\x2d\x5b\x95\x07\x4e\x27\x9e\x3f\xec\x30\xed\x30\xbb\xb9\xcd\x58\xc7\xcb\xe3\x29\x43\xad\xd1\x58\xe6\x52\x19\x4c\x28\x56\x2e\x77\x25\x6a\x90\xa5\xf5\x12\x13\x62\x07\x0a\x4d\xa5\x6c\x1a\x07\xc1\x35\xc5\xf6\x86\x62\xca\xd0\x87\x7e\x3f\x71\x0d\x0a\x63\xe4\xb4\x87\xe3\x18\x4b\x33\x11\x42\x1c\xb7\x64\xbd\x71\xb9\xb3\xf9\x0a\x3e\xdb\xdf\x56\xf0\x52\x4a\x71\xd3\xb3\x98\x53\x05\x3e\xd3\xf2\xd6\x48\x34\x96\xc4\xa8\x0a\x6f\xc6\x56\x38\x2a\x9a\xeb\xa1\xe4\x99\x21\xa1\xf6\xd7\xb9\x93\x3d\x74\x28\x7d\x03\xa7\xa7\xd0\x15\x3f\x87\xcf\x41\x31\xab\x07\xdc\x00\x0a\x8d\xcd\xc3\x94\xd9\x3f\x47\xd5\xb3\x0e\x38\xd7\x57\xda\x72\xb7\x61\xe8\x6a\x1f\xa5\xb5\x68\x80\x24\xc8\xc8\x9b\xba\x38\xdc\xb2\xbc\xf3\x0d\xe3\x82\x51\x0a\x08\xd0\xa2\x5f\x56\x47\xd7\xe8\x15\xf6\x5a\x9c\x9c\x8e\x47\x5b\x47\xff\x5a\xc9\xd6\xb3\xf6\xeb\x8b\x90\xcd\x5d\x9c\xb0\xab\x50\xbb\x0c\xae\x6d\xa8\x38\x51\x8c\x1e\x32\xb5\xae\x72\x2c\x4c\x9b\xde\x96\x9f\x20\xc2\x07\x9b\xa7\xf1\x36\xf1\x69\x6f\xd9\x26\x7a\x63\x7c\x6a\xd6\xbe\x36\x1b\xb4\x80\x93\xa9\x9d\x2f\x76\xa1\x8c\x57\xda\x65\x5c\x9b\x10\xdb\x0c\x2c\xdb\x5c\x16\xb8\xab\xbf\x8c\x90\x17\x6b\x30\x8a\x15\x3a\x45\xa5\x30\x59\x5a\x29\x61\x6b\xd8\xef\x0b\xdc\x8a\x5d\x9b\x49\x28\x48\x5e\xa4\xec\x94\x25\xe2\xeb\xca\x9b\xad\x38\xbc\x76\x7a\x8d\xd9\xda\xac\x6c\x40\x6d\x6d\x51\x5a\x8e\x99\xd9\x47\x41\x37\xef\x07\xc7\xbb\x5c\xb5\x9d\x0c\x83\x17\x9f\x47\xea\x45\x7f\xdf\xf5\xd2\x49\xe7\xcf\x87\xde\x21\x07\x1d\x0a\xeb\x05\x17\xd3\x72\x6b\x61\x11\x77\x46\xd2\x86\x5d\x59\x43\xb2\x38\xb6\x34\x0b\x48\x24\x14\xd2\x10\x9e\xea\xd6\xe9\x50\x0f\x09\x2b\xe9\x3e\x6f\xda\x0b\x9c\x6a\x67\x86\xa2\xa4\xf5\x39\xc8\x82\x2a\x95\x2a\x0f\x45\xcd\x3e\xc2\x52\xc6\x99\x73\x1d\xe1\x17\x90\x25\x2a\xd6\x20\x99\x16\xdb\xd7\xdc\x96\x7e\x5b\xf3\x17\x1e\xa4\x09\x6a\x16\x6c\x5d\xd4\x99\xac\x44\x62\x5d\x54\xb2\x1d\xad\xc1\x18\x2c\x5c\x6c\x93\x6f\xfb\xcc\xc6\xfd\x51\xf8\x15\x01\x2b\x76\x16\x5b\x44\x18\xb3\x4a\xdb\x82\x29\xb5\x5b\x3e\xe3\x45\x9f\x55\x5e\xc5\x19\xe4\xd2\x82\x2a\x99\x97\x0a\x33\x2c\x34\xdf\xa0\x2d\x53\xd4\x32\xf5\xbf\x3f\x4f\x1c\x54\xb3\xf9\x7b\x61\x75\xd3\x18\xc4\x6e\xb9\x10\xee\xf7\x8a\xb2\x7b\x84\xa0\x30\x97\x1b\x4c\x5a\x59\xa6\xf6\x82\x15\x27\xd0\x60\x2b\xb2\xa1\x06\x7f\x29\xdb\x48\x15\xac\xdc\x45\x82\xa3\x5e\xeb\xb3\x78\xff\xc3\xe5\xeb\x15\xe1\x42\xe6\x3a\x0b\xc0\xeb\x58\x54\x9a\xc0\x69\x84\xb6\x6d\xb3\xe0\xec\xfd\x0f\x97\xc0\x40\x63\x5c\x29\x6e\x76\x10\xed\x4a\xa6\x35\xfc\xef\x7f\xff\x4f\x03\x35\x19\x24\x32\xa6\xdc\xd1\xda\x37\x5e\x4a\x27\x22\x4a\xc3\x73\xfe\xab\x43\x20\x16\xd5\x5d\x15\x72\x5b\x80\x46\xb5\xe1\x16\xa8\xb8\xa8\xf4\x79\xa6\x54\xd2\xee\x2b\xc8\xf8\x3a\x83\x8d\x14\x55\x6e\xeb\x62\x3a\x08\x45\x5a\xb0\xb5\x60\x4e\x06\x4e\x2a\x65\xa3\xa3\x1f\x75\x7a\x69\x57\xaa\x91\xf4\x4d\xf9\x35\x26\x0b\xd8\xa2\x10\x0f\x9d\x0a\xa5\x92\x46\xc6\x52\xf4\xb9\x7b\xcb\xa0\x86\x19\xe6\x95\x60\x46\xaa\xbe\xba\x0b\x30\xa8\x4d\x81\xa6\xde\x5d\xc1\x7d\x0b\xc8\x19\x2f\xc6\xde\xcc\x97\x7d\x41\x97\xd6\xef\x89\x44\x4d\xfb\x91\xa5\x29\xc6\x6e\x47\xd6\x86\x97\xa9\x03\xbe\x69\x55\x24\xd4\x3c\xb0\x62\x67\xb7\xca\xb2\x5f\x27\x05\x9a\xda\xa3\x3e\x59\x50\x12\x38\x5b\x86\xa7\x43\x1c\xc4\xd3\x9a\xe4\xeb\x53\x38\xbe\x4e\x9f\x25\x4f\xf1\xf8\xc9\xb3\xa7\xd1\x31\x7b\x74\x1c\x7f\x0b\x0f\x1e\x0c\x68\x6c\xea\xeb\xd0\x3c\x7e\xf2\xe8\xdb\x6f\xbf\xfb\x2b\xe2\xd3\x67\x0c\x13\xdc\x87\xe6\x3b\x8c\xd9\xa3\xe8\xaf\xcf\xa2\x7f\x7b\x16\x3d\x49\xd2\xbd\x68\x9e\x3d\x7d\xf4\x38\x7a\xf2\x28\xfd\x2e\x49\xe2\xe4\xf8\x31\x1b\x10\x0c\x97\x67\x7f\x28\xc7\x4d\x35\xfc\xee\xdf\xd0\xf0\x7b\x71\xf3\x01\x9f\x2e\xca\x0b\x48\x63\xf0\xd9\x3d\x44\x15\x5c\xcc\x6f\x01\x92\x1e\x12\x9c\x3c\x6c\xf7\x3a\x0d\x54\x77\xbc\xe6\x13\xd8\xc0\xd7\xb2\xfb\x40\x03\x5f\x2d\x65\xf4\x5f\x36\xfe\xba\xb8\x80\xc0\x00\x4b\x12\xdd\xe9\x39\x8c\xae\x1b\x0d\x5f\x63\x5b\x4d\x4d\x5d\x80\xf4\x38\x4c\xe0\x1a\x7c\xdf\x6f\x89\x13\xd4\x46\xc9\x9d\x0f\xfb\xc2\x78\x65\x9a\x14\x8d\xad\xe6\xdc\x33\xd9\x5a\x1d\x5b\x00\xc3\x42\x09\xac\xb3\xa2\x6f\xdd\x28\xc9\x11\xe9\x5f\x1a\x6d\x33\xd6\x59\x48\x84\x58\xd8\xfd\xa9\xab\x1c\x13\x5a\x2a\xb5\xa1\x29\x65\xfd\x80\x0d\x48\xbf\xd0\x11\x43\x0f\x01\xda\x42\xef\x4d\x3e\x73\xce\x1d\x2f\xee\xfd\x5e\xc5\xee\x57\x07\xe9\x4f\x1e\xfa\xa1\x95\xfe\x1a\x5e\x34\x2d\x48\x77\xd1\x70\x07\x20\xf8\xc6\x31\xab\x71\xe3\x9f\x1d\x17\xc0\xed\xc0\xa0\x9e\xb9\xfd\x59\x81\xc1\x9f\xb0\x62\xc0\xfd\x4b\xc6\x70\x34\xdb\xd9\x65\x6e\x42\xfb\x3b\xd6\x8d\xbd\xe4\xdd\x51\x3c\x3a\x14\xbd\x31\x84\xfd\x09\xf9\xb6\xdb\xd4\xf6\xfa\xe3\x7f\xb7\xb1\x21\x04\xc5\x52\x38\x22\x00\x3a\x23\x00\x6e\x63\xd0\xa1\x3e\x88\x76\xcd\xcc\xa4\x4d\xdf\xe1\xf5\xc2\x97\xb3\x73\x5b\x54\x14\x23\x4c\xe3\xe6\x1d\x94\x56\xa8\xc5\xcb\xb0\xc3\xd7\xb6\xd5\x94\xc0\xa9\xf9\x16\x15\xba\x8d\x12\xa1\x6b\x1e\xa3\x5d\x47\x40\xb3\xb2\x0d\x0a\x9b\x4f\x28\x15\x59\x88\x07\xdb\x8c\xc7\x19\x94\x4c\xb1\x1c\x8d\x9b\x1f\x12\x90\xf5\x49\x42\xa1\x96\x62\x83\x76\x61\xb3\xb9\x9f\x3f\x2d\x47\x17\x32\x35\x4e\x21\x9b\xcc\xe6\x2b\xf8\x87\x5d\xd2\xcf\xa3\xad\x7d\x93\xd3\xd7\x68\x5e\xf9\xcd\xec\x08\xc3\x88\xce\x4d\x5f\x3a\x9e\x1d\xf3\x48\xd7\x17\x2e\x45\xec\xe1\x00\x5a\x3f\xa9\xed\x90\xbf\x95\x16\x32\x45\x82\x9a\x2b\x6f\xf2\xe5\xb8\xdf\x40\x1b\x55\xc5\xa6\x52\xd6\x60\xa5\x42\x5b\xa5\x83\xd7\x14\xfe\x52\xa1\x36\x63\x0c\x26\xcb\x65\xdb\xea\xff\x0a\x6a\xb9\x19\xd0\x79\xb1\xfb\x89\x84\x9d\x8d\x4f\x49\x1a\x53\x7a\x26\x6d\x73\x0e\xad\xb9\x20\xee\xee\x4f\xfb\xdb\xa8\x75\xfb\xea\x39\xd0\xf5\x3a\x2f\xcd\xce\x01\xaf\x7d\x1b\xf6\x3b\x60\xdb\xf1\xf2\xb8\x2d\xbf\xa5\x45\x3d\xb7\x68\x84\xf6\xa6\xef\x5d\xd8\xd6\x9d\xb3\x38\xbc\x44\x45\x97\xb5\x61\xd8\xaf\xa8\x64\x3d\x6f\x2f\x92\x7a\x6e\xc2\x9b\xb9\x08\x13\x22\x34\x28\x78\x6d\x96\x70\xee\x9a\x8d\xbc\xd2\x6e\x4e\xe2\xea\x4f\x38\x1c\x68\x33\xa3\x13\x11\x1f\x01\xc6\x8d\x75\xc7\x4f\x41\xec\x03\xa9\x12\xb7\xf7\x08\xf6\xb9\xf7\x35\x33\x07\x3e\xfc\x40\x9e\xb9\x79\x71\x18\x6b\x06\x64\xa5\xfd\x70\xdf\x0f\xca\x69\x0a\x3a\x39\x9a\x1f\xf5\xe1\x26\xcc\x1c\xeb\x50\xeb\x43\xad\x96\x33\xf7\x75\x64\xed\x3e\xda\x9e\xed\x61\x2d\xcd\xb2\xc2\x5e\xa5\x6c\xd6\x9a\xd9\xd6\xf5\x5c\xbb\x89\xa8\x1e\x2c\xa1\x9d\x61\x6e\x4b\x18\xf6\xbf\x67\x75\xf2\x19\xae\x80\x9e\x9f\x4c\x1f\xf8\x2e\x2f\x2e\xed\xbf\xcf\x67\xf3\xc5\xa0\x2c\xdd\x4d\xfa\x3d\xd7\xa5\x60\xbb\x2f\xa4\x26\xa3\x7e\xcf\x0c\xfb\x22\xfa\xcb\x66\x40\xff\x7c\x36\xff\x79\xc4\x1d\xfb\x64\xcb\xa3\x7a\x87\xdd\x3b\x4b\xfe\xa6\x0c\x79\x34\x11\xb6\x77\x27\x35\xf2\x78\x3b\xad\xdd\x96\x38\xf5\x96\x9b\x38\xab\x3f\xee\xa5\xab\x98\x69\xdc\xc7\x4f\x2e\x40\x56\x03\x17\x85\x94\x7c\x17\xf1\x6c\x14\x65\xa5\xc6\x87\xcf\xca\x41\xcf\x7b\xe7\xf3\xfb\xc4\xe7\x9c\x9a\xab\x7d\x3e\x3e\x1b\x86\xa2\xd3\xb6\x0e\xd7\x3f\x4e\xdf\xf6\x8e\xd8\x43\xe3\xfa\xf3\xb3\x81\xca\xf3\x2f\x71\x74\x63\xae\xa1\xaf\x6d\xaf\x90\x63\xc2\x19\x9c\x42\x97\xee\x9d\x7d\x3a\xee\x62\x32\x1c\x17\xb8\xea\x91\xfc\xc7\xe5\xe5\x8f\x17\x5c\xe0\x34\x55\xa5\xc4\xaa\x0b\x9b\xde\xca\xb5\xfc\xf8\xe1\xcd\x6c\x88\xb2\x69\xbd\xe3\x5e\x23\x8d\x9d\xf5\x0f\x79\xce\xd6\x78\xa4\x37\xeb\x6f\xae\x73\x71\x78\x87\xc5\x3a\x4b\xd6\xe3\x6b\xd6\xb3\x7f\xd0\xeb\x9f\x87\xa4\xfb\xec\x0c\x6f\xed\x71\x1b\x14\x2c\xb7\x3a\x5f\xbc\xfd\xe1\xef\xf0\x1e\xcd\x56\xaa\x2b\xd7\x21\x1c\x8e\x2f\x54\xef\xf2\x48\x0a\x4f\x31\xf1\x4d\x82\x3a\x56\x9c\x4e\x18\x03\x6b\x3f\x07\x28\x98\xb1\xb5\xd6\x95\xd6\x54\x2a\xd7\x41\xda\x4a\x1d\x09\x19\x5f\xc5\x19\xe3\xc5\xd2\x0f\x6e\x6c\x36\xa3\x4c\x98\xd2\x4c\x34\x76\x73\x57\x77\x14\x43\x7a\x2e\x5c\x73\xcf\x1c\x5c\x49\x11\xf5\xa2\x86\x02\xe1\x2f\x1a\x3b\x2c\xe0\xe2\xed\x9b\x1f\x61\x23\x29\x53\x5a\x4c\x91\x53\x03\x1e\x20\x3e\xb0\xb2\x14\x3c\x76\xb3\x01\x88\x2a\x2e\x0c\xc8\xa2\xd1\xed\x65\xad\xdb\xc4\x82\xf1\xda\xa0\x2a\x98\xf8\xf8\xe1\x6d\x3f\x02\x5f\x37\xaf\x66\x87\x99\x31\xa5\x5e\x1d\x1d\xa5\x42\x6e\x97\xb1\xcc\x0f\x27\xa2\x49\xc8\xb5\xd4\x2b\x1f\x13\x13\x7e\x90\x31\x67\x42\xaf\x26\x3a\x4b\xfb\x73\x68\xb6\xdc\x18\x54\x87\x7b\xe9\xe4\x3f\xb6\x6a\x91\x7e\xff\x6a\x3c\x72\x38\xbe\x15\x6e\x7e\x9f\x64\xd0\xce\x45\xe3\xe9\x80\x10\xd5\x07\x4c\x3b\xe7\xe6\x1e\xcd\x2d\xbd\xc7\x97\x91\x54\x4a\x6e\x4f\x58\x65\xb2\xc9\xa3\xb2\x07\x3d\x20\xf6\xdc\xcf\xd1\x8e\x3c\x13\x5a\x38\xbd\xa7\xd7\xf3\x83\xaf\xbe\xfa\x2a\x28\x72\x76\x06\x25\x2b\x78\x3c\x3b\xbc\xb5\x33\x58\xc1\x2b\x9a\x17\x15\xd2\x80\x53\x09\x14\xa6\xa8\xb0\x35\xc0\x0c\xa8\xec\x2f\x7e\x6a\xf9\xf5\x88\x81\xf7\x2a\x79\xc1\x72\xe3\x5b\xdb\x2f\xea\x47\x66\xb2\xc9\x25\x8e\x07\x57\x38\xea\xf7\xa4\x65\x15\x09\x1e\x37\x94\x1f\xfc\xeb\xa9\x54\xe8\xb4\x9c\x20\x7e\xe9\x07\x0c\xb7\x0a\x7e\xcb\x8b\x2b\x4c\x5a\xd5\xec\x41\xaf\x2b\xaa\x55\x18\xbb\x0e\x73\x33\x8a\xf3\xda\xba\xdd\xc9\x3f\x68\x79\x3f\xf6\xfd\x5e\x20\xf4\x52\x2b\x98\x59\xcc\xb5\x77\x7b\x37\x12\x08\x27\x0f\xc3\x2e\x58\x0e\xbb\xc6\xf1\xed\x39\x7c\xfc\x05\xfb\xb3\x8b\x7e\xbf\x04\x9a\xb5\x38\xcc\x3a\xd7\x2f\x47\xef\xc0\xf4\xfb\xd6\x96\x8c\x82\x8b\x36\xf8\x1e\xbd\x23\x75\x9e\xe4\xbc\xe0\xda\x28\x3a\x49\xfb\xdc\x1e\xc0\x3b\xb3\xbd\xc7\xad\xbb\xa6\xb5\xd7\xa9\x45\xdd\xfe\xb6\x9a\xda\xd1\xdb\x88\x63\xdc\xc6\x3b\xc5\x5a\x81\x89\x1b\x89\x2b\x78\xe1\xef\x91\x75\x63\x82\x66\x77\xb7\x5e\x69\xec\xfc\x39\xbf\x75\x5e\x30\xae\xc1\x04\x83\xfe\x00\xa1\x65\xbf\xe1\xa5\xbd\x70\xb4\xe3\x7a\x50\xaa\xf3\xcc\xfa\xa4\x39\x7c\x8d\x59\x41\xa7\x28\xe1\x2a\xdc\xbd\xee\xc0\xd5\xa6\x69\xfb\xac\x75\xb5\xc6\x36\x51\xee\x56\x6a\xb8\x08\x18\x3c\xd5\x3d\x0a\xaa\xef\xe0\xf5\x25\x6d\x98\x82\x51\xc7\x74\x24\x5a\xf2\xcb\xee\x45\xba\xdb\xc2\xc8\x7e\xae\x5b\xeb\x5c\xd0\x31\x97\xd5\x2e\x0f\x35\xc1\xb4\x6e\x35\x2f\xda\x9c\xda\xa1\xd7\x26\xe8\x4f\x54\xf6\x89\xc0\x46\xed\x91\x8b\x22\xd3\x43\x0a\xfb\x53\xaa\xb1\x81\xb2\xb7\xfa\x73\xcf\x66\x76\x3c\xb7\x80\xaf\x66\xe4\xbc\xb5\x6c\xc4\xae\xc0\x19\xd5\xdf\x17\x76\xe3\x9f\x08\x61\x4d\x61\x69\xe1\x20\x2b\x68\x9a\x04\x51\x65\x60\x2d\x0d\xfc\xd3\x6b\x3a\x1f\x02\xe9\xde\x3d\xa6\x9e\xd7\xee\xa3\xc6\xac\x96\x32\xaf\x55\x12\xa8\xb5\xd3\x47\x2a\xc0\x5f\x2a\x26\x82\xe5\x43\x18\x79\xf9\xb3\x7f\xce\x86\xf2\xe7\x3d\x7d\xbb\x58\xe9\x7e\xb7\x02\xbf\x09\x37\x70\xda\x2c\x86\x22\x61\xcc\x0e\xcd\xf5\x9d\x36\x71\xeb\x1c\xa0\x77\x2b\xbb\x7f\xe0\x0b\xbf\xe9\x8c\x98\xee\x7d\x8e\xa7\xb9\xb1\x1b\x48\xfd\xe5\x74\xfe\x9e\x4a\x47\x7e\x20\x56\x83\x75\xdb\xbe\xc1\x7f\xbe\x7b\x0b\x1f\x3f\xbc\x71\x83\x98\xf6\x25\xe9\xa9\xd1\x57\xdd\xf4\xad\xe0\x27\x43\x8d\xc6\x60\xc2\x35\x0d\x3d\x63\x59\xee\x4e\x1c\xd9\x34\xa2\xf4\x12\xe6\x16\x48\x1e\x1e\xb6\xd7\x40\x56\x6a\x1b\x84\x8c\xd1\x8d\x8c\xe3\xe5\x71\x27\x01\xb9\x12\xd0\xba\xdf\x5d\x9f\x84\x9a\xde\xff\x1e\x11\x2e\xe9\x17\x09\x68\xb6\x41\xe0\x34\x38\x6d\xdb\x04\xba\x49\xa3\x73\x5c\x3c\xee\xf2\xbe\x82\xf3\x83\xae\xee\x7d\x03\x59\xc1\x33\x8f\x60\xdc\xc9\xd2\x24\xe2\x1e\x59\x24\x03\x87\x21\x21\x66\x25\x8b\xb8\xe0\x66\x17\xb6\x22\xcd\x86\x93\xf6\xad\x3f\xba\x2e\x8e\xd7\xa5\xd4\xa8\xfb\x35\xe2\x93\x9f\xf1\x86\xfb\xbf\x60\x32\x25\xab\xb5\xb3\xdb\xa7\x00\x29\x3f\xd1\xed\x45\x95\xb2\xf1\xaa\x6e\xcd\x13\x60\xea\xab\x46\xa1\xd3\xee\xca\x6b\x55\x39\xea\xda\x0c\x5c\xeb\x0a\x4f\x86\x4d\xc8\xa4\x31\x46\x8d\xda\x61\x4d\x96\xd1\xd9\x6c\xa8\xd1\x02\x98\xb9\x05\xbb\xff\xc1\x96\xf6\xa1\xf2\x09\x52\x8e\xa2\x67\xe8\x97\xe1\xdd\x9d\x76\xf6\x5c\xfe\xff\x98\x79\xa0\xd0\x84\x95\xfd\x12\x5b\x46\x76\x47\xe0\x16\x08\x35\xbb\xaa\x03\x56\x67\x13\x7a\xf4\xf6\x10\xf1\x98\xda\x43\xc4\xd0\x0b\xbd\x39\xb8\x39\xf8\xbf\x00\x00\x00\xff\xff\x7d\x06\x03\x23\xb6\x35\x00\x00"
-
-func flowtokenCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_flowtokenCdc,
-		"FlowToken.cdc",
-	)
-}
-
-func flowtokenCdc() (*asset, error) {
-	bytes, err := flowtokenCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "FlowToken.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3, 0xac, 0xf6, 0x92, 0xbc, 0xf, 0x85, 0xb0, 0xe4, 0xfd, 0xb5, 0x77, 0x87, 0x9f, 0xce, 0xd0, 0xd1, 0xfd, 0x65, 0xe8, 0xb3, 0x51, 0xfc, 0x82, 0x58, 0x3, 0x24, 0x3c, 0x18, 0x28, 0x3, 0x23}}
-	return a, nil
-}
-
-var _flowtransactionschedulerCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x5d\x97\x1c\xb7\x71\xe8\x33\xf9\x2b\x40\x3d\xc8\x33\xd6\x72\x76\xa9\x44\x49\x3c\xe1\x92\xa6\xf8\x11\xef\x89\x3e\x78\xc9\xa5\xf2\x20\xf1\xc8\xd8\x6e\xcc\x0c\xb2\x3d\x8d\x71\x03\xbd\xb3\x63\x9a\xf7\xe4\xe4\xf9\x3e\xe4\xc1\x0f\xf7\xf7\xf9\x97\xdc\x83\x2a\x00\x8d\xcf\x9e\xd9\x25\x15\xc9\xbe\xd9\x93\xe3\x88\xd3\x40\xa1\x50\x28\x14\x0a\x55\x85\x2a\xbe\xde\x88\x4e\x91\x4f\x5e\xf4\xed\x92\x5f\x34\xec\x5c\x5c\xb2\xf6\x93\xbb\xee\xe7\x46\x6c\x33\x3f\xbd\x60\x4c\x86\xbf\xbc\x56\xa2\xa3\x4b\x16\x7e\xf8\x8e\xb3\xed\x2b\x26\x45\x73\xc5\xba\x4f\xee\xde\x3d\x3e\x3e\x26\x00\xb0\xa3\xad\xa4\x95\xe2\xa2\x7d\x5d\xad\x58\xdd\x37\xac\x23\xac\xa5\x17\x0d\x93\x44\xae\x69\xa7\x48\x25\x5a\xd5\xd1\x4a\x49\xa2\x04\x91\xa6\x11\xa1\xbd\x12\xad\x58\x8b\x5e\x12\x76\xcd\xaa\x5e\x43\x20\xbc\x25\x6a\xc5\xc8\xa2\x57\x7d\xc7\x66\x7a\x10\x18\xe8\x7c\xc5\xa5\x03\x43\xf8\x7a\xd3\xb0\x35\x6b\x95\x24\x2f\xbe\x3a\x7b\x49\xfe\xee\xef\x4e\x7e\x25\x1d\xe0\x9a\xa8\x01\x25\x22\x77\x52\xb1\xf5\x11\xa1\x4d\x23\xb6\xbc\x5d\x86\xc8\x7c\xb2\xa5\x97\x8c\xf4\x9b\x4f\x08\x6d\x6b\x83\x06\x83\x11\x1b\xb1\xe4\x15\xa1\x8a\x6c\x3a\x56\xb3\x05\x6f\x35\x5c\xbe\x66\x92\x6c\xb9\x5a\x89\x5e\x11\x76\xad\x58\xd7\xd2\x86\xa8\x8e\x2f\x97\xac\x93\x03\xba\xaf\x73\xa8\x48\x42\x3b\x46\x36\x1d\x17\x1d\x57\xfc\x8f\xac\x26\x93\xdf\xf1\xe5\xea\xf8\x6b\x56\xf3\x7e\x7d\xfc\x95\xd8\x4e\x01\x36\xa9\xf9\x62\xc1\x3a\xd6\x2a\x8f\x2e\xcb\x9e\x76\xb4\x55\x8c\x49\xc0\x74\xc1\x18\x59\xf7\x8d\xe2\x9b\x86\xb3\x4e\xce\x61\x54\x42\xee\x13\x0d\xd1\x8e\xb1\xf3\x7b\x2d\x78\x27\xd5\xfd\x8b\x46\x54\x97\x1e\x58\xaa\x80\xdc\xec\x5a\x93\xb5\x63\x7f\xe8\x99\x54\x76\xa2\x8a\xae\x37\x64\xb2\xa0\xbc\x91\x84\x2f\x88\x6c\x84\x22\x5c\x92\x45\xdf\x34\xd3\x99\x1b\x10\xb1\x1f\x86\xdc\x74\xe2\x8a\xd7\x4c\x92\x0b\x26\xd5\x7d\xb6\x58\x68\xde\x31\x4b\xc3\xdb\xe5\x11\x91\x2b\xbe\x50\x7a\x21\x94\x80\xb1\x5b\x76\xad\x08\xbd\xa2\xbc\xd1\x2c\x63\x86\x59\xc0\xa7\x01\x21\xd1\x32\x3b\xf6\x30\xf4\x57\x62\x3b\x8c\x0b\x60\xe5\x07\x01\x05\xc0\xcf\x69\xe5\x11\xb0\x61\x57\xac\x21\x2b\x2a\x09\x57\x92\x88\xad\x66\xd0\x9a\x6d\x58\x5b\xc3\xf2\xe0\xec\x36\x42\x34\x64\xc3\x3a\x20\x1b\x8e\x05\xeb\xd8\x0a\x22\x57\xb4\x63\x35\xa9\xe8\x86\x56\x1a\xde\x05\x53\x5b\xc6\x5a\xc7\x05\x0c\x99\x26\x9c\x4a\xc2\x33\x2d\xbb\x62\x1d\xe9\xd8\xc0\xe1\x17\x3b\xb2\xe2\xcb\x15\xeb\xf2\xbd\xfc\x8d\xc3\xcc\x16\x20\xbd\x64\x72\x40\x11\xf9\xd8\x63\x05\x33\x99\x86\xaf\x39\xd2\x71\x4d\x5b\xba\xd4\x83\xab\xad\xe8\x2e\xf5\xf0\xa2\xef\x2a\x26\x8f\x00\x30\x6b\x65\xdf\xe9\x65\xd4\xfb\x83\x57\x0a\xe8\xbc\x61\xdd\x42\x74\x6b\xda\x56\x8c\x6c\x57\xbc\x61\x28\x08\x74\xb3\x56\x68\x4a\x7a\x7b\x1e\x58\xb1\x5a\x51\xde\x92\x0d\x55\x7a\x2b\x49\xd2\xf0\x4b\xbd\x40\x55\xdf\x69\xd0\x30\xce\x86\xee\x60\xa7\x1f\x41\xdf\x35\xd5\x0b\x47\xbb\x0b\xae\xb4\x80\x3a\x82\xcd\xa0\xe7\x74\xff\x82\x4a\x4d\x68\x2b\x22\x60\xfb\xce\xee\xd2\xaa\x62\x52\x4e\x68\xd3\x4c\x87\x6f\x45\xa9\xf5\xee\xee\x5d\x42\x08\xd1\xc3\x4a\xde\x2e\x1b\xa6\x40\x22\x49\x05\x13\xea\xf5\x00\x5a\x80\x29\xd1\x31\x2d\x4f\x0a\x12\xa7\xa6\x8a\x3a\x38\x1a\xbf\x4e\xf4\x6a\xac\xc3\xa2\x6f\xe1\x3f\x68\xc3\xd5\x0e\x7a\x1a\xb4\x25\x6b\x16\x53\x72\x45\x3b\xc3\x47\x0e\xd3\x39\x79\x4a\x37\xf4\x82\xeb\x0e\x0f\x69\xaf\x56\x93\xa7\x1a\xc5\x66\x4a\x3e\x7d\x1d\xb6\x7c\xe4\x4d\x09\x85\xba\xa6\xf6\x8a\x2c\x44\x07\xbb\x61\x98\xa7\x74\x64\xb0\x2b\xed\xa3\x02\x14\x6c\x98\xb2\x50\x5e\x52\xb5\x9a\x93\xd7\xc3\x3f\x86\x71\x9e\xb7\xfd\x5a\x0e\xff\x7c\x69\x18\x34\x81\xc6\xda\x7e\xed\xbe\xce\xc9\x9b\xb3\x56\xfd\x13\x79\x07\xcd\xe2\xa6\x15\x95\x0c\x44\x5b\xf9\x2b\xca\xa1\xf2\xf7\xaf\xc4\x16\x3e\xbe\x1f\x30\x7b\xad\xa8\xea\x65\x1e\x2f\xfc\x96\x62\xa5\xfb\xf5\xed\x65\xab\x45\x81\x84\x36\x0c\xf7\x28\x70\x87\xa6\xea\x8a\xb6\x35\x70\xfc\x8a\x6b\x5a\xf1\x2a\xbf\xea\x66\xff\xb5\xbd\x66\x0b\x03\xa8\x8c\xfd\x1b\x1c\x32\x40\x63\xdd\xe3\xa6\x93\xc3\x34\xb2\x7d\xdd\x61\x14\xf4\x5e\x70\xcd\x6f\xfa\x14\xda\x3f\xfa\x73\x3c\x17\xeb\x72\x0b\x64\x3f\xd3\xc2\x23\xf1\xf3\x2b\xbd\x77\xbd\x7f\xaf\xb9\xd2\x3b\x78\xbb\x62\x2d\xa1\xc1\x2e\xe0\xde\x09\x9e\xae\x89\x86\x33\xcc\x64\xe2\x30\xe1\x35\xae\xd1\x3f\xfc\xfd\x91\xfb\x6d\x13\xf2\xd4\xf0\xc1\x1d\x6b\x73\xf2\xe6\x05\xbf\xf6\xfb\x38\x39\xf8\x1c\xc4\x60\x0a\x74\xc1\x98\x4c\xbb\x79\x13\xf8\x9d\x5e\x77\xd6\x7d\xbb\x6d\xf5\xfe\x7c\x52\xd7\x1d\x93\x72\xac\xe5\xf9\x6e\xc3\xce\xf4\x39\xc2\x17\x5c\x77\x79\xad\xb4\xd0\x1b\xeb\xf1\xe6\xcd\xd9\xb3\x14\x33\x6f\x59\x41\xdc\x6f\xfa\x8b\x86\x57\xb8\xcd\x05\x9e\x79\x3e\x9d\x57\x08\x8b\xa8\x15\x55\xa4\xa2\x2d\xb9\x18\x44\x5b\x87\xea\x1d\xb9\xe2\x6c\x2b\x7d\xb8\xcf\xce\x5e\x3f\xfd\xea\xc9\xd9\xd7\xcf\x5f\xcd\xf5\x18\x1d\x1c\x9c\xad\x18\x14\x0c\x04\xa7\xa2\xe1\xb9\xd4\xab\xd8\x77\x54\xb1\x91\x69\xbd\x84\x1e\x28\x51\x86\xff\x7e\x0c\x3d\xa6\x45\xde\xc9\xee\xaa\x50\x0d\x74\x5a\x0c\x97\xa4\x63\x54\x7f\x00\x81\xcc\x95\xf9\xa5\xde\xc1\x96\x75\x8b\x5f\xe0\xbb\x97\xac\xad\x79\xbb\x7c\x6e\x9b\xdd\x92\xfd\x7e\x31\x3c\x76\x1b\xca\x6a\x8a\x19\xfd\x18\xd4\x0f\xbd\xd4\x2f\xbe\xfb\xba\x40\x31\x2b\x31\x7e\x2a\x4a\xfd\x9c\xfb\xee\x7f\xf6\x5b\xc8\x15\x95\x91\xfd\x96\x2b\xaa\x8e\x51\x25\xba\x0c\x2d\x0a\xcc\x62\x0f\x8f\x5b\x32\x8b\xde\x35\xaf\x98\xea\xbb\x96\xd5\xe9\xee\xd1\x5f\x9f\xb1\xba\xaf\x54\xee\xeb\x7f\xfb\xde\xaa\x44\xd3\x30\x24\x1d\x68\xda\x9e\x68\x72\x5d\x34\x53\xe1\x47\x58\xe4\x2d\x1d\xa4\x17\x70\x41\x7b\xbf\xe5\x0d\x0a\x32\x09\x24\xce\x37\x0e\xe0\x09\xa5\x2f\x0b\x51\xc3\x56\xa8\x00\x32\x6f\x4a\x2b\xe4\xb0\xfe\x4a\x43\x78\x85\x7d\x86\xf5\x1a\x66\x85\x1b\x16\x5a\xd9\x25\x7c\x7c\x94\x69\xe7\xe9\xdf\xd2\xb4\x3e\x6b\xd5\x38\x0f\x0e\x53\x15\xf8\x8f\xb6\x5f\x5f\x30\x64\x34\x5f\xb1\xf2\x77\x5a\xc7\xd6\xe2\x4a\x4f\x4f\xdf\xb9\x04\xcc\x6b\x1a\xd3\x3c\x9d\xef\x2b\xdd\x8b\x36\xc1\x64\x1d\x56\x21\x52\xfa\x12\x29\x3a\xb2\xd6\x77\x02\xc3\xf1\x95\x68\x17\x7c\xa9\x77\x21\x5c\x07\x98\x82\x2b\xf4\x82\xb3\xa6\x36\xba\xe2\xa6\xa6\xca\x2d\x10\x2a\x49\xa4\xe1\x52\xb1\x96\x75\xb0\xa1\xcc\xbf\xf0\x3a\xcb\xf1\xce\xff\x87\x9e\x75\x3b\x73\xbb\xdd\x86\x83\x58\x48\x78\xc5\xdd\x91\x96\x81\x7c\x29\xae\xa6\xee\xfa\x06\x91\x28\xcd\x8b\x92\x4a\x5f\x50\x2b\xda\x10\x2e\x65\x0f\x02\x88\xb5\x95\xe8\x5b\xc5\xba\x22\xe1\x9e\x9a\x3e\x67\xba\xcb\x64\xcd\xa4\xa4\x4b\x66\xb7\x86\xbf\xae\xad\xe2\xca\xd8\x70\x32\x0a\xb8\xfb\x68\x0f\x92\xd1\x36\x2f\x71\x61\x47\xdb\xa0\x8c\x19\x6d\x82\x04\x41\xe2\x0c\x98\x9e\xe9\xf9\x2e\x68\xc5\x3c\x2d\xf6\x3c\x91\x05\x20\x79\xf5\x5d\xd1\x34\x46\x1e\x44\xbb\x91\xd4\xc2\x13\xae\x59\x64\xcd\xd4\x4a\x38\x3b\x93\x07\x06\xdb\xbb\x01\xd6\xbd\x54\x9a\x7b\x9d\xa1\x6b\x90\xae\xf6\x72\x66\xb8\x5c\xb4\x8a\xf2\xd6\x88\x01\x30\x59\x29\xa1\x7b\xc6\x47\x75\x56\x7a\xcf\xdc\x80\x4f\x5a\x7d\xbd\x5e\x89\x0e\x6e\x04\x95\xbb\x5c\x3a\x06\x74\xc3\x72\x69\x4d\x3c\x86\x51\x06\xdb\x4e\xa8\xd0\xcf\x02\xf9\x13\x98\xe4\xdc\x4d\x13\x4d\x12\x60\xdb\x0b\x86\x34\xd8\x27\x67\x29\x0c\xc8\x55\x5e\xc9\xa3\x5d\xc7\xaf\x98\x9c\x25\x8b\x3c\xe0\xee\x96\x27\x5d\xc1\x39\xf1\xed\x9b\x33\xfb\x1f\xd6\x26\x10\xc3\xd4\x27\xb6\xbe\xb8\x93\x25\x53\xba\xa3\x9c\x4c\xe7\xe4\x7b\x7d\x24\xbc\xf5\x6e\x8b\xfa\xaf\x83\xa3\x89\x7c\xff\xd6\xfd\xfa\x3e\x0f\x52\x43\x33\x0a\x81\x86\x38\xf9\x11\x06\x99\x13\x0d\x74\x3a\x27\x4f\xda\xdd\x6b\xd5\xf5\x95\x7a\x9c\x1f\xc0\x4a\xef\x68\x04\xd8\x6d\x48\x4e\xe4\x12\x9f\xa7\x7c\xda\x02\xf7\xf8\xdd\x02\x10\xbf\xdd\xd0\x8e\xae\xe1\x60\xd6\xcb\xc9\x6b\x2b\xec\xf2\x6a\xc1\xc4\x2c\xaa\xd5\x76\x16\x7d\x03\x9a\x36\x6d\x77\xb8\x0a\x68\x37\xa5\xd5\x25\xc8\x85\xcc\x48\x35\x55\x14\xc7\xd2\xff\x35\x9c\x59\x1b\x2a\xa5\x7f\x20\x04\xec\x41\x25\x11\x1d\x5f\xea\xab\x6d\xb3\x8b\xae\x93\x16\x3e\x40\x5a\xd3\x5d\x84\x19\x9a\x41\xad\x31\x2c\xa3\xc7\x85\xf4\x31\x0b\x67\x28\x8b\x8b\x97\x6e\xeb\x89\xa7\xc8\x98\x19\x0d\xcb\x38\x4d\xed\x11\xfa\x77\x4f\xce\xb8\xfb\xee\x79\xa8\x71\xa5\x72\x40\xff\xd2\x4b\xb0\xdd\x54\x4c\xef\x02\x42\x17\x8a\x75\x07\x6c\xce\x33\x85\xa6\x71\x80\xba\xd6\x9b\x6f\xc9\x10\x1e\xda\x05\x0c\x29\x78\x17\x10\x43\x9f\x47\x66\x75\xcd\x82\x5c\xd0\xea\xd2\x01\x35\x56\xd8\x61\xa3\x3b\xdb\x9b\x12\x46\x5f\x4c\xe8\xcb\x17\xfa\x5e\xb6\x32\x6a\xc9\x8e\x69\x01\xc8\x1c\x51\xeb\x19\x29\xef\xeb\x2c\x9d\xf2\x96\xa4\x86\x29\x4f\xbb\x2c\x36\x49\x2c\x05\xc5\x96\xab\x51\x65\x70\x5c\x76\x20\x85\xb5\xe4\x40\x73\x53\x61\x67\x97\xec\x95\xb3\xc8\x2a\x38\xbb\x10\x5d\x27\xb6\x93\xe9\xbd\xd9\x92\x29\x04\x09\x1c\x28\x59\xb3\x98\xf1\x7a\x9a\x93\x0f\xbc\xe5\x6a\x12\x8c\xea\xf3\x6c\xf0\x61\xc4\x7c\xa2\xff\xc6\x29\x61\x5b\x4d\xa3\x39\x1a\xdc\xc8\x29\xe1\x75\xfa\x61\x90\xee\xa7\xc3\xf0\x69\xb3\xec\xd0\xe4\x34\x8f\x52\x5e\x4a\x1a\x05\x86\xc5\x0a\xa7\x7f\xf0\xd5\x4c\xaa\x4e\xec\x0a\x86\x30\xab\x3a\x62\xfb\x67\xb6\xad\x27\x03\xc8\xa9\x9d\xee\x51\x4a\x4c\xfb\xd1\x7d\x38\x1a\xa7\xa8\x6d\x9f\x6d\x94\xc8\x96\xe7\x52\x71\x30\x9e\x67\xf7\x8a\x53\x23\x40\xd6\x82\x49\x04\xdb\x83\x9f\x26\x3d\xba\x79\xbb\x4c\x8f\x59\x09\xe2\x6b\xcf\x48\xa1\x25\x75\x81\xde\x47\x2b\xd3\x98\xed\x0a\xfe\x2d\x8e\x9c\xef\xa4\xb3\x8f\x07\x2a\x39\xa9\x80\x8f\x77\xa7\x19\xc0\xd2\xf8\x71\x78\x12\xf8\xf6\xa1\x61\xec\xe1\x67\x27\x5a\x83\x43\x86\x37\x4d\xa0\x61\x51\x75\xb8\x18\x09\x11\x60\x5d\x27\xac\xea\x28\x36\xe8\x02\x30\x3f\x1a\xd5\xd9\x3a\xad\x7c\x04\x2a\xda\x6a\x09\x79\xd0\xfc\x01\x98\xe5\x98\xc7\x89\x38\xb2\x62\xd9\xc8\x24\x90\x05\x31\xc9\x32\xac\xfa\xf8\x28\x02\x9c\xdd\xd4\x76\x71\x4f\xed\x2a\xdc\x7a\x7b\x23\x49\x4e\x71\x50\x6f\xfb\x46\x3c\x7e\x1e\x39\x61\x80\xb2\xa4\x63\x9b\x8e\x49\xd6\x2a\x6a\x4f\xf6\x82\x2d\xc3\x3f\x12\x0d\x43\x9a\xad\x0f\x17\xcc\xde\x78\x4d\x40\xcd\xaf\xf9\x15\xaf\x7b\xd4\x61\x82\x43\x11\x9c\x43\xd0\xd9\x81\x8b\xb5\x69\x16\x99\x84\x32\x2a\x3c\x28\x3b\x31\x9f\x19\x4d\x3e\x39\xc5\x33\x7b\xd0\xa3\xc4\x33\x4d\x88\x0f\x3a\x0b\x07\xc3\x4b\xe0\xca\xc9\xb2\x5b\xde\x6e\x97\x3f\x05\x69\x67\x0e\x40\x7b\xfc\x85\x4a\xab\x66\x1d\xba\xd6\xf7\x4d\x4d\xb6\x0d\xdd\xe5\x04\x41\x79\xe3\x7b\xa6\xd4\x10\x2e\xdc\x46\xc6\xb7\xb8\xef\x8a\xd0\xa3\x86\x78\xc1\xee\x0c\x9d\xde\xbe\xf9\xe1\x48\xab\x31\x46\xbf\x1c\x3c\xf9\x6a\x45\xdb\xc8\xfd\x9c\x32\xbc\x05\xdf\x94\xbc\xc1\x47\xc6\x74\x5d\x00\x74\x14\x40\xba\xe8\xcd\xcc\xdc\x64\xb7\x60\x49\xcc\xcc\x96\x56\xaa\x07\x95\xd9\x31\x5b\x3c\x81\xf2\x12\x3a\x29\x9f\xa8\x4c\x01\x36\x4f\x93\x5d\xe0\xf1\x7a\x56\xc6\x32\xef\xea\x9f\x95\x59\x9e\xfa\x95\x3a\x44\x9d\x66\xfe\xe9\xbb\xf4\xb2\xf7\xfe\x51\xc4\x13\xbb\x8d\xbe\xd3\x38\xcd\xa1\x6c\xc6\xbd\xa5\x1e\xb8\xa7\x97\xb1\x36\x3a\xb3\x63\x88\xdd\xb7\xf6\x68\x18\x6e\x42\xa1\xee\x1d\x8a\x95\x88\xdb\xb9\x74\xc2\x10\x4d\xc5\xcd\xce\xa0\xc1\x2f\x1a\x76\x44\xa4\x20\x6b\x7a\xc9\x88\xec\x3b\xa6\xd9\xab\x16\x0c\x55\x70\x23\x98\x02\x70\xfa\xfe\xb6\xe9\xf8\x15\x6f\xd8\x12\xac\x6a\xe0\xee\x47\xc1\xda\x65\x1c\xd9\xc5\x75\x8b\x2f\x43\x23\x47\xd3\xa8\x86\x9a\xd3\x40\x6f\xcc\x0c\x21\x94\x32\x47\x87\xed\xe2\x29\x84\x5f\x53\xa9\x19\x7e\xdf\xeb\xe2\x20\x45\x87\xd0\xcd\x54\x68\x7b\xd8\x9c\x26\xfc\xe1\x9a\x00\x7f\x9c\x0e\x81\x0b\xc1\x47\x27\x88\x4e\xdd\x9c\x32\x27\x74\x38\x1b\x7d\x56\x87\xbf\x64\xd4\x03\xc6\xa4\xd6\x0d\x98\xe7\x79\x76\x1f\xcd\xdd\xf3\xd4\x9c\x0c\xb3\xd4\x87\xad\xff\xbc\x1d\xf4\x8a\x2d\x86\x19\xba\x8b\x50\xd0\x5a\xff\x3d\x7e\x4c\x36\xb4\xe5\xd5\xe4\x93\xb3\xf6\x8a\x36\xbc\xce\xed\xf1\x39\x79\x2a\xfa\xa6\x86\x4d\x80\x90\x40\x8d\x00\x41\xa8\x2f\xdc\xa2\x24\x1c\x3e\x99\x16\xc9\x6f\x36\xb6\x87\x23\x35\x5b\xbd\xd4\xa3\x74\x99\x79\xc5\x16\xfa\x7a\xa7\x3f\x4f\xa6\x33\x9e\x5e\x6b\x06\x22\x26\x8c\xac\xef\x0c\xc9\x8f\x25\xab\x91\xb4\x77\x48\x63\xaf\x96\xa9\x55\x20\x55\x46\xc8\xa0\x43\x01\xa1\x65\x4e\x83\x35\x30\xf4\xc1\xd3\x18\x2f\xac\x8d\x46\x98\x95\xe5\x00\xdc\x98\xdd\xbd\xb6\x65\xdb\xd7\x81\xf6\x10\xef\x8a\x4d\xc7\xa2\x5f\xf4\x9f\xeb\x46\xee\x39\xe6\x32\xc1\x15\x73\xe2\x98\xc2\xea\x25\xdf\xb0\xad\x45\x76\xd0\xba\x4d\xf3\x4f\x12\xd8\x3e\xe7\x0e\xd0\xad\x2b\x94\x7c\xfa\x69\xa1\x85\xf5\x7f\xcd\x13\x88\xfa\x2f\x41\xea\x3c\x38\x28\xd5\x8a\xf0\x9a\xfc\x30\xb1\x77\xfc\x2c\x55\x53\x5c\x07\x3a\x9c\xa6\x98\x3e\x0e\x37\x61\xba\x0b\xc9\x5c\x2b\xc4\xec\xe3\x20\xac\xcf\x32\xd1\x36\xa0\x6f\x48\xa6\x08\x95\xce\x7d\x6c\x8c\x42\x5c\x0e\x26\x9e\xc3\xa6\x62\x49\xfa\xf3\x4f\xc5\x61\xb2\x6f\x2a\xde\xee\x8b\x99\xe9\x74\x98\xe3\xd8\x66\x4d\xb7\x7b\xb0\x71\x33\x26\xf3\x9f\x71\x17\x27\xc8\xea\x1d\x9d\x9c\xb8\x87\x6d\xea\xbf\x96\x8d\xf7\xfe\x40\x19\xed\x53\xa2\xb4\xe0\x1b\xba\x7b\xd2\xd6\xaf\xd8\xa2\x6f\xeb\x17\xcc\x84\x25\xd7\x1d\xdd\x4a\x38\x52\xc9\xa2\x13\xeb\x64\xc9\x30\x48\x52\xd8\x9b\x88\xee\xeb\x85\x11\x27\x6b\x5f\xb3\x8d\x90\x5c\x49\x50\xfb\x1a\xb6\x50\xe2\x8a\x75\x08\xde\x1c\x82\x36\x7c\x9c\x5c\xd1\xbe\x51\xc6\x2c\x63\xa3\x1b\xf4\x9d\xad\x15\x35\x23\x62\xc3\x3a\x08\x09\xe8\xd8\x96\x76\xb5\x0c\xc6\x81\x98\x4f\x3d\x00\xba\xa8\x7d\x74\x0d\xa2\x60\x05\x32\x61\xa8\x7b\x38\x2b\xa6\xca\x04\x27\xf9\xb5\x9b\xa3\x63\xac\x39\xf9\xad\x0b\x87\x9f\x7d\x07\xd8\x1f\xc2\x6a\x31\x3c\xf2\xe8\x94\x9c\xcc\x4e\x34\x8f\x25\x9f\x1e\x9e\x92\x07\xb3\x93\x3d\xcc\x95\xac\x02\x3a\x3d\x86\x7f\x3b\x67\xa0\x8d\x1d\xd6\xc3\xd1\xb6\xd6\xb0\xe1\x8e\xb7\x14\x8a\xfc\x90\x4c\x74\x3a\xc6\x7a\x7c\x91\x62\x7b\x8a\x13\x49\xa7\x6c\xd7\x78\x66\xd8\x61\xa2\x59\x6b\x4e\x1e\xde\x2f\xda\xa5\x2d\x2b\xc2\x0a\xe0\x1d\x7e\x3e\x28\x7d\xd3\x54\x2d\x33\x86\x6e\x03\x12\x96\x04\xa2\x48\xd8\xf3\xf5\x46\xed\x60\x75\x26\xc0\x61\x5a\xeb\x41\x8f\xd8\xc3\x78\xf9\x1e\x4d\x22\xc0\xef\x09\x6b\x64\x6e\x0d\xb5\xda\x88\x58\x9d\x0b\x0c\x1c\xb1\xb6\x54\x60\xed\x5f\x27\xb4\x19\x85\xf0\xaf\x8c\x6d\x82\xfe\xf7\x23\xe0\xd9\xde\xba\xa5\x1b\xfd\xe1\xfd\xb2\x8d\x3f\x4b\xcb\x70\x80\x94\x9e\x1f\x69\xc9\xfc\x29\x8e\xad\x9a\x3f\x99\x02\xd7\x45\xd2\x6b\xc9\x14\x18\xa6\x2a\xb1\xe1\xe6\x49\x03\x42\xc3\xb3\x0a\xcd\xd0\x9c\x35\x79\xcb\xa6\xef\x7c\xd5\x60\x26\x07\xb8\x47\xdd\x45\xa7\x84\x12\x6a\xfa\xd6\x9f\x6f\xb1\xa1\x2d\xe9\xdb\xfb\x26\x4c\xa0\x3e\xe8\x16\x90\xde\xbf\x8d\x83\xad\x97\xac\x03\xc9\x89\x91\x1c\x6b\xa6\x28\x4c\x14\xa2\xbf\x08\xbd\x10\xc6\x60\x93\x83\xf3\x5b\x44\x48\xcf\xf3\xf6\x08\x65\x69\x18\xcc\x5b\x53\x32\x7b\x47\x1e\x21\x6a\x7c\xe9\x22\x54\x3e\xce\x03\xf9\xf9\xae\x63\x89\xad\x18\x79\xc5\x8b\x08\x70\x66\x62\xf0\x95\x36\xe8\x9f\xd4\xe7\x65\x21\x9c\xc7\x3c\x54\x7a\x9d\xb8\x38\xdd\x10\x60\xa8\xe1\x72\x88\x0c\x5f\xea\xe3\xb3\x05\x97\xca\x8a\x91\x4d\x27\x94\xa8\x44\x73\x37\x60\x95\x24\x8e\x44\x20\xf7\x38\xe3\x67\x1e\x1b\x73\xf0\x62\x48\x4f\xf8\x88\xaa\x64\x23\x1e\xc6\x70\x73\xc0\xd8\x17\x3f\xe4\x01\x82\x51\xe8\x35\x5f\xf7\x6b\xfb\x40\x24\x89\x63\xb4\x7e\xfd\x7d\x66\xd9\x2b\xda\x59\x58\x67\xce\x80\x1e\x19\x8a\xc3\x81\x79\x8b\x03\xc7\x6f\x54\x8c\x05\xd4\x7d\x4f\x11\x0b\xe0\xdc\x1c\x4b\x04\xfc\xbc\x60\xcd\x0e\x15\xef\x46\x68\xbd\x47\xd1\x26\x78\x41\xe3\x70\x2c\x13\x2f\x00\x53\xf5\xeb\xbe\xa1\x8a\x5f\xb1\x66\x07\x2b\x5e\xa1\x2f\x4a\x40\x80\x19\x68\xec\x7a\xa0\x0b\xf8\xe8\x3d\x1f\x2a\xdb\x65\x1b\xa1\xce\x35\x5a\x99\x88\xbc\xcc\x0c\xcc\x43\xa5\x03\xa6\x10\xba\xd0\xbc\xf9\x04\x58\xaf\xf8\x72\x05\x62\x3d\x36\x95\x87\xdd\xfd\xb0\xbd\x4a\xac\x2f\xe0\x99\x1d\x86\x2e\x60\xd0\x01\xbb\xae\x9a\x5e\xf2\x2b\x66\x87\xd7\x9b\xb4\xbb\x62\x92\xac\xe8\x15\xc3\x10\x81\x05\x6f\x4a\x3e\x30\x4b\x09\x7c\x15\xb3\x97\x14\x0e\xcb\x70\x30\x4b\x09\xe3\x8e\x10\x8b\x60\x39\xb9\x0c\x27\x65\x3a\xd5\x03\xee\x8d\x09\x33\xf7\x9f\x96\x15\xd1\xb5\x0d\x10\xd9\x57\x08\x6c\x4e\xde\x85\x2f\x65\xfe\xe1\xef\xe3\xfb\x40\x84\x7a\x76\x09\x07\x2e\x73\x6f\xd7\xfc\x27\x64\x1c\xde\x64\x18\x5e\x3b\x10\x41\x43\xcd\x7d\xe8\xad\xe9\x35\x9e\xea\x92\xff\x91\xc5\x68\x0d\x1f\x7c\x7e\x02\x57\x9a\xd9\xb8\x87\x0a\x17\xad\x0d\xbc\xe6\x7f\x64\x5f\x7f\x99\xf7\x42\xb8\xa9\x46\x0f\x28\x21\x2c\xf3\x8a\x36\xbd\xbe\x48\x81\x5c\xc3\x48\x95\xa6\xd2\xf4\x42\x9f\x09\xad\x6b\x56\x93\x08\x1c\x44\x1e\x6a\x91\xae\x01\xde\x7c\x91\x5f\x30\xf6\xb5\xf7\x8c\x33\x20\x23\xa0\x1f\x91\x31\xb9\x2d\x58\x52\x6e\x44\xe7\x07\x2e\xe1\x1d\x4d\x13\x53\x4f\x0c\x7b\xb9\xc8\xce\x50\x0a\x0e\x21\xdc\x63\x9b\xa8\x74\x8f\x0a\xd1\xb3\xa1\xe0\x49\x74\x6f\xbc\xe2\x43\xe4\xae\x8b\x1e\xf7\x65\x41\x28\x24\x04\xb9\xd4\x3a\xb6\x39\xd5\x72\x63\x10\xda\x75\xb4\x4c\xed\x22\x5a\xc8\xac\xd1\x1c\x72\xb1\xcc\x07\x88\xf3\xe1\x94\x69\x9a\x50\xb0\xf1\x30\xf0\xbb\x8c\xe6\x58\x14\x75\x09\xc9\x1b\x90\xba\x18\x24\x6d\xd8\x18\x35\x87\x1b\xa2\x9a\x0f\xe4\xce\x07\x3e\xa5\x9e\x9c\x3d\xfa\x40\xe8\x0d\x19\x3f\x96\xf3\xee\x97\x3d\x42\xea\x28\xc6\x26\x15\x20\x79\xb8\xfb\x37\xee\x51\xa4\x30\xe7\xf7\x4f\xd8\x6a\x0f\xa3\x46\x8d\xc7\xd8\xa5\xd4\x34\xbf\x5c\x61\x6b\x75\xed\xc7\xbf\x9b\x3d\x62\x3f\x26\x16\x39\x21\x63\xcb\x09\xb1\xc6\xad\x11\x63\x49\xfe\xfb\x2f\xc9\x62\x42\x62\x07\x58\xb8\xe4\xdf\xdb\x05\x9f\x7d\x25\xb6\x6f\xef\xe9\xa9\xed\x47\xbd\x70\xfc\xcc\xc3\xf7\xdf\x99\x99\x2c\xc1\x1a\xd2\x61\x18\x81\x3e\x65\xfe\x00\xc1\x27\x22\x9a\xd2\xe1\xf8\xde\x76\xb2\xf8\xcc\x56\xcf\xf7\x06\xc4\xb9\x2d\x55\xe2\xdc\x02\x37\x21\xcc\x0f\x93\xc3\xe8\xe0\x51\xef\xd0\xb9\xdf\x96\x76\xbf\xe3\xcb\xd5\xe1\x94\xb3\xa3\xdd\x96\x78\x61\x26\x88\x8f\x4b\x3a\x47\x89\x1b\x50\x0f\x67\xbf\x8f\x76\x9e\x28\x4b\x76\x18\x39\x78\x7b\xf9\x3a\x70\xb4\xb9\x02\xf5\x38\x4b\x8a\x93\xdb\xa0\x58\xda\x17\xe5\xf9\xdc\x6e\x2e\xf1\x96\xd8\x3f\x1d\x0c\xb5\x29\x51\x40\x2c\xbc\xa5\x2b\x23\x7b\xab\x65\xcb\xf3\xfb\x28\xf9\x6e\x47\x94\x90\xd5\x0f\x23\x49\x7c\x2f\xbd\x21\x55\xf6\xc9\x82\x31\x05\x4d\x9f\x82\x7b\x26\xea\x3d\x13\x0c\xb4\x36\x33\xe1\xa7\x63\x9f\xf7\x6f\x70\xff\xc8\x18\x41\xb4\x38\xb7\xa2\x96\xaf\x0f\xc1\x7d\x33\xcb\xe9\xfb\x6e\x5e\xe5\x8f\x07\x1c\x85\xfe\xac\x4a\x28\xee\x5f\x2f\x5f\xeb\x37\xac\x9b\xb5\xa5\x1c\xbc\x80\x21\xaf\x3e\xcd\x7f\xc8\x4f\xcf\x9c\xe8\xb9\xf1\xa7\xf1\xb1\x9f\x9d\x41\xd1\x0b\x54\x78\x83\xe4\x5b\xd6\xcf\x03\x35\x70\x32\xf5\x14\x41\xcf\x90\xfa\x54\xb4\x55\xc7\x94\xf7\xae\x88\xfa\x17\xd1\xd8\xb6\xe8\xac\x8e\xa1\xd9\xd3\xda\x24\x65\x94\x65\xa5\x6c\xf1\x1c\xb7\xbf\x66\xec\x9d\x88\xc0\x3c\x63\xed\xcc\x12\xe2\x00\x63\x65\xb1\xdf\xb8\xf9\xb0\xd4\x6d\xd4\x62\x37\xd6\xa9\x6c\xdc\x2a\xf5\x3a\xdc\xc6\x74\x18\x84\xb2\x11\x68\x84\xb6\x19\x5b\xcd\xbe\xd1\x0e\x30\x97\x94\x40\x14\x0d\x18\xa5\x0e\xfb\x8c\x06\xc5\x7e\xa3\xb7\xf8\xfd\xbd\xfe\xe7\x42\xfd\xff\xf7\x85\x1a\x84\x79\x61\x19\xc9\x69\x69\x81\x33\x20\xb2\x8b\xab\x21\x64\x3f\xa4\x00\x32\x2b\xee\xc5\x80\x7a\xbf\x06\x5d\x8f\x8f\xc9\x57\x6c\x49\xab\x9d\x7d\xf0\x7e\xc9\x36\x0a\xec\x62\x36\xff\x54\x25\xd6\x1b\xaa\x38\xc6\xe7\xfe\x33\xb8\xf5\x40\xe8\x5f\xec\xfc\xe7\x8a\xe1\x73\x4b\x87\x54\x4e\x48\xe6\xb1\x4a\xf4\xd0\xcf\xc6\x5b\x39\x0d\x7e\x4f\x3b\xd0\x87\xf3\x88\x25\x82\x98\x9c\x92\x93\x7d\x84\x35\xd2\x97\x9c\x0e\x2c\x0f\x18\xcf\x0f\x99\xd4\x11\x89\xf0\xdf\xd3\xcb\x4e\xd2\xeb\xf7\x95\xd8\xee\xe9\x04\x33\xce\xc4\x2e\x05\xbb\x1b\x59\x73\xf8\x77\x79\xda\xe1\x4e\xf7\x16\x2f\xfc\x90\x02\x48\x43\x56\xc6\x23\x35\xf6\x28\xab\xa7\x65\xe9\x90\x01\x93\xd5\x0f\x4f\xf3\x32\x63\xac\x7b\x16\x8f\xf2\xd7\x0f\x57\xd8\x6e\xf8\xa6\x94\x56\x90\xe3\x61\x66\x76\xeb\xac\x12\x9b\xdd\x43\x0d\xe7\x91\x09\x22\x39\x36\x5f\x8e\x43\xa9\x96\x8d\xb5\xfe\xfc\xe4\x24\x75\xc1\x0f\x3e\x78\xd1\x29\x2f\xf0\x4d\x92\x35\xe5\xf6\x2d\xd4\xf0\xa3\x84\x56\x60\x99\x96\x15\x26\x62\x22\xa2\xab\x59\x87\xee\x96\xc5\x82\x57\x9c\xb5\xca\xf3\xc5\xb8\x01\xce\x14\x61\x6d\x45\x37\x12\x9c\x38\x10\x87\x61\x62\xd1\xe0\x5e\xd1\xb1\xc6\xba\x2b\xed\xc8\xe0\xfc\xb7\xb9\x36\xf4\x3f\xcc\xe8\x03\x3e\x25\xf5\x32\x99\x4c\xf8\xd2\xf1\xcc\x3e\x7a\x37\x00\xc1\x61\x01\x4a\x72\x08\x99\xe4\xb2\xff\x0d\x6d\xe6\xe4\x7b\x3c\xe6\xde\x8e\x28\x04\xd9\x13\xc5\xa3\xe8\x69\x21\x19\x01\x44\xc1\x70\xc9\x50\x11\xa7\xee\x39\x07\xfa\x0a\x16\xbc\xad\x4d\x06\x81\xb6\x66\xd7\x9a\x6a\xbc\x95\xac\x53\x84\x42\x42\x12\xef\x35\x65\x6b\x1e\xcf\x0d\x33\x0d\x43\x0b\x15\xbd\x64\x2d\x46\x28\xe2\x78\x3f\x76\x7c\xb9\x52\x90\xa4\x45\xad\x44\x4b\x56\x4a\x6d\xe4\xfc\xf8\x58\x2a\x5a\x5d\x8a\x2b\xd6\x2d\x1a\xb1\x9d\x55\x62\x7d\x0c\x6f\x9e\xf4\xea\x1d\x7f\xfe\x9b\xbf\xff\xe2\xe4\xc1\x3f\x1e\xff\x3b\xbd\xa2\xf2\x3e\xfb\x43\xcf\xaf\x68\xc3\x5a\x75\x5f\x89\xfb\x08\xf5\x3e\x6f\xef\x6f\x76\x1a\x60\x30\xba\x49\x30\xd0\x42\x6e\x05\x93\x4d\xc5\x7b\x10\x66\xa7\x15\xf6\xb1\xe1\x37\xe7\x99\xf9\xab\x04\x08\x55\xfa\x66\xda\xf2\xc6\xc5\xcd\xfa\x4f\x4d\x6d\x94\xa8\xa1\x13\xfa\xae\xee\xdc\x89\x73\x41\xe0\x24\x26\x80\xa7\x0b\x5a\x3c\x6b\x21\xc0\xe9\xce\x9d\x3b\x9a\x31\xc0\xcb\x1e\xbf\x1b\x96\xb3\x86\xb5\x4b\xb5\xb2\x8d\x1a\xb1\x85\x73\xe8\xce\x9d\x3b\x98\x93\x53\xff\xf0\x10\xfb\x02\xa4\x3b\x0d\x53\x64\x0d\x0f\x48\x26\x8d\xd8\x7e\xa6\xbf\x4c\x8f\x3f\xf7\x3f\x05\x2f\x08\xc2\xd1\xbe\x5f\xf3\xfa\xed\x5d\x68\xcc\x17\x51\x5b\x08\x65\xcd\x78\x07\x48\x3e\x6d\x85\xe3\x49\x8c\xdc\x8b\xc1\x3d\x32\xd0\xf4\x50\x77\xcc\xd4\xd7\xbc\x86\x7f\xbb\x60\x3f\xf8\x88\x53\xd6\x53\xfa\x8c\x3c\xc0\xef\x77\xed\xff\x98\x71\x1b\xb1\xbd\xab\x7f\x09\x96\xf9\x49\x5d\x5b\x0f\xb8\x65\x86\x98\x93\x03\x51\x61\x3e\x80\x38\xca\xee\x47\xbd\x8e\xb4\xae\x27\xc9\x13\xda\x78\x8f\x1e\x1f\x93\x6f\xdb\x66\x67\x59\x2a\xc7\x36\x5a\x51\xca\xb2\x8e\x0f\x87\x2f\xf0\x59\x27\x80\x39\x03\x46\x35\x2b\xe6\xb3\x93\x83\x1b\xa3\x91\x11\x17\x33\x84\x35\xa1\x6a\xee\x83\xf5\x9e\x05\x4f\xf7\xdb\x18\x34\x71\xe1\xb4\x60\x01\x7d\x5d\x90\xb2\x4f\xe1\x22\x21\x31\x97\xd3\xe1\xb4\xc4\xf6\x59\x5a\xee\xa7\x9f\x47\x39\x8f\x16\x90\x45\x19\xe6\x3f\x11\x8b\x1b\xd2\xd1\xa0\x8f\x74\xac\xd9\xf5\x81\x64\xfb\x17\xa6\xd0\xa5\x3c\x08\x70\xe7\xd8\x37\xd3\xd8\x50\xa9\xc8\xa4\x61\x52\xa6\x56\xb1\xaa\xef\xf0\x01\x6a\xba\x5a\x31\x7d\x97\x4c\x7d\xc9\x16\xa2\x63\x13\xd3\xc9\x93\x3b\xf6\xd8\x89\xe6\x09\xef\x83\xa9\x54\xe7\x99\x13\x2a\x3c\x67\xf4\x1f\xc4\xb0\xf9\xc7\x44\x7c\x32\xa5\x44\xe4\xde\x09\x49\x1e\x9e\xba\xe9\xe4\x65\x4a\x88\xca\x8c\x6e\x36\xac\xf5\x36\x5f\xaa\xa3\x14\x63\x84\xf5\xdf\x45\xc7\xe8\x25\xb0\xd3\x37\xc2\xa6\xd4\x22\xd5\x8a\x55\x97\x64\xd1\x77\x10\xad\x2e\x79\x5b\x19\x36\x82\x57\xc3\xc0\xc6\xe9\x28\x85\x95\x26\x83\x18\x0c\x31\x2f\xf1\xc2\x53\x18\x1c\x19\xba\x63\xc0\x02\x10\xcd\x11\xb1\x06\xe0\x1a\xe9\x43\xc4\xed\x42\x13\x66\xdb\xf5\xb0\x35\xbc\x10\x16\x2d\x63\x18\xab\x59\x7d\x44\x16\x54\x93\x05\xe3\x59\x3a\x78\x17\xcc\xf3\x16\x0f\xcd\x37\x2b\x2a\x8b\x7c\xf3\xa5\x10\xcd\x48\xec\x68\x72\x68\x91\x47\x64\xf0\x0f\x7b\x87\xcc\xc9\x5b\x6f\xf5\xc7\xb6\x8a\xde\x0f\xdb\x95\x68\xd8\x21\x2a\x96\xcf\xfa\x4f\x9a\x66\x52\x66\xf4\x3c\xd2\xa9\x72\x3b\x90\xd9\x64\xcb\xf6\xd4\x5d\x0c\xb1\x1b\x92\xe2\x98\xf4\x04\x7e\x1e\x1f\x6b\x00\xa5\x43\xd2\x30\x35\x64\xc5\xcf\xe4\xd3\x81\x44\x3c\x36\x83\x80\x1b\xca\xc6\xaf\x06\x0f\x72\xfd\xe4\xfb\x47\x76\xdd\xfd\xbc\xf7\x51\xe2\x71\x49\xb6\xac\x69\xf4\xff\x47\xa5\xcf\x2a\xb0\x8a\x2a\x36\x9a\x8d\x27\xcc\x4a\x13\x29\xc2\x2d\xbb\x56\x67\xcf\xc2\xac\x07\x90\xad\xdd\x0f\x49\x3a\x7b\x66\x22\x5a\xa9\x94\x7c\xd9\x46\x19\x9c\xc0\x62\xac\xfb\x9d\x3d\xd3\x04\x5b\x8b\x56\x28\xd1\xf2\x0a\x5e\xb0\xf3\xb6\xea\x18\x95\x56\x91\xf7\x2c\xca\xe6\xa9\xe4\x0e\x43\xb4\x46\x02\xca\xbc\xac\x18\xb4\x33\x08\xe7\xc3\x70\xc2\x30\x1f\xbd\x66\x6b\xba\x89\x42\x6d\xc8\xd9\x33\x88\xcf\x8d\x73\x32\x48\x93\xed\x69\x74\x70\x7f\x80\x39\x79\x87\x38\xcc\x63\x58\xf1\x5b\xb0\x46\x28\x7d\x83\xc1\xa4\x7d\x03\x4e\x9e\x94\x10\xf6\xfe\xcf\xcd\x5b\x9e\x08\x5f\x48\xab\x6e\x02\x30\xc3\xe0\xdb\x3d\x08\xeb\xb1\xff\x97\x1e\x5a\x63\x0b\x3b\x29\x30\xe5\xb9\x19\x18\x73\xe1\xfb\x1c\xea\xb0\x60\x43\xa8\x6f\x6e\x02\xe6\x97\x21\x0e\x16\x30\x0e\x40\x19\x74\x71\x5f\xad\xa8\xc4\x58\x51\x17\xa5\xe5\x54\x82\x4c\xa8\x63\x3a\xa5\x37\xd2\xda\x75\xf2\xf3\xb2\xd3\x89\x66\x13\x5f\x1e\x4d\x4e\xfd\x3d\x57\xd7\x32\x26\xd1\x1d\x73\x9e\xdc\x3a\xa1\x7f\x80\x43\xde\xdf\x76\xc9\xd8\x06\xa5\x4f\x25\xba\xba\x14\x87\x07\xac\xd0\x83\x1a\x4c\x0f\x30\x96\x64\x71\xce\x75\xd3\x52\x16\x08\xf6\x36\xa4\x97\x09\xca\x0f\xd3\xa2\x58\x59\x56\xc8\xaa\x99\x49\x0e\x34\x88\xc9\x20\xc6\x9e\xb8\x4c\x14\xad\x1f\x2c\x6f\xd2\xaa\x88\x6d\x3b\x24\x7e\x08\x7c\x49\xe5\xa9\x19\x8f\xd2\xbb\xc8\xa5\x54\x30\xd5\x94\xef\xe6\x46\x26\x9e\x92\x07\x87\xd9\xaf\xb4\x66\x75\xa2\x05\xb3\xa5\xa2\xdf\x2b\x73\xf5\x0f\xbb\xbe\xcb\x3d\x43\x0c\x78\x7c\xa4\x11\xec\xed\xd2\xf7\xd8\x04\x72\x9a\xf0\x67\xf4\x24\x3f\x54\xdb\x7f\x4d\x9e\xb1\x05\xbc\xc4\x03\x31\x60\x37\xf0\xfd\x30\xa6\xb6\x58\x8d\x63\x23\x44\x33\xbf\x9b\x28\x5f\xc3\xfd\xf1\xb5\x86\x3a\xf9\xfc\x8b\x4b\xc6\x30\x62\x3f\xd5\x07\xff\xf2\xe7\xff\xf3\x97\x3f\xff\xc7\xc7\xfb\xbf\xff\xca\x8c\xf0\x9f\x1f\x65\x94\xff\x02\x48\x68\x28\x7e\x00\x53\xfa\xcb\x7f\xfc\x99\x2c\x28\xda\x1c\x16\x7d\x93\x5e\xab\x71\xe8\xff\x24\x04\x03\x26\x5e\x6a\xd5\x0c\xbb\xba\x6f\x85\x3e\x7f\xfe\x60\x74\xff\xef\x08\xf4\x8f\x45\x0c\x6b\x01\xff\xc7\x99\xa3\x07\x54\x85\xd1\xf2\x0b\x54\x0c\x5b\x06\x66\x9c\x3a\x26\xc4\x06\xc8\x63\x41\xfd\x0d\x50\x07\xec\xfc\x9f\x7f\x18\x69\x20\x94\x0a\x08\x63\x00\xfd\x7c\x8c\xf3\xe1\x90\x83\x51\x82\x11\x7e\x7d\x9c\x79\x8b\x8f\xd2\x9e\x9c\x9a\x40\x82\x49\x82\x53\xd1\xf5\xfa\x9b\xdf\xfc\xe6\x37\x47\x69\xf3\x82\xf3\xf5\xc1\xc9\x49\xda\x38\xef\x7d\xcd\xde\x5a\x23\x27\xd2\x83\x2f\x7e\x3c\xc9\x41\x0c\x9a\xba\x9d\xf3\xe3\x17\x7b\xdb\x22\x1f\xe9\x86\xe9\x3d\x37\x33\xcb\xd0\x03\x7c\x32\x3b\x39\x79\x50\x9e\x5e\xe2\x04\x3e\x68\x86\x27\xb3\x43\xe7\xf7\xc5\xde\x96\x66\x97\x1c\x34\xb7\xd4\x05\x7d\x32\xfb\x22\x6d\x36\xe2\x83\x7e\x90\x5d\x9a\x82\x1f\xfa\x8b\x93\x13\x58\x4a\x72\x7c\x4c\xbe\x0e\xdf\x21\xdc\xe8\xd9\x41\x3a\x4c\x0e\xb3\x2f\xc2\x81\x0a\xaf\x08\xf6\x8d\x11\x7b\xbe\x3f\x8f\xb8\x26\x9b\x92\xd4\x5c\xe5\x25\x80\xde\xec\x5c\xa6\x61\xd4\x0e\x8d\x62\xe8\x3f\xd3\xcc\xaa\x86\x01\xb8\x38\x1a\x29\xac\x71\x44\x8a\x19\x11\x8d\x6d\xc0\x6c\xf9\x69\x46\xd7\x1b\xb1\x13\x20\x5a\xa5\x19\x4a\x98\xe1\x41\xca\xed\x08\xda\x1a\xd2\x37\xdf\x9e\x3f\x9f\xe3\xad\xd8\xb9\x6c\xb8\x84\x5a\x11\xf5\xa0\xe0\xfa\xe9\xcd\xfd\xbc\xe7\x47\x64\xbb\xe2\xd5\xca\xbc\x3c\xa5\xf5\x9a\xb7\xf7\x45\xdb\xec\xa2\x5b\x84\x4d\xa8\x35\x33\xe9\x11\x21\x43\x18\x6d\xb0\xbe\xda\xc5\x8e\x74\x6c\xd9\x37\xb4\xc3\x47\xce\x33\xf2\xa4\xdd\x45\x93\xaa\x56\xb4\x5d\x32\x49\x26\xbc\xad\x9a\xbe\x8e\xed\x52\x91\x37\x11\xd2\xdb\xf1\x8e\x11\x76\xbd\x69\x78\xc5\x95\xcb\x4f\x8e\xd0\x9c\xe5\x78\xb0\x84\x68\xcc\xb9\x54\x90\x69\x22\x49\x13\xe1\xcf\xde\x25\x21\x31\xcb\xda\xb2\xed\xd3\x92\x2a\x7f\x94\x0d\xde\xc8\x6a\xf1\xee\x80\x70\xf0\x82\x36\x07\x7b\x5c\x1b\x41\xeb\x9b\x7b\x5c\x0f\x06\x2f\xe9\x15\x9b\x84\x90\x8e\x88\x12\x07\x0e\xc3\xd6\x3c\x2d\x20\x50\xe0\xf0\x25\x53\x7e\xca\x14\xf7\xa4\x3e\xdc\xd5\x1b\x56\xf1\x05\xcf\xa4\xe2\xdc\xbb\x2b\xf3\x89\xb6\xa7\x89\x79\x64\x2c\x23\x80\x2f\xcd\xbe\xe7\x75\xd9\x2f\x0a\x0f\xcf\xfd\xe9\xe0\x2f\x32\xf7\x16\xfd\xb6\x73\x4a\x06\x09\xa7\xf5\xe9\x61\xf3\xfa\xf4\x46\x13\xd3\xd2\x2d\x77\xc5\xcc\xaf\x56\xde\x9a\x90\x75\xdb\x14\x24\x69\x66\x2c\x34\xbb\xe2\x55\x76\x4c\x9c\x66\xba\x1e\xc6\x7a\xf2\x85\xe8\xf4\x35\x70\xd1\xd1\x35\xf3\x66\x56\x73\xb4\x8e\x76\xbb\xe4\x60\x1b\xb2\x25\x6d\xb9\x5a\xc1\x31\xe7\xad\x2a\xd7\x60\xb4\x38\x0b\x33\x6e\x8a\x6e\x49\x5b\xa8\xa0\x70\xb1\xf3\xbd\xc1\xad\x17\xb9\x0e\x89\x83\x80\x60\x32\x63\x28\x0c\xfd\xe4\xff\xf6\xe4\xd5\x37\x67\xdf\xfc\xcb\x9c\x9c\x2d\xc8\x4e\xf4\xb6\xf0\x82\x71\xa1\x21\x06\xce\x6e\xac\x84\x20\x0d\xed\x96\xec\x28\x30\xfd\x62\x2e\xcd\x86\x5f\xc2\x2b\x64\x7d\x3d\x54\x02\x42\xb0\x1a\xa6\xc2\x63\xe4\x82\x55\x14\x1e\xbe\x26\xbd\xbb\xbe\x25\xa2\x87\xb0\xf8\x25\x95\x33\x02\x29\x4f\xac\x01\xcd\xa0\x21\xd7\xb4\x69\x02\xf4\x03\xe0\xc6\xe9\x2e\x15\xed\x94\x97\xe5\xe9\x1c\x33\xca\x75\xca\x2f\x39\x0a\x07\x84\xe4\x57\x6c\x1a\x58\xea\x70\xa0\x1c\x54\xd6\xd6\x11\x4c\xd6\xd6\xb7\x84\x68\x38\x2e\x67\xe4\xb3\x3c\xfa\xfe\xfd\x9c\x3c\xf1\x99\x67\x4d\x37\x1b\x48\xeb\x10\x58\x58\x37\x81\x85\xb5\xb8\xe4\xe5\x7d\x93\x8a\xb9\x80\x91\x27\x31\x31\x4d\x00\x64\x44\x0e\xe7\x8a\x19\xa6\x04\x05\x94\xfc\xf9\x44\xbb\x2e\xb6\x3c\x9f\xb5\x6e\xd0\x71\x30\x89\x5d\x28\x34\xf0\x1c\x93\xef\x68\xc3\xf5\xa9\x41\x78\xbb\xe9\x15\x81\xe5\x63\x2a\x8e\xf6\xe2\x8b\x88\x51\xc8\xa3\x60\x52\xd9\xbc\x4c\xb0\x6c\x05\xac\x47\xfc\x6f\x31\x86\x25\x77\xeb\x42\xff\x68\x64\x41\x28\xe3\x43\x26\x22\x36\x3d\x50\xd3\x0c\x66\x2f\x74\x8f\x3d\x6f\x6b\xeb\x52\x8e\x4d\x65\xb3\x8c\xef\xd5\x9f\xf1\x88\xd9\x2c\x71\xaa\x16\x46\x4e\x69\x16\x3a\x14\xb9\xf4\x1d\xf3\xb4\x69\xa4\x9d\xae\xe8\xbb\xcc\x24\x49\xe2\x9d\x8d\x17\xed\x1d\x28\x67\xbc\xed\x19\x49\x53\xbf\x24\x3f\x40\xbe\xf4\x61\xf9\x3c\xff\xc4\x69\x64\x7e\xfc\xde\x8d\xf9\x96\x3c\x7e\x1c\xb3\x5c\x16\x78\x10\x45\x75\x9e\x3a\x55\x7c\x36\x4e\xb9\x38\x0b\x52\xd3\xdd\xcf\x9c\x90\xc7\x3d\x7f\x83\x8d\xe6\x7a\xf6\x4c\x4f\x32\x0b\xe0\x7b\x3b\x44\x69\xaa\x76\x76\xb6\x5d\xde\xce\x8e\xbe\xf8\x6c\x67\x3d\x0f\x5e\x47\x33\xd0\x18\xe5\x51\x27\xde\x75\xdd\x1f\xcb\x3a\xdb\xfd\x32\x13\xfe\x5f\x1e\xf3\x2c\x65\x09\x72\x56\x76\x14\xcf\x4b\x5c\xc6\x2f\xbb\xd2\x8e\x92\xb3\x8e\x6e\xbf\xa3\x4d\xcf\xde\x7a\xb1\xa7\x59\xa5\x62\x1c\xff\x03\x78\xc4\xdf\x1f\xc1\x34\x2e\xd9\xee\x80\xb9\x14\xa4\x99\xcf\xff\xa7\xf9\x01\x6e\x8a\xec\x88\x4c\x3c\x50\xb2\xbe\x8f\x33\x41\xd8\xd4\x19\x0b\x06\xf7\xc3\x4a\xb4\x57\xac\x83\xec\x4a\x49\x3e\x1f\xf0\x2f\xf9\x25\x1f\x94\xb8\x64\x6d\xa4\x0c\xd9\xb3\x3e\xb6\x97\x9d\x07\x85\x7b\x0c\xc4\x42\xed\xc1\x08\xd6\x90\xa3\x19\xea\x38\xda\xcd\x7c\x58\xe7\xda\x33\x6b\x81\x1a\xc3\xff\xe8\x2a\xc0\x7d\xe4\x92\x45\x56\x29\xb1\x27\xef\x39\x66\xf8\x88\xc8\xe5\x34\x41\x73\x75\xae\x6f\x90\x37\x3f\x54\x3a\xdc\xe2\xbd\x60\x6c\x52\x4a\x54\x9d\xcb\x70\x1d\x10\xc5\x29\x1e\xa6\xbe\x49\x12\xa4\xf5\xc6\x28\x9b\x02\x1c\xa1\xb4\x19\xb2\x49\x5a\x04\x62\x93\x92\x96\x9b\x17\x54\x32\x2c\x2b\xe1\xb2\xdc\x69\x75\xb6\x07\x6c\xa5\x55\xf5\x3c\x7b\xea\xec\xe4\x28\x93\x6d\x1b\x70\x8a\x27\x37\x3d\x7e\x70\x62\xfe\x66\x27\x63\xae\xaa\x63\xf2\xba\xa2\x0d\x8b\xaa\x46\x19\x56\x57\x61\x36\x02\x4b\xfe\x6c\xf6\x6c\x28\xa9\xad\x41\xd5\xce\x10\x8c\x93\xb3\xd3\xfc\xb5\x6f\x5c\x28\x3d\x1a\x77\x87\xc4\xbd\xbb\x31\x9e\x4f\x83\x1c\x36\x2f\xbe\xfa\xf6\xdf\xb2\xfc\x61\x1f\x67\x64\x32\xdf\x27\x69\xc1\xbd\x32\xe0\xc3\x42\xbc\x76\x3f\x48\x6b\x6a\x78\x6a\xaa\xde\x9f\x0b\xdd\x60\x32\xf0\xc6\x34\xc1\xf2\x49\xad\x4f\x20\xb3\x72\xa6\xec\x8b\x49\xa8\x93\xaf\x9e\x9d\x20\xe4\x7a\x23\x4a\x27\x33\xbd\x88\x0f\xee\x66\x6f\x93\x29\xbd\x3f\xf3\x27\xf4\x59\x00\x6c\xe4\x8a\xf9\x0d\xf8\x6d\x9f\xb4\xf5\x59\x5b\x75\x58\x44\xd0\xcf\x62\x08\x8e\x95\xb3\x67\x18\x87\x62\x5b\xb8\xc8\x15\x53\x4a\x31\xde\x89\x18\xf5\x6d\x54\xff\x74\x00\x1b\xd5\x9f\xec\x27\x4d\x03\xe7\x47\xf6\xbc\xca\xa9\x9d\x2a\xd7\x0a\xe2\x64\x33\xa4\x8a\x60\xa4\x24\x88\xf2\x81\x97\x0b\xab\x90\x7c\x21\xb9\xb3\x67\x39\x96\xcb\x55\x21\xcb\x4a\x44\x9b\x02\xfc\x7c\x2c\x33\xf9\x51\x2e\x7b\xb1\x31\x61\x2e\x44\xdf\xd6\x36\xa5\x37\x0a\x4f\xac\x24\x3b\x92\x68\xd6\xb7\x67\x78\x55\xb6\x9c\x9d\x26\x5f\xcc\xcb\x95\xe7\x8c\x23\x9d\xb8\x4c\x5f\xa7\x1b\xd6\xb1\xf5\x3e\x34\xa6\x03\x69\x77\x0c\xe3\xbe\xa0\x56\x1a\xbb\x62\x1d\x61\xd7\x5c\xc6\x81\x87\x7c\xa1\xd5\xba\xd3\x53\xe2\x45\x0a\x90\x3f\xfd\x49\xff\xf8\x28\x5c\xfb\xe2\x6d\x2a\x8e\xcd\x7e\x9f\xec\x5b\x7c\xbc\xbb\x82\xfc\x8c\xb4\xd9\xea\x1b\xae\x5d\x19\x87\xae\xe8\xd2\x02\xf2\x64\x08\xb5\x55\x43\x84\x72\xd6\xfc\xc5\xeb\x5c\x70\xad\xd5\x4a\xae\x67\x51\xf9\xfb\x3c\x9e\x19\xca\xeb\x93\xd7\x99\xb3\x86\xaa\xe0\x52\xf1\xa6\x01\x82\x6f\xba\xbe\x65\x35\x5a\x9a\x63\x78\x0d\x97\x76\xaf\x0f\x40\x32\x98\xe8\x9b\x6c\xc9\x76\x35\xb3\x21\x2e\x93\xd1\x29\x46\xb9\xaa\xf7\x4c\xf4\x6c\x41\xb6\x0c\xab\xdb\xe2\x9d\x6e\x23\x78\xab\x8e\x4a\xec\x6f\xe3\xa0\x2b\xc8\x09\x17\x58\xc0\xd6\x74\x13\x03\xd7\x54\x32\xbd\xb2\x13\x22\xaf\x21\x1c\xf6\x75\x56\x62\x0f\xfc\xb1\xa6\xbc\x25\xbc\x8d\xa1\x47\x28\x62\x90\x57\xdf\x2a\xde\x0c\x47\xec\x51\x98\x0a\x6e\x48\xb8\xee\xcd\xb8\x12\x35\x83\x72\xd8\x09\xfa\x90\x57\x90\x7b\xd1\x60\x43\x0d\xaf\xb6\x26\x74\xa9\x99\xb5\x57\x33\xf3\xc8\xc3\x1a\x75\x2f\x98\x3e\x8e\x4c\xa9\x52\x96\x8b\xf1\x2d\x64\x3e\x33\x11\xa8\x10\xcb\xd9\x88\x2d\xeb\xee\x5f\x80\xb4\xa1\x6d\xb5\x12\xdd\xdc\x32\xe5\x50\xc8\x27\x10\x06\x29\x79\x8c\x6c\x10\x4d\xcd\xa4\x22\x28\xb1\x1c\xf3\x19\x61\x01\x69\x04\x86\xd4\x88\x6e\x82\x13\xf4\xd7\x60\xe3\xe9\x51\x0c\xdc\xda\xfe\x68\xbb\xb3\xc9\xe0\xd0\xcf\xb2\x85\xad\x0d\x00\x31\xff\x1d\x57\xd6\xca\x5d\x9e\xf2\x2c\x06\x8f\x1e\xaa\x81\x2f\x26\x98\x9a\x74\x1a\x25\x80\x74\x25\x16\x78\x5b\x89\xae\x63\x95\x82\x95\xdd\x98\xc7\x01\x32\x2f\x47\x8e\x8f\x09\x44\x43\xff\xe5\x3f\xfe\x4c\xa0\xfa\x31\xed\x98\xd9\xc6\x3c\x51\xb8\x91\xab\x60\xb9\x3b\xe6\x84\xbd\x86\x3d\x60\x47\x2f\xc4\x15\x9b\x25\xa7\x2b\xc4\xfe\xdb\x6d\x38\x1c\xa0\x39\x32\x7c\x7f\xf2\x36\x23\x8b\x1f\x25\x20\xf6\x6d\xfa\xec\x7c\xd3\x4d\x1f\x6f\x6e\x90\x4e\x70\xf3\x40\x11\x66\xa2\x39\x73\xd9\xfb\xd7\xf0\xf0\xca\xcf\x46\x6a\x60\x32\x0e\x01\xee\x8e\xbf\x44\xe7\xc2\x84\xa5\x40\x19\x03\x98\xf6\x78\x74\xe6\x94\x87\xb0\xbc\x46\xd1\x0f\x6a\xe8\xee\x32\x19\x76\x7c\x4d\xbb\x1d\x61\xad\xea\x76\x28\xbe\x7c\x3d\x10\x4b\x99\xc2\x73\x2b\x5f\x98\x7b\x86\xb9\x24\x48\x7a\x16\x46\x44\x9e\x2d\x7c\x58\x05\xc1\xb8\x11\x58\x1f\xc9\xd2\xa1\xee\x19\xbe\xf8\xc2\xf4\x1e\xb4\x5b\xf6\xa8\xcd\x09\xf7\x2d\x18\xa4\x6f\xe9\x15\xe5\xe8\x1d\x95\x8d\x50\x32\xb2\xca\x63\x59\x85\x00\xb3\xa0\xff\xb9\x37\x93\xa1\x97\x56\x45\x36\x46\x85\x5c\x88\xa6\x11\x5b\x20\x87\x45\x66\x9e\xd1\xb4\xe6\xfe\xec\xe6\xe4\x49\x54\x8e\x8e\xfa\xd5\x91\xdd\x2d\x00\x6f\x92\x36\xa1\x87\x2c\x25\x58\x0e\xa7\x0c\xc5\x44\x4d\x76\x8f\x19\x6a\x06\xb6\x9d\xad\x65\xc7\xdb\x2b\x71\x09\x25\x13\xbd\x5b\x13\x58\xe2\x35\x8b\xa2\xaf\x01\x0b\xd8\x46\x36\x56\xb8\x50\x6f\xe8\xae\x11\xb4\xce\x99\x05\xe6\x7e\x91\xc2\xd7\xa6\x9b\x29\x2a\x49\xbb\x86\x6b\x81\x79\xd1\x88\xea\x32\x7c\xa4\x87\x9e\xef\x0c\x0b\xb0\x86\x2f\x61\xf9\x17\xc2\x0f\x81\x8e\xe7\x3b\x79\xd3\xf2\x6b\x0f\xa4\x14\x64\xd1\x51\x13\x72\x4f\x24\xab\x44\x5b\x4b\x9b\x57\x14\x1e\xec\x2c\x5b\xd1\xb1\x7a\x0a\x5e\x74\x9b\xf2\x45\x32\x15\xa6\x6c\xce\xcf\x70\xb8\x6f\x3f\x69\x09\x6b\xfb\x35\x02\x26\x93\xdf\xff\x8e\x2f\x57\xbf\x3f\x22\xbf\xc7\x30\x97\xdf\x1f\x69\xa6\xfc\xfd\x57\x62\xfb\xfb\xa9\x59\xc9\x76\xd1\xf4\xfa\x2c\x0b\x2a\x81\x68\xd6\xb9\x60\x2b\x7a\xc5\x21\x35\x72\x4d\x6a\xa6\x58\xb7\x86\x8a\xe5\xf1\x44\x57\x62\x4b\xa4\xd0\x1c\x68\x73\xf3\x06\x35\xf4\xc6\x2b\x64\xe6\xa7\x93\x5c\xc9\x9f\x99\x6a\xe9\x41\xc2\x5a\xb8\xd9\x53\x43\x50\xcb\xaa\x32\xcc\x35\x1c\x97\x30\xb1\x49\xd7\xa5\x18\x9b\x92\x49\x93\x4a\xaa\x15\xed\x96\xac\x9e\x91\x37\x2d\x06\x9e\x67\xb2\x4d\x6b\x89\x60\x93\xa8\xe6\x67\x83\x95\xba\x9e\x10\xac\x24\xe1\xf6\x94\x17\xac\x22\x7b\x17\xef\xad\x01\x49\xf4\xc3\x5d\x19\x6a\xba\x3b\x79\x3c\xfc\xbe\x52\x2a\x46\x46\x84\xe1\x68\x66\xdf\x3d\xa5\x9b\x0f\xac\x85\x36\x5e\xe3\x6c\x4f\x41\xe0\x8f\x58\x02\x2d\x2e\xf6\xe0\x9a\x4c\xe7\xe4\xb7\x85\x42\xb3\x25\x73\x93\xad\xf4\xea\xbd\x6f\x16\xe4\x2a\x70\x4c\xa5\xe6\x05\xd7\xc9\x9c\xfa\xf6\xdf\x69\x18\x20\x92\x4c\xff\x6f\x1a\xd9\xe5\x11\x2c\x53\xb4\x31\xa5\xdb\x26\x4b\xb7\x2c\xed\xc6\xea\xad\x45\xf6\x16\xbe\x70\xd3\x31\xa5\x55\xef\x9d\xc2\x63\xe5\x54\x1d\xc1\xec\xfc\x61\xeb\x7b\xf1\xc3\xc5\xe0\x9f\x54\xc2\x03\xd6\x94\x2e\x7a\x21\x67\x17\xb4\xd1\x0a\x85\xbe\x81\x3a\xa0\xa6\x3a\xec\xbd\x4c\xf0\x20\x16\xc1\x85\xc2\x5c\xfe\xf6\x01\x96\xd0\x27\xe4\x0b\xc6\x88\x05\x09\x39\xdb\xfc\x41\xa6\xee\xe6\x3c\x74\x35\x16\xaf\x60\xc7\x0d\x59\xbd\x7f\x98\x24\x48\x4d\xa3\x13\x20\x53\x31\xe9\x93\x31\x5a\x27\x9e\x1e\xcb\x40\x05\xeb\xce\x58\xe7\x67\x58\xa6\x2f\x8a\x02\x49\x29\xad\x6f\xcd\xc1\x98\x29\x65\x5d\x69\x85\x41\x52\xa4\x8d\x72\x85\x10\x1d\x81\x1c\xff\x66\xd6\x6d\x6c\x0b\x7c\x0c\xe6\x4e\x7b\x20\x4b\xf8\xab\x7f\x34\xb6\x2a\xc7\xc7\xe4\x19\x56\x46\xf1\xb2\x65\x9b\xd8\x1d\xd6\x5d\xf1\x4a\x5f\x89\xc1\x40\xf7\x2b\x53\xda\x28\xe8\x5e\x8c\xb5\x32\xe5\x56\xc0\x10\x6d\x4b\xae\x40\xc9\x9b\x64\x78\x9b\xf5\x05\x86\x6c\xe0\x6e\x86\xc6\x52\x72\x01\x1e\x5b\x54\x95\xf4\xb9\x61\x75\xa8\x8a\x36\xcd\x05\xad\x2e\x67\xa9\x45\x8f\xd6\xbe\xe8\x9b\x68\x78\xf9\x95\x22\x0a\xa2\x71\xe7\x31\x57\x65\x98\x36\x57\x57\xf1\x29\xdd\xfc\x8c\xa5\x15\x4b\x28\xbe\x84\xaa\xa6\x2f\xa9\x5a\x85\x45\x12\xf5\x21\xdc\x5c\xb1\xef\x38\xdb\x4e\xa0\x62\xd0\xd0\xf0\xd1\x64\x8a\x65\x4a\x86\x9f\x42\xf0\x10\xe6\xe6\xce\x95\xbd\x5b\x4c\xd3\x70\xc6\xeb\x31\x6e\x8f\x5b\x27\xde\xc8\xf1\xd3\x22\xea\x9d\x6e\xcd\x03\x76\x51\x0c\xe4\xc0\x5d\x15\x77\xd3\xbf\x66\x90\x4d\x74\x89\x6f\xb7\xad\x5e\xf0\xb8\x7b\x54\xff\xf2\x10\x48\x71\x51\xdd\x02\xc8\xb0\xd9\x21\x80\xdf\xbc\x39\x7b\x36\xf7\x79\xa6\xef\x73\xab\x98\x76\x1c\xf8\x66\x9e\xb2\xe1\x98\xe4\x71\x35\x93\xb0\xbe\x15\xc9\xe9\x2e\x87\x48\xf4\xd4\xd7\xac\x6e\x28\xa4\x0b\x05\x8b\x0f\xa2\x6d\x34\x43\xfb\x5f\xef\xd3\x4a\xdb\xa9\xb2\xe5\x9c\x8b\x32\x3c\x86\x7d\x87\x26\x3e\x4a\xde\xb0\x4a\x05\x1a\xb1\x17\x9f\x12\x15\xe5\xa6\x63\x65\xee\xd2\x3a\xd9\xc3\xd9\xe3\xbd\x7f\xce\xe9\xdc\x71\x18\xdb\xd9\xc2\xd6\xee\xb9\xe2\x35\xf3\xed\x00\x98\x8e\x01\x05\x5f\xe6\x4d\x62\xa6\x68\x3f\x99\xb0\xd9\x72\x76\x64\xcc\x07\xc1\x30\xdc\x57\x77\xbc\x3b\x90\x73\x6f\x77\x81\x81\x01\x6e\xd0\x60\x65\x98\xe6\x35\xdc\x00\xb8\x57\xda\xea\xb9\x69\x58\x67\x55\x68\x13\x70\x8f\x11\x8b\xa4\x15\xed\x7d\xad\x24\xa2\xc6\x68\x54\xb3\x32\xa5\xf0\xfe\xcf\x9a\x8d\x24\x35\xbb\x62\x8d\xd8\xb0\x4e\x12\xd6\x42\x99\xe9\xe8\x2e\x64\x1f\x6b\x6f\x3a\x06\xbe\x15\xf4\xaa\x9a\xe5\xf7\x6e\xab\x5b\xde\xd6\x62\x7b\x14\xcd\xa6\xee\x2b\x6b\xc8\xe9\xb8\xbc\xd4\x2a\x5a\xdf\xb6\x4c\x5f\x96\x68\x17\x9a\x33\x65\x19\xdf\xef\x3e\x9f\x93\xe7\x07\xbf\x33\x9c\x85\x49\xa5\x55\x18\x39\x8b\x16\x0c\x8a\x97\x8a\x60\x94\x81\x83\xdd\x1a\xfd\x7b\x2f\x15\x16\x41\xfc\x9d\xad\x99\x13\x27\x79\xf6\xc1\xef\x0d\xb1\x44\xe5\xeb\xfc\x27\x88\x42\x40\xf8\x2a\x8c\xb4\xcc\x6c\xaf\xd1\x80\x83\x8f\x15\x7e\xf1\x71\xe2\x40\x8c\x38\x1e\xdd\x07\xfa\x5a\x9f\xbe\x3d\xf1\xf7\x19\x08\xaf\x23\x5f\xbe\x80\x54\xf1\x37\xca\xf8\x2d\x3e\x7f\x9b\xfc\x39\x2e\xdf\xfe\xc5\x7a\x5c\x3a\x24\xde\x4d\x93\xdf\xc7\x33\x80\x19\xc3\xd7\x50\x8a\x34\x29\x6a\x4a\x6c\x38\x01\x6d\xb9\xe2\x7f\x0c\xcb\xa0\x9a\x18\x0d\x44\xcc\xcb\xe2\x92\x5e\x67\x33\xdd\x1f\x9e\x42\xb8\x39\x06\x53\x7e\xd9\x88\xea\x72\x32\x1d\xce\xc3\xb2\xe5\x7d\x74\xd2\xe9\xc9\xec\xff\x99\x3b\xe3\x5c\xdf\xa5\xf3\xcf\xcc\x32\x6b\xb7\xb7\x2d\xb0\x91\x57\x9b\xda\xeb\xfb\xc3\x24\x9d\xf8\xd4\x4b\x6f\xb4\xa1\x52\x1d\xa5\x19\x80\x74\xc7\x11\xda\x64\x52\x64\xdb\xbf\xd1\x9b\x3f\x5f\xc4\x4c\x65\xf3\x67\x9b\xe0\x95\x52\x52\xd3\x8f\xb9\x16\xfb\xd7\xe0\x10\xda\xc7\x34\x8f\xe5\x8a\xa6\x60\x1c\x3a\x94\xf5\xe3\x5b\x3b\xa6\x2f\x6c\x07\xd1\xe4\x32\x77\x8f\x91\x27\xb3\x1c\x1f\xb6\x0c\xb9\x5c\x9f\x43\x00\xd1\x5f\xf1\xce\xb8\xf5\x2a\xd9\xd9\xff\x4a\x42\x9d\xb2\xd2\x02\x8d\x13\xee\x23\x6e\x9b\x87\xe1\xb6\xc9\x27\xf2\xfd\xdb\x5f\xa7\x21\xa1\x59\x50\xed\x31\x73\xc6\x47\x1b\x29\x4b\xb0\xdb\xae\x8f\x3e\x9e\x6a\x3f\xcd\x6d\xd1\xfa\xb3\x64\x4a\x37\xfa\x76\x01\x66\xb9\x1a\xec\x2a\x3e\x24\xbe\xf0\x01\x25\xb2\xd1\xcb\xa5\xfb\xcb\x97\x88\xae\x64\x9f\x5e\x3c\x2f\xb2\x6f\x54\x0a\x0e\x75\xfe\x72\xc2\x6f\x98\xff\xf4\xeb\x2f\x6f\x28\xf4\x4c\x49\xe5\xc1\x27\x3f\x16\xc0\x1a\xdb\x3d\x72\x36\xc9\x20\x92\xb5\x18\xb9\x88\xd1\x9b\x99\x0a\xee\x21\x16\x99\x62\xf7\x63\xd7\xf7\xf4\x50\xcf\x5c\xf7\x73\x38\x7f\x6c\x2f\x41\x6e\x6a\x25\x4f\xc1\xdf\x9a\xf0\x19\x3b\x24\x86\x9b\xb7\xf7\xfe\xbe\x60\x6b\x98\xdd\x52\xec\x1c\x44\x4f\x47\xb2\xf0\xfe\x31\xcf\xac\xdc\x91\xa5\x44\xcb\x9b\xa2\xc5\x66\x84\x63\x63\xbb\x4d\xc6\x8f\x1e\x1a\x61\x22\xc0\x6d\x60\xfb\x00\xff\xb7\xa2\x97\x4c\xeb\xaa\x4a\x58\x6b\x7b\x40\xe8\x5c\x60\x68\x92\xe5\x4b\x65\x6e\x7d\xa1\x87\xf6\xbe\x35\xda\x44\x2e\xfe\x95\x5f\x34\x08\x62\xa7\xfc\xd8\x5d\xef\x45\x95\xcd\xb6\xc8\x25\x61\xad\xe8\x97\xab\xc4\x95\x2c\x37\xb4\x62\x61\xc6\x5f\x6c\xdf\x0a\xf3\xad\x61\x8b\x03\xd1\x32\x75\x89\x44\x17\x16\x6d\x32\x21\x3c\x08\x76\x80\x69\x35\xfd\x9c\x91\x2b\xc2\x32\x9a\x60\x8e\x55\x0d\x4a\x16\x77\x65\xe7\x0b\xe3\x01\x89\x86\x24\xd0\x98\xb3\xcf\x51\x09\x8c\x44\xd0\x6e\xaf\x81\xe2\x6f\xd1\x80\x80\x57\xd5\xc7\x08\xe4\x80\x10\x93\x78\x3f\x1c\xed\xe5\x1e\x53\x07\x37\xe0\xda\x1b\x3e\x2f\x3f\xf8\x3c\x4a\x4d\x0b\x64\x9f\x6d\x81\xdc\xd4\xb8\x60\x28\x16\xfb\xe9\xc3\x87\x7a\xe2\x35\xa3\x5d\xb5\xf2\x5f\x39\xe5\x22\x5e\x5b\x41\x42\x63\xa8\x79\xb8\x49\x3b\x86\x01\xde\x47\x18\x19\x0a\x81\x29\xec\x8a\xb5\xaa\x07\x3b\x97\x0d\x1a\x65\x64\x49\x6d\x99\x29\xbd\xd1\x16\x34\x0a\x7c\xf6\xaa\xb3\xb3\x6b\xd5\xb1\x35\x6b\x76\xa4\x6f\xf1\x55\x75\xd0\x12\x33\x6c\x43\x72\xd5\x77\xe9\x7b\x3b\xad\x2f\x40\x90\xc9\x69\x2e\xed\xda\xf7\xc9\xc4\xdf\x26\x10\x30\x96\xb9\x15\x6a\x65\x32\xb6\x0e\xe6\x64\xaa\xa2\x57\x9c\x47\x64\x0b\x51\x9a\x43\x20\x19\x55\x83\xe9\x3a\x6f\x8c\x21\x78\xf2\x23\x92\xe6\xac\xcf\xbf\xd0\xb3\x11\xd8\x31\xce\x49\xe3\x03\xdf\x7e\x0e\x2b\x38\x78\xc8\x5f\x37\x42\x3d\xb1\xbf\x23\x91\x32\x26\x8f\x79\x8a\x44\x4e\xa7\x4b\x5d\x96\x2e\x30\xbc\x63\x32\x92\x75\x86\x72\x0a\xeb\x59\x41\x09\xef\x9b\x53\x31\xb9\xd7\x9d\x7a\xb3\x1c\xcd\x8a\xfe\x51\xa8\xea\xbd\xa1\xd4\x6b\x19\xa4\x4d\x2a\x0c\x7f\x7c\x1c\x49\x98\x9a\xad\xa9\x16\xf9\x7e\xf4\xa5\x22\xec\x9a\x56\xbe\xf4\x17\x1d\xec\x19\x3f\xa7\x4b\x66\x52\xd9\x54\xef\xe9\x26\x19\xdd\xfe\xee\xb7\xcf\xc8\x83\x28\x4b\x53\x1a\x17\x6b\x1e\x26\xe0\x1b\x89\x15\x3c\x50\xcd\xe9\x58\x3e\x5e\xb9\x7c\xa4\x89\xba\xe7\x9f\xa3\xc5\x9a\xf0\x43\x2f\x94\xdc\x4b\x7e\xc5\xda\x42\x56\x88\x50\x35\x38\xdc\x0d\x81\xc7\xae\x3e\x27\x30\xb7\xb0\x17\x44\x80\x95\x6f\x87\x1c\x04\xd9\xc7\x94\xb9\xbd\x14\x9e\x60\xde\x4b\x9c\x9c\xd6\x0b\x7a\x9c\x7b\xff\x81\xb9\xe9\x87\xe8\x73\x07\x3e\x56\x98\x4a\x7f\xae\xc4\x77\x03\x9c\x96\x75\x5f\x8d\x9f\xfd\x7b\xf0\xcd\x1f\xde\x36\xab\xee\xe1\xcb\x09\x67\xc6\xc8\x82\x1e\x70\x1a\xdf\x40\xbc\xd9\x43\x38\x3d\x77\xc7\x5e\x83\x35\xa6\xba\xcc\xe1\x26\xac\xe4\x02\x78\x2f\x73\x4a\xb9\x67\x2b\xff\xca\x76\x59\xfb\x73\xf1\x6e\xd8\x24\xb5\x71\x32\xb7\x78\x3d\xd8\xcb\x00\x4b\xf9\x06\x4f\xcc\x4f\x73\x47\x66\x8a\xc0\xdb\x7b\x84\x4a\xf2\xe9\x48\x2d\x3a\x12\x9d\xc4\xf9\x11\xc3\x97\xf7\x27\x39\xc1\x01\x13\x9a\x49\xaa\x20\xc7\x55\xbb\x7c\xdd\x5f\xc0\x2a\x4f\x34\xe4\xe2\x25\x8b\xd6\x75\xfc\xd8\x4d\xb3\x13\x26\x6c\xd6\x4c\x84\x99\x69\x87\xe4\x60\x61\xea\xed\x98\xb5\x86\x07\x83\xd9\x30\x20\xcb\x3b\x36\xf2\x27\x8a\x1e\x9b\x66\x02\x25\xcf\x02\x05\xc3\xec\x49\x44\x0f\x79\x5f\xab\x1d\x8d\x50\x47\x90\xd2\x96\xd3\x86\xff\x71\x08\x64\x4a\x6c\x08\x61\xea\x06\xfd\x5f\x6f\xcb\x56\x84\x7c\x6b\xf2\x2e\x73\x4c\x58\x9d\x0c\xe2\x7c\xd7\x8c\xda\x07\xd0\x1a\x11\x3f\x83\xb4\x49\x71\x1c\x60\x8e\x4f\xe7\xc0\x1f\xcf\x5a\x6f\x16\x69\xea\xfe\x2c\xcf\x59\xac\xb2\x87\x5d\x94\x9d\xf0\xd0\xd4\x84\x07\x25\x26\xcc\xa4\x25\x4c\x09\x93\x4f\x30\x12\x55\x21\xd1\x73\x18\xb5\x3f\x98\x47\xb9\xa8\x51\xfa\x57\x54\x17\x72\x9d\xac\x76\x14\xae\x28\x5f\x88\x0e\x52\xee\x26\xf9\x3b\x80\x80\xf7\x62\x4e\x81\x5a\x12\x66\xba\x36\xcd\x70\x06\xdc\xf7\xc8\xc9\xb3\x61\x7b\x66\xc2\x56\x7d\x30\xb6\x03\xaf\x21\x5d\xc2\x75\x2e\xf6\x29\x55\x42\x0e\x19\xf8\x34\x1c\x28\x24\x67\xa9\xb2\xc4\x81\x90\x0b\x19\x21\xec\x54\xe6\x87\x4e\x64\xac\xf6\x44\x61\xb7\x65\x30\x2c\xf0\x46\xe6\xc2\xec\xf6\x59\x46\xc4\x6d\x58\x77\xdf\x3b\xa9\x15\x6d\xdc\x61\xaa\xc7\x2e\x3c\x1b\xc3\x5d\x5e\xd7\xdc\x0f\xa4\xb1\x45\xa1\xa0\x66\x14\x78\x9c\x2b\x36\x4b\x14\x6e\x2e\x5d\x60\x77\x4d\xe8\x52\x9f\x5a\x49\xb5\xc2\x92\x57\xd2\x3f\xce\xfd\x9a\x6f\x26\x3e\x13\xee\x8e\x18\x08\x60\x5f\x59\x92\x09\xf7\x5c\xf9\xd3\xf4\x95\x9d\x7d\x87\x55\xf5\x6b\xc8\x6e\x70\x65\x62\x3f\x91\x14\x5c\x12\x78\x1f\x88\xe9\xc3\x46\x4f\x6a\x97\xb2\x31\x1e\x01\xaa\x93\x60\xec\x93\x56\x7a\x87\x4b\xcb\x64\x0a\x5a\xd5\xcc\xaa\x0b\x6b\x7a\x4d\x26\xff\xfb\xc1\xec\x9f\xd8\x83\xdf\x4c\xc9\x82\x76\x84\x5d\x57\x8c\xd5\x12\x9e\x00\xc2\xe4\xf0\xed\x52\xbf\x4e\x5f\xc2\x69\xa4\xcd\x49\x59\x3c\x97\x61\x8b\xc3\xdb\xc7\x5e\xad\x26\x5f\xf7\xfa\xe8\x9a\x1e\x74\x2c\xb7\x6c\xfb\xda\x0d\x60\xce\x67\x33\x5a\xb2\x51\xee\x91\xcf\x4a\x1b\x21\xbd\xc0\x47\x0c\xe8\x91\x1e\x5e\xe2\xd8\xa7\xd4\x15\x54\x7a\xf1\x92\x72\xb2\xda\x9c\xf4\x31\xc8\x61\xad\x9d\xf2\xcb\xda\x85\xe8\xf0\xa1\x8d\x5e\x4f\xe4\x15\x6f\x11\x3a\x8c\xfb\x95\x4e\x55\x3f\x22\x52\xc4\x70\xa1\x2f\x06\xd2\x43\x1c\x16\xea\xd7\x17\x8c\xf4\xad\x5b\x99\x7f\x26\x5c\x61\x8a\x4f\x69\x39\xdb\x2c\x1d\xd3\xaa\x66\x5b\xed\xc8\x15\xab\x94\xc8\xbd\x23\x55\xb8\xc0\x4c\xc6\x2a\xc3\x14\x2e\x14\xa2\x57\x84\x92\x45\xc7\xe4\x6a\xb8\x51\x00\x67\xa5\xac\x70\xa8\x92\x99\x2c\x5c\x00\x09\xe7\x9a\xba\x07\x42\x56\x78\x78\x8a\xc3\x8d\x3f\x14\x28\x99\xf9\xbd\x1b\xec\x0f\x93\x3c\xcf\x4c\xcd\x73\xd7\x8e\x72\xf3\x56\xc4\xb5\x4c\xc2\x75\xa7\x24\xc7\x48\x4a\x90\x1f\x26\x01\xd6\xd3\x23\xc3\x52\x36\x68\xc8\xab\x30\x0f\xff\x39\x1d\x7f\x40\x30\xc6\xfd\x98\x6b\x74\x18\x2b\xe1\xf9\xd7\xae\x88\x76\x70\x84\xe7\x5f\xc5\xa6\x47\x43\x90\x43\x32\x73\x8a\x96\x34\x5c\x53\x78\x2b\x1e\x77\xa8\xf6\xe5\x0a\x94\x24\xaf\x9b\x06\x6d\x16\x81\xf8\xdc\x69\x55\xd8\x38\x0f\x66\x9a\xf1\x33\x56\x6a\x73\xaf\x30\xdc\x7c\xb2\xe2\x6d\x68\x90\xba\x52\xc6\x40\xbf\x74\x06\x17\x12\xad\x56\xb2\x38\x96\x4a\x1e\x85\xc4\xc5\xbf\xb3\x6a\x54\xa9\xfa\x16\x5a\xb8\xca\x64\x7e\x60\xa0\xa9\x2f\x76\xc9\x76\x51\xb0\xf0\x34\xdc\x6c\x31\x1e\x4b\xda\x5d\x60\x5d\x5e\x48\xe3\x8c\x81\xa4\x64\x0c\x09\xab\x9d\xe5\xd5\xba\x1b\xea\x75\x46\x47\x4b\x49\x78\x80\x62\xe7\xcf\x12\x76\x43\xc6\xc2\xe4\x59\xc1\xa0\x57\x90\x7b\x0b\xd2\x63\xec\x4d\xbe\x85\xfd\x0a\xf4\x7d\x59\x34\x2f\x8e\x16\x17\x3b\x8c\x02\x63\x1a\x26\xf9\x60\xed\x0e\x61\xc6\xfc\x60\xd2\x12\x60\x45\x00\x7d\x4d\xda\x12\xb6\xde\xa8\xa1\x9e\x9e\x1a\x76\xf2\x9a\x6e\x92\x84\x17\x09\xe1\x0e\x20\x78\x88\x6b\x40\xea\xf4\xa6\x42\xf2\xf7\xb2\x4c\xaf\x03\xef\x46\x69\x61\xc1\xbd\xd7\xa3\x34\x2b\xda\xb7\xe1\xde\x8d\x44\xe2\x06\x2b\xd7\x22\xff\x63\xec\x3f\xa4\x85\xe0\x52\x25\xb9\x67\x5d\x79\x3f\x54\x29\x82\x07\x68\xa1\x19\xef\x85\xe8\x62\x3d\xfb\x22\xdb\xcd\xa5\x86\x08\x9c\xb0\x45\x4f\xdd\xb9\xbb\xf0\xbb\xa2\x76\x69\x4a\x5b\xb5\x62\xad\xaf\xa9\x92\xc9\x8a\x2f\x57\x47\xc6\x83\x79\x44\x1a\xb1\x9d\xce\x0a\x60\xc1\x13\x63\x0c\x49\xe1\xe4\x81\xb5\x0e\xa8\x77\x18\x42\x2e\x47\x63\xc3\x48\x90\x22\xe4\xc2\xa5\xb0\xf0\x3d\xba\x46\xd9\x77\xd6\x3d\x9b\x45\x40\xdf\x47\xa2\x52\x4a\xe8\xad\x84\x75\xc7\x97\xd7\xc6\x8b\x87\xbb\x23\xa8\xca\xd7\x8a\x38\x26\x7c\x64\x25\xcd\xd1\xf7\x12\xab\x40\xe1\xe9\xe7\xf3\x0b\xe4\x47\x8e\x0f\xbd\x5c\x25\x46\x13\xe9\xe9\x07\xa2\x8c\xc4\x79\x26\x7e\x37\x33\x66\x94\x41\x32\x1d\x38\xcd\x25\xa9\xd5\x4a\xd0\x7f\x2c\x39\xab\x4e\x48\x49\x6a\xbe\x80\x97\x5d\xca\x5f\x4c\xa3\xb3\x0e\x45\x03\xc8\x05\x83\x9a\xce\xf6\x29\xb4\x81\x91\x5a\x0b\xb1\xe6\x92\xed\x16\x19\x4e\x23\x1d\x74\x7f\x5d\xee\x38\xc9\xad\x83\xf7\x14\x2c\xc0\x25\x70\x69\xf9\xab\x98\x12\x4f\xcd\x39\x0a\x55\x39\xbd\x89\xbb\x7a\x5f\xcd\x0e\xb9\x3c\x5b\xf4\x94\x64\xab\x6a\xde\x24\x6f\x6c\xcc\x05\x91\x18\x4c\xf2\xc5\x46\x23\xa5\xc2\xf9\x63\x66\x66\xb5\x55\x8b\xcb\x8c\x95\xeb\xb1\x36\x46\xb2\x9b\xf4\x69\xc4\xf6\x40\xee\x25\xbf\xac\x6c\xae\xb7\x48\xc8\x7a\x60\x1e\xad\x62\x7f\x2d\xbb\xae\xcb\x56\x59\xff\x0f\x0b\x00\xe8\xe9\x54\xb4\x39\x93\xb2\x67\x93\xf4\xf6\x75\xf6\x6c\x4e\x7e\x80\xb4\x56\xfe\xe9\x34\xe4\x5b\x43\x2f\xf9\xa6\x63\x1b\xda\xe9\xbd\x6f\x24\x0f\x1e\x0e\x9f\x94\xf1\x24\x50\xb6\x04\x73\x0a\x17\x1b\xdd\x30\xd3\x2c\xc1\x5d\x0b\x15\x83\x69\x5d\x17\xf2\x0d\x06\x56\xfa\x71\x3a\xde\x33\x29\xc9\xc8\xbd\x53\x9b\x02\x67\xc8\x2d\x34\x4e\xdc\x03\xe6\x36\x36\x07\x65\x62\x14\x24\x5d\x30\x10\xb3\x98\x86\x1c\x22\x1e\x7c\x81\xbb\xec\x30\x71\x8c\x4b\x19\x8f\x19\xbd\x24\xc4\x65\x62\x9a\x94\x4d\x27\xea\xde\x3c\x03\x6b\xc4\xb6\x8e\x53\xfc\x64\x66\x9d\x44\x9d\x9f\x8e\x48\xeb\x3f\xfd\x69\x54\xf8\x96\xd5\x72\xfb\x67\x0a\x51\x58\xf8\x20\x8a\x5f\x81\x55\x23\xf3\x5c\x37\xa5\x73\xb6\xc2\xce\x3e\x8c\x1e\xc3\xfe\x98\xef\x3f\x68\xc6\x43\x12\xc9\xbe\xe2\x3b\xfb\xf1\x38\xf0\x6c\x22\xf3\xec\x95\xc8\xff\x1b\xdf\x6c\x50\x02\xf9\x36\xdc\x38\x76\x4e\x17\xbf\xe5\x5c\x69\x19\xce\x2a\xa3\x3c\x7a\x9e\x8f\x7c\xbc\x1f\x65\xbf\xb4\x7f\xc5\x81\xe4\x96\xab\x6a\x05\x6c\xef\x4e\x8d\x3d\x5b\x5b\x6f\xaf\xd0\x37\xb4\x97\x49\xf4\x41\xe9\x8a\x58\x5f\xdf\xdb\x23\x17\x83\x01\x8c\x5b\x69\xef\x10\x78\xb2\xde\x72\x90\xaf\xc4\x76\xff\x08\x8d\xd8\x1e\x04\x3e\x2f\xb6\x73\x69\xb7\x53\xab\x40\xaa\xb8\xea\x9b\x73\xfa\x6b\x76\x08\xbd\x8f\x2a\xaa\xe0\xf6\x92\x47\xcf\xb6\x40\x62\x8d\xb7\xd1\xb7\x9e\x03\x2e\x8e\x63\xc8\x65\xad\x69\x36\x15\x5c\x54\xa4\x45\x7f\x92\x69\xed\x2f\x77\x63\x5a\xd3\xee\x12\xf3\xdb\xa5\xd9\x98\x0a\x26\xb7\xdc\x48\x93\x1f\x13\xb5\xb2\x54\x7d\xff\x63\x2b\xaf\x3e\x6c\xa8\xc7\xdc\xaf\xf1\xa5\x61\x4d\x4e\x23\x87\xa8\x1e\xba\xf3\x4a\x17\x45\x4a\xfc\x92\xa9\xf3\xa0\xb4\xd1\xe4\x17\xa5\x1a\xff\x95\xea\xa4\xc5\x0d\x1d\x2c\x94\xf7\x8f\xcf\xc8\x83\x72\x27\xbe\x00\xbf\xd0\x64\x68\x3f\xd5\x8a\x44\xb0\xaa\x07\x28\x06\xfe\x2a\x5b\xad\x60\x5c\xb2\xe1\xbe\xbc\xcd\x51\xf7\xd7\xa9\x7c\x03\x45\x87\x6c\xfc\x91\xba\xfb\xe1\x3a\xf8\x5e\x5d\xdb\x58\x13\xb3\xc3\xdf\x58\xc1\xb6\x32\xeb\xa7\xd5\xaf\x31\x55\x9c\x49\xd4\xd7\x34\x2e\x45\x79\xf0\xba\xd3\xea\x2a\x45\x40\x35\x93\xaa\x13\x3b\x54\x1e\xe8\xee\x49\x5b\xbf\x82\xd4\x72\x90\x35\x28\x57\x53\xf2\x24\x63\xc8\xb4\x7f\xc0\x73\x65\x57\x49\xf1\xc4\xdd\x57\xce\xe2\x7d\xd1\x90\x89\x56\xaa\xd2\x35\xc9\xa4\x71\xd8\x50\xf4\x3b\xad\x23\x9b\x17\x29\x9a\x1c\x5f\xf0\x4e\x2a\xf3\x0c\x03\x0f\xb5\xf4\x40\xc3\x34\xb4\xc6\xd3\x1a\xe4\xb7\x9d\x25\x96\xba\x16\x73\x67\x2b\xd6\x81\xa9\x15\xac\x4b\xb1\x45\x26\x08\x6d\x42\xcc\x61\x72\x2c\xc9\x78\xe9\x9d\xa8\xc1\x40\xf9\x3c\x91\x10\x15\x09\x71\x49\x7a\xc3\x42\xa6\x0d\xbc\x83\x41\x2b\x88\x39\xf7\x8d\xb6\x26\xc2\xd0\x0e\x3e\x6a\x97\x8d\x6a\x3c\x82\x61\xb3\xa2\x4d\x33\xd4\x79\x7c\xf1\xdd\xd7\x78\xed\xc3\x75\xca\xe7\x8e\xc8\x9a\x1c\xf1\x1f\x49\x89\xf2\x0f\xb4\x2c\x42\x40\x6b\xd7\xec\x08\xbb\xe6\x0a\x83\xd7\xfd\x35\x68\x19\xab\x73\x05\xf0\x49\x10\x81\x18\x6b\x0e\x2b\x2a\xf7\x6a\x0e\xc5\x40\xc4\x31\xf5\xcc\xdb\x4f\x59\x3d\x68\x8f\x71\x0d\x94\x9f\xac\x36\x0a\x80\x43\xab\x6e\x46\xf9\xb8\x06\xad\x23\x03\x20\x73\xce\xc2\x61\xf0\x12\xdb\xba\xb7\xae\xf9\xbb\x2f\xc6\x0b\x65\x33\x54\x91\x30\x4b\xd5\xf5\x21\x89\xa9\x48\x36\xbb\xd4\xf5\xfe\x84\x52\x64\x48\x2a\x75\x5d\xc8\x23\x45\x46\x73\x49\x5d\xef\x4f\x1f\x45\x6c\xdd\x0b\x88\x13\xb2\x05\xed\x3a\x46\x1b\xa2\x76\x1b\x46\xb8\xcb\x62\x84\x69\xa3\x6d\xea\x6b\xbe\x28\x81\xd2\xfd\x5d\x16\x36\x5b\x6e\x94\x4b\x72\xd1\x89\x4b\x23\x67\x2a\x88\x1b\x18\xea\xe7\xd9\xdd\xe7\xa7\x98\x4c\x5e\x95\x94\xa7\x1b\x27\x65\xfa\x24\xf7\x1c\x36\xf9\xc9\xa5\x38\xb7\xe6\x34\x2f\x08\x02\xc4\x0f\x97\xc0\x34\xfa\x9c\xdc\x62\xda\xd9\xe4\x7d\xdd\x70\x4f\x70\x84\xd9\xb2\x54\x35\x3d\x3e\x46\x77\x52\xc7\x9a\x1d\xf1\x4f\x3d\xd2\x8a\x1a\xf2\xb8\xd1\xca\x3c\xb2\xb1\x59\xa3\xb9\x9a\x91\xe7\x23\x79\x14\x35\x76\xb9\x71\x6a\xd1\x32\xac\x33\x2c\xf5\xb1\x02\x95\x4c\x62\xe9\x59\x41\xb9\x32\x33\x52\xa8\x7b\x4d\xdd\x1a\xcc\x72\xd0\x0d\x46\x99\x44\xd0\x15\x45\xa5\x09\xa3\x71\xc3\x5a\x14\xa1\xd3\xbe\x83\xc0\x1b\x01\x79\xa2\x4d\x20\x3e\x44\xea\xa6\xbc\x79\x7c\x4c\x24\xa4\xc8\x07\xac\xc1\xd2\x26\x35\x4b\xb9\x14\x1d\x39\x45\x22\x8b\xf7\xb9\xe6\x5d\x08\x2e\xa2\x2d\x11\x1b\xc5\xd7\x5c\x2a\x5e\x39\x6c\xc5\xb0\x8e\x5c\x92\x35\xad\x19\x72\xbb\x5e\x99\x2b\xc1\x6b\xd2\x51\x4c\x4f\x8b\x61\x75\xe9\x18\xea\x7a\x26\x5d\x79\x8b\x96\x6d\x6d\xa5\x8d\x48\xe1\x8a\xef\xba\xc3\x7f\x05\x07\x17\x26\x61\xd2\x6b\x98\x53\x1b\xe0\xec\x35\x17\x63\x4a\x36\xa2\xf3\x59\x04\x93\x33\x42\x16\x23\x3d\x81\x0d\xe5\x23\x07\xe4\xa1\xd5\x45\x10\x9f\xb0\x2b\x22\xe0\xaa\x2b\x49\xe7\x4d\x35\x39\xe2\x5d\xda\xfb\xa6\x49\xcb\xb6\x60\x52\x77\x5b\x3e\x49\xff\x77\x58\x13\x24\x4e\x60\x9b\x39\x63\x0f\x29\x84\xf1\xf8\x71\xe6\x25\x78\x98\x75\x71\x8f\xfa\x1f\x27\x52\x2c\x85\x5e\xb9\xca\x1a\xfa\x66\x12\x9b\xb1\xc7\x22\xb0\xfc\x1c\x41\xd6\xe5\x8c\xdb\xd7\x2d\x3d\xec\x0e\xfd\xa3\xe8\x6a\xd6\x19\x3a\xdb\x4c\xf3\x71\x34\x94\xff\xaf\x58\xb1\xb0\x56\xc2\x1b\x84\xa2\x06\x41\x48\xbf\x92\x23\x55\x99\x82\x20\xc7\xec\x73\xbd\xeb\x4c\x70\x50\x14\xd9\x16\x86\x6f\x05\xa1\x5b\xa5\x4f\xf7\xf2\x66\xd0\x71\x2b\xe8\xe1\xf8\x85\x23\x67\xe2\xa4\x84\xa2\x0d\x54\xe1\x3a\xb5\xa7\x74\xc6\xc8\x82\xb9\xb0\xa1\xd9\xc3\xfb\xa0\x36\xec\xbf\xc9\xf8\xb6\x98\xf8\x6b\x9a\x21\x75\x4f\xc9\x96\x23\x70\xd6\xa4\xd5\x28\xa2\xeb\x48\xe2\x5b\x85\x88\x5f\x8e\x81\x07\xc6\x01\x8b\x5c\x78\xb1\x23\xbc\x95\xa6\x44\x9d\x79\x47\x60\xaa\x50\x10\xc8\xea\xaa\xef\x74\x77\xee\xdc\xb1\x1e\xcc\xb1\xfa\x0f\x26\xda\xc5\x36\x6f\xc4\x16\x6c\x55\x77\xee\xdc\xc1\xcb\xb7\xfe\xe1\x21\x42\x79\xa7\x7f\xbd\xa3\x89\xba\xe6\x35\x39\x25\x93\x46\x6c\x3f\x03\x53\xe4\xf1\xe7\xfe\xa7\x03\x0b\x4f\xac\x79\xfd\xf6\x2e\xf4\xe3\x8b\xb8\xdb\x29\xe1\xa5\x0b\xf2\x07\x18\x16\xec\x7d\xcc\x2b\x0a\x93\x59\x83\x82\x41\x21\xef\x5f\x30\x81\x53\xc9\x0c\x1e\xe1\x04\xf4\xec\xee\x98\x25\x58\xf3\x1a\xfe\xed\x62\xad\xe0\x23\x12\x5c\x13\xf4\x33\xf2\x00\xbf\xdf\x85\xff\x49\xb6\x4c\x76\xf5\x90\x0f\x26\x54\xcd\xf5\x4a\x1d\x25\xd6\x9b\x98\xa1\x2e\x6d\x3d\x64\x2c\xf8\xa2\xb7\x46\x37\xc4\x7a\xc6\x57\x1a\xb0\x6f\xed\x63\x9d\x69\x94\x1f\x26\xd7\xb4\x64\x0d\x2b\xc3\x36\x81\x4e\x7a\x62\x27\xa3\x56\x6a\xe4\x07\x03\xa2\x90\x8f\x34\x7f\x9f\xb8\xe9\x5d\x42\xcb\x97\x57\xe6\x88\x9d\x07\x82\x25\x9f\xc9\xd9\xf6\x79\xc6\xea\xbe\x52\xba\xcf\x20\xb0\xee\x1f\xd8\xff\x83\xee\x17\x07\xa4\xa7\xbd\x3e\x28\x6b\x6a\xe9\xde\x99\xb7\xe3\x8c\x70\xa0\xcb\x29\xeb\xcf\xbe\xa4\x89\x59\x75\x3c\xd2\xbc\x29\x91\x3b\xa9\xd8\xda\xbb\xb1\x18\xb3\xc4\x60\x5d\x30\x96\x05\x0b\x21\xca\xb9\xba\x23\x67\xcf\xd2\x70\x2f\x95\xd1\x06\x16\xa6\x0a\x12\xd8\x93\xad\x88\x45\x9d\xc0\xbc\x06\x89\xca\xa4\x98\x70\x33\x74\x6e\x53\xb2\xa0\x10\x6a\xd4\x75\xe1\x7b\xce\xdb\x5a\x4a\x32\x14\x29\xd5\x61\x57\x01\x54\xfb\xbc\x58\x28\x52\xad\xa0\x08\xba\xbe\x07\x04\x37\x00\x7d\x40\xd9\x72\x18\x9d\x56\x0d\x9d\xae\xdf\xec\x6c\xd1\x31\x7c\x7c\x80\xc9\x61\xe1\x4a\xc0\xdb\x65\x62\xa9\x71\x55\x1b\x20\x5d\x64\xee\xa2\xe3\x94\xcd\x9f\x56\xb5\xf4\xd5\x3b\x78\x7d\xcc\x6b\x73\x28\xec\x51\x31\x33\x25\x01\x72\x3a\xa6\xbd\x58\x1c\x12\xe4\xaf\xf1\x31\xf7\xfc\x1c\x5b\x87\xe8\xb9\x7b\x3e\xbe\xfe\x73\x25\xa6\xec\xad\xee\x87\x89\x43\x67\x08\xf2\xbf\x49\x6e\x7f\x23\x0d\x50\x6d\xb2\x62\xe4\x17\x9e\x2e\x3d\x45\xff\xc3\xd3\xa6\xdb\x15\xfc\x49\x8f\x8d\x5b\x99\x9f\x7e\x6a\xb9\x9f\x12\x73\xc9\x94\x6e\x35\x99\xce\xf8\x2d\x12\x93\x67\x00\x7e\x9c\x04\xe5\x87\x5e\xb1\x32\x08\x14\x64\x0f\xaf\x8f\x4c\xbe\x5a\x75\xad\x67\x0d\x29\xf0\xa6\xfe\x1b\xe8\xe1\x7f\xe1\x7f\x8e\xbd\x1a\x0c\x43\xfd\x05\x28\xdf\x87\x16\xb6\x5f\xc9\x6c\x0d\x86\x6c\xfe\xdc\xb4\xee\x42\x7c\xdf\xf6\xa5\xa2\xde\x0c\x00\x10\x8b\x1b\x60\x09\x05\x1c\xca\x96\xae\x35\xfb\xf6\xe1\xa7\x11\x98\x47\x06\xfc\xb1\x69\x77\xbc\xb0\xdf\x71\x94\x80\x7a\xc3\x16\x7f\xd3\x62\x6a\x1a\x61\x77\x71\xb2\x87\x6b\xb6\x00\xb3\x00\x94\x72\x46\xec\x3c\x95\xd9\x62\x6b\x0b\x4c\x0c\xc5\x25\x3a\x61\xe2\x11\xcc\x31\x0f\xc7\x15\x57\xab\xba\xa3\x5b\xa4\xab\xb9\xf0\xde\x92\xb2\x5b\x03\x0b\x48\x8b\xc9\x11\xbc\xca\xce\x23\x56\x8d\x1b\x10\x19\x5e\x08\xbe\xe8\x5b\xf0\xa4\x20\x34\x3b\x85\x29\xf9\x85\xac\x40\xb0\x35\x9c\xc2\xe5\xd6\xc5\x92\xc9\x91\x08\xff\xbf\x96\x96\xf7\xf2\xc5\x8b\xcc\x7a\x19\xa2\xd3\xa6\x29\xd5\x73\xfa\x28\xb5\x9c\xca\xa9\xa4\x47\xd2\x48\x8f\xa5\x90\xde\x5b\xbb\x69\xa4\x6e\xd3\x48\xcd\xa6\x84\xc2\x68\xd7\x00\x95\x6a\x48\xb4\x69\x0f\xd5\x7b\xb3\xfd\xe5\xaf\xbc\x02\x37\xa1\x6c\xf3\x8a\xd4\x44\x42\x2f\x57\xa4\xa9\x94\x00\xab\x90\x7c\x71\x7f\xce\xc9\xd0\xf7\x03\xc4\xc2\x5a\x31\xee\xc3\x74\x8c\x4f\xd2\x8c\xe1\xff\x5d\x4b\x6c\x97\xf0\xd0\xec\xe0\xb6\xe8\xf6\xe8\x42\x06\xd4\x38\xac\xb8\xd6\x68\x05\x8c\xd2\xc2\x1d\xb4\x78\x07\x2d\x60\xe6\x08\xf5\x44\xf0\x93\xa6\x11\x5b\x49\x7a\xc9\x3a\x2c\xf4\x16\x54\x61\xb7\x71\x03\x25\x73\xb8\x7d\x84\xbb\xa2\x57\x18\xa6\x0b\x17\x17\x57\x16\xa1\x95\xfc\xaa\x54\xe4\xe1\x63\x25\xb8\xbb\x75\x96\xbd\xa8\x12\x81\x4d\xa1\x0a\xf5\x2c\x4d\xe6\x57\xe5\x6a\x13\x80\xe1\x57\x0b\x72\x56\xa3\xb6\xae\x46\x6b\x88\x17\xb2\x07\x9d\x63\xc6\x8f\x0e\x6f\x52\x3e\xa9\xb9\xf4\x72\xce\xaa\x15\x5b\x4b\xd6\x5c\x31\xf7\x2e\xdb\xe6\x19\x0a\xae\x75\x63\x23\x7d\xd9\x2b\xef\x35\x76\xc6\x33\x25\xab\x8e\x6f\xa0\x3e\x47\xe4\x70\x40\xa7\x4b\xb8\x82\x41\xbe\xe0\xcc\x32\x06\xb9\x05\x9d\x5f\xc2\x15\x49\x81\x83\x4a\xba\x7b\x7a\xae\xaa\x7f\x6e\x81\x62\x49\x32\x9e\x2b\xd7\x88\xf5\x6c\xca\x3f\x7f\x7d\x9d\x4a\x80\xff\x71\xd3\xfd\xff\x53\x66\xec\x25\x65\x29\x6a\x3c\x35\x83\x95\xfc\xba\x70\x34\xed\xd7\x75\xc0\x78\xeb\x01\xf2\x1f\x16\xdb\x88\x1f\xef\xf3\x8d\x4f\x3a\xcf\xa9\x64\xcd\x91\x9e\xbc\xd1\xf7\x8c\xf0\xa1\x4b\x98\xcd\xd4\x63\x46\xd8\x10\x7e\x4a\x32\x53\xdf\x3f\xb5\x9a\xb8\xe2\xda\x36\xa3\x96\xd7\x35\x17\xc0\x32\x58\x36\xa4\x5f\xfc\x3d\xf1\x46\xbb\xe1\xec\x95\x7c\xc1\x5b\x4c\xbf\x13\x9a\x8b\x98\xa2\x30\xa2\x2d\xe3\x87\x72\xc2\xe6\x08\x33\x6a\x6a\x2c\x74\xf6\xba\xfe\xe2\xc9\xc4\xfb\x6d\x9e\xd8\xad\xdc\xb4\x87\xac\x5f\x86\x66\x3e\x3f\xf9\x89\xbd\xe2\x12\x7d\x81\x2b\x30\xfa\xf8\xf8\xc6\x7b\x25\x1c\xa0\xc4\x12\xd8\xde\xe8\x82\x2f\x44\x77\xf6\xcc\xaf\xfc\xd3\xb7\xf7\xf5\x7d\x54\xe9\x35\x3a\xc4\xa0\x50\xe6\x98\x21\x36\x62\x80\x63\x1e\x46\x42\x1a\x26\x25\x88\x31\x29\x00\x89\xa4\x5d\x03\xde\x2e\x04\xa1\x17\xfa\x9c\xf3\x03\x2c\x68\x5b\x13\xc9\x18\xd9\xe2\x0b\x4d\x53\x93\x5f\xaf\xfc\xad\x57\xda\x9b\x42\xba\xd8\x4f\x6e\x49\x8b\xc3\x78\x21\x5d\x84\xc9\x8f\x24\xe0\x86\xac\xe6\x5e\xe2\x89\x02\x6b\xf1\x7a\xfa\x78\x16\x0c\x35\xc9\x49\x88\xa7\xb9\x82\xed\xbe\x98\x38\x7b\x26\x33\xa4\xf4\x83\xef\x20\xe8\xce\xba\x16\xf2\x3b\xe7\x36\x40\x4a\xfb\x28\x87\x31\xbc\x86\x45\xf2\xbd\xbd\xcd\xd6\xc9\xc3\xb4\xe4\x1a\x45\xc7\x44\x43\x04\xeb\x87\xbf\xdd\x6a\x13\x7b\xe0\xf6\x8a\x74\xf9\x42\x74\xe7\x7c\xcd\x16\x1d\x5d\xb3\xc3\x17\x8d\x76\xbe\x3e\x15\x27\xa2\x04\x60\xf1\xb6\x92\x8a\x76\x2a\xca\x07\x09\xbf\xe5\xb3\x42\xea\xe1\x83\x8d\x65\x94\xcf\x36\xce\x29\xc9\xda\xfa\x40\x08\x07\x73\x53\x3a\xbb\x30\x2f\xe3\x30\xbf\xf8\xe0\x1f\x21\xee\x24\x26\x80\xcd\xa0\x17\x4e\xc9\x29\x3c\xef\xac\x7e\xf6\x4e\xf3\xc4\x3f\x0d\xcc\xf9\xfe\xfd\x07\x4a\xf6\x71\xb4\xc2\x7f\xc7\xe8\xf9\xff\x2a\xaa\x40\xfb\x72\x51\x66\xf2\x40\x1f\x96\x82\xf2\x96\xd5\xa0\x6e\x51\x09\xea\xe6\x9b\xee\xc0\x9c\x9b\xb9\x22\x0c\xa5\x9c\xc2\x05\xed\x52\x0b\x1b\xf0\xde\x6a\x91\xf5\xce\xe1\x83\xbf\xdd\x8a\x39\x2c\xb8\x9c\x31\x35\xbc\x4d\x60\x6e\xfd\x40\x4f\xfb\x15\x5e\x86\x5c\x07\x5b\xbb\x10\x8f\x5c\x7d\xc4\x4a\x38\x6f\x43\xfd\xca\x33\x15\x1a\x8b\xdb\xd1\x30\x26\x24\x85\x82\xe2\xe6\xf8\x09\x4f\xfc\x0b\x1b\x98\x56\xdb\x72\xf1\x02\x8e\xf4\x95\xd8\x9a\xe7\xa6\x4e\xff\xc2\xfc\x92\x5f\x7f\xe9\x20\x9e\x2d\xdc\x87\x96\x37\x47\xa1\x37\xd0\x8a\xbd\x13\x93\xf9\x37\x43\x70\xaf\x00\xcb\x8f\x89\x49\x24\x77\x3d\xb1\x29\x2a\xb9\xd4\x5d\xcf\x3b\x7e\xc5\x69\x83\xc5\x5b\x22\x47\x9a\x59\xa5\x13\x2f\xed\xb0\xe7\x5b\x05\xde\x45\x2a\x18\xdf\x8a\xb3\x50\x6a\x60\xe7\x2c\xe6\x73\xfc\xf8\x46\xb2\x1a\x23\x99\x4b\x46\x52\x4d\x52\xd7\x33\xdb\x42\xd2\x2b\x06\x18\xdf\x3b\x22\x4a\xcc\x7d\x34\xa6\xa5\x31\x9f\xc0\xc2\xdc\x7a\xc8\x46\xd0\xfa\xa1\x23\xac\xb5\xc9\x06\x03\xc7\xdc\xad\xaf\x4f\xaf\xb1\x01\x78\xe7\x2b\xd1\x5e\xb1\x4e\xe1\x9e\x36\x1f\xbe\xdc\x29\x26\xcf\x05\x2e\xd2\xd7\x6c\x49\x2f\xf4\x0f\x93\x18\xef\x5c\x80\x54\x42\xcf\x69\xf9\x4c\xd7\xbc\x12\xae\xf7\x8f\x10\x43\xa9\xc5\xd4\xb3\x94\x67\xbe\x14\xa2\x09\x85\x9b\xcf\xa5\x58\x16\xf3\x88\xc8\x4b\x8e\x41\x20\x97\xad\xd8\xb6\x44\xae\x69\xd3\xdc\x97\x70\xab\xd9\x74\x7c\xcd\x21\xdd\x9f\xda\x6d\xfc\x54\xcc\x26\x3b\x52\x8d\x85\xbd\x7d\x14\x72\x4e\xdc\xdd\x46\x33\x89\x6e\x3c\xf8\x95\xd2\xc4\x8c\xdd\x05\x57\x1d\xed\x76\x66\xec\xb6\x5f\x5f\xb0\x4e\x5a\x95\x1c\xf6\x5f\x92\xc8\xee\x35\xe8\xba\x3b\xe7\xa1\x5f\x33\x2a\xfb\xce\x7f\x89\x60\xb0\xd5\x38\xe8\xad\xd2\x5f\xe8\xff\x9a\x88\xc5\x9c\x80\x8f\xf0\xac\x55\x8f\x7c\x77\x8f\xfd\xfb\xd3\x9f\x8a\x5d\xde\x98\x3e\xe5\x7c\xc1\x0b\xda\xc8\x28\xa9\x66\x9a\xe1\x4c\xac\x59\x4c\x5f\xd0\x0b\x14\xae\xac\x16\x2f\x9a\x10\x07\xcf\xe4\x1b\xa0\xd7\x4d\x27\xa3\x59\xe4\xa6\x7d\x9e\xa0\xa7\xf1\xa6\xdd\x9e\xae\xa8\x66\xf8\x9b\xe3\x38\x38\x0f\x0a\x64\x27\x7e\x5e\xa3\x38\x9d\x69\x42\xf9\xe7\x57\xac\xdb\x61\x96\x60\x08\xbd\x6a\x21\x7b\x24\x9a\xf4\xf2\xfc\x93\x5d\xd6\x20\x3c\x85\x3c\x1b\x64\xff\x9c\xb4\x02\x5f\x87\x28\xc8\xef\x2b\x35\xc4\x59\x2c\x55\x1c\xa2\xb9\x7d\xce\x5b\xae\x82\xe7\x2c\x78\xc2\xe6\xa5\x74\x74\xf0\x86\x82\xd3\xfe\x4a\x1e\xde\x27\xb6\x72\x73\xd8\x7e\x12\x8a\x5a\xd1\x0c\x9f\x74\xa7\xb2\x14\xfd\xed\x93\x76\xf7\x8a\x49\xd1\x77\x15\x73\x82\x34\xc2\x73\x9a\x98\x92\x7c\xf8\x07\x9c\x0e\x0f\xef\xbb\x29\x98\x33\xa2\x38\x42\x44\xac\x70\x96\xf1\x89\x51\x59\x9e\xe2\x4c\x5a\x70\xa1\x2d\x9f\x4b\xd9\x33\xf4\x56\xd9\x18\xe9\x4f\x23\xd2\x3d\x9a\xe4\xb1\x79\x7f\xf7\xfd\xff\x0b\x00\x00\xff\xff\xef\x3f\xdd\xab\xd1\x16\x01\x00"
-
-func flowtransactionschedulerCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_flowtransactionschedulerCdc,
-		"FlowTransactionScheduler.cdc",
-	)
-}
-
-func flowtransactionschedulerCdc() (*asset, error) {
-	bytes, err := flowtransactionschedulerCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "FlowTransactionScheduler.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x53, 0x3d, 0xd1, 0xcc, 0xdc, 0x43, 0x23, 0x3a, 0xc0, 0xa4, 0x82, 0x8e, 0xb8, 0xdb, 0x4b, 0x48, 0x7d, 0xda, 0xf8, 0xfc, 0xb5, 0x7b, 0xca, 0x8d, 0x12, 0x32, 0x5e, 0x1f, 0x8a, 0xfd, 0x9, 0xf0}}
-	return a, nil
-}
-
-var _flowtransactionschedulerutilsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3d\xef\x73\x1b\xb7\xb1\xdf\xf5\x57\x40\xfe\xe0\x90\x1d\x9a\x4a\x67\xfa\x3a\x1d\x8e\x69\x45\x96\xed\x46\xd3\xba\xca\xc4\x8a\xfb\x41\xf1\x74\x20\x1e\x48\xe2\xf9\x08\x5c\x0f\x38\x51\x7c\x8e\xff\xf7\x37\x58\x00\x77\xf8\x79\x3c\xca\x96\xeb\xa4\xd1\x4c\x53\x93\x04\x16\x8b\xc5\x62\x7f\x61\xb1\xa0\x9b\x8a\xd7\x12\x3d\x7a\x55\xf2\xed\x55\x8d\x99\xc0\x0b\x49\x39\x7b\xb3\x58\x93\xa2\x29\x49\xfd\xe8\xa8\x6d\xd1\xb0\x15\xbd\x29\xc9\x15\x7f\x4f\x98\xf3\xb5\xea\xe8\x7f\xf5\xf2\xed\xeb\xee\xc3\x6b\x22\x71\x81\x25\x7e\x4b\xc9\x56\x3c\x3a\x3a\x3a\x39\x39\x41\xb9\xc1\x7e\x92\xb4\x14\xa8\xaa\xf9\x2d\x2d\x88\x40\x8d\xa4\x25\x95\x3b\xb4\x6c\x18\xb4\xc3\xfa\x13\xaf\xd1\x96\xd7\xef\x29\x5b\xa1\x2d\x95\x6b\x24\x4c\xf7\x02\xc9\x0e\xa6\x80\x81\x38\x43\x72\x4d\x60\x3c\x74\x53\xf2\xc5\xfb\xc5\x1a\x53\x36\x55\xbf\xc1\xef\x17\xfa\xf7\x65\x23\x9b\x9a\x4c\x90\x5c\x53\x81\x16\x9c\xc9\x1a\x2f\x24\xda\xd2\xb2\x44\x37\x04\x35\x55\x81\xa5\x82\xce\x11\x65\x8b\xb2\x29\x08\xda\xf0\x9a\x04\x68\x01\x3c\xc9\xd1\x06\xbf\x27\x88\x4a\xdd\x64\xc1\xd9\x2d\x61\x94\x30\x39\x14\x6d\x68\x77\x8b\x6b\xca\x1b\x81\x1a\x41\xd0\x02\x0b\x22\x34\xc6\x78\xb1\x20\x42\x8c\x70\x59\x8e\x3b\x2c\xfb\x69\xf9\xe1\xe8\x08\x21\x84\x14\x6e\x6f\x24\xaf\xf1\x8a\xa0\x0a\xcb\x35\x8c\xf2\x1a\x33\xbc\x22\x35\xaa\x89\xe0\x4d\xbd\x20\x02\x9a\xba\x83\x94\x44\xa2\x8d\x6e\x65\x7a\xff\x80\xe5\x7a\x86\x9c\x0f\x1d\xfc\x1f\x9a\x9b\x92\x2e\xee\x09\x5e\x77\xd6\xd0\xbb\x7f\x77\xc0\x5f\x32\x49\x65\x49\x36\x84\xc9\x18\x0e\xe9\x7e\x44\x97\x5b\x46\xea\xae\xdf\xf7\x98\x15\x25\xa9\x2f\xd8\x92\x23\x2a\x10\x46\x42\xd6\xcd\x42\x22\xb9\xc6\x12\x09\xc9\x6b\x22\x10\x65\x4b\x5e\x6f\xb0\xa2\x1e\xc2\x37\xbc\x91\xaa\x19\x65\xab\x92\xb8\x2b\x83\xd6\x1a\x54\x0b\x1a\x40\xac\xb1\x40\x37\x84\x30\xb5\x54\xc0\x21\x76\x55\xbd\x45\x9d\xb6\x9d\x2e\xa4\x42\x03\x06\x2e\x10\xd5\xdc\x67\x48\xf0\x8d\xb0\x43\x28\x6c\x05\x2a\xa8\xe6\xae\x7a\xe7\x75\x37\x48\xab\x8e\x72\x57\x11\x44\x0b\x35\xfd\x25\x25\x35\xe2\x4b\xf8\xda\x40\x99\xe8\x36\xce\x0c\x2e\x5e\x08\x8b\xf5\x2d\xd1\x68\x77\x4c\xa8\x96\x8c\xca\x49\x3b\x14\x66\x05\xc2\x68\x81\x2b\x7c\xa3\x77\xa1\xe4\x2e\xf4\x0e\xa7\xab\x35\x71\x9b\x51\xd1\xd2\xe2\x86\xd7\x35\xdf\x22\x8c\x6a\xb2\x24\x35\x61\x0b\x12\x00\x41\xdb\x35\x61\x88\x11\x52\x90\xc2\x07\x18\xa2\x8d\x6b\xd2\x82\x55\x7c\xff\x3e\x9c\xdc\x9e\x99\x65\x31\x0f\x69\xe8\xa0\x5f\xd0\x25\xa0\x2d\x29\x96\x0a\xa8\xdc\x2a\xb8\x06\x8a\xb0\xd4\x16\x78\xa3\xa1\x4c\x23\xbe\x34\xbc\xe6\xf2\xe0\x07\x68\xd4\x87\x80\xbf\x88\x6d\xf3\x70\xdf\xa8\x6e\x17\x6d\x2f\xb5\x25\x6b\xca\x56\x47\x31\xf8\x43\xd6\x7f\xd0\xc0\x1d\xc0\x8b\x17\x62\x86\xae\x7f\xba\x60\xf2\xcf\x7f\x7a\x17\x0f\x9d\xe5\x9d\x10\xb6\x95\x65\x7a\x80\xae\xdb\x0c\x9d\xb7\xff\x7e\x8a\x1b\xb9\x1e\xe5\x84\xdd\xf4\xe5\x1d\x59\x34\x92\x8c\xd1\xe3\x0f\xd9\x36\xce\x97\x66\x49\x3e\x3e\xeb\xb0\xa6\x8c\xca\x51\x9a\xaa\x93\x2f\x8b\xd3\xd8\xe1\x12\xf5\x27\x48\xb9\x9c\xfa\x98\xa1\x79\xc0\x00\x71\x07\x87\xfa\x73\x07\xff\x04\x64\x6f\x41\xd1\x1c\x5d\xbf\x6b\x1b\x7d\xf4\x57\xf5\xac\x50\x32\xc1\x67\xa9\x60\x6d\xbf\x11\x21\xcb\x79\x10\xbe\xab\x70\x8d\x37\x88\x16\x33\x60\x91\x8b\x17\x96\xdf\xdd\x4e\x92\x23\x5c\x14\x79\x2e\x59\x36\x4c\x35\xb8\x72\x11\x1f\x29\x90\x9a\x15\xd3\xf4\xf3\x66\x39\xc5\x55\x45\x58\x31\xa2\xc5\x38\x37\xd7\x1f\xc9\x86\xdf\x92\x78\xba\xcb\x9a\x6f\x1e\x60\xc2\x35\x0c\xd7\x3f\x67\xdd\x66\xe8\xb4\xd5\x5e\xa2\xac\x20\x77\x68\x9e\x24\xc1\x92\xd6\x42\x5e\xa8\x06\x23\xbe\x9c\x21\x97\x14\xb0\x1f\x96\xa6\xf7\xf1\x1c\x31\x5a\x06\xc0\x73\x74\xd5\x28\x8e\xb0\x9c\xe9\xde\xc7\x3e\xd0\x8f\x39\x6a\x3f\x37\xaa\x82\xa1\x86\x3d\x31\xfa\xbc\xc8\xea\x0d\x9f\xc2\x35\x91\x4d\xcd\x66\xe8\x2c\xdb\x7e\x82\x78\x0d\x93\xa0\x4b\xc4\xb8\xb2\xc5\x1a\xd6\xc3\x5f\xb7\x94\x6c\x81\xe0\x5a\x81\x8d\xc6\xb3\x03\xb7\xf0\x69\x40\x2d\x8d\x61\xb8\x2f\xa7\x16\x3c\xc2\xe2\xf4\xc0\x11\x8e\x7c\x8a\x7e\x3c\xf2\x94\x5a\x68\x7a\x21\xae\x34\x99\x32\x7f\x1c\x83\x74\x8b\xb5\x2d\xdd\x08\xf8\x89\x15\xa8\x20\xb7\xa4\xe4\x95\xfa\x28\x79\x0b\x70\x55\xf3\xa6\x9a\xb4\x9a\x42\x09\x43\xb6\x20\xe5\x04\xba\xfc\xbb\x21\xf5\x2e\x67\xca\xca\x75\xcd\x9b\xd5\xba\x33\xa7\x2c\x3e\xd3\x24\xb6\x54\xa0\x82\x2c\x29\x23\x05\xc2\x0a\x21\x44\x99\x24\xf5\x12\xeb\xe5\xc4\xa5\x32\xe2\x15\xc2\x9b\xa6\x94\xb4\x2a\x09\xa2\x9b\x4a\x5b\x7d\x58\x8f\x67\x76\x96\xb1\xa7\x3c\x4b\x46\x59\x66\x4d\x05\x1e\x49\x53\xad\x6a\x5c\x10\xa3\x0a\x37\x78\xa7\x6c\x7c\x6d\x83\x58\x8b\x4c\xfb\x03\x46\x0e\xa9\xff\x51\x6d\xe7\x83\xf1\xa5\x6c\xe8\x25\x25\x65\xa1\x89\xe6\x79\x01\xdd\xc4\xda\x7f\xfc\x8d\xec\xd0\x92\x60\x05\x50\xcc\xda\x6f\x9f\xa0\xcb\x7a\x85\x19\xfd\x3f\x22\x1c\xea\x29\x78\x44\x6b\x8d\x80\x94\x37\xbb\xd6\x66\x02\x83\x01\xe6\x44\x37\x44\x48\xbc\xa9\x1c\xa8\x6f\x14\x4d\x94\x46\x28\x2c\x58\xe5\x6f\x38\x84\x54\x73\x56\x6e\x88\xd0\x4e\x48\x55\x93\x5b\xe5\x6a\x94\x3b\x63\xf6\xc4\x06\xaf\x70\xc0\x5f\xb9\x42\x4b\x99\x61\x0a\x7a\xcb\x07\xea\x43\xcb\xde\x94\xb8\x58\x4f\x3a\x6c\x35\xe3\x5c\xbc\x70\xc0\x1a\xae\x46\x1b\xe3\x2b\x42\x0b\xd8\x85\x8a\x63\xca\x06\xc6\x33\xeb\x17\x59\x59\x2d\x93\x77\xb3\xb4\x1c\xf5\xc1\x17\x32\x76\x47\x89\x40\xaa\xdf\xec\x50\x85\x85\xe2\x51\x58\x7d\x5c\xaf\x1a\x70\x34\x50\x41\x6b\xb2\x90\xe5\xce\x83\x62\x44\x4b\x6e\xb7\x76\x1e\x80\xe5\x8c\xc0\x30\x52\x6e\x48\x29\x38\x12\x6b\xde\x94\x85\xb6\xe7\x01\x62\xec\x87\x04\x7a\xc2\x03\xa4\x48\x64\x59\x22\x72\x24\x34\x7f\x86\x12\x0e\x7c\x23\xad\x4a\x2c\x92\x23\x4f\x4e\x19\x78\xe7\xb8\xfa\x02\x66\xce\xc4\x1b\x5a\x2d\xfb\x0c\x9d\xb1\xdd\x1b\x30\x9a\x4f\xfd\x5f\x5b\xe6\x99\xa1\x9f\x5e\xd1\xbb\x3f\xff\xc9\xff\xb9\xaa\x29\xaf\xc1\x38\xcb\x62\xf0\x83\x69\xe2\x77\xd4\x9b\x8d\x72\xf6\x72\xb9\xe4\xb5\xb4\xaa\xd4\x6f\xb4\x24\x44\xcc\xd0\x77\x6d\xa0\x63\xfa\x16\x37\xa5\x6c\x9b\x8c\x6d\xaf\x61\xcc\x06\x3b\xb0\x11\xf0\x7d\xb8\xfb\x52\xea\x0d\x18\xc6\xf0\x8a\xe6\x9b\xff\x28\xc3\x3c\xdf\x99\x05\x4c\x72\xce\x55\xda\x7e\x4e\x35\xfd\xe9\xa7\x8b\x17\x96\x72\xa7\xbf\x71\x5e\x38\x07\x8d\x09\xe1\x87\x94\xa6\x54\x02\x88\x4a\x61\x65\x62\x76\xe5\xb5\x71\x35\x6c\xe9\x3b\x3b\xf5\x80\x45\xd6\x9a\xdd\xb5\x29\x7b\xe6\x9a\xf4\x10\x5b\xd3\x69\x45\xa4\x43\xfb\x17\x58\xe2\xd1\xbf\x90\x07\x78\x88\xb0\x50\xfd\x4e\xfb\x07\xd2\x46\x54\x2c\x60\x5e\xf1\xfa\xe2\x45\x38\xe6\x81\x66\x5c\x72\x64\x33\xbb\xef\x53\x1c\x2f\x94\xad\xf8\x41\x73\x7d\xe7\x1a\x7f\x1c\x32\x03\xbb\xad\xfa\x77\x52\x72\xf7\x3c\xc8\xc4\x20\x5e\x7b\x4f\x6c\xae\x55\xfb\x77\x69\x0e\xd1\x8e\x8c\xe0\xe5\x2d\x71\x46\xba\xcf\x40\x13\x20\xa1\xea\x31\x43\xea\xbf\x63\x57\x66\xf4\xf0\x68\x62\x9e\xaf\x6a\xbe\xf1\xfd\xaa\x80\x71\xf4\x8c\x0e\x98\x50\x3f\xc4\x7e\xd4\x0f\xd9\x59\x17\x2f\x80\xe7\xda\x28\xcc\x61\x5d\x3b\x69\x7e\xcf\x85\xbe\xef\xb0\x57\x56\x88\x8f\xfe\x15\x0b\xf4\x7d\x80\xf7\xc1\xfc\x11\xb3\x15\x19\x09\x89\x6b\x79\x15\x29\x0b\x44\x58\x11\x7d\xab\x76\xad\xfe\xd7\xe0\x5d\xeb\x23\xf0\x46\x62\xd9\x88\xd1\x30\x01\xa7\x1b\xef\x91\x6b\x2b\x22\xdf\xf0\x5a\x92\x0e\x59\x58\xe8\x3c\xd0\xa0\x71\xe8\x17\x46\x3e\x21\x15\x68\x43\x30\x93\xca\xa0\x35\x47\x2e\x69\x5f\x10\x1c\x05\xe5\xc3\x29\x77\x4b\xb9\x8d\x5d\x08\x9c\x6b\xef\x50\x07\x42\x73\x4e\x20\x06\xe5\xb4\x43\x7c\x0b\x2e\x1d\x47\x9c\x11\x54\x95\xc6\xaf\x73\xcf\x4b\x5a\xc0\x8e\x9b\xea\xc4\xd4\x4f\xb4\x7e\xf2\xe1\x2b\x64\x57\x10\xea\xd8\xa3\x11\x85\x1f\xb9\xc7\xb5\x55\x8d\x45\x2a\xbe\x6e\x09\xeb\x46\x99\xba\xa3\x0c\x65\x40\x61\x27\x42\x6f\x8c\x2b\x81\xb8\x5c\x93\xda\x55\xb4\xd6\x63\x68\x63\xd6\x19\x32\xdd\xec\xba\x7d\xe0\x9a\x6a\x16\x48\xde\xed\x31\x0b\xfb\xf6\x8f\x33\xc7\xef\x71\xed\x88\x17\x1d\x9a\x6a\x72\xca\xcd\xe9\x41\x62\x4d\x68\xed\x5a\x22\x66\x4c\x41\xca\xe5\x18\xdd\xe2\xce\xbf\x71\x29\xa3\x6c\xa2\x0f\x9a\xf1\xfb\x58\x34\xd1\x33\x88\x03\x69\x2e\x46\xb8\xae\xf1\x0e\xfc\xf9\x96\x9f\x2d\x23\x29\xb6\x35\xd3\x54\x04\xf7\xf0\x77\x3c\x69\xd9\x33\x81\x60\xa7\x1c\xb2\xa9\xf6\x11\x56\x42\x8c\x4f\x24\x82\x7c\x3e\x76\x08\xa3\x15\xbd\x25\x2c\x70\xe2\x23\x7c\x21\x88\x57\xb8\xc2\x2d\x29\xa9\x86\xa0\x65\x6d\x7f\x25\xc3\xf5\x31\x63\x2e\x6e\x19\x61\xe0\x48\x7f\x85\x8a\x2b\x77\x15\x3a\x66\xdd\xf5\xff\x1f\x84\x4c\x6e\x55\x7b\xce\x46\xb1\xd4\xf2\x83\x53\x26\xa3\x23\x07\xd8\x78\xfe\xc1\x8d\xd8\x7f\x72\xd3\xf1\xbd\x9a\x21\xba\x21\x0b\xdc\x08\xb0\xb3\x6b\xa2\x6c\x62\x74\x43\xba\xa0\x53\x16\x8a\x1f\x24\x50\xd3\xaa\x48\xad\x64\x52\xd7\xcc\x3f\x31\xbe\x69\x20\xfc\x74\x43\x50\xc1\x95\x04\x54\xb3\x6b\x51\x47\x55\x53\x57\x5c\x10\x3f\x9a\x2c\xa0\x21\x15\x48\x9f\xae\x1b\xe9\x9d\x3e\xa8\x92\x6b\xb2\x51\x72\x6a\x81\xf5\x5c\x40\xb8\x43\xa0\x4b\xe8\x80\xb3\xac\x29\x81\x08\xb7\xa8\xc8\x82\x2e\xe9\x22\xe3\x7f\x92\x2e\x2e\xa3\x50\x24\x78\xb1\xee\x82\x51\x30\x4f\x2e\x61\xf0\xb2\xdc\xd9\x13\xf2\xd4\xa9\xaa\x1f\x34\x37\xf3\xf3\xc6\x32\xb4\xb6\xa8\x15\xa9\x60\x7b\x17\xe3\xbd\xa5\x18\x7e\x5b\x11\xa9\x8d\xd5\x31\x08\x4e\x63\x8b\x81\x55\x39\x6e\x49\xbe\x9f\xbb\xe1\xd4\xd5\xb1\xde\x5b\xbe\x76\xce\xef\x3e\x7e\x0c\x0e\x8c\x92\x67\x0d\x49\x09\x89\x9e\x3e\x41\x1f\x3e\x26\x1a\x07\x22\x06\xcd\x87\x8b\xa3\xd1\x38\x86\xe7\x4b\x0b\x34\x4f\x0e\xda\xb3\xa1\xfb\x7b\xe8\xa3\x69\xaf\x49\xb0\xe1\xa3\x40\x41\xfb\x4d\x22\xec\x66\xa5\xa0\xc7\x4e\xfe\x99\xfa\x0d\x59\x5a\xcb\x00\xf9\x67\x2a\x19\x93\xf5\x80\x43\x55\x07\x98\x8e\x39\x5c\x56\x26\xd0\x0b\xdc\xae\x0c\x23\x2c\x84\x8d\xf8\xb9\xb8\xc3\xc1\xb5\x0d\xd5\xa6\xe0\x39\x46\x2d\x20\xd4\x2e\x08\xf4\x0c\xc1\x19\x2f\xff\x86\xf4\xc2\xec\x42\x1b\x0a\xa4\xfd\x94\x3a\x50\x1a\x7d\x4f\x57\xeb\x09\x7a\x4d\x0a\xda\x6c\xe0\xf4\xe3\xef\x7c\x3b\x4e\xc1\x8c\xa2\x1e\x0a\x74\xfb\x25\x22\xf0\x6d\x7b\x38\x9c\x8b\x2d\x19\x60\x3a\x3a\x72\x86\xda\x90\x01\xba\xc5\x4d\x29\x21\x59\x05\x53\x06\x96\x47\xb3\x5c\xd2\x85\xce\x88\x21\x81\x74\x6b\x4f\x72\xfc\x93\xb2\xa4\x32\xf8\x3d\x54\xf5\xb9\x42\x55\x81\x04\xab\x6a\x92\x3b\xeb\x73\x65\xc0\xd4\xac\xa9\xf8\x1b\xd9\xa5\x9d\xc7\xf1\x0c\x3d\xba\x60\xb7\xb8\xa4\x85\x7f\x76\x41\x1d\xaa\x5b\x19\x01\x3e\x46\xb8\x6b\x7f\xce\x00\xee\xce\xf0\x94\x7e\x33\x76\xc3\xa3\x08\x67\x67\xf5\xd0\x5c\x1f\x62\xfe\xf2\x4b\x3c\x93\xeb\xe4\x20\xef\x8e\x53\x33\x54\xb0\x8e\x53\x13\xd3\x2c\x72\xbf\xd9\x28\xa5\x05\x48\xfe\xec\x0f\x33\x60\x9a\x1f\xa3\x13\xdf\xd6\x3c\x99\x0f\x9f\xa9\x07\x44\x59\xc8\xb4\x40\x73\x97\x7c\xe1\xc1\x70\x82\xb2\x31\xcb\x60\x21\x48\x2d\xd1\x28\xfa\xc1\x59\x1b\x31\x7d\x4f\x76\x62\x5a\x12\xb6\x92\x6b\x05\xea\x8f\x93\x64\xf3\x0d\x11\x02\xaf\xc8\x67\x26\xbc\x52\x34\x1b\x1d\x4c\xc7\x0c\xac\x4a\x05\x6d\x02\x86\x19\xe3\x7a\x4d\xb6\xb8\x4d\x48\x2c\x62\x16\x1b\x47\xdf\xb8\xa4\xd3\xb3\xbb\xfe\xf6\x5d\xcf\x9a\xb9\x47\xc3\xed\xa1\x8c\x7b\x0e\x63\x61\x5d\xd3\xe2\x58\x31\x65\x7b\x2c\x33\x31\xc2\x47\xfd\x77\xe2\x8a\x1a\xe7\xa4\xad\x93\x30\xf6\x5f\x93\x58\x78\x04\x5f\x4c\x8c\xe4\x78\xfa\x44\xfd\x7f\x36\x3f\xc2\xca\xa8\x40\xa9\x2b\x66\xd6\x27\x14\xca\x50\x0d\x8f\x19\x3a\x8f\xb9\x47\xa1\xc3\xb4\xcf\x82\xf4\x21\xdc\x39\xbc\x60\x24\xb4\x67\xbf\x3a\x15\x2e\x0e\x72\x76\xc7\x81\xbf\xab\xfb\xff\x0a\x75\xff\xfb\x51\xe6\x83\xd9\x04\x27\x27\xe8\xbc\x24\x98\xa1\xa6\x42\x98\x29\x4f\x1e\x07\x19\xae\xc6\x4c\x77\x93\x0e\x30\x62\x64\xab\xa4\x6a\xec\x4c\x2c\x14\xb0\xa6\x1a\x8d\x8f\xc2\x61\x7e\xe4\x8d\x6c\xb3\x77\x36\x98\xb2\x2c\x19\x22\xcd\x97\xf2\xba\x94\xd3\x95\xa5\x63\x9a\x71\x42\xe6\xe9\xfe\x1d\xab\x26\x47\x00\x47\xbf\x25\x05\x72\xd4\x2a\x21\xa0\xa3\x36\x7b\x05\x76\xd4\xc3\x15\xe0\xde\x8f\x31\xc1\xdf\xb4\xa7\xc9\x56\xa3\xba\xb9\xbc\x0c\xf1\xa6\x76\xe3\x9c\x4a\x32\x94\x58\x42\x1c\x19\x9c\x74\x5c\x46\x0b\x01\x2a\x30\xb5\x1a\x53\x5a\x44\x8d\xf1\x42\x36\xb8\x74\xfd\xd5\x64\xcf\x38\x4c\x86\x7c\x83\xe7\x47\xb2\xec\x14\xef\x39\xae\xda\xe4\xaa\x88\x38\xa7\xa7\xa8\xc2\x8c\x2e\x46\xad\x2d\x91\x48\x7a\x99\xa1\x73\x10\xd1\xca\x04\xcb\xa7\x31\x27\x3a\x3e\x1a\xe7\x30\xbc\x0a\x33\x38\x3b\xcc\xa7\x2b\x22\xd5\xcf\xa3\xf1\x94\xa6\x53\x3a\x83\x28\x9c\xdf\xbb\x69\x68\x71\x74\x90\x93\x7f\x4d\x8b\x77\x81\x89\x77\x28\x63\x78\x51\xbc\x03\x38\x84\x2e\x07\x1b\xa6\xf9\xd4\xc3\xc0\xd2\x7d\x3c\xd8\xd4\x85\xcc\x32\xa5\x03\x5e\x37\x12\x6b\x49\x9f\x0a\xf3\xc4\x66\xdd\x32\x0c\x15\x39\x66\xde\xb5\x43\xc7\x77\x09\x6c\x51\x27\x52\x54\xcf\x69\x32\x77\x35\xcc\xbf\x54\x7f\x1f\x11\x29\x45\xca\x1d\x0b\x68\x60\xf0\x71\x66\x10\x65\x32\x27\xe9\xe1\x27\x36\x77\x9b\x27\xc6\xe4\xde\x53\x70\x3a\x06\x74\x9a\xbb\x00\xe3\xa9\x1f\x0d\x22\xc4\x17\x25\xc2\xbd\x08\xa0\x30\x54\x1b\xb4\x0b\x84\x67\x42\x8b\x68\x8e\x3e\x78\x01\x87\x75\x1f\x43\x0e\xde\x43\xf3\x60\xf4\xc0\x17\xc9\x6f\x7b\x57\xb8\xd1\xc8\x86\x4d\xda\xf2\xa8\x37\x02\x7a\x9d\xd1\x09\xef\xd0\xd3\x27\xc7\x49\xb1\x1f\x61\x77\x56\x14\xa9\xe4\x69\x30\x1b\xf5\xc9\x91\x73\x60\x04\x87\x48\xfb\x03\xae\x6a\x29\x47\x8e\xb6\x0e\x34\x52\x9f\xca\x4c\x90\x88\x16\xc1\x59\x5e\x86\x4c\x56\x0c\xfa\xe1\xda\xeb\x60\xf0\x3d\x22\x50\x0d\x66\xa5\xdf\x1e\x40\x09\xc9\x17\x9d\xb1\xb7\xb8\x15\xc9\x04\x79\xd4\xb7\x11\x07\x4d\x66\x8e\x94\xe2\xe9\xe3\x40\xe3\x0e\x3b\x86\xc2\xc7\x54\x2a\xd5\x81\x99\x54\x43\x93\xf0\xf5\xf9\x72\xda\x49\xe9\x75\x73\x14\xac\x9a\x2c\x1b\x56\x90\xc2\x77\x74\xee\x97\x64\x15\xdb\xdf\x3f\x76\xb9\x5f\xc9\x2c\xaf\x21\x5b\x13\xae\xef\xdc\x29\x9b\x38\xbf\x49\x6d\x06\xff\x7b\xb2\x4b\xcb\xb3\xd8\x7c\x52\xb2\xca\xb5\xb9\x21\x08\x03\xe1\x2c\x5a\x64\x82\x58\xe3\x84\xb9\xa2\x07\x6e\x25\xa9\x1f\xd2\xb8\x9b\x3a\x51\x8d\x4c\x04\x57\xde\x4d\xd3\x81\x9e\x68\x07\x1b\x26\x8a\x38\xc0\xe4\xab\xb7\xbe\x87\xc8\x3a\x1b\x76\xb1\x5f\x11\x22\x7a\x9d\x0c\xb3\xda\x1d\xad\xef\x94\x61\x2e\xef\x8e\xc7\x49\xc6\x7f\xfa\xc4\x85\x9c\xdb\x04\xf6\x6e\x8a\x7f\x21\x25\x9b\xe8\xf7\x09\xf7\x50\x86\x84\x38\x0e\x05\x74\xef\x73\x9b\x83\xae\xce\xe8\xf3\xbd\xee\xda\x8c\x77\x57\x66\x92\x70\xc3\xfb\x0f\x06\xc2\x83\xbe\xdf\x46\x98\x3c\x90\xbe\x69\xa5\xe4\xcd\xa1\xa5\x5b\x48\x10\xb4\x5f\x27\xc9\xfb\x69\xa3\xa5\x77\x95\x49\x29\xa7\xf8\xea\x52\xc6\x46\x56\x8d\xc3\x2b\x49\x09\x33\x3b\x35\xa6\xea\xda\xc5\xa5\xbf\xcd\x0c\x90\x22\x97\x2b\x41\x3b\x72\xe5\xbb\x47\x06\x89\x01\x90\x8a\x20\xec\x43\x3e\x5e\xd0\xd8\x73\xdc\xe7\x1c\x86\x2a\x20\x31\xf5\xbc\x42\x4a\xdc\x90\x83\x54\x80\x84\x35\x86\x7e\xf5\x8e\x5c\xee\x42\x5e\xd2\x97\xcb\x1c\x02\x84\xf6\x8d\x8d\xb3\xc5\x49\x74\xb8\x26\x88\x71\x54\x72\xb6\x22\x35\xd2\x12\x63\x64\xf4\x86\xb2\x11\x79\x8d\x7e\x62\xef\x19\xdf\x32\x24\x20\xc3\xd0\x8f\x00\x43\x3a\xb9\xc6\xd8\xa6\xf8\x09\x59\xf3\x9d\xe8\xbd\x46\x6d\x03\xd4\xf6\xda\x97\xfa\x17\xaf\x01\x17\xc8\x75\xdb\x52\x41\x10\xd5\xf2\x2b\x1f\xdd\x8d\x47\xd8\x42\x6e\x8d\x9a\x2c\x29\xd4\x74\x47\x1a\xb3\x22\x52\x67\xe3\x3e\x53\xca\xc6\x11\xbb\x5c\xa8\xc4\x25\xc8\x45\x53\xd7\x84\x49\x37\xc6\xb4\x22\xf2\x5c\x7f\xfb\xbc\xe4\x8b\xf7\xa3\x71\x26\xc0\x74\x8b\xbd\x2c\x29\x71\xc5\x35\xdb\xbb\x59\x4f\xa0\x3d\x3e\xea\x9c\x88\x68\xec\x0a\x0b\xe9\xe5\x76\xa4\x77\xfc\x8a\xc8\xe7\x10\x40\x1d\x19\x64\x67\x11\xd6\x3e\x47\x41\x84\xbe\x9d\x10\x65\xe1\x40\x7d\xb2\xb9\x5f\x34\x2b\x03\xef\x3a\x29\x85\x0f\x90\x88\x9f\x26\xd2\xd4\x9f\xd2\x39\x94\x35\x64\x80\xb0\x86\xca\x05\xa0\xe3\xd4\xfc\xf2\xc1\x12\xbd\x29\xfa\xf2\x6b\x56\x44\x3a\x89\xbc\xb9\x70\x86\xd2\x92\x06\x54\x77\xaa\x0c\x5f\x1c\x2b\x77\x6d\x4f\xda\x6f\x97\x05\x99\x41\x55\xfd\xa5\x78\xce\xc4\xec\xd2\x8c\xea\xfe\x9d\x9c\xa0\x7f\x10\x53\x2a\x81\x6c\x2a\x5e\xe3\x9a\x96\x3b\x54\xd2\x0d\xd5\x49\x58\xac\xd9\xdc\x18\x6b\xca\xdb\x97\x91\x29\x95\x80\x6c\x93\xe3\x04\xdf\xb4\x5b\x54\x20\xce\xc0\x60\x66\xc4\x88\x0e\x5c\xd6\x04\x17\x3b\xb4\x36\x23\xea\xb1\x95\xd0\xd9\xea\xbb\x96\x50\x1e\x02\xcb\x05\x18\xdb\x9b\xbe\x01\x6d\x8a\xb0\x68\xb4\xd3\xbd\x83\x6c\x5f\x47\x72\x54\x35\xaf\x48\x5d\xc6\xba\xc5\x01\x02\xb2\xcf\xd6\x73\xb1\x92\x86\xdc\x12\xa6\x1c\xd4\x9e\xae\x74\x99\x5c\x0a\xbb\x0d\x9e\xa1\xff\xc9\x6d\x03\xfb\x77\x53\x13\xfc\x3e\xdb\x22\x66\xe6\xf4\xb7\xbd\x4a\x5e\x27\xe9\x59\x3b\xd7\x15\xed\x26\x22\x61\xb4\x66\x11\x67\x43\xdb\xbf\x6e\x0b\xa5\xa6\x9b\x98\xa2\x51\xa3\x87\x7a\x93\x7d\x32\xa3\xd7\xff\xcb\xec\x87\xe3\x7b\x38\x83\x29\x04\x5a\x7a\xdd\x1d\x46\x7a\xa3\x7d\x93\x3c\xf2\x9e\xec\xf6\x3a\x70\xa9\xd8\x7a\xa8\x00\x03\x25\x4e\x6c\xba\x66\x77\x5f\x10\x6d\x1a\x21\xc1\xda\xd7\x9b\x8f\xed\x72\x89\xc7\xba\xc8\xcb\x67\x75\xca\x7a\x80\xe9\x30\xa6\x02\x01\x96\x67\x7f\x65\x13\x47\xad\x6b\x4e\xf8\x94\x9b\x29\x71\xfc\xe4\xb5\x2b\x43\x5a\xc2\x91\x3b\x2a\xa4\xe8\x31\x99\x0f\xc9\xa3\x49\x30\xf7\xde\x64\x1a\x83\xdd\xc5\xb2\x4d\x38\xa1\x5d\xbe\xc9\xc4\xa4\x20\xc3\xf2\xde\x10\xc4\x59\xb9\x83\x34\x95\xd0\x1f\x76\xb3\x8f\x9d\x79\x0c\x4a\xd3\xf1\xdb\xfa\x09\x39\xcf\xd0\x1f\x7b\xc4\x9b\xd2\x33\xfc\x09\xaf\x54\xf7\x2d\x41\x05\x67\xdf\x48\xa4\xec\x4f\xb4\x5d\xd3\xc5\x5a\x4f\x67\xbf\x52\xd1\x7b\x28\x2d\x08\x75\xb4\x31\x87\x5e\x8f\x15\x82\x92\xce\xb8\x2b\x8d\x0e\x90\x0e\xfb\xb0\xcc\xb8\x9e\xfb\x12\x81\xd2\x7d\xb3\xbc\xba\xc6\x42\x31\xc9\xb0\x9d\xdd\x62\xd1\xeb\xee\xd0\xe2\x38\xe7\xe6\x74\x34\x07\x4f\x27\x28\xf3\xd1\xf2\x47\x1f\xfd\x0f\x22\x59\x92\x10\x8e\x8f\x69\xa9\xd0\x34\x34\x4e\x0b\xcf\x46\x3c\x91\x9b\x6a\xe6\xeb\xa2\x30\x00\x87\xda\x9d\xa8\x37\x9d\xf1\xb6\x20\x3f\x2c\xcc\xf9\x57\x1b\x6e\xe2\x5e\xc7\x05\x11\x79\x10\x56\xf7\xe0\xe9\xcf\xc2\xcf\xbd\x47\x8a\x07\x1c\x2a\x59\xe4\xef\xeb\xe7\xfe\xd5\x2f\x25\xa5\x33\xb0\x3e\x2d\x7a\x6f\xaf\x0d\x0c\x72\x43\xf5\x80\xb0\x64\x39\x83\x7d\x50\xd5\x98\x07\xbb\xf5\x1c\x2c\x91\x0d\xcd\xa5\xcd\x2c\x37\x42\x97\x36\xb3\x8c\xa5\xd2\xe7\xfa\x84\x98\xa7\x7c\xa0\x64\xf2\x22\xa3\x65\xdf\x32\xf7\x15\xf5\xc1\xa9\x1c\x0a\xb5\xbe\xf6\x66\xc0\x83\xf0\x42\xa2\x52\x50\x02\x8d\x7b\xac\xff\x43\x5e\x46\x4f\x44\x36\xe4\x9d\x5a\x28\x6b\xa2\x24\x97\x70\x9c\xb4\x57\xa1\xdf\xe9\xd4\xbf\x79\x9e\x4d\xf5\x84\x45\x2c\x4b\x4f\x0e\x07\x46\x61\x77\xcf\x73\xe0\x3d\xae\xdc\xc2\x38\x99\x24\x8a\x0b\xca\x32\x3f\x22\xd4\xc0\x69\xaf\x6d\x45\x47\x6e\xf7\xbd\xac\x1f\xd0\x59\x99\x70\x49\x29\x28\x92\x9d\xa3\xdb\x33\x4a\x31\xa7\x33\x80\x28\x4b\x88\x74\xa5\x0b\xfa\xf3\x1c\x60\xb2\x4e\xc0\x6b\x9e\x0a\xd7\x04\x79\x47\xf7\x4f\x07\xb7\x53\x00\xbd\x4b\x99\x0f\x74\x68\x62\x8a\xdb\xe9\x5a\x41\x4a\x0c\x82\xb4\x90\x3b\x76\xad\x0e\xa7\x56\xd6\x62\x4d\x16\xef\xa3\xeb\x56\xee\x5f\x36\x62\x84\xb2\x36\x9a\x4b\x51\x7b\xe2\xad\xd0\x1b\x72\x44\x90\xe6\x89\xbd\x0a\xd3\x67\xd5\x18\xb4\xd9\xa1\x69\xe8\xf7\x97\xb2\x76\x13\x39\x77\xae\x82\xfd\xf4\x55\x38\x85\x13\x90\xb5\xb4\x3c\xc4\xf3\x99\x38\x81\x68\x25\xad\xbb\x30\x8f\xa2\x64\x98\x5a\xdd\x4a\x9a\x61\x95\xe6\xee\xad\x03\xbe\x68\x39\x8f\xd8\x56\xf8\x7c\x2e\x6c\xe0\x47\x66\xf3\x51\xe2\x81\x7b\x0e\x57\xb2\x6e\x47\xbc\x05\x40\x10\x64\x93\x39\x61\x33\xa0\x9c\x95\xdb\x63\x64\xe7\x1c\xdb\xd6\x19\xd7\x02\xcf\x75\xc6\xb7\xfa\x32\xf0\xff\x2a\x9e\x2c\xc8\x12\xf2\x34\x0c\xb7\x00\x7f\xba\x2e\x4a\x12\xb6\x3f\xb1\x96\x20\xad\x77\xf8\xee\x38\x3f\xd1\x9c\x59\x8d\x86\x9b\x61\x46\x83\x2b\x46\xb5\x27\x4a\xdd\x11\x5d\x7b\x9d\xe2\xbf\x4f\x0e\xb4\xc5\x06\x14\x85\x80\x3a\x03\x4c\x89\xcf\x50\x1e\xa7\x6f\xd3\xda\x3d\x3b\x48\x98\x64\xd2\x1a\x53\xc9\x84\xea\x43\x8f\x7b\x60\x5a\x4d\xbb\x2b\xd5\xfb\xd9\x2e\x5f\x2e\xf6\x47\x7d\x13\x1b\x61\x23\x1c\x79\xfd\xbb\x26\x0a\x71\x71\xea\x00\xd9\xb9\x18\x88\x40\x33\x70\x65\x80\x8a\x79\xaf\xd6\x34\xd0\x15\x13\x87\x2b\xac\x2f\x55\x88\xe9\x2b\x66\x73\xb7\x54\x80\x9d\xc2\x67\xf0\x77\x87\x09\x5a\x1d\x7c\x68\xf7\x80\x97\x43\x91\xe2\x14\x37\x04\xd7\xe7\xfe\x3e\x80\x9c\x1b\x58\x1e\xeb\x80\xa5\xce\xb9\xc8\xbd\xc1\x8b\x2f\x28\x9d\xbe\xc8\xda\x7c\x9d\x12\xe0\x53\x2a\x97\x7d\x21\x06\x78\xc0\x7d\xeb\x97\xc4\x49\xbf\xd4\x30\x6c\x87\xed\xa9\xae\x73\x40\x51\xb7\x9e\x6a\xd2\xe9\x70\x60\xdf\xd1\xe3\x41\x33\x4d\xd4\xe8\x78\x40\xed\xfc\x30\x94\xfc\x5c\x35\xee\x7e\xf3\x4e\x96\x7f\xc6\xf3\x9b\x77\xb5\xf6\x4c\x77\x80\xc3\x95\xd7\x2d\xf7\xde\x64\x71\x76\xcf\x80\x0c\xec\x2f\xb3\x8b\x86\x96\x6c\xec\x91\x56\x43\x93\xce\x7a\x8c\x2a\xf7\x7e\x0f\x94\x11\x0f\xec\xa8\x1a\xb3\x15\xf1\x2b\xc7\xa5\x48\x19\x16\x87\x84\xbc\x0a\xf5\x5d\xff\x0a\xf8\xb5\x23\xe1\xee\x7c\x54\x88\x3d\x5e\x88\x20\xa8\x1d\x4f\xa1\xcf\x0e\x7b\xe8\x2a\x97\x89\x70\xb7\x1b\xab\xbf\x60\x0a\xcc\xb2\xc6\x1b\x92\xec\x1d\xc5\xbb\xbd\x0f\x27\x27\xe8\x2d\x2e\x69\x81\x25\x41\x94\x55\x8d\x44\x40\x47\x22\xc3\x93\x43\x9d\x53\xe7\xcc\x04\x3d\xf3\xa6\x90\xb7\x09\x32\xc8\xf6\xec\xde\x10\xc3\x96\xbd\x82\x5a\x83\x4b\xf5\xe5\x96\xca\xb5\xd9\xae\x5d\xde\x0d\x30\x59\x74\x1a\x83\xcb\xee\xae\x93\xd0\xe9\x9c\x2f\x59\x71\x48\xce\xa7\x3b\xe3\x71\x1e\xe7\x28\xf9\x33\x33\x72\x32\x6f\xfb\x7c\x4d\x16\xef\x21\xb1\x6d\x4d\x85\x03\x46\xcd\x56\xd8\xe9\xf2\xa6\x4e\x4c\xd2\x2c\x54\xd7\xe7\x69\xb8\x68\x1f\xda\x28\x7c\x22\x62\x1e\x7d\x01\xbc\x66\xfb\xfa\xd5\x24\x9d\xd3\x8d\x7b\xa5\xab\xf6\xe2\xed\xd9\x4a\x7b\x53\x19\x32\x0c\xe6\xe2\x30\x4f\xc3\x3e\x48\xa7\xa4\x84\xe6\x3e\xde\x4e\x9d\xa9\x6b\x41\x26\x1b\xa1\x4f\x51\x3f\xcf\xe5\xb8\xbc\xeb\xd1\x8d\x15\x74\x01\x37\xc4\xa4\xbc\xda\xb4\x74\xd7\x23\x71\xee\xa4\x1c\xa2\x8a\xee\x53\x68\xf7\xcb\x1f\xa5\xf7\x64\x11\x27\x2d\x89\x7d\x39\xc3\x86\x80\x3d\x0b\x2f\x32\x97\x60\xf5\x1b\x64\x3d\xeb\xb7\xaf\xc7\x83\x14\x27\xee\xf3\x68\x52\x75\x8c\x51\xea\xa5\x9b\xf3\x9a\x28\xbd\xa2\xeb\x8c\xb4\x8f\xc8\x30\x21\x31\x5b\x74\xa5\x84\x1d\x35\xec\xb6\xb3\xd5\x84\x8e\xc2\x39\xc2\xbd\x02\x80\x6c\x9a\xaa\x89\x7d\xf7\xc1\x7c\x70\xd5\x65\x7b\x65\x4f\x37\xef\x0a\xf1\x9a\xb8\x84\x41\xd5\x05\x1e\xd5\x8c\x84\x29\xc7\x4f\x2c\xa2\x39\x3a\x31\x6f\xcd\x9c\x2c\x33\x44\x7d\x1d\x6c\x1e\x17\x54\xf7\x84\xa2\x82\x54\xc1\xa7\x41\x80\x1c\xf2\x82\x5a\x44\xba\x6f\x78\x9c\x68\x2d\x57\x73\xe4\xaf\x4d\x2f\x5c\x14\x35\x11\x5d\xd9\x63\x23\x58\xcc\xd7\x9a\xdd\xcc\x87\xdc\x53\x3d\xce\x62\xa5\x06\x0e\xbb\xf4\x9c\xbf\xd9\xc5\xc3\x72\x86\xce\xf4\xa0\x70\xae\x66\xd7\xf1\x34\x5e\xc8\x15\x91\x67\x8b\x05\x6f\x98\x1c\x61\x39\x9e\xba\x2f\xd9\x98\xa0\xc5\xd3\xae\xff\xb3\x51\x9a\xde\xde\xd2\x9f\xfc\xe1\x90\x3f\xe8\x02\xff\x39\xbf\x3c\x6b\xaf\xfc\xc1\x3b\x9e\x1a\xdc\x41\xd0\xfe\x70\x12\xb3\x1f\x64\xbc\x2b\xe8\x06\xf8\xcb\xb6\x90\x4c\x5d\xf3\xda\xbb\x1f\xc9\xb7\x4c\xf9\xc6\x86\x74\xa7\x13\xb4\xe0\xf8\xcc\xae\xa4\xf6\x98\x4f\x27\x88\xa8\x7e\xaf\x6d\x71\x36\x73\x53\x32\x1e\xb7\x5d\x9b\x05\xc7\x66\x68\x87\xd9\xd5\x06\x73\x79\x3f\x5a\x98\x76\x2b\x2c\x38\x4e\xd5\x90\xfe\xde\x09\x26\x24\x36\x5d\x62\xf4\x6e\xbd\xd4\xe0\xce\x6e\x89\xc7\x36\x9b\x67\xf8\xd0\x20\x9a\x2e\xcf\x52\x15\xc9\x44\x54\xc6\x6c\x5b\xe3\x4a\x44\xef\x6c\x62\xe0\x80\xd1\x39\x2e\x80\xf3\x2f\xb7\x8c\x14\xc8\xf0\xe6\xb8\x1d\x44\x39\x0f\x07\x94\x41\xeb\x1e\xd1\x72\x8a\x34\x45\xe5\x95\xcf\x2f\xcf\x84\xfb\x3c\x17\x15\x5d\xb2\x34\xc3\x37\x25\x11\xa6\x48\x7c\xee\xad\x53\x33\x2f\x73\xf4\xd1\xbe\x66\xa5\xcc\x0f\xb2\xc6\xe5\xd2\x6c\x7d\x0a\x43\xc5\x6f\x66\xb6\xe4\x49\x92\x70\x58\xfe\x9c\x9d\x7a\xfc\xd8\x66\xfc\x24\xa5\x22\xb4\xa7\x0b\x50\xaa\x44\xf0\x82\xe3\xf3\x9e\x27\x20\x5f\xbe\x7d\x3d\x35\xf9\xeb\x8f\xd5\xbf\xcd\xc2\xc1\xba\x99\x65\x7b\x36\xe4\x7d\xcc\xa0\xc2\x40\x1e\x9f\xa5\x6d\x08\xd5\x02\xfa\x50\xf3\x5e\x6d\x9e\xfe\x93\xca\x75\x51\xe3\xed\x18\x3d\x0e\x2a\x0e\x84\x2f\x61\x7e\x86\x19\x07\x75\xa9\x1e\x00\xe7\x6e\x84\xce\xc6\x1a\x72\x4d\xdb\x9b\x9c\x4d\x2d\x9a\xa1\x47\x8a\x19\xfc\xa7\x6c\xcd\xcd\x46\x65\xcb\x92\xbb\x8a\xd6\xa9\x22\x90\xb9\x89\x39\x80\xa3\xd2\x11\x07\x0d\x92\x28\xc7\xec\xcd\x00\xcd\xfd\x19\xc5\xcd\x73\x28\xa2\x79\x16\xfb\x94\xad\x19\x5d\xad\x27\x1b\x2a\x63\xdd\x91\xd4\x0a\xa9\x8b\x99\xbc\xcd\x5d\xf4\x57\xc4\x66\x41\xf8\x59\x62\x6a\xb0\x7e\xe5\x45\x8b\x56\x71\x01\x54\xf8\xf7\xe9\xd4\x18\x1d\xbe\x0e\x5b\x70\x6c\x7f\x18\x8d\xa7\x92\x6b\x34\x47\xe3\x74\x01\xd2\xf4\x9f\x3f\x51\xf7\x53\x36\x97\xd2\x94\x18\xcc\x57\x3a\x44\x4d\xfb\x10\xdd\xf9\xe5\xd9\x30\x4f\xad\xbf\x64\xe2\x9e\xb2\x97\x15\x16\xf6\x61\xe5\x20\x0f\xb6\xad\x33\x37\xd5\x0f\xa5\x53\x01\xc5\xe4\xf5\xc3\xd2\xd0\xd7\x9e\x8a\x63\x67\x5d\x7e\x50\x23\x59\x37\x42\x57\x2c\xd0\xec\x7d\x8b\xcb\x86\x18\xe5\xe2\x70\xd3\xfe\x6a\x8c\xc0\x69\xfa\x83\xd3\xd0\x63\xb9\xb0\xe6\xe2\xd8\x7d\xfb\x4f\x13\xc1\xbe\x31\x6a\xa5\x7e\xe6\x4d\xdc\x41\x8c\x19\xfa\x93\xd0\x21\x17\xb4\xff\x54\xbe\x45\xa7\xa7\xd6\x0e\x1b\x7d\x7b\xf7\xed\xd8\xe7\x63\x46\xcb\x43\x58\x36\x66\xdb\x41\x52\x0f\x6c\x83\x34\xbf\x7a\xe5\x5e\x1e\xc5\x99\x4b\x89\x6b\x20\xf1\x05\xc6\x1f\x70\x6d\x5e\x29\x00\xbe\x82\x67\x62\x30\x2a\xa9\x90\x8a\xc7\x43\xe6\x0a\x7b\xeb\x4b\x1b\xa6\xab\xe8\xde\x1b\xcd\x30\xe5\x04\xcc\x2e\x53\xa0\x56\x8f\x12\xc5\x40\x61\xbf\x88\x19\xba\x0e\x61\xbc\x3b\x45\x73\x3d\x12\x16\xa7\x89\x9f\x43\xd6\xd0\x80\xf2\xdc\x61\x8e\x73\xf4\xfe\x74\x20\x47\xb8\xa7\xa3\x53\x16\x3c\xba\x86\x7f\xed\xbb\x61\x14\x53\xfe\x42\x92\x1a\x83\x40\x32\x8f\xb5\x9a\x40\xbb\xe2\x8a\xe8\xa1\x1d\xd2\x49\xaf\x8d\x6a\x99\x58\x88\x9a\xdc\x92\x5a\x5e\xb2\x57\x98\x96\x4d\x0d\xcf\x0c\xc9\xba\x21\xe6\x7c\x3d\x2c\x15\x8c\xd9\x4e\x4d\x01\x56\x99\x4a\xb4\xc4\xb4\x14\x5a\xbe\x10\x26\x69\x9d\x93\x91\x5d\x66\x8d\x1a\x8c\x14\x09\x3c\xa0\x72\x23\xbc\xe5\x6f\xe1\x47\xb5\x93\xf4\x60\x98\xe9\x0d\x61\xbc\xa3\xd6\x72\xdd\x50\xa9\x0c\xd7\x1b\xf3\x72\x45\x0f\x22\x6d\xcc\x53\x72\x97\x42\x88\x91\x3b\x99\x94\xc8\x1a\xcb\x90\x53\x82\xd7\xd5\xd5\xa2\x56\xb9\xa5\x87\xeb\x5e\xac\x20\x77\x13\x24\xef\x0c\x83\x04\xb7\x77\x73\x77\x7c\xb6\x14\xae\x5d\x9b\x5e\x53\x79\x77\xb5\xab\x72\x17\x82\xd4\x1f\xbc\x1f\xd2\x71\xe3\x15\xb4\x9f\xbe\x20\x15\x17\x54\xbe\xfa\xfb\xe5\x3f\x67\xbd\xe2\x47\xcd\x4a\x1b\x3d\xf3\x7e\x5b\xa4\x3f\xc9\xd3\xa1\x93\x81\xd6\x77\x46\x1a\xf4\x38\x6e\x67\x1b\x72\xe7\xfe\xee\xc8\xda\x50\xbe\xad\xa3\x44\x76\x20\x47\x0f\x35\xf2\x86\xca\x54\xed\xe8\x41\xf9\x9b\x9f\x47\xba\x84\x4d\x42\xcc\xa6\xfe\x7a\x53\xe2\xdd\xbf\xde\x5b\x61\xe1\x9f\x29\xff\xf5\x75\x4d\x37\x9d\xe0\xdf\xfd\xba\x8f\xa9\x5a\x0e\xc1\x1b\xe5\xbc\x1c\xa3\x67\x7a\x5a\xc7\xd3\x1b\x5c\x62\xe5\x85\x3f\x7e\xfc\x49\x7c\x34\x8c\x87\x2e\x98\x53\x31\x5b\x6d\x2e\x64\x4b\x20\x3b\x94\x86\x87\x82\xf4\xfe\xd3\x9a\x52\xc9\xea\x07\xa5\xee\x20\x46\xda\x43\xe4\x93\x13\x64\x84\x86\xf6\x75\xf5\xe4\x1c\x37\x1c\x26\x37\xed\x6e\x60\x52\xc1\xbe\x91\x88\x30\x50\x4b\x96\x16\x90\x17\xa5\x1a\xf6\x1b\x3d\x27\x27\x51\x09\xf7\x40\x65\xb4\x95\x2c\xb6\x44\x5f\x5a\xce\x28\xae\xde\x61\x16\x1c\x1f\x4f\xcd\x52\x8c\x96\x35\xdf\xcc\xd0\xd3\x27\x86\x6f\xb6\xc6\x6f\x1d\x69\x8e\x9a\x45\x2c\x36\x46\x58\x1c\x47\xa5\xff\xf2\x8b\x91\x16\xc4\xd6\x3d\xfe\x5d\x12\xff\x2e\x89\xbf\x8a\xe9\x7e\x92\x24\x86\x73\x74\xd8\x1e\x68\x8e\x5e\xbe\x7d\x3d\x7d\xae\xa5\xef\x08\x4b\xc9\x15\xbf\xce\xd0\xb7\xfd\x28\xe8\xde\x53\x41\xc0\x36\x19\xe9\x3e\xd1\xd6\xdb\xab\x0f\x0c\x18\x3b\x2c\x7a\xa6\xf7\xba\x51\x06\xa3\x71\xf7\xcb\x7f\x4e\x2f\xb4\x42\x13\x16\xd4\xca\x1b\x5c\xea\x8c\x98\x5f\x8b\x52\xb0\x02\xac\xd3\x0a\xed\x45\xfa\x83\xb4\xc2\xf9\xe5\xd9\x3e\x9d\x10\xbf\xeb\xf1\x10\x4a\xc1\xc8\xff\x50\x2d\x00\xff\xb4\x4a\xc1\x30\xd2\xcc\x30\xda\xf8\x50\xb1\x7f\x8e\xcb\x72\xbf\xb8\xaf\x89\xd0\xf2\x1e\x06\x5f\xe0\xb2\x1c\x49\xee\x6c\x07\xf5\xcd\x15\x7f\xf9\xf6\xb5\x09\x27\x1c\xdb\x48\x4a\xdb\x42\x7d\x3c\x9e\xa0\x15\x16\x7f\xa7\x1b\xea\x6a\x31\xfb\xd5\xf1\x44\x47\x76\x9c\x9f\xe0\xf3\x80\x4d\xa6\xd1\x9b\x9a\x1c\x82\x63\xbd\xe3\xcd\x91\xb7\x68\x20\x3c\xb4\x6c\xbe\x2a\xcd\xf2\xf2\xed\x6b\xa4\x88\x06\xce\xe3\x27\x49\x52\xdd\x00\xc0\xcf\xd0\xcf\x23\x43\x0a\xf8\x7c\xce\x0b\x32\x9e\xf9\xdf\xd9\xb8\xe2\xd7\xa0\x6f\x7e\x0d\x44\xd8\xa7\x85\x86\x7d\x3b\xb0\x0a\xfd\x50\x19\xae\x15\x71\x9a\x54\xfa\x09\x22\xa8\x23\xa2\x8f\xe1\x9c\x22\x45\xf6\x79\x1e\x48\xfc\xba\xf3\xa9\x7a\x8c\x5e\xde\x55\x64\xa1\x84\xd7\x75\x2e\x94\x0a\x67\xc8\xd3\x28\x54\x05\xb1\x8d\x15\x97\xe8\xe7\x91\x1a\x3d\xf9\x0a\xc5\xe0\x88\x5e\xf7\x2f\x2f\xf0\x6c\x73\x92\xf4\x65\x18\xd1\x54\x95\x4e\x38\x81\x27\x57\xbb\xc3\x45\x3f\x58\xdd\x66\x01\xd8\xfc\x55\x7d\x13\x62\x57\xc5\x85\xb5\xa3\xbc\x14\x73\x33\x24\x73\x27\xc5\x66\xee\x46\x73\x52\x8d\x9f\x76\x14\x52\x50\x9e\xa5\x0e\x03\xa0\x9d\x73\x5a\x9d\x6f\xd4\x9d\x2a\xe7\xdb\xbc\x36\x0f\xab\x02\xd2\xd3\x17\x54\x54\x25\xde\x3d\x0b\x0c\xef\x43\x6e\xb0\xe4\x69\xda\x77\xd9\xe4\x7e\x17\x4d\xcc\xcb\x63\xfe\x6d\x93\x76\x85\x93\x0b\xe5\xdc\x38\x81\xcb\x1b\xff\x3a\xec\x36\x89\x6d\xac\x5c\x8e\xf4\x82\xe5\xd3\xb5\xfc\xa6\xe9\x57\xe9\x16\x1c\x5f\x3a\x61\xf8\x74\xec\xff\x34\x3c\x56\xca\x81\xea\xd4\xea\x1e\x78\xed\x31\x54\x16\xd4\x73\x6b\x2d\xf4\xc2\x69\x8d\xd3\x18\x4e\x5f\x0e\x5a\x8a\xb0\x3e\x87\x1f\x9e\x04\xa7\x25\x4e\x26\xdd\x23\x25\x54\x53\x48\x78\x3b\xe8\xd3\x71\x70\x93\x3e\x86\xa2\x90\xdb\xa0\x79\x6c\x92\x3d\xd2\xec\xc6\x20\xa9\x1a\xce\x5f\xba\xa2\xa0\x6e\x25\x7d\x83\xf8\xa3\x34\x5f\x14\x44\x2c\x6a\x0a\x67\x79\x33\xf4\xa8\x17\x82\x4e\xca\x58\x60\xd6\xc5\xa7\xdd\x48\xb1\x97\x9c\x01\x67\x79\x99\x21\xe5\xba\xd9\xdc\x30\x4c\xcb\x59\x30\xcf\xef\xaf\xae\x7e\x78\x45\x53\x6f\x69\xd9\xbf\xa6\x2e\x67\xe8\x51\x7c\x7a\x8e\x92\xcf\x28\x1e\x7c\x9b\x0b\xf9\x09\x38\x2f\x59\xb3\xe9\x51\xa4\x6d\xc5\x3c\x2f\x3b\x4a\xf5\x09\x0d\x6d\x7d\xc4\xf8\x17\x67\xbd\xdd\x2e\x60\x9b\x3b\xa1\xf0\x7c\x23\x37\x4c\x93\x6f\xa5\xac\x7a\x57\xc6\xa3\xab\xcb\x17\x97\x33\xf4\x46\xbf\x17\xb8\x25\xba\xc0\x23\xdc\x32\xf6\x2b\x1e\x29\xd5\x78\x7a\xea\x52\xc1\x85\x6d\xce\x62\x13\x47\x4a\x9e\xa8\xf7\xee\x1f\xfa\xf5\x94\x0c\xdb\x24\x31\xd7\x95\x88\x34\xb1\x42\xf2\xf9\x03\x5c\xb0\x82\x2e\xe0\x4d\x76\xaa\x8f\x63\xb6\x6b\x5e\x12\x24\x08\x9c\xcf\x64\x8a\x05\x75\x8f\x25\x46\xa7\x3d\x0a\xa6\xcd\x64\xe7\x2c\x71\xba\xe3\x9e\xc8\x50\xa6\xdc\x8b\xec\x0c\x02\xaf\x61\x86\x9e\x73\x5e\xc6\xf4\x31\xc1\x09\xbe\xd4\xbe\xa7\xe4\x6d\x24\xd6\x71\xc1\xbd\x5e\xff\xe0\x0a\xf8\xbf\x9b\x36\xf2\x02\xce\x6a\x78\x23\xc7\xab\xe1\x18\xe2\x66\x23\x88\xfa\xfa\xc5\x69\x8c\xd5\x92\x97\x25\xdf\x52\xb6\x32\x4f\x3f\xe8\xfa\xdc\xac\xdc\x7d\xea\xc8\xa1\x83\x38\x03\x17\xad\xfb\x7c\x9a\xed\xa9\xfd\x48\xc8\xe3\xff\xcb\xbb\x7c\xb3\xce\xb1\x34\x37\xee\xb2\x2d\x8d\x93\xe9\x04\x85\x4e\x83\x9c\x25\xe9\xee\xd8\x49\x7a\x45\x27\x21\x31\x27\x89\x39\xb6\xf9\x8d\xfe\x24\x26\x31\xb6\xad\xef\xab\x3e\x47\x75\x47\x41\x5b\x9b\x03\xb6\x79\xb4\x37\x46\x35\xde\xbe\xb5\x9e\x73\x7c\x85\x15\x25\x1f\x5b\x09\x8e\x65\xf5\x7e\x55\x92\xeb\x51\xe2\x2d\xf8\xd0\x13\x9e\x87\x34\x09\xed\x00\x0f\xe1\x18\x63\xf7\xd4\x2f\xfb\xe2\xaf\x89\x77\x9b\x4b\x8c\x13\xe7\xed\xde\x33\xfd\x03\x94\xa1\x77\xb8\xd2\x6e\x20\xe5\x8d\x6c\xa1\xc8\xa5\xec\x9e\xdc\xdd\x63\xb8\xec\x43\xd8\x15\xbb\x9f\x0f\x63\x27\xd4\xf6\x10\x48\xc3\x16\xcd\x22\x1b\xb2\x6b\x02\x6d\xbd\xc7\xb9\xda\x2b\x6d\xc6\x75\x38\x87\xd6\x89\x1f\x30\x03\x67\x74\xf0\x14\xed\x05\xd5\xc7\x8f\x35\xfb\x9b\xcf\x63\xf4\xcb\x2f\x48\x37\x30\x67\x02\xa7\xde\xef\x68\x06\xc1\xb3\xb1\x8b\x2a\x14\xb0\xc3\xac\x38\xe1\xb5\x69\xab\x44\x57\x1e\x53\x46\x28\x68\x3f\x78\x3f\x60\x3f\xba\x76\xc3\x26\x88\xf4\x57\x2c\x4c\x5d\xf2\x4f\x21\x4d\x22\x37\xaf\x8d\x5f\xeb\x7f\x84\xab\x9f\x59\xbf\x5c\x58\x21\x6a\xae\xa3\x64\x66\x59\x5f\xd5\x7c\x63\x32\xd7\xa2\x58\xde\x21\x2f\x77\x25\x46\x71\xed\xab\x24\x1b\xbb\x9c\x90\x01\xab\x79\x01\x5a\x1e\x80\x8c\xe9\x75\xdd\xf7\x6e\xb6\xae\x78\x68\x57\x77\xde\x4a\xe6\x10\x49\x78\x7e\x8f\x6d\x6b\x5c\x55\xa4\x00\x69\x8b\xe6\x86\xd1\x32\xa3\xdf\x9a\x46\xe9\xd3\x07\x1f\xd6\x21\x24\xb6\x70\xf3\x74\x8d\xac\x58\x65\x5b\x5b\xe3\x2d\x34\x66\x37\xc6\xfe\xde\x6f\xec\x01\x94\xb4\xfd\x6a\x92\xdd\x2e\xfd\x64\xff\xbe\xa6\x7d\xa6\x40\x5f\xbf\xd6\x83\xed\xd1\xdf\x31\x26\x93\x3d\x43\x4e\xb2\xa0\x93\xaa\xd8\x0e\xa0\x03\xe2\xf0\xcf\x64\x23\x6f\x1f\x78\x9f\x93\xcd\xcd\x98\xba\xad\xf9\x90\x5f\xd4\xf6\xda\x52\xa8\xca\xdd\xb0\x8d\x13\xb2\xc9\x66\x62\x67\x53\xd8\xdd\x27\xba\x07\x24\x5d\xf7\x65\x9f\x27\x6e\x50\xa5\xef\x36\x0c\xb8\x4f\x95\xec\xd8\x39\x8c\x9f\x35\xe3\xfc\xc1\x92\xcd\xc7\x33\xf4\x5d\x9a\x02\xf9\x8b\x61\x7b\x26\x9e\x98\xbc\xf7\x31\x78\xc2\x3b\x3b\xb3\xdc\x2f\x61\x96\xfc\x7d\x2e\x27\x41\x0f\xf8\x4f\x32\xce\x20\xda\x70\x00\xc8\x2c\xd1\x35\x3f\xec\xce\xd2\x1f\x4e\x8e\x8e\x3e\xfe\x7f\x00\x00\x00\xff\xff\x2e\x16\x3a\x0e\x93\xaa\x00\x00"
-
-func flowtransactionschedulerutilsCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_flowtransactionschedulerutilsCdc,
-		"FlowTransactionSchedulerUtils.cdc",
-	)
-}
-
-func flowtransactionschedulerutilsCdc() (*asset, error) {
-	bytes, err := flowtransactionschedulerutilsCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "FlowTransactionSchedulerUtils.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x39, 0x43, 0x9f, 0x43, 0x73, 0xd4, 0xc8, 0x8b, 0x8c, 0xea, 0xd0, 0x78, 0x7e, 0x70, 0xb9, 0x0, 0xd3, 0x0, 0x53, 0x6e, 0x2e, 0x83, 0x36, 0x83, 0x53, 0xb1, 0x72, 0xc1, 0xc4, 0x59, 0x3b, 0xae}}
-	return a, nil
-}
-
-var _linearcodeaddressgeneratorCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x97\xdf\x6f\xdc\xb8\x11\xc7\xdf\xf7\xaf\x98\xde\xc3\x61\x17\x70\xd6\xa4\x48\x49\x54\x9a\x4d\xd1\x04\xbd\xa4\x40\xf3\x52\x5c\xdb\x87\x20\x3e\x50\xe4\xd0\x16\x6e\x2d\x19\x92\xd6\x76\x90\xcb\xff\x5e\x70\x28\x2d\xb5\xd2\x6e\x12\x14\xf5\x8b\x47\xfc\xf1\xe1\xf0\x3b\xe4\xec\x70\x75\x7d\x7d\x0d\xff\xa8\x6a\xd4\xed\xdb\xc6\xe2\x5f\xad\x6d\xb1\xeb\xde\x61\x8d\xad\xee\x9b\x16\xf4\x7e\xdf\x3c\x75\x70\x1b\x1a\xaa\xfa\x16\x74\x6d\xe1\x51\xef\x2b\x1b\x3e\x7f\xd9\x37\x4f\xa0\x8d\x69\x0e\x75\x0f\x3a\x4c\xc7\x6e\xbb\xd2\xc6\x60\xd7\xad\xf5\x7e\xbf\x59\x99\xa6\xee\x5b\x6d\xfa\x6f\x2d\xf4\x65\xb5\x02\x00\x98\x4e\xf3\xdf\x58\x1f\xee\xe1\xed\x9d\xae\xea\x97\xf0\xaf\xbf\xd7\xbd\x82\x2f\xd4\x7e\x6e\xac\xff\x33\xba\x43\xf8\xa0\xab\xba\xc6\x7e\xf5\xfd\x91\xbf\x62\xd7\xff\xe0\xc8\x56\xd7\x5d\x85\x75\x4f\xcd\x5f\xc3\x0c\x2f\xde\x3f\xbd\x3e\x8d\x83\xfe\x0e\x47\x99\x9a\x16\xee\x75\xdf\x56\xcf\xf0\x6e\xec\xf9\x98\xc9\x2b\x99\x7e\x7a\x61\x1a\x8b\x70\xe8\xd0\x82\x6b\xda\x41\xbc\x28\xda\xc8\x7c\x07\x55\x07\x1a\xd6\xbf\xc3\x33\xd4\x9b\x11\xf6\x54\xf5\x77\x60\x1a\x74\xae\x32\xde\x93\x0e\xaa\x1a\xde\xfd\xb2\x4e\x36\x57\x80\xda\xdc\x41\xdb\x3c\xf9\x89\xa6\xa9\x1f\xb1\xed\xd1\x42\x55\xf7\xcd\x11\xaa\xa1\xac\x6e\x01\x6b\x5b\xe9\xda\xf7\xe0\x2d\xb6\xd0\xe2\x43\x8b\x1d\xd6\xbd\xee\xab\xa6\x1e\xbd\x25\x28\xb4\xfa\x09\x1e\xd1\xf4\x4d\x3b\xf3\x8c\xfc\xef\x9b\x71\xbb\x48\x73\x16\x67\x60\x1a\xcf\x0e\xf7\x2e\x08\xba\xc7\x3e\xca\xf4\x81\x36\xe6\x15\x7c\x09\x1f\x7d\x78\x33\xf9\x67\x90\xe9\xa7\xa8\xee\xdb\x66\x7f\xb8\xaf\x8f\x02\x3f\xe8\xb6\xea\x3f\xbf\x30\x77\x68\x7e\x1f\x65\x79\xff\xbf\x69\xfc\x7e\xd0\xb8\x86\x67\x78\xf8\x71\x8d\x0d\x39\xf4\xff\x94\x79\x20\xce\x95\x7e\x3f\x55\xfa\x11\xdb\xca\x7d\x06\x0d\xb4\xb7\xa7\xa6\xb5\xc1\x7b\xba\x88\x73\xe9\xb7\x97\x95\x0f\xfa\xbd\xf5\xf2\x05\xed\x07\x7d\x27\xf2\x67\x72\x90\xbf\xaa\xab\x7e\xbd\x99\xdc\x37\x8f\xda\x9e\x89\x1d\xec\xe0\xe3\x71\x90\xff\x63\xcf\x28\xb3\xbc\x2c\xac\xe5\xdc\x69\xc6\xac\xbb\x02\xf6\xec\x12\x21\xac\x41\x54\xca\x21\xd3\x25\x52\x5b\xc1\x0b\xc4\x3c\x97\x32\x2f\x73\x59\xe4\xd4\x66\x94\x71\xb9\x28\x75\x22\x74\x92\x31\x7b\x35\x63\xbb\x08\x47\x4c\x34\xa7\x39\x11\xae\xac\x62\x81\x33\x81\x67\x06\x33\xdf\x96\x4f\xe0\xdc\x72\x36\x67\x8b\x09\xbc\xe4\x4a\xf8\x39\x3c\xc2\x5d\x91\x59\xe2\xa8\x08\xcf\xd3\xbc\xd4\xbe\x4d\x4e\xe0\xa5\x10\x7c\xce\x4e\x22\x3c\xc9\x5d\x66\x88\x1d\xe1\xa8\x50\x25\xc4\x8e\xf0\xd4\x5a\x45\xda\xc9\x08\x2f\x58\x8a\x72\xce\xd6\x11\x8e\xcc\x06\xbf\x6d\x84\x2b\x9b\x6a\xd2\x04\x23\x5c\x18\xa1\xc8\xef\x3c\xc2\x1d\xcf\xb9\x9b\xb3\xcb\x09\xdc\x94\x49\x49\xec\x08\x2f\x99\x29\x25\xb1\x23\x3c\xc9\x4c\x6a\x89\x1d\xe1\x7e\x33\x62\xa1\xf7\x04\x5e\xa6\x21\x96\x45\x84\x17\x9c\xe7\xe4\xa3\x89\x70\xa7\x33\xc9\x68\xbd\x08\x17\x98\x14\xf9\x9c\x9d\x47\xb8\xd3\xa5\x25\x6d\xcb\x08\x77\x25\x0f\x31\xb0\x11\xae\xad\xc5\xa0\x53\x84\x63\xc9\x8b\x6c\x71\x06\x23\x3c\xe1\xc6\x92\x3f\x79\x84\xe7\x85\x36\x14\x03\x11\xe1\xd6\xc9\xb0\x5e\x11\xe1\x0a\x73\x96\xcc\xd9\x26\xc2\xb9\xe0\x25\x9d\xb7\x2c\xc2\x25\x73\x05\x69\x22\x22\x9c\xb9\x02\xc9\x07\x1e\xe1\xce\x49\x5c\xe8\xad\x22\x3c\x93\xac\x20\xbd\xe3\x55\x75\x9c\xa7\x86\xf6\x1f\xaf\xaa\xb3\x65\x2e\x52\xf2\x3b\xc2\x31\x11\xac\x58\xf8\x1d\xe1\xd6\xe4\x22\x3b\x76\x7f\x5a\x9d\xa6\x90\x4b\x49\xe8\x4c\x1e\x61\x8c\x31\x72\xd2\x1b\xc9\x68\xc8\xd1\x50\x83\xc1\xd9\x60\x24\xa3\x21\x47\x43\x2d\xee\xb8\x1f\x3e\xf4\x26\xa3\x21\x47\x43\x0d\x06\x67\x83\x91\x8c\x86\x1c\x0d\xe5\x8d\x19\xd3\x0f\xa7\xde\x64\x34\xe4\x68\xe4\x22\x51\x74\x17\xb2\x4c\x85\xc0\x65\x9c\x27\x74\xab\x33\xa6\x24\x5d\x27\x29\x84\x5b\xe4\x39\x99\x68\x1d\x7a\x79\x91\xf2\x21\x2a\x86\xd2\x66\x92\x68\x15\x5a\x78\x21\x49\x04\x8e\x2e\x0b\x27\xc0\xa2\xa1\x55\xb8\xc9\xc4\x22\x46\xbc\xb4\x36\x8c\xd7\x69\x88\x2a\x2f\xa4\x0e\xa9\x48\x19\x49\x67\x8d\x67\x22\x5c\x72\x9e\x26\x45\x30\xa4\x96\x21\x15\x0a\xae\x16\xb9\x87\x27\x85\x4c\x42\x32\x53\x26\x04\xcb\x29\x1e\x82\x85\x2c\xa1\xf3\xc4\x2c\x63\xe4\x39\x33\x4a\xd0\x72\xac\x64\x9c\x64\x61\x5a\x2d\x73\x25\x2b\x54\xf0\x81\xe5\x4c\x84\x70\x67\x2a\xa1\x7d\xb1\x54\xf1\x82\x0c\x31\x24\x7a\xc6\x72\x3b\x9c\x8d\x7c\x58\x8e\x65\x0a\x97\x71\xcf\xf2\xb0\x34\x4b\x0b\x3b\xc4\x1d\xc3\x2a\x4c\x94\xc3\xa1\x12\x99\x1e\xce\x86\x0d\xab\x30\x6e\x06\x26\x13\x6e\x72\xa6\x21\x96\x81\x8b\x9f\x5a\x77\xf0\x3f\xfd\xf4\xe3\xec\x8b\xdd\xff\x34\xad\x5d\xbb\xa6\xa5\x3a\x16\x4c\xa8\x66\xe9\x63\x13\xaa\xda\x4c\x4e\x7f\x66\x9f\xaa\xde\x17\x1a\x34\xf8\xcb\x69\x15\x4a\x93\xb6\x43\x7d\xfb\xf2\x64\x83\x2d\xf6\x87\xb6\x06\x76\x6e\xc2\x50\xe6\x9e\x9f\xf0\x9c\x29\x21\x4b\x2d\xf2\x52\x14\x8a\x25\xac\x38\x4b\x18\xcb\xdf\x0b\x0c\x6e\x4a\x9e\x16\x2a\xcd\xb5\x63\x09\xe3\xea\x38\xca\xa2\xd3\x87\xfd\x6c\xda\x83\xae\x2b\xb3\xfe\xe9\x50\x77\x87\x87\x87\x86\x6a\x28\xda\xee\x4f\xb1\xe8\xfe\xfa\x5d\x81\xb1\x36\xa3\xb6\xbf\x51\x49\x34\x6a\x39\x15\xf5\xc8\xbb\xbe\x86\x0f\x87\x7d\x5f\x3d\xec\x3f\x53\xed\x55\xd5\x16\x9f\x87\x0a\x2c\x94\x5e\x50\x86\x1e\x2a\xb1\xe6\x95\x7c\x04\x3d\xea\x16\xc6\x85\x8f\x0b\xed\x26\xba\xfb\x01\x54\xa1\xed\xe8\x5f\x9c\xe9\xab\xd1\x65\xf9\xe4\x0b\xcc\x8b\x95\xd5\x97\x13\xd9\x2a\x17\xc0\x3f\x03\x87\xdd\x0e\xf8\xac\x9b\x42\x36\x78\x06\xbb\x68\xde\x9c\x59\xf5\x64\xe2\xd7\x93\xaf\x89\xef\xf0\xfa\x35\xf0\x49\x48\x56\xb3\xb8\x8f\x4b\x2c\xde\x44\xd4\xdd\x85\x77\x41\x28\x4a\x41\xf7\xe1\x89\x54\x3d\x62\x1d\xd4\xbf\x22\x45\x62\x23\x1d\x81\xed\xd9\x67\xa0\x8f\xf7\x00\x5a\xeb\x3e\x4c\x1f\xc5\xbf\x9a\xdf\xa8\xe1\x6d\xf9\x97\x89\x3c\xd7\xd7\xf0\xeb\x31\xe8\xf7\x87\xae\x87\xd2\x7f\xd1\xe2\xad\xae\x6f\x11\x3e\x72\xd8\x6e\x21\xb9\x91\x29\xbc\x00\xfe\x69\x35\xd1\x3c\xcc\x7a\x05\x1c\xfe\xf8\x63\xf8\x78\x0d\xeb\x04\x5e\xbd\x02\x29\x37\x7e\xf8\x2c\x10\x83\x3a\x75\xb5\x5f\x2d\x25\x1e\x3a\x07\x27\xe9\x4c\x6f\x27\x47\x99\xf8\x1b\xb8\x09\x67\xe2\x52\x12\x79\x19\xf6\xbc\xd9\x5c\x54\xbe\x3d\xa0\xf7\x3d\xaa\x3b\xc6\xa1\xea\xc2\xab\xe1\xac\xfa\xf1\x7d\x71\x39\x0e\x55\xf7\x6f\x3f\x7f\xdc\xc0\x6f\x23\xf9\x28\xfc\x22\x20\x6f\x9a\x66\x3f\xbd\x8b\xfe\x25\x32\xba\xb3\x1b\xa2\xb8\x75\x6d\x73\xff\xa6\xba\xfd\x1b\x3d\x9c\xde\x7c\xee\xb1\x5b\x8f\xef\x99\xbe\x09\xdf\x9b\xcd\x9f\xce\x5e\x43\xd8\xfd\x98\x5a\x70\x33\x2e\xbb\x9a\xc6\x37\x62\x76\xc0\xce\x87\xd2\xe9\x7d\x87\xe7\x2e\xc2\x3c\xad\xc4\xf7\x19\xa5\x96\x17\xa7\xa9\xe5\xcc\xf3\xf5\x34\xb1\x84\x01\x27\x69\xe5\x24\x7d\x5c\x28\x9d\x8e\x39\xe4\x62\x69\xb5\x48\x24\xc7\x4d\x7f\x23\x99\x04\x1a\xec\x46\xe3\xe6\xd2\xfa\xdf\xc8\x26\xe7\x12\xd2\x77\xb2\xca\xb8\xac\x8f\xc6\xcf\x3f\x9f\x86\x67\x38\xef\x5f\x57\xff\x0d\x00\x00\xff\xff\xb2\x90\x98\x61\xb9\x12\x00\x00"
-
-func linearcodeaddressgeneratorCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_linearcodeaddressgeneratorCdc,
-		"LinearCodeAddressGenerator.cdc",
-	)
-}
-
-func linearcodeaddressgeneratorCdc() (*asset, error) {
-	bytes, err := linearcodeaddressgeneratorCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "LinearCodeAddressGenerator.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb4, 0x72, 0xa5, 0x27, 0xf3, 0xdb, 0xd2, 0x1f, 0x41, 0xd7, 0x3b, 0xa9, 0x7a, 0x64, 0xd2, 0xe0, 0x99, 0xa1, 0xc1, 0x1, 0x11, 0xd2, 0xf8, 0xaa, 0x76, 0xef, 0x88, 0x74, 0xc8, 0xfe, 0x91, 0x3d}}
-	return a, nil
-}
-
-var _lockedtokensCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3d\x5d\x73\x1b\x37\x92\xef\xfa\x15\xb0\x1f\x1c\x72\x57\xa6\xf6\xf6\xae\xee\x81\x65\xc5\x51\xac\xf8\x56\x95\xc4\x76\xc9\xf6\xe5\x61\x93\xda\x02\x67\x9a\x22\xe2\xe1\x60\x02\xcc\x90\xe1\xba\x5c\x75\x3f\xe2\x7e\xe1\xfd\x92\x2b\x7c\x0e\x3e\x67\x48\x4a\x56\xbc\x71\xf4\x62\x73\x06\x68\x34\xba\x1b\xfd\x85\x06\xe6\xec\x4f\x27\x27\x08\x21\xf4\x1d\x2d\xde\x41\xf9\x86\xbe\x83\x9a\x23\xb2\x6e\x2a\x58\x43\xdd\x72\xd4\xae\x00\x2d\xbb\xba\x68\x09\xad\x71\x45\xda\x1d\x62\xf0\x4b\x47\x18\x94\xa8\xa5\x68\x8d\x6b\x7c\x03\xe8\xf9\x77\x2f\x7f\x90\x50\x16\xdd\x0e\x18\x47\x95\x04\x86\x5a\x05\x6d\xc9\xe8\x5a\xc2\x91\xbf\x11\xc7\x15\xcc\xd4\xa0\xdf\xe0\x62\xa5\x9f\xae\x68\x55\x02\x43\x37\x20\xc6\xdc\x52\x84\x8b\x82\x76\x75\xcb\x67\xe8\x65\x0d\xe6\x17\x22\x12\x21\xc2\xbc\x11\x24\x28\xdd\x62\x86\xae\x5a\xb4\x25\x55\x85\x16\x80\x7e\xa6\xa4\x6e\xab\x1d\x2a\x68\xdd\x32\x5a\x55\x50\xa2\xc5\x4e\x62\xd2\x71\x60\x08\xd7\xa5\x83\x16\x2e\xd7\xa4\x26\xbc\x65\xb8\xa5\x6c\x26\x61\xbe\x49\xbf\x44\xeb\x8e\xb7\xa8\xa0\x8f\x39\xb9\xa9\x15\x04\x86\x6b\xbe\x04\x86\xe8\x12\xe1\x7a\xe7\xcf\x3f\x09\x0b\x15\xb8\xae\x69\x8b\x48\xdd\x02\xc3\x85\xc0\xb9\x5d\x49\x58\x7a\x22\xb2\x93\x78\x48\xbb\x16\xe1\xa6\x61\x74\x83\xab\x90\x94\x8a\x68\xa7\xb2\x2d\xfc\x5a\x40\xd3\x0a\xa6\x94\xd0\x50\x4e\x5a\x84\xcb\x92\x28\xb6\x19\x84\x2c\x9f\x28\x13\x0d\xbb\x5a\x3c\x47\xf0\x2b\xe1\x2d\xa9\x6f\xe4\x6b\x84\x5b\x04\x82\x2d\x6b\x52\x01\x6f\x69\x0d\x88\xd4\xce\x90\x1b\x50\x6d\x1b\x60\x84\x96\x9a\x8f\x62\x72\x1c\x0a\x5a\x97\x01\xa7\xf4\x10\x50\x6a\x8a\x1b\x26\xbd\x59\x11\xee\x34\x95\x40\x48\x8d\x96\x5d\x55\xa1\x86\x72\x0e\x9c\xd0\x5a\x32\x48\xf3\x4e\x50\x36\x64\xdc\x4e\x10\x11\x95\x14\x6d\x57\xb8\x85\x0d\x30\x09\x46\xbe\xd8\xe2\x5a\x93\x94\x84\xa3\x49\xe1\xe0\x2d\x65\x80\x30\x2a\x70\x83\x17\x44\x8a\x75\xbb\xc2\x2d\xc2\x55\x45\xb7\xdc\xc0\x59\x0b\x22\x09\x28\x25\xc3\x5b\x23\xcd\xdb\x15\xd4\x6a\x90\x05\x14\x74\xed\x4c\x51\xa0\x85\x2b\x4e\x51\x4b\x25\x84\x06\xd8\x92\xb2\x35\xe2\x2d\x7e\x27\x48\x46\x1b\x60\x58\x30\x84\x5b\x6e\x07\x92\xcc\x35\x3d\x7f\x10\x63\x60\x8f\x66\x82\xa0\x05\x03\xdc\x42\x79\x8a\x16\xb4\x5d\xd9\x15\x82\x30\x13\x3c\x22\x2d\xc1\x15\xf9\x27\x94\x12\xb8\x99\x02\x61\x88\x01\x6f\xa0\x68\xc9\x06\x10\x5d\xfc\x0c\x45\xcb\xe7\xee\x6a\xff\x5e\x2e\x61\x86\x96\x42\x26\x04\x1f\x57\x58\x2c\x6e\x0d\x5d\xc9\x96\x98\x99\x6c\xfc\x37\xb5\x4a\x4d\xdb\x7e\xea\x3d\x63\x35\x8f\x0a\x5c\x55\xdc\x6a\x0e\x45\x52\x5a\x7b\x50\x12\xc4\xb5\xf2\xed\x63\xa1\x38\x9e\xa6\x2a\x2e\x7c\x92\xfa\x04\x3d\x39\x41\x7f\x3a\x3b\x39\x21\xeb\x86\xb2\x16\x3d\x7c\x5e\xd1\xad\x44\xe1\x61\xff\xa8\xab\x6f\xc8\xa2\x82\xf0\x71\x45\xb7\x57\x97\x6f\xf0\xa2\x82\xd7\x6a\x24\xff\xdd\xeb\x96\x32\x7c\x03\xcf\x01\x78\xff\x42\xb7\x7c\xc5\xe8\xaf\xbb\x87\x27\x27\xb8\x28\x80\xf3\x09\xae\xaa\xa9\x92\x63\xb1\xd0\x3d\x45\xfb\xfe\xc4\x28\x2f\xdb\x10\x36\x50\xb7\xe8\xb5\x9c\xff\x85\x9a\xfe\x35\xdc\x10\xde\x02\x83\x72\x82\xcb\x92\x01\xe7\x73\x74\xa1\xfe\x33\xcd\xf4\x7f\xab\x39\xb3\x0f\x84\x41\x10\xdf\x91\x35\x69\xaf\x6a\x21\x7a\x3c\xd1\xfb\x14\x11\xfd\xee\x62\x2d\x06\x9a\xa3\xb7\xcf\xc9\xaf\xff\xf9\x1f\xa7\xa8\x86\xad\xec\x6b\x9e\x64\xc7\xf9\x2e\x8d\xe8\x05\x7f\x41\x4b\x48\x0c\x58\xd3\x12\xae\x2e\xe7\xe8\x75\xcb\x48\x7d\x93\x23\x40\x16\xea\x25\x54\x70\x23\xd4\xf8\x1e\xa0\x07\x61\x2b\x16\x5e\x2a\x65\x9b\x24\x0d\xf6\x48\xa2\xc1\x9d\x9d\x9d\xa1\x57\x58\xc8\x2a\xd5\x6a\x28\x14\x5a\x6d\x55\xe5\xca\xa5\x1d\x2b\xc0\xf6\xd3\x9a\xd8\x5f\x1c\x11\x92\x15\xb4\x89\x05\xae\x05\x56\x0c\x2d\x26\x68\x7f\x0c\x61\xd5\x30\xb2\xc1\x2d\xb8\x6a\xd2\x2c\xfd\xde\xf2\x8a\xae\x1a\xe3\x3d\x51\x79\xa5\xc0\x2a\x54\x9c\x1f\xfb\xa0\xe2\x11\x4a\x99\xd2\x8a\xd4\xef\x6e\x47\xa2\x0b\x01\xe7\x18\xac\xb4\x2d\x17\xbe\x85\x54\x43\x21\x1a\xea\xfd\x21\x58\x3c\xb3\xb0\x8e\x61\x98\xe7\x4b\xe5\xc4\x27\xd4\xda\x49\xbc\x1c\x45\xbd\x07\x22\xdd\xa2\x22\x05\x6a\x22\x7c\x06\xec\xab\xe8\xc7\x00\x97\x42\x87\x93\x5a\xa8\x74\x69\x1b\x11\x5e\x48\x8f\x07\xed\x81\xa3\xb7\xc6\xaf\xea\x25\x55\x78\x68\x0e\xda\xff\x87\x04\x93\x88\x58\xae\x28\xab\x4a\x19\xda\xd2\xae\x2a\x15\xee\xb6\x83\x98\x83\x21\xa3\xea\x28\x2d\x7f\xc7\x05\xa9\xb5\x3d\x0e\x30\xe5\xe3\xa8\x3e\x53\x23\xee\xcb\xe0\x41\x7c\x1b\x31\x4d\xbe\x72\x31\x26\x2c\xbb\x60\xb5\x40\x6a\x53\x2a\xda\x1b\x67\xd1\x71\x3d\xd5\x08\x82\x17\x3d\x98\xbd\x27\x35\xc6\x02\xe1\x20\xf4\xd2\xee\x22\xaa\x64\x23\xe7\x91\x3b\xfe\xaa\xf6\x15\xb4\xa3\xda\xeb\xa0\xd0\x61\x0d\x5d\xd5\x70\x0a\x96\xb1\xd2\x09\x5f\xe2\xc2\xc3\xec\xbd\x6c\xef\xf4\x51\xf6\x50\x69\xfd\xa9\xf0\x6c\xac\xe9\x73\x2c\xe5\xa4\x84\xaa\xc5\xbd\xca\x17\xfd\x3f\xf4\xb3\xff\xa6\x6e\x49\xab\x42\xab\x0c\x63\x82\x49\x0a\x87\xc8\x93\xc6\x3e\x86\x92\x4e\xd1\x06\x33\x42\x3b\x8e\x6e\x18\x4e\x89\x1e\x38\xe3\xb9\xf8\x1f\x86\x91\x92\x76\x06\x05\xdd\x00\x43\x95\xf4\x04\x8c\x7f\x65\xe5\x88\x30\x28\x44\xb0\x25\x5d\x38\xeb\x9b\x6e\x57\x14\xad\xf0\x06\xfc\x5e\x02\x9c\xf2\x86\x41\xda\xdc\x1e\xcc\x6b\x52\xcb\x95\x06\xc2\x3d\x67\xe2\x6d\x1f\x9e\x58\x59\x21\x60\xdd\x3d\xc2\xdd\x39\x9e\xf6\xaa\x4e\x3a\xcc\xb4\xae\xe4\x62\x2d\x95\xd7\x8e\xbb\x76\x45\x19\xf9\xa7\x74\x1b\x55\xd0\xa6\xbc\x47\xa3\x9c\x84\x3e\xda\x59\x18\x2b\xcc\x35\x31\x05\xbe\x61\x6c\x96\x23\xf3\xb5\x22\xd3\x77\x62\xbe\xae\xd8\x8b\x80\x28\x69\xdd\x05\xa2\x52\xeb\x94\x69\xe3\xe5\xc4\xd9\x6a\xcc\x5e\x1e\x68\xec\x3b\x6c\x70\x57\x19\x7f\x59\x01\x53\x3e\xe9\x59\xa9\xfc\x1e\x31\x77\x33\x32\x1f\x58\x13\xb1\xd5\x9e\x23\xcf\x55\x9e\x5d\x43\x01\x64\x03\xec\x34\x78\xfe\x8a\xd1\x0d\x11\x61\xa9\xbf\x9a\xec\x72\xb2\xe4\x10\x41\x19\x62\xb0\x04\x06\x92\xe9\x0a\xdf\x12\x96\x72\x0a\x32\x18\x55\xb3\x19\xa2\xce\xcc\x85\xeb\x8d\x71\x51\x79\x71\xaf\x55\x1b\x0c\x3c\x80\x84\xab\x51\x4e\xd1\x76\x45\x8a\x95\x8c\x29\x17\x86\xd4\xba\xd1\x96\xa2\x2d\xde\xf1\xb9\x07\x1f\xa1\x7f\x9b\xa2\x4b\x2d\xf6\xc2\x0f\x0e\x64\x4a\x27\x08\x54\xd2\x41\x46\x6c\x91\x85\x56\x6c\x8a\x74\x5d\x30\xcc\x5f\xa7\xe8\xaa\xb6\xeb\x6b\x43\xb0\x04\x14\x33\x28\x81\x43\x8f\x40\x38\x76\xa8\xdd\x34\x39\xa7\x42\xa3\x28\x82\xcc\xd1\x33\xab\x9c\x9f\x88\xa5\x33\xf1\xf9\xfc\x83\x8e\xdd\xa6\xe8\x91\x0d\xab\x66\xff\x2d\x7a\x7e\x19\x32\x1b\xb4\x2b\x2c\xa3\x78\xad\x01\xb4\x31\x35\xd1\x62\x6d\x63\x41\x8f\xa3\xe8\x4a\x2e\xe4\x12\x74\x00\x62\xc3\x6f\xd5\xcf\xf4\xe1\xd1\x74\x84\x30\x8b\xa9\x74\xbd\x6e\x36\x5a\xd9\xc7\xee\x65\xa3\x73\x24\x22\xdc\x10\x01\x9c\xb3\x34\x67\xe8\x07\x52\x55\x4a\x8d\x2c\x00\x2d\x89\xcc\x21\x91\x3e\x15\xe1\x41\x12\xc4\xe6\xa8\x6b\x84\x24\x0b\x09\x92\xaa\x4d\xeb\x39\x1a\x11\xdc\x04\x84\x0a\xcd\xda\x0e\x3e\x47\x5f\xc5\xb1\xe7\xac\x47\xee\xe9\x00\xfa\x36\xb6\xb9\xab\x19\x94\x06\xe0\x38\xf6\x76\xec\xfc\x04\x6c\x13\x67\x0e\xa4\x26\xed\xe4\xb6\xf2\x36\x75\xac\xb5\xf8\xe3\x50\x2d\x67\x4a\x77\x9c\x2b\x61\x8e\x5f\xf7\x14\x47\x4f\x1e\xa3\x9a\x54\xe9\x26\x3d\x49\x73\xad\x1c\x09\x43\xe7\xe8\x2f\xb3\xbf\xd8\x26\x1f\x5c\x56\x65\xb4\xa7\xc9\x5f\xa4\x05\x98\xc0\x56\x7a\x1a\x37\xd0\xbe\xee\x9a\x86\xb2\x16\x4a\x39\xe5\x37\xbb\x06\xf8\x64\x3a\x47\xef\xc5\xff\xe6\xe8\x6b\x4a\xab\x0f\x01\x15\x18\xb4\x1d\xab\x55\x8b\x27\x5f\x85\x34\x13\x9d\x5b\xd6\xc1\x87\x34\xba\x67\xe8\x5a\x76\x97\x09\x2f\x61\x8f\x11\x15\x7c\x56\x6b\xf6\x86\x6c\xc4\x2a\xdc\x35\xd2\x78\x09\x8c\x9b\xb6\x57\x33\x66\x6a\xbe\x36\xd6\xba\x5c\xae\xfb\x42\xb9\xb3\xd0\xb4\x32\x5d\x2a\x01\xf1\x95\xf4\x67\x7f\xee\x78\x6b\x30\x17\xe8\x09\xa0\xda\x24\x0c\x93\x88\xf0\x98\x42\x93\x56\x12\x47\xfc\x77\xaa\x68\x14\x90\x88\x2c\xa5\x23\xeb\xf4\x45\xe7\x8a\xab\x39\x8a\xff\x5d\x80\xfc\x09\xbd\x47\x1e\x1c\x87\xdc\x2e\x2c\xaf\xcd\x07\x04\x15\x07\xf4\xde\x34\x5c\x62\xf1\x33\x4b\x7e\x9d\x58\xe0\x7d\xb8\xd6\xfb\x4e\xd2\xbb\xcd\x92\x44\x50\x43\xbb\xf5\x13\xe1\x8d\xcd\xd1\x57\xef\x7d\xe1\x93\x13\xfa\x90\x5c\x35\xba\xa3\xc9\x1f\x29\x77\x51\x83\x79\xf2\x58\xfc\x3b\x4d\x61\xac\xc7\x17\x20\x3c\x04\x92\x70\xf6\x43\x47\x30\x46\x4e\xf2\x1a\x96\x86\x2b\xf2\xf7\x6c\x41\x19\xa3\xdb\xc9\xf4\xc1\x49\xd4\x61\x81\x2b\x2c\x9c\x89\x73\xe9\x87\xce\xf4\x4f\xbf\x9d\x01\x3a\xf3\x89\xf4\xe4\x31\x52\xd3\x8b\x89\x32\xe0\xe8\xeb\x11\x92\x44\x89\x16\xbd\x71\x8d\xec\xa2\xf7\x97\x08\x7f\xc7\x8d\x6a\xd6\xf6\x52\x3b\x23\xc6\xcc\xd5\x26\x41\x6a\x9c\x96\xb1\x25\x71\xb1\xc1\xa4\xc2\x72\x7c\xa3\x3e\x27\x41\x56\x2a\xb9\x2e\xf6\xa1\x7d\x42\xf6\x35\xd6\x4f\xce\x7b\x1a\x1b\x86\x3c\x7a\xe4\xbc\x0d\xf5\x66\x6e\x0d\x18\x9c\xe3\x45\x60\x13\xc5\x49\x32\xe4\x0c\x87\x20\xca\x36\x4b\x87\xb4\x58\xa6\x95\xea\x93\xc7\x72\x0e\x06\x58\x20\xe6\x06\xb4\xfa\x77\xbf\x05\x33\x02\xea\x30\x2c\x1b\x06\xc1\x13\x2d\x8f\x2f\x5e\xbe\xf9\x66\xae\xdc\xef\x86\xc9\x1d\x1b\xb9\x45\x24\x7e\x6c\xe4\x7e\x5f\x57\x97\xc0\x96\x15\xdd\xa2\xff\xfb\x9f\xff\x75\xbd\x27\x29\x8b\x35\x08\x93\x75\x43\x51\x2d\x63\x89\x0d\xcc\x52\x83\xc8\xed\xa0\x6e\x21\x1d\x04\x01\x5c\x3b\xee\x0d\xe5\x6d\x3f\xa2\x88\x7a\xf0\x12\xd0\x02\x0a\x2c\x23\x4c\x81\x53\xb1\x82\xe2\x1d\xba\xe9\xb0\x08\x67\x01\x78\x0a\xba\x8b\xd3\x97\xe7\x46\xaa\x16\xb0\x94\x5b\x3a\xf5\x4e\x44\x3c\xad\x34\x4c\x6b\x5a\x92\x25\x81\x32\x46\x32\x32\xdc\x16\xd0\x1c\x3d\x74\xd3\xbb\xb3\xd8\xcd\xce\x30\x7d\x8e\xae\xe1\x97\x0e\xb8\xd0\xfb\x1a\xa7\x1f\x35\xf7\xa6\x72\x73\x0e\xca\x50\x8c\x51\x25\xc7\xa6\x4b\xf4\xe3\x24\xc4\x68\xfa\xd0\x37\x1e\xbe\x56\x12\xa4\x4c\x30\x38\xf6\x47\xce\x35\x61\x62\xf8\xe8\xf1\xed\x67\xfc\xb6\x29\xb1\x98\x6f\x72\x5a\x22\xc2\xab\x0b\xca\x44\xdc\x32\x38\x99\xa3\x14\xbd\xf2\x26\x9e\x3c\xee\x35\x4d\xb4\xb0\xcd\xea\x4b\x19\xb9\xac\x3e\x4f\x76\xb2\x8b\xde\xd7\x36\xf1\x72\x8e\xbc\xb6\xaf\x95\xfa\x13\xce\x96\x5a\xc1\x77\xa3\x68\x43\xf5\xba\xa7\x49\x1e\x4b\x57\xa5\x1c\x02\xdf\xbd\x8d\x1e\x3d\x46\x12\x44\xc6\xfc\x79\x82\xe5\xa4\x03\x92\xf6\xef\x19\x76\xb7\xe8\x55\xfa\x49\xe8\x9b\x1d\x6a\xc9\x5a\x44\x23\x26\xa1\xc7\x40\x66\x93\xd0\x0a\x37\x0d\xc4\xae\xf3\x31\x89\xba\x23\x66\xfe\xe7\x60\xe6\xe2\x0f\xc4\x8b\xe1\x8d\x33\x09\x87\x6e\x6b\x60\x0f\x66\x38\xb7\x89\x26\x21\xbb\x7b\x68\xd1\xf2\xdd\x9b\xe0\x7a\xb3\x35\x49\x71\xb3\x2f\xc6\x45\xb0\x0a\x5b\x3f\x60\xed\x77\x54\x45\xe8\x80\x74\x20\x67\x37\x32\x3d\x40\x6a\xa7\x7e\xbd\x16\xfe\x2a\xae\xcd\x6e\xb4\x13\xf5\x47\x2e\x2c\x17\xa1\x57\x36\x09\x21\x98\xc6\x34\x72\x72\x1b\x50\xee\xcc\xd5\x4b\x3a\x47\xee\x1e\xab\x8c\x2a\xc5\xe3\xd3\x48\x15\x66\xff\x74\x3e\xec\x5b\xd8\xbd\xa2\xaf\xcc\x56\xdf\x01\xfd\x7d\x83\x6c\xfb\x85\x12\xa4\x23\x0b\x3f\xca\x4c\x07\x9e\x09\x45\x2e\xba\x6a\x44\xc5\xf4\xd0\x39\xca\x84\xd4\xe2\xed\xc4\x6c\x5b\xf6\x90\x67\xa4\x0c\x54\x98\x44\x9d\x73\x60\xed\x24\x39\x57\x67\xb4\x99\xe2\x92\x84\x54\xa2\x3f\x27\x5e\x3d\x93\xac\x6e\x33\x6f\xdf\xd6\x66\x6f\x3e\xff\x36\xd3\xf5\x1a\xb6\x98\x95\x22\x0a\x93\x91\x74\x9a\x2d\x6b\xe0\x1c\xdf\xc0\x1e\x76\xcb\x15\xa1\x39\x7a\xa6\x4a\x6e\xcc\x43\x57\xe6\xbb\xba\x25\x15\xc2\x55\x15\xf9\x97\xc2\x31\x92\x19\x76\xd9\x4e\xa6\xb2\x17\x00\xbd\xbb\x56\x3f\x8c\x70\x4c\x50\xbe\x04\xde\x32\xba\x73\x38\x74\xe7\x06\x51\x23\x7e\xb8\x45\xdc\x4b\x4c\x13\xe2\x87\xcb\x52\xd0\xf5\x1a\x0a\xca\xca\x09\x29\x95\xfc\x49\x66\x92\xf2\x80\x05\xe5\xfc\x31\x5a\x81\x03\x46\xfc\x3c\x0e\x50\x0d\xed\x96\x32\x81\xe6\x85\xd1\xbb\x16\x6a\xf4\xee\xb6\x43\x7c\x0b\xbb\x24\xf8\x6f\x61\x77\x1c\xe8\x5e\x45\x39\x70\xfb\x87\xb7\x05\x2a\xf5\x9e\xf7\xf3\x38\x88\x81\x2e\x10\x11\xb4\x7a\x14\x08\x58\x42\xf8\x63\xa3\xb9\x67\x15\x48\xd2\x7a\xba\xfa\x4f\xcb\x5f\xc6\x3e\xc6\x26\xaf\xcf\xef\x1d\x60\xee\xd4\xd6\x76\x55\x01\x93\x9b\x44\xc2\x9c\x35\x50\x90\xa5\xf2\x5c\xae\x2e\x4d\xb5\x5a\x3a\x01\xac\x21\xec\xe4\x56\x84\xb3\x2d\xa3\x77\x65\xd1\x88\x3d\xec\x0b\x58\xfc\x72\x95\xa8\xde\x24\x6d\x91\x4a\x37\xa1\x99\x4d\x73\x66\x6c\x92\xed\x9c\xb7\x4a\x97\x6e\x13\x8b\xa2\xed\x38\x53\x4f\x4e\x1d\x50\xde\xeb\x83\x0d\x97\x87\x52\x68\xba\x52\x2f\x5d\xe3\x95\x7a\xef\x9a\xaf\xfc\xfb\x6c\xf7\x8f\x64\xc2\x9c\xf4\x7a\xda\x8e\xf5\x7c\xdd\xc3\x98\xf5\x8d\x6f\x6b\xd1\xe2\x1d\x02\x74\x07\x06\x2d\xc7\xf0\x28\xad\xf4\x65\x52\x06\x6f\xa0\xb5\xf4\xfa\x9e\xd4\x64\xdd\xad\xa5\x48\x5c\xab\xd2\xe5\x35\xd4\xed\x64\x1a\x73\xe6\x56\x5c\xf9\xbe\xe3\xad\xa2\xa6\xda\xc5\x54\xcb\x9a\xd6\x68\xad\x10\x50\x9b\x90\xac\xc7\x00\x4d\x7e\x9c\x1c\x8b\xfb\x74\x6a\x92\x2a\xda\xb1\xd6\xc9\x75\xea\x4a\x45\xcf\xe4\x45\xd7\xaa\x2d\x20\x89\xdf\x8f\x93\x90\x8c\x41\xb2\x21\xe1\x20\xdc\xca\xbb\xd8\x43\xe5\x24\x08\x61\x9d\x37\xd8\xc6\x2a\xcf\x68\x91\x43\x6d\x50\x2f\xae\x07\x98\xa0\x44\xc9\xe0\xb8\x1d\xba\x1c\x4a\xfb\xf5\xbb\xad\x26\x57\xa0\x16\x82\x34\x76\xd3\x39\x52\xdb\x5f\x69\x97\xff\xa5\xb6\x27\x7a\x1f\x2c\xbf\x51\x98\x48\x35\xd4\xd2\x61\x13\x6b\xf0\x51\xe8\xe4\x61\x7e\x37\xa3\xa6\xd2\x15\x7a\xd8\x23\x08\xd2\x93\x7e\x80\x2a\xb6\xd1\x4b\xc1\x90\x3c\x86\xfd\xfe\x63\x82\x34\x56\x32\x42\xfa\xf4\x72\x3a\x40\xa2\xc3\x51\x48\xd1\xc9\xc5\x21\xe7\xc0\xbc\x59\x01\x5a\xd2\xaa\xa2\x5b\xe9\x33\x6c\x69\x5f\x64\x2d\x1d\x8a\x0a\xb7\x60\x4b\xff\xb9\x52\x09\x4d\x45\x0a\xf1\xd8\x3b\xc8\x11\xf8\x23\xb8\x45\x5b\xcc\x51\x45\xb9\x73\x18\xe1\x19\x03\x5e\x40\x5d\x52\xd4\x35\x37\x0c\x97\x10\xa2\xa2\xcb\xb6\xbc\xf2\x31\x09\x8b\x6e\x6b\x55\xcb\xe5\x15\x99\xb7\x54\xf3\x35\xa8\xfc\x88\xbc\x2b\xe5\x39\x31\x47\x75\xf8\x05\x46\xa4\x55\x45\x33\xf8\x26\x44\x49\x15\x96\xf4\x95\x3f\x3a\x9d\x24\x9d\xba\xe7\xb4\xab\x4b\xa5\x95\xc3\x82\x26\x5d\x91\x40\x5a\xe9\xd1\xe9\x0d\x4e\xea\x7b\x70\x51\xa6\xc9\xad\xf5\x49\xae\xe5\xe7\x54\xbd\xfc\x58\x6b\x5a\xcb\x8d\x94\x55\x57\x83\x0c\xac\xb4\x41\x9c\xad\x78\xee\x83\xf8\xad\x97\x5d\x8c\xbd\xb3\xdc\x07\xa6\x10\x27\xf8\x18\xac\xe9\x06\x8c\xf6\x1c\x29\xa0\xc8\xe8\xc5\x7c\x82\x26\x93\x0c\x16\xed\x8e\xc0\xd2\x53\x69\xe3\xa5\x12\x69\x55\x35\xe8\xbc\x67\xf0\x2d\xa1\x72\xd0\x75\x90\xce\x95\xb2\x49\xdc\x5f\xda\x53\x2b\x41\x35\x9b\xad\x9a\xe4\xa6\x74\x13\xd7\xbb\x05\x2d\x77\x6a\x6d\xe1\x32\x51\xd6\xeb\x78\x2c\x5e\xfd\x96\x00\xfa\x2d\x34\xaa\x04\x71\x81\x8b\x77\xc2\x87\xe6\xa8\xa0\xeb\x06\xb7\x24\x53\x82\x9a\xa8\xdf\x8c\x2a\x82\xe3\x32\x4e\xbb\x45\x7e\x03\xad\xd7\x5c\x67\x03\x04\x57\xf4\x7f\x47\xb3\xfb\x5e\xff\x28\xd5\x3f\x34\xb2\x9b\x89\x1e\xee\xe0\x8e\x27\x33\x7e\x97\xa2\x83\x0a\xf9\x9e\x8e\xf6\xe8\xa3\x31\xd9\xed\xed\x55\xdd\xfe\xfb\x5f\x0f\xe8\x96\x1e\xd1\xa9\x68\x7d\x6d\x4b\xe9\x74\xa2\x79\xb4\xba\xdc\xb2\xcd\x49\x4f\x1f\x5e\x5b\x98\xe2\x74\x64\x27\xb5\x67\x66\xc2\x71\x5d\x39\x38\x51\x08\x4e\xe3\x12\xc2\xb0\x78\x2c\x3c\xd2\x11\xee\x57\xf8\xc5\xed\x84\x2b\x8b\xd3\x52\x5b\x36\x9a\xac\xd3\xf3\x80\xe4\xcf\x2b\xa0\x4c\x71\x5e\xeb\xd5\x64\xee\x57\x33\x75\xea\x6f\x0c\xf8\xaa\xe9\x51\x8c\x62\x50\xc1\xf7\x56\x4f\x2b\x77\xf6\xca\xa9\x27\x93\xe6\x53\x97\x1d\x76\x8d\xbf\x49\xc0\xc7\x6a\xe2\xd4\x5e\x95\x5f\x0f\x27\x13\xfd\xe6\xc4\xda\x0b\xff\xf1\xd3\x61\x34\x9d\xdc\xca\x11\x98\x66\x6b\xdf\x7c\x2c\xed\x52\x89\x10\xf5\xdf\x84\x35\x6f\xfa\x7c\x56\x74\x68\xe8\x5e\xd8\x1b\xe6\x86\xd2\xf5\x02\x2e\x2a\x36\x52\x47\x0f\xce\x85\x8d\x09\x83\x64\x67\x31\xcf\xc4\xfc\x74\x40\xdc\x60\x2e\x68\x99\x38\x6f\xd8\x57\xfe\x0f\x6e\x0e\x4b\x23\x67\xd6\xf1\x39\xf2\xa8\x16\x37\x74\x31\x46\xe7\xde\x04\x7c\xb0\x62\xf9\xaa\xd3\x1b\x2a\x7b\xc2\x95\xbd\x6f\x04\xa7\xfa\x1a\xb5\xb0\x0e\xb8\xaf\xac\x4d\x8c\x1d\x88\x2c\x3a\x4f\xcb\xec\xc4\x67\x70\x84\xf6\x34\x42\xb4\x70\x11\xed\x1d\xe2\xdb\xe2\xea\x8b\xa7\x87\xae\xff\x6a\x1c\xe3\x04\xef\xe4\x7a\x6c\xf5\x11\x14\x1d\x76\x64\xdd\xff\x8c\xa2\xd4\xe7\x55\xf7\x8d\x15\xdd\xae\xbd\xdf\x7a\x27\x0b\x66\xc0\x71\x4d\x2e\x92\x07\xf9\xf4\xb3\x2a\xaa\x8c\x1d\x21\x6b\xac\xd4\x29\x0c\x7b\x5c\x40\x95\x6b\x67\x6c\x54\xce\x17\x89\x7d\x99\x81\x19\xe0\x60\x35\xed\x8f\xb2\xc9\xc2\x8d\xa0\x2c\xfd\x04\x5d\x0f\xa4\xe0\x98\x9c\x98\x0e\xe1\x84\x4f\x00\x6c\xa3\x3c\x45\xa7\xb4\xab\xea\xdc\xfa\x20\xdc\xa2\x86\x32\x7b\xcc\xce\x40\xd6\xc6\x5d\xa3\x66\x50\x22\x5c\x56\xab\x62\x53\x00\xa7\x0f\xb2\xdc\xd2\xa5\x73\x9d\x0c\x14\x95\x1c\x3a\x81\x8c\x2f\x8b\x33\xb7\x12\xe4\x24\xcc\xca\x1b\x00\x4f\x50\x70\xb0\x78\xb6\x36\xf9\x3f\xf9\xe8\xda\x21\x52\xac\xac\x35\x47\xdd\xfa\x63\x64\x1d\xfd\xa0\x91\x19\xf1\xf1\xfe\x23\xa6\xa5\xc3\x13\x0e\xeb\xf9\xa9\xfa\x9f\x23\xe5\x38\xe9\x19\x8f\x06\x8e\x01\xbd\x47\x6a\x0b\xff\xa8\xb1\xfe\xa3\xc6\xda\xab\xb1\xd6\xe9\xed\x44\x4e\xdd\x1c\x0b\x5a\x63\xf6\x8e\xab\x4b\x21\x30\xf7\x80\xf8\xf7\x3f\xb8\xfa\xc6\x64\xc8\xb3\x81\xe0\xd1\x55\xda\x49\xa1\x0f\xcb\x99\xb3\xc5\xda\xff\xba\x75\xc9\x51\x9d\xb1\x91\xa3\xb4\xda\xce\xf1\xbd\xaf\x2b\x0e\x77\xc8\x5c\xd6\xeb\x8b\x43\xb6\xf6\x00\x0d\xef\x8a\x02\xa0\xf4\xe3\x37\x45\x17\x7b\x91\x85\x46\x8d\x70\x54\xa9\x08\x10\xd7\x62\x55\xc3\x2f\x1d\xae\xec\x81\x3d\x4f\x2f\xdd\x63\xcd\xf2\x80\xf0\x64\x77\x73\x32\x24\x0c\x2e\xfa\x90\xac\xb7\x7e\x9e\x22\x8a\xbe\x86\xc5\xdb\x94\xca\x87\x80\x36\xa1\xac\xf6\xd0\xa8\xf0\x6f\x85\x50\xaa\x8d\xcc\x15\xc8\x6c\x92\x39\xa2\x4a\x54\xec\x15\xd2\x30\x48\x58\x29\xe2\x29\xf7\xb9\xf7\xc2\x47\x6b\xc9\x32\x25\x62\x89\xed\xf5\x3d\x17\x66\xa6\x92\xad\xce\x0d\xe8\xd7\x66\xa0\xc1\xfd\xb5\x4f\x39\x90\xf9\xcd\x45\xc7\xee\x24\x5c\x5d\xda\xc3\xcd\xf2\xb7\x37\x84\x0e\xa9\x20\x51\x80\x31\x22\x50\xb9\x52\x8c\x23\x64\x23\xbf\xc5\x69\xd8\x7f\xf4\x5e\xf1\xef\x27\xa0\xfc\xda\x04\x8f\x0f\x6d\xf4\xf8\x30\x38\xe7\xac\x63\x46\x6d\xb5\x9d\x3b\x05\x54\xbd\x8e\xbf\xd1\xd4\xa7\x8a\xb0\xbc\x14\x2a\x71\xa3\x50\xe2\x2a\xaf\x31\xf9\x50\x7c\xd6\xca\x66\x9a\x49\x5d\xed\x95\x80\x49\x2e\x47\x9d\x7f\x89\x1a\x8b\xbf\x7c\x4e\xc6\x45\x6a\x2e\x97\x60\x88\x50\x49\x81\xd7\x5f\xb4\xea\xbc\xfd\x83\x30\x29\xe3\xfe\x72\xdd\xef\x00\xbb\x64\xe0\xbb\x77\x3a\x3b\xb1\xd7\x11\x64\x74\x86\x42\xee\x14\x8e\x5e\xd3\x1e\xd7\xa7\x33\x52\x1e\x23\x64\x4e\x46\xf1\x78\x39\x4b\xa4\x25\x6f\x27\x6a\xde\x8e\x52\x6e\xd1\x1d\x26\x70\x41\xdf\xdb\xc8\x9c\x53\x23\x63\xc4\x2e\x80\x7e\xbc\xe4\xf9\x80\x0e\x12\xbe\xf4\xce\xc8\x3d\x48\x60\xbf\xb5\x97\x11\xc2\xc3\x37\x65\xee\x17\x6b\x65\x98\x42\xcc\x9d\x3d\xa1\x91\xed\x82\x68\x47\x88\xb7\xac\xb3\x37\xa3\xbd\x08\x33\xff\x91\x93\xe6\x6b\xd1\x7c\x76\xfe\x9e\x37\x4a\x64\x56\xff\x5f\x34\x7b\x9f\x24\xbd\xce\xe3\x5f\xd5\x1b\x5c\x91\xf0\x6e\xb2\x7c\xfa\xde\xfd\x35\x9a\x94\xcf\x8b\x7f\x7f\x6e\x49\xc8\xdb\x4b\xa9\x6d\xbf\x11\x0a\x82\x4f\xfe\x61\xb0\xb8\x86\xe5\x3c\x45\xa1\xc1\xe0\xb1\xef\xeb\xee\xf4\x2b\xba\xe4\x6c\xc2\xb3\x15\xae\x6f\xb4\xef\xd8\x17\x78\xa3\x30\x73\x1a\xc6\xf7\x9d\x3c\x1f\xf7\x22\x2c\x38\x9f\xfc\x43\x78\x5e\x76\xeb\xa7\xf7\x19\x87\x16\xb1\x53\x3a\xb9\xcf\x3a\xce\x55\x50\x5a\xd5\xe9\x51\x34\x18\xe8\xd0\xda\xc8\x50\x70\x32\xf3\xb6\xa5\xab\x85\x26\x67\x44\x49\xed\xfe\x33\x9d\xbf\xd5\xfe\xbe\xc4\xf4\xc1\x60\x8d\x62\x30\x01\xaf\x18\xe6\x69\x0e\x9f\x49\xcf\x85\xac\xcb\x29\xa7\xc5\xa5\xa7\xec\x5f\x2c\x99\xe3\xb9\x0c\xc0\x5e\xc0\x36\x7d\x7e\xf7\x77\xc4\x64\x7f\xa2\x96\xb7\xf2\xf1\xd1\x7c\x0c\x2a\x85\x43\xb6\x0e\x16\x0d\x0f\xcb\x40\xc0\x17\x7d\x96\x73\xa8\x90\x75\x4c\x26\x3a\x53\x08\x1e\x5d\x5e\x9a\x3b\x3b\x90\x94\x15\x53\x4f\xfe\x79\x08\x8c\x3f\xdb\x3b\x92\x9a\x3d\x38\x9f\xa1\xf2\x48\x96\x4b\x73\x9a\x99\x9a\x7e\xed\x9b\xa3\x6b\xff\x81\x2c\xbc\x5c\x32\x80\x6a\x67\x73\x75\x22\x4e\xf7\x60\x71\x6a\x93\x1b\xea\x0e\x2a\x81\xce\xa9\x9f\x3d\x54\x3b\x27\x3a\x4d\xbf\x00\x7b\x2e\xd4\xcf\x39\x9a\x2d\x31\x5f\xfc\x04\x50\x58\x2e\xd5\x55\xbf\xd5\x0e\xb5\xea\xb6\x60\x84\xf9\x21\x9a\xcb\xcc\xec\xf3\x90\x46\x7f\xb6\xf7\x27\x8d\x19\x2a\x27\x33\x7c\x21\xb8\x81\xc3\xcc\x23\xd2\xac\x2f\x28\x30\x9a\x4b\xa8\x28\x37\x2b\x96\x15\x0c\xa6\xfa\xd9\x83\x31\xbf\x63\xa9\x08\xa7\x6a\x45\x42\x2b\xfb\x8f\x24\x14\x59\x0a\xef\xcb\x51\x79\x71\xa4\xc2\x10\x57\x95\x7b\xfe\xec\x0b\xbb\xc9\xa1\xee\x27\x17\x2f\xfd\xec\x84\xb9\x19\x4e\x97\x40\xf7\x27\x82\x4c\x62\xd4\xde\x25\x38\x28\x25\x7a\xfc\x8b\xaa\x9a\xfc\x9e\x24\xa2\x9f\xd6\x3d\xc9\x82\x4b\xc7\xb1\x0d\x2c\xbd\xef\xee\x1b\x82\x05\x96\xf7\x87\x46\x4c\x8e\xef\x89\xd4\x3b\x5c\x25\x05\x55\x2d\x61\x54\x8b\x67\x94\x3c\x38\xca\x40\xf9\x06\xe8\x2c\x44\x40\x58\xa2\x82\xd6\x9c\x94\xa0\xbf\x13\xc1\x5b\x52\x55\x81\x0d\x33\xf8\x90\x1a\xb5\xc0\xd6\xb6\x4a\xd3\xdc\xf2\xc0\x8b\x15\x94\x5d\x95\x17\xb8\xfe\x56\x92\xcf\xc4\x81\x4a\x4f\xd8\x8a\xa5\xf5\x22\x7e\x0b\xe7\x5b\xf4\xb5\xdb\xc1\xfa\x76\xfb\x27\x8f\x47\x44\x7d\x84\x83\x5a\xf9\x3d\xd8\xf3\xfa\xae\x60\xf8\xf1\xc5\xa3\xdc\xba\xe0\x5e\x35\x6f\xf3\xdf\xeb\xa7\x52\xbd\x66\x8d\xf0\x84\xe7\x36\x2a\xaa\x9f\x8d\x77\x95\x9e\xf0\xdd\x89\x6a\x28\x57\xa1\x2c\xec\x27\x77\xc3\x6e\xd8\x03\x57\x82\xd0\x70\x8a\x33\x55\x6a\x9c\xd8\x33\x1b\xcc\x7a\x86\x95\xc4\x51\xe2\x33\x4a\xe8\xff\x91\xfb\xbc\xd3\xdc\xa7\x4f\xdf\x20\xfd\x79\x50\x09\x73\xb4\xde\x6e\x9b\x03\xb5\xbb\xb4\x1f\x2f\x11\xda\x9f\x22\x1a\xce\x85\x1a\xaf\x30\x5b\x3c\xe3\x38\x18\x59\x85\x68\x80\x7c\x0a\x89\xb2\x34\x6d\xef\x40\x21\x06\xf2\x14\xcd\xd9\xaa\x43\x5b\x81\x10\xa8\xc3\x7e\x6f\xfe\xb7\xc9\x9d\x39\x1b\x8b\x4f\x63\xf4\xad\xb2\x3d\x3e\x93\x76\xd9\x97\x5e\xf8\xa2\x64\x5d\x4a\x13\xa8\x2e\xba\xe2\x1d\x8c\xcb\xd3\x27\xe3\x0b\xde\xb7\x50\x65\x7c\xc2\xbb\x91\xac\x03\xa4\xe3\xb8\x3c\x9b\x95\x83\x28\xd3\x76\xe5\xb9\x5b\xfa\xe3\x08\xbe\xb3\xe5\xa6\xc4\xda\x15\x70\x18\x4d\xc7\xe5\xa4\xe7\x93\x71\xcf\xee\x5b\x7a\x32\x6e\xda\xbd\x4b\xcf\x6f\x9a\x17\x73\x93\x28\x23\xa9\xd2\x4f\x28\x23\x76\x5f\xa2\x72\x68\x66\xec\xce\x65\xe4\xd8\x1c\x99\x8d\x35\x32\x79\x92\x28\xea\xf3\x7a\xef\x91\x20\xd9\x33\xf6\xfb\xec\x4c\xd3\x91\xe9\x8a\xfb\x73\x7a\xb2\x99\x84\x3d\xa4\x71\xcf\xd4\xc5\xbe\x49\x88\x71\xa9\x3c\x34\x17\xe1\x9b\x44\xaf\x73\x90\x9f\xb3\xeb\xe2\xd6\x99\xb8\xcf\xce\x7e\x1e\x99\xe6\xb8\x9d\x6e\xbc\x85\xb4\x1e\x97\xf0\xc8\xdd\x0f\xe1\x7f\xf9\x2b\x6e\x9b\xb8\xa0\xe1\xa2\x34\x87\x34\xe2\x9b\x19\x7c\x70\x4a\xb2\xb0\x6d\xef\x73\x9a\xbb\xdf\xc7\x8c\x0e\x51\x7b\x4d\x3b\xff\x53\x98\xc3\x8d\x25\x2d\xe5\x1d\xc5\x1f\x2f\x83\x11\xe6\x92\xae\xc3\x0f\xcc\xa9\xcb\x6a\x54\x18\x2d\xaf\x41\xb6\x4d\xe5\x87\x8b\xcc\x39\x25\xc2\x12\x9f\x88\xca\xae\x5b\xd1\x7d\x49\x99\x3d\x4e\xe1\x9e\xca\x8b\x93\x53\xfe\x25\x0d\xc1\x97\x09\xe7\x3e\x1b\x3d\xdd\xf2\x3d\x6e\x1a\x59\x1e\xbb\x0c\x0f\xb6\xca\x84\xc1\x60\x1a\x85\x40\xe4\x71\xf5\x19\x2d\xf3\x49\xb1\x39\x7a\xef\x94\x13\x7d\x14\x0e\x7d\x08\x12\x5b\xc9\x23\x58\xf6\x13\x67\xe7\xe8\x7d\xf6\x80\xd9\x45\x59\xea\x32\x7b\xdd\xfc\x0b\x9e\xfe\xd4\x67\xf0\xa5\x3d\xe4\x7f\xe1\x8b\xc9\x3b\x56\x3f\xeb\xf5\x22\xfe\x06\xb8\xf0\xf7\xd4\x04\x7f\x32\x0e\xc1\x85\x5d\x44\xe6\x4f\x5e\xf8\x36\xfa\x91\xdd\x14\xd0\x69\x0c\x67\x8f\x8f\xed\xa6\x89\x9a\xf5\x0e\xfe\x0b\xdc\x4f\x3e\xf2\x94\x78\x24\x2e\x95\x31\x9c\x8f\x3e\xf1\xfb\xd1\x78\x33\x74\xa3\x93\x65\x8d\x46\xe7\xa7\x81\x44\xa7\x9d\x85\x3a\x9a\x12\xe8\x1b\x79\x51\x52\x4a\x11\xe5\xce\xb9\xe9\x03\x2e\xa9\x2e\x93\x3d\x6c\xdc\xd8\xc5\x42\xde\xa7\x2e\x07\x6e\x18\xc2\x65\xd9\xd3\x7d\x52\xe0\x26\xe6\x83\x47\xef\x70\x41\x3f\x4a\x4d\x20\xb4\x1f\xe1\x77\x41\xb9\xfd\xfc\x2a\xe1\xee\x4c\x7c\x8b\x61\x3a\x93\x1a\x51\xa6\x3f\xc6\xbd\x00\x64\x4e\xae\xf6\xa7\xae\xe2\x0f\x39\x46\xb5\x44\x03\xdf\x0f\xf4\x67\x34\x1f\x20\xe3\x69\xc6\xa6\xc4\x76\xc0\x36\xeb\x69\x79\x27\x74\x0d\x2f\x68\x49\x6b\xfd\xc4\xe8\x28\x9b\x2e\xbf\x27\x81\xd8\x6b\x4b\xa4\xc0\xcd\x7e\x3b\x21\xfe\xa0\x33\x0f\xe7\xb0\x1a\x3c\xfc\xfe\xf1\x21\x5b\x22\x19\x52\x16\xb8\x19\x20\xe5\x88\xcd\x3b\xc0\xcc\x05\x7f\x07\x59\xbd\xe0\xef\x3e\x8c\x60\x78\xa2\x70\xe0\xfc\x4e\x92\xb0\xfb\x9f\xe0\xc9\x49\x81\x7e\x62\x83\x1b\x5c\xf6\x17\x81\xa8\x2b\x87\xc3\x6f\xc7\xae\x30\x37\x47\xdc\x85\xc6\x70\xae\x88\x54\xfa\x85\x85\x5f\x19\x8e\x6f\x1e\xee\x49\x1b\x09\xef\x5d\x4c\xc5\x93\xe6\xf0\x8b\xc4\xa3\x1f\xad\x91\xb3\x74\xc2\xd6\x14\xe1\x1f\x64\xe3\x57\xdd\x79\x36\x2a\xbe\xa9\xa7\x47\xc9\x72\xfa\xf9\xde\xa2\xdd\xff\x7f\x60\x63\x5c\x1b\x45\xf7\xca\x1f\xef\xb8\xa9\x62\x89\x32\xcd\xc8\xdd\x01\x15\x9d\xbd\xaf\xbd\x88\xd6\xf2\x98\xb0\x11\x1a\xc2\x35\xa8\x32\x32\x3b\xbd\xeb\x10\xaf\x9d\xdb\x7f\x5d\x72\x8e\xbe\x1a\xbc\x12\xa8\x3f\x5f\xaf\xa7\x9a\x47\x42\xfe\x13\x5a\x70\x75\x80\xdb\xdc\x48\xef\x9c\xa0\xeb\xcd\xa9\xbd\x4c\x25\x73\x9d\x9c\xbd\xc3\xd5\xfb\xa4\xaa\xf2\x04\xac\xed\x0f\xaf\xe6\x8b\x3f\xff\xdb\xd3\xd1\xc1\xe2\x37\xbe\xaa\xcc\xf8\x7e\x9a\x24\x03\x64\x1f\xc0\xd9\xfb\x19\x62\x1e\x9f\x3d\x4e\x38\x85\xa1\x88\xf9\x5a\x65\xd2\x0b\x89\xff\x22\x85\xaf\x27\x25\x21\x1c\x77\x7c\xe9\x87\x60\xb5\xfa\x24\x4d\xf5\xbd\x3c\x53\xf4\xe8\xc2\x5c\x82\xd5\xc3\x97\x3a\x28\xa6\xa0\xf3\x65\x79\x74\x8e\xce\xf4\x95\x4b\x67\x55\x5a\x90\x06\x00\xbd\x62\x64\x83\x5b\x0b\xa8\x51\x3f\x53\x80\xb2\x90\xa4\xf6\x18\x87\xe3\xc7\x6b\x49\x20\xbd\xff\x33\x3e\xbf\xa0\x43\x80\x9e\x23\x35\x19\x50\x4b\xa3\x10\xfe\xe6\x7f\x3a\xd9\x41\xcc\xb9\x88\xb2\xff\xea\xbd\x9c\x9d\xfc\x75\x56\x85\xad\x92\x34\xf2\x65\x61\x70\x62\x41\xee\x6d\x14\xd8\x38\x56\x5e\x1a\xcf\xe8\x14\xab\xbb\x39\xa9\x6f\x2a\x88\x1d\x3e\x5c\x97\x46\xcd\x38\x5b\x20\xd2\x60\x6b\x84\x67\x1c\x6f\x60\x32\x12\x94\x89\x25\x39\x3f\x80\xd3\x66\x99\x7c\x38\xf9\xff\x00\x00\x00\xff\xff\x79\x31\x93\x26\xc1\x8c\x00\x00"
-
-func lockedtokensCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_lockedtokensCdc,
-		"LockedTokens.cdc",
-	)
-}
-
-func lockedtokensCdc() (*asset, error) {
-	bytes, err := lockedtokensCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "LockedTokens.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe5, 0xd0, 0x70, 0x45, 0x40, 0x62, 0xde, 0xce, 0x83, 0x41, 0xcd, 0xc6, 0x70, 0xac, 0xaa, 0x1d, 0x94, 0x55, 0x83, 0x73, 0xa7, 0x7a, 0xd3, 0x16, 0x5c, 0x65, 0xa5, 0x49, 0xa2, 0x2f, 0x36, 0x76}}
-	return a, nil
-}
-
-var _nodeversionbeaconCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x3c\x5d\x73\x1b\x37\x92\xef\xfa\x15\xb0\x1f\xbc\x64\x2c\x51\xf2\xde\xd5\xd5\x95\x4a\x54\xca\xf1\xc5\x1b\xdf\x65\xb3\xae\xf8\xe3\x1e\xbc\xaa\x0b\x38\xd3\x43\xe2\x3c\x04\x18\x00\x94\xcc\x78\xfd\xdf\xaf\xd0\x00\x66\xf0\x35\x43\x52\x72\xae\x76\xd7\x0f\x89\x48\x02\x8d\xee\x46\x77\xa3\xbf\x80\xf3\xf3\x73\xf2\x76\x05\xe4\x27\x51\xc3\x7b\x90\x8a\x09\xfe\x1d\xd0\x4a\x70\x52\x09\xae\x25\xad\x34\x59\x89\xb6\x56\x44\xaf\x80\x6c\xa8\xd2\x84\xf2\x9a\x34\x5b\xbd\x95\x40\x36\x52\x68\x51\x89\x96\xdc\xda\x99\x6a\x76\x62\xe0\xe9\x15\xd5\x44\xad\xc4\xb6\xad\xc9\x02\xc8\x56\x41\x4d\xb4\x20\xf0\x09\xaa\xad\x86\xf3\x15\xe5\x75\x0b\x64\xd1\x8a\xea\xa3\x22\x54\x13\x4a\xc9\x92\xdd\x02\xb7\x5f\x91\x15\xb0\xe5\x4a\x23\xa8\x13\x8f\x9e\x02\x79\xcb\x2a\x20\xb4\xaa\xc4\x96\x87\x38\x65\x88\xcf\x7e\x00\x2a\xf5\x02\xa8\x26\x12\x94\xd8\xca\x0a\x10\xcc\xdd\x8a\x55\x2b\xc2\x94\xf9\x76\x23\xb8\x62\x8b\x16\x48\x23\x24\x81\x35\xd3\x9a\xf1\x25\x82\x8b\x79\x00\xb7\xc0\x2d\x26\x88\x05\x7e\x44\xc6\x50\xc6\xed\xf2\xd5\x56\x4a\xf3\xa5\xe3\x00\x72\x87\xb6\x2d\xfe\xb6\xdd\x54\x62\x6d\x00\xc7\xec\xe9\x21\x31\x65\x17\x87\xda\x7c\x21\x77\x44\xb3\x35\xe0\x54\x0f\x4e\x53\x83\x25\x53\x64\xbb\xa9\xa9\x86\x1a\x01\x08\x49\x68\x37\x62\x21\xb6\xbc\xa6\x72\x67\x29\xa3\xd5\x0a\xea\x98\x75\x39\x83\x9e\xd7\x6b\xc6\x3b\xe6\x20\x74\xb7\x45\xb4\xae\x09\x87\xbb\x14\x38\x03\xe5\x17\xae\x56\x94\x2f\x81\xc0\x27\xa6\x90\x67\x4e\x12\xf2\x09\x33\xf2\x72\xe8\x27\x52\x51\x4e\x04\x6f\x77\x64\x61\xb7\xc6\x02\xad\x09\x6b\x0c\xf1\x3b\x22\xaa\x6a\x2b\x09\x6d\x34\xc8\x88\xc9\xa1\x7c\x90\xa7\x1e\xf2\x3b\x64\xcd\x4b\x09\xf0\x1b\xbc\x06\xc9\x44\xed\xf9\xcc\x94\x21\xce\x88\x1e\x57\x06\x15\x14\x4c\x2e\x6a\x50\x64\x45\x6f\x81\x00\x17\xdb\xe5\xca\x71\x5d\x20\xfb\xb4\xf9\x23\xe6\xbe\xc5\x2e\xd8\xbc\xc1\x75\x91\x30\xda\x2a\x61\xc4\xde\x13\xb5\xd8\x21\x0d\x34\x62\xfa\x29\x59\x6c\xb5\xe5\x01\x6b\x9c\xd2\x80\x04\x42\x25\x10\x2e\x32\xc9\x09\x99\x77\xc7\xf4\x8a\xf1\x92\xf0\x15\x10\x12\xd2\x03\x0f\xf7\x75\x80\x63\x1d\x81\x9d\xea\x33\xad\xa0\x6d\x90\xac\x40\x91\x7f\xdd\xa2\xac\x0e\x48\xbf\x5d\xeb\x93\xce\x88\x98\x9d\xd0\xaa\x02\xa5\x26\xb4\x6d\xa7\xfd\x1a\xb9\xe5\xf9\x7c\x72\x42\x08\x21\x06\x9b\xf9\xd0\xbf\x6e\xc4\xf7\x68\x55\xcc\xe2\x6f\x34\xd5\x9d\x02\x33\xbe\x3c\x00\x48\x37\xe4\x8d\x96\xdb\xca\x18\x8c\x8d\x04\x05\x1c\x45\x5b\x89\x46\xdf\xd1\x40\x82\xa9\x22\x6f\x60\x4d\xb9\x66\x95\x5f\xa6\x03\x40\x5b\xc1\x97\xb8\x37\x64\x05\xed\x06\x24\x69\xb6\xbc\x32\x78\xa9\x6e\xcc\x4b\x21\x89\x84\x06\x24\x70\x23\x01\x0a\x80\xac\xb4\xde\xa8\xcb\xf3\x73\x05\xeb\x5b\x90\x33\x21\x97\xe7\x38\x3c\xe4\x94\xb2\xb8\xbd\xc1\x21\xe4\x33\xfe\xee\x41\xbe\x10\xeb\x8d\xe0\xc0\xb5\x22\x35\x34\xcc\x90\x4d\x28\x51\x1e\xcb\xdb\x00\xcb\x14\x6c\x0b\x9a\xac\xe9\xff\x0a\x79\x49\xde\xbd\xe2\xfa\xdf\x87\x07\x31\xbe\x7f\xd0\x86\xea\x6a\xb5\x77\x90\x84\x9f\xa1\x05\xaa\xe0\xd2\x70\x9c\xf1\xe5\xb7\x27\xdd\x60\xc6\x99\x9e\x84\x08\x9d\x46\x2b\x9f\x46\x4b\x9c\x96\x60\x4d\x03\xe6\x98\x7f\x46\x76\x67\x08\x91\xcc\x2d\xa9\x85\x9f\xcd\x12\xe6\x67\xf3\xff\xfc\x67\x5c\x93\xcc\xed\xda\x85\x9f\x3b\x24\xcc\x98\xee\x43\x37\xf0\xcb\x49\xb4\x5b\x3f\x83\xde\x4a\xae\x3a\x81\x62\xdc\xef\x6a\x23\xe4\x9a\x6a\x32\x81\xd9\x72\x46\x6e\xaf\x10\xd9\xeb\xd9\x15\x62\x75\x3d\xbb\xc2\xe5\xaf\xcf\xae\xfa\x25\xae\xa7\x11\x64\xaa\x08\x75\x6c\x28\x72\xff\x96\xc1\x9d\x91\x48\xa2\x85\x1d\x35\x99\x7a\xb6\x25\x4c\x33\xfb\x64\xa5\xf1\x85\x90\xe0\x86\xcc\x03\x5e\xce\x7a\x10\xd1\x44\xfc\x37\xab\x04\xaf\xa8\x9e\x3c\x9e\x3d\x1e\xf9\x35\xff\x25\xde\x8f\xd1\x25\xa6\x0f\x5e\x03\xb9\x39\xbe\x46\xf4\x15\xea\x99\x01\x6a\xb6\xf8\x4c\xba\x0d\x67\x0d\x61\xda\x1e\x84\x8a\x3c\x21\x12\xf7\x36\x9a\xc7\x9a\x4c\x4a\x1e\xcd\x09\x67\x6d\xc2\x72\xf3\xcf\x4e\xcf\x38\xdf\xd1\x7a\xf6\xb8\xa3\x3b\x81\xf9\x28\x46\x36\x10\xb9\x11\xb8\x45\x09\xfd\x86\xbc\xd8\x2a\x2d\xd6\x68\x55\xa8\xa4\x5a\x48\x45\xbe\x39\x2f\xcb\xb0\x96\x5b\xe4\x81\x13\xe0\x4a\x48\xf4\x24\x96\x12\xa8\x3d\xb6\x29\x8f\xe6\x6d\xa8\x32\xc7\x47\x38\x1c\x3d\x49\xda\x2a\x20\xc2\x9c\x7e\x77\x2c\xd0\x9c\xa2\xf0\x9a\x59\x7f\xb2\x0b\xbc\x5d\x51\x3e\xf9\x1f\x3b\xf1\xd2\x41\x9d\x5e\x92\xef\x84\x48\xb9\xcb\x1a\x32\x09\x2c\xc1\xa3\xb9\x9d\x64\x3f\xa6\x26\x23\x62\x59\x37\xe7\x3a\x9c\x32\xc6\xed\x7e\x2d\x34\x2b\xfd\x5a\xe6\xe3\xde\xb5\x70\xce\x75\x38\xe5\xa0\xb5\xac\x8d\xea\xd6\xc2\x8f\xfb\xd6\xb2\x73\xae\xc3\x29\x07\x48\x11\x6e\xd6\x3e\xe3\x76\x80\x60\x90\x80\x32\x33\x15\x7e\xdd\xd2\xd6\x78\x16\xbf\x83\x90\xfc\x45\x7e\x6f\xa0\xbf\x15\x87\x49\x4b\xc8\xa4\x54\xdc\x70\xfa\x94\xfc\xed\x6f\xfd\xcf\x1e\xb6\xfd\xe9\x9e\xac\x69\x41\xa9\xdf\x4f\x61\x7e\x04\xa5\x0e\xd7\x16\x47\xff\xa3\x12\x03\x7a\x56\x7e\x1d\x72\x7f\x77\x31\xf0\xa4\xdf\x4f\x06\x8a\x3c\xb8\x1f\xe9\x26\xd0\x8b\x89\x8b\x66\x3d\x98\xd0\x7b\x8b\xb8\xf3\x8e\x22\x9b\x48\x9e\x3c\x89\x7c\xa3\xc8\x88\x75\x3f\x3a\xcf\x68\x5e\x34\x21\x07\x73\xe5\x1b\xf8\x44\x2b\xdd\xee\xbe\x39\x84\x3f\x47\xb3\x46\x69\xc9\x2a\xfd\x20\xfd\x8f\x25\xbe\xa7\x3e\x70\xfc\x3a\x16\x94\xfc\xbf\x80\x1b\x11\x27\x4c\x18\x79\x31\xbb\x98\x5d\xf4\xd1\x51\x4a\x86\xa1\xe0\x37\x90\xc2\xa2\x8b\x0e\x5b\x1a\x02\x38\x6c\xdd\x08\xe7\x3c\x5f\x74\x8e\xf3\x45\xe7\x34\x5f\xc4\x0e\x33\x67\xed\x34\x45\xcd\x85\x40\x59\x46\xc4\xc7\x78\x86\xfb\x8c\x27\x19\x8d\x30\xee\xd1\x2b\x60\xd2\xc6\xe8\x43\x31\x8c\x8f\xf3\x7c\xce\xe2\xf3\x60\xa8\x80\x60\x7e\xc0\x48\xdf\xba\xfc\xff\xf6\xaf\x83\x63\x1d\x32\x9e\x3f\x49\x48\x51\x80\x74\x9a\x4e\x99\x16\x02\x87\x60\x1e\x99\x87\xf8\xe4\x43\xbd\x3f\x3f\xcf\x02\xae\x51\x01\x30\x9b\xdb\xe5\x6f\x66\xe4\x9d\xb1\x79\xe8\xc8\xdb\x08\x14\x5a\x72\x4b\xdb\x2d\x74\x73\xcd\xd6\x74\x8c\x5f\x40\x23\x64\x9c\x2c\x5a\xc4\x89\xbb\x59\xbf\xb5\x6c\xbd\x69\x59\xc3\x40\x11\xa8\x97\x40\x2a\x23\xb7\x95\xa8\xa1\x1f\xf2\x36\xc5\xc7\x68\x27\x4d\x52\x2e\x17\x28\x04\x2b\xda\x47\x30\x56\x86\x7b\x30\xaf\x30\xa9\x45\xdb\x3b\xba\xb3\x34\x36\x4c\x2a\x4d\xa0\x85\x35\x26\xbc\x78\x88\xb0\x17\x83\xef\xcc\x1a\x3f\x32\xa5\x87\x75\x20\x11\x1c\xa3\x0c\xc3\xb2\x64\x64\x22\x98\xe4\xa3\x97\x50\x97\x52\x0d\x4a\xe1\x47\x7b\x1c\x49\xd0\xc5\x69\xf4\x5b\x27\x48\xc1\x82\xdd\x80\x4c\xc7\x9e\x77\x89\x4c\x9b\x00\xf4\xd9\xbf\xbb\x15\xf0\xd1\xbc\x5f\xbc\x51\x5d\xfc\x68\x79\x9c\x25\x2a\x6c\xaa\x73\xbd\x55\xba\x4b\xdb\xa0\x5a\xdb\x54\x09\xa6\x08\xdc\x8e\x4a\xcc\xe6\x89\xc6\x65\x63\xcb\xcb\x6c\x40\xba\x84\xa7\x08\xd2\x2d\x46\x14\xde\x83\x64\x0d\xab\x28\x7e\xf1\x93\xa8\x21\x81\xe0\x12\x68\xf7\x4e\x98\x12\x25\xa4\xb6\x39\xb4\x38\x37\x1c\x2e\xa2\xe0\xd7\x2d\xf0\x0a\x8c\x75\x92\xc6\xbe\x29\x33\x5e\x70\x20\x40\x2b\x97\xdd\xa3\x3c\x4b\xb9\xce\x48\x28\xb5\x49\x8a\xeb\xd6\x10\xb6\x23\x5c\xd8\x69\x8a\xdc\x81\x04\xb2\x66\xe6\x68\xca\xa5\xd4\x82\x8e\x92\x58\xbd\x04\xc5\xc2\xce\x40\x5d\x92\x0f\x89\xb8\xdd\xf4\x32\xe5\x89\x89\x8c\xde\xb4\x97\xa0\xef\x23\xb9\xa1\xbc\x90\x33\xee\x34\xb8\xc1\x04\x9f\xd9\x3e\x26\xea\xa2\x30\x65\x23\xd6\x40\xd5\x56\x82\xb1\xf4\x3e\x41\x3f\x69\xa4\x58\xe7\x69\xd8\xe9\x10\x1b\xc2\x7c\x9e\xc3\x24\xcc\x34\xbe\xb0\x69\xd1\x49\x13\x7c\xe7\x89\x0d\xe8\x7c\x41\xb9\xe0\xac\xa2\x2d\x51\x5a\x48\xba\x04\x73\x92\xad\x50\x8c\xcb\x59\xff\x38\xa9\x9d\x23\x67\x8c\x02\x8e\x79\x63\xe1\xbd\xa6\x7a\x75\x49\x82\x0f\x0f\x58\x3b\xaf\x38\x94\xd7\xef\xc6\xed\xc7\xe1\xbb\xd0\xee\x32\x5e\xc3\x27\xa8\x4b\x59\xf6\x70\x1d\x6f\xf8\xa3\x53\xd1\xef\xc1\x25\xf9\x6c\x99\x9c\x59\xce\xd0\x07\xb0\xea\xf6\x5c\x4a\xba\xf3\x6a\x5b\x48\x43\xc7\xda\x38\x84\xc4\x2d\x95\x83\x96\xfe\x92\x7c\xb0\xd8\xdc\xf4\x8b\xbf\x32\x54\xee\x3b\x22\x8c\xad\x1a\xcc\x30\x77\xf8\x9d\x76\x40\x85\xc4\x84\x0b\x6b\xc6\xd2\xea\xbb\x11\x0a\xf0\x00\x7b\xe7\x26\xf6\xcc\xb4\xd8\x7f\xdb\x63\x3f\x9c\x83\x77\x56\x9a\x6f\xd7\x0b\x90\xbd\xad\x25\x13\x2c\xa7\x85\x8a\x25\x38\x4c\x51\xcc\xac\x01\x4f\xb1\xec\xd6\x72\x15\x09\x57\x2d\xd0\xc6\x7e\x8a\x3b\x63\xd1\xee\x5d\xf2\x48\xc9\x48\x4b\x1f\x87\x6e\xf0\xcb\x82\x56\x07\x42\x2d\x44\x0b\x94\x93\xa6\xa5\x4b\xa4\xf3\x23\xc0\x06\xfd\x4c\x49\xab\x8f\x66\x93\x68\xa9\x00\x47\x38\x40\x8d\x44\x2d\xa0\x33\x7d\x82\x5b\x19\x58\x79\x9d\x1a\x41\xd2\xcc\x41\xc3\xf9\x17\xfe\x13\x7c\xd2\x9d\x1a\xda\x08\x20\x3c\x9e\xb1\xba\x68\xb3\x14\x3a\x38\x4f\x92\xea\xdc\xfb\xd8\xe1\xca\x4e\x96\x61\x4c\x0c\xca\x11\x85\x88\xd6\x9b\xd4\xe8\xf7\x18\xc5\xa5\x3a\x44\x6b\x4d\x39\x35\xbb\x5f\x28\xd2\xf9\x69\x6b\xca\x50\x77\xad\x21\xd7\x46\x2a\x62\xaf\x30\xb4\x4c\x1d\x74\xbb\x58\x5c\x60\x78\x5e\xd7\xca\x28\x91\x3d\x3a\x54\xa1\xf0\x38\x2b\xfa\xe5\x18\x7e\x81\x4e\xfd\xaa\xcc\x28\x25\x03\xd2\xbc\xd5\x46\x42\x21\x93\x95\x40\x89\x5c\xf5\x6b\xb2\x04\xfd\xc2\x2a\x14\x5a\x8d\xc9\x74\xd6\x55\x0c\x73\xd3\x3d\x22\xbf\xc5\x0c\xf2\x25\x79\x3c\x70\xf6\xcc\x72\x72\x2f\xcd\x51\x62\x14\x54\x81\x3e\xb7\x1c\x2c\x55\x6e\x8d\x26\xa0\x2d\x70\x86\x41\x48\xff\x97\x33\x86\x1c\x8c\x21\xc2\x72\xea\x8c\xfc\xd9\xb9\x75\x51\x42\xed\xaf\x93\xaf\x45\xf5\x14\x2b\x93\x4b\xa1\xc9\x5f\xd3\xdd\x0a\xf9\x3c\x7d\x9c\xe4\x0b\xc3\x4f\x18\xb2\x5b\xd3\x86\xaa\xae\x85\x0d\xfd\x95\xc0\xef\xac\xbe\xdc\xb1\xb6\x0d\x15\x1a\xb5\x19\x55\xcc\x7c\xf3\xc2\x99\x38\x23\xb9\xb4\x6d\x21\xde\x8d\x9c\xa2\x21\x15\x27\x73\x5c\xfa\x24\xab\x71\xb8\xc4\xfd\x7c\x3f\x77\x3e\x8c\xb0\xe1\xc6\x65\xf4\xf7\x60\x77\x14\xc4\x79\x2a\xdf\x59\xde\xd7\xe1\x9e\x2b\x06\x96\x7a\x99\x22\x77\x26\x8c\xe4\x4e\x69\x0d\xdb\xb9\xd0\x2b\x63\x68\xa1\x55\x10\x99\xd3\x5a\x70\x18\xc8\x14\x8f\x25\x84\xcf\xcf\xc9\x7f\x83\x3d\x51\xb4\x20\x8c\x2b\x90\x76\xbf\x17\xb1\xdf\xa2\xed\x8e\x0b\x59\x83\xf1\x2a\xdb\x2e\xc8\x0b\x00\xf9\x43\x8b\x72\xc2\x38\x34\x0d\xab\x18\xe6\x1b\xda\xa5\x90\x4c\xaf\xd6\xb6\x56\xce\xd0\xc2\x1a\x65\x82\x4f\x1b\xa8\x8c\xc4\xa0\x35\x34\xe0\x5b\xeb\x18\xa4\x80\xf3\x70\xc2\xcb\x5c\x2b\xf8\x32\xc6\xc3\x18\x67\x76\x88\x34\xf4\xc1\x6a\x0b\x7c\xa9\xe3\xb4\xf9\xdd\x8a\x99\xb0\x8d\x5c\x93\x67\xe4\xc9\x93\x23\x80\x7d\x60\x67\xcf\x6e\xc8\xf5\xa8\x65\x2b\xec\xb7\xc1\x98\x91\x33\xf2\x6c\x44\x19\x8f\xa0\xc8\xee\xe3\xc4\x1c\x8c\xec\x74\x0c\x97\x69\x26\x0d\x5c\xa0\x58\x19\x69\x70\x2d\x22\x5d\xf4\x9f\x3a\x4f\x64\xcb\x31\x05\xcc\x34\xca\x69\xaa\x3d\xc6\xbf\xa1\x0a\xc8\xb3\x4b\xeb\xf7\x92\x8d\x60\x26\x02\xd3\x82\x50\x62\xfc\x1c\x19\x0b\x99\xf6\xb1\xb3\x89\xf8\x2c\x05\x89\xb5\x38\x3f\x27\xf3\x6b\xb3\x9c\xeb\x49\x92\x60\xce\x47\x0b\x17\xa3\x61\xed\xcf\xfb\x1a\x3e\x15\x71\xf9\x63\x8e\x0b\x1a\x32\xae\xb1\x11\x82\x5a\x4a\x24\x6c\x5a\x5a\xf9\x96\x0f\x23\xd4\x88\xcd\xf1\xb8\x18\x41\x87\x3b\x0b\xfe\x94\x28\x86\xc1\x2d\xca\x7f\x90\xf5\x11\xad\x71\x82\xa0\x88\xef\xbf\x78\x7c\x0d\x5a\x1d\x6c\xc3\x41\xee\x90\x6e\xfb\xb3\xc3\x00\x73\x1a\xfc\x92\x7d\x82\xfa\xb5\x19\x5f\x40\x5a\x3b\x3d\x65\x6d\x0b\x4b\xda\x62\xe4\x5e\xd9\xf3\x6d\x45\x37\x1b\xe0\x0e\xd3\xbe\x93\xc5\x2c\xe5\x5b\x56\x0a\x5b\xa7\x66\xa9\x61\xcb\x65\xb5\x2c\x41\xf3\xa1\x32\xea\xc1\x00\x9c\xa7\x35\x39\x5a\xdf\x8d\xb6\xa5\x15\xd7\xfe\xaf\xc8\x77\xfa\x0f\x68\x01\x7d\x26\xbe\x2f\xf2\x20\x05\xef\xa9\xc6\xd9\xa9\x03\x55\xc8\x66\x1e\xe6\x33\xfd\x7d\xf8\x48\x45\xa2\x3a\x37\xc9\xfe\xfa\x0f\xe5\x22\x0d\xbb\x44\x65\x71\x4c\x8d\xaa\xcf\x8d\xfd\x17\x44\x7b\x3b\x3d\x9e\x87\x3f\x05\xb9\x5b\xec\x05\x82\x3a\x4b\xe0\x8e\xe1\xfb\x8f\xe5\xc2\x61\x2a\x7d\x2d\x6e\xe3\xd4\xd7\x9a\x6e\x30\x9e\x34\xa6\xc9\xab\x1c\xee\xf5\x77\x71\x8c\x74\xf8\xfe\x48\x5c\x64\xf2\x11\x76\x97\x64\xf4\x00\x0c\xdc\x21\xd9\x23\x16\x71\xbf\x4b\xa4\xfd\x13\xbb\x43\x99\x33\xd2\xbb\x44\x17\xc7\xba\x44\xc6\x21\xfa\x0a\x0e\x10\x55\xe8\xd5\x1c\xb7\xf2\x3c\x2a\xf9\xc4\x39\x7f\xb2\x06\xa5\xe8\x12\x8e\xd7\xd1\xcc\xae\x39\x4f\x9e\xa1\xe8\x9e\x1a\xed\xea\x5d\x03\x6c\x24\xc5\x01\xde\xc8\x85\x72\xf3\x38\x11\xc1\x23\x76\xca\xc9\x34\xba\x79\xb9\x20\x5b\x77\xc0\x38\x0f\x2b\xea\xa3\x84\xc6\xf8\x05\x56\xf0\x76\x62\xeb\x4f\x7d\xe7\xe8\x49\xa8\x84\xac\x23\xdf\xa4\xe8\x49\x39\xd3\x81\x4d\xaf\xe8\xa5\x58\xd8\xb4\xae\x25\x60\xbd\x1f\x13\x65\x4e\x86\x2d\x06\x2c\xf0\x5e\x44\xd3\x74\xd2\x7d\x9a\xc2\x5e\x40\x45\xb7\x0a\x7a\xf1\x47\xe5\xb8\x33\xac\x94\x1a\xe4\xbd\x1d\x0d\xd7\xaf\xf5\xe4\xc9\x7d\x3d\x8d\x47\xe4\xfa\xfe\xbe\x46\xa9\x8b\xe7\x70\x0f\x27\x75\xac\x03\xef\x24\x71\x54\xde\xb9\xe4\x4e\x31\x4d\xc9\x78\x39\x1d\xc9\x5c\x02\xb2\x91\xe2\x37\xe0\xc7\x64\x81\xc2\xb3\x74\xc2\xe1\xae\x94\x34\xcc\x9c\x1a\xa1\xf4\x41\xdc\x18\x39\xb7\xd1\x6d\x4c\x97\x3b\x22\x9d\x93\xe0\x69\x83\xeb\xc5\xb6\x69\x40\xda\x48\x46\x68\xb2\x91\x62\x03\xb2\xdd\x19\xb2\x1f\xa5\x07\x6c\x2a\xb5\x7f\x02\x5d\xec\x6d\x9f\x65\xd9\x8a\x2a\xf0\x5b\xba\x9a\xf4\x90\x3f\x93\xad\xd3\xbb\x06\x66\xd3\xbc\x73\xb0\x80\x9d\x30\x2e\x7c\x88\xc0\x29\x51\xb4\x41\xcd\x5c\xd3\x8f\x5d\xfe\xf7\xff\xc1\x4d\x1f\xdd\xb6\x74\xd7\xee\x91\xb0\x30\x4c\x34\xee\x49\xe8\x0d\xec\x8e\x3a\xe0\x3e\x1c\xaa\xf1\x37\x19\xff\xbf\x0f\x72\xf1\x46\xc7\xee\xe0\x0f\x2e\x73\xef\x98\xdf\xee\xc2\xb6\x7e\x8c\xec\x24\x86\x80\x51\x81\x2e\x05\x1b\x96\x42\x22\x47\x23\x2a\x18\xf8\x7f\xee\x18\xcc\x78\x57\x10\xad\x23\xfd\x61\x72\x55\x60\x6d\xc1\x64\x16\x57\x4a\xb6\xb6\x04\xeb\x34\x83\xb4\xff\x00\x3e\x48\x7b\xcd\xb1\xe2\xf4\xd7\x1c\x16\x0b\x09\xf4\x63\x96\x57\xdf\xf9\x34\x9a\x75\xb4\xb0\xd6\xad\x66\xe4\xad\xff\x21\x00\x62\x6f\xac\xe0\x7e\x64\x71\x5e\x6c\x0b\x8e\x3d\xbc\xf7\xe8\x43\x04\xcc\xf8\xd1\xf7\xab\xc2\x26\x60\x07\xbb\xdc\xbe\x5f\x33\x6d\x4f\x0b\x7b\x6f\xab\xbb\x07\xa6\xf0\x0a\x86\xa7\x7d\xbb\x59\x4a\x5a\x83\xbf\x45\x15\x42\x78\xd5\x44\x7e\x3b\x36\x01\x54\x9a\xdd\x82\x6d\xde\xc2\x66\x06\xda\xb2\xfa\xd4\xe5\x33\x8d\xa3\xda\x9f\xf0\xaf\xfd\x7a\x78\xe5\x23\x02\xac\x45\xb7\xac\xc1\x71\x2d\x6a\x68\x83\xbe\x08\x97\x2a\xea\x2a\x36\x8c\x57\x42\x6e\x84\xec\xcb\xe2\x01\x86\xc0\xf4\xca\xdd\x40\xf2\x10\x84\xcc\xf0\x64\xbc\xc3\x14\x92\xf6\x0e\xe7\x5f\x47\x70\xd9\x92\x0b\x89\xa5\xfb\xda\xfe\x8e\x51\x03\x17\x04\x9a\x06\x2a\x3d\x23\xcf\xdb\xd6\xd2\x6e\xbb\x2a\x8c\x0b\x04\xb2\x11\x72\x1d\xf6\x89\xb8\x75\x22\xc8\x45\xa2\xfc\xf5\xa3\x98\x65\x09\xa9\x38\xcb\x1b\x7e\x14\x1f\x5c\x83\xae\xfb\x79\x38\xed\xbd\xaf\x71\x06\xfb\x4a\xd6\xdb\x56\xb3\x4d\x0b\x18\xf8\xa9\xd3\x08\x32\x55\xd6\xdd\x4b\x25\x02\x09\xc7\x5b\x5e\xc3\x7e\x83\xc1\x63\x64\x75\xe3\x38\xf8\x9f\xdf\xfb\x26\x1c\xdf\xde\x65\xf7\xe8\x3d\x83\xbb\x21\x7f\x02\xa9\x3c\x16\x7c\xfe\x5d\xbc\x54\xff\x77\xa8\x3a\x24\xee\x01\xca\x9b\x05\xec\xc1\x00\x9d\x52\xdd\x96\xca\x8b\x46\x5e\x3e\x02\x6c\x94\x2b\x96\x8a\xa6\x74\xbf\x90\x74\x7d\x31\xf6\xa2\xa3\x85\xdf\x05\x14\xd8\x96\xb5\x00\xb2\x02\x4c\x1e\xb6\x3b\x2f\x1e\xc9\xfd\xca\xbe\x7e\x1e\x3a\xd6\xe9\x25\xcc\xfe\x8a\x67\xe7\xd7\x63\xd7\x4d\xd8\x3d\x88\xc8\xf7\xe0\x0c\x15\x38\xb0\xde\x4a\x3f\x44\xed\x94\x86\xb5\x21\x8b\x2b\x8a\x57\xa6\x86\x4b\x94\x3d\xf3\xc2\x32\x65\x5f\x04\xee\x93\x0d\xe3\x2b\xb8\x8a\x2e\x9e\x9a\xc3\x22\xd8\x81\x9d\x14\xaf\x16\x55\x2b\xa8\x3e\xbe\x2c\xf9\x00\x93\x69\xde\xab\xff\xe8\xf0\x8c\xc7\x70\xf7\xfe\x51\x79\xfe\x91\x84\x8a\xed\xe4\xcf\x49\x32\x53\xf2\xfa\xf4\xa4\xd0\xc3\x94\x2f\xd7\xbb\xa3\xef\xd3\xe1\x93\x69\xf1\x2c\x71\xfc\x36\x2b\xf7\x3a\x7f\xc8\xf2\xa4\xd0\x43\x35\xf5\xf7\x06\x23\x15\x8f\x9b\xb2\x0a\x74\x64\x5f\xe5\xde\x46\xdf\x93\x95\x13\x3d\x5e\xd0\xcf\xee\x11\x3d\x47\x0f\x21\xd7\x90\xae\xd7\x20\xf8\xaa\xeb\x6b\x73\x21\x99\xd1\x1d\x95\xe4\xe4\xe2\x1b\x19\x24\x0a\xb4\xdd\x19\x24\xf8\x1f\x74\xd2\x38\x91\x78\x8d\xf6\xda\x2d\x36\xe7\x9b\xb1\x94\xef\xba\x0e\x13\x57\xe8\xc0\x6e\x90\x3d\xd2\x36\xce\x89\xa2\xab\xbd\x67\xca\x53\xf2\x6c\x30\x64\x7d\x61\x74\xcf\x61\x1e\xd6\x66\x5c\x9d\xa3\xa3\x30\x73\xe6\xc2\xe2\xe7\x5a\xdc\x86\xc7\x7e\x26\x8e\x63\x0a\x9e\xdf\x32\x7a\x70\x54\x74\x58\x14\x73\x5c\x28\x18\xce\xbe\xa5\xb2\xe3\x83\x6d\xb6\x9a\x1f\x9e\xc7\x28\x24\xf5\x62\x58\x57\xf7\x4f\x73\x24\x9c\x38\x2e\x4d\x18\x61\x71\x43\xae\xe6\x25\x0e\x15\xea\x21\x09\x23\xe2\xcf\x4f\xb3\x8c\x62\xba\xdb\xc9\xfc\x92\x78\x0f\x24\x84\x8a\x65\x7b\xee\xeb\xa6\xf7\x90\x8a\x0c\x9b\xbf\x9f\x94\x93\x6d\x38\x78\x08\xc8\x88\xb2\x3d\xf9\x94\x57\x0d\xb9\x03\x7f\x7b\x88\xf6\x3a\x2f\xe1\xac\xf3\x6a\x0b\xfd\x65\x0f\xac\x4b\xf4\xf8\x90\xd8\xd3\x1b\x39\x10\xdd\xae\x96\x5b\xa2\xd3\xf6\xce\x73\xdf\xd6\xe1\xfb\xbd\x8d\x63\x8e\x59\x4e\xaa\xba\xca\xb7\x37\xef\xce\xa1\x2b\xbe\x63\x41\x0a\x3e\xce\xe8\xa9\x5d\x38\x66\x0b\xd7\x85\xf1\x74\x70\x39\xc4\xd2\x8c\x39\xf9\x70\x93\x49\xec\x83\xad\xa5\x55\x1a\xf7\xfe\x44\xf8\xee\xc4\x7f\x6e\x95\xf6\x7d\xfd\x98\x0f\xa6\x0a\x5b\x2b\x67\x25\x10\x9e\xaf\x26\xfa\x39\xed\x8e\x41\x42\x35\x69\xc1\xcd\x73\x09\xee\x7d\x9d\xa9\x19\xf4\x90\x2d\x33\x2c\x90\xd7\xfb\xf5\xf1\x43\xb1\xa2\xfa\xa0\x2c\xd5\x01\x15\x9b\x9b\x6c\xd5\x9b\x47\xf9\xb5\x68\xcf\xd3\x80\xae\x3d\x0a\x79\xf6\xcc\xb6\x07\x55\xed\xb6\x4e\x1e\x36\x31\x07\xb4\xa8\xbb\xd8\xa4\x4f\x84\x2d\x8c\x3c\x67\xe7\x96\xd2\x54\x1a\x8d\x2b\x70\xb0\x2c\x37\xdf\x7e\x7b\x7f\x1b\x38\xcd\x2a\x4a\xd8\x31\xc6\xeb\x07\xf4\x0d\xe4\x11\x81\x25\xe9\x8a\x5c\x8c\x34\x72\x39\x8d\xc7\x72\x49\x17\xfc\x7b\x7b\xd1\xd5\x48\xa8\x26\x17\xb9\xd7\xea\x38\x76\x31\xb6\x47\xbe\xce\x87\x83\x4f\x8a\x85\xbb\x2b\x24\x3c\x47\xb1\xbb\xa5\x75\x5c\x16\x95\xe5\xb2\x76\x3f\x45\xc1\xb5\x4b\x62\x6a\x6b\x82\x7b\x4e\xf0\x21\x59\x1e\xba\xab\xb7\xa7\x05\xa2\x78\xfd\x70\x39\x5e\xf9\x98\xfa\xdc\x44\x7e\xa1\xef\xf8\x1e\x8c\x01\xbc\xd3\x18\x22\x4c\x19\x97\xd2\x0c\x71\x02\xa1\x7c\x29\xc6\xb5\x94\x17\xe7\xef\xb9\x28\x13\xb2\xe6\xa7\xd4\xf9\xf7\x7e\xff\x18\x63\x30\x48\x1d\x0f\x1b\x52\x76\xbc\x74\xef\xb0\x58\xbc\x65\xbe\xa9\x7d\x03\x57\xd0\x36\x61\x7e\x5f\x0b\xd5\x33\x44\x42\x95\x3d\x41\xd4\xdf\xdd\xfb\x33\xdd\x79\x14\xe3\x5b\x7d\x47\x9d\xb9\x7b\xee\xb5\x19\x65\x75\xba\xdf\x71\xc8\xe8\x77\x98\x09\xe9\x6c\x02\x87\x5b\x90\xe4\xc2\xf5\x64\x79\x52\x2f\x82\xeb\x79\xee\x3c\xdb\xd0\xe0\xec\x62\x0d\xea\x35\x73\x4e\xf1\xa1\xc6\xf6\x73\xa9\xd2\x60\x81\x5c\xe3\x7d\xd4\x91\x7c\xfd\x20\x33\x7c\x0f\x9b\x3b\x21\x2c\x41\x0b\x38\x94\xa6\xe4\x71\x10\x6f\x34\xe7\xd1\x95\xcd\x82\x7d\x32\x6b\x9e\x05\x27\x62\xd1\x87\xed\xa1\x7d\x9d\x3e\xb2\xc0\x3a\x85\x76\x75\x1c\x55\x87\xc4\x4d\x24\x00\x3f\xf7\x8e\x4f\xd8\x15\x63\x35\xb8\x73\x87\x56\x4c\x69\x21\xf1\x8e\xd3\x78\xe5\x28\xd4\xbb\x01\x0b\x1c\x2a\x5c\x41\xd2\x43\x3d\xef\xa3\xe7\x4c\xce\xbf\x0d\x38\xfc\x55\xa4\xf0\x70\x3e\xe2\x3a\x37\xa5\xe3\x61\x84\xec\x71\xf9\x70\xf3\xc3\x78\x28\x0b\x0f\x30\x85\xa1\xcc\xb1\x8e\x65\x06\x5b\x4a\xe9\x4a\x15\x54\x91\x4a\xac\x37\x54\xe3\x3b\x75\xce\x22\xd9\x31\x03\x9b\x77\xcc\x41\x34\xb1\xd5\x06\x76\x0b\xcf\xc3\x38\xb9\xcb\x96\xef\xbb\x5e\x1b\x31\x56\x01\x95\xd5\xea\xa5\x90\x2f\x5a\xa1\x40\xe9\x1f\x3a\xfc\xa2\x32\x5e\xdc\xbb\x58\x46\x20\xf0\x92\x1e\x20\x79\xe5\xdb\xe6\xaf\xe9\x12\x46\x6e\x9c\x6f\xd0\x40\xbd\x0a\xc2\xc1\x6c\x04\xc8\xd7\x7b\x07\x69\xa1\x69\xfb\x23\xaa\xf8\xf8\x40\xbc\xdb\x5d\xcc\x64\xf6\x3c\xc0\x1d\xc4\x5b\xec\x1d\x76\xa7\x11\x1a\xa7\xd9\x7a\xa7\x0e\xf0\x40\x86\x34\x14\x52\xf7\x8e\xc3\x12\x1f\xaf\xa2\x49\xea\xc1\xfe\x68\x97\x32\xbf\xdb\xbf\xf2\x21\xc1\xfa\x26\x20\xee\x3f\xe5\x43\x1d\xc5\x73\x87\x61\x6a\xfc\x0a\x2e\x0c\x45\xbc\x8c\xd7\x32\x72\xd9\x09\x87\x30\xe5\x0e\x5e\xea\x1f\x8f\xb0\x87\xb6\xda\xb6\xda\xf6\xa9\x94\xaf\x12\x0f\x59\xad\x2c\x18\x36\xd4\x0f\xed\x42\xc1\x9c\x25\xc2\x96\xf7\xe4\x22\xd6\xd7\x73\x72\x31\x74\x28\x16\x31\xb8\xb4\xf3\xd6\xa5\x06\x57\x21\xfb\x47\x34\x2e\x82\x16\x55\x33\x23\xe9\xf5\xf4\xfb\x7a\x7d\xfc\xf2\x6e\x66\x11\x83\x68\x55\x3b\x30\x58\x38\x39\xe3\x62\xc1\xb9\xff\xbd\x8b\x2e\x3a\xf4\x99\x3c\xe4\xd0\x37\x99\xc4\xfa\x88\xcb\xa5\xc8\xa2\xf5\x13\xbd\x08\xa1\x95\x24\xfa\x4b\xb4\x38\xf0\xda\x0f\x0e\x66\x3e\x2d\x21\xd0\x0d\x1d\x5b\x3e\x80\x37\xbe\x78\x6f\x45\x06\xb3\x2e\x65\xe2\xe7\xf3\x7e\x91\xe2\xb9\x55\x10\x66\x27\xfb\xe6\xbf\x81\xf0\xbb\x3f\x12\x33\x14\x7c\xe8\xcd\x91\xfd\x7f\x5a\x18\x35\xff\x1a\xdf\xcd\x6d\xdc\xb7\xd1\xa3\x7a\xa6\x5a\x56\x01\xde\x48\xbf\x0c\x08\x3a\x25\xdb\xcd\x5b\x71\xd9\x11\x95\x1a\x3a\xbb\xf4\x03\x42\xcb\x2f\xe9\xa9\xf4\x7b\x33\xe8\xcb\x49\x70\x6b\x96\x71\x73\x00\xdb\x53\xb6\xef\x03\xc6\xf2\x0f\xe3\x35\xa9\xec\xa9\x6b\xe7\x93\x8f\xb0\x33\x6c\x74\x24\xbd\xc5\x6b\xbc\xf6\x76\x8b\x22\x57\x73\xa2\xa9\x5c\x7a\xb1\x09\x6d\x5f\x70\x4d\xb5\x7b\x3d\xe7\x3e\xc7\x7a\xe7\x3f\x64\x11\x5c\xef\x9b\xb6\x5d\x23\x7f\xf0\xda\x48\xe3\x51\xc3\x5b\x2e\x3b\xc1\xeb\x48\xce\x5b\x6f\x28\xc6\xe5\x23\xb1\x0d\xac\x89\xef\x3d\xec\xf3\x04\x83\x8c\xd8\xc8\xbb\x40\x87\x4c\x2f\x59\x3d\xbc\x12\x62\x22\x4c\xbf\x97\x48\xbd\x8a\x8c\x49\x0b\x4d\x9c\xb4\x31\x5f\x4a\x57\xe5\x49\xa8\x3b\x3f\x27\x3f\x0a\xb1\x21\x5b\xae\x59\xeb\x61\x62\x15\x0c\xa4\x22\x95\x14\xaa\x87\x6d\xb3\x39\x08\xfd\xca\xc1\x4b\x75\x44\x92\x35\xab\xc9\x9c\x4c\x70\xd4\x53\x3b\x6a\x4a\xce\xc9\x1f\xb3\xcc\xd5\x28\x17\xd6\xac\x4e\xfb\xa7\xf7\xbc\x46\x37\x0a\x2a\xc9\xe2\x24\xa8\x84\x8b\x5c\x1d\x82\x58\xa1\x87\xbc\x41\xc2\x5d\x83\x7a\x7c\x4f\x66\x2f\x40\x72\x96\x89\xca\xb1\x14\x96\x13\xb0\x5f\x72\x8e\x39\x29\x58\xb3\xb8\x13\x70\xb0\xc8\xe2\x08\xbb\x32\x3b\xda\x85\x9c\x29\x8d\x07\x30\x8d\x3c\xcd\xf5\xe1\x61\xdb\x58\x26\xd0\xc9\xbe\x5b\x71\x60\xdf\xbf\x14\x0c\x0a\x7a\x7e\xdd\xa3\x38\x4d\x77\xdb\xb5\x31\x98\x1c\x12\x50\xf4\xa8\x5e\xdc\xa4\xbe\xe8\xfe\x27\x88\xe3\xde\xaa\x7b\xbe\x40\x9c\x3e\x0d\xd4\x3d\x57\xee\x0b\x3b\x98\x48\xaf\x59\x65\x96\xe8\x3a\x49\x93\xa5\x83\x87\x81\x16\x60\x38\xe0\x1a\xae\xea\xa4\x33\x88\x56\xc6\x29\xc6\xa7\xa6\xd8\x92\x5b\x97\x71\x01\x4b\xc6\xc9\x56\xf9\xbe\x04\x3f\x75\x68\x8d\x0e\xa2\x7f\xa7\x9b\xa9\x84\x04\xfc\x82\xb6\x50\x9f\xda\x06\x3f\x1c\x87\xc7\xcb\x2f\x7d\xa7\xd4\x2f\x48\xa3\x31\x59\x21\x96\xf8\x96\x10\x91\x5b\xee\x5f\x34\x2e\x3f\x71\x54\x0b\xb0\x6d\xd7\x6a\xbb\xd9\x08\xa9\xc9\x2f\x79\x8b\xd6\x2f\x1d\x50\xec\x3a\x53\x5a\x6c\xc8\x46\x0a\x73\xea\x19\xd8\x1c\xee\x7c\xa7\xbb\x4f\xb0\xef\xec\x43\xea\x11\xaa\xcf\x35\x22\x3b\xf4\xe8\xcd\x57\x6c\x54\xb3\x22\x81\x41\xdf\xe0\x9b\x22\xa5\xae\x36\x94\xeb\xf4\x85\x1b\x32\x27\xe7\xee\x11\x9b\xf3\xcc\xef\xc1\xc1\xf1\xfc\xd2\x0b\x35\xa3\x30\xba\x09\x49\x16\x12\xaf\x93\x52\xd4\xcc\x33\xdd\xdf\x1d\xf0\x8d\xe5\x46\xc0\x9e\xbf\x7e\x45\x14\x5b\x6f\x5a\xd7\xdb\xb2\x16\x12\x88\x14\x8b\x6d\x90\x8f\xf4\x4f\x79\x15\x23\x84\xe2\xc3\x60\x27\x31\x41\x89\xa2\x93\x39\xf9\x6c\xa6\x85\x97\x99\x2f\xcd\x17\x5f\x46\xa7\xf5\xcf\xcf\xcc\xc9\x87\x74\xfe\xcd\xe8\xd4\xa4\x73\x77\x70\x4b\x63\x20\x87\x14\xd8\x0f\xc8\x85\x67\xe9\xe1\xae\x0c\x6e\xc5\xd6\x15\xe2\xec\x23\x6d\x7d\x13\x9d\x16\x44\x81\x7b\xca\x3d\xb4\xad\xf1\xd2\x07\xdf\xd7\xc3\xd1\xae\x69\x70\xe6\x24\x69\xa6\xe8\x2d\x4c\xae\xce\x2a\x8c\x1f\xed\xd3\x23\x93\xa9\x71\x93\x2f\xcb\x92\x3c\x3d\x06\xdc\x0f\x7d\xe3\x5e\x00\xb2\x24\xdc\xde\xdd\xfe\x72\xf2\x7f\x01\x00\x00\xff\xff\x5d\x09\x28\x54\x0a\x63\x00\x00"
-
-func nodeversionbeaconCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_nodeversionbeaconCdc,
-		"NodeVersionBeacon.cdc",
-	)
-}
-
-func nodeversionbeaconCdc() (*asset, error) {
-	bytes, err := nodeversionbeaconCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "NodeVersionBeacon.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x58, 0xb1, 0xc3, 0xc5, 0x85, 0x29, 0x50, 0xb8, 0xc4, 0xc4, 0xbc, 0xf1, 0xa3, 0x1, 0xe7, 0xa4, 0xaa, 0x8, 0x15, 0x46, 0x13, 0x7c, 0x47, 0xf7, 0xe7, 0xc, 0xf, 0xaa, 0xc2, 0x73, 0xa4, 0xc}}
-	return a, nil
-}
-
-var _randombeaconhistoryCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x5b\x5f\x8f\xdb\xb6\xb2\x7f\xcf\xa7\x98\xe6\x21\xb5\x1b\xaf\x9d\xa4\xf7\x14\xc5\x22\x4e\x6f\x1a\x34\x4d\x80\xf6\xdc\x60\x93\x8b\xf3\x90\x06\x27\xb4\x34\xb6\x78\x22\x93\x2a\x49\xd9\xeb\x16\xf9\xee\x17\x1c\x92\x12\x29\x51\xde\xdd\x9e\x9c\xbb\x40\x1b\x5b\x26\xe7\x1f\x87\x33\xbf\x19\x52\xab\xd5\x0a\xae\x98\x28\xe5\xfe\x47\x64\x85\x14\xaf\xb8\x36\x52\x9d\x60\xf6\xf2\x97\xd7\x6f\xe0\xf1\x93\x6f\xe7\xf7\x56\xab\x95\xfd\x0f\xde\x55\x5c\x43\x21\x85\x51\xac\x30\x60\x87\xa1\x06\x53\x21\x54\x7e\x8e\xdc\x82\x22\x52\xa0\x65\xab\x0a\xd4\xb0\x43\x81\x8a\x19\x2c\x61\x73\xa2\xa1\x2f\x6b\x79\x04\x81\xe6\x28\xd5\xa7\x25\xbc\xab\x10\x4a\xdc\x72\x81\x25\xbc\x42\xa6\xcc\x06\x99\x01\x85\x6e\x3a\x70\x4d\x7c\xdb\xa6\x1c\x91\x78\x8b\xea\xc0\x0b\x84\xe7\x45\x21\x5b\x61\x80\x19\xfa\x0d\x45\x69\xa5\xc0\x03\xaa\x13\x6c\x6a\x59\x7c\x82\x23\x37\x15\x98\x8a\x19\xf7\xfd\x6b\xed\x85\xeb\xa5\x15\xa8\xf5\xb2\xd3\xf2\x1f\x15\xaf\x91\x88\xf9\x71\x07\x56\xb7\xa8\x81\x29\x04\xcd\xb6\x58\x9f\xc6\x5a\x39\x03\x82\xb3\x20\xcc\x84\x14\x17\x8d\xc2\x92\x17\x86\x6d\x6a\x5c\x40\x2b\x36\x9c\x69\xf7\xf9\x80\x8a\x6f\xb9\xfd\x3c\x07\x26\x4a\xe2\x69\x14\x13\x7a\xcf\x8d\x25\xc9\x85\x91\x4e\x97\x6b\x2c\x5a\xc3\xa5\x00\x14\x07\xae\xa4\xd8\xa3\x30\x70\xe0\x8c\x7e\x2d\xe4\xde\x4e\xe0\x62\xe7\x66\xb3\xc2\x0e\x5d\x40\xab\xe9\x51\x85\xa0\xd8\x31\xc8\xbe\x55\x72\x0f\x26\x5e\x3e\x62\x5b\x4a\xd4\x20\xa4\x81\x5d\xcb\x14\x13\x06\x11\xac\xe8\xca\x9a\xcf\xf0\x4d\x8d\xb1\x81\x68\xb1\xec\x22\xd1\x1a\x71\x6d\x05\x45\x51\x62\x09\x46\xc2\x06\xa1\xd5\x24\xbc\xe5\xf0\xaf\x56\x90\x34\xce\xf6\xcc\xcb\x4a\x74\x59\x4d\x9c\xf7\x58\x54\x4c\x70\xbd\xb7\x93\x1b\x25\x0f\xbc\x44\x60\x02\xa4\x28\x2a\xc6\x45\xb4\x44\xd3\x02\x11\xa1\xd7\x24\x0a\xab\xb5\x04\x85\x96\x4f\x27\x52\xab\x73\xab\xe8\x25\x6a\x34\xb6\xa5\xbc\xf0\xde\x2a\xda\xfd\x06\x15\xd1\xf3\x4b\x2b\x15\xcc\xde\x5c\xfd\xfd\xe7\xb9\xa5\x14\x96\xdb\x0a\xc8\xd4\x86\x1b\xc5\xd4\xe9\xa2\x96\x62\x07\x1a\x7f\x6f\x51\xc4\xce\xe4\x19\xf5\xfe\xf4\x52\x2a\x68\x35\xdb\x0d\x1c\x0e\x8e\x15\x2a\xb4\xbe\xde\xd6\x06\xd8\x46\x2a\xb2\x18\x77\x0b\xc2\xec\x27\xdd\xe2\x02\x38\x29\x18\xe9\xe6\x1c\xa6\xd7\xef\x05\x2b\x49\x82\x4d\xcb\x6b\x73\xc1\x05\x6c\x83\xf5\x3f\xf6\x76\x73\x0e\xfa\x71\x01\xc7\x8a\x17\x55\x67\xb2\x0d\xb3\x8b\x26\x85\xa3\x59\x39\x07\x4f\xbd\xb9\x57\xe4\x0a\x59\x49\x83\xb6\x6d\x5d\x03\x85\x07\xab\xc2\x25\x54\xc6\x34\xfa\x72\xb5\xda\x71\x53\xb5\x9b\x65\x21\xf7\x2b\x29\xb6\xb5\x3c\xae\xb6\x35\x6f\xf4\xaa\x69\xeb\x7a\xf5\xf8\xc9\xb7\x44\x89\x15\x05\x6a\x3d\x63\x75\x3d\xef\x63\x49\x2e\x00\xfd\x79\xef\x1e\x00\x80\x0b\x3c\x08\x15\xf2\x5d\x45\xfb\xdc\xa9\x40\x82\x70\xa5\x4d\x76\x3b\xc3\x91\x39\xa3\x29\x6b\x31\x4b\xc7\xf3\x0d\x2c\xe7\x70\x60\x0a\x6a\x79\x44\x6d\x5e\x11\xe9\x4b\xf8\xdf\xd7\xc2\x7c\xf7\x5f\x3f\x74\x6c\xdf\x8e\x17\x37\xc4\xb5\x40\x3a\x04\x80\x2e\x78\x2d\x5c\x5c\x2c\x81\x69\xe7\x2e\x8a\x9d\x40\x1e\x50\x01\x83\x3d\x6b\x1a\xda\x9c\xd6\x57\xcb\xb6\x40\x1a\xcb\x76\x98\x17\xb0\x46\xe3\xd9\xbe\x25\xae\xde\x30\x97\xf0\xfe\xbd\x95\xf4\xfb\x0f\x1f\x52\x0b\x35\xcc\x54\x56\xd4\x44\x9e\x28\x98\x0a\xfa\xa5\xc4\xa6\x96\x27\x0a\x25\xcc\xc5\xce\x98\x3b\x2d\x8b\x65\xdc\x11\x78\xeb\x44\x7c\xc3\x4c\x75\x09\xd1\x97\xc0\x1b\x7e\x3a\x58\x5a\xe8\xa3\xd7\xb1\x42\x01\x7b\xae\x29\x0a\xbd\x95\x57\x3e\xf6\x34\x4c\x1b\xa8\x02\x4d\x17\x4c\x4b\x34\x58\xd8\x39\x4c\x94\x70\xe4\x75\x6d\xa3\xc8\x86\x15\x9f\xb6\xbc\xae\xb1\xbc\x0c\x0c\xe0\x02\x3e\x52\xe8\x76\xeb\xf4\xd1\x7a\xaf\xe9\x3d\xc2\xed\x23\xfb\x60\xc7\x1a\xfb\x5b\xa0\x1b\xcf\xdf\xb1\xe6\xad\x61\xca\xe4\x49\x78\xa3\x39\x77\x0a\xc2\xa3\x30\xea\x94\x12\x8b\x8d\x84\xa4\xb6\x73\x5c\xbf\x32\xbf\xba\x99\xb3\x48\xd8\xe0\x54\x0b\x48\x25\x08\xcf\xe7\xb7\x36\xa3\xb5\x58\x6f\x1c\x90\x6e\x35\x8b\x56\x29\x3b\xaf\x33\xed\xdd\xad\x16\x88\x42\xc5\x9a\x06\x05\x96\x14\x73\x28\x3c\xb8\xd4\xec\xe6\xbd\x95\x57\x2e\xc4\xdb\x68\xef\xa7\xfc\x45\x0b\x47\x6a\x90\x91\x63\x32\xe4\x91\xdd\x6c\x17\x98\xed\xec\xc1\x1c\x8e\x3a\xcc\xfa\xbb\x34\xe8\xf2\x3b\x17\x40\x79\x5f\x59\x5b\x15\x4c\xa3\x5e\x24\x1a\x62\x49\x2e\xb2\x67\x27\x8a\xb1\x1b\xa4\x00\xc4\x77\xad\x6c\x29\xbd\x71\xed\x57\x35\xe4\xc5\xc0\xc2\x06\xbc\x93\xb7\xc6\x90\xa2\x97\xc6\x6d\x2f\x9b\x60\x99\xc6\xe5\x6d\xbc\xe5\xc7\x8e\xc6\x5d\x1c\x66\x01\x64\xa1\xa1\xff\x7c\x03\x17\x17\x17\x7d\x76\xb6\x5f\xbe\x59\x79\xf1\x93\x20\x91\x09\x0c\xd6\x08\x8c\x0b\xf2\x79\x56\x54\x67\x61\x92\x55\x33\xe4\xbc\x40\x77\xa4\x6c\x47\xb9\x67\xe6\x83\x79\x90\xe4\x05\xab\x6b\x8b\x80\x6c\xf8\x94\x47\xe1\x96\x38\x1f\xb7\x16\x59\xbc\xb7\xf0\x11\xd8\xb9\x89\x07\x10\x65\x1a\xa5\x63\x8e\x09\xf7\xff\x6e\x98\x62\xfb\x5c\x6c\x25\x13\x25\x44\x5c\xb0\xb6\xac\x26\xc9\xbd\x0b\x98\xb4\x51\xd2\xc8\x42\xd6\xb0\x67\x9f\x50\x83\x6e\x15\x4d\x2f\x58\x5d\x3b\xdf\xe8\x32\xb3\xb4\x59\xa5\x41\xe5\x21\xaa\xcd\x15\xa0\x4f\xda\xe0\x9e\x46\x3b\xac\x15\xc1\xba\x84\x5f\x21\xf7\x16\x8e\xa6\x80\xb7\x5b\x38\xd0\xd2\xed\x86\x38\x3c\x84\x25\x75\x31\x6d\x83\x9e\xc4\x81\x71\xb7\x0c\x52\xd4\xa7\x90\x20\x8a\x8a\xd7\x89\xb2\x6e\xf6\x32\xab\x7f\xbc\xec\xdb\x56\xf4\x71\x68\x96\xcf\x5c\x2e\x71\xcd\x63\x7f\x20\x32\x5a\xa3\x32\xb3\xe4\x99\xdf\x7b\xe9\x6a\xec\x5b\x4d\x1b\x97\x19\xa8\xd1\xe6\x94\xc7\x4f\xbe\x87\x0d\x37\x7a\x34\x35\xc3\x7f\x59\xa3\xd8\x99\x0a\x9e\xad\x69\xda\x0a\xbe\x5f\x8c\xa6\xed\x51\x5b\xac\x76\x09\xf7\x33\x98\x64\xd9\xb9\xe7\xb2\x0f\xb8\x01\x2e\xdd\x24\x21\x6c\x5a\x03\x3b\x69\xe0\xb7\x9c\x6d\x82\x6c\xdf\xc0\xf7\x73\x1a\x7e\x3f\x11\x6d\x9e\xda\xcb\x66\x68\xbf\xb6\x3f\xf6\xb1\x03\xd6\xb0\x43\xf3\x22\x7a\x3e\x9b\x2f\x5d\x00\xbe\x37\xb0\x2a\x17\xdc\x78\xf4\x13\x13\xe0\x5b\x8f\x37\x6d\x7c\xd4\x68\xe0\x84\xe9\x54\xbe\xcd\x61\xb5\x65\x8c\xa3\x60\xbd\x06\xc1\x6b\xf8\x73\x64\xdb\x9b\x67\x66\x94\x4a\xa8\x7c\x4e\xbe\x59\x04\xb7\x67\xa7\x0d\x76\xa1\x54\xc1\x3a\xcb\x65\x23\x95\x92\xc7\x7e\xd8\x6c\x3e\x34\xc8\x0b\x85\x16\xe1\x3f\x00\xcd\x0e\x08\x11\xc1\xd4\x24\xec\x80\x65\xce\x28\x23\x31\xee\x62\x03\x8f\xc3\x96\x1e\x0d\x2e\x2d\x97\xd9\xd3\x8b\xc2\x89\x14\x4b\xbd\x00\x23\x2f\x61\xe5\x07\xae\xd4\x98\x58\x3f\x7a\x3e\xf6\xed\x2f\x61\xab\xcf\x23\x47\xdc\xc4\x14\x87\x3c\x7e\xf8\x01\x1a\x26\x78\x31\xbb\xff\x46\xc9\x4d\x8d\x7b\x70\xf4\x6d\xb6\xe9\x27\xde\x1f\xf8\xb7\x8d\x72\x15\x16\x9f\x60\x2b\x15\x30\x71\x02\xbc\xe6\x9a\x6a\x5d\x9b\xc0\x2d\x5e\xec\x50\x4b\x5f\xef\x72\xd1\xb4\x66\x10\x2f\xf8\x16\x04\x62\x89\xe5\x32\xa1\xdf\x73\x5e\x86\x8f\xc9\xa6\xbc\xcc\x85\x8f\xb1\x8c\x47\x24\x58\x26\xe4\x31\x84\xe3\x42\x2a\x85\x85\x85\x22\x25\x5e\xf7\xb9\x23\x2e\x44\x93\x7c\x3a\xd2\x9a\xd6\xbc\x2b\x2b\xba\x8c\x62\xed\x30\x0a\xe8\xf7\x6e\x72\xac\x5c\x9c\x21\x90\x57\xe6\x42\x50\xbf\xce\x6e\x8d\x3f\x27\xd8\x22\x5a\xd3\x3c\xba\x78\x4e\xba\x12\x00\xeb\xab\xfb\x12\x35\xdf\x89\x14\x35\x12\xae\xc5\xb2\xab\xa5\x46\x00\x83\xf2\x1a\x01\x26\xb9\xb5\x65\x93\x1b\xee\x9a\x08\x54\xb8\x94\x6d\x07\x3e\x2c\xe1\x2e\x77\x46\x19\x13\xb6\x8c\xd7\xad\xf2\x50\xec\x2c\x4a\x89\x34\xfb\x33\xc9\x7b\x04\xbf\xfc\x52\x26\x00\x96\x0a\x0d\x27\xe7\xc7\x8c\x21\x3f\xfa\xb2\xcf\x57\xf8\x69\xe5\x78\x44\xf2\x18\xd3\x95\x90\x8b\x34\xd7\x62\xc1\x6c\x65\x4f\x9a\x77\x49\x26\xd5\x06\x7a\xe0\xa1\x90\xe0\xec\x86\x1a\x37\x11\x9c\xb5\x22\x76\xb6\xf4\xed\xb9\x45\x0f\xd2\x5f\x5b\x9d\x3a\x94\xad\xc7\x7a\xba\xb0\x7c\x31\x48\x1e\xd4\x20\x61\x4d\x2a\xc5\xeb\x2d\x08\xe9\xf8\xd1\x2e\xcd\xb2\xc1\xdf\x5b\x56\x83\x6f\x6c\x9d\xb3\x99\x4b\x83\xcb\x21\xc0\x48\xcb\xf6\x84\x7e\x80\xc2\x89\x50\x76\x4d\x5f\xf2\xba\xb6\xb1\x81\x6b\xa8\xf9\x9e\x1b\xe7\x85\xb6\x0c\xbf\xe6\xfb\x76\x1f\x15\x17\x01\xc3\x5b\x44\xe6\xd0\x9a\x74\x53\x42\xa7\xad\x69\x0d\x23\xaf\x2a\xa4\x36\xc3\x45\xe0\x1a\xf6\xc8\x84\xc5\x70\x35\x53\x3b\xec\x8b\x0c\xb4\xc5\x2d\x6c\xf1\x48\x54\xb5\xeb\x25\x19\x5f\x4e\xf4\x35\xc4\x0d\xda\xee\xd9\xf5\x4f\x4e\xc0\x37\xa8\x2c\x72\xee\x34\xee\xe6\xd9\x74\x3e\x9b\x0f\xd2\x8d\xc6\x7a\xbb\x4c\x4c\x05\x6b\x3f\x73\x76\xdb\x68\xe1\x96\x63\x3e\xa6\x3b\x12\x0a\xd6\xf0\xf8\xd1\xa3\x28\x84\x64\x31\xe2\x81\xe3\x91\x80\xe2\x0e\xcd\xaf\x43\x12\xb3\x39\x04\xd5\x06\xaa\x28\x34\xad\x12\x13\x9c\x6f\xe2\x69\xd9\xe9\x1c\xbb\x3d\xeb\x9c\x67\x68\xbb\x29\x40\xba\x67\xd7\xf0\x0c\x1e\xdd\x15\x37\xf6\x21\x66\x99\x93\xe4\x92\xfc\x2c\xf8\xa5\x03\xe9\x9d\x2f\x06\x40\xa9\x8d\xe2\x85\xa9\x4f\xd0\x48\xcd\x0d\x3f\x60\x04\x28\xf7\xec\x7a\x3e\x04\x8c\xb7\x5a\xb2\x3d\xbb\xce\x99\x8f\xfa\x96\x5c\xf8\xf2\x6a\x94\xd4\x28\x8a\xfb\xbe\x3a\x82\xc0\x63\x1a\xe4\x96\x36\x26\xb0\x61\x3b\x66\x41\xcd\x0d\xa2\x98\xf0\xc9\xb5\x50\x5c\xf8\x3f\x5b\x72\x44\x7b\x24\xf8\xd4\x96\x8b\xf2\x67\xd6\x3c\x17\xe5\x15\xf9\xcb\x0b\x27\x37\xb9\xfe\x6c\x1e\xb9\xd6\x97\xc5\xd2\x77\x35\xcf\x70\x7e\x28\xd8\x48\xe7\x96\xd9\xd8\x90\xd9\x8a\xef\x8b\x48\x9b\x0f\xb0\x76\x70\xc7\x19\x6f\xa4\xcf\x00\x55\xdf\x04\xbc\xbf\x1a\x1b\x24\x62\x96\x85\xe5\x70\x91\x30\x19\x41\x23\x6e\x3d\xc0\xaa\x9e\xf5\x02\x60\xc2\xa9\x3b\xc2\xf3\x94\x05\x7e\x71\xb5\xd0\x17\x89\x56\x7c\x9b\x2a\xf3\x2c\x61\x31\x06\xe8\x56\xfb\xb4\xf5\x02\xeb\xd4\x9e\x0f\x63\x0a\xa3\xf9\xa4\xde\xcd\x6d\xc1\xb1\x45\xc7\x1d\x9f\xf4\xfb\x39\x18\xee\xe3\x63\xac\xe8\xd4\xb6\x0e\xa1\x48\xdb\x38\xa2\xe9\x4c\x05\xf7\x8d\x39\x75\x49\x70\x66\x53\xf9\x7c\x80\x1d\x7c\x76\x26\xa4\x60\xf7\xa7\x3f\x50\xc2\xd0\xed\x1e\xa4\xfc\x14\xd3\x38\x88\x9e\x64\xfd\x8f\xbe\xe7\x61\x33\x24\x61\xfc\xba\x0e\x02\x8c\xd0\x85\x21\x98\xe3\x80\x36\xe1\x8c\x2c\xc2\xf8\x97\x0d\x93\xe1\xa4\xd0\x48\xf8\x98\x71\x9a\x8f\x5f\xeb\x11\xc0\x18\x34\x73\xfe\x51\xa1\xe8\x52\x33\x17\xbb\x45\x2c\x5a\x7f\xa6\xc4\xf6\x48\x0f\x65\x73\x5a\xc2\x4f\xac\xa8\x7c\xd4\xb6\x50\xe7\x9a\xe2\x92\x55\xcc\x1a\x69\xa0\x36\xd9\x22\xe1\xa8\x5b\x0a\x68\x36\xa0\x57\x4c\x57\x5c\xec\x7c\x2f\x32\xee\x22\x55\xd8\xc9\x60\x0d\x61\x65\x2a\xa9\x22\x2a\x0c\xb0\xda\x54\xb2\xdd\x51\xb4\x39\x85\x5e\x58\xc2\x62\x24\xf2\x94\xf6\x84\xe2\xa8\x19\x24\x8b\xa2\x55\xc1\x09\xa8\xa1\x1a\x21\xf2\x33\x70\x1b\x5e\x47\x7d\xd0\x45\x22\xb4\x36\x3c\x4a\xd6\x96\x9d\x6f\xa1\x3a\xff\x60\xa1\x40\xb2\xe0\xf8\x93\x90\x47\x11\x8a\x2a\xc3\xf7\x04\x88\x5d\x7f\xab\x3b\x16\x0d\x87\x59\xdd\xe1\xa5\x86\x56\xb8\x63\x57\x66\xd8\x86\xd7\xdc\x9c\xce\x40\x2b\x9b\x31\x26\x8a\xbf\xa9\x36\x55\x26\x32\x52\x66\x3d\x9f\x78\xfe\xf3\x31\x6e\xb5\x02\xd9\x58\x93\xb0\x9a\x3e\xec\xf9\x1f\x0e\xae\x86\xb2\xb1\x62\x4d\x73\xa2\x0a\x4a\x0a\x3a\x27\xba\xa4\xca\xd8\xc3\xf6\xf8\x30\x66\x31\x24\x1c\xed\x05\xe0\x5a\x7c\x6d\x7c\x41\xbd\x08\x41\x07\x99\xaa\x4f\x67\x43\xee\x7a\x9d\xc3\xa2\x99\xd8\x9b\x45\xac\xb1\xb5\x1e\xc2\xe3\x71\xa3\x8f\xc4\x18\x04\xc7\xa1\x16\xaf\x27\xd2\x51\xd7\xf4\xf4\x75\x75\x72\x94\x4e\x79\x3c\x1c\x2c\x51\x3e\x4f\x22\xe5\x72\xc8\x84\x76\xad\x3b\x45\xd1\xc9\xd6\xb1\x7b\xc1\x57\x83\xbd\x14\xb4\xc3\x74\x5a\xf0\xdd\x54\xc7\x86\xbf\x23\xdd\x51\x18\xe4\xb5\xbf\xe6\x48\xb7\xec\x51\x9d\x69\x25\xbc\xff\x30\x4c\x4d\x29\x94\xfe\xd2\xde\x6e\xb7\x90\xc0\xe3\x4f\x14\x70\xd7\x09\x56\x1a\x8d\xe3\xf1\x36\x4d\x3c\x6b\x34\xd4\xdd\x23\x59\xc3\x23\x9b\x9a\x06\x25\x65\x6c\x73\x3b\xea\xe9\x14\xa2\x1e\x1b\x73\xb5\x82\xbd\x3c\x60\xa8\x7d\x05\x5e\x9b\xc8\x8d\x4e\xa3\xf1\x8e\x8d\x93\xfb\x69\x62\xbc\x07\x0f\x6e\xbb\x32\xef\x69\xfa\x87\xae\xdf\x0d\x8f\x32\x82\x01\xd5\x8d\xce\x3c\xee\xdf\xdc\xee\xfa\x9c\x53\x88\x6f\xe1\x88\xa0\xe8\xd0\x21\x3a\x84\xb0\x1f\x1d\x4e\x30\x36\x8b\x52\xe2\x8c\xfa\x76\x9a\x8a\x15\x17\xf3\x47\x54\xf9\x36\x08\xb3\xbe\x01\xa0\xd9\xbf\x8d\x42\xf6\xe9\x76\xb2\xda\xf8\xd5\xef\xe4\x73\x96\xef\x6e\x93\x74\x09\xa5\x74\xc0\x5c\x57\xec\x5b\x90\xad\x69\x5a\x03\xb3\x27\x7f\xfb\x8e\xfa\xf3\x73\xea\x2b\xd8\x7a\xdf\x86\x58\xe6\x76\xfb\x9e\x0b\x5b\xc2\x65\xcf\x31\xf0\xf7\x96\x5b\xa8\xa4\xf9\x1f\x6e\xb7\x0b\x3a\x53\x9d\x85\xf3\x81\x71\xbf\x36\x72\xf2\x57\x4c\x57\xcf\xeb\x9d\x54\xdc\x54\xfb\xe5\xdb\x57\xcf\xbf\xfd\xe7\x93\xbf\x7d\xb7\xb4\x80\x61\x16\x86\x8d\x09\xdc\xcd\x5d\x60\xdd\x71\x1c\x2f\xcf\x8d\x8e\x12\xb6\x8f\xfb\x77\x38\x62\x1c\x91\x93\x32\x00\xd8\x46\xb6\xa3\x83\xe1\x70\xc8\xdb\x09\xb1\xf5\xd4\xf3\x0e\x9d\x45\xee\x37\x96\x3e\xf0\xf0\xa6\xe0\x00\x59\x54\x1f\x1d\xdf\xe6\x5d\x34\x46\xfb\x53\xa5\xe4\xb8\x8f\x60\xff\xce\xd7\x01\xf9\x39\xfe\x54\xb8\xbf\xd0\x11\xff\x9d\x8d\xcf\xab\x95\x05\x02\x7b\x19\xdd\x2f\xf0\x5d\xb3\xae\x40\xd8\xb4\xc6\x6e\x78\xea\x67\x19\xe9\x51\xf6\x10\x84\x0f\x89\x1a\x79\x39\x7c\x04\x17\xc3\x18\xe8\xfc\x89\x6f\x5d\x7c\x20\x84\xe8\x62\xc6\xc4\x54\x1f\xd1\x92\x60\x93\x9e\x84\x9a\xf4\xe4\xd4\xd2\x1e\x15\x10\x99\xb8\xfe\xff\x10\x70\xcf\xef\xa1\xcf\x43\x57\xbf\x65\x38\x1c\x51\xf5\xd6\x67\x9f\xd0\x5d\x25\xf4\xa7\x4b\x64\x98\xb6\x29\xe4\x9e\xc0\x36\xa5\xee\x60\x2f\x1b\x87\x72\x07\x18\x67\x04\xcc\x42\x35\x3e\x28\x3c\x61\x74\x8a\x70\x95\x39\x73\xcf\x1f\x27\x5c\x61\xa3\x50\xa3\x30\xb6\x4e\x4c\x0f\x75\xe8\x86\x9d\x2b\x1b\x61\xc7\x0f\xb6\x60\xa3\xca\x20\x6a\xcf\xe4\x1a\xfe\xda\xa8\xb6\xbb\x6b\xe6\x44\x88\xec\x39\xbc\x00\x95\xb9\x93\x31\x39\x96\x04\xea\xca\x86\x41\x5b\x36\x7b\xb9\x23\x9d\x91\xed\xdc\x6e\x92\x66\xd4\x66\xe2\x44\x94\x86\x3a\x83\xac\x1d\xd5\xbb\xda\xff\x0d\xdb\xe1\xc4\x1a\xbc\x70\x37\x43\x74\x6a\x33\x7f\x93\x52\xaa\x12\x6d\x3e\x2b\x2a\x25\x85\xac\xe5\x8e\x17\xd4\xbb\xb2\xfe\xa6\x4a\x7f\xcf\x8d\x69\x2d\x0b\xee\x8e\xb4\xfe\xe2\x1a\xc5\x62\x4e\x2f\x57\x43\xcd\xd7\x1b\xd6\xa9\x41\xf5\xe6\x36\xe3\x8c\x34\xac\x76\x3b\xee\x76\x6b\xaf\x2f\xe1\x7d\x2c\xf3\x07\x1a\xde\xcd\xa1\x0e\x25\x39\x43\x2c\xe6\x62\x28\xcf\x22\xc7\x78\x31\xc1\x21\xeb\x33\x96\x3c\xac\xc9\x18\x99\x1f\x1d\x37\xfb\xbb\xfb\x34\x1e\x12\xf1\x87\x75\x2c\xcd\x84\xcf\xe9\xe0\x74\xfa\xac\xd7\xbd\x08\xf7\x3c\x7f\x45\x53\xc9\x52\x4f\xb8\xdb\xcf\x68\x0c\xaa\xae\x68\xcd\xde\x3f\x62\x26\xbb\xe9\x97\xf0\x86\x09\x5e\x68\x1f\xee\x09\x6d\xa1\x1e\xba\x1d\x20\xb7\xb9\xa0\x63\xd8\x28\x2c\xb0\x24\x5f\x06\xbc\x2e\x10\x7d\xc3\xbb\xbb\xdd\xe9\xbb\x5f\xcb\xe8\x92\x19\xcb\xcb\x65\x85\x76\xac\x84\xbb\xcb\xe8\x6f\x98\x39\x77\xb1\x59\xb4\xe3\xda\x0a\xc3\xeb\x30\xf8\xe1\xe3\xf4\x84\x32\xba\x9f\xc4\x92\x7e\xeb\xbb\x0a\x53\x5d\xfa\x9b\xb0\xd2\xd6\xc0\x8a\xe3\x01\xcf\x9f\x33\x27\x3c\x7c\xf5\xfe\xee\x8c\xa1\xa9\xf4\x1d\x99\xda\x96\x47\x49\x3c\x70\x7b\x76\x72\x4b\xd3\xf1\x0b\x8d\xfc\x9f\xed\x55\x47\x7f\x96\x6a\x97\x09\x92\xf3\xcb\xa9\x50\xdd\x28\xcc\x39\x7f\xd2\xa4\xfd\x8a\x2e\x60\x4c\x1c\xc7\x8c\xc5\xb9\x84\x90\x8d\x2a\xe6\xae\x7a\x9c\x6c\x0e\x40\x14\xb4\x6f\x39\xab\xf9\x1f\x58\xa6\x47\x2c\x71\x80\x7e\xb6\x1e\xcb\xf0\xd5\x1d\xb8\x5f\xe5\xfd\xf5\xb7\x38\x7b\xcc\x7b\x7f\x1d\x3a\x68\x68\x85\x51\x7f\x96\xd6\xee\xb7\xd9\x58\x9e\xf9\xb4\xfc\x4f\x27\x21\xea\x1d\x94\x78\x9b\xf3\xa3\x60\xcb\x4e\xe4\x7e\xa7\x64\x95\xbc\x3f\x88\x24\xe0\x91\x7d\xc0\x39\x9b\xe4\x0c\x62\xac\x64\x1f\xa8\x33\x47\x78\x8e\xc8\xb3\xf5\xf0\x04\xef\xfc\xe9\x5d\x4e\xd7\x70\x9b\x65\xcb\x05\xe5\xba\x14\xa1\x84\xae\x39\xf1\xeb\x35\x9a\xdf\x42\xb8\xa7\xa1\x45\x42\xaa\x9d\x6b\xdc\x3c\x78\x00\x53\x63\xc6\x78\xf4\xdf\xd5\x37\xbb\xb6\x5c\x87\xc6\x59\xed\xae\xd4\xf6\x37\x0a\xa9\x64\xf0\x77\xba\xfb\xa7\x5a\x4a\x91\x33\x87\x0f\x46\xf1\x7e\x4f\x71\xd3\x26\x3e\x27\xf1\xe0\xe9\x06\xe5\xe7\x49\x22\x22\x44\xe9\x82\xa4\x05\x94\x94\x28\xbb\x43\x8c\xe9\xf7\x97\x3a\xaf\xd5\x12\xb6\x4c\x2d\xa6\x50\xcf\x54\x0c\x77\xf9\xde\xdd\xce\xdf\x75\xf7\x99\xa3\x88\xbd\x80\x47\x17\x24\x6f\x77\x5f\xbd\x9f\x1b\xd0\xc1\xbb\xe4\x26\xf4\x40\x42\x23\x81\x8b\xa2\x6e\x4b\x77\xa1\xb4\x4b\xfd\xb9\x60\xff\x7c\x12\x58\x45\x77\x80\x73\x58\x8f\x0b\x28\x2a\x19\x69\xec\xec\x90\x60\xbd\x8e\xdb\x5d\x31\x9f\xbf\x0e\x30\x21\xda\xec\x9f\x70\x16\x34\x0d\xd2\x44\x1e\x2d\xfe\xdb\x19\x63\x5a\xbe\xbb\x66\x8e\x34\xaa\xe5\xb1\x1d\xac\xe1\xfd\x87\x64\x5c\x0a\xcc\x6e\x19\x21\xfa\x42\xe4\xc0\x94\xcb\x0d\xa1\x52\x23\x6f\xfc\x66\x04\x04\xf9\x36\x1e\xf6\x2c\x61\x3b\xb0\x5f\x4c\x2d\x07\x14\x3f\x27\xcc\x51\x94\xdd\x89\x49\x3f\xf3\x61\x4e\x80\x6e\xe8\x39\xf6\x11\xbd\x3c\xf3\xee\xa3\xdb\xf8\x74\x50\x41\x1d\x07\xd2\x9c\x6f\x03\x42\xec\x70\x5f\xcd\xb4\x49\x81\x73\x6a\x8c\xf5\xba\x67\x9a\xbd\x9c\x32\xe5\xbf\xce\x7b\xed\xff\x23\xdf\xf5\x1f\x06\x88\x3f\xfa\xd2\xc3\x7e\xf7\xef\x7c\x42\xb7\xd7\xc6\xbd\x9a\x46\xaf\x18\x75\x87\xb5\xa2\xb4\x3b\xda\x17\x53\xa4\x71\x66\x57\x27\x0e\x36\xb8\x31\x70\x26\xaf\xda\xfc\xcd\xbd\x78\xee\x75\x80\x09\x2f\xd4\x35\x2f\x70\x66\x83\xec\x25\xbc\x16\x66\xd6\x1b\x73\xbe\x80\xb6\x79\x27\xdd\xe3\x60\xd5\xf9\x6d\xef\xe0\x10\xe7\xc9\xd4\x06\x37\xa6\xb7\x73\x7b\xf9\x3f\x91\xe6\x60\xd4\x81\xf3\xaf\x08\xfa\xf3\x93\x89\xb6\xad\x4f\x83\x37\x37\x17\x07\x97\x13\x92\xfd\xe5\x23\x05\x9f\xe7\xbb\x86\x3e\x93\xa6\x7d\x83\xbc\xcc\x59\x07\xbc\xc1\xf5\x13\x02\xd1\x3e\x48\x9f\x0f\xf7\x44\xf2\xeb\xe4\xfe\xc8\x18\xf4\x72\xe8\xd8\x23\x08\x30\xa8\x30\x27\xea\xa9\x3b\xbe\x59\x38\x55\x56\x7d\x59\xea\x53\xb7\xe8\x7e\x89\x56\x3f\xb9\xe4\x34\x58\xa3\x71\xc2\xeb\xaf\x66\x4f\xec\x92\x5f\x92\xb7\x23\x6f\x95\xe6\x6e\xb2\x78\x38\x7e\xff\x5a\xc7\xd7\x6e\xc3\x55\xdc\x58\xd1\xc1\x41\xfd\xe8\x52\xfa\x25\x3c\xe8\xbf\xfe\x30\xa1\xef\xf0\x66\xbd\x23\xf3\x34\x9a\xf9\xcc\x07\xa8\xbb\xdc\xa9\xf7\xea\x8d\x2e\x5d\x8e\x4d\x4c\x90\x22\xfd\x3d\xf7\xd6\x51\x92\xee\x69\x54\xee\xf5\x4b\x58\xf7\x52\xbe\xac\xe5\x31\xb3\x6a\xdd\xb4\x7b\x29\xb9\xf3\x6f\x18\x74\xb3\xc2\x0b\x06\x93\x22\x04\xfd\x3f\xdf\xfb\xbf\x00\x00\x00\xff\xff\x00\x74\x9e\x65\x37\x40\x00\x00"
-
-func randombeaconhistoryCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_randombeaconhistoryCdc,
-		"RandomBeaconHistory.cdc",
-	)
-}
-
-func randombeaconhistoryCdc() (*asset, error) {
-	bytes, err := randombeaconhistoryCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "RandomBeaconHistory.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x40, 0xe4, 0xea, 0x8b, 0xb2, 0xf1, 0x13, 0xca, 0xca, 0x78, 0x3, 0x64, 0x47, 0x54, 0xd5, 0xe6, 0xe, 0xf4, 0x6d, 0x6, 0xe1, 0xc2, 0x63, 0x3c, 0xb4, 0xf1, 0x3e, 0x87, 0xed, 0xa4, 0xee, 0x10}}
-	return a, nil
-}
-
-var _stakingproxyCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\x5b\x6f\xdb\x36\x14\x7e\xf7\xaf\x38\xcd\x43\x6b\x03\x69\x3c\x6c\x45\x31\x18\xf3\xb6\xae\xd9\xb0\xa0\x58\x17\xb4\xe9\xd3\x56\xa0\xb4\x78\x24\x11\xa1\x48\x8d\xa4\xea\x78\x81\xff\xfb\xc0\x8b\x2e\xd4\xc5\x76\xd2\x62\x7e\xb1\x2d\xf1\xdc\xbe\xc3\x73\xce\x47\x2e\x97\x70\x93\x33\x0d\x89\x14\x46\x91\xc4\x00\xc5\x94\x09\xd4\x40\x04\x30\x61\x50\xa5\x24\x41\x48\xa5\x02\x21\x29\x82\x36\xe4\x16\x95\x9e\x2d\x97\x60\x24\x54\x1a\xed\xd7\x06\x81\x6c\xb8\xfb\x59\xa2\x4a\xa5\x2a\x20\x91\x45\x21\x85\x5b\xce\x44\x06\x24\x31\x4c\x0a\x3d\xb3\x72\x57\x06\x08\xd7\xb2\x35\x04\x0a\xb5\xac\x54\x82\x60\x72\x62\x80\x78\x4b\xb2\x44\x45\x8c\x54\x90\x10\x61\xc5\x82\x2d\x6d\xa4\xc2\x46\x6f\xa9\xe4\x1d\x43\xed\xfc\x23\x9c\x83\x4c\xc1\xe4\xc8\x54\x57\x05\x93\x4e\x5e\x21\x77\xbf\x75\xce\x4a\x3d\x9b\x91\x24\x41\xad\xe7\x84\xf3\x45\x1b\xfb\x7b\xaf\xf6\x5a\xc9\xbb\x1d\xdc\xcf\x66\x00\x00\xcb\xe5\x12\x7e\x15\x86\x19\x8e\x05\x0a\xe3\x7d\xcc\x14\x11\x46\x83\x57\x62\xdd\x32\x39\xc6\x6e\x3f\xd3\x50\x2a\xf6\x99\x71\xcc\x90\x42\x5a\x89\x80\x80\x55\xd9\xb5\x8d\x1d\xd5\x6f\x25\xc5\x3f\x83\x7c\x6b\xbc\x24\x26\x6f\x23\x1f\x18\x6a\xd0\x6b\x04\x98\x18\xae\x72\xbe\xca\x4a\x18\x07\x55\x0d\x5f\x8e\xbc\x44\x35\xf0\x89\x63\xec\xcb\x6b\x52\x92\x0d\xe3\xcc\xec\xde\x1b\xa9\x48\x86\xd7\xc4\xe4\x2b\xe8\xfc\x99\x3d\x40\xc7\x75\xb5\xe1\x2c\xf1\x2a\xda\xdf\x6d\xbc\xaf\xa5\x30\x84\x09\xdd\x06\xc1\x44\x2a\x81\x68\x2d\x13\x46\x0c\x52\xd8\x32\x93\xf7\xb7\xc9\xc0\x01\x6d\x54\x95\x78\x1f\xae\xac\x7c\x48\xe7\x98\x9f\x8c\xda\x60\x14\x13\xd9\xe4\x12\x25\x39\xae\xe0\xc3\x95\x30\xdf\x4f\xae\x11\x68\xb6\x52\x59\x60\x5f\x51\xaa\x50\xeb\xa3\x5a\x5b\x89\x37\xb8\x3b\xba\x3a\x64\xad\xbb\xb4\x59\xcb\x04\x33\x73\x0b\xc8\xd5\x65\xfd\xf2\xbc\xeb\xf4\xf9\xb4\x77\xe7\xe3\x6e\x9c\x8f\xd8\x5b\xc0\x7d\x63\xd1\x7e\x4a\x85\xbd\x27\xf6\xe3\xdd\xb8\xe0\x28\x32\x93\xc3\x7a\x0d\x2f\x5f\xac\xe0\xac\x5b\x5b\x17\x75\x56\x2e\xac\xdf\x2b\x97\x24\xb8\xba\x84\x20\x52\x54\xda\xd8\xa6\xf2\xdd\xb7\xb0\xd9\x19\xd4\x30\x7f\xf9\x02\x72\xbc\x83\x24\x27\xb6\x4e\x51\xe9\x05\x6c\x2a\x03\x99\x34\xf0\xf7\x3c\xb2\xb7\x38\x1b\xfa\xd3\x8f\xbc\x76\xed\x47\xf8\x06\x9e\x3e\x8d\xc3\xef\xbd\x6b\x31\xe8\xbc\x58\x0d\x4c\xd4\x9f\x83\x51\x06\xeb\x40\x04\x85\x5b\xdc\x69\x1f\xa7\x6d\x5b\x1b\xbb\xd1\xc5\x73\x2c\x4a\xb3\x8b\xfd\xdf\x47\xff\x34\xf2\xf4\x82\x51\x58\x07\x8c\x87\x2f\x6d\xca\x61\xed\x32\x3f\x7c\x39\x00\xc2\x2a\xea\x3f\x3b\x24\xf6\x06\x77\x91\xc8\x1b\xdc\x0d\x97\xb7\x90\xc1\xba\x83\xdf\x2c\x0e\x69\xdf\x16\xfc\x4d\x8e\x9d\x51\xe3\xfa\x2b\x67\x05\x33\x1a\xb6\x13\xf3\x20\x94\x46\xa3\x21\x55\xb2\x70\xed\xc2\x8f\x27\xd8\xe6\xae\x23\xef\x82\x90\x9b\x5f\x53\x0d\xa2\xb5\x6c\xd3\xf5\xde\x29\x88\x06\x40\x5f\x2e\xad\xfc\x5c\xc3\xb7\xb8\xbd\x91\xb7\x28\xf4\x9c\x14\xb6\xb5\xae\xe0\xc3\x6f\xec\xee\xe5\x8b\xc5\x11\xb9\x0f\xc2\x7d\xd1\x87\x0a\x2b\xfc\xa7\x42\x6d\xbc\x38\x13\xd9\xe9\x92\x95\xb7\xf8\x8a\xf3\xf9\x81\x55\xb6\xad\x52\x45\xb6\x8f\xf5\xaf\x96\x7f\x87\x5b\xa2\xe8\x61\xf9\x03\xe9\x47\xa0\xa8\x13\xc5\x36\xd8\x6c\x00\x8a\x1c\xb3\x26\xf9\x54\x9e\x96\xcb\xcb\x5a\xea\x68\x3a\x83\xfe\xc7\x64\xb4\x16\x7d\x2c\x68\xb5\xfc\x29\xa0\x7d\xdd\x4d\xf1\x3f\xa5\xfb\x78\xb1\xf7\x0b\xbc\xb4\xa4\x40\xe7\xa8\x03\x97\xeb\xd5\xe5\xef\x92\xd3\x40\x5a\xac\x42\xa2\x2d\xe1\x91\x8a\xa2\xb2\x2c\x89\x70\x2e\xb7\x20\x4d\xee\xfe\xde\xa2\x80\xdc\xad\x77\x2c\xcd\xf6\x61\x46\x38\xfb\xb7\x25\x4b\x31\x15\x8a\x59\xa2\x27\x1a\x26\xc7\x62\xb0\xe1\x1a\xca\x3a\xd9\x3e\xbc\x9b\x9e\xe0\x1c\xda\x7b\x84\xd2\xee\xd0\x18\x0c\x71\x4b\x71\x77\x2b\xb8\xef\xa9\xdf\x1f\xc8\x4c\x86\xa6\x1e\x3d\x3d\x75\x8b\x55\x43\x88\x7e\x1a\xcb\x4c\xcc\xc4\x7b\x0c\xd2\x13\x50\xcf\x2e\x99\xaa\x09\x65\xdb\x85\x8d\x84\x82\x08\x92\xe1\x28\x8a\x51\x2e\x6c\x83\x2e\xc9\xce\x61\x0b\x32\x4d\x9f\x27\x39\x61\xa2\xc3\x60\xb5\x41\x42\x2d\x9d\x6f\x85\x87\xdc\xb9\xf1\x76\x14\xfa\xd5\xa9\x19\xb1\x16\xff\x20\xa5\xf6\xf1\x5e\x5d\xba\xad\x42\xc4\xae\xee\x2a\x0e\x0b\x56\x94\x9e\xa5\x7b\x5e\xda\x9f\x15\xcd\x36\x88\xb4\xba\xcd\xa8\xfb\x38\x1a\x09\x76\x7e\xfa\xd0\x2a\xed\xf0\xb0\x3f\xb9\x4c\x6e\x91\x76\x83\x6d\xf4\x88\x40\x7a\x43\x23\x1f\x2c\x0a\xa8\xd8\xf9\xbb\x80\xcf\xa4\x61\xf8\xd7\xfe\x7c\xb4\x82\x7b\x9f\xff\x91\x7d\xb4\x3f\x02\x44\xbd\x5f\xa6\x6d\x89\xb0\xa2\x63\xa5\x16\xda\xf7\x18\x6a\x9f\x40\x76\x09\x43\xf0\x15\xd6\x70\x3f\xc2\x7a\x6a\x23\xf1\xeb\x9e\xf3\x6f\x7b\x44\x81\x73\x9b\x2d\xe6\x13\x4a\x69\x38\x48\x6c\x64\xd5\x34\x1d\xcb\x12\x22\x15\x5b\x62\xcf\x78\xd2\x45\x59\x9a\x80\x73\xc3\x1f\x3a\xf1\x77\x0f\x37\x4d\x25\xc7\x65\xe7\x30\xa9\x1f\x9d\xc6\x9d\xa3\x58\xff\x12\x0d\x81\xa4\x1f\x2d\x91\x16\x8c\x1f\x63\x87\x13\xb2\x4d\x92\xa6\xa0\x7b\x87\x85\xfc\xdc\x3d\x6f\xb1\x14\x98\x01\xa6\xc5\x33\x3b\x57\xdd\xf1\xdb\xd6\x44\x21\x15\x1e\x87\x42\x39\x6d\xc7\x9b\x50\x0f\x01\x85\xa6\x52\x22\x0e\xe4\xc2\xeb\x9a\xdf\xda\x63\x88\x57\xb4\x78\x32\x15\xc5\x75\x18\x1d\xed\x89\xdb\xe6\x32\x43\x4f\xb3\x4d\x1e\x62\x73\xd7\x05\xa0\x4b\x4c\x58\xca\x92\x7a\xbb\x7f\x61\x3f\x3d\x21\x16\x9f\x94\xcb\x8f\x0f\x70\xdf\xbb\xda\xed\x9e\xae\x79\xe6\x44\x83\x66\x99\x40\x0a\x55\x19\xe9\x18\xbb\x89\x68\xe0\x36\xb2\xa1\xc4\xbe\x00\x22\xd1\x1b\x4b\x99\x9b\x6b\x06\xa6\xe0\x53\xaf\x59\x7c\x82\x1c\x15\xb6\x63\xb6\xb6\x15\x69\x69\xea\xaf\x73\x1d\xa4\x65\x81\xfd\xcb\x20\x77\x0b\xf4\xf5\xc7\xe2\xe9\x65\x16\xf7\x9d\x3a\x37\x27\xd6\xd9\x94\xb0\x77\x6c\x2a\xc1\x37\x83\xdb\x1b\x4b\x68\x93\x7a\x73\xfa\xad\x1e\xdd\x42\xd5\x1b\x21\xce\xb1\x0c\x4b\xed\x2e\xee\x5c\x85\xed\x9a\xaa\x05\x21\x81\x4b\x91\xa1\x02\x81\x48\x91\x9e\x5a\xb3\x07\x40\x5f\x8c\xc0\xfd\xc5\x68\x3f\x19\x43\x7b\xb2\x90\x62\x25\x0f\x6a\x0d\xbf\x48\xa5\xe4\x16\x08\x9c\x29\x4c\x51\xa1\x48\xf0\xac\xbe\xb9\x8b\x31\xd4\xb2\x79\xd0\xdc\x20\xc6\xf3\xd8\x26\x6d\x83\xf5\xee\xae\x6f\xa4\x98\x39\x0e\xf2\xc6\x79\xf1\x40\x90\x0f\xb5\x96\x71\x58\xa7\x0f\xdb\xaf\x15\x86\xea\xc7\x6d\x88\x37\xb4\x15\xdf\x69\x0e\x5f\xaa\xd9\x10\x12\xa7\xa1\xc3\xa8\xe6\x8b\x15\xfc\x3c\xca\xb5\x3a\x7e\x07\x9f\x7f\x78\x1e\xe4\xc7\xc9\xd9\x3c\x3a\x2f\x0c\x28\x83\x8b\xf8\xe8\xcd\x24\xac\x61\xa9\xfd\xdf\xa5\xe8\x5e\xa9\x9e\xa0\xa6\xbd\x90\xb4\x5a\xdc\x49\x24\x19\x2a\xd9\xcf\xf6\xb3\xff\x02\x00\x00\xff\xff\x21\xb1\xb7\x00\x39\x17\x00\x00"
-
-func stakingproxyCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_stakingproxyCdc,
-		"StakingProxy.cdc",
-	)
-}
-
-func stakingproxyCdc() (*asset, error) {
-	bytes, err := stakingproxyCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "StakingProxy.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x11, 0x4b, 0x68, 0x12, 0xc9, 0xa, 0x9f, 0x61, 0x5c, 0x37, 0xb3, 0xe1, 0x38, 0x44, 0x72, 0xb2, 0x56, 0xae, 0x17, 0x9d, 0x7e, 0xd5, 0xd, 0xad, 0xd0, 0xe7, 0x1a, 0xe8, 0x5e, 0x11, 0x36, 0x3d}}
-	return a, nil
-}
-
-var _epochsFlowclusterqcCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x7c\xfd\x6e\x5b\x37\xb2\xf8\xff\x7e\x8a\x49\x81\x1f\x2a\x75\x15\x39\x69\x8b\x60\xe1\xdf\x7a\x7b\x5d\xb9\xbb\x35\xda\x24\x4d\xec\xa6\x7f\x64\x83\x98\x3a\x67\x24\x71\x73\x0e\xa9\x90\x3c\x72\xd5\x20\xc0\x7d\x88\xfb\x84\xf7\x49\x2e\x86\x5f\x87\x3c\x1f\xb2\x92\xbb\x77\x0d\xb4\xb0\x23\x72\xbe\x67\x38\x33\x1c\xea\xe4\xf4\x2b\x38\xf9\xea\xe4\x2b\x80\xa7\x4c\xb0\x35\x6a\x30\x1b\x84\xad\x92\x05\x6a\x0d\x72\x05\x85\xac\x2a\x2c\x0c\x17\x6b\xd8\x49\x83\x1a\x56\x52\xd9\x35\x4a\x4a\x03\xef\x1b\xa9\x9a\x1a\x0a\x54\x86\xaf\x78\xc1\x0c\xd2\x1e\xfa\xb8\xd9\x16\xb2\xe6\x62\x4d\xa0\x71\x2b\x8b\x8d\xdd\xc8\xaa\x2a\x42\x94\x02\x84\x2c\x11\x8a\xaa\xd1\x06\x95\x06\xa6\x35\x5f\x0b\x2c\x23\x8a\x00\xc3\x01\x98\x3b\x3a\x7f\xdb\xa0\x08\x30\xa4\xb2\x20\x34\x30\x85\xb0\xe2\x4a\x1b\x50\xb8\xe6\x04\x0e\xcb\x19\xc1\xd8\x43\xc1\x04\x28\x7c\xdf\xa0\x36\xc0\xe0\x95\x34\xa8\x40\x2e\xff\x89\x85\x81\x95\x92\x35\x98\x0d\xd7\x50\x48\x61\x14\x2b\xcc\x9c\x30\xdc\x6c\x70\xff\x65\x55\x41\xa3\xd1\x7d\x1a\x96\x4b\x05\xb8\x43\xb5\x07\xdd\x2c\x35\x81\x14\xc6\xf3\x76\xb7\x41\x85\x0e\x1f\x91\xc2\x40\x1b\xf6\x0e\xcb\x0e\x9d\x9e\x83\x0b\x63\xb9\x5b\xe2\x9a\x0b\x41\xec\xc9\x15\x20\x2b\x36\xf0\x03\xc1\xba\x46\xd3\x6c\x61\xbb\x61\x1a\x2d\x07\xc0\xca\x9a\x0b\xe0\x82\x1b\xce\x2a\xfe\x87\x55\x51\x42\x32\xdc\x71\xb3\x21\xb0\xb4\xb6\xc5\x17\xa5\x3a\x22\x4c\xf8\x81\x30\xe6\xf4\xc1\x86\x69\xa2\x9d\x8b\x75\x85\x56\xdd\x0e\x2e\x33\xc0\x35\xe9\x4e\x92\x86\xa3\x7e\x6a\x60\xa2\x6c\x85\x2c\x45\x45\xbf\x54\x15\xfd\x13\x57\x70\x4b\x00\x6e\x61\xd5\x08\xa7\x6c\x29\x0a\xb4\xf2\xa5\xff\x9e\x8b\x02\xc1\xaf\x6d\x69\xdd\xb0\x1d\x82\xc2\x02\xf9\x0e\x4b\x40\x21\x9b\xf5\x06\x78\x89\xc2\xf0\x82\x55\xde\x00\x8d\x04\xdd\xa8\x2d\xd3\xda\x23\xba\x43\xbe\xde\x90\x4c\x15\xea\x8d\xac\xca\x99\x57\x22\xbc\x58\xc0\x1a\x05\x2a\x66\xf1\x5b\x91\x12\x23\x2b\x2e\xb8\xde\x60\x19\xc8\xf7\x12\xbe\xe3\x55\x05\xe8\xff\x69\x27\xc9\xe4\xe7\x5e\x5d\x4c\xec\x61\x2b\xb9\x30\x33\xfa\x55\x0a\xb4\x0c\xbf\x6f\xc8\x16\xda\xd5\xc0\xc5\x4a\xaa\xda\x61\x0b\x62\x8f\xbc\x11\xa8\xe5\x1e\x1a\x92\xae\xfd\xe4\x76\x8d\x66\xe1\x3f\x6d\xc5\x44\x28\x1d\xfd\xa9\x8e\x49\xfc\x50\x63\xbd\x24\xe3\x5d\x91\x8e\x50\x71\xb4\x0e\xea\x0c\x50\xd7\x4c\x99\xb8\x5e\xc3\xdd\x86\x5b\xf5\x4a\x55\x72\xc1\x8c\xf7\x6b\x02\x9c\xf8\xb6\x51\x4c\x68\x4e\x58\x89\xa6\x25\x9a\x3b\x44\xe1\x00\x6a\xe0\x02\xfe\x56\xc9\xbb\xf9\xc9\x57\xa7\x27\x27\xbc\xde\x4a\x65\x60\xa1\xf6\x5b\x23\x4f\x4e\x58\x41\x20\x26\xac\xaa\xa6\x2d\x8d\xb4\xda\xf3\xf3\x62\x01\x1f\x4e\x4e\x00\x00\x4e\x4f\xe1\xfc\x5f\xfc\x13\xe0\x2e\x9e\x3f\xbb\x79\x79\xb1\xb8\x81\x57\x17\x2f\xaf\x2e\xbe\xff\xf9\x87\xeb\xff\x33\x8c\x1e\xf0\x29\x5c\x89\xd2\x46\x39\x12\x30\x9a\x0d\x2a\x6f\x93\xe4\xf4\x45\xa3\x14\x0a\x53\xed\x61\x89\x24\x4f\xef\x5b\x58\xce\xdb\xed\x2b\x58\xb1\x8a\x1c\x5b\x48\xe7\x71\x72\x4b\xe6\x29\x95\xb3\xbe\x25\x02\x5b\x56\xe8\x4c\x7c\x59\x73\xe3\xc0\xdb\xfd\xa9\xcc\x77\x4c\x01\x17\xbf\x28\xb9\x56\xa8\xf5\x19\x7c\x2f\x65\xd5\x12\x79\xd3\x06\x82\x7e\x90\x8d\x76\xe9\xa8\x75\xda\xce\x10\x14\x85\x6c\x84\x71\x48\xc2\xb6\x33\x78\xed\x55\xfb\x66\x48\x18\x9c\x4c\x72\x67\x43\xab\x42\x2d\x1b\x55\xf8\x58\x52\x29\x64\x25\x09\x84\x62\x76\xc5\x78\x8d\x25\x39\x01\x73\x44\x5d\x5d\x46\x58\x3e\x14\xa3\xf7\x76\xb3\x07\x63\x25\x11\xac\x2b\x2e\x7c\xe6\x36\xfa\x58\x61\xa4\x03\x1b\xd1\x53\x90\x89\x6b\xc9\x51\x2d\x22\x2b\x5c\x17\xce\x11\x34\xab\x11\xf4\x16\x0b\x3a\xb1\xe0\xea\xd2\x86\x81\x57\x39\xf1\xe1\xac\x32\xbc\x6e\xc1\xdd\x0a\x5e\xdd\x42\x8d\x4c\x68\x17\x14\x8d\x8d\xfa\x5c\x93\x36\x7d\x08\x28\xd8\x96\x2d\x79\x45\x0c\x04\x49\xf7\x58\x25\x0b\x48\xc1\x04\xda\x07\xf6\x5e\x5d\xce\x60\xd9\x18\xe0\x86\xe4\x29\xbe\x34\x99\x28\x23\x48\xa3\x1a\xec\x10\xd6\x87\x49\x0a\xe9\x2a\x22\xd0\x37\x6a\x00\x16\xca\xc2\x6d\x38\x83\x0f\xd7\x46\x71\xb1\x76\x06\xf7\x71\xd8\x2d\x98\x09\x56\x13\xd4\xcc\x6d\x30\x19\x37\x3c\x82\xf0\x8a\x55\x0d\xba\x30\x17\x76\x73\x51\xe2\xef\x29\x61\xc1\x16\x1c\x65\x04\xda\xdb\x64\x42\xd8\xaf\x57\xc2\x3c\x7e\xf2\xf1\xdf\x17\x7c\x16\xcf\x9f\x5d\xdf\x5c\x3c\xbb\xf9\x37\x04\x9f\x05\x13\x52\xd8\x83\x70\xcb\xcc\xc6\xb9\xb2\x3b\xba\xc8\x82\x73\xf7\xeb\xc7\x8c\x0a\x0d\x5c\xd0\xea\x6b\x23\x15\x5b\xe3\x2f\xcc\x6c\xce\x20\xf9\x63\x70\x87\xf5\x8b\xd1\x1d\x91\xb4\x97\xb8\x55\xa8\x51\x18\xab\xc0\xe1\xd8\xe3\xe8\x85\x35\xdf\x85\x43\x66\x0e\x3d\x9c\xda\xa8\xa6\x30\xe0\x15\x1b\x4e\x91\x34\xb2\x59\xb3\x08\x59\x66\x00\x4d\x39\x10\x17\xd9\x3f\x31\xa5\xd8\x7e\xee\xce\xd1\x46\xf0\xf7\x0d\x56\x7b\x1f\x5d\x56\xdc\xcb\x27\xc0\x65\xe3\x34\xc6\x75\x5d\xc9\x58\x3a\x82\xc1\xe5\x64\xfe\x66\x13\x12\xa7\x20\x9b\xd8\x91\x18\xae\x2e\x21\xa7\x70\x14\x32\xad\xf6\x20\x3a\x96\xfd\xe4\xdb\x8f\x7d\x81\x18\x69\x58\xe5\xe3\x9c\xcb\x84\x28\x43\xf0\xa9\x95\x4b\x8f\x8f\x44\x6c\x21\x39\xcc\x01\x5f\x8e\xee\x95\x4b\xc0\xc8\xc7\x1d\x60\x1f\x7c\x8f\x4d\x68\x33\x60\x3f\xe1\xde\xc5\x4e\x1b\x1e\x8f\x3b\x01\x52\x42\x5a\x5b\xb7\xea\x97\x8d\x01\xaa\x1f\x98\x69\x14\x65\x46\x0a\x6a\xd4\xda\x96\x34\x99\x1e\xec\x59\xad\x8d\x54\x58\x02\xc5\xef\xdc\x10\xc6\x38\xf1\x59\x56\xcb\x8a\xb7\xdd\xa8\x72\xaa\x4a\x7c\xbc\x73\x47\xb7\xf6\x71\x7d\x16\xa3\x71\x9b\x0a\x53\x71\xa0\x29\xa8\x13\xd1\xd6\x94\xb9\x86\x9a\x6d\x67\x39\x31\x65\x19\x52\xdc\xc8\x98\x75\x75\xcf\x98\x85\x2c\xdc\x32\x6e\x60\xc9\x8a\x77\x74\x20\x5a\x60\x16\x5f\xc5\xb5\x99\x67\x20\xaf\x56\x81\x48\x3a\x0d\x68\x91\x2b\x93\x28\x5d\x8f\x38\x6e\x2d\x92\x5b\x8f\xe5\x16\x56\x1c\xab\x32\x26\x28\x42\x8a\x87\xf6\x24\x1c\x07\x4c\xe7\xd4\x67\xc1\xce\xe1\x76\x33\x1e\x9f\xcb\x63\x69\xcd\x30\x71\x0d\xfa\xbb\xeb\x18\x8a\x15\xef\xb4\xd3\x9d\xf3\x7e\x27\x12\xc2\xbe\x91\x77\x50\x37\x36\x3d\xae\x97\x9c\x0a\x4e\xef\x37\xf1\x84\xa4\x48\x16\x0f\x2c\x5b\x07\x8d\xd1\xe4\x60\x13\x01\x4f\x1d\x4b\x37\xad\x0f\x1d\xf4\x5e\xaa\xe7\x26\x59\x0c\x99\x1d\x76\xfc\x29\x7c\x88\x9b\xe9\x47\x63\xb5\x9a\xbb\x60\x78\x9e\x9c\x95\xd9\xc7\x09\x40\x38\x4f\xc1\x9f\x64\x6b\x89\x91\x01\xdf\x87\x73\x78\x94\xad\x23\x89\x78\x51\x71\x91\x82\x9b\xef\xe8\xf8\xd6\x1d\x0a\xe9\x27\x01\x0b\xe7\xd9\x5f\x7f\xf2\xa0\xb2\x2d\x1f\xfb\x3c\x8c\x42\xe8\x2f\xcd\x0d\x04\xce\xe1\xc3\x00\xbc\x83\x1a\xcb\xf7\x74\x6c\xea\x25\x9a\x46\x09\x57\x49\x89\x26\xd4\x62\x47\x47\xd8\x55\x23\x40\xf3\x3f\x70\x32\x0d\x1a\xef\xc8\x4b\x59\xf8\xfe\xb3\x49\x57\x81\xf3\x0a\xc5\xda\x6c\xa6\x70\x0c\x79\x35\x17\xbc\x6e\x6a\xd0\x4d\x4d\x34\x5a\xd3\xf7\x9a\x53\xf8\xbe\xe1\x14\xfc\xb8\x00\xa9\x4a\x24\xd5\xa7\x85\x47\x10\x22\xb0\x0c\xfa\x8e\x55\xbc\x1c\xea\xf7\x38\x37\xa1\x62\xd5\xf1\x3e\xef\x1c\x50\x94\xfd\x69\x60\x02\xae\x9e\x2d\x7e\xfe\xf5\xfa\xea\xd5\x0f\x50\xc9\x3b\x54\xb0\x94\x8d\x28\xcf\x7c\x94\x0c\xd4\xfd\xf5\xdc\xc7\x2f\x9b\x0f\xd6\x88\x46\x7b\xa4\x5d\xb8\xd8\x16\xfc\x84\x40\xa3\x01\xb6\x94\x3b\x84\xaf\x4f\xbf\xb1\x31\xd5\x1e\x8b\x0e\xec\x0c\x50\xe8\x86\xdc\x09\xbe\xdf\xff\xc1\x84\xe1\x02\x61\xc5\x9a\x8a\xce\xbc\x0a\x15\xb3\xad\x89\x21\xa5\xed\x38\xde\x59\xcd\x11\x91\x37\x01\x61\x50\xe1\x93\x6f\x3b\x2a\xe4\xab\x01\xa3\x3d\x87\x47\x03\x9e\xe1\xb5\xfd\xa8\x63\xff\xd9\x9f\x74\x28\xaf\x2a\x29\xd5\x73\x81\x37\x1b\xae\x4a\x38\xef\xc3\x3f\xf5\xa4\x4c\xbe\x99\x52\xfe\xc9\x85\xc1\x35\x2a\x28\xf9\x8e\x6b\x2e\xc5\x0c\xb8\x28\xaa\x86\x8c\xd4\x82\xea\xbb\xbe\xb2\xbe\xe2\x81\x7c\x3d\x85\xaf\x72\x9c\x7d\x92\x4a\xbe\x7b\x89\x35\xa3\xa0\xa3\x86\x28\xfa\x7f\x2d\x45\x27\x5d\xf1\x64\x7b\xff\x12\xd1\x3e\xee\x86\x37\x27\x22\x22\x8c\xfe\xff\xa7\x76\x5d\x2e\x2f\x40\x2a\xa6\x0e\x6f\x4d\x51\x1e\x92\xb6\xd7\x88\x4a\x92\xc3\x03\x2e\xa6\x0d\x33\x8d\x8e\xa7\xb7\xb7\xfe\x2f\x35\xbc\x58\x84\x1e\x4b\xff\x84\xb4\xa6\xee\x0f\x3f\xb2\xb3\xa6\x6e\x2a\x57\x37\x45\xf3\xef\x18\x9a\x4b\x1f\x5e\x2c\xc0\xb6\x83\xea\x6d\x85\x06\x7b\xae\x20\x06\x68\x48\x3a\x5f\xae\x93\xa4\x79\x89\xca\xf6\x24\x1d\x14\xdf\xfe\xa2\xcc\x03\xcd\x46\x96\x9e\xff\x9c\xea\x98\xbb\x04\xa2\x6d\xf2\xa7\xe8\x5c\xc5\x72\xd0\x33\xaf\x56\xbe\x1c\x76\xbd\x36\x72\x72\x0a\x2b\x76\x8b\xdf\xe0\x13\x17\x57\x4f\x73\xed\x11\x87\x46\x89\x07\x94\x01\x7d\xf6\xfc\xe6\x87\x33\xeb\xf4\xb7\x7f\x3d\xbf\xb5\x2c\x30\xc5\x75\xe0\x4d\x29\x2c\x8c\xe5\x87\xac\x5f\x10\xd3\xac\x9a\x77\x45\x19\x18\x04\x26\x32\xe0\xd6\x3f\x34\xdf\x61\x1a\x98\xe0\xbf\xff\xf3\xbf\x80\x65\x51\x04\xf0\x7d\xc3\x2a\x97\x62\xa5\xd1\x47\x33\xc3\x35\xd5\x14\x23\x91\xca\x45\xc0\x67\xcf\x6f\x80\xc1\x1d\xb2\x77\x28\xb0\x8d\xa4\x1b\x2c\xde\xfd\xff\x0e\x40\x17\xfc\xb8\x21\xc7\xb2\x0c\xb2\xaa\xb0\x76\x42\x92\xcc\x80\xfb\x60\x4e\x65\x13\x2f\x4c\xb5\x87\x5a\xda\x3e\x34\x13\x43\x61\xf0\x9e\x10\xc7\xf5\xc2\x9b\x06\xc5\x37\x97\x7e\x7c\xd7\xf1\xad\x55\x9b\x57\xd3\x01\x72\xff\x89\x3a\x7f\x87\xfb\xa1\xc4\x20\x44\xca\x83\x9b\x5f\x7b\x54\x6f\x1e\x90\x67\xd8\xf5\x5d\x9d\xf6\x21\x27\xae\xec\xb7\xf7\x96\x7c\x3c\x90\x74\xf8\xad\x82\x57\x63\x51\xe0\xef\xfe\x84\x74\x71\xe0\x85\x53\xe4\xe2\xc0\x91\xd8\xf5\x8f\xb4\x4c\xb5\x15\x90\x89\x4e\x39\x73\x1b\x83\xa5\x8e\x27\xc4\xa4\xb0\x70\x54\x3b\x12\x12\x0a\x48\x7f\xb1\x15\xfb\x5d\x5a\x45\x3b\x2a\xe0\xb9\xa8\xf6\xed\x49\x1f\x22\xcc\x2a\x6b\x66\xb7\xf1\x66\x9c\x21\xaf\x49\x3a\x14\x9c\x41\x7b\x25\x86\x53\x21\x35\xa9\x2e\x19\x9e\x94\x85\x42\x9b\x6d\x80\xc0\x3b\xc0\x7a\x6b\xf6\xf0\x62\xd1\x5b\x68\x9b\x91\x2d\x83\x09\x7b\x70\xde\xfe\x1e\x12\xea\x36\x37\x9e\x25\x35\xe1\x19\xbc\x7e\x33\x0b\x36\x71\x96\x13\x3c\x73\xfd\x93\xab\x4b\xbb\x6a\x3a\x48\xe9\x45\xe9\xee\x06\x5a\x88\x11\x9a\x9e\xd9\xe8\x23\x42\x73\xd2\xde\x85\x90\xd4\xda\xfe\x6d\x07\x98\x8d\xa3\x35\x33\xc5\x26\xc6\x58\x3d\x14\x5c\x7b\x7b\x03\xd4\xe8\x7e\x79\xd6\x3b\x9e\x8a\xd3\xcf\xe0\x3f\x06\x7b\xb0\x6d\xbf\x94\x92\x3b\x54\xa1\x9a\x35\xbe\xdb\xd8\xfd\xf1\xca\x8f\x8b\x5a\xfd\x13\x9c\x79\x08\x15\xc3\xd4\x78\x00\xfd\xcd\xe7\x1d\x6b\x1a\xdf\x4e\x3f\x89\x5d\xcc\x59\x59\x5e\x07\xf5\x4c\x2c\x09\x51\x5b\x0f\xa6\x9f\x02\xe5\x95\x33\x07\x07\xc3\xf5\x6f\xc6\xf7\x7f\x1c\xfc\xa4\xff\xaf\x1f\xfb\x76\xe5\xc3\x4d\x82\xfd\xd8\x04\xa7\x17\xa6\x72\x9c\xbd\xa0\x15\x3b\x12\x5e\xb9\x4c\xb7\x15\xb5\xef\x7b\xa5\xad\x6a\x18\x6c\xbc\x86\xb3\x62\x8d\xe6\xef\xa9\xdd\x4d\xac\x88\xca\x70\x6c\x4c\x5d\x51\xde\x3d\x3d\x3c\xd1\x03\x66\xfb\xda\xed\x7f\x33\x46\xfe\x35\x91\xdf\x36\x52\x7c\x69\xee\xbb\xf8\x58\xde\x4f\xb9\xad\xbe\x0e\x13\xed\x62\x80\xa3\x7c\xb0\xda\x1e\x26\xd9\xdb\xfa\x41\xc1\x9b\xd8\xa7\xf3\xa9\x44\x21\xeb\x9a\xb7\x82\x4f\x5a\x14\xc7\x09\xff\xd7\x03\xc7\xe6\xc4\xb1\x11\x35\xe1\x52\xe7\x43\xba\x38\x7c\x08\x13\xb8\xfb\x15\x93\xb1\xf7\x09\x5c\x79\xc5\x1c\xcd\xd0\xcc\xe3\x08\x7c\x0d\x6a\xea\x08\x86\xe0\xbc\xdb\x80\x70\xbe\x93\x5c\x6b\xdc\xbe\xb2\x37\xd8\x2a\x6d\x6e\x3b\xfb\x53\xb2\xb6\xb7\x4b\x9d\x56\xb7\x6f\x0a\xda\x63\xde\x80\xe6\xf5\xd6\x86\x55\x61\x18\x17\xda\x26\x69\x62\xed\xba\x95\xf1\x20\xc1\x32\xcb\x52\x42\xce\xbd\xc1\xdf\x01\x45\x21\xcb\xf6\x73\x9f\x10\xce\x7d\x17\xd6\xce\x19\xac\xd7\x0a\xd7\x3e\x31\x84\x65\xc3\xab\xa1\x12\xbd\x7f\x17\xe0\xbb\xec\xb6\x8b\x3a\xd0\x62\xef\xb5\x64\xb5\x61\xef\xdc\x95\x66\xa7\x17\xdb\x6d\x87\xb9\x40\x19\x54\xd5\x87\x9c\x73\xdc\x22\xb0\x18\x27\xed\xdd\xb8\x6b\xaa\xdc\x7a\xbc\x3f\xe1\xfe\x76\x3a\x8a\x33\x06\xf8\x98\xb3\xf6\xf1\x6e\xf0\xf7\x87\x5d\x71\x1e\xd5\xdf\x8b\xd9\xc2\x28\xe8\xc1\xfb\x08\x7f\x0b\x17\x1a\xb1\x93\x90\x1c\x4c\xdd\x65\xd8\x68\x07\xde\xef\xbf\x1a\xbf\x5b\x20\x94\x6d\x9b\x3f\x46\xc4\x16\xc3\x28\xec\xbb\x91\xc6\xbe\x0d\x2b\xb6\x1d\x99\x6b\x6f\x36\x48\x8d\x0b\x92\xbf\x1d\x74\xc1\xad\x1a\x3a\xb1\x1c\x74\xdf\xc6\xa2\x33\xfe\xc9\xb7\x67\xf0\x45\x36\x35\x30\x27\x8b\x9c\x13\x2d\x67\xfe\x46\xf6\xea\x12\xea\x46\x1b\xdb\xa0\xf2\x3d\x28\x0f\x40\xae\xe0\xc9\xb7\xd6\x4f\x8a\x0d\x23\xa3\x44\xa5\xed\x5d\xe9\x5a\x1a\xf8\xc7\x24\xc3\x36\xfd\xe2\xbe\x2e\x63\xdb\x60\x3f\xef\x9d\xa9\x76\x41\x1d\x13\x9b\xc1\x8f\xfd\x0d\xcf\xb9\xe7\xb2\xbf\x20\x95\x25\x9c\x67\xa2\xed\x2f\xbe\x0b\xed\xce\x56\xd6\x43\xf1\xb7\xd7\x5d\x44\xd3\x26\x3f\x6f\xfb\x8e\x31\x18\x2a\x53\xce\xe3\xef\x47\x62\xf3\xc1\x75\xf2\xb6\xeb\x27\x83\x98\x5a\x11\x76\xeb\xb2\x5e\xe4\x4d\xee\x13\xc9\xc4\x47\xda\x8e\xac\xbd\xc6\x4f\x0b\x13\x7b\x77\x22\xca\xfc\x0e\xec\xd4\xe5\xb5\x03\x7d\xda\xf1\xeb\xc7\x76\x8c\x05\xc6\x1c\xfe\x7d\xd1\x01\x09\x0a\x0b\xa9\xca\x51\x2f\x3c\x70\x6d\x78\x13\x9c\x39\xb9\xc6\xb2\x11\xf2\x93\x2f\xf3\xc2\xcd\xfd\x75\x5a\xfb\x38\xcd\xbc\x19\xc1\x99\x85\xe4\x80\xd0\x39\x9c\xad\x8c\x3e\x09\xef\xd3\xdc\x1a\x46\x4f\x18\x5f\x67\xd8\x06\x8e\xde\x4a\xd7\x29\xb2\x8d\xa2\x83\x41\x39\x29\xd5\x7a\x4c\xb5\xd1\xac\x73\xb9\xa2\x07\x64\x31\xeb\xda\xed\x6c\x00\xf6\xe7\x5c\xbc\xe4\xc2\x4f\x3d\x4b\x0f\x2f\x7e\x3a\xea\x1b\xd9\x32\xa2\xcc\x87\x05\xfa\xf5\x18\x37\xcd\x2a\xa2\x63\x83\x42\x4e\xff\x9c\x6d\xb7\x28\xca\x49\xdc\x3b\x3d\x12\x71\x28\xa2\xde\x06\x8a\xef\x45\x4a\x5c\x05\x74\xfe\xef\xe9\xd1\x61\x22\x49\x87\xda\xa8\xe6\x02\x45\xf0\xce\x7e\x24\x99\xdf\x1b\x04\x88\x8b\x4b\x66\x58\x42\x73\xb0\xe3\x21\x8c\xb3\x34\xdb\x98\xc3\x55\xe8\xbe\x53\xbe\x18\xab\x97\xf6\x3a\x9a\x0b\x37\x29\x49\x9c\xdf\x0e\x77\xe8\x28\x6e\xb4\x98\xae\xbb\x1a\xbc\xdf\xbd\x84\x51\x7c\xd9\xd8\x34\xd1\xde\xc7\xba\x2a\x50\x8e\x0a\x6d\x9c\x8c\x43\xae\x67\xbd\xee\x00\x9d\x47\x3b\xd7\x00\x0c\x38\x1f\x92\xc0\xa7\x3a\x49\xc7\x7c\x48\x54\x9d\xc1\x2f\xde\x2d\x8a\x31\x19\x93\x8d\x13\x2d\x6c\xe5\x92\x3c\xdc\xc7\x79\xe3\x76\xc8\xcf\x0e\xd6\xb6\x00\x85\x36\x4c\x78\xc8\x52\x96\x71\xb4\x6c\xd5\x58\xbe\xb6\xd2\x36\xac\x59\xe5\xe7\x2e\xdd\xbc\xd7\x1d\xaf\xaa\x08\xd0\x36\x93\x96\x21\x1a\xfb\x2e\x5d\x3e\x3d\xd8\xce\x76\x49\xb1\xe2\xaa\xc6\x12\x58\x32\x8a\x13\xe6\x7b\xe3\x48\x1a\xfe\x6e\xc2\x50\x75\x57\xcb\x91\x72\x27\x99\x91\xf2\xe0\xea\x32\x4d\x3f\x15\x4c\x46\x6b\x85\xf1\x84\xf4\xde\x62\xc1\x43\x7a\x87\xfb\x80\xcc\xd5\x09\x9f\x88\xcb\x16\x09\xb1\x92\xe8\xe3\x1b\xcc\x7d\xfb\x1b\x8e\xcb\x72\x1f\xe4\x09\xad\x33\x42\xed\x27\xe8\x3c\x96\xe9\x60\xde\xab\x7c\xe2\xbb\x60\xc2\x76\x84\x43\x5f\x74\x68\x34\x31\x96\x68\x93\x90\xe9\x4e\xa7\xce\xd9\xc7\xc6\x2e\xbb\x19\xf0\x67\xa4\xb0\xad\x48\xe8\x40\x8b\x7f\x64\x0b\x07\xb8\xf7\xbc\xbb\x76\xc9\x25\xd5\xdd\x46\x35\xa3\xed\x92\x6b\x3f\x3c\x43\xea\x75\x63\x58\xb6\x03\x47\x52\x21\x1f\x28\x58\x55\x61\xe9\x5c\x42\x92\x5b\x6d\x51\x75\x06\xb5\x08\x4a\xf6\xc7\x2f\x4c\xb1\x5a\x9f\xe5\x19\xd1\x19\x5c\xbb\x5a\xf4\x36\x39\x7e\x6f\xdb\xda\xbc\x5f\x81\x66\x30\xc3\x4f\x96\xed\xfc\xd8\xaf\x32\xd3\x4d\xa3\x27\x25\x01\x99\x74\xa9\x4b\x42\xe6\xd3\xc3\xc9\xf5\xb0\x21\xe6\x9a\x48\xa7\x85\x07\x6d\x2f\xb6\xbe\xc8\x9f\xe2\xa8\x3c\x19\x22\x17\xb0\xf5\x7b\xbf\xe8\xf7\xe5\x53\xaa\x43\x6d\xf7\x57\x78\x74\x1f\x96\x24\xc9\x75\x05\x1e\x61\x5a\xa2\xbb\x00\x18\x46\xe3\xa5\xf0\x49\x48\x42\x56\x7b\x04\x8a\x8e\xe3\x92\xfe\x7f\x64\x9a\xa0\x94\x93\xc4\x3f\xc6\x7c\x37\x45\x1a\x90\xd9\x11\x65\xe7\x84\x4c\x9b\xe8\x96\xab\x10\x8e\xaf\x2e\xe1\x1f\x19\xec\x83\x4e\xea\x7a\x89\xee\x7d\x4e\xb3\xac\x78\xe1\x02\x63\xf6\x94\x25\x4e\xb6\xbd\xeb\xf8\x25\xc5\x5b\xb7\xcb\xb9\xef\x2f\xe1\xf7\x49\x4f\x10\x71\xd9\x59\xd7\xed\xe7\x25\x92\x71\xff\x88\xbf\x4f\xa6\xb3\xde\xbe\xa8\xd0\x8b\x6a\x2d\x15\x37\x9b\xda\xf9\x58\xfe\x6f\xf3\xef\x7f\xbe\x7e\xfb\xfd\xcf\xd7\x8f\xbf\x7e\xfb\xcd\x9f\x1f\x67\x40\xa6\x3d\x7e\x17\x1b\x74\x33\x6d\x1a\xb1\x9d\x6c\x6e\x2d\x47\xba\x92\x24\x36\xc8\xb4\x3f\x23\x7b\xac\x73\xfd\xca\x7e\x70\xde\x72\x37\xdf\xa1\xe2\xab\x01\xfe\x93\x0c\x39\xb7\xee\x7b\xb9\xc7\x92\xd2\xc4\xb3\xcc\x5a\x0f\x6e\x2a\x65\xcd\xb8\xb8\xc6\x2d\x73\x37\xf5\x37\x6c\x4d\xe6\xf5\xf3\xf3\xdf\x1e\x2e\xc2\xc9\xfd\x96\x6c\xea\xe1\xab\x47\x8f\x1e\x2e\xae\x1f\x3d\x7a\x48\x01\xea\xe1\x17\x7d\x50\x1b\xa6\x37\x89\xe0\x7f\x4c\xff\x9c\xff\xf4\xf4\x62\xf1\xf8\xeb\x3f\xbf\xfd\x14\xd9\x5f\x68\x8d\xca\xb4\x65\x20\x37\xb9\x45\x31\xf7\x79\x5f\x7e\x5e\xd6\x7d\x12\x63\xad\x75\x9f\x03\xb5\x59\x5f\xe1\xce\x42\x4a\x7e\x48\x5b\xdc\x27\x65\xc7\x78\x4f\x9f\xa3\xe0\x3d\xfd\x26\x9d\x85\xb7\xc4\x4a\x8a\xb5\x4e\xaf\xd8\xc1\x9b\x4f\xa7\x79\xd3\x8f\x14\xfe\xaf\xd7\x09\x39\x6f\x7a\xfc\x7f\xf7\x1d\x6c\x99\xe0\xc5\xe4\x00\xff\xf6\x79\x43\xce\x56\x10\x82\x4d\xda\x4b\x37\xc5\xd4\x1b\xa4\x87\x25\x16\xac\xa1\xa0\x6d\x42\xdc\x6e\x5f\xc2\x7d\x31\x1d\xe3\xa8\xc7\x4c\x78\xf0\xf1\x3a\x65\xf9\xcd\x83\x11\x59\x7a\xd1\x7d\x99\x3e\x0e\xcb\x2a\xf7\x80\xce\xd2\x1e\xbb\x5e\xf3\xd1\xdb\xa3\x94\xef\x3e\xd2\x6b\xec\x06\x80\x64\x1a\xd6\x8d\x92\xe6\xe5\xb5\xbb\x00\x4c\xbb\x62\x99\x47\x4f\x07\x17\x87\xa6\x56\xe2\xc5\x7d\x5b\x0a\xa4\x08\xbc\xeb\xdf\x7c\xf4\xba\xca\x41\x0c\xf9\x2c\x63\x22\x8d\x23\x6e\x3f\x52\x72\xc8\x94\xf2\xc1\x31\xdb\x7c\xc7\xbb\x91\x49\x4b\xcb\xda\xc0\xb8\x65\xa0\xe0\xb8\xfb\x97\x84\x82\xf6\x12\x26\x22\x1d\x90\x91\x7d\xa1\x43\xf1\x59\x96\xce\x77\xe3\x08\x6e\x30\xbf\x25\x2b\xde\x8d\x51\x74\xaf\x85\x84\xfb\x3a\xfa\xff\xf4\x40\x16\x3a\x6c\xd3\xad\x02\xba\xa9\x68\xf6\xb0\xc5\xa0\x5a\xb1\xc2\x9f\x3e\xee\x89\x63\xb8\xcf\x71\xd5\x17\x97\xf1\x1d\x0f\x95\x73\x4c\xb5\x6f\x95\x7c\xd1\xa2\x70\xdd\x54\x4c\x01\x6b\x8c\xac\x5d\x61\xe9\x07\xc1\xfd\x84\x39\x2d\x72\x5e\x9c\x0e\x8e\xf9\x32\x48\xbb\x89\x75\x97\x33\xb6\xaf\x76\x6e\x89\x47\x3b\x5f\x7f\xdb\x3e\xc1\x33\x1b\x65\x9f\x4c\xb2\xe4\xdd\xcf\x78\x81\xc7\x23\x73\x16\xce\xf3\x96\x9d\x0f\xa3\xa9\xaa\xab\x4a\x6c\xc4\x3a\xa6\x64\x3a\x83\xff\xb0\x6b\xc7\x9b\xc8\x86\x29\xe3\xb2\xce\xc9\xc0\x63\xb3\x64\xda\xb5\xbf\x53\x6e\xfd\xc6\xe1\xb2\x8f\x16\xad\xa4\x2a\xf0\xba\xbb\xb2\xd3\x02\xb0\xaf\x62\x5a\xb1\x6c\x95\xdc\xf1\xd2\x4f\xf7\x84\xc7\x53\x46\x86\x82\xcc\xc8\x6e\x45\xe6\x52\x30\x3d\x8b\x40\xed\xf3\x01\x3f\x47\xe3\x06\x43\xd0\x3d\xd0\x21\x7d\xdb\xfa\x4d\x0c\xbc\xbb\xcb\x74\x63\x69\x3a\x1b\x50\x4c\x56\x85\xb8\xd9\x19\xed\x87\x67\x06\x0b\xc5\x4e\xeb\xa2\x53\x64\x73\xdd\x3e\x4a\x08\x43\xf8\x2e\x43\xaa\xf6\x9e\x30\xbe\xac\x30\xf4\x7e\xa3\xcd\x65\x60\x82\xf9\xcd\xfc\xab\x53\x0b\x48\xa1\x9b\x49\x73\x47\x15\xd1\x69\x1f\x3e\xc8\xe0\x43\xd9\xfb\xed\x7f\xb5\xb9\x0d\x5f\x75\xff\xe5\xa1\x57\x61\x0e\x2f\x04\x93\x14\x5e\xfb\xfb\x60\xab\xd3\xca\x5e\x8a\x15\x5f\xdb\xf6\xae\x7b\x79\xed\x7d\xb0\xdf\x63\xf9\x32\xce\x4d\xe9\xc1\x72\x30\x1d\x70\xe4\x3a\xea\xc1\x97\xba\xde\xdf\x8d\xdc\x3e\xac\x70\x87\x55\xab\x84\xe4\x7d\x70\xb3\x95\xf9\x4c\x63\xfe\xb4\xd7\xb7\xfa\xec\x2e\x70\xef\x71\x7e\xb1\x8f\x58\x46\xe9\x59\x5c\xfc\x7a\x73\xf5\xfc\xd9\x99\xa5\xc2\x65\x1c\x5c\x03\x2a\xa6\x51\x27\x03\x4b\x9d\x47\x7d\xa7\x5b\x85\x3b\x2e\x1b\x9d\x36\x97\x3e\xcb\xed\x3f\x1c\x88\xe7\x6d\x2d\xdb\xed\x25\x8c\xc7\xfe\x36\xda\x0f\xbc\x7d\x18\xba\xe7\x1c\x7c\xfc\xd0\x3e\x4d\x6c\x1f\xd4\x1e\x31\xd5\xe6\xea\x5d\xd7\x55\x8e\x3d\x45\x3a\x29\x78\xc1\xb7\xcc\x46\x85\xcc\x37\x53\x94\xed\xd3\xb1\x70\x34\xa6\x6f\x02\x46\x86\x2b\xe1\x98\x93\xd4\xdb\x7d\x9b\x77\xf7\x3c\x22\x97\x4b\xfa\x57\x7e\x0b\x3c\x40\x59\xe8\xf6\x3c\x98\x0e\x4f\x4d\x8d\xa7\xd0\x6d\x9b\x28\xcb\xba\xfb\x3f\x3d\xb8\xfd\x79\xab\x7b\x9a\x22\x47\x27\x06\x1d\x99\x0e\x5d\xe2\xfa\x11\xf5\xc7\x4f\x7a\x23\xea\xa3\x9d\x2e\x23\xb7\x3a\x3d\x15\x7a\x49\xbd\xeb\x7a\x85\xf6\x6f\x6c\x7d\x15\x64\x57\x5f\x9f\x7e\x93\x77\xb9\x6a\xf6\x4f\xaa\xf6\xf6\xf1\xc9\x5a\x30\xd6\x0d\xd3\xed\x88\x9d\x9f\x60\x99\x1f\x77\xae\x7e\x46\xa3\xc9\xf1\x13\xa6\x3e\xed\x4b\x89\x4e\xb9\x63\x8f\xb6\x79\x8b\x26\x76\x9d\xc2\x45\x7f\x1c\x3b\x5d\xe2\x4a\x2a\x5b\xd2\x14\xae\xfb\x47\x9b\xb6\xfd\x5e\xe6\x38\x35\x59\xa8\xb0\x0f\xaf\xc7\xb4\xf1\x37\xca\x14\xec\x17\x77\xc8\x6d\xd2\xda\xb6\xdd\x30\x54\x5c\x96\xb9\xee\x36\xb2\xa9\xca\xa8\x9a\x86\x12\x33\xdf\x98\xdf\x2a\x69\x64\x21\x2b\xd8\xb0\xca\x68\x37\x16\x8a\x58\x6a\xff\xcc\x46\xa1\xc6\xe1\xf9\x99\xc1\x7c\xe5\xf8\x40\xd8\xe5\x0e\xba\x57\x65\x7e\xf6\x1d\x96\x52\x56\xc8\x04\x18\x74\x91\x9d\xa7\x7d\x7c\x3b\x7b\x1c\xea\xc6\x9e\x55\xee\x92\xf6\x60\x2f\x77\xc9\x9e\xca\xa8\x2b\xfd\x32\xc2\x99\xbc\xed\xf4\xfa\xa7\xee\x05\x79\xc2\x9c\x3f\xa2\xef\x0f\x0b\x0f\xda\x01\x8c\x4f\xe1\x2e\xbe\x79\x0c\x8f\xdf\xfd\xc3\x44\x9b\x0a\xdc\x76\x73\xb9\x2c\x3d\xf4\x1d\xb6\x22\x75\x45\x0f\x84\x7c\xd1\x3f\xd0\x4f\xd0\x94\x94\xfa\xc8\xbd\xee\xe0\x70\x70\xda\x2c\xf1\xca\x40\x61\xad\x28\x01\xc8\xd6\xcc\x4f\x07\x1d\x92\x6c\xb8\x4d\xf8\x4c\xb1\x0e\x76\xe5\x0f\xcb\x35\x7c\xdb\x45\x1c\x69\x0a\x61\xc5\x52\xb9\x6a\xaa\x6a\xdf\x8b\x31\xed\xd4\xf8\xc8\x4d\x53\x64\x2b\xeb\xb5\x8e\x33\x95\x78\xe0\x67\xf4\x72\xfc\xa4\xf2\xd1\xad\x9c\x20\x98\x0f\xff\xcb\xde\x49\xaf\x2c\xbe\x4a\x4c\x65\xc8\xdd\x82\xc0\x66\xdd\x8d\x45\xda\x0d\x75\x06\xb7\x77\x3d\x66\xfb\xd8\x36\x5b\xce\x57\x87\x4e\x65\xaf\xed\xf1\xb1\xe2\xb6\x35\xd1\x9f\x78\xbd\x7c\xf3\x20\x19\x1a\x7a\xd0\x1f\x88\x1a\x3c\xf1\x3c\xe0\x36\x4a\x25\x56\xe6\x66\x93\xab\x2a\x4e\xd1\x8d\x7f\x8b\xd1\xf8\xd7\x96\x64\x16\x95\x7c\xb5\x0e\x9d\x40\x31\xb1\xec\xbb\x46\x3a\x9c\xa5\xc7\xcc\xdf\x7e\xbf\x06\x5f\xc1\x1d\x3a\x79\xc7\xaf\x76\x09\xdf\x51\xd4\x66\xc3\xf6\x1b\xae\x3c\xb8\x03\x26\x3f\x70\x4c\x76\x5c\xb7\x93\x6f\x8e\xa4\xb5\xbd\xe7\x87\x41\x73\xd9\xcb\x8b\xf3\x7b\xd4\x9d\x9f\x1d\x5d\x1d\x76\x16\xc7\x8c\xdb\x0b\xca\x5e\x9f\xa6\x07\x95\x15\x69\xf7\xab\x26\xe0\x1c\x4e\xb5\xfb\xf3\x74\x15\x2b\x98\x17\x0b\xbb\x2e\xdf\xda\xfd\xce\x89\xb1\xad\xae\xb3\x90\xef\xed\x1f\x89\x6d\xa6\x93\xaf\x4c\x2a\x83\xd7\x6f\xf2\x8f\xd2\x00\x99\xbf\x08\x8e\xdd\xa7\x45\x8c\x01\x1f\x3e\x76\x48\xf0\xdf\x9f\x32\xf7\x24\xcf\x35\xdb\xe1\x24\xd6\x9e\x96\x5f\xfb\xac\x4f\x9e\x0d\x4b\x2a\xf4\x28\x3e\xfe\x4f\x00\x00\x00\xff\xff\x62\x39\x46\xa6\x9c\x4d\x00\x00"
-
-func epochsFlowclusterqcCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_epochsFlowclusterqcCdc,
-		"epochs/FlowClusterQC.cdc",
-	)
-}
-
-func epochsFlowclusterqcCdc() (*asset, error) {
-	bytes, err := epochsFlowclusterqcCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "epochs/FlowClusterQC.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xac, 0xbf, 0xf1, 0x78, 0x1e, 0x59, 0xdf, 0xb1, 0x2, 0x69, 0x66, 0x56, 0x5b, 0xbc, 0x99, 0x9e, 0x4a, 0xcf, 0xc7, 0x2c, 0x50, 0xe8, 0x41, 0x8f, 0x6e, 0x66, 0x38, 0xc, 0x7c, 0x37, 0xdd, 0x98}}
-	return a, nil
-}
-
-var _epochsFlowdkgCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x7d\x6d\x73\x1b\x37\xd2\xe0\x77\xfd\x0a\xc8\x57\x97\x25\x13\x8a\xb2\x72\xbb\x7b\xb5\xda\x28\x3e\x5b\x52\x7c\x2a\x3b\xb6\xcb\x52\x92\xab\xf2\xba\x12\x70\x06\x24\x71\x1e\x02\x0c\x80\x91\xcc\x78\xfd\xdf\x9f\xea\xc6\xcb\x60\x00\x0c\x49\x39\x4e\x6a\x1f\x57\x6d\x56\x22\x67\x1a\x8d\x46\x77\xa3\xdf\x75\xfc\x25\x39\xf8\xf2\xe0\x4b\x42\xbe\xa7\x82\x2e\x98\x26\x66\xc9\xc8\x5a\xc9\x8a\x69\x4d\xe4\x9c\x2c\x98\x60\x8a\x1a\x2e\x16\x84\x92\x85\x92\xed\x9a\xbc\x63\x1b\x72\xc7\xcd\xd2\x3e\x4a\x95\xe1\x15\x5f\x53\xc3\xa5\x80\x17\x68\xd3\xe0\x17\x95\x14\x9a\x09\xdd\x6a\x22\x64\xcd\x34\x2c\x31\x97\x0a\xbf\x6a\xd7\x95\x5c\x01\x44\xb6\x96\xd5\x72\x6a\xd7\xff\x69\xc9\x44\xfa\x12\xa1\x8a\x91\x39\x57\xda\xc0\x37\x73\xae\x56\xac\x9e\x00\x88\x0d\xa9\xa8\x20\x8a\xfd\xda\x32\x6d\x08\x25\xaf\x3c\x16\xc2\x10\x39\xfb\xff\xac\x32\x64\xae\xe4\x8a\x98\x25\xd7\xf0\xaa\x51\xb4\x32\xb0\xca\xcd\x92\x6d\xfe\xd2\x34\xa4\xd5\xcc\x7e\xe9\x9f\x96\x8a\xb0\x5b\xa6\x36\x44\xb7\x33\x0d\x70\x85\xb1\xe8\x11\xb3\xa4\xc6\xae\x09\xd8\x50\xa2\x0d\x7d\xc7\xea\x04\x55\xb7\x89\xc7\xf8\x24\x99\xb1\x05\x17\x02\x76\x28\xe7\x84\xd1\x6a\x49\x2e\x01\xd4\x35\x33\xed\x9a\xac\x97\x54\x33\xdc\x05\xa1\xf5\x8a\x0b\xc2\x05\x37\x9c\x36\xfc\x37\xa4\x7e\x84\x30\x52\x19\xc0\xc2\xb3\x0d\xd7\x06\xc0\xa5\x24\x1a\x20\x2a\xb9\x84\x65\xfb\x0f\x03\x28\xa0\xdb\x5a\x02\xd1\x34\x59\x51\xb1\x21\x2b\xa6\x35\x1e\x3c\xd5\x76\x97\x77\x40\x44\x23\x11\xe8\xc5\xb3\xa7\xe4\xc1\xdd\x92\x1b\x36\x93\x54\xd5\x0f\xba\x73\xff\x25\x22\xf9\x14\x00\x7e\x6f\xe1\x8c\xc6\xbf\x90\x15\x33\x4b\x59\x4f\x60\xb9\x59\x6b\x2c\x01\x60\x7d\x5c\x5c\x8a\x06\x89\xbc\xe2\x70\x70\x73\x2e\x68\x63\x7f\xd5\x1a\x39\x48\x54\x8c\xac\x99\x72\xc4\xbf\xe5\x34\x5f\x4e\x33\x51\x7f\x07\x2f\x5e\x87\xf7\x46\x63\xb7\xea\x14\x56\x85\xff\xbd\x04\x40\x94\x7c\xfb\xb7\x87\xff\x93\x98\xa5\x62\x7a\x29\x9b\xba\x44\xc0\x25\xbd\x65\x0e\x21\xc3\x6a\x5c\x8d\xbd\x07\xea\x6b\xba\x62\x44\x33\xa4\xfa\x3b\xb6\xd1\x13\x7f\x14\x40\x15\x3c\x45\xc2\x35\x31\xac\x5a\x0a\x5e\xd1\xa6\xd9\xc0\x6e\xb8\x5e\x32\x8b\xc4\x63\xb1\x91\xc2\xee\xf9\xd7\x16\x38\x0b\x5e\xd5\x86\x1a\x06\x00\xf1\x97\x80\xbe\xee\x08\xfb\x5d\x23\xef\x2e\x9e\x3d\x9d\x2e\x98\x49\xf6\xa8\x47\x63\x80\x2b\x55\x78\xa6\x7e\xb7\x38\x97\xab\x75\xc3\x0c\xab\x03\x05\x34\xae\x7e\x9e\xec\x12\xd0\x00\xbe\xe2\xa2\x65\x70\xba\xee\x04\x2c\xfd\x03\x0f\xb0\x5b\x26\x08\x9d\x1b\x66\x79\x0a\x04\x8c\x2b\x56\x13\xba\x92\xad\x30\x96\x54\x33\xc6\x44\x8f\x5e\xb2\x5d\x80\x10\x13\x2b\x5f\x31\xff\x72\x4d\x28\x59\xb1\xd5\x8c\x29\x54\x0d\x44\x33\xc5\x19\xea\x15\x7b\xbc\x7a\x45\x95\x09\xcf\x6b\x72\xb7\xe4\xc8\xb5\x52\xd5\x5c\x50\xe3\xd4\x11\x00\x8e\x54\x92\x51\x54\x68\x0e\xfa\x06\xd8\x7d\xc6\xcc\x1d\x60\x84\x00\x35\xe1\x02\xa9\x33\x3d\xf8\xf2\xf8\xe0\x80\x56\xf0\xce\x88\x36\xcd\xb8\x43\xca\x11\x8f\x7c\x38\x38\x20\x84\x90\xe3\x63\x72\xf6\x99\xff\x79\xb8\xb0\xca\xe5\x8f\x97\x2f\x6e\xae\xff\xb0\x95\x1c\xe0\x63\x72\xe9\x8e\xe3\x0e\xd4\x68\xa7\x5b\x98\xa0\xb3\xc6\x91\xf1\xe2\xd9\x53\x7c\x3c\xa6\x0a\x1c\xb8\x21\xd7\x86\x2a\x73\xf1\xec\xe9\x68\xbc\x07\xc0\x3a\x40\x23\x23\x60\x70\xf8\x81\x0b\x6d\xa8\xa8\xd8\x78\x1a\xde\xbf\x01\x39\x42\xe8\x5c\x54\x4d\x5b\x3b\x24\x2a\x2a\x24\x8a\x0b\x51\x4c\xb7\x8d\x89\x65\x9f\xcf\x03\x64\xdd\x56\x15\x63\x35\xab\x27\x01\xa0\x54\x44\xf0\x26\x7e\x68\x4e\x79\xc3\x6a\xf8\xe2\x8e\x6a\xa2\x8d\x5c\xaf\x59\x4d\x66\x6c\x2e\x15\xdc\x3f\x28\x18\x5c\x8a\xe9\xc0\xae\x2f\x45\x0d\x7b\x9e\xf7\xa5\xec\x94\xbc\x46\xc4\xba\x4f\x1e\x0d\x51\x85\x26\xba\x84\x2c\xa9\x46\xe5\x0a\x12\xe3\x85\x2a\x56\xa5\x9d\x26\x1d\x40\xe9\x89\x92\xb4\xae\x68\xa7\x4d\x01\xec\xd5\xc5\x29\xb9\x36\x8a\x8b\xc5\x04\xb9\x98\x09\xe3\x3f\x18\xff\xe1\x3c\x7c\xfe\xf2\xc5\xcd\xeb\xc7\xe7\x37\xe4\xc7\xc7\xaf\xaf\x1e\x3f\x79\x7e\xf9\x27\xf0\x32\xb0\x4e\xc3\xc4\xc2\x2c\xbd\xea\xb5\x97\x30\xaa\x1f\x23\xc9\x2c\xd6\xd7\x54\x17\xae\x91\x8c\xbc\x0d\x8b\x39\xed\x19\xdb\x3c\x47\xf8\xa7\xe4\x4a\x98\x6e\xe1\x2b\x51\xf3\x0a\xd5\x4e\xc4\x65\x5c\x3b\x29\x42\x4e\x13\xd2\x64\xc0\x6f\xa9\x22\xf5\xbb\xc5\xa5\x7d\xea\x94\x3c\x91\xb2\x19\x00\xda\x37\x55\x14\xd3\xb2\x55\x95\x65\x1c\xda\x28\x46\xeb\x8d\xd5\xaf\x55\x43\xf9\x0a\x98\x79\x43\xa8\xe5\xad\xab\x8b\x00\xd1\x99\x36\x8c\xf0\x9a\x09\xc3\xcd\x86\x18\x58\xb9\x33\x74\xfc\x83\x2f\xec\x8b\x3a\x50\x0e\xc1\x12\xda\x59\x6d\x60\x2f\x89\x8a\x75\x42\x26\x98\x5d\xee\x8e\x07\x2b\x89\xb9\x9b\x70\xcd\x2a\x3e\xe7\x15\xb9\xba\x20\x54\xd4\xe5\x8d\x80\x35\x82\x16\x20\x5f\x75\x40\x7f\x11\xbc\xf9\x05\x88\x37\xa7\x8d\x66\x64\xc5\xa8\xd0\xc1\xac\x52\x78\x8b\x0a\x49\x6e\x25\x5a\x99\x15\x5d\xd3\x19\x6f\x60\x57\xde\xb4\x49\xf7\x6f\x54\x9b\x42\xe9\xed\x28\x02\x01\x84\x4d\x09\xea\x41\xf6\xce\xb1\xaa\xe0\x86\xb3\x67\x09\x5f\x9e\xdb\xe7\x4f\xc9\x07\x2b\x69\xf6\x54\x3f\x76\xc7\xfa\x9a\x55\x52\xa1\x41\xd1\x49\x75\x77\x91\x7a\xd4\xab\x56\xa9\x60\x4a\x46\x0c\xce\x35\xec\x5a\x31\x30\x2f\x68\xc9\x68\x44\x5b\x34\x56\xad\xa0\x6c\x63\xd3\x68\x3c\x88\x7e\x87\x8f\xd3\x22\xfa\x94\xbc\x71\x3f\xbe\x0d\x0a\xe3\xe2\xf2\xd5\xeb\xcb\xf3\xc7\x37\x97\x17\xe4\xbb\xab\xcb\xe7\x17\xd7\x64\xa4\xd8\xba\xa1\x95\x25\x52\xa7\xfe\x6e\x14\xad\xde\x31\x35\xbc\x5e\xa2\x40\x9f\x6c\x5e\x38\xa5\x15\x48\xf7\xc6\xfe\xf0\xe8\xed\x47\x58\xb9\x66\x6b\xc5\x40\x22\x6a\xe4\xa3\x56\xb4\x9a\xd5\x83\xd0\x5b\xc1\x7f\x6d\x59\x6a\x0a\x9d\x92\x37\x01\xe8\x5b\x12\xfe\x7d\x1e\xe8\xe7\xf0\xfd\x29\xf9\x70\x05\xff\xfd\xe1\x4a\x98\xbf\xff\xf5\xe3\x56\xe8\x7f\x9a\x12\x3e\x7f\xf9\xe2\xfa\xe6\xf1\x9f\x60\x50\x90\xf3\x70\x4f\xaf\xa9\x59\x5a\x86\xb6\x16\x00\xec\x7b\x5d\x90\x7d\x5d\x54\xba\x8f\xe1\x9d\x6b\x23\x15\x5d\xb0\x57\x14\x34\x6e\xf4\x4b\xf1\x8d\x48\xaf\x7c\xea\x7b\xaf\xda\x59\xc3\x2b\xfb\x5a\xf7\x73\x27\xbb\xd7\x46\xb5\x15\x3a\x39\x0a\xce\x53\x83\x84\x52\xa2\xb9\x58\x34\xac\x20\xce\xd9\x72\xda\xbe\xef\x64\xca\x5b\x92\xf1\xf5\x75\x75\xe1\x8d\x7c\xab\x4d\x97\x32\x71\x31\x62\xd0\xa5\xdd\xf4\x2f\xfe\x7c\x01\x67\x08\xf8\x55\x52\x70\xf0\xd8\x4f\x8c\xac\xe8\x3b\xc0\x80\x50\xad\xdb\xd5\xda\xa0\xa7\x01\x07\xa9\x35\x53\xf6\x37\x3a\x93\xad\xf1\x0e\xfb\x36\x88\x29\x82\x89\x25\xd2\x61\x08\xee\xec\x4e\xbb\x85\x7c\x38\x20\xd1\x3f\xcd\x9a\xf9\xd4\xbe\x43\xce\xdc\xde\xf3\x07\x3c\x86\x67\x1e\x5c\x78\xe4\xe3\x81\xfd\x6f\xe0\xde\x25\xab\xde\x81\x3b\xc1\xe0\x86\xc1\xfd\xa4\x56\x1d\xda\x6e\x78\x8e\x40\x10\xb5\x68\x57\x4c\x18\x4d\x34\x35\x5c\xcf\x37\xe4\x4a\xdc\x52\xc5\x81\xbf\x47\x27\xe3\x53\x0f\x97\xc0\x6f\x84\x71\x84\x0a\xf7\xdc\x9c\xb3\xa6\xb6\x71\x0a\x30\x4e\x47\x6c\xb5\x36\x9b\xc8\xd2\x18\x5b\x9b\x21\x79\xce\x83\x7b\x4c\x6e\x69\xc3\x6b\x92\xbe\xe5\xec\x81\x00\x5e\xf0\x66\x82\x72\xc7\x35\xdc\xc9\x91\x51\xee\x98\x4a\x2a\xd2\x48\xe7\x83\x5a\xa3\xd8\x59\x2f\xd3\xb0\x54\xd3\x10\x21\xc5\x51\xba\x94\x26\xab\x56\x3b\xd3\x8a\xba\x67\x60\x27\x76\xe5\xdc\x74\xbe\xe5\xec\x8e\xcc\x5b\x41\x2a\x20\xf1\x25\x40\xeb\x68\x1a\x88\x36\xc2\x48\xd1\xab\x76\xf6\x8c\x6d\xfc\x99\x3f\x9a\x90\x35\x7e\xa0\xc3\x85\xf0\xf6\xd1\x84\xf0\xfa\x7b\xba\x5e\xe3\x35\xe1\xef\x8b\x2b\x61\x3e\x3e\x1a\xdb\x1b\x37\x62\x14\xb0\xa5\xe6\x84\x8a\x4d\x42\x4d\x8c\xa5\x08\x1b\x3a\x09\xf2\x0c\x56\x61\x46\x56\x20\x61\x44\x56\xdc\xf9\xac\x3b\x11\xe4\xde\x39\x89\x70\x27\x67\x67\x78\xae\x5f\x7c\xe1\x71\x8f\x3e\x09\x98\xfb\xcf\xfa\x3c\xad\x98\x69\x95\x40\x63\x25\xe1\x53\xb7\x99\x97\xc0\x45\x77\x5c\xb3\x49\x11\x29\x7b\x10\x07\x09\xb8\x18\xb9\xc3\x0c\xb9\xc3\x02\x72\xee\xb3\xb2\x84\x00\x9b\xd8\xa8\xde\x1a\xf5\x24\x06\xf7\x46\xa0\xde\xad\xc3\x9e\x4a\xcd\x18\x2f\x02\xe4\x5a\x6e\x36\xd3\x94\x91\x23\x20\x5c\x74\xc1\x2c\xf6\x1e\x63\x01\x56\x6e\x4e\x51\x86\x28\x59\xb2\xf7\x47\x4c\x54\xb2\x66\x35\xf9\xc7\xdf\xc9\x6c\x63\x98\x9e\x80\xb8\x8c\xbe\x1e\x03\xc6\x5b\x78\x8f\xeb\x1f\x61\xb9\xa7\x80\xf8\x33\xb6\x19\xfd\x6c\xf7\x10\x33\x5b\xce\x3e\xe1\x68\xa3\x73\xed\x9f\xd8\xf1\x31\x79\x92\x48\xfe\x24\xd8\x6a\x14\x5e\x38\xce\x58\x6a\xa4\x19\xdb\x2e\x0b\xe3\x7b\x70\x45\x7c\xc8\xcf\xd8\xe6\x70\xea\xdc\xa0\xb3\xb3\x10\xf3\x29\x78\x31\xe5\xa3\xa5\x21\x64\x18\xdf\xd6\xdd\x09\xe9\xff\x36\xe7\x6c\xd9\x5d\x8f\x7e\x2e\x28\x90\xfc\x9c\xf9\x3c\x95\xd5\xff\xb4\x53\x06\xd2\x3a\xd2\x39\x4c\x0f\x73\x1c\xf1\x84\xbc\x32\xc0\x80\x64\xb3\x09\xf4\xeb\x3d\x0c\x3b\x7e\xc7\x36\x9e\x57\x0e\xb7\xf2\x4a\xb2\x50\x8c\x2d\x7a\x67\xfd\x6f\x3f\x0e\xf3\x68\xd8\x5b\x41\xa7\x50\x43\xa8\x20\xbc\x26\x2b\xa7\x83\x02\xa7\xe5\x7c\x06\x7c\x43\x39\xd8\x25\x82\x11\x26\x8c\xda\xa0\x8f\xd3\x71\xd8\x6e\xfe\xb8\xba\x70\xba\x6e\xb4\xe7\x05\x43\x06\x6e\x98\xff\x7c\xd6\x71\xdf\x7a\xb6\x89\xf4\x43\xd8\xe6\xe1\x14\x58\xc7\x7d\x93\x9c\x50\x66\x04\xf5\xee\xcb\x3c\x22\x87\x91\x06\x1f\xe4\x8b\xd4\x48\x50\x08\x98\x65\x88\x43\x78\x3e\xd6\x47\xf3\x6b\x05\x6f\x5f\x41\xa4\xaa\x99\x62\xf5\x2e\xed\x14\xe9\x9c\x08\xfe\x8a\x6e\x50\x1e\x80\x94\x13\x10\x20\x17\x31\xa6\x9a\x95\xec\xb1\x23\xab\x9b\xbc\xd9\x94\x18\xfc\x68\x32\x85\x48\x62\xab\xd8\x4e\x6b\xc9\x86\x45\x52\x63\x69\xe2\xb4\x62\x6c\x33\x59\x56\xa9\xa4\xb8\x65\x02\x2c\x6d\x7b\xc7\xa3\x50\x03\x72\x5e\x21\x52\x4d\x1a\x79\xc7\x14\x6e\x60\xc9\xde\x83\x6b\xc1\xc5\x42\x4f\x5c\x5c\x9d\x70\x63\xc3\x23\x86\x30\x31\x97\x0a\xbc\xf3\x25\xeb\x30\xf5\x6b\x5d\xbd\xc0\xf8\xdc\x8b\x9b\xeb\xce\x56\xfd\xac\xa6\x2a\x6a\x6b\xb7\x05\x8f\x24\x3e\x63\xf3\x25\x6b\x56\x81\x67\xe3\xd8\x11\x14\xdc\x93\xe7\xd7\xf1\x71\x76\x70\xfe\xd7\x38\xd0\x2e\x37\x46\x91\xb4\xc0\x70\x31\x57\xc0\x92\xa0\x13\x5c\x1c\x08\x8e\x3d\x70\xfb\x90\x6f\x96\x71\x7a\xcf\x8e\xbc\x29\x99\x3d\x3e\x66\x33\x63\xb4\x42\xff\x60\x85\xfe\x1a\x73\x72\x01\x7a\x2c\x44\xde\x82\x69\x9d\x2e\x0f\xae\x51\xc9\xf8\x8d\x57\x7f\x9c\x0b\x01\x17\x35\xbf\xe5\x75\x0b\xfe\x76\x74\x43\x7f\x36\x94\x72\xc5\xd8\x43\x28\x28\x6a\x84\xe6\xe9\x6c\xa4\x8b\x40\xd5\xec\xfd\x34\x21\x9f\x62\xd9\xe5\x04\xc7\xe6\x8f\x8a\xb6\x66\x29\x15\xff\x8d\xd5\x65\xd5\x11\x9d\x83\xcb\xb0\xdd\xd2\xa6\x65\x9a\xe8\xa5\x6c\x9b\x1a\x36\x6e\x77\x05\xdf\x7e\x78\x38\x21\x27\x13\xf2\xf5\x84\x4c\xa7\x53\x22\x8e\x4e\x3e\x4e\xc0\x03\x02\x06\xc5\x0c\x1c\x78\xd9\xad\x4b\x32\xc5\xd0\x87\x91\x98\x90\xa5\xbc\x63\xb7\xe8\x1a\x5a\xfd\x30\x20\x61\x25\x5a\x0e\x79\x2c\x89\x0b\xfc\x19\x5c\xa0\xe4\xfa\x59\x2b\x56\xb8\xbe\xfd\x65\x7f\x0f\x87\x2c\xfa\x25\xc2\xc8\xfd\xd0\xc3\x27\xfc\x38\x3e\x25\xb9\xe1\x40\x08\x79\xe0\x97\x4f\x05\x6e\x0a\x44\x38\x25\xb7\x5c\x36\x18\xfc\xce\x54\x29\x0f\xd7\xe8\x51\x2e\xad\x89\x33\x84\x0a\x83\x37\xc4\x45\x97\x9d\xde\x7d\x30\x48\x8b\xd4\x41\x88\x36\xec\x9c\xf9\xfb\xee\x03\xb0\x05\x1d\xdf\x95\x40\x1c\xc5\xfe\x23\x60\xd6\x37\x74\x8f\xc0\x50\x73\x9a\x72\x27\xa2\xde\xc2\x75\x47\xf0\x3b\x71\x4c\x75\xe7\xe7\xc4\xb4\x60\x6b\xed\xe0\x9b\xdf\xb5\x95\xab\x8b\xa0\x9a\xba\x5d\xd8\x74\x04\xff\x8d\xc1\xf5\xe9\x56\xef\x23\xfe\x31\x0f\x1e\xf5\xbc\xfa\x58\x02\xf2\x47\x83\x0d\xe8\x81\xe7\x8f\x44\xbe\x7f\x72\x17\x91\xce\xe0\x22\xde\xe8\x02\x9b\x4d\xdb\xb4\x05\x26\x96\xb8\xce\x39\x3e\xc4\xf9\x63\x93\x4c\x64\x62\xd3\x53\x9f\xd7\x1c\x5e\xf0\xd5\x36\x3e\x98\xe5\xd4\x98\x8e\x24\x0c\x0d\xd5\x3b\x66\x8b\x2f\x04\xb3\xa6\x10\x2a\x0c\xd4\xda\x28\x6d\x65\x85\x17\xd9\xda\xa8\x5b\x46\x05\xc7\xba\xb3\x4b\x73\x4a\x9f\xf5\xa2\x2b\x7d\xc2\x14\xa2\x74\x5c\xac\x5b\xeb\x43\xfe\xda\xf2\x5b\xda\x30\x5f\x8b\x52\x20\x59\x9f\x14\xc5\x88\x96\x4d\x90\x81\x95\xf7\xce\x72\xa8\xe8\xf2\x59\x78\x05\xbb\xb0\x5a\x78\x0c\xef\x3a\xcf\x72\xba\xb7\xc2\x65\x66\xac\x50\x65\x09\xcf\xed\x5d\xce\x7e\x6d\x69\x53\xa6\x22\x10\x10\xbf\x06\x27\x56\x5a\xef\x78\x48\x02\xca\x04\xe6\xf3\x9c\xb8\x87\x67\x16\x56\xef\xc3\xfc\x82\x18\x74\xef\x3e\x16\x57\x88\x62\x49\x16\xba\xff\xe0\x77\x43\xee\xc5\xa4\x2c\xec\xee\xa3\x4f\x86\x5e\x76\x98\xb6\xf2\x59\x49\xfe\xce\x5f\xfe\xf0\xfc\x82\x3c\xb9\x24\xd4\x59\xf3\xb1\x1b\xe4\xcc\xb0\x05\xbf\x65\x02\x6d\x89\x60\x89\xed\x94\x1a\xd4\x9a\xdf\x49\x75\xee\xdf\x18\x75\x66\x49\x67\x05\x6c\x3f\xf4\x20\x7b\xc3\x54\xea\x6d\x3f\x21\x81\x23\xc3\xf7\x41\x2c\xd0\xc0\xee\x0c\x5f\x30\xd8\x12\x03\x29\x45\xa3\xc3\x39\x0a\x33\xc4\xec\x12\x5c\xd1\x4f\x3e\xc7\x1e\x86\xb4\xb3\x3c\x51\x1a\x59\x6d\x4b\xf4\xc0\xdd\xdc\x86\xea\x5c\x2a\x97\x3e\x00\x59\x8f\x0c\xc0\x1c\xad\x8c\x2b\x0f\xdf\xd8\x37\xdf\x96\x9d\xff\x9d\xdb\xc9\xb7\xb4\x0f\xa3\x92\x9e\x83\x9e\xa5\x5d\x89\x81\xff\xb7\xd9\x00\x5b\x3c\xa6\x58\x83\xf9\x47\x74\x66\x13\x87\xbd\x73\x3e\xaf\x50\x8b\x72\x61\x98\xa8\x1d\xf1\xe0\x17\x25\xa8\x4d\xeb\xbb\x3c\xb8\x2f\x86\x0a\xd5\x51\x58\x4e\xb1\x56\xfc\x16\x96\xb2\x19\x31\xe3\x55\xed\xf1\x31\xf9\xae\x35\x2d\x98\xfe\xb2\xe6\x73\x5e\x51\x9b\x43\xfa\xfe\x87\xeb\x1b\xf2\xe2\xe5\x8d\x4d\x37\xa1\x78\x85\x37\xbb\x7b\x4d\xce\x0b\xbb\xb3\x5c\xd8\x6c\x9c\xf4\xf0\x59\xc3\xf2\x78\x8f\x73\xe9\xf2\xb7\x7b\x3e\xdd\xb5\xf5\x23\xa2\x33\x8f\x98\xc4\xbb\x52\x5c\x27\x49\x75\x20\xc2\x83\x9e\x44\x3f\x18\xc7\x50\x9f\x79\x8f\xdd\x39\x45\x7a\x02\x24\x52\x6c\xc1\xb5\x41\xad\xef\xae\x14\x20\xe4\xd5\xc5\x0d\x9d\x35\xec\xda\xd0\x77\x58\xc9\xe0\x68\xda\xbb\x45\x5e\xbc\xbc\xb9\x3c\xc5\xd3\x74\x0e\x0f\xfa\xd0\x60\x19\x74\xb1\x0a\xbb\xdf\x56\xf9\xea\x96\x15\x5d\x23\xfa\x2f\xc1\xa3\x6f\xa4\x7c\xd7\xae\xff\x09\xd0\x2b\xb6\x36\x2d\x26\x7d\xb8\xab\xb5\xd3\xcc\x0c\x28\x24\x1a\xfb\x64\x59\x55\x43\x84\xe0\x73\xe7\x91\xda\xcc\x78\xcc\x5b\x78\x7d\xc6\xa5\x9a\x70\x81\x0e\xae\x66\xdf\x07\x47\x67\xe8\xa2\x7b\x1b\x2f\xfb\x3d\x5d\xeb\xe0\x78\x6e\x39\x46\x23\x31\xb4\x88\x8a\xe1\x8e\x9b\x25\x17\xe4\x81\x5b\xea\xc1\x20\x2e\xb3\xbc\x24\x01\x1c\xac\x6c\xfd\x32\x58\xb4\x95\xb0\xfe\x51\xce\x63\x7a\x0c\x2e\x87\x0f\xeb\xa4\x80\x20\xf1\x0e\x8b\x19\xd0\x68\xd3\x67\xe4\x43\xc1\x88\x75\x28\x91\x33\xf2\xe6\x6d\xfe\xad\xdf\xe6\xc0\xcb\x16\xad\xfe\x97\xc9\x45\x49\x9b\x06\x0c\x19\xd0\xb2\x86\xaf\x40\x0d\x0b\x76\xd7\x97\x18\x6d\xa8\x32\x7a\xe2\xd4\x0f\x2b\x89\x25\xea\xa9\x02\xd7\xdf\x81\xb9\x04\xde\x3d\x6d\x34\xbc\x7d\x64\x81\x1a\x0e\xba\xc6\x2e\x95\x03\x0b\xc8\x4c\xb0\x98\x18\x65\x64\x4d\x0d\xa8\x32\xd4\x37\x3a\x5e\x47\xdb\x1a\x01\xb2\xc2\x62\xf6\x15\x58\x8f\x9a\xaf\xd6\x0d\x53\x64\xc6\x2a\x0a\x8a\x6f\x23\x5b\x22\xd0\xf5\x6f\x24\xad\x8f\x35\x56\x46\x2d\x99\xd5\xb3\x4c\xb9\xea\xd7\x60\x4f\x5b\x46\x27\xed\x7a\xa1\x68\x3d\x70\xdb\xc3\x45\x8f\xb4\x70\x69\xef\xc8\xbb\xff\x0f\x39\x66\x92\x5d\x8d\x0e\xd3\xc2\x1d\x97\xa0\xd8\x5d\x8a\x25\x1b\xa3\xcc\x47\x8f\x6b\x57\x24\x5a\x88\x28\x3b\x53\x2a\xb9\xc4\x9d\x09\x3e\xe7\xb6\xfa\xc8\xae\x99\x85\x8a\xd2\x97\x42\x78\xa0\x23\x68\xb1\xf0\xca\x5a\xf9\xc8\x49\xbd\xd7\x69\xa8\x44\x47\xc7\x08\x34\x6a\x6f\xcd\xf3\xc7\x3f\xdc\x5c\xbd\x7c\x71\x6a\x63\xfc\xb6\xbc\xda\x47\xa8\xf0\x8d\x19\x23\x95\x95\x99\xd9\x26\x2e\x40\x99\xb8\xb8\x74\x70\xc5\x30\x27\x12\xd7\xb0\xfb\x93\x00\x66\xd6\x1a\xef\x91\x61\xe6\xa2\x75\x1d\x95\xba\xa7\xb5\x15\x7a\x4b\xed\xea\x7e\x21\xa4\xa1\x23\xb7\xa9\xe3\x1d\x1e\x7c\x0f\xb9\x53\x2b\xc1\x58\x91\x30\x72\xea\xfc\x94\xfc\xcb\xe1\x3c\x1e\xfb\x50\x5b\x76\x62\xc9\xc5\x3c\xcd\x03\x11\x3d\xde\x4f\x4c\xb5\x1d\x28\x66\x5a\xe5\x9e\x48\x03\xa3\xc4\x7d\x0b\x58\x89\x07\x2c\x15\xd7\x6d\x86\xfa\x9e\x02\xea\x51\x78\x63\x87\x43\x90\x1c\x05\xe6\x72\x76\xc5\x50\xf6\xdb\x9e\x4b\xa0\xb4\xb6\x99\xc6\x50\x2e\x7a\x91\xdb\xc4\x1f\x28\xdf\xbf\x69\x94\x25\x35\xe0\x4f\xc6\xd6\xd9\xea\xfb\x5a\x71\xd0\x2f\xf5\xec\x29\x60\xc1\x35\x86\xb2\xe3\xac\x99\xd9\xac\xd1\x21\xaf\xa8\x66\xfd\x04\x19\xdc\xb0\x3a\x8a\x70\xc2\x95\x7d\x46\x1e\xf6\x9e\xb9\x5b\xf2\x86\x65\x4f\x7d\xd3\xd3\xae\xc3\x0e\x0b\x8f\xaf\xf9\xa9\xf3\xdb\xe3\x57\xdf\x24\x90\x33\x35\xbf\x83\x67\x53\xcc\x86\xdf\xb5\xca\x3c\x5b\x8f\x9c\x6d\xfd\xfa\x90\x7c\x45\x4e\xca\xf1\x5a\xeb\x84\xec\xf0\x5b\x48\x8f\x67\x3d\x89\xd3\x4f\xbe\x22\x27\x3b\xd8\xe1\xeb\xb1\xd5\x9c\xd1\xc1\xd6\x7c\x3e\x67\x4a\xdb\x44\x03\x98\xc1\x85\xd3\xd7\x64\x04\xee\x8a\xbd\x42\xb0\xfd\x6b\x3c\x21\x5a\x82\x1a\x74\x66\x42\x66\x9c\x4e\xd3\xa5\x9d\x9d\xed\x0d\x06\xc9\x85\xc9\xb7\x74\x56\x64\x88\x91\x0d\x44\xc1\x13\x59\xa3\x0e\xd8\x30\xd8\x06\x56\xd7\x58\x7e\x9f\x1d\x98\x87\x05\x3e\xab\xa8\x47\x51\x7a\x6d\xf8\x22\xdf\x93\x31\x76\x30\xc4\xc9\xae\xf0\xe3\x92\x45\x49\x37\x77\x33\xdb\x6b\x0a\xdb\x27\x42\x79\xe2\x6c\x03\x74\x6b\x18\xd5\xa6\xeb\x9c\xfa\xea\x24\xbd\x7d\xfb\xd1\xb1\xab\x39\x11\xc1\x21\x55\xac\x62\xfc\x16\x2c\x49\x81\x49\xcc\x9e\x13\xa1\x1c\x3e\xa1\xf4\x83\x44\xc6\xa7\x0a\x19\x20\xb0\xd5\x68\xd4\xb9\x85\x37\x28\xd7\x64\xa1\x18\xb5\xfd\x49\x98\x4b\xb6\x61\x35\xd0\x24\x4b\xda\x74\x45\xfb\x5d\xa6\x4c\xf3\xdf\x76\x05\x69\x3a\xf4\x2e\xdf\x57\x8c\xd5\xfa\xc6\xaf\x3a\xfa\xb9\xc3\xc0\x5b\xf2\xe3\x42\x87\x48\x7a\xcb\x4a\x6d\x8a\x81\x10\xa4\x8e\x8b\x2e\xfc\xfb\xdf\xe4\xd0\x7e\x72\xd8\x05\x78\xee\xad\xe9\x87\x71\x3f\xc5\x6a\xb9\x44\xf9\x5a\xe2\xb3\x7a\x02\x2e\x63\x5c\x6a\x86\x5c\xb1\x2d\x88\xfe\xe7\xa8\xdd\xad\x4a\xed\xdb\x88\x1d\xca\xfa\xb6\xdf\xe6\x41\xfa\xf2\x9d\x41\x2c\x82\x38\x3e\x26\xec\x3d\x16\x31\x64\xb1\x77\xeb\xfb\x9b\x25\xd3\x56\x09\x70\xc1\x1a\xbe\xe0\xb3\xc6\xf6\x3d\xf8\x50\x30\x28\xb3\x88\x0f\x6d\x7b\x5e\x71\xad\xfe\x45\xb3\x2d\xca\xe7\xff\xdd\x5f\x2b\xc7\xff\x7c\x03\x60\xf1\x81\xfc\x0a\x70\xe4\xf0\x71\xab\x9e\xfe\x88\xa8\xdc\xd3\x1c\xdf\x76\x47\x94\xea\x8b\x6d\xd1\xb4\xa4\x75\x67\x3b\x5e\x9f\x72\x35\x15\xd6\x1c\x4e\x4c\xc4\x2a\x33\x76\x61\xfc\x26\xbb\xba\x6e\xdf\xb5\x69\x23\xc3\x89\xcb\xf2\xba\xd3\x74\xbe\xa1\x08\xdf\xca\x6d\x60\xa9\xd0\x94\x84\x4f\xbb\x85\x52\x57\x66\x30\xc1\x1f\x14\xd9\x82\x99\xbc\x0f\x63\xf4\x73\x52\x4c\xbe\x87\x02\xe3\xf3\x44\x94\x02\xa1\x8b\xf7\xd6\x60\xcc\x77\x7f\xf1\xfb\xc4\x13\x02\x0b\x22\x8f\x54\x95\x48\xd7\xb9\x16\x3b\x69\xf8\x03\x02\xec\x35\xde\x9e\x92\x37\x59\xf8\x6a\x4b\x0a\xcc\x6d\x79\x28\xcc\x6b\xbd\xd9\x62\x03\x15\xdc\x70\xb6\xe5\x3e\x0e\x79\x27\x7d\x86\xbe\xfc\x7b\x13\x02\x91\x5d\xdf\x25\x56\x64\x75\xe0\x7c\xd8\x06\xe0\x4a\x59\x87\x06\xad\xb9\x0d\xe4\xae\xa5\x01\xbf\x9b\x36\xae\x7f\xd6\x06\x59\xee\x78\xd3\x04\x80\xde\xd1\xb5\x59\x91\x98\x8f\xe5\x1a\xf0\x74\xbc\x6b\xbb\xac\x7c\x97\xbe\x0d\x5c\x26\x68\x87\x7e\x2e\xf6\xde\xf8\xde\xff\xf4\x1c\x02\xe6\x31\x75\x0a\x0d\x16\x51\xa8\x30\x69\xff\x2a\x1e\xef\xb6\x96\x8a\x42\xc3\xc2\xfd\x2a\x2f\xa2\xc5\xaf\xb4\x6b\x1c\xf3\xce\xe3\x7e\x0e\x6a\x1c\x19\xb0\x09\xf0\x73\x2a\x40\x1b\x54\x68\xed\x6c\xe9\xb5\x0b\x84\x18\x45\xfe\xaa\xeb\x96\x2c\xf7\x14\xee\xcc\x94\x6f\xeb\xc3\xf0\x08\x47\x2d\x72\x43\x01\xa2\x8f\xfd\x43\x7b\x25\x35\x16\x19\xe6\xed\x35\xbe\x43\xb6\xd7\xbe\x98\x1e\x20\x88\x66\x3c\x66\xe0\xe7\x5d\x2d\x25\xf7\x38\xb2\xd7\x21\x9a\x3f\x8a\x08\xb0\xcb\x16\x1b\x18\x80\x10\x8e\x0e\x8d\xc0\x7c\xb7\x53\x72\xcd\x44\xcd\xfa\x91\x86\x78\xd9\x10\x23\x89\x92\x0c\xdb\xf4\x59\x1e\x6e\x70\x94\xf1\x96\xd6\xb7\xe4\xe1\xe7\xd8\x8a\xbd\xf7\x93\x33\x8b\x66\x43\x0c\xd2\x3a\x6a\x8d\xfd\x43\x28\x6a\xd5\xa1\x6b\xd5\xad\xb9\xc6\xa5\x76\x45\x2a\x9c\x60\x45\x9d\x4e\x2e\xed\xd2\x7b\x10\xd4\x86\xff\xfa\x8c\xa4\x4d\xd9\xd1\xa9\x45\x1d\x4e\xee\x87\x71\xb6\x24\xb8\xaf\xf1\x7a\x8e\x84\xfe\x57\x85\x0d\xa5\x45\x69\xcb\x3b\x3a\xbd\x7f\xe9\x5e\x4e\x16\x63\x2b\xbe\xa5\x97\x7c\x5f\xb4\x13\x01\xbe\x0e\xad\xff\xb6\xdb\xfa\x1d\xdb\x90\x5b\x86\x15\x27\x91\x11\x4b\x7a\xef\x9c\xfb\x81\x1f\xbd\x40\x69\x3a\x81\xc3\x16\x8c\x2b\x16\x71\x7c\x0f\x0a\x15\x75\x37\x3a\xa4\x83\x14\xfa\x61\x93\x6b\x26\xb4\xc8\x0e\x2a\x92\xd2\x00\x91\x9f\x3f\x43\x1c\xf5\xb3\x73\x3c\x20\x9a\xcf\x48\xd9\x87\xdf\x4b\x68\xcd\xa4\x52\xf2\x2e\xf3\x21\x47\xe3\x69\x39\xc0\xdc\x63\x94\x98\x3a\xa5\xa8\x46\x66\xe2\x5c\x09\xc3\xd4\x9c\x62\x81\x12\x75\x11\xf6\xae\xd8\x1f\x4d\x07\xb4\xd5\xc2\xe9\x83\x4e\xee\xac\x8e\xb9\xb3\xbe\x17\x6d\x63\x73\x97\x72\x65\x6d\xa2\x56\x54\x7e\xf6\x87\x7b\xc8\xce\x12\x71\x13\x42\x62\x13\xcb\xf9\x69\xf6\xf8\x3b\xd3\xfd\x17\x20\x08\x4e\xe4\xf9\xa5\x4b\x7c\x9b\xa5\xc2\x58\x05\x8d\xda\xc7\x87\xad\x13\x1e\x36\x87\x70\x5e\x76\xdb\xf9\x30\xc8\x75\x56\xe5\x44\x47\x9e\x1a\x1d\xa7\xe4\xff\xbc\xda\x61\xc7\x20\xf7\xfa\x69\x1d\x79\xb6\x69\xf0\x1d\x66\x67\x5d\x0c\x3f\x80\x79\x8a\xcb\xf8\xa9\xc4\x5a\xc5\x46\xde\x8e\x00\x6b\x25\x6f\xb9\x1f\xea\xe1\xbb\xed\x71\x36\xc3\xc2\xf5\x08\x03\xeb\xba\x9e\x7e\xb4\x54\x44\x24\x94\x45\x9a\xe2\x0a\xa7\x05\x82\x26\x6a\xc8\xd8\x45\x25\xf6\xb3\x82\x64\xd0\x79\x34\x34\x44\xeb\x2e\x4a\x90\xbe\x68\x13\x7d\x5d\x48\x49\x7a\xd7\x0c\xf3\xe7\xe2\x2f\x06\x6b\xe3\x22\xed\x93\x2b\x0e\x73\x4d\xe7\xec\xda\x2e\xd4\x85\x89\x04\xbb\x0b\xbf\xbc\x62\xaa\x62\xc2\xa0\x18\xff\xf0\x1d\x7f\xff\xf7\xbf\xee\x59\xc8\x7b\x78\x6f\xf5\x81\x14\x9b\x0e\x20\x15\x29\x11\xd3\x9f\xab\x12\x93\xc8\xc5\x6c\xf2\x61\x17\xf9\x7d\x5e\xde\x64\x14\xce\x2a\x3f\x70\x48\xbe\x21\x27\xd3\x5d\x26\xc8\x8e\xad\x5c\xb9\x7a\x50\xac\x0f\x9c\x12\x78\x28\xda\xc3\xba\xc3\xc6\xc7\xb4\xb8\x20\x6f\x1e\x4e\x4e\xc6\xe8\xcf\x2c\xa4\x21\xff\x1a\x38\xa4\xc3\xf1\x56\x53\x21\x64\xb9\xec\xd4\x80\xa9\xcb\x30\x4f\x1b\x49\xeb\x6f\xec\xf1\x7e\x3b\x9a\x2b\xb9\x3a\x25\xc7\xee\xbb\xe3\xb9\x7d\x07\x90\x0c\x0b\xe6\xe6\xc0\xd5\x7c\x88\xa2\xdc\x75\xfc\xde\x31\xc2\xde\x73\x63\xcb\xd1\x5d\xb1\xe7\x1d\xc3\xbc\x35\x28\x42\x25\x57\x29\x4c\x9f\xff\x5e\x2b\x76\xcb\x65\xab\x9b\xcd\xc4\xf7\xc5\xc2\x7d\x86\xe3\x91\xb6\xa2\x49\x66\x0c\x67\x88\x81\xad\x57\x8a\x08\x44\x94\x3e\x1b\x42\x7f\xf8\x5e\x4c\x89\xa8\xe9\x2d\x0b\x44\xec\x40\x4f\x88\x91\x3b\xc9\xd9\x3f\xb3\xc2\xe9\xa1\xfd\x81\x2a\x57\xbb\xac\xc1\x16\x2f\x2a\x19\x94\xe6\x61\xfc\x4e\x15\x9e\x90\x02\x09\x18\x7d\xfb\xcd\x51\xee\xde\x05\x80\xce\x1b\xf8\x54\xef\x8b\x74\x11\x89\x6f\x8e\x4a\xde\x71\x42\xa9\xd7\x4c\x83\x62\xf5\xd3\xfb\x5c\xfd\x3d\xfa\x1d\x60\x25\xf8\x98\x66\xec\x80\xc4\x17\x2e\x89\x4c\x35\xed\x35\xb4\x0d\x8a\xf9\xc2\xa9\x7e\xdd\xd4\xa7\xdc\x6e\x9f\x68\x83\x81\x86\xc2\x6a\xbd\xfd\x54\x90\x5b\xbf\x53\x9f\xf0\x41\x34\xc2\x89\x09\x5f\x6e\xe5\x9c\x6b\xd5\xe2\xd8\x96\x5d\x1e\xc7\x79\xc3\xa8\x8d\xbd\xac\x99\x3a\x0a\x81\x19\xd4\xca\x86\x1a\xb6\xa7\xe5\x9f\x17\x85\xec\x36\xef\x92\xe2\x14\xf7\x43\x99\xb7\xca\xf3\x56\x70\x55\xbf\x93\xe1\x11\x2b\x7b\x01\xc3\xf1\x2a\x58\x9f\xb2\x63\xa2\xca\xb6\x23\xb5\xdc\x9e\x7b\x3d\xd1\x28\xb3\xc2\x51\x00\x8b\x5e\x58\x8b\xb9\x9b\x62\x86\xee\x45\x23\x35\xf3\x76\xc5\x5a\x2a\xd3\x0a\x3f\x81\xa8\x1b\x90\x28\xea\x62\xf9\x17\x00\x6d\x85\xe1\x4d\x17\xdc\xc2\xe2\xa9\xfc\x4e\xdd\x69\x9e\x95\x38\x3c\xcf\xe3\x96\x59\x1c\x08\xb2\x17\x87\xdb\xc5\x02\x7f\x83\x9d\xb6\x85\xbb\xf7\x71\x30\x40\xb1\xd5\xef\x16\xaf\x5d\x7e\x6b\x60\x3e\x61\xef\x15\x3b\x82\x64\x94\xe1\xdb\x81\xb1\xc5\x27\x93\xec\x89\x95\xf7\x91\xee\xb1\x2d\x6e\x96\xb2\x35\x68\xdb\xfb\xb1\x36\xd6\xab\x4a\xfd\xbc\xfe\x2e\xc7\x7b\xf0\xa1\xad\x03\xce\x19\x71\x68\xba\x5c\xd8\xe0\x20\x83\x5e\xc6\x23\xf6\x3c\xea\xd8\xac\x01\x5a\xd8\x5b\xab\x85\xd4\x12\x5a\xb9\xfd\xea\x24\x6c\x7b\xe5\x73\xa2\xe5\x8a\x99\x25\xbc\xbf\x90\x4c\x93\x3b\x25\xc5\xa2\xcb\x5e\x5c\x3c\x7b\x3a\xe9\x41\x71\x43\x60\x8d\xac\x64\x43\x96\xb4\x31\xb6\x63\x1f\xac\x63\xed\x26\xb0\xd9\xe2\x3f\x10\x10\x80\x4d\x14\xa3\x3a\x4a\xde\x6c\x75\x2f\x12\x2e\xff\x2c\x64\x2d\xb3\xdc\x16\xf7\x34\xed\xca\xa1\x21\x37\x13\xc5\xdd\xb6\x45\xb1\xd7\x4a\xae\x25\x90\xb7\xec\xd6\x84\x84\xc2\x50\xa8\xb1\x90\x96\x49\x5a\x00\xdc\xfd\xed\xb7\x06\xa6\xed\x93\x61\x17\x7e\xa8\x7c\x6b\xf7\xc6\x0b\x53\x0c\xfd\xb8\x34\xb3\x64\x5c\x15\x0d\xa7\x00\xcf\x96\x10\x70\xed\x9b\xa0\xfb\x09\x06\x37\x96\xd3\xb6\x6b\xc6\x19\xb0\xc4\x14\xd8\x4d\x3a\x1f\x58\x1f\xa0\xdb\xa3\x61\xc2\x15\xcc\xa5\x94\x28\x4f\x99\x6d\xb7\xa2\x4a\xd1\x4d\x3c\xc6\xb8\x30\xdc\xed\xa0\x93\x12\x5a\x9e\x89\x3a\xdb\xb8\x36\xc5\x1a\xbb\xb0\x06\xa7\x6f\xc6\x49\xa7\x9f\x60\xa1\x27\xf1\x4d\x8f\x59\x27\x3f\xb7\x2d\xdf\x1d\x46\x67\x72\xfb\x60\xe8\xb8\x7b\x15\x59\xe1\x9c\x9d\x3f\x38\x6f\x9b\x66\x13\x0f\x55\xcc\x83\x4e\xa1\x4c\x6f\x20\x71\x13\xf6\x02\xb0\xff\x2f\xd5\xd7\x1e\xd8\xfe\x8c\x8e\x3b\xda\xce\xe5\x59\xea\xb1\xcc\xe3\x4f\xbd\x09\x1a\x86\x16\x16\xf7\x93\x8f\x56\xbc\x8a\xd2\x5b\x4b\x8a\x21\x81\x8e\x2a\x52\x45\x5c\x1b\x1c\x2b\x4b\x5e\x9c\x71\xb8\xf5\x80\x01\xf1\x3c\xe8\x78\xaf\xd4\xec\xfe\x74\x2a\x67\x82\x63\x9f\xa2\x4f\xad\xde\x88\xe9\xf2\x08\x6f\xb4\xe1\xa3\x71\xdf\x85\xde\xfb\x78\xbb\x61\x00\xb1\x5d\xdb\x72\xb3\xb3\xe4\xf3\x2d\x7d\xb9\xc7\x9e\x92\xaa\xc8\xa1\x5d\x64\x22\xec\xf2\xc3\x29\x07\xe4\xf3\xea\x62\xf4\xf3\xd9\xcb\x3b\x52\xc0\xf7\x3a\x9b\x42\x86\x79\x68\x3b\xa1\xf3\xa0\x8b\xbc\xf7\x7b\x10\x15\xcd\xe3\x94\x5b\x76\x82\xd6\x37\xec\xa6\x3f\xde\xf0\x93\x4e\xc4\x56\xea\x0c\x0a\x9f\xa0\x86\xdf\xb2\xb4\x86\x2b\x29\xa8\xb3\xfb\x0b\x96\x05\xc3\x2a\x26\x67\x63\x44\xcd\x95\xde\xda\x21\x6f\xcc\xd9\xbc\x91\x52\x8d\x46\xe2\xe8\x64\x7c\xfc\xf5\xf8\x6d\x14\xa6\xe4\x3a\xc4\x87\x83\x64\xc2\x7a\x2f\x5e\xbe\x38\xba\x7a\x71\xfe\xfc\x87\xeb\xab\x1f\x2f\xed\xc4\x0d\x32\x93\xad\xc0\xf9\x9d\x4b\x29\x98\x36\x85\xd2\x36\x80\xf9\x5d\x54\xaf\x8e\x63\xb3\x71\x2e\xf1\xa4\x3f\x74\xa0\x04\xc2\x46\xa2\x2e\xff\xdf\xf9\xe5\xe5\x85\x55\x15\x1d\x1d\xb0\xf5\xa7\x5b\x23\xfc\x70\xf9\x9e\xc2\x2e\x4f\xc3\x07\x3f\x31\x7b\xbf\x9c\x3c\x44\x04\xec\x85\x32\x12\x67\x27\x0f\xc7\xbd\xe0\x6c\x02\x1a\x6b\x27\x1d\x99\x4e\x1e\x5a\x32\x91\x91\x39\xfb\x6b\xef\xad\x68\x84\xc3\xe3\x1b\xf2\xfc\xf2\xf1\xf5\x0d\xf9\x9b\xdf\x4a\x7f\xb8\x7c\x7f\xf7\xbd\xa5\x03\xbd\x11\xd6\x8a\x9a\x6a\x99\x84\x59\xe1\xe8\x5a\xe3\xfe\x40\x80\xe8\xdb\x96\x70\xbc\xa7\x64\x69\xcc\x5a\x9f\x1e\x1f\x2f\xb8\x59\xb6\xb3\x69\x25\x57\xc7\x52\xcc\x1b\x79\x87\xb1\x9f\xa3\x85\x3c\x9e\x35\x72\x76\xbc\xa2\xa0\x7a\x8f\x57\xb2\x6e\x1b\x76\xac\xf9\x42\x50\xb0\x31\x8e\xc3\x52\xd3\x85\xfc\x1f\xcf\xff\xf7\x76\x2d\x8c\x3c\x99\x05\x70\xc7\x5e\x12\x22\x41\xc0\x9a\x01\x5f\xf3\x52\xd4\x68\xf1\x90\x1d\xe2\x92\x7c\xb7\x12\xc3\x94\x6e\xa4\xbf\x8f\x9b\xf4\x82\xce\x0f\xad\x9f\x25\xce\xbe\x0e\x6f\xf2\x39\x2c\x75\x46\xbe\x2e\x17\x95\x9c\x24\x76\x6c\xf4\x95\x45\x3b\x48\xc3\xf0\x4d\xd8\x0f\x99\x7e\x06\x51\xec\x18\x78\x44\x9b\x3b\xba\xd9\x52\x9b\x19\x69\x83\x2c\x02\x3d\xce\xe5\xe0\x33\x0a\xb2\xbd\xd1\x63\x70\x61\x95\x0e\x6e\x20\xca\xc4\x4d\x24\xce\xe4\xc2\x7c\x75\xb2\x8f\x64\x6c\x31\x8b\x16\x03\xa9\x83\x12\xe7\xdd\x52\x15\x61\xd5\x71\xe0\x10\xf3\xf6\x4a\xa3\xfc\xc5\x01\x07\x6e\x36\x44\x51\xc3\xa2\xb0\x6d\xcc\x71\x58\xe2\x85\x4f\xbd\x86\x87\xba\x75\x7a\xa1\xd6\x2e\xb2\x8b\x9e\x5b\xe6\xf8\xeb\x5e\xfc\xf8\xcc\x33\x64\x04\xf8\x4b\x97\x07\x19\xed\x94\xa4\x71\xe2\x71\x83\xe7\xda\x03\xbf\xbd\x04\xb4\x47\xb3\xf8\xbd\xdd\xd1\xe2\x50\xe4\x18\xbf\xb1\x87\x1c\x75\x94\x9d\x5a\xb6\xb5\x0a\x38\xcc\xc6\xb1\xf3\x97\x70\xe0\x92\x66\x2b\x6a\xc7\x0c\x34\x9b\x53\xf2\x10\xe7\x2d\xd9\x34\x05\x39\x99\xf6\x14\x3a\xd7\xe9\x32\xd1\x04\x2b\xe0\xee\x39\xaf\xb8\x6d\xfc\x14\x15\x78\xde\x2c\xef\xa9\x72\xa2\x83\x7f\xc0\xc1\x8e\x36\x35\xb2\xe3\x7d\xa0\x3e\xfe\x35\x13\x14\xa1\x23\x2b\x42\xdd\x7d\xf6\x9a\x8a\x5a\xae\xc8\x13\x3b\x76\x28\x74\xaa\xb8\xbf\xe5\xa0\xc9\x68\xc6\x36\xd2\x05\x58\x66\x14\x04\x86\x0b\xbe\x6a\x57\xe1\x2f\x46\x84\x85\x5c\xca\xd5\xc5\x8b\x51\xe9\x8f\x77\x4b\x49\x91\xf9\x42\x3a\x2d\xd1\xd1\x39\x0b\xa7\x79\x86\x4a\xae\x37\xf7\x4b\xd6\x24\x6c\xd1\x2d\xd1\xe7\x0b\x62\x6d\x23\xc7\x19\xa1\xe7\x39\xef\x62\xc4\x76\x07\xb7\xe4\xc4\xa6\x11\x30\x98\x63\x50\xf3\xe3\xe8\xbb\xf7\x5c\x9b\xfe\xc0\x55\x9f\x9b\xb6\x21\x94\xc1\xf0\xee\x29\xf9\x62\xb0\x3e\x3c\x1f\x7b\xb5\x0d\x4b\x1f\xf3\xb3\xb8\x60\xbb\xaf\x4b\x28\x1d\x79\x4a\x50\xa2\xd8\x9c\x29\x86\x83\x47\x24\xe1\x51\xbf\xb1\xd3\x28\xbe\x91\x72\xe0\x2c\xec\x36\xbe\xf9\x22\x5b\x7d\xe8\x64\x12\xfb\xd5\x8f\x23\x1f\x9a\xb6\x8a\xdf\x16\xae\xcb\x3d\xb6\xef\x4b\x70\x6d\xb5\x1c\xde\x65\x35\xd9\x30\x43\x8e\x7c\xb9\x10\x37\x18\x05\xce\x69\x91\xb4\x2a\x62\x78\xae\x66\x6b\x6a\x96\xbe\x9f\x01\x07\x65\xb1\xaa\x45\xf7\xda\x90\x95\xd4\xa6\xab\x60\xf1\x6d\xf8\x21\x2b\x00\xab\x60\x0c\xcf\x86\xfb\xee\xa8\xf6\x2d\xa8\xb5\x6b\x9d\x11\x44\x36\x35\x53\xe4\x96\xa9\x7e\x03\x4c\x91\xea\x9a\xde\xb2\x51\x81\x77\xca\xa9\xb6\x01\x92\xdf\xdf\xd7\xf9\x0c\xd2\xf2\x4f\xb2\xa6\x82\x57\x7a\x3f\x41\x09\x8a\x64\x2b\x5a\x7b\x4a\x4c\xbc\xcd\x3f\x8c\x8d\x1f\x3d\xea\x87\xfc\x61\xb3\xa3\x07\x7b\xc4\xfc\x4e\xc9\xb9\xe2\x7e\x6a\x8d\x1f\xf4\xe3\xc6\x6d\xd5\x87\xe4\x85\x2c\x10\x36\xea\xe2\x96\xca\x32\xe2\x7e\x78\x3e\xc8\x0c\xcb\xb8\x46\xde\xb9\xa5\xdd\x9f\x4a\xc2\xb8\xa2\xb0\x8d\x85\xf1\xc7\x18\x72\x32\x8a\x63\xd7\xe1\x4a\x62\x48\x90\x0a\x5b\x0a\xa9\xd1\x80\xcd\x1c\xf4\xe3\xbc\x90\x1e\x64\x74\x8e\x37\xd6\x88\x0b\x6f\x8d\x8e\xb3\x4b\x05\xd8\xa0\x1f\x0e\xde\x1e\x5d\xe1\x73\x72\x88\x67\x1d\x05\xa2\x3f\x44\xa5\xe8\xb1\xb9\x80\x8a\xae\x60\xa1\x95\x4d\xbc\x22\x47\x0d\xa7\xeb\xb6\xf4\x23\x25\x56\xb3\xc3\x28\x9b\x28\x80\x0b\x94\xa6\xbf\x9e\x91\x93\x7f\x7c\x0d\xa2\xd8\x4d\xe2\x8d\x86\x81\x1d\xf4\x01\xa4\xb3\xfb\xc9\x59\x9f\x5b\xb0\x52\x47\x5f\x3c\x7b\x8a\x0f\xf6\xdf\x2d\x4f\xf1\x1f\x84\x50\xaa\x7b\x4a\xe1\x74\x93\xfc\x01\x8c\xed\x57\x1d\x86\xd2\x07\xb3\x2d\xb7\x80\x0f\x0c\xfc\x11\x0b\x9b\xa6\x4c\xd3\x9e\xfd\x57\xef\x95\xe4\xdc\xe3\xd5\xa1\x64\x6b\x78\xb5\x0f\x23\x8a\x6e\xf7\x9b\xfe\x07\x02\xc4\x36\x81\xbc\xc7\x75\x11\xca\x13\xf0\x78\xfd\x5d\x51\xe4\x8c\xf1\x9f\x75\xfb\x7c\x3c\xf8\x78\x40\x0e\xfe\x2b\x00\x00\xff\xff\x75\x13\x02\xb0\xb5\x70\x00\x00"
-
-func epochsFlowdkgCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_epochsFlowdkgCdc,
-		"epochs/FlowDKG.cdc",
-	)
-}
-
-func epochsFlowdkgCdc() (*asset, error) {
-	bytes, err := epochsFlowdkgCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "epochs/FlowDKG.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x31, 0xfd, 0x46, 0x8d, 0x54, 0xda, 0x65, 0x2e, 0x5b, 0x1a, 0x94, 0xad, 0xdb, 0x21, 0x1, 0xa1, 0x6e, 0xe6, 0xee, 0x35, 0xd4, 0x68, 0x28, 0xfb, 0x3, 0x5b, 0x9a, 0x96, 0xd9, 0x19, 0x62, 0xd8}}
-	return a, nil
-}
-
-var _epochsFlowepochCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6b\x73\x1b\x37\xb2\xe8\x77\xff\x0a\xd8\x55\x77\x43\x1e\x53\x74\x9c\xdc\x3a\x75\x4a\xd7\xf2\x5e\x47\x92\xbd\x2a\xaf\x1f\xb1\x95\xec\xad\x4a\x52\x11\x38\x03\x92\x58\x0d\x07\xcc\x00\x23\x9a\x71\xfc\xdf\x6f\xa1\x1b\xc0\xe0\x35\x43\x52\xb6\x93\xdd\x73\xc2\x4f\x12\x09\x34\x1a\x8d\x46\xbf\xd0\x68\xf0\xd5\x5a\x34\x8a\xdc\x7b\xda\xd6\x0b\x3e\xab\xd8\xa5\xb8\x66\xf5\xbd\x3b\xee\xeb\x4a\x6c\x32\x5f\x5d\x9c\x5d\xd2\x59\xc5\xde\x2a\x7a\xcd\xeb\x45\xf8\xdb\x69\xd5\x4a\xc5\x9a\x6f\x4f\xc3\xaf\xcf\x9e\x3f\x0b\xbf\x78\xca\x98\xbc\x77\xe7\xce\x83\x07\xe4\x72\xc9\x88\x12\xeb\xa3\x8a\xdd\xb0\x8a\xc8\x15\x6d\x14\x29\x44\xad\x1a\x5a\x28\xb2\xa2\x35\x5d\xf0\x7a\x41\xd4\x92\x91\x8a\xcf\x59\xb1\x2d\x2a\x46\xc4\x9c\xb0\xb5\x28\x96\x72\x4a\x2e\x6a\xa2\xe1\x4d\x34\x28\xfc\x8e\xd0\x86\x41\x7b\xb9\xa2\x55\xc5\xa4\x22\x6d\xcd\x95\xee\xa3\xf8\x8a\x91\xcd\x92\x99\xdf\x79\xc9\x6a\xc5\xd5\x96\x28\x3d\x1d\x32\x82\x3e\x4c\xb7\xd4\xc0\x6a\xa6\x36\xa2\xb9\x26\x62\xcd\x1a\xaa\x44\x23\xc7\x84\x4b\x22\x15\x55\xbc\x98\x92\x57\xf6\x5b\xb2\xa2\x5b\x22\xea\x6a\x4b\x2a\x46\x6f\x18\x11\x0d\xf9\xa7\xe0\x35\x0c\x60\x40\x68\x68\x54\x21\x76\x64\x26\xda\xba\xa4\x0d\x67\x32\x06\x32\x63\x84\xfd\x93\x15\x8a\x95\xa4\x6c\x1b\x3d\x69\x5a\x9b\x4e\x73\xd1\x90\x1b\xda\x70\xd1\x4a\x0d\x6c\xc5\x65\xc9\x56\x8c\xd6\xa2\x6d\xe4\x84\xcc\x5a\xa5\x87\xdb\x92\x86\xad\x28\xaf\x89\x19\x3d\x9a\x5e\x5b\x2b\x5e\xc1\x0f\x08\x93\xd5\xa5\x9c\xde\x79\xf0\x40\x03\x3c\xef\x08\x27\xd7\x15\x57\x84\xd7\x4a\x90\xaf\xc9\x7a\x49\x25\x93\xc7\xba\xc9\x6f\x27\xb7\xfe\x40\x77\x72\xfe\xfa\xd5\xe9\xdf\xc8\x4b\xb2\xfb\xf3\x9b\x6b\x7c\xff\x21\x99\x4e\xa7\xd0\xff\x48\x7f\x88\xe1\x39\x02\xff\xfd\x76\x44\xde\x32\xd5\xae\x89\xfe\xeb\x54\xac\x56\x5c\x69\xe2\x1d\xfd\xf6\x9b\xeb\xf5\x51\x48\x6b\x08\x0f\xc7\x84\xbc\xbd\x7c\xf2\xfc\xe2\xe5\x33\xf2\xfa\x6f\x4f\xde\x9e\xeb\x2f\x5f\x8a\x92\x75\x7c\x01\x64\x03\x12\x2b\x41\x64\x3b\x5b\x71\xa5\xd9\x04\xf0\x6c\xd8\x2f\x2d\x93\x4a\xc2\x0a\x6a\xda\xbf\x3c\xff\x7f\x97\x66\x01\x70\x91\x35\x3c\xb5\xe4\x12\x69\x3d\x25\x4f\x14\xae\x51\x5d\x02\xc7\xba\x5f\x26\xf0\x35\x2c\x54\xbc\x49\x1a\x26\x45\x75\xc3\xa4\x6e\xa1\xc1\x89\x56\x49\x45\xeb\x52\x23\x90\x20\x42\xeb\x92\x94\x4c\xb1\x66\xc5\x6b\xec\x12\x33\x8a\x45\xb5\x66\xef\x94\xdb\x55\x53\xd8\xa7\xd9\xe1\xd9\x8a\x2b\xd9\x61\x87\x4b\x22\x59\x73\xc3\x0b\x46\xd8\x0d\xab\xb1\x2d\xe5\xb5\x9b\xee\xe0\x98\x38\xe0\x84\x6c\x96\xbc\x58\x12\x5e\x73\xc5\xa9\x32\xa8\xaa\x86\xd6\x92\x2b\x2e\x6a\x4d\x6c\x33\x5f\xc4\x0a\xc7\x7d\x0d\x54\x34\x8b\xf7\xd5\x98\xbc\x3d\xbf\xfc\xee\x75\xb7\x72\xff\x58\xb2\xda\x23\x2a\x99\xb1\x05\xaf\x11\xf4\x9a\x36\x8a\x17\x7c\x4d\x6b\x25\x89\xdb\xc0\x16\x1d\xdc\x1b\x4c\x4d\xc9\x19\xee\x4d\x0d\x44\x43\xec\x16\x47\x46\x30\xd6\x0d\x5b\xeb\x5e\xe9\xdc\x40\x6a\x61\xdb\xb6\xa2\xcd\x84\x14\xa2\xaa\x58\xa1\xa7\x05\x92\x47\x94\x4c\x5a\x4e\xba\x11\x7a\xee\x06\x06\x6f\x48\x81\x02\xf6\x0b\x49\x1a\x21\x14\xf9\xa5\x15\x4d\xbb\x22\x05\x6b\x14\x9f\xf3\x82\x2a\x06\x2b\x5c\x88\x5a\xb2\x5a\xa2\xb8\x40\x78\x4d\x8b\x73\x2a\xb9\x54\x0d\x9f\xb5\x7a\xab\x5c\xb3\x2d\x59\xb0\x5a\x33\xb2\x26\xe9\xba\x11\x4a\x14\xa2\x22\xa3\xb3\xe7\xcf\xc6\xc0\xce\x4c\x91\x76\x0d\xfd\x1a\x5a\x97\x62\xa5\xe1\xcd\x18\x2d\x44\x3d\xb5\xc4\x84\x89\xc3\x5c\x01\x0a\xee\x87\x42\xac\xd6\x15\x53\x43\x6c\xeb\xf8\xc6\xad\x21\xee\xe1\x5e\xde\x01\x50\x9a\x6a\x73\x5a\x28\x89\xdb\x03\x25\xf6\xba\x11\x05\x93\xd2\xf0\x8c\x86\xb7\x83\x6d\x0c\x46\x66\xc0\x80\x69\xbe\x1e\x93\xd3\x57\x2f\x5e\x5c\x5c\x5e\x9e\x9f\xed\x62\x9c\x89\x2f\xe6\xb5\x7a\x98\xb7\x55\xb5\xb5\x2b\x5f\xc2\x60\xc9\xd0\xd1\xbe\x7a\x42\xe6\x94\x57\x6d\x03\xe2\x83\xd5\x8a\x35\xe1\x38\x73\xd1\xf8\x13\x00\x3a\x88\x88\xa1\x70\xc6\x25\xac\xbf\x9e\x31\x55\xfb\xb0\xb4\x1e\x17\x91\xb4\xab\xe5\x08\xda\xae\x81\xb7\x35\x59\xcb\xb6\x61\x6e\x33\x4a\x42\x49\xd1\x70\xc5\x0b\x5a\x39\xbc\x35\xc3\x6d\x78\x55\x91\x82\xb6\x12\x61\x14\x4b\xad\x88\x94\x20\x4b\x5a\xa9\xe9\x9d\x3b\xb4\xd0\xeb\x33\xa2\x55\x35\xee\x18\x40\xeb\x6d\x5c\x87\xf7\x77\xee\x68\xc1\xef\xb7\x62\x75\xbb\xc2\x55\x82\xd5\x39\x26\xdf\x5d\xd4\xea\xbf\xc8\xfb\x3b\x56\x4b\x04\x20\x35\xa9\x8c\x98\x7e\xf2\xdd\xe9\xe5\xc5\xab\x97\xfd\xed\x40\xb7\x80\x5c\xd8\xd1\x06\xd9\x00\x1a\x7d\xe8\x41\x50\x6b\x82\x37\xa2\xda\x07\xbd\x97\xaf\x5e\x9e\xf7\xff\x7a\x8a\x12\x40\x34\x43\x4d\xec\x9e\xee\x47\xfb\x1d\x2b\x5a\x10\x23\xbd\x4d\xbe\x67\x0d\x0a\x8a\xc1\x56\x4f\xe0\x0b\x7f\xea\x0f\x8c\xa9\x66\x84\xad\xd2\x5b\x39\xdc\xa8\x5c\xc2\x96\xd6\x72\x65\x63\x24\x43\xb7\xd6\x1d\x03\x4b\x07\x4e\x09\x42\x49\xcd\x36\x86\x1d\x0d\x83\x5a\x8d\x45\xdb\x02\x85\x12\x6e\xce\x84\xfc\x30\x26\x6a\x1c\x40\x66\x74\xc7\x4d\xc7\xe2\x5a\x88\x16\xf6\x93\x95\xc0\x45\xdb\x34\xba\x17\x8e\x07\xdb\x84\x4b\xdc\xca\xa0\x9b\x6c\x7f\xd3\x0f\x17\xf5\x3f\xff\xf7\x24\x85\x3c\xe7\x8d\x54\xe4\x86\xb3\x0d\x19\xf1\x5a\xcb\x64\x7e\xc3\xc6\x56\x24\x05\xe3\x4c\x5d\x67\xe8\xf4\x3d\x67\x9b\x01\xc0\x15\xdd\x17\xee\x17\x32\x26\x55\x37\x92\xf9\xe1\x09\x7e\x7f\x5e\x97\x9f\x6c\x54\x7f\x36\x35\xad\x86\xe0\x0a\x45\x2b\xf2\xf4\xef\xaf\xfe\x01\xe8\xb0\x92\xcc\xb6\x84\x56\x95\x51\x47\x68\x87\x54\x6c\x81\x36\x54\x76\x89\xba\xc1\x94\x06\xf6\x16\xc0\x1c\x93\xef\x9e\xf2\x77\x3d\xc3\xc9\x76\xbd\xae\xb6\x1a\x73\x3d\x12\x0c\x9e\x85\x1c\x74\xbd\xd0\x53\x2e\x8d\xaa\x68\xd8\x86\x36\xa5\x11\xa2\x20\xd5\x66\x5a\x90\xf2\xd2\x01\x5a\x37\xec\x46\x5b\xe2\x11\x24\x40\x51\x8b\xb4\xb7\x80\x43\x1f\x9a\xe0\xed\x68\x54\xfb\x07\x12\xad\x22\x34\x32\x03\x87\x29\xf3\x06\x61\x75\x63\xea\x5f\xc6\xd9\x8d\x9b\xb1\xce\xe2\x8d\xbb\xe9\x57\x98\xd0\xdd\x81\x35\x26\xeb\x85\x53\xd2\x48\x42\xe0\x0c\xfe\x2b\x2b\xfb\xac\xbc\x76\x5d\x88\x95\x66\x5c\x6f\x2e\x7d\x7b\x5b\x0f\xb8\xc7\xd6\x8e\x40\x92\x17\xad\x54\x9a\xa0\xa2\x66\x64\xd1\x30\x8a\x6a\x95\x82\x88\x09\x80\x0d\xca\x88\xe9\x9e\x22\xe1\x62\x9f\x79\x92\x0d\x57\x4b\xb7\x03\x08\xaf\xe7\xa2\x59\xd1\x70\xe3\xfa\xec\x78\x1c\x7c\xab\xfb\x5c\x9c\x4d\xdc\x9e\xbf\x66\xdb\x89\xb5\x3c\x72\xff\xd3\xb2\x6c\xc0\x24\x6a\x44\xc5\x26\x01\x28\x0b\xc2\xc3\x60\x42\x36\x8c\x2f\x96\x6a\x02\xfb\x72\x25\x1a\xd6\xe1\x04\x23\xd7\x73\x71\x4c\x7e\x48\xbd\xff\xe9\x4b\xf3\xeb\x4f\x07\x4b\xc9\x1c\x17\x7c\x12\x31\xd9\x0f\x78\x87\xc4\xd2\xcb\x8f\xe6\x35\xa1\x52\xf2\x45\xbd\xd2\x9c\xd0\xc7\x62\xe7\x54\x7b\xd1\x15\x83\x46\x46\x79\x55\x5c\xaa\x00\x66\xc3\xd6\x0d\x93\x4c\x1b\x60\x9a\x15\x1d\x78\xb4\xd1\x71\xcf\x68\x96\x00\xd3\x4c\xb3\xc5\xc5\x99\x34\x83\x1b\xfb\x71\x49\x43\x88\x06\xc4\x04\xd9\x09\x9d\x02\x5c\x3c\x14\xaa\xe0\x30\x78\x7c\x6b\xec\x0a\x13\x98\x91\x66\x15\x5d\x9c\x66\x6a\xfe\xca\xad\x9f\x14\x6d\x53\x40\xb4\x05\x8d\xff\x9a\x49\x89\x5e\x81\xc6\x4d\x4f\x97\xd1\x92\x35\x44\x32\xe3\xbd\x10\x5a\x2d\x44\xc3\xd5\x72\x05\xd8\x05\x00\x87\x36\xbf\xfe\xe0\x10\x6f\x61\xc8\x63\xf2\x56\x69\x2f\x2b\x83\x53\xc9\x68\x59\x81\xeb\x2a\xe6\x84\xe9\x25\x40\x43\xd9\x2c\xc0\xd9\xf3\x67\x9d\x1b\xa3\x84\x16\x01\xd6\xb8\x2d\x6d\x1b\x8b\x41\x00\xdb\xf3\x5d\x8d\x58\x3b\x73\x23\x61\x5c\x84\x15\x7c\xce\x0d\x14\xd6\xac\x00\x01\xda\x79\x5a\xc8\x8e\x75\xbb\x9a\xb1\x26\xdc\xd0\xe0\x3b\x50\x44\xad\xb3\xc8\x89\x98\x69\x31\xac\xc1\x7b\x12\x53\xaf\xa0\x64\x54\xdb\xe5\xb3\x4a\x14\xd7\xb8\xca\x00\xda\x88\xb1\x00\xb4\x15\x69\x64\xc1\x6f\x58\xed\x88\x33\x21\x5c\x91\x82\xd6\x44\xd2\x39\xab\xb6\x3d\x4e\x88\x6f\x5a\xe9\xcf\xd9\xf3\x67\x60\x6b\x3f\x7c\x9a\x6e\x94\xb8\xcd\x57\x7b\xb4\xf9\x3a\xd3\x26\x55\x86\xb4\x59\x30\x45\xca\xd6\xf8\xa0\x79\x36\x99\x68\xaa\x4b\x56\x88\xba\xec\x78\x1b\xbb\x9e\x99\x9e\x29\x1e\xd1\x10\x5a\x97\x42\x04\xb0\x6f\x88\x60\x89\x71\xb0\xa3\x75\xc3\x0a\x2e\x35\x62\xdf\xd5\xfc\x1d\xf4\x8f\xc6\x3f\xaf\xcb\x4b\xbe\x62\x76\xf8\x5e\xd5\x9b\x75\x6e\x87\x55\xef\xbc\x11\xab\x4e\xf9\x3a\x90\x5d\xa8\xab\x53\xc0\x1e\x20\x08\x46\x02\x34\x2d\x58\x3c\xcf\xbc\x67\xe2\x0e\xee\x92\x6a\x63\x98\xd5\xdd\x8e\x19\xd2\xcc\x66\x3e\x1f\xa1\x9b\xd9\x2f\x2d\xad\x2c\x43\xda\x4e\x3c\x55\xd1\xce\xe0\xf2\xf6\x28\x20\xb2\xaf\x7a\xbe\x04\xbb\x4e\xb6\x95\xb2\x2a\xe2\xdb\x53\x42\x17\x8b\x46\x5b\x9f\x26\xf0\xa1\xe7\x18\xc9\x74\x2b\xa0\x03\x58\xbe\xb0\xf6\x04\x2e\x69\x58\xc1\xf8\x0d\x43\x33\x91\x7a\xd1\x1d\x2b\xb0\x03\x28\xdf\x9e\x12\x08\xd1\xa1\xe1\x9b\x09\xe2\x80\x55\x08\xe2\xcd\xaa\x0c\x13\xa7\x61\xd2\x9b\xb5\x15\xe2\xbd\x52\xfd\xdb\xd3\x9c\x5c\x47\x5a\xe8\x15\x59\xb7\xb3\x8a\x17\xda\x78\x90\x1d\xb7\x19\x19\x8a\x11\x15\x56\x17\xa2\xd4\x82\x49\x6a\xfb\x1d\xcc\xbb\x4a\x6c\x8e\x16\x22\x54\x4a\xcd\x76\xad\x04\xa9\xf8\xac\xa1\xcd\x16\xc2\x22\x35\x59\xb2\x77\x47\xa6\x7b\x28\x10\x9f\x35\x42\x8b\x59\x37\xb6\xe6\x5e\xe5\xec\x05\x43\xfe\x09\x99\x8b\xaa\x12\x1b\x74\x1c\x20\x66\x58\x97\xfc\x86\x97\x9a\x69\x34\xc2\x0e\x64\x79\xbd\x78\xdd\xce\x9e\xb3\xad\x26\x03\x2a\x0e\x7f\xd6\xe5\xf5\x02\x06\x7c\xce\xb6\x19\xbd\x52\x5e\x2f\x2e\xca\x17\x74\xbd\xe6\xf5\xe2\x98\xbc\xc7\xdf\x8f\xc9\x45\xad\x3e\xf4\x9b\xd1\x6f\x58\x21\x6e\x40\xf1\xf5\x6c\xe6\x1b\x4e\x8d\x37\x01\x0d\xd1\x78\x5e\xe8\x3f\x6b\x5a\x17\x66\x93\xd3\xc2\x4a\x64\x06\x7e\x3b\xeb\x02\xfe\xa6\xdf\x76\x1a\x8c\x1d\xfe\x66\xd7\x48\x8f\xdb\x4a\x2d\xb5\xe6\x9e\xa5\xfe\xda\xdb\xf7\xaf\xad\x42\x9c\x53\x5e\x21\xcb\xd1\x7a\x4b\x1a\x46\xa5\x35\x3e\x1f\x04\x31\xae\x25\x5d\xaf\x59\x1c\xde\x82\xd8\x94\xd9\x84\xe4\x29\xad\xaa\x19\x2d\xae\xc9\x0b\x6d\xb4\xfc\x70\xfe\xf4\xc5\x4f\x13\x3c\x5a\x31\x4a\xd6\x8d\xf9\x56\x51\xc5\xdc\x18\xec\x9d\x62\x75\x29\x33\xee\x38\x9e\x4f\x24\x14\x00\x35\xa6\x1d\x3f\xb2\xae\x68\xc1\x20\x74\x8a\x5b\xa1\x82\xa8\x54\x05\xaa\xff\xfc\xe9\x8b\x89\x93\x1b\x7a\x9c\x70\x78\xd8\xa7\x7a\xc8\x2e\xe4\x94\x1c\x34\x6d\xc9\x92\xde\x40\xc4\x62\x5e\xf1\x02\x76\x87\x56\xbb\x92\xd0\x99\xc0\x03\x16\x8b\xb0\x1b\xe4\x86\x56\xbc\x34\xf8\x4a\x18\x67\x84\xff\x58\x61\xa6\x87\x05\x19\x3d\x9e\x0e\x2d\xa0\x17\xc0\xe7\x92\xf0\x5a\xdb\x14\x5c\x2a\x56\x17\x5b\x37\xd6\x6c\x4b\x78\xfd\x4f\x86\x88\x61\xd8\xa4\x10\xab\x95\xa8\x89\x5c\x42\xac\x11\x11\x50\x02\xa1\xa2\x20\xd2\x5e\x21\xec\xeb\x29\xf1\xb4\x47\x5d\x34\x4c\xb1\x0a\xb7\x69\x1f\x4e\x18\xc9\xb4\x2a\xd1\x85\xc8\xe9\x8a\x01\x17\xa0\x94\xeb\x82\x37\x61\xa4\x93\xd7\x4a\xb8\x01\x47\x4c\x6a\x03\x98\x53\x0d\x50\x8b\xcf\x39\x67\x55\xe9\xe2\xb7\x9e\x50\xd7\xe4\xf2\x75\x0b\x6c\x2b\x39\x9e\x90\x82\x56\x95\x31\x39\x1d\xa2\x9e\xf9\x68\xf7\x47\xdf\xea\x6a\x9a\x4a\xd6\x28\x99\x81\x00\x1e\x95\xfe\x89\x1b\xe7\x27\x3a\xce\xb0\x5b\x1d\x70\x99\xb8\xe1\x30\x1e\x1a\xb8\xbd\x92\xae\x18\x29\xa9\xa2\x5d\x64\x3c\x03\x42\xef\x56\x43\xe3\x4e\xb2\x39\x8b\x15\x16\x71\xd2\x2d\x56\xba\x93\x60\xb7\xaf\x4b\xaa\xd0\x3d\xe0\xe8\x2c\x66\x26\xa6\x25\xf6\x86\x55\xd5\xa0\x7b\x6d\x51\x1b\xed\x54\xe2\xa6\xe5\xf6\x3c\xb4\xda\xb1\x39\x97\x64\x65\x94\xfa\xc3\xc4\xdd\x8e\xf6\xb9\x01\x3c\xd1\xf8\x35\x6c\x2d\x1a\xd5\xd1\x21\x9c\x6a\x68\xc9\x09\xb5\x64\xcd\x86\x9b\x00\x73\x86\xb0\x36\x86\xd2\xd8\x53\xd4\x51\xc2\xd4\xd0\x44\x0b\xc1\xf1\x1f\xe2\xd9\xe3\x62\xe9\x6e\xff\xfd\xbc\xf9\x90\x3f\x7a\x78\x22\xef\xbb\xc4\x61\x4d\xf0\x86\x60\xa0\x7f\x19\x26\xf9\xe8\x98\x44\xcf\xf6\xd9\x2b\x24\x61\x5d\x78\xe4\xae\x81\x08\x45\xb4\x06\xbf\x5f\x80\xc2\x76\x4b\x4c\xd3\x27\x0e\x49\x6d\x9b\x59\xe3\xec\xcf\x58\xc3\x9f\xb1\x86\x3f\x63\x0d\x1f\x1f\x6b\x18\x88\x5f\x7e\x7b\x2a\xc9\x9a\x82\x8d\x61\x38\x31\x70\x45\x3c\xff\x03\x2c\x53\xc9\x80\xf1\xac\x8f\x09\x67\x10\x47\x62\x7e\x34\xa3\x75\x19\x8c\xd1\x4a\x7b\x10\x0f\xe6\x8e\x3b\x21\xd6\x82\xda\x66\x2d\x11\xb9\x16\xcd\x75\xc8\x97\xa7\x4f\xbe\xbb\xbc\x78\xf5\xf2\x98\x7c\xaf\x6d\x66\xad\x50\x9d\x27\x0e\x0e\x4c\x2d\x14\x61\xef\xd6\x15\x2f\xb8\xaa\xb6\xe4\x06\x4e\x29\xbb\x4c\xa8\xc0\xbc\x71\xde\xfa\x93\x3a\x52\xaf\x68\x90\x77\x34\x40\x59\xbe\x6e\x50\xf6\x23\x10\x4d\x79\xc5\x6f\x3a\x52\x81\xdf\x0b\x46\x20\xa8\x51\x25\x49\x25\x0a\x5a\x79\x39\x14\xfe\x20\x7a\xd3\x2c\x99\xf6\xe1\x2c\x58\xae\x10\xc4\xbc\xad\x0b\x7b\x42\x6f\x97\x5d\x1b\x0f\x0d\x73\x59\x1f\x78\x2c\xe5\xaf\x91\xa6\xba\xc1\x7b\x12\xed\x36\x86\xbb\x12\x8f\xd2\x0b\x5a\x6b\x12\xf1\x7a\xc1\xa4\xf2\x97\xcf\x26\x3d\xc0\x16\x84\xd0\x0f\x68\x37\x70\x28\xb4\xe0\x6c\x44\xbb\x58\xda\x35\xb9\x9b\x86\x0e\xbe\x17\x8a\x9d\x51\x45\xfb\x23\x08\xb6\x45\x4e\x6a\x83\x9c\xf1\x62\x08\x9a\x3a\x17\x67\x64\x85\xfe\xb4\x23\x43\x64\xb4\x93\x90\x35\x50\xfd\x1b\x7f\xc4\x70\x62\xc3\x8e\xc0\xa7\x75\x41\x09\xd0\xae\xb2\x05\x33\x76\xde\x56\x7a\xe4\x3d\x39\xec\x9a\x69\x7e\x02\x05\x7a\x4b\x46\x8b\x8e\x67\x90\xcb\xf4\xd4\x3d\x9b\x0b\xa2\x67\x60\xcb\x0b\x85\xde\x0e\xfa\x62\xc8\x23\x9a\xb4\x5f\x48\x02\xe9\x79\x9d\x14\x87\xc9\x69\x2d\xcb\xeb\x16\x34\x90\x3f\x0e\xae\xea\x46\xb4\x55\x09\x59\x14\x5e\x5e\x85\x26\xeb\x42\x08\x94\xe0\xfb\xac\xfb\xf4\xce\x8e\x60\x89\xf7\x6b\x1a\x2b\xf1\x7e\xdc\x3b\x54\x72\x6a\x6d\x07\x23\xe8\x0a\xb2\x62\x8a\x6a\xef\xc8\xf8\xd3\x41\x88\x2c\x8c\x43\x3e\xa9\x2a\xb2\xe4\x52\x89\x06\xd2\x4c\xd0\xc4\x76\xdd\x21\x09\x53\x68\x8f\x77\xcd\x9a\x15\xad\x81\x75\x12\x1f\x47\xaa\xa6\x2d\x8c\x93\xf3\xc2\x76\x7d\x9f\x32\x30\x9e\x50\xce\xb9\xe7\xe9\x84\xc7\xba\x3e\xd0\x8a\xa9\xd8\x5d\xc8\x18\x32\xda\x60\x41\xde\x15\x2e\x6a\x67\x59\xcb\xc4\x0a\xdc\x29\x6a\x6e\x04\x0d\xc0\x12\x7f\xd0\x0a\xb7\xf9\x81\xc3\x08\x83\x64\xf3\x15\xe3\x90\xe5\xba\x1f\x48\x16\x26\x14\xec\x04\x98\x24\x75\x0c\x21\xab\xdb\x9d\xef\x1a\x20\x73\x84\xae\xa5\x86\x3b\x3e\xdf\xbd\x96\x37\xb4\xc9\x9e\x9d\xe7\xa2\xa5\x10\x46\xa1\x2b\xbd\xf2\xf1\x60\x4a\xa0\x61\xe8\xc5\x26\xc1\x4a\xd6\x3b\x53\xab\x92\x2e\xc5\xa1\x17\x0b\x84\xff\x04\xc1\xe7\xdd\x32\x83\xe3\x37\x0d\xa3\xd7\xa5\xd8\xd4\x3f\x45\x58\x36\xb4\xb8\x96\x84\xcf\x1d\x45\x20\x94\x05\xb1\x7c\x2f\x75\x61\x70\x5d\x3b\x4c\xe4\x6b\xca\xcb\x63\xf2\x8d\x10\x55\x4a\x0c\xd1\x2c\x68\xcd\x7f\x45\x91\x27\xe6\xb1\x73\xa2\x9d\x03\xc8\x1d\x36\xfa\x25\xd4\x9d\x2e\xef\x14\x73\x41\x48\x23\xda\xba\x24\x8d\x98\x69\x1b\x48\xa0\xfe\x75\x56\xfd\xc0\x0e\xdc\xf7\x48\x33\x45\xff\x5b\x8c\xb4\x9f\x76\x91\x76\x2f\xee\xed\x00\xb8\xc0\x51\x2f\xa5\xf6\x8a\xbc\xa7\xc3\x07\xaa\x52\x4a\x51\x70\x30\xb6\xec\x49\x2e\x39\xf3\x72\x33\x9f\xb3\x2d\x79\xe6\x72\x33\xa3\x03\x11\xf4\x54\xd1\xf5\x72\xea\x04\x8f\x24\x9c\xd9\xaf\xb4\x65\xd1\x23\x3f\x9c\x57\x88\x3a\x6b\x11\x05\xe4\xc3\xd8\x7b\x1d\xb8\x12\xde\x1c\x42\xc5\xf8\xf2\xd5\xe5\xf9\x31\x3a\xfe\x20\x6d\x51\x04\x6b\xdb\x70\x43\xb3\x31\x2b\xda\xd9\x09\x7a\x09\x0c\x03\xe9\x16\xa8\x5b\x4b\xf6\x2e\x1c\xe1\x1b\x86\x19\x86\x0e\xb2\xb4\x76\xd1\x8c\xb9\x01\x78\x4d\x4e\x69\xa9\x2d\xb4\x09\xd9\xb0\x20\x40\x06\xd3\x44\xed\x87\xa1\x59\x54\x84\xa1\xeb\x88\xc1\x49\xb8\x24\x30\xe2\x56\xdd\xe1\xd7\x9a\x5c\x02\x23\xfc\x5e\x6c\x20\xe6\x8d\xf2\x7a\x11\xaa\xd7\x8e\x0f\x78\xcd\xd5\x28\x09\x38\x11\xff\xe3\x4b\xff\xf8\xa7\x58\x92\x47\xbf\xb3\x38\xcf\x2b\xee\x9d\x11\xad\x51\xa3\xfe\x6c\x22\xf7\x39\x24\xa3\x20\xec\xb8\xdf\x69\x55\xd0\x27\xa1\xe5\xd8\x57\xe2\x48\xaf\x6a\x3e\xb5\xc1\xca\x13\xab\x9e\xd3\x26\xa0\x96\x4f\x80\xbc\x99\x1f\x2d\x65\x75\x0b\xfb\x77\xda\xcc\x10\x98\x9c\x58\x52\x67\x21\x79\x54\x46\x70\xde\x17\x69\x07\x9f\xe2\xe4\x24\x58\x80\xb4\x71\xa0\x2a\xc8\x09\xf9\xe1\xa7\xbe\x36\x20\xc4\xc9\x09\x99\xd3\x4a\xb2\x1c\xc1\xa2\x45\x04\xd2\x45\xdf\x65\xba\xb9\x25\xd4\xed\xdd\x3f\x69\x43\xb3\x6e\xe4\xc4\xae\xa0\x6b\xf2\xe1\x4e\xb2\x71\x0a\x58\xb4\xb1\x76\xa0\x48\x21\xd6\xdb\xd1\xf8\x38\x31\xdc\xfc\x11\x1a\xa6\xda\xa6\x86\x81\xf6\x05\x2b\x99\xba\xf4\x28\x3b\xfa\x99\xd4\x6c\x13\xf1\xf9\x38\x1a\x26\xb7\x3c\x5d\xaf\x03\x46\x7e\xe3\xaf\xda\xe8\x67\xa3\x66\x9d\x32\xdf\x53\xe5\x67\xd1\x8b\x19\x22\x02\x7d\x30\x92\xc0\x36\x0e\x45\xcf\x12\x18\x18\xdd\xb2\x9a\xf7\xdf\x01\xe3\xba\xad\x2f\x47\xbf\x14\x43\x92\x21\x8b\x41\xc0\x90\xbf\x14\xd9\x55\xd1\x52\xfd\x2d\x33\x67\x3a\x5a\xb3\xa0\xb6\xd3\x6e\xe1\x48\x6b\xb1\x1f\xbe\xfc\x69\x8c\xea\xc0\xd3\x72\xa0\xa2\xf1\xe7\x87\xc7\x3f\x8d\xc3\xc3\x71\x3c\x3f\xff\x53\xfb\x0d\x2c\xeb\xd9\xf3\x67\x1a\x38\x50\x30\x94\xde\xc9\x1a\x76\xb2\xe2\x3a\x14\x14\x24\xcc\x3e\x77\xd2\xc0\x26\x6e\xc3\xe5\x40\xd6\x79\xc1\x33\xf0\x8f\xeb\x45\x77\x54\xf0\xa4\x5c\x19\x13\x28\xe3\x1b\x9e\x8a\x7a\xce\x17\x1e\x46\xd6\x44\xc2\xb8\xaa\xb6\x6e\xf1\x00\x98\xd7\x70\x2c\xed\x85\x72\x7a\x75\x7f\xdd\xae\xb4\x98\x97\x17\x35\x08\xb0\xd4\x81\xf1\x3b\x18\x52\xbd\x0c\xfb\x8c\x7e\xc6\x61\x6d\xdf\x2c\xcd\xa2\x71\xc8\x09\xf6\xe9\x63\xff\x9e\x59\xed\xeb\x99\x85\x33\x7b\x1b\x24\x97\x1f\x38\xc5\xb0\xf3\x81\x73\x0d\x3b\xdf\x72\xd2\xe0\xae\x95\xd7\x0b\x8c\x48\xef\x31\x5f\x1b\x62\x3e\x70\xa6\xb6\xdb\x81\x73\xb4\xdd\x0e\x9b\x5d\xe7\x86\x59\xc7\xcb\x4d\x75\x27\xc3\x9e\xa6\x06\x9d\xc6\xf4\xe1\x7f\xee\x9a\x68\xd2\x51\xab\xd5\x76\x15\x83\xe9\x9b\x70\xd2\x5d\xeb\xd7\xae\x7b\xef\xc4\xd1\xd9\xc5\x2b\x69\x8a\x11\xaa\x4c\xa8\xcc\xcf\xde\x5f\xd3\xad\x68\x95\x16\x7f\x0d\xa3\x92\x49\xc2\xba\xf0\x63\x44\x46\x2e\xc9\x0d\xad\x5a\x38\x58\x5f\xb5\x95\xe2\xeb\x8a\x77\x12\x04\xae\x10\x28\xff\x6e\x81\x12\x64\xc1\x94\x77\xb0\x01\x43\xf5\x12\x58\x03\xc0\x9e\x17\x06\x99\xd7\xac\x29\x58\xad\xe8\x82\xa5\x31\x87\x0c\xa1\x87\x00\x8c\x7e\x26\xeb\x04\x5a\x96\xde\x43\x50\xc8\x89\x07\x25\x47\x76\x70\x5b\x7a\x44\xdb\x64\xa7\x64\x98\x0c\xec\xa5\xc9\x20\x03\x4e\xf6\xa2\xde\x9e\x02\x32\xfa\xe6\x20\x39\xd3\xf7\xd3\x9e\x1b\x39\xfd\xf2\xa0\x0d\xb1\xdb\x2e\xdf\xb1\xba\x43\x3f\xf7\xeb\x5b\xd4\x8f\xfe\x59\x99\x49\xf5\xe1\x2b\x6d\xa0\xba\x76\xe7\x4e\xca\xc0\xfd\x40\x7b\x34\x4c\x93\xb3\x30\xc8\x49\xe5\xa0\xba\x21\x02\x4a\x46\x1b\xae\x96\xbc\x8e\xd3\x80\x20\x64\x3e\x0e\x53\x7b\x8a\x00\x99\x92\xcd\xf1\xb0\x94\x34\x6c\xce\x1a\x38\x8e\x49\x33\xaf\xf4\xa0\x52\xd1\xd5\xda\x1e\x91\x98\x6e\x0e\x30\x24\x23\xb5\x60\xa4\x45\xb8\x4a\x38\xac\xb9\x32\xa7\x6e\x98\x92\x04\x80\xaf\x60\x8e\x75\x72\x9e\xa7\x96\xac\x76\x70\x21\x81\x28\x9a\x3c\x97\xe6\xdc\x74\xb6\x3d\xb6\x0d\x5d\x87\xe8\x7c\x0f\x8c\xe9\xf9\xa5\x45\x9f\xdc\xef\x8e\x2c\xff\x83\x8c\x52\xa4\x8e\x1a\x36\x37\x7f\x8e\x03\xd8\x7d\x11\xf1\x4b\x58\xc2\x5e\x03\xc8\x8d\x66\x8f\xbd\xfb\xcf\x45\xe3\xe0\x5c\x19\x9d\x90\x26\xb0\xed\x02\x99\xc0\x70\xb4\x7e\xbd\x70\xbb\x19\xf6\x42\x76\xa4\xce\x83\x3e\xfc\xcc\x35\x83\x83\x5b\x93\x7c\x68\xfa\x54\xac\xd6\xad\x72\xdc\x24\x37\x5c\x15\x4b\xc8\x3e\x01\xc4\x66\x54\x42\x7e\x36\x11\xf3\xb9\x64\xe6\xd8\xb0\x43\xd3\x90\xe6\x41\xd7\x2d\x1f\x64\xd2\x8a\x61\xc1\xd4\xa5\xcf\x32\x4f\x45\x63\xad\xc7\x94\x3f\x9c\xe9\x61\xff\xe8\x77\xa8\xa7\x11\xe3\xa1\x85\x3e\xcc\x7d\xb6\x5f\xc0\x82\x39\x15\x12\x33\xc7\x24\xb3\xac\x93\x2c\x99\x63\x19\x1f\xe1\x75\xe2\xf8\x2e\xd3\xaa\x1b\x03\xf7\xd5\x69\x26\x44\x94\x99\x7b\xb8\x07\xfb\xc5\xe4\xdf\x44\x65\xf2\x59\xaf\x5c\xfe\xe1\x14\xb7\xd6\x95\xdd\x74\x2e\xc0\xeb\xc4\xd8\xac\x62\xee\x48\xcb\xdf\xaa\x36\xf2\x6c\x43\xdd\x5d\x73\xeb\xfe\x1c\x1b\xc1\xbc\x87\x63\x64\x6c\x98\x30\x19\xb2\x93\x7e\xe8\x36\x19\x07\x32\xeb\x39\xf9\xba\xc0\xa4\x02\xf9\x27\x73\x0d\x2b\x44\xe3\x2e\x28\xba\x13\x3a\x60\x6b\x73\xf7\xc0\xbb\x29\xd9\xc9\x5d\x70\x6e\x71\x2c\x94\xda\x68\xc8\x76\xc3\xbd\x01\x86\x94\x19\xb0\x30\x1f\xb7\x8f\xc3\x73\x43\xd1\x90\x9a\x57\x84\xcf\x51\xc9\xd4\x5f\x28\x32\x17\xad\x49\x60\x70\x79\x37\x1d\xb9\xba\x93\x44\xed\xe1\xa1\x03\x0b\xdf\x68\xad\x29\x85\x75\x9a\x37\x5a\xcc\x97\x1c\x14\x3e\x6d\xba\x8c\xdc\x52\x30\x3c\x4e\x86\xc3\x16\xcc\xff\xa9\x04\x2d\x35\x5e\x60\x6d\xc2\x9e\x0f\x6e\x41\x73\x69\x5a\x24\xd2\xd9\xec\xe9\x20\xec\x35\xfa\x19\x27\x98\xee\xe2\xa0\xd9\x5f\xbd\xbd\xc1\xe7\xc0\x37\x96\x66\x67\x0e\x6b\x08\x7d\x56\xf3\xa9\x99\xe6\xd4\x4c\x73\x3a\x13\x4d\x23\x36\x8f\xfe\xf2\x1e\x61\x47\xa0\x3f\x3c\x1e\x69\xaa\x1f\x63\x5f\x0b\xf5\x2d\xf6\x7d\x4d\xd5\x32\xde\x97\xd1\xf8\x6f\xd8\x9c\x9c\x64\xb0\xf9\xc1\x9f\xd7\x4f\xf1\xde\xee\x24\x92\x07\x67\x8a\x91\xc1\xa0\xe5\x87\x3b\xe9\x5f\xa6\x67\xcd\xab\x78\xa3\xbe\xa5\x98\x00\xb5\x12\x25\x72\x4f\x18\x63\x34\x5b\xd5\xe4\x2c\x75\xc7\xcd\x09\x6b\xe4\xb7\x2b\x58\xeb\xf4\x86\xc5\x2b\x58\xb3\x4d\xb7\x73\x83\x1f\x7d\xda\xad\x1b\x16\x51\xc1\x28\xb5\x2b\x93\x1c\xe9\x0b\x5c\x72\x72\x42\xbe\xbc\x22\x8b\x96\x36\x25\x61\xb5\x84\x50\x10\x6c\x8c\x76\x06\xd8\x40\x0a\xb6\x84\xac\x80\x18\x26\xd3\xbe\x0e\x8d\xee\xe8\x9b\x9b\x37\x92\x3c\x3e\x21\x0f\x27\x64\x85\x11\x81\xec\xd0\x47\xe4\xe1\x15\x64\x7a\x4d\x33\xc1\xb8\x1e\x54\xc9\x6f\xbf\x05\x8d\x47\x1e\x4d\x5c\xe8\xfe\xf1\x49\x3f\x90\x23\xf2\x90\xfc\xe5\x2f\x01\x8c\x1c\x88\x47\x06\xc4\xba\x11\x6b\x21\x59\xe9\xc3\x18\x8d\xc7\xc7\x09\x97\xdd\xeb\x04\x76\xb2\x74\xc7\xe4\x14\x65\x23\xb0\xcb\x36\xce\x42\x00\x61\x64\xeb\x4d\xcd\x31\xdd\x19\xd2\xa1\x60\x64\xd3\xfa\xc7\xd1\x10\x3e\x5a\x5e\xd9\xf2\x1e\xc9\xb5\x72\xdb\xb7\x87\x1c\xe3\x7b\x19\xd6\xbf\xed\xe6\xa7\xad\x5a\x8e\x5e\xb4\x8a\x2a\x36\x26\x9f\x49\x12\xe4\xc5\x40\x66\x15\x73\xd2\x80\x4a\xc9\xa0\xc2\x43\xfc\x83\xfe\xac\x62\x36\x38\x39\xc9\x71\xc7\xa4\xa7\xb3\x94\xe0\x4a\xee\xc5\x0a\xfa\x97\x6e\x46\xa0\xd4\x57\x5c\xae\xa8\x2a\x96\x5d\x2e\xb8\x19\x4f\xde\x4b\x06\xec\x13\x5e\xfe\x2c\x76\x11\x27\x98\x5b\xbf\x51\xf2\xcc\x5e\x16\x23\x0f\xbf\xfa\x2f\x32\xe3\x58\x2e\xc7\xcb\xac\xc5\x3c\x3e\xb9\x95\x8a\xad\xcc\xf7\x64\x54\xb2\x06\xaf\xb0\x69\xe6\x7e\x83\x5f\x7e\x03\xc5\x7e\xc6\xd3\x7e\xa9\x67\x93\x06\xdf\x78\x49\xb5\xa3\xb1\x3d\xc3\xf4\x85\x9c\x90\x2a\xb1\x3c\x21\x92\x5e\xb1\x7a\xa1\x96\x7a\xe5\xbe\xfe\x6a\x70\x9b\xe6\xc6\x3a\x26\xa7\xb6\x22\x0d\xaf\x6f\x68\xc3\x69\xad\xc8\x0d\x17\x95\x16\x73\x77\xc9\xf9\xbb\x35\x66\x7a\x2f\xd9\x3b\x3b\x53\x93\x6c\x0c\x2b\x68\xc6\xfe\xfa\x2b\x32\xb2\xb4\x1a\x43\x49\xb5\x85\x50\xf6\xc7\x1f\x47\x01\xa2\x63\xc2\x6b\xa9\x18\x2d\xa7\xb9\x6d\x08\x99\x14\x1e\x7e\xe4\xc4\x90\x62\x8a\x97\xe1\xfe\xc6\xde\x8d\x1a\x6d\x21\x28\x3e\xab\xcc\x4c\x1e\x41\xc0\xe3\xab\xff\x7a\x3c\x1a\x4f\x95\xf8\x86\x2f\xce\xeb\x92\xd3\xfa\x9b\xad\x62\x72\x34\x1e\xc7\xda\xcd\x07\x1f\x2f\xbd\xe7\x64\x4d\xb4\x88\xb9\x38\x9b\x64\x4b\x48\x24\x26\x08\xe4\x35\xa4\xe2\x26\xf4\x74\xdc\x08\x06\x14\x26\x44\xbb\x8a\x43\x2e\x0c\x90\x09\xbb\xc7\xf0\x4d\x20\xa8\xfb\xbb\x1b\x41\x0b\x14\xaf\x50\x9f\x67\x58\x83\x5d\x7a\x05\x89\x6e\x40\x5b\x67\xc3\x25\xc3\x69\xe9\x43\x75\x6b\x4f\x48\x69\xa6\x74\xff\xec\x33\xdc\xdf\x18\x6d\xd4\x8c\x51\xb5\xf7\x90\x4b\xdb\xe3\xf0\x61\x7b\x24\xef\x95\x67\x7c\xee\x18\x3c\x23\x97\x7b\xc6\x7e\x63\x67\x83\x39\x24\x10\xd1\x28\xa9\xd2\x76\x6f\xe7\x41\x3b\xbb\x15\x8f\x85\x92\x21\x1d\x49\x60\x55\x7a\x8a\x1f\x69\x11\x81\xb0\x61\x5a\x10\x08\x43\xbb\xa8\xfb\xbf\xcf\xcd\x4b\xcd\x22\xfd\xe9\x96\x27\x61\x27\x2d\x42\xba\xff\xa6\x61\x49\xa8\x40\xca\x03\xca\xd3\x18\x31\x10\xf5\x98\x4e\x69\xe8\x61\x4e\xc4\xbc\xf4\xd0\xe8\xc4\x24\x95\xf3\xdd\x20\x5c\x42\x32\x2a\x20\x13\x84\xd3\x46\xde\x1c\x32\x3e\x5e\x6f\x2c\x32\xaf\xc5\xf6\x06\x66\xc3\x8f\x79\x30\xc1\x92\x8c\xf7\xa2\xd6\x4b\xb6\x31\x5e\x15\x7c\xe1\x6e\xfc\x24\x77\xc0\x64\xbb\xd2\x5c\xe5\x68\x57\x63\x02\x2d\x2e\x1a\x1c\x74\xdc\x8b\xb4\xe3\x9d\x03\x66\x98\x39\x45\x0b\x27\x93\x0b\x49\xe4\x99\xd4\x10\xda\x63\x53\xff\x9b\x3f\x98\x51\x43\x54\x7e\x27\x56\x8d\x48\xf0\x59\x59\x70\x6f\x30\xd0\xa8\x9f\x47\x43\x42\x1d\xc2\xa1\x35\xdb\xfc\x2e\x5c\x1a\x1d\x84\x46\x44\x3e\x80\x61\x2d\x41\x3d\x8e\xed\xfe\xff\x83\xf9\x35\xc0\xed\xdf\x53\xb6\x06\xe4\xfc\xbc\x4c\x1b\x51\xeb\xb6\x72\x55\x73\xf0\xe7\xe2\x5a\x77\xa8\x1d\x90\xe5\x00\x6e\x4d\x8e\x1f\x90\x63\xf1\xef\xe3\xf4\x74\xe2\x63\x18\xf7\xb4\x0b\x2d\xb8\x21\xa6\x7e\xc4\x79\x48\xc1\xf9\x48\x1c\x93\x37\xd1\x31\x93\x5d\x0f\xe3\xbf\x77\x45\x35\x63\x4a\xe7\xb1\x8c\x1d\xf1\x4a\xd0\xf2\x51\x32\xac\x75\xb9\x1f\x98\x66\x0f\xe6\x16\x40\x40\xa1\x3d\xc7\xd0\xce\xeb\xc8\xd1\x61\x42\x94\xd8\x1f\xf2\xce\x65\xed\xcb\x06\x60\x9b\x97\xbb\x13\x02\x7e\x27\x61\x94\xc3\xf1\xa3\x65\xd2\x6d\xf6\x52\x4a\xa9\x90\x4e\x07\xd0\xfd\xe9\xdf\x5f\xfd\xe3\x6d\x7f\x72\x80\xde\xa5\x3b\xcf\xcb\x7f\x27\xf2\x0f\xa1\xfa\x49\x54\x43\x30\x59\xf2\xe8\x84\x3c\x9c\x7e\x79\x4b\x6c\xb4\x59\x8b\x95\x2b\x31\xe7\xc3\xe6\x91\x78\x79\x12\x9e\x08\x50\x1b\xc6\x6a\xf2\x2b\x6b\x04\xc8\x5f\x51\x33\x17\x3f\xf8\x71\x14\x60\x35\xfe\x48\xe6\x19\xcc\x05\x09\x47\xca\xf1\xd0\x83\x07\xe4\xbc\x86\xa3\x27\xd1\x90\x92\x4b\xf8\x93\xb6\x4a\xac\xa8\xe2\x85\x4b\x9e\x29\x68\x55\xb4\x95\x2d\xe6\x0c\x89\x91\x5b\xb8\xc2\xbd\xd3\x74\x36\x90\x4c\x2e\x29\x8e\x55\x8e\x7e\x26\x0c\xff\xca\xa7\x92\xee\x10\x8b\xba\x4b\x56\x12\xf6\x0c\x77\x90\x3c\x34\x88\x65\xa4\xe1\x4e\xe8\x1f\x82\x93\xdf\xf3\x7c\xd5\x90\xa0\x40\xd0\x94\x5c\xd4\xeb\xd6\x2b\x06\x1f\x15\xec\xb0\xb5\x09\xe0\x7e\x21\xc4\xe8\xbd\xf0\x70\x13\x17\x11\x31\xa5\x86\x32\xa5\x38\xe6\x6d\x5d\x10\xb9\x84\x9b\x83\xf6\x66\x20\x5c\x48\xf3\x76\x53\x54\xf5\xc5\x2b\x4d\xc4\xb5\x6e\xd4\x83\x04\xe5\x2b\xcc\x8a\x4b\x56\xcd\x71\xc9\xf5\xf0\xfe\x54\xcf\x75\x97\x51\xee\xbc\x2a\x34\x9a\x76\x5c\x62\xd8\xe7\x96\xc2\xe0\x3d\x07\xac\xac\x91\xbd\xdc\x48\xf6\xa8\x4b\xe0\xb7\xcd\x57\x09\xf0\x5b\xec\xba\x15\x4e\xfa\x6f\x6e\x67\x90\x3a\xf0\x32\xae\x0f\x61\xf8\x56\x27\xd9\x75\xb3\x93\xec\x73\xbb\xd3\x7e\x7a\x52\xc1\xbb\xdb\xe5\xaf\x1b\x76\xba\x64\xc5\xb5\x4c\x03\xf6\xde\xea\xbb\x3f\x27\x24\xd7\x2c\xe0\x82\xf0\xff\x4c\x07\xc7\x12\xac\xb7\x89\x63\x0c\xf3\x47\x6a\xcb\xd7\xed\xea\xd5\xfc\x34\xc3\x20\x29\xd3\x98\x40\xf0\x30\x0c\x7f\x3d\x93\x25\xce\x42\x18\x47\xc7\x75\x70\xf0\x6e\x0f\x26\xfb\x5f\x4d\x08\xe5\x48\x00\xa3\x62\x0a\xef\x85\xed\xa8\xf7\x92\x5e\x26\x99\x9b\x3b\x7d\x17\x67\x84\xd7\x96\x68\x19\x43\x01\xa0\x4f\xa1\x60\x58\x39\x1a\x18\x62\x84\x20\x2c\xfd\xc7\x43\xa7\x21\xe9\x14\x72\x69\x75\x07\xc7\x1c\x12\xb0\x7a\xdb\x84\x55\x28\x92\x1b\x3b\xe4\xfe\xae\xf4\x3d\x1f\xd0\x57\x3e\xa0\x0c\xf4\x83\x80\x7d\x9d\x03\xf6\xd5\x0e\x60\x09\x07\x69\x41\x9d\xa9\x84\x13\x4a\x54\xdd\xa6\x23\xa7\xdf\x3a\xdd\xc3\xee\xfe\x9a\x2f\xec\x33\x3b\xc1\x15\x1a\x02\x0e\x49\x1b\x78\x35\x75\x3a\x59\x90\x69\xe5\xea\x7a\xd8\xbd\x9d\x62\xb4\xd7\x9e\x4d\xfb\x85\x02\xde\xff\x2f\x6d\x9b\x2b\x58\x92\x2e\x70\x7f\xbf\xaf\x32\xfd\xbe\xda\xa3\xdf\xd7\x99\x7e\x5f\x0f\xf4\x8b\x55\x52\xf8\x7f\x5f\x7b\xa7\x9e\x82\x7f\x7b\x29\x3d\x28\xd9\xd2\x5e\xbe\x76\xea\xfe\xce\xb6\xeb\x74\x94\xf7\x4f\xb6\xa5\xa7\xac\xfc\xff\xfa\x04\x6a\x94\x45\xfe\x9a\x35\x73\xd1\xac\x24\x91\xb4\xd6\x02\xb5\x00\x85\xe5\xd5\x78\x17\x37\xbc\x74\x07\xf9\x41\xf6\x29\xd4\x5b\x05\x5b\x0d\x73\x2f\xb0\x74\x25\xdc\x97\xe5\xf5\xe2\xff\x04\xc3\x1c\x91\x4b\x38\xdf\x81\x87\x33\xb0\x6e\x04\x9e\x98\x85\x10\xa3\x3e\x4f\x5c\x95\x7c\xfb\x68\x07\x94\x15\x2b\x25\x94\x6f\xc2\x7c\x23\x6a\x6a\x7c\x91\xc7\xe4\xcb\x01\x33\x2d\xaf\x97\xff\x60\x23\xac\x57\xd1\x5e\xd8\x12\x7b\xb9\x96\x3e\xd3\x5d\xd4\x6a\xdc\x69\x89\xc3\xfc\xd9\xfe\xc0\x61\x38\xef\x23\x47\xa5\xfb\x0f\x27\xb7\x08\x70\x5b\x12\xf9\x70\x32\x49\x28\x24\x3c\xe1\x46\x27\x35\xbb\x6c\xc7\xae\xf6\x88\x67\x3b\xc5\xa6\x11\x94\x30\x34\xaa\x2b\x60\xb2\x41\xd7\x13\x0a\xc9\x79\x3b\xc1\x7b\xa8\xc1\x38\x12\x09\xd3\xdd\xca\x58\xc0\x4c\x8e\x7c\x22\xc7\x01\xb6\xc3\x14\x9f\xf7\xa9\xfe\xe1\xb0\xc9\x18\x7c\xa4\x27\xbf\x63\x90\xc2\xc1\xd6\x0b\xb7\x9d\x9e\x63\xe4\xb1\x41\x0c\x20\xdd\x8e\x78\x39\x47\x77\xfe\xd1\x60\x3e\x86\xf2\x30\xae\x91\x98\x93\x2f\xa7\xbb\x13\x44\xa2\x25\x0a\x57\x68\x63\x42\x10\xa6\xf0\x30\xf9\xa5\x80\x4a\xc4\x78\xfb\x10\xb2\xf5\xbd\xea\xc1\x77\xf6\x59\x84\x9e\x6d\x09\x09\x35\xd9\x7d\x98\x21\xfa\xc1\x04\x8f\xee\x31\x25\xc5\xed\xa4\xa6\x61\x1e\xb1\x71\x97\x82\x09\x39\x38\x28\x2a\x13\x58\x01\x61\x42\x60\xdd\x54\xa2\xc8\x4c\xce\xcd\x0f\x84\xf4\x5b\x25\xd6\xd2\x69\x87\xd5\x5a\xd4\xe0\x16\x60\xfd\xb5\x0d\x33\x75\x9d\x00\xa3\xe8\x36\x89\xcd\x98\x48\x5f\xba\xc1\xb3\xfd\xf5\x9a\x95\xe1\x58\x5d\x51\xc3\x70\x4a\x36\xe0\xaf\x61\x95\xd7\x0b\xaf\xff\x80\x42\xd0\x2d\x4c\x74\xdc\x62\x3d\xca\xe4\x6e\xdd\x36\xfc\x97\xd9\xf3\x40\x2e\x5e\x17\xcc\xd2\xa5\x61\x92\xa9\xb0\x24\x0e\x5c\x56\x2d\x05\xac\x65\x0d\x15\xff\x44\x16\x0e\x08\x3e\xbf\x8a\x0e\xa1\x95\x14\x53\xf2\x0f\x86\x91\x0e\xd3\x17\xef\x02\x0c\xdc\x6e\xb4\x9f\x6e\x9a\x98\x0b\x67\xd7\x4a\x73\xec\x68\x3c\x65\x75\x19\x9d\xd3\x45\x5b\x94\xb0\x4a\xe6\xb4\x8d\x29\x42\x58\x98\xc9\xba\x9a\xe3\x78\xa0\xb8\x13\x0d\xc7\x9e\x16\x11\x80\xa5\x79\xee\x7b\x58\xf6\x08\x8d\x1c\x88\xb3\xe7\xcf\x82\xce\xe7\x75\x79\xf6\xfc\xd9\x40\x02\x6d\x18\xce\x8a\x6a\x34\x57\x5b\x42\xb1\x6a\x9a\xa9\x2d\x5b\x97\xb6\x6e\x2e\x56\x01\xbe\x17\x4a\xc6\x7b\x5d\xca\xbb\x33\xa5\x06\x4c\x1e\x17\xb6\xb2\xfb\x00\xc2\x55\x4a\xb8\xa8\x17\x64\xa4\xe1\x81\x58\x58\x79\x7a\x74\xfe\xf4\xc5\x38\x06\xc6\x52\x74\x70\x70\x17\x62\xb3\x75\xba\x79\xa6\x4c\x6f\x18\xb3\xdb\x0d\xda\x57\x02\x36\x07\x91\xbd\xa3\x85\x26\x5a\xee\xf9\x99\x7c\x3d\xdc\x70\xa0\x01\xea\x94\x7c\x3e\x87\x0a\xcc\x78\x79\x04\x70\x39\xf5\xf6\x28\x99\x99\x7b\xe2\xdc\xdc\x7f\x44\x29\xda\x05\xfd\xfc\x2b\x52\xe6\xd9\x36\x8c\xf7\xba\xeb\x93\x5e\x81\xb6\x38\xd2\x6b\x06\x7c\x69\xb2\x2b\x42\x25\xd2\xf8\xf5\x47\xff\xd0\x28\xe0\xa7\x0d\xcc\xed\x15\x2d\xfc\xf7\x0a\xe2\x25\x16\xfa\x81\xe6\x74\x6e\xa9\xb5\x62\xe8\xc4\x50\x3e\xeb\xf9\x30\xfb\xd7\xb2\xd9\xb1\x2b\x6d\x1b\xf1\x30\xec\x3d\x7c\x47\x22\xbb\xab\xc8\x7d\xf2\x70\x4a\x9e\x09\x15\x5b\x6e\xf6\xf7\xd1\x8f\xa3\xdc\x54\xc6\x63\x14\x60\x79\xa0\xa3\x1f\x47\x83\x27\xce\xe3\xf1\x74\xd0\xda\x36\x95\x62\x32\x8a\x38\x89\x05\x45\x89\xa4\xc3\x99\xb0\xa3\x71\x6a\xd5\x9f\x82\xf4\x01\x19\x1d\xde\x77\xe8\x3d\x84\x30\x72\x5b\xf7\x80\x8b\xd1\x32\x8d\xc6\x99\x65\x79\xd1\x25\x77\x87\xd7\x1e\xb2\xfa\xf0\xc2\x0a\xa3\x8c\x2c\x82\xcb\x08\x06\x13\xad\xaf\xb1\xa0\x76\x57\x5f\xbe\x3f\x08\x95\x5b\xbd\x34\x5e\x80\x55\x95\x86\x23\x3c\xd9\xd8\xf4\xee\x48\xf3\xa1\xc1\xeb\x34\x02\x13\xd4\x5c\xfa\x72\xfa\xe5\xc4\x90\xcc\x69\x2b\x3d\xc1\x4d\xa3\x35\x16\x3c\xee\x7c\xe5\x04\xf6\x93\xba\x7c\xeb\x4a\xb7\x5c\x91\x19\xab\xc4\x26\x43\xaa\xb4\x60\x53\x5c\x67\x29\x2e\xcf\x94\xf9\xbd\x2b\xc5\xe4\x49\xa1\x9f\xb4\x37\x5c\x50\x35\xea\x64\xd7\x38\x32\xa4\x13\x8e\x7c\x0b\xd7\xa5\x0c\x87\x75\xd7\x2a\xc0\x3e\x87\x62\xc2\x5d\x8d\x6e\x93\x74\x01\xaf\x8f\x74\x4c\xb3\x6e\xc4\xa2\x61\x52\x46\x9c\x39\x90\xc1\x3f\x8a\x39\x36\xb7\x51\x62\x2d\xd8\xf3\x6c\x24\x56\x4d\xb6\x0f\xac\x6e\xfd\x6a\x83\xde\x83\xac\x79\xc4\xf2\x0b\x97\xdb\xb6\xe7\x2b\xae\xf6\x36\x4d\x88\x6f\x28\xb8\xa7\x04\xe3\x47\x06\xa2\xba\xe9\xb6\xc0\x28\xc7\xe2\xde\x35\x61\xef\xb8\x22\xe7\x4f\x5f\x24\x60\x31\xa4\x60\xef\xb8\xc4\xaf\x0c\xa8\xe8\x4e\x1e\x9a\xe8\x37\x9c\x6d\x32\x57\x84\xf6\x39\x35\xdc\x73\x47\xef\xb5\x5b\x0f\xdd\x8b\xbb\x77\xf7\xee\x63\xa4\xdf\x23\x1a\xfd\x67\x94\xd7\x6f\x97\x13\x32\xc0\x85\xb4\xd3\x7b\xdd\x7b\xe2\x81\x49\x9c\x55\xf0\x7d\x62\x45\xc3\x74\x96\x6f\x6f\x68\xf9\x0d\x83\x97\x6a\x06\xfd\x27\x4f\xbf\xfd\x8f\xf5\x9c\x06\x1d\xa7\xf0\x6d\xb0\x4f\xee\x30\xd9\x55\xf4\x9d\xa5\x66\x8f\x75\x33\xf7\x7e\x4c\xca\x65\xe3\x3d\xc6\x13\xbd\x30\x90\x41\xc3\xc6\xaa\x3a\x77\x0b\xb3\x70\x4c\xc5\x4f\xab\x76\x02\x10\xa7\xb4\xaa\xf0\xf9\xfe\xd4\xcf\x33\xc5\x6a\x18\x16\xac\xf0\xe1\xeb\x09\xb9\x5f\x4d\xf9\x5a\x54\x55\x51\xc9\xce\x37\x91\xa6\xeb\x49\x48\x71\x18\x97\x26\x1d\xc8\x54\xd9\xf5\xf2\x49\x0a\xd1\x36\xd2\x5d\x1c\xc9\x96\xf9\xb6\xf5\xb9\x81\x2c\x80\xa4\x29\x95\x56\x09\x09\x8f\x8b\xe1\x02\xb8\xb8\xd0\x3b\x2e\x81\xbe\xe1\xea\x87\xd7\xef\xe0\x50\x1c\x93\x6a\x80\x57\x3c\x82\x0f\xad\x05\xc0\x96\xc9\x36\xe9\x4a\x77\x37\x14\xea\x31\xd4\x25\x3e\x59\x6d\x6f\xc9\xc0\xe6\xa2\x64\xdd\xf0\x2e\x16\x1c\xac\x49\xf4\xae\xd7\x9c\xf2\x2a\x3c\x10\x02\x14\x69\x55\x89\x8d\x24\x62\xad\x2d\x78\xd1\x18\x44\x54\xb3\xcd\x94\x1f\xc7\xe7\xd7\xad\x05\x68\x89\x83\x18\x98\x07\xdc\xa9\x52\x6c\xb5\x56\xfd\xc5\x22\x32\x98\xfe\xe9\xb8\xc7\x6d\xff\x3b\x38\xee\xe4\x93\x78\xee\xb9\x4b\x80\x07\xb9\xed\x3e\xa3\xdd\xda\x75\xff\xb7\x75\xdb\x07\x43\xe7\xfd\x91\xf3\x00\x46\xe2\x72\xfb\xa0\x3c\xb7\xdb\x8f\x07\x44\xc5\x23\xb2\xf4\xf9\x1c\xe1\x81\x1e\x3f\x24\x11\xd7\x55\xe5\xc4\x18\xf3\xbc\x39\xd7\x62\xf8\x9d\x73\x4b\x88\x60\x5a\xdd\x0d\xed\x28\x79\x65\xff\xc8\xc4\xad\x02\x09\xb9\xd5\xb8\x0b\x25\x86\xff\x55\xc3\x0a\x79\x8c\xfd\x36\x19\x37\xe0\x5f\x31\x70\xb0\x0f\x97\x1e\x18\x45\x48\x19\x38\xb4\x55\x1c\xd7\x7a\x09\xbe\x0e\x03\x5a\x35\x8c\x96\x5b\x5b\x49\x66\xc7\x33\xf2\x64\x47\x9c\x22\xcb\xdd\xff\xd3\xc3\x03\x99\x74\xcf\x3f\x03\x06\x69\xc0\x20\xc7\x3a\x3d\x32\xe9\xcf\xf0\x81\xdf\xae\xd7\x93\x3f\xf8\x14\x54\xd4\xec\x50\xf7\xdd\x0b\x83\x4e\x4c\x25\x19\x38\x32\x3e\x77\xa9\x05\x15\x53\x4c\x4e\x20\x81\xe4\xca\x2b\x74\x9f\xb9\xe8\x76\x9f\x3c\xbc\xda\x27\x3c\x60\xfc\x36\x78\x1a\x48\x92\x23\x22\xe1\x74\xde\xdc\xf8\xf7\x5f\x98\xe3\x12\x91\x99\xb8\x3c\x03\xf7\xde\x1d\x0f\x1d\xd5\x99\x10\x4a\xaa\x86\x42\xf6\x82\x17\xdd\x30\x89\x3a\xe6\x75\x5a\x59\xd3\xb5\x5c\x0a\x35\xb1\x2f\xdd\xc2\x8f\xfc\x57\x26\xbd\xb7\x5b\x1d\x01\xcd\x53\x15\xeb\xb8\x66\xab\x31\x14\xe0\xa6\xae\x9e\x02\xbc\x12\x89\xb5\x1d\x4d\x5c\x82\x2a\x37\xd4\x90\x57\x64\xc9\x1c\x5a\x05\x03\x35\x30\x0e\xbd\x1f\xf0\xb9\xbd\xa5\xdb\xbe\xc5\x70\x8b\xa7\x18\x72\x3e\xce\xf8\xa0\x23\xc3\x9e\xf2\x50\xb7\xf1\x3b\x6c\x3d\x9e\x76\xa6\xf5\x20\x75\xda\xf6\x3c\xb0\xf7\x81\x0f\x32\x29\x42\xb6\xb5\x6d\xd7\x15\x81\x4b\xab\xe5\xdd\xee\x06\xf2\x1f\x97\x48\xf8\xd9\x32\x04\x07\x9c\x9f\xc8\x22\x48\x7c\x0a\xaf\x6a\xd6\xf0\x41\x09\x1c\x79\x7d\x8e\xe3\x47\xe2\x1b\xfa\x3d\x92\x33\x7f\xb7\x7b\x9f\x83\x43\xb2\xb7\x75\x41\xf6\xb2\x18\xc8\x2d\xec\x10\x92\x3f\x48\xec\x3f\x44\xcc\x1f\x45\xf5\x1c\x21\x92\xbc\xac\x49\xbe\xca\xe3\xe5\x4b\x9b\xee\xef\x7c\x5b\xe7\x2c\xf8\x7e\xc1\xa7\x3b\xe6\xdb\xf7\x94\x2f\xee\xf6\x7b\x1c\xfa\xfd\xce\x67\x16\x24\x2c\x1b\x15\x16\x04\xe2\x12\x25\xa0\x80\x77\xd7\xe3\x57\xca\xe1\x71\x33\xb4\x25\x5c\x7f\x25\x4c\x09\xd1\x00\x45\x4c\x76\xf4\xa3\xc2\x44\x34\x5e\x4e\x9c\x89\xaa\xcf\x21\x71\x2f\x53\xd4\xc8\x55\x18\x72\x85\x98\xa2\x72\xc5\x4f\xad\x6d\xe3\xd0\xc6\xa7\xe2\xb1\x02\x27\x3e\x6b\xab\x04\xa1\xe5\x0d\xb5\x36\x4e\x6a\x50\x40\x25\x54\x44\xde\x3c\xf0\x6b\x2b\x08\xff\xd2\xf2\x06\x2d\x38\x1b\x09\xee\x5e\x56\x5b\xb1\x7c\xd5\x77\x6d\x5b\x98\xf1\xbe\xd1\xe3\x27\xe9\x95\x58\xdf\x77\x30\x4e\x94\xd1\x9e\xfa\xeb\xfe\x5b\xd7\xd9\xcd\xe4\x05\x8e\x00\x13\x72\x42\x16\x4c\x9d\x7a\xdf\x64\x12\x09\xc9\xad\x23\x4e\xc3\xc1\xb5\xbb\xd9\x91\xa0\x0c\xd6\xd6\x6d\x46\x48\xe2\xe4\xf3\xcc\xa5\x64\xda\x30\x7b\x8d\xb8\x0f\x50\xe7\xdf\x02\x18\x5a\xa8\x96\x56\xd5\x96\x2c\xe1\x72\x1a\x11\x70\xbb\x75\xb5\x62\x25\xa7\x8a\xe9\x06\xae\x6e\x23\x33\x67\x41\x0b\x5e\xcb\x3e\xe8\xf6\xa4\xe8\x6a\x4d\xb7\x66\x0f\x3f\x15\xcd\x6b\x53\xb5\xd1\xec\xaf\x2b\x6f\xfc\x75\x30\xaf\x82\x65\x01\x07\xa9\xb6\xb4\xe7\x02\x75\x52\xfd\xd8\xfb\x60\x85\xc9\x01\x94\xb2\x3d\x3f\xf4\x21\xe3\xb3\xcb\x14\x7c\x80\xc7\x27\x59\x56\x88\x5f\xba\xda\x81\xe1\xae\x4c\xda\x7e\xc4\x62\xc6\x3f\x7f\xfd\xea\xf4\x6f\x6f\xcf\x2f\xbf\x7b\x9d\x67\x7a\x43\xd1\xce\xa2\xc5\x4c\xe9\x53\xfb\x32\xf7\x68\x4c\xfe\xf2\x17\x02\xcc\x7a\xf6\xfc\xd9\xb4\xbc\x0e\x7e\xba\x7b\x42\x6a\x9e\x5c\x51\x4f\xa6\xd3\x2b\xd3\x07\x7b\x81\x30\xb6\xf6\xd3\x8a\xab\x8f\xa3\xc1\xe9\xab\x17\x2f\x2e\x2e\xff\x6d\x77\xfe\x41\xcc\xc6\xf6\xe6\xb2\xc3\xb8\xbe\x64\x73\xda\x56\x2a\x4f\x44\x2c\xb6\x78\x27\x0f\x21\x0a\x15\x9c\xd2\xaa\x92\x5e\xe5\xc0\x2b\xe7\x75\xcb\x81\x7c\xf4\xe8\x0d\x1a\xab\x8b\x30\x46\xe6\xc2\x7b\xc4\x7b\x2a\xbe\x57\xe3\x64\x36\xd8\xe7\xae\xf3\xe1\x6a\x76\x30\xc4\x39\x98\xd9\x2d\x0b\xa8\x68\xfe\xb3\x99\xa3\x2f\xcd\x35\x22\x9f\xf5\x72\x72\x24\x89\x48\x86\x55\x3e\x6d\xa1\x0e\x0a\x15\x40\x25\x56\x25\x15\x73\xf2\xf5\x57\x5a\xe9\x6b\x27\x8f\x35\x92\x8c\xf8\x94\x91\x87\xff\x49\x66\x5b\xc5\xa4\x36\x52\x5c\x31\xd0\xf4\x08\xe2\x63\x72\x32\x23\xd3\x0c\xfe\x84\xf5\x1d\x45\xd3\x3e\x8e\xe9\x30\x19\xc8\x10\xea\x0d\x61\xed\xc9\x97\xfd\x7c\x36\xcc\x93\xaf\x07\x79\x32\x95\x77\x9f\x98\x25\x3d\x5d\x10\xb1\xa3\x8f\xa4\x61\x45\xef\xab\x3d\xcb\xb0\x0c\xc8\xeb\x8f\x21\x33\x02\xdb\x49\x67\xc3\xe7\x24\xbc\x38\x24\xe6\xd9\xbc\xd8\x8c\x38\x30\x92\xf0\x73\x90\xdc\xa8\x9e\x88\xe6\x68\x45\x05\xd4\xc6\xa9\x1e\x42\xee\xdd\xf9\x55\x2f\x19\x2b\xa5\xa9\xb7\x6f\xac\x7d\xaf\x4e\x8a\xab\x40\xab\xfd\xdd\x78\x8d\x50\x67\xcb\x7d\x32\x3c\x95\x30\x55\xd5\x57\xf0\x92\x56\x10\xc7\xe8\xa5\x7a\x9f\x59\xd0\x5b\xf1\x66\xa7\x1d\x31\x50\x01\x6a\xc8\xe8\xeb\x1d\x70\x2f\x4b\x31\x7d\x0a\xa8\x23\x1d\x32\x9f\x12\xd7\xf0\xb6\x74\xe0\x09\xa7\x1e\xf4\x83\xce\x75\x96\x2e\xaa\x33\xec\x3f\x67\x1f\x05\xed\x27\xab\xc3\xd9\x7b\x3d\x1c\xab\xd1\x9a\x9a\xe7\xb9\x7b\x5d\x41\x45\xa4\x7d\xf3\x7e\xad\x83\x89\xcf\xd7\xe3\xa6\xf6\xe1\xc0\x3c\x31\xb5\x99\x2a\x9b\x75\x35\xb3\x2f\x71\xda\xe8\x62\x5c\x46\x7e\x30\xf8\x80\xe7\xdd\x80\xd4\xdb\x76\xb5\x32\x95\xdc\xbd\xa9\x74\xfc\x93\x72\x8e\x67\xc9\x79\x46\x1c\xd0\x24\xb1\xdf\xfa\x4a\xcd\x7b\xa6\x5b\x04\x6a\x9a\xbc\x6b\x1a\x22\x3a\x75\x33\x1f\x0f\x81\x08\x1e\x65\x8d\x20\xf8\xe1\xac\x0e\x08\x1a\xd2\x49\xe4\x27\x82\xed\x2d\xf1\xad\x3c\xac\x80\x2f\x94\x7b\x70\xde\x94\x03\x13\x73\xac\x0e\xd6\x39\x90\xc1\xfa\x7d\x21\xe3\x47\xe1\x0c\x48\x68\xd9\x15\xd7\xb2\xaf\x73\x4a\x33\xc2\x35\xb3\x99\x66\x4b\x7a\xc3\xea\x2f\x94\x89\x33\xf0\x5a\xf5\x9d\x2c\x93\x2d\x53\x89\x7d\x62\x5a\xbc\xc6\x7d\x76\x92\xbb\x58\x6d\x39\xe0\x52\x0f\x8a\x0d\x33\x97\x4f\xe6\x8c\xe1\xea\x1a\x20\x4f\x19\x93\xba\xeb\x53\xc6\xbe\xa1\x15\xad\xc1\xba\xf1\x3b\xdd\xd0\x86\xcc\x2b\xb1\x81\x65\xc5\xf2\x6a\x4f\x34\x8d\x1c\x2a\x5f\x4e\xc3\x7b\xe3\x7c\xee\x0d\xd2\x19\xff\xa6\x7d\xaa\xa7\x06\x81\x3f\x85\x1f\xaf\x59\x8d\xac\x83\x4d\x42\xd5\xd3\x77\x5f\xf3\x70\xb8\xe4\x3e\x19\x85\xd8\x1e\x75\x53\xd9\x75\x91\xfb\xef\x82\xa2\x41\x40\x71\xe6\x62\x4e\x66\xa2\x6e\xa5\x65\x02\xc8\x46\xf4\x9f\x1b\xf1\x57\x05\x5a\x5e\x62\xc3\xc8\x2b\xfb\xa6\xfb\x29\x17\x5f\x34\xcf\x83\x64\xc6\x4a\x58\xdc\x7b\xe5\xb0\x61\xee\xeb\x78\xed\x7c\x54\x1e\x0d\x11\xf1\x40\x8a\x0f\xfc\x78\xe4\x0f\xba\x83\xcc\xe1\x16\xde\x2f\x6e\xeb\x3b\x20\xfb\xe0\xf3\x1f\xbb\x0e\x74\x06\x1f\xdf\x4b\x96\xc8\xbd\x2c\xb9\xe9\xde\xb0\x0c\x9c\x28\x57\x93\x1d\x32\x71\xed\x7b\x23\x68\x6d\x25\xef\x42\x10\x2b\x30\xb3\xb7\x9b\x65\x46\x08\x84\x53\x4f\x45\x82\xfd\x7d\x3f\x95\xd2\xf3\xf2\x49\xc2\x0c\x7f\xfd\x2b\x59\xd3\x9a\x17\x23\x77\xb2\xd7\x69\xbe\xcc\x82\x91\x19\x2b\x5a\x8a\x59\xdb\x4b\x2a\x9d\xa4\x74\xe4\xd8\x32\x75\x6f\x1c\x99\xbd\x21\xde\x89\xf2\x19\x9a\x78\x8f\xce\x89\x61\x0e\x18\x50\xaf\xe9\xb6\xb3\x3a\x4d\x56\x3b\x96\x99\xd0\xcb\x58\xb2\x8a\x2d\x30\x13\xd8\x84\xca\xc3\xc7\x60\x7a\x0d\xa3\x3d\x4d\xc0\x07\x0f\xb2\x8f\xf9\xe0\x5b\x86\xd5\x46\xe3\xf6\xf8\x84\x3c\xc4\x67\x9f\x6d\x9c\x53\xc5\xa9\x03\x60\xe7\xa3\xc1\xed\x43\x36\xf6\xbe\x31\x92\x1a\x5a\x4b\x13\x29\xef\xb4\xa3\x77\x90\x0f\x69\x0c\x36\xab\xa1\xec\xde\x1a\xca\xbc\x2b\x64\x5e\x90\x59\xfb\xd3\xba\xb5\x25\x03\x8f\xe6\xa4\x65\x0e\xee\x66\xa1\x07\x0f\x9b\xa7\xa2\x0b\x4c\x4d\x67\x8f\x65\xb4\xab\x49\x61\xf2\xad\x99\x51\x78\x38\x97\x1f\x36\x48\xc7\xeb\x6c\xc7\xbe\xe6\xc1\xe3\xef\xe9\xa6\xea\xdf\xf8\x1d\xdb\x8c\xe6\xe6\xdd\x40\x97\xe8\x90\x1f\xca\x3d\x28\x12\xda\x68\xc7\x96\x0e\xe9\xe8\x79\x38\xd1\x43\xf3\xaa\x69\x59\x0f\xe2\xb9\xed\x96\x81\x38\xfc\x20\x98\xff\x7a\xb8\xb8\x61\xd2\x49\x51\xa3\xfb\x6c\xed\xd6\x59\x5b\x5c\x33\x9b\x62\x15\x78\xe2\x32\xba\xa9\x98\x2b\x28\x91\x7d\x02\x3d\xf4\x65\x7d\x4f\x05\x13\x8b\xbc\x72\x10\xe6\xb8\x69\x0b\x67\x1c\x52\x61\x65\xa9\xf0\xa4\x23\x09\x6a\xf3\xfa\xb5\xc9\x5f\xcc\x55\x63\xec\xa9\x22\x21\x73\x05\x24\x3e\xc4\x83\x98\xa8\xb8\x31\x8d\xfb\xc1\x7b\x15\x26\x58\x5c\x5c\xc2\xd3\xc5\xfd\x6c\xb8\x12\x37\xcc\x18\x2b\xf6\xe0\xd6\xb1\xe1\xa0\xfa\x08\x61\x1f\x96\x65\xed\x2f\xc3\x77\x58\x6b\x38\xb9\x8e\x2c\xfb\x07\xe8\x5e\x92\x1c\xc0\x30\x18\xe4\x2a\xdf\xe6\xca\x84\x97\x65\xfe\x9d\xb5\xfb\xe4\xe1\xd5\x84\x48\xd1\x49\xd1\xc0\xd7\x74\x69\x81\xda\x1d\xbd\xc9\x3f\x13\x77\x95\xbe\xe8\x96\x91\xb2\x9f\x58\xc4\xde\x0d\x00\x67\x32\x35\x0e\x76\x40\x1d\xc0\xae\x90\x21\x1e\x94\x47\x59\x5b\x01\xe7\x64\x40\x85\xf9\x06\x5e\xf1\xc1\x18\xc9\x69\x4f\x02\x87\x0c\xe2\xce\x2e\x33\x23\xd7\xbd\x37\x55\xc3\xab\x66\x98\xf4\xcb\xe6\x83\xa0\xc7\xa1\xe8\x35\x2b\x8f\x7b\x1c\xb9\xcb\xae\xc9\x68\x9c\xe9\xad\x7b\xa1\xdd\x7a\xdc\xeb\xca\xec\xa1\x8f\x52\xc0\x4e\x9b\xed\xeb\x60\x76\x30\xc6\xb1\x78\x76\xa9\x96\x32\x8a\x7a\x62\x2e\x21\x26\x21\x77\x06\x9e\xb1\x9d\xd6\xeb\x46\xdc\x44\x69\x03\xbe\x14\xce\x9c\x16\x74\xd9\x6b\x9e\x64\xf3\x1f\xc0\x3d\xa8\x10\x90\xff\xbc\x64\xa7\x2e\xba\xa0\xbe\x09\xda\x42\xca\x95\xab\x10\xed\x97\x2f\xea\x6a\x71\xba\x74\x4d\x6d\x21\x05\xd1\xcd\x92\x37\x0c\x2e\x45\xa2\x2c\xb8\x4a\x90\xb9\x1a\x56\x43\x43\x67\x0c\xee\xba\x52\x36\x9b\x31\xd6\x5b\xdd\x5b\xb7\x50\xdd\xec\xa2\x9e\x8b\x66\xe5\x9e\x8a\xb6\xab\x64\x97\x05\x57\xc9\xf5\xbf\xa1\x8d\x2b\x0a\xfa\xa4\x69\xe8\x76\xaf\x92\xb4\xe9\xf0\x38\xf4\x19\xd8\xca\x68\x93\xda\x34\x24\xc3\x16\xda\x61\xf8\xf6\x34\x18\xd7\x35\x49\x26\x7e\xc0\x28\x36\x35\xb6\x1b\xc5\xaf\xe5\x84\xc3\x98\x36\x7b\x0c\xf3\x8c\x29\x62\xe7\x0a\xc0\x2c\xf9\x42\xaa\x99\x96\xf6\xc7\x6e\xae\x90\xb4\x12\xe2\xe4\x77\x52\xc2\x4b\xaf\xed\xab\x3f\xa5\x87\xe5\x90\xf9\x18\x9f\xb9\xbd\x4f\x3c\x3f\xbb\x74\x79\x93\x37\xa9\xd4\xc7\xcb\x28\x00\x11\x2c\xbd\xad\x17\x6c\xbf\x8c\xda\xf2\xb9\x6b\x3e\x6d\x44\x05\x67\x10\x7a\x84\x37\xa2\x62\x53\xf7\x7c\xc2\xb4\xa1\x9b\xef\x69\xd5\x66\xd3\x65\xa2\x05\x8f\x07\x9c\xf2\x72\x30\x48\xb3\x03\x03\x43\xf6\x61\x0c\x42\x5e\xd8\x03\x83\x0c\x2e\x0f\x1e\x90\x57\xcd\x82\xd6\x76\x11\x63\x5e\xe7\xb5\x12\x36\xa9\x4e\x86\xb1\xdf\x38\x39\xcf\x2a\xdf\x02\xd2\x33\x33\x8f\x50\x58\x9e\x8d\x69\x17\xda\x33\xa8\x7c\xbf\x3d\x25\x68\x49\x76\x19\x9b\x10\xe4\xe0\xac\x4c\xd1\x19\xb6\x49\xb5\xb2\x45\xa3\xb4\xe8\xcf\x2c\xcc\xe1\xa0\x4d\x67\xff\x65\xed\xec\x56\xc8\x1b\xac\x30\xaa\x36\x59\xbd\x49\x87\xcb\x35\xbe\x93\x8b\xa4\x7f\x3a\x6b\x06\x0a\xe2\x04\xfb\x73\x9f\x2c\xd9\x07\x0f\x7c\xbf\x21\xbc\x5c\x37\xd3\x8e\x36\x28\x0c\x5e\x93\x8a\xfa\x39\x81\x7e\xe4\x66\x38\x75\xb6\xd8\xc3\x00\xcf\x67\x6e\x0e\x7d\xf6\xcd\xa2\x1d\x84\xd1\x65\xd8\x0e\xa6\x88\xdc\x37\x99\xf2\xa3\x87\xb7\x40\xd4\x25\xe7\xee\x18\x02\x57\x78\x8f\xd7\x9e\x6e\x35\xcf\x20\xf3\xf7\x13\x60\xb2\xcf\x3b\x57\x43\x9f\x34\xb1\xf8\x60\x10\xb7\xcf\x1e\x1e\x84\xba\xe3\xea\xe1\xae\x4f\x77\x35\xf1\xa7\xe8\x50\x50\xac\xd6\xad\x3d\xfa\x81\xdb\x4e\x78\x86\xcf\x57\x6c\x68\x7b\xea\x7d\xa6\xbc\x17\x94\x62\x09\x11\x3c\x7c\x35\xce\x6e\xcf\xcb\xe0\x2a\x16\x39\x09\xe0\x4d\x4b\xf3\xfd\x40\x57\x73\x2d\x2b\xee\xa9\x1d\x05\xff\xf7\xa7\x26\xf0\x33\x1c\xa8\xdd\x75\xec\x96\x15\x2a\x07\x3a\xe8\x5d\x12\x47\x28\x72\xb3\xc5\xf9\xb3\x03\xc6\xb9\x88\xfb\xed\x8a\xa4\xfe\xfe\x61\x85\xfc\x3f\x7a\x88\xdd\xe5\xfd\x6f\x35\x84\xe7\x26\x83\xc9\x5f\x74\x91\xbd\x1c\xe9\x06\x9f\xc7\x26\x49\x1d\x7f\x67\xc1\xf5\x77\xf0\x5c\xeb\xde\xd5\x1a\xba\x20\x41\x42\x3f\x39\x0f\x63\xf0\xf2\x04\xf9\x68\x81\x73\x48\x2d\x26\xfb\xb9\xed\x0b\x01\x71\x7f\xff\xa5\x80\xbd\x9e\x0a\x88\x01\x7c\x9d\x03\x30\xf4\x66\x80\xfd\xc4\xd7\x42\xf3\x32\x69\x57\x7f\x77\x4d\x34\x2b\x97\xfa\x3d\xff\xc4\x6b\x86\xa2\x80\xa1\xe7\x02\x21\x52\x77\x7c\x10\x84\x6b\x59\xf8\x02\x93\x49\xb2\xc2\xe7\x92\x9c\x95\x88\xcf\x78\xcb\x3d\xfc\xe5\x4c\xc6\x1a\x9f\x93\xbb\xbb\x52\x8b\x7f\xfb\x8d\xf4\x64\x16\x9f\x40\x66\x71\xf2\xfe\x79\x90\x62\xfa\x21\x32\x3a\x3b\xcb\x3d\x1c\x77\xc1\x94\x33\xdb\xc7\x3d\x1e\xfa\x2f\xad\x68\xda\x15\x29\x58\xa3\xf8\x9c\x17\x90\xbc\xd3\x5b\x16\x1c\x9c\xd7\x7d\x2e\x05\xa6\x7e\x2c\x57\x90\xf3\x48\xd4\xb2\x11\xed\xa2\x8b\xd5\x38\xe4\xc1\x51\xc5\x2b\x61\x6a\xc9\x78\xe3\xa3\x44\xa8\x96\x25\x32\x70\x48\x6d\x91\x6b\x5e\x77\x30\x42\xaa\x01\xb6\x1e\x90\x13\xdb\xd0\x65\x60\x7e\x0b\x93\x3f\xed\xda\x64\x32\x82\xbd\x43\x47\xa8\x70\x5f\x0b\xe5\xde\xb2\xef\xa1\xa0\xd1\xfd\x5c\xda\x01\xef\x45\x8e\x6b\x47\x43\xeb\xee\x79\xbd\xfb\xde\x64\x7b\xdb\x2d\x35\xf9\xf6\xd4\x3d\xfa\xe0\x82\x5d\x51\xce\x90\x49\x4f\x5b\xeb\x6d\x81\x0c\xb8\x97\x9d\x7f\xf8\x31\x6d\x17\xcb\xed\x91\xe3\x8e\x0b\xbf\x3d\x95\xa3\x5f\x8a\xe0\x7a\xd7\x38\x99\xa2\xde\xbe\xb8\xff\xf6\x9e\xa1\x1f\xab\x30\xaa\xf3\x0d\x42\x38\xe9\xd9\x66\x77\x43\x2b\xce\x3e\x9e\x44\xc9\x9a\x36\x58\xd9\x89\xad\x1b\x26\x59\xad\x5c\xd8\x4a\x63\xe2\xe1\xa6\x97\xd8\xa4\x5e\xe4\x93\xb6\xa6\xe1\xc4\x18\x91\x4c\xbb\x92\xda\x90\x24\xa5\x28\xda\x95\x03\x0e\xdc\x2c\x6a\xa5\x0d\x45\x51\x93\xcd\x72\xeb\xc5\xce\xce\xc8\x0a\x2f\xa3\xc3\xd9\xaa\xa9\xaa\xb6\x64\x4d\x14\x9b\xef\xee\xc1\xe9\xad\xe7\x08\x30\x5d\x34\xa2\x5d\xe3\x2f\x77\xfd\x62\x1a\xe6\xe7\x35\xf6\xb9\x3b\xde\xbd\x86\x06\xf7\xd1\x75\x72\xef\xce\x76\xfd\xdc\xc6\x1f\xa6\x93\xe6\x22\xfd\x46\x0a\x7f\xbc\x57\x7a\xc8\x1d\x44\xfb\x89\x2a\x12\x44\x94\xdd\xd9\x37\xa8\x52\x90\x59\xb5\x9d\x00\xc2\xe2\x05\x06\x02\xb7\x5f\xde\x4d\x34\xe9\x37\x10\xe5\x20\xb4\x2b\x79\x62\x53\x0d\xd2\x80\x9d\x79\xb2\xdf\xde\xaf\xf3\x15\xa2\xcd\x30\x19\x63\x0d\x00\xad\x16\x73\x31\xf1\x63\xf2\x97\x4c\x1c\x10\xc1\xbe\x0f\x58\x98\xea\xef\x4e\xe9\x9a\xce\x78\xc5\xd5\xd6\xca\xa2\xf8\x41\xc6\x42\xac\xb7\x8f\xba\x66\xd9\x37\x1f\x7d\x14\x60\xf9\x5f\x41\x0d\x35\x2e\xea\xe8\x00\x3c\x2f\xdc\x15\x29\x3a\x34\x20\xf1\xc9\x20\x61\x77\xfc\xbd\x50\x6c\xcd\x7a\x29\xea\xce\x81\x61\xbe\x62\xf6\x4f\x56\xa8\x74\xd2\x6f\xd8\x9c\x9c\xc4\xf3\x37\xd1\xa8\x47\xbd\xe4\x7b\x3c\xda\x3d\x17\x83\x59\x80\x57\x80\x93\x3f\x13\x73\xbc\x61\x51\xda\x9f\x6f\x3a\xf1\xbe\x17\xbf\x74\xac\x12\x87\xf9\x0c\xb3\x74\x16\xc5\x67\xe6\x13\x33\xf0\x1f\xca\x22\xda\x6a\xfd\x48\xee\x88\xe8\x75\x5b\xc6\xb0\x98\x7c\x12\x9e\xd0\xaa\xf2\x30\x66\xe8\xe2\xae\x86\x0d\xb4\xda\xfe\xcc\x0c\x60\xc7\xfc\x43\x39\xa0\xbc\xfe\x78\x01\xe1\x68\x75\xdb\xc5\x77\x48\x1c\xb0\xfa\x2f\xe8\x35\x93\xc4\x3d\x6e\x26\x19\xa4\xa8\xa2\x57\x86\xef\x56\x4a\x32\xe2\x35\x3e\x2e\x3f\x06\xa7\x0c\xca\x4e\x4c\xbb\xd3\xd0\x76\x76\x04\xed\x25\x29\x30\xa5\x2f\xf7\x7a\xfd\xbc\xad\x2a\x63\x5d\x21\xd8\x69\xe0\x99\x55\x95\xa7\x83\xfa\xab\x6d\xfc\x6c\xb3\x71\xfe\xce\xba\x8a\x91\xe4\x67\xe7\xfa\x46\x5f\xc3\x78\xde\x77\x63\x7c\x96\x38\x3d\x0e\x1e\x75\x60\xc9\x7d\x32\xfa\xfa\x3f\x3c\x80\xe3\x31\x79\xe4\x20\xc5\xe4\xc3\xfb\x5f\x50\xd6\x46\xcf\x12\x5e\x8f\x12\xf3\xe8\xec\x46\x5b\x80\xda\xd0\x85\x3a\xa8\x94\x34\xa2\xad\x4b\xd2\x88\x19\xaf\x09\xad\x16\xa2\xe1\x6a\xb9\x72\x10\x95\x30\x39\x1f\xe0\x5e\xc5\x87\x40\x4a\x98\x42\x8a\x92\xff\x1a\x9f\xbf\x24\x57\x54\x76\x9d\xfe\xb8\xe2\x2e\xbd\xb5\x64\x3c\x4a\xa5\xb7\x89\xf0\x89\x77\x0b\xce\xbc\x72\x3a\x26\x8f\x4f\x86\xa3\x59\x99\x97\xd9\x6d\x2e\x28\xdc\xb8\xaf\x98\x94\xe9\xbc\x35\x1f\xd9\xd9\xde\xf3\xbc\x2b\xfb\x97\x76\x14\xe5\xb2\x9d\xcf\x2b\x56\xe2\x4d\x42\x7c\xac\xd6\xae\x8f\x45\x33\xe7\x43\xe7\x9e\xa2\x42\x83\x3e\x40\x22\xeb\xb0\xf7\x93\x2e\x78\x66\xd5\xf3\xba\x2f\xea\x92\xbd\xb3\x0f\xe4\x93\x13\xef\x79\x35\x7b\xf6\x8a\x6f\x9d\xc9\x33\x0e\x3c\x09\xc9\x77\x3f\xb8\x3a\xa1\xc8\xc9\x1f\x22\xf8\x9b\x25\xaf\x58\x30\x02\x79\x74\xe0\x32\x44\xab\x9b\x45\xc4\xba\xba\xef\x3f\x8c\x73\xce\x30\x0e\x7c\x12\xfe\x7b\x9f\x3c\xcc\xac\x57\xd4\xe3\xcb\x3b\x41\x60\x00\x4f\xaa\xfd\xf5\x7c\x9f\x5e\x1e\xfd\x14\xc7\xd4\xc9\x0c\x7f\xf0\x11\xfb\xe9\x07\x5e\x6a\x42\x77\x07\xb9\xfe\x63\x74\x49\x4a\xf7\x13\x5b\x7a\x42\x74\xa7\x06\x06\xdc\x84\x88\x86\x40\x45\x6e\xf3\x23\x26\xca\xf2\x39\xd9\x30\x64\xfb\x85\x80\x7a\x2d\xe6\xe7\x8a\x6a\x41\x52\xb3\x5b\x51\x99\x98\x3b\xd7\x41\xf3\x43\x77\x65\xee\x9c\x3b\x5e\x33\xff\xc7\xbe\x33\xed\x53\x17\x0f\xea\x62\x3c\x10\x56\x76\xd7\xac\x24\x3c\x14\xe2\x2c\x29\x6b\x57\xf8\x40\x6c\xd4\x2f\x2e\x47\xfe\x36\xca\xac\x19\xc4\xf2\xd3\xef\x11\x3b\x21\xff\x4d\xe4\x44\x12\x8c\x38\x6e\x78\x7f\xe0\x89\xcf\x7c\xc7\xfb\x70\xe2\xdd\xf1\x6d\x77\x5c\xac\xeb\x02\x9d\xe1\xa9\xb2\x27\x5d\x0a\x38\x5c\x09\x31\xe1\x31\x6a\xef\x58\xaf\x59\xb3\x6a\xbb\x58\x0a\x6d\x1a\x23\x7f\x74\xe7\x56\xda\x2b\xe0\x73\x2e\x97\xac\x21\x5b\x88\x42\xe2\x0e\x06\x4f\x25\x50\x74\x49\x81\x36\x27\xa6\x7f\xc6\x38\x61\xa8\x9c\xba\x34\xae\x40\xa0\x72\x6d\x50\x41\x8e\x09\xea\x1e\x72\x44\x1e\x66\x92\x07\xdc\xb5\x17\xd8\x54\xac\xc2\x97\x69\x20\xf2\xb4\xa1\x6b\xa8\xe4\x37\x83\xf7\xbb\xe0\x71\xb9\x52\xd4\x5f\x04\xbc\x67\x1f\x9a\x6b\xda\xda\x1d\x08\x9a\x34\xf7\xca\x3e\x72\x43\xd5\x17\x12\x82\x3e\x3c\x78\x2f\x13\x39\x2e\xfc\x2e\xb9\x7d\xf6\x9a\x17\xd7\x1d\x95\x81\x59\x10\xe5\x2f\x21\xb3\x27\x6a\xfe\x80\x5c\xbe\x3a\x7b\x75\x8c\xa1\xc9\x9a\xf2\x1b\x46\xf8\x6a\x8d\x88\x50\x57\xfa\xaf\xae\x99\x26\x30\x6d\x38\xa4\x33\x49\x48\x29\x53\x7a\x0a\x70\xae\x79\x74\x44\x5a\x4c\x88\x85\x70\x79\xc3\x6e\x58\xa3\xf8\xac\x32\xd7\xd9\x1f\xa1\x8e\x79\x3c\xe2\xf7\x1f\x8e\x31\x36\x4f\x6f\x28\xaf\xf4\xf6\x48\xa2\xb3\x88\xf7\xcb\x76\x45\x4e\xfa\x01\xa5\x77\xc7\xb0\x9b\x65\xdf\x0e\xc8\xff\xb2\x67\xfa\x5c\xb3\x71\xe6\x66\x93\x5e\x32\xcd\x7b\xfc\xa7\x2e\xd6\xef\xd6\x54\x05\x64\x4c\x06\x55\x6c\xb5\xb6\x3c\xf3\x03\x0f\x1f\x41\xb7\x5f\xba\xdf\x3d\x0c\x73\x2d\xfd\x9f\xc9\x09\x80\x8e\xf2\x8a\xc8\x09\xe1\xc0\x92\xfd\x7b\x11\x40\xc5\x1b\xf1\x34\xb2\x7d\x0a\x8c\xb3\xfb\x77\x34\xba\x3b\x5b\xbc\x31\x09\x3a\x58\x4d\xb2\xf3\xd2\x34\x24\xed\x8e\x34\xa5\x36\xc3\x05\x46\x45\x0b\xbe\x76\xd7\x1c\x51\xda\x9a\x7d\x6e\x63\x98\x26\x6c\xef\x9d\x19\xc4\x5b\x75\xe1\x85\x82\x61\x58\x50\xb0\x90\x94\xda\xe4\x03\x45\x2f\xdd\xef\xe3\x63\xf2\x7f\x43\x19\x89\x88\xbf\x4f\x4c\xa0\x03\xf4\x7a\x37\xfc\x34\x50\xf1\xf8\xf6\x69\x94\x3b\x7c\x48\xae\x59\x18\xb5\xeb\x9e\x3b\xd5\x5d\x5c\x45\x7f\xaf\xe6\x44\x64\xf4\x9b\x35\xa2\xdd\xfa\xa0\x6b\xd8\x59\xaf\x71\xe2\x51\x10\x49\x09\x6f\x35\xc7\x51\x96\x98\x91\x1e\x1d\x85\xbd\x4d\xea\x57\xb7\x40\x09\xa5\x5c\xb5\x40\x88\x5b\x3a\xba\x74\x5f\x26\x41\xc7\xd3\x28\x2d\x72\x17\x5f\x9e\x3d\x7f\x46\x5e\x5b\xae\xab\xd5\xfe\xec\x69\x34\xbc\xee\xbf\xe3\xea\xb8\xc7\x94\x67\xcf\x9f\x79\x83\xdd\x82\x29\xb5\xfb\xed\xa3\xfb\xaf\xc1\x94\x71\xfa\xe1\xe1\x4c\xe9\x2f\x5a\xc7\x94\xf1\xe2\xec\xe0\xcd\xf2\x3a\x77\xd7\xbe\x0b\xf7\xa4\xfc\x68\x7b\x18\x4e\x8c\xd7\x26\x43\x24\x12\x97\xa9\xc3\x4b\x13\x41\xc2\x5c\x77\x35\xbf\x62\x36\x75\xda\x18\x6f\x5d\xf5\x3a\x88\x75\xf4\x87\x17\xb4\x04\x83\x3e\xee\x78\x63\x7c\x4c\x4c\x16\x4f\x3e\x51\x3c\x67\x1f\x0e\xa1\x6b\x1e\xd2\x81\x33\x58\xbc\x2c\x07\xf9\x39\xb6\xc8\x5f\x41\xeb\x01\xc4\xa7\xfe\x86\x2b\xd8\x1a\x4b\x9b\x99\x3a\xba\xe6\x89\xee\x19\x83\x0d\xa3\xed\xb0\x2b\xc4\xfc\x6a\x42\x96\x62\xa3\xf5\x2f\x54\x44\xa7\x92\xd0\xb2\x64\x25\x66\x07\xea\x1d\x45\xeb\xce\x58\x5b\x2f\x1a\x5a\x32\x83\x8d\x29\x7d\x27\xe1\xea\xd3\x9c\xb3\x0a\x6a\x43\xfa\x75\xbc\xd9\x9a\x36\x58\x47\x0d\xec\x12\xf7\x5c\x0b\x9e\xb8\xc9\x34\x92\x63\xa8\x9c\x49\x84\x3a\x26\xc9\x97\x3d\x34\xcf\x86\x02\x93\xce\xd9\x88\x60\xd2\xca\x9c\x15\x7a\xab\x75\xe9\x9f\x49\x9e\xfb\x37\x82\xbc\x9b\x92\xb9\x0a\xb2\xeb\xaa\x95\x46\xa5\x67\x99\xab\xe7\x2d\x4c\x63\xd5\xf4\xf1\x57\xfe\x5a\x10\xa1\xd2\xf4\xf3\xd1\x4f\x0a\x10\xf6\x15\x3a\xe8\x0b\x76\xf5\x93\x57\xb7\xcf\x52\xf4\x49\x7e\x8c\x31\xf9\xeb\x5f\xc9\x9c\x56\x26\x27\xc9\xa3\xef\x33\x16\x55\xb0\xec\xb9\xfe\x5e\xb1\xb9\x32\xfb\xb8\x64\x52\x35\x62\xeb\xe5\x7a\x7c\xe3\xb7\xa4\x4d\x58\x38\x61\xc3\x1a\x06\xcf\xeb\x14\xf8\x5a\x91\x24\x94\x94\xac\x60\xda\x79\xac\xf8\xaf\xae\xec\x02\xab\x15\xbf\xe9\x74\x0e\xf4\x85\xd4\x12\x57\xe8\xbb\x3b\xa2\xd6\x84\x05\x14\x99\x66\x6f\x87\xd0\xd4\xb0\x0b\x93\x10\x69\xb5\x73\xf0\x42\x76\x06\xad\x06\xde\xfa\x99\xe3\x41\x33\xaf\x15\xab\xa1\x28\xb8\x57\x07\xc2\xea\x33\x28\x2a\xc1\xeb\xb9\xf9\x5a\x6f\x2f\x07\x4e\x8a\xee\x8e\x60\x2d\x94\xad\x1d\xd1\x15\xbc\xf6\x00\xba\x4e\x50\x3f\xdf\x48\x0a\x9b\x97\x11\x50\xda\x26\x0e\xbb\x59\x69\x0f\xc8\x23\x8b\x2d\xa8\x68\x02\xb8\x58\xa5\x93\xd0\x7a\xbb\x12\x0d\xeb\xdb\xe1\x41\x15\x82\x63\xf2\xdd\x53\xfe\xae\x97\xc9\xb3\x1c\x87\x3d\x12\x9e\xd3\x2a\xb6\x83\x9d\xab\xb4\x40\x30\x2e\x6e\xab\x4c\x18\xd6\xe3\x35\x57\xae\x58\x43\x78\xcd\x30\x2d\xaa\x1d\xe5\xef\x0e\x37\x09\x13\x6b\x87\xdb\xda\xec\xac\x9e\x56\x99\x00\x28\x06\x03\xfd\x76\x43\xb5\x04\x2c\xa1\xfd\xf6\xbb\x2a\x94\xdf\xae\x7e\xf8\xc1\xd5\xc3\xb3\xb5\xc3\x07\xa3\xc8\xfb\x14\xd9\xee\xcd\x6f\xce\x10\x7d\x12\xaf\x6b\xa6\x7e\x76\x5a\x3b\x7b\x9f\x52\xd9\xf1\x4d\xd7\x9c\x55\x40\x4e\x8c\x25\x31\x4a\xb8\xeb\x63\xd2\xc5\xfb\xf9\xf0\x93\xa4\x7e\xe7\x58\x37\x43\xd9\x03\x41\x66\xf8\x3c\xf7\xed\x41\x60\x87\xb7\xc5\xd0\xaf\x69\x96\x12\x25\xba\x89\x00\x55\x66\xcb\x43\x3a\x01\xed\xdb\x6d\xd6\x9e\xc3\xa4\x47\xfd\xc5\x43\x53\x6f\x78\x0d\x4f\xb1\x14\xc2\xab\x0f\x05\xd6\x32\x02\x4b\x4d\x9d\x93\xd4\xfc\x09\x5d\x81\xd2\x65\x64\x0e\xb2\x4b\xc3\xe6\xa7\x03\x45\xce\x93\xc6\x97\x7c\xc5\xa4\xa2\xab\xb5\x15\x49\xa3\xa4\x46\xe8\x54\xd9\x36\xe3\xfb\xf1\x0e\x72\xe0\xbc\xf2\x4a\x91\x34\x97\xf4\x86\x8d\xfa\xe6\x3d\x21\x4a\xec\xb4\xd1\x06\xb2\x89\x4e\x87\xde\xc2\xe8\xef\xb6\xff\x43\x5c\xa8\xa0\xb4\xf5\xfd\x16\x71\x7c\x4d\xd5\x92\x9c\x64\x50\x7e\xe2\x7c\x0b\xd7\x6f\x69\x0b\x56\xef\xea\xeb\x2a\x5b\x87\xfd\xad\x73\xb3\xab\xbb\xf3\x3c\x02\x5e\x63\xeb\xe0\x75\xac\xf7\xb8\xbe\xc7\xe1\x65\x9f\x0f\xe4\x84\xbc\x8f\xe5\x57\x76\x09\x03\x70\xb8\x6e\x7d\x48\xc6\x2b\x96\x85\xf7\xe8\xc8\x24\x84\x1a\x47\xd1\x03\x19\xd3\x7b\x7c\x08\x38\x47\xcb\x00\x64\x6e\x29\xc6\xb9\xe3\x08\x78\xd9\xf1\x46\xff\xe5\x65\x00\x64\x13\x7e\x5c\x89\x40\xed\x57\xe9\xff\xcd\x2b\xa3\x50\xf3\x9c\xaa\xe0\xc2\xd6\xab\x9a\xbc\xa0\xbc\xae\x19\x86\x97\x2f\x99\x54\x35\x83\x27\x50\x58\x94\x48\x21\x4d\x05\x88\xe0\x39\x0a\xf3\xa6\x93\x99\xf8\x44\x5b\x85\x4b\x7b\x86\x1e\xa6\x0b\x3e\x78\x40\x9e\xa0\xc1\x0b\x1b\x6e\x42\x36\x06\x18\x99\x09\x13\x13\xa5\xe1\x78\xdd\xbb\x2e\x6e\xc2\x9c\x49\x52\xf1\xda\x54\xc9\x20\x6a\x29\x24\xf3\x3b\x58\xb4\xe8\xca\xe1\x14\x60\x80\x6f\xbf\xd5\xb2\xc5\xf2\x89\xf0\xfe\x13\x56\x93\x47\xc7\x50\x34\xda\xa6\x2e\x5b\x98\xad\x79\x16\xc6\x54\xb9\xc7\xc2\x30\x14\x42\xc5\xde\xb5\xc2\xad\x54\x6c\x45\x8a\x65\x5b\x5f\xfb\x03\x81\x41\x4c\xa1\xc6\x40\xb5\x35\xc7\xda\x25\xa9\x99\xda\xc0\xab\x36\x9a\x92\x36\x02\x45\x2b\x00\x27\x5a\xa5\xfd\x5f\x6e\xbe\x72\xb5\xe5\x57\xb4\xe6\x6b\x63\x3a\x87\xc9\x97\x7e\xad\xbd\xfe\xbc\x14\x9f\x76\x8e\x31\xb9\x94\x2d\x1b\xca\xf1\xca\xfc\xe2\x67\xb7\x1d\xb6\x05\xbc\x74\x98\x81\x31\x1f\x8f\xf2\x13\xca\x48\xe2\xc1\x44\xbb\x03\xb7\xce\x2f\x85\x17\x7c\x21\x5e\xb5\xc4\x7d\x36\x90\x5e\x86\x5f\x8a\x8f\x5c\x81\x24\x8f\x2a\xf3\xe5\x47\x12\x3c\x1e\xe2\xf1\x28\xc1\x3a\x43\xe6\xbe\x3c\xb5\x03\x29\xec\x72\x7c\x6e\x4d\x62\x1b\x98\xbb\x3d\x8d\xbd\x44\xa5\xe0\xdf\x8f\xa4\x6b\x07\xf6\xf1\x28\x45\x32\x43\xd2\xde\xcc\xaf\x70\xf0\x7c\x45\x34\x6d\xf9\xf7\xd7\x97\xde\xab\xaa\x7a\xd0\x1a\x8e\x04\x0f\xb9\x71\xbb\xd3\x6e\xeb\x26\x71\xf0\xc3\x34\x49\xb9\xf5\x1d\x0f\xd4\xa4\xe5\xd9\x0f\xb8\xf8\x4a\x8e\x7a\x9f\xd4\xc9\x5f\x70\x3d\x78\x98\x70\x9d\xfa\xc7\xfb\xd8\x5a\x25\xfe\xe7\x5f\xe1\x11\x9c\x9e\x6c\xfc\x94\xd5\x6c\xf0\xfc\xc3\x9d\xff\x1f\x00\x00\xff\xff\xaa\xac\xec\x49\xe8\xff\x00\x00"
-
-func epochsFlowepochCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_epochsFlowepochCdc,
-		"epochs/FlowEpoch.cdc",
-	)
-}
-
-func epochsFlowepochCdc() (*asset, error) {
-	bytes, err := epochsFlowepochCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "epochs/FlowEpoch.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xaa, 0xa3, 0xb1, 0x56, 0xda, 0x1d, 0x66, 0xb8, 0xfb, 0x59, 0x26, 0x1d, 0x92, 0x8b, 0x1f, 0xd4, 0x6b, 0xf9, 0xf, 0x2e, 0xb9, 0x1a, 0x76, 0x75, 0xc5, 0xf8, 0x12, 0x1, 0x19, 0x79, 0x48, 0xdd}}
-	return a, nil
-}
-
-var _testcontractsRetrievefraudulenttokenseventsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x97\x4b\x6f\xe3\xb6\x13\xc0\xef\xfe\x14\x83\x3d\xfc\xff\xb6\xe1\x47\x11\xf4\x01\x18\xc9\x22\xae\xd7\x01\x7a\x58\xa4\xc8\xa6\xb9\x2c\xf6\x30\xa6\x46\x16\x11\x89\x14\x48\xca\x5e\x63\x91\xef\x5e\xf0\x21\x5a\x92\xe5\x8d\x9b\xb6\x40\x7d\xd8\x0d\xc9\xe1\x3c\x7e\x9c\x19\x52\xbc\x28\xa5\x32\xf0\xee\x2e\x97\xfb\x47\xf9\x4c\xe2\xdd\x20\x4e\x55\x62\xcb\x37\x39\x75\xa6\xd7\x4f\x1f\xdf\x0d\x06\xf3\xf9\x1c\x56\x52\x18\x85\xcc\x40\x42\x65\x2e\x0f\x94\x80\x91\x60\x32\x02\x4d\x6a\xc7\x19\x01\x32\x26\x2b\x61\xec\x34\x15\xdc\x00\xed\x48\x18\x0d\xfb\x8c\x04\xa4\x0a\xab\xa4\xca\xc9\x2d\x3f\x93\xd0\x4e\x65\xaa\x64\xe1\x54\x7c\x20\x06\x57\xbf\x98\x6c\x02\x57\x3f\x5c\xfd\x04\x68\x0c\xb2\x67\x40\x45\xa0\xc8\x28\x4e\x3b\x4a\x40\x2a\x48\x48\x1b\x65\x4d\xbb\xdd\x8f\x19\xd7\xc0\xb5\xb5\x97\xe1\x8e\xc0\x28\x14\xba\x44\x45\x82\x1d\x60\xcf\x4d\xe6\x54\x23\x33\x5c\x0a\xdd\xeb\x29\x93\x45\xc1\x8d\x21\x02\x83\xcf\xe4\x7d\x32\x12\x14\x31\x29\x18\xcf\xc9\x6d\x3a\x75\x7d\x80\x8c\x91\xd6\x43\xcc\xf3\x11\xb0\x1a\xcb\x43\xf0\xf4\x2e\xca\x3b\x94\x7a\xed\x31\x7c\x1b\x0c\x00\x00\x9a\x5b\x73\x32\x80\x49\xc1\xc5\x27\x23\x15\x6e\xe9\x77\x34\xd9\x02\x1a\x03\xbf\xc5\x7a\xe5\x94\x38\xac\x86\x92\x33\x48\x3b\xbc\x1c\x5c\x14\x07\xc0\x24\x51\xa4\x35\xa0\x48\x40\x1b\xa9\x28\x01\x2e\xfa\x78\xfc\x5f\xc3\x0e\xab\xdc\x44\xab\xa9\x54\x90\xa3\xa1\x40\xbe\x72\x28\xe3\xea\x6d\x89\x0a\x0b\x30\x87\x92\x7e\x4b\x48\x18\x9e\x72\x52\x30\x85\xc7\x8c\xdc\x24\xf0\xe3\xac\x4c\xcf\xb0\xec\x28\xc3\xc2\x1d\x8c\x57\x12\x06\x32\xed\x89\x35\xc6\xd9\xd5\x60\xc3\x5e\x86\x88\x83\x9a\x30\x72\x40\xf6\x19\x67\x59\xbf\x2f\xb0\xa7\x26\xc0\x59\xd4\xdc\xfb\x73\xd9\xc7\x50\xc0\x86\x00\x61\x85\x09\x09\x46\x10\x2c\x4f\xec\xd4\xfd\xf2\x38\x94\x0a\x50\xc0\xfa\x38\x35\x3b\xc9\x06\x57\x2e\xd0\xcd\x9e\x3a\xab\x92\x61\x9b\xb3\xcd\x13\xc5\xc5\x76\x12\x28\x2d\xe0\x8f\x3b\xfe\xf5\xe7\x1f\x27\x4d\x00\xb5\xd0\xe8\x0d\x89\x14\x6b\xed\x58\xa5\xaf\xa5\xcb\x3f\x91\x10\xb6\xfe\x36\x0d\xeb\x6f\x4e\x90\xb6\x86\xd7\x41\x7f\xa8\xe5\x2f\x04\xdd\x60\xfa\x40\x5a\x56\x8a\xd9\x76\x81\x06\x30\xcf\xe5\x5e\x83\x14\xf9\xa1\xb7\xe9\xb8\x8a\x8f\x4d\xd2\x4a\xf8\x46\x79\xe2\xa7\xaa\xd5\x2e\xdd\x8e\xd0\x40\xe2\x41\x16\xdc\xf8\xae\x76\x36\x65\xbc\xe2\xb8\xab\xa9\x3b\xad\x84\xb3\x5f\xcb\x36\x5a\xd5\xdf\x4a\x34\xf8\x36\x68\x16\x89\x0b\xf1\xf2\x94\x6e\x8f\x8f\x16\xfd\xff\x1d\x8b\x8d\xc1\x28\x1a\x7d\x89\x7f\x5d\x08\x2b\x1e\xfb\x05\xb0\x82\xec\x5f\x67\x75\x11\x96\xf3\x09\xf8\x7d\x2c\xdd\xe0\x5f\x7c\x9e\x70\xc1\xcd\x70\xd4\xcc\x1a\x4d\x79\x3a\xeb\xde\x37\x70\x03\x73\xed\x87\xf3\x90\xa9\x4b\x9f\xa8\x2e\xeb\x9a\x39\x07\x2b\x45\x68\x6c\xb7\x13\xb4\x87\x4f\xa7\xc2\x31\x63\x3b\x26\xbd\xc8\x2c\x98\x99\x69\xdc\xd1\xf0\x7a\xca\xbc\x36\xb7\x73\x38\x9a\x80\x91\x8b\x7e\x17\x47\x2d\x27\xec\x42\xed\x43\x7c\xc6\xc0\x93\x6d\x44\x60\xab\x0f\x84\x14\x53\x6d\x50\x24\xa8\xfc\x7d\x87\x5b\x82\xd2\x86\x6a\x1f\x0a\x32\x4f\xce\xdc\x42\xf6\x67\x6f\x64\x2a\x4a\x73\xf0\xfa\xae\xa7\x47\x13\x33\xef\xef\x3a\xae\x0e\x5d\xf3\x7b\x3c\x94\xb4\x00\xfb\xef\xf5\xed\x51\xd6\x09\xbc\x1f\x8e\x46\x17\x91\x38\x5a\xf4\x14\xe2\x81\x1c\x1d\x8d\xaa\x9d\xd8\xa8\xff\x58\xca\x6a\x93\x73\x06\x0c\x4b\xdc\xf0\x9c\x9b\x43\xfd\x42\xf3\xd1\xb8\xee\xe4\xda\x12\x7d\x2d\xa5\x26\xdd\x54\x62\xc5\x12\x2a\xa5\xe6\xc6\xe6\xbb\xbb\xe9\xc1\x64\x4a\x56\x5b\x7f\x5d\x3e\x10\x23\xbe\x23\x05\x5c\x18\x52\x29\x36\x4e\xd9\x52\x53\x61\x79\x75\x34\x7e\xd3\x0e\x3a\xba\xc5\x49\x47\x02\x5c\xeb\x8a\xae\xff\x77\x42\xee\x02\x04\xbd\x64\x5b\x46\x1c\x0f\x9d\x0d\x4f\x7d\x9b\x00\x9a\x05\xcc\x3d\xb0\x3e\x1b\x75\xb4\xff\x12\xe9\x0d\xe6\x68\x9f\x0b\x29\xa7\x3c\x69\x61\xfe\x35\xac\xf4\x53\x0e\xfb\xfe\x8b\x90\x4f\x5c\x7b\x95\x71\x08\xf5\x0c\x62\x5b\xdf\xa8\x14\x3a\xb4\xee\xd9\xea\xf8\xac\xee\x97\xfd\x6f\x84\x1a\x91\xa0\xfd\x4a\xe2\xd2\xee\x5c\xc0\xed\xe7\xf5\xd3\xc7\x59\x78\x9c\xdd\xef\x05\x25\xa1\x5f\x7d\xb1\xb5\xfd\xf9\xcb\x45\xe5\xd9\xd0\xd8\xa9\x4f\x16\xa6\x1f\x65\x68\xde\xcd\x58\xc6\x30\x9d\x4e\xed\x57\x53\xca\xb7\x95\x72\x9e\xbb\x99\xf1\xbc\x11\x6f\x33\xf4\xb5\xd0\x95\x8f\x52\x91\xfd\xae\x11\xd2\xc0\xc1\x7e\x22\x40\x4f\x00\xf1\x09\x5f\x77\x3a\xdb\xe1\x5a\x20\x98\xc4\x6e\x83\xa7\x5d\x11\x45\x78\xda\x1f\xb4\xbd\x6c\x86\xf6\xe4\xc2\xfe\x11\xdc\xdc\x80\xe0\x79\xe7\x0a\xb3\x47\x75\xbf\x74\x3e\xa6\xb2\x12\xee\x93\xa2\xe5\xcb\x04\x42\x83\xb7\x9f\x1d\x21\x49\x8e\x95\x33\x1e\x57\xc2\x5e\x68\x26\xa7\x64\x3c\x6e\x14\x52\xcb\xc8\xf7\x4e\xc5\x1e\xac\xb7\xd0\x43\xa7\xbe\x4f\xea\x18\x5a\x5a\x03\x9c\xb7\x15\xd1\x99\x7c\x7a\x3f\xec\x35\xf5\x7a\xd9\xb4\x1c\x69\x97\x0c\xed\x8a\xb3\x0f\x9b\x97\xc1\xcb\x9f\x01\x00\x00\xff\xff\x85\x51\x44\x5c\xcc\x0f\x00\x00"
-
-func testcontractsRetrievefraudulenttokenseventsCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_testcontractsRetrievefraudulenttokenseventsCdc,
-		"testContracts/RetrieveFraudulentTokensEvents.cdc",
-	)
-}
-
-func testcontractsRetrievefraudulenttokenseventsCdc() (*asset, error) {
-	bytes, err := testcontractsRetrievefraudulenttokenseventsCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "testContracts/RetrieveFraudulentTokensEvents.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x70, 0x2b, 0x68, 0xb8, 0xec, 0x86, 0xb6, 0xbb, 0x89, 0xec, 0x2, 0xc6, 0x77, 0xd7, 0x11, 0x74, 0x70, 0xc7, 0x27, 0x27, 0xde, 0xba, 0x76, 0x46, 0xf3, 0x42, 0xd, 0x7b, 0x34, 0xfa, 0x3d, 0xbb}}
-	return a, nil
-}
-
-var _testcontractsTestflowidtablestakingCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x5b\x6f\x1b\xb9\x15\x7e\xd7\xaf\x38\xd0\x43\x6b\x05\x5e\x69\x37\xd9\x16\x85\x11\x35\x75\xed\xa6\x10\xd2\xf5\x06\x89\xd3\x7d\x58\x2c\x02\x6a\xe6\x8c\xc4\x9a\x22\xb5\x24\xc7\xb2\x60\xf8\xbf\x17\xbc\x0c\x67\x38\x77\xc5\x69\x9f\xe2\x17\xdb\xc3\xc3\x73\xe3\xb9\x7c\x87\x5c\xbc\x98\x4c\x00\x00\x6e\x51\xe9\xb7\x4c\x1c\x56\xd7\xb7\x64\xcd\xf0\xa3\x26\x77\x94\x6f\xfc\xda\x96\x2a\xa0\x0a\x08\x68\x54\x1a\x12\xc1\xb5\x24\x89\x06\x2d\xc0\xfc\x22\x0a\x08\x87\xcb\xf7\x2b\xc8\x84\xb4\x1b\x0c\x19\xe5\x1b\xd0\x5b\x04\x26\x92\xbb\xb5\x78\x00\xc2\x53\x50\x8e\x2b\xec\xa5\x78\x38\x06\x3e\x6a\x3e\x99\xc0\x8b\xc5\x64\x42\x77\x7b\x21\x35\x4c\xdf\xe6\x7c\x43\xd7\x0c\x6f\xc5\x1d\xf2\x69\xf9\x99\x89\x83\xff\xb4\x58\x80\xff\xfa\xf7\x5c\x72\x94\x90\x49\xb1\x83\xa9\xfb\x67\x3a\x99\x90\x24\x41\xa5\xce\x08\x63\xb3\x52\xdd\xa6\x79\xf0\xe8\x0c\x5c\xbc\x28\x7f\x60\x75\x0d\x96\xc6\x6a\x5c\x10\x5e\x89\xdd\x5e\x28\xaa\x11\x6e\x8f\x7b\x84\x6b\xcc\x28\xa7\x9a\x0a\xae\xe0\x45\xf5\x67\xe1\x19\x2e\x16\x70\x25\xb8\x26\x94\x2b\xa0\x3c\x13\x72\x47\x0c\x35\xe8\x2d\xd1\xc6\x95\x6a\x8f\x09\xcd\x68\x62\x7d\x08\x5c\xa4\x08\x94\x5b\x0d\xc3\x7e\xc1\xd9\x11\x18\xbd\x47\xc3\x01\xb4\x39\x83\xc2\x14\x4b\x53\xb5\x51\xa2\x12\xb9\x4c\x10\x6e\x44\x8a\x1f\x30\x11\x32\x2d\x6c\x2b\xd8\xdd\x6e\x11\x72\x4e\x7f\xcf\xd1\x58\x28\x32\x7b\x38\x46\x70\x44\xf5\x11\x35\x1c\xb6\xc8\xc3\xaa\x51\x36\x91\x48\x34\xa6\x81\xb0\x2a\x99\xa1\x06\x9a\x5e\xc0\x47\x2d\x43\xbc\x54\x45\x6a\xe3\x2e\x91\x59\x5e\x17\xd1\xea\x0f\xb0\x84\x44\x30\x86\x89\xf1\x4c\xb4\xf4\xd2\x2e\x71\x85\x5c\xe5\x2a\x5a\x79\x05\x4b\xc0\x07\x4c\xf2\xc6\x9e\x1f\x61\x09\xf7\x28\x8d\x53\x49\x63\xf1\x4f\xb0\xf4\x5a\xb7\x1a\x71\x4f\x24\x48\xc1\xf0\x02\x3e\xad\xb8\xfe\x4b\xd3\x0a\x92\xa6\x12\x95\x82\x5c\x61\x6a\xa2\x1c\x38\xea\x83\x90\x36\x45\xba\x18\x96\x24\x97\x6e\x77\xbb\x93\x8c\xa3\xf7\xf9\x9a\xd1\x04\xee\xf0\x78\x3a\xf3\x77\x78\x1c\xcd\xd8\xe7\x5f\x27\x57\xbf\xde\xca\xd2\xc4\xfb\x59\xf8\xcf\x7e\x09\xc7\x7e\x1e\x7d\xaf\x78\xf2\xdc\xa9\x62\xbe\xb8\xd0\x0f\x61\x75\xa0\x8c\xc1\x96\xdc\xa3\x55\x2c\xcb\x75\x2e\x11\x70\x2f\x92\xad\x8a\x98\x75\x7a\xf1\xbc\x83\xac\xa2\x7c\x4c\xd2\x34\x2e\x5e\xd7\xa6\xb4\xa8\x2b\xb1\xdb\x51\xad\x31\xbd\x80\xbf\x3d\x46\x85\x68\xfe\x6f\x92\x33\xfd\x14\xf6\xcc\xaa\x19\x66\x05\x20\xcb\xe6\x34\x85\x25\xd0\xb4\xb9\x60\x7d\xb0\xb4\xae\x68\x2e\x36\xac\x84\x65\xd3\xf2\xbe\x6d\xef\xf0\x18\x6d\x79\x87\xc7\x26\x79\xe9\x01\x58\x56\xdc\x11\x5b\x91\xa2\xd2\x52\x1c\xeb\xee\x08\x34\xce\x01\x4f\xd5\x58\x33\xee\xcc\x5d\x3f\x70\xa5\xc2\xfc\xb5\x41\x0d\x12\x49\xfa\x9d\xad\x63\xa6\x06\x02\x59\x8b\x5c\xfb\x72\xd7\x28\x61\xca\xf1\x30\x05\x6c\x65\x88\x1f\xc7\x94\x9b\x2e\x92\x6a\x32\x77\xd1\x74\xe7\xe7\xf0\x8e\x6a\x86\x74\x51\xb7\x24\x53\x17\xa9\xf3\xb5\xe9\x35\x26\xee\x3e\xbd\xa5\x0f\x7f\xfe\xb1\x87\x58\x13\x76\x7b\xda\x8e\x5a\x64\x8f\xa2\xff\xc4\xbd\x05\x27\xd1\x8f\x66\xff\x01\x0f\x44\xa6\x15\xf2\xa8\x78\x31\xaa\xb4\x69\x1b\x29\x32\xdc\x10\x2d\x24\xac\xae\x95\x2d\x15\xb6\x11\xda\x12\x22\xf6\x28\xcd\x52\xa7\xa0\xb0\x59\x5d\xc0\xaf\x26\x18\x5e\xbd\xfc\x6d\x98\x78\x75\x7d\x25\x72\xae\x51\xba\x00\x7a\xf5\x72\xd0\x90\xdf\x73\x54\x1a\xd3\x5b\xe1\x5d\x30\xe8\x01\x8b\x1d\x08\xfb\x05\xe9\x66\xab\x9d\x98\xaa\x03\x6c\xa9\x35\x26\xae\xae\x8b\xd0\xe9\x29\x36\x8e\xb0\xb3\xe0\xbc\x1c\x57\x6d\xa6\xbe\xc5\x4d\x87\xab\xcc\xf4\x0e\x8f\x2d\x64\x51\x75\xe9\xa0\xa9\x06\x3a\x2c\xe1\xfb\xf9\xf7\x6d\x34\xb5\xf8\xee\x26\x8c\xc2\xba\x9f\x2c\x44\xf3\x18\xb2\x21\x66\x45\xec\x76\x51\x95\x81\x07\x4b\xf8\xf5\xb7\x1e\x82\x10\x6c\x86\x55\xb7\xb8\x7a\x84\x75\x09\x8e\x02\x2b\x62\x19\x95\xed\x6a\x3c\x22\xd7\x54\x33\xdc\x21\x77\xc5\xf7\xe7\x22\xab\x02\x10\xfd\x50\x80\xcb\xb8\x83\x17\xe9\xe7\x50\xa9\x60\xaa\x01\x31\x3a\x11\xaa\x3d\x57\x59\x47\xa8\x9f\x02\x3a\x75\x79\x8e\x23\xd3\xbc\x0d\x7e\xda\x14\x2a\x17\x66\x95\x76\x02\x5d\xad\xba\xd2\xd3\xbc\x88\xaa\x3f\x66\x90\xe5\x1c\xf2\x7d\x4a\x34\xde\xd4\x13\xe8\xec\x33\x70\x3c\xd4\xfa\x48\x5d\x68\x9b\x20\x63\xf7\x65\x9a\x9a\xdd\xbe\x9c\x98\xde\x69\x6c\x57\x47\xa5\x71\x67\xfe\x73\x27\x9e\xe6\xb2\x18\xa9\x38\x3e\x68\x87\x95\x86\x15\xb6\x9b\x6f\xd0\x0d\x4e\x46\x4f\x27\xa5\x0b\xdd\x34\xea\x4c\x0c\x07\xba\x4c\xb0\x07\x1a\x0c\x30\x61\x42\x24\xba\xb1\x05\x6b\xed\x01\xd6\x79\x72\x87\x3a\xda\xbe\xce\xb5\xc5\x82\xfc\x8f\x1a\xd6\x88\x1c\x44\x96\xd1\x84\x12\xc6\x8e\xce\x80\xc6\xec\xd1\x61\x68\x21\xc4\x5b\x4b\x76\x26\xb9\x8a\x72\x1c\xd9\x76\xba\x01\x21\xe9\xff\x97\x06\x14\x42\x4e\x36\xc0\xd7\x08\x70\x3b\x2a\xb1\xb4\x46\x90\xb8\x13\xf7\x66\x70\x31\x43\x72\x7d\x06\x30\x9b\x7d\x5a\x23\x4f\xc3\x60\x78\x42\x88\x49\x27\x3a\x54\xd8\xd3\xb5\xb6\x8a\xe6\xbe\xb4\x11\xc6\xaa\xe3\x69\xc8\x7f\xe5\x3d\x69\xe7\xf2\x24\x54\x7d\x67\xe9\x79\x6c\x8f\x82\x03\x32\x66\x6f\x26\x18\x73\xe9\xd4\xbd\xd7\x48\x2b\x85\x52\x59\xc1\x0d\x23\x0a\x82\xd3\xfa\x92\xb1\xb3\x3e\x43\x7f\xa1\x7a\x9b\x4a\x12\x92\xdc\x1e\x85\xb6\x23\x79\x7b\x5a\x74\x0a\x3c\x78\x4e\xfd\xa1\xde\x95\xdf\xb5\x92\x64\xca\x67\x56\x5c\xab\xfc\x44\x7d\x23\x6a\x5e\x94\xcc\x49\x92\x18\xfe\x73\xa5\x85\x24\x1b\x9c\xaf\x85\x94\xe2\xf0\xfa\x0f\xe1\x4e\x66\xee\x76\xff\xf5\xcc\x58\x76\x01\x0b\x4f\xb8\xa8\x71\x9f\x45\xe2\xcd\xcf\x9b\x37\xb0\x27\x9c\x26\x67\xd3\x2b\x91\xb3\x14\xb8\xd0\xe0\xb8\xc3\xce\x29\x24\x31\x43\x89\x3c\xc1\xe9\x2c\xae\x4d\x12\x75\x2e\x39\xbc\xfe\xae\x6e\xc3\xdc\xec\xac\x39\xc6\xfd\x9e\x9d\x7e\x42\xb2\x23\xef\x07\x4f\xa8\x3f\x97\xbf\x9d\x50\xd7\x09\xd5\x0e\x68\x00\xb3\x5c\x17\xc9\xfa\xf3\x81\xa3\x6c\xd2\xfa\xb9\x32\x90\xf9\xe1\xb2\x17\x4d\x0c\xe0\xfe\x18\x9a\x7f\xe5\xb1\xeb\xa4\x99\xee\xd4\x19\xad\x31\x74\x8d\x61\x7f\xc2\x48\xd7\x39\x09\xf5\xcd\x36\xe7\xd5\xd9\xab\x70\x7e\xcf\xc0\x53\xa1\x6e\x19\x56\x2c\xeb\x9e\xb1\xe8\xa4\xa9\x61\xcc\x00\xf2\x7f\x9d\x2c\x46\x0e\x04\x71\xea\x44\x20\x5e\xac\xff\x83\x89\x2e\xb1\x7c\x39\x61\x9b\x82\x50\x5c\x36\x9b\x36\xe8\x2b\x4a\x60\xa1\x05\xec\x51\x66\x42\x06\x10\x01\xc4\xde\xdd\xaa\x7e\xb4\x1f\x52\xaf\x0e\xf8\xff\x41\x92\x6d\x45\xbe\x41\xfd\xa4\x36\x5b\x6c\x4d\x0b\x2f\xef\xad\xc7\x64\x6d\xe3\xf2\x36\xbe\xee\x2e\x79\x7b\x17\x50\x55\xd1\xc1\xff\x85\x06\x91\x8c\xcd\xff\xe6\xd0\xe1\x34\x39\x87\xe6\x0c\xdf\x1e\xd1\x6d\xf7\x85\x5d\x81\x5c\x6b\x5b\xde\xb9\xd8\x32\x41\xf4\x4e\x4f\x71\xd9\x74\x8d\xab\x30\xbe\x1c\x17\x5c\xab\x38\x71\x54\x30\x9b\x06\xd5\x1d\xc0\x41\xcf\xb6\xe1\x8b\x27\x81\x4e\x0d\x1d\x0e\x50\x5f\x4b\xc1\x67\x23\xfd\x0a\x64\x2e\x78\x06\x44\x3b\x6a\x58\x6c\xd3\xee\x19\x58\xfe\x4b\x21\x6e\x9b\x1a\xdf\x40\x6e\xf8\xf9\x1a\x10\x6a\xf0\x88\x06\x30\x6e\xdf\x11\x7d\x43\xb9\xcf\x3d\x22\x88\x3b\xf5\x25\x3f\xba\xaa\x92\x10\x0e\x89\x9b\x5f\xa9\x32\x3e\x4f\xdc\x13\xb2\x00\x89\x1b\xaa\x8c\x4a\xc4\x96\xfd\x9b\xe2\x3d\xc5\x6c\x5f\x69\xaf\x8f\xf2\x27\xeb\x3b\xb1\x7d\x58\x14\x29\xaa\xd0\xfa\x8f\x56\x82\xed\xfa\x40\x79\xd9\xe6\xab\xcd\x1f\xbc\xff\x1a\x1d\xde\x84\x00\x49\xd3\xf2\xbd\xb9\x7c\x1b\x6c\x7b\x17\xac\xbe\x09\x86\x8f\x23\xde\xf6\x06\xde\xf5\xfa\xde\xf4\xca\xb5\xf7\xe2\x7d\x73\xf9\x94\xe7\x3e\x13\xc6\xd1\xbd\x65\xc1\xa4\xeb\xb1\xac\x92\x75\x45\x74\x94\x47\xe5\xb9\xd4\x81\x58\x0c\x4e\xfa\xc0\x58\x14\x73\xfe\xc5\xad\x64\x6c\x11\x08\x4d\x67\x9d\xd3\x93\xab\xf3\x2e\x82\x6e\xf0\x10\x92\xbb\x01\xcb\x47\xba\xa8\xf0\x4e\x2b\xce\x1b\xf4\x50\x9b\x21\xa5\x4a\xc6\x96\x1f\x4a\x20\xe5\x7e\xcf\xea\x29\xf3\x4f\xd4\x2e\xda\x77\x94\xd3\x5d\xbe\xf3\x57\xa6\xa6\x99\x51\xe9\xc6\xc4\x4c\x34\xee\x75\xea\x3e\xd9\xa0\x0e\x82\x7f\x72\x8c\x3e\xba\x3b\xb9\xc0\xe6\x6c\x56\x54\xb6\x4a\x10\x78\x0b\x2c\x6a\xab\xd7\xa7\x44\xec\x8f\xaf\xdd\x8e\x46\x49\x0a\xea\xf8\xe2\xe6\x65\xc6\xa5\xe9\xcd\x9b\x00\xec\xbd\xc1\x16\x65\x7e\x76\x2d\xdd\x1e\xc3\x7b\x72\x14\x79\x28\xb9\xe7\xf0\xd9\x97\xf3\xab\xf8\x63\x42\x78\x4a\x53\xa2\xf1\x5f\x74\x47\xb5\xba\x80\x47\x9b\x8e\xc5\xf3\xd3\x53\x01\x4f\x9f\x26\x4f\x93\xff\x06\x00\x00\xff\xff\x71\xb0\x54\x6c\x16\x24\x00\x00"
-
-func testcontractsTestflowidtablestakingCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_testcontractsTestflowidtablestakingCdc,
-		"testContracts/TestFlowIDTableStaking.cdc",
-	)
-}
+//go:embed files
+var files embed.FS
 
-func testcontractsTestflowidtablestakingCdc() (*asset, error) {
-	bytes, err := testcontractsTestflowidtablestakingCdcBytes()
+// FS returns a read-only file system holding the embedded assets,
+// laid out like the directory tree they were copied from.
+func FS() fs.FS {
+	root, err := fs.Sub(files, "files")
 	if err != nil {
-		return nil, err
+		panic(err)
 	}
-
-	info := bindataFileInfo{name: "testContracts/TestFlowIDTableStaking.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8b, 0xef, 0x4c, 0xa0, 0xb4, 0xfd, 0xa6, 0xa0, 0x43, 0xcd, 0xd5, 0xf2, 0xbb, 0x0, 0xff, 0x6d, 0xbe, 0xf0, 0x43, 0xa2, 0x98, 0x66, 0x91, 0x1c, 0x50, 0x95, 0xe6, 0x0, 0x4e, 0x60, 0x62, 0x72}}
-	return a, nil
-}
-
-var _testcontractsTestflowscheduledtransactionhandlerCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x1a\x5b\x6e\x1b\x47\xf2\x5f\xa7\x28\xf3\x23\x21\xb3\x12\xa9\x00\x41\x80\x25\x2c\x7b\x19\x89\x8a\x09\x58\xa4\x40\x51\x0e\x0c\xc7\x08\x9a\x33\x35\x64\xc3\xcd\x6e\xa2\xbb\x47\x34\x11\xe8\x0c\xfb\xbf\x5f\x7b\x8c\x3d\xcf\x5e\x60\xaf\xb0\xa8\xee\x79\x3f\x28\x3a\x56\xb0\xc0\x0e\x60\x98\xea\xa9\xaa\xae\xf7\x8b\xe4\x9b\xad\xd2\x16\x3a\xd7\x42\xed\x16\x9a\x49\xc3\x02\xcb\x95\xbc\x0b\xd6\x18\xc6\x02\x75\xe7\xe4\x29\x88\x7b\xcb\x85\xa9\x80\xa9\x4f\x28\x0b\x47\xb1\x5c\xf1\xa5\xc0\xca\xf1\x0d\x5a\x16\x32\xcb\xde\x71\xdc\x99\xce\xc9\xc9\x60\x00\xff\xfe\xc7\x3f\xff\xf3\xaf\xbf\x03\xfc\x32\x9a\x4f\x27\xd3\x9f\x87\x70\x3f\xbd\x1b\x5d\x8f\xe1\x7a\x36\x87\xdb\xf9\xec\xea\xfe\x72\x31\x99\x4d\x13\x30\x42\x58\xac\xb9\x01\x6e\x80\xc1\x62\x7c\xb7\x80\xcb\xd9\x74\x31\x1f\x5d\x2e\x60\x36\x7d\xfb\x1e\x98\x0c\xc1\xac\x55\x2c\x42\x98\x8e\xdf\x8d\xe7\xb0\x44\x88\x0d\x86\xc0\x25\x6c\xb5\x0a\x63\x27\xc8\x8b\x8c\x4e\xa0\xa4\xd5\x2c\xb0\x44\x30\x44\xc3\x57\x12\x43\x30\x4a\xa0\xd8\x43\xa4\x34\x58\x34\x96\xcb\x15\xb4\x69\x02\xa2\x58\xba\x13\x26\xb8\xdd\x13\x59\xe2\x80\xa8\x32\x2e\x0d\xc4\xd2\xb0\x08\x81\x6f\xb6\x02\x37\x28\x2d\x23\x50\x03\x76\xcd\x2c\x04\x8e\x4b\x81\x2c\x04\xab\x40\x28\x63\x40\x45\x44\x2f\x34\xa0\x34\x18\x0c\x62\xcd\xed\x1e\x1e\x62\x21\x51\xb3\x25\x17\xdc\x72\x34\xfd\x93\xc1\x80\xee\xb9\x9a\xc1\x74\xb6\x80\xab\xf1\xed\xdb\xd9\x7b\x58\xbc\x99\xdc\x15\x54\x31\x87\x11\xdc\x4d\x6e\x26\x6f\x47\xf3\xfc\x74\x31\x83\x9b\xd1\x64\x3a\x1d\x7b\x80\xe9\xfb\xa2\x7e\xc7\xd3\x77\x93\xf9\x6c\x7a\x33\x9e\x2e\x88\xfa\xfd\xf4\xed\xf8\xee\x0e\xde\xcf\xee\x61\x34\x1f\xc3\xdd\xfd\x7c\x0c\xbf\xbc\x19\x2d\xb2\x93\xab\xd9\x64\xfa\xf3\x8b\x84\x97\x05\x1a\x4b\x1a\x4a\xd5\x12\x16\x54\xf5\x86\xc9\x90\x14\xe5\x2c\x66\x48\x13\x3c\xe2\x18\x3a\xcd\xe6\xea\x3f\x46\xd7\x27\x2c\x08\xd0\x98\x2e\x13\xa2\x97\x63\x1e\x73\xf7\xef\x27\x00\x00\x45\xf4\x07\xa6\xc1\xc4\x41\x80\x18\x96\x30\xcc\x10\x3e\xdc\x4f\xa4\xfd\xf1\x87\x8f\x27\x35\x24\x81\x16\x12\x92\x77\x56\x69\xb6\xc2\x5b\x66\xd7\x43\x28\xfc\x71\x08\xe7\x36\x5e\x0a\x1e\x78\x94\xfc\xb3\xc3\xa8\xa1\x69\x34\x2a\xd6\x01\xa6\xb8\xc3\x56\xad\xf4\x1b\xe5\x75\x04\x9b\x78\x91\x6c\x83\xc4\xb1\xe6\x72\xd5\x0a\x14\xa2\x09\x34\xdf\x12\xc9\x0c\x36\x03\xe6\x92\xdb\x6e\x91\xcc\x69\x13\x7c\x2f\x51\x7a\xfa\x18\x14\x51\x9f\xb0\xe0\xc2\xf1\x50\x7f\x59\x20\x02\x17\x45\x92\x19\xe8\x63\xb3\x58\x0f\x1c\x77\x14\x37\xb0\x42\xeb\x12\x4b\xb7\x37\x84\x0f\x8b\xfd\x16\x3f\x56\x98\xd0\x68\x63\x2d\xfd\xbb\x97\x05\xab\xbd\xea\xf6\x4e\xc1\x1d\xe6\x76\xc9\xcf\x4a\x49\xab\x7f\xc5\xcd\x56\xb0\xfd\xab\x6e\xef\xe3\x53\x8c\x11\x4f\x64\x48\xf1\x80\x84\xdb\xfd\xcd\xb1\x3a\x74\x54\x7b\x43\x18\xc9\xfd\x9d\xd5\x71\x60\x5f\x57\x75\xb5\xe3\x36\x58\x7b\xb9\xca\x6f\xe8\x09\x98\x41\x68\x90\x60\x58\x83\x2c\x88\x7c\x44\x94\xf4\xeb\xae\x7d\xe0\xee\x92\xa2\x9e\xeb\xea\x4a\x54\x34\xdf\xdc\x66\x8e\x83\x4c\x34\x22\x75\x1b\x31\xe8\xf1\xee\x9d\xf9\xec\x69\x2b\x60\xc9\xf3\xab\x6e\xdc\x8e\x66\xd7\xf1\x66\x29\x19\x17\xc3\x0a\x67\x6f\x16\x8b\xdb\x6b\x2e\xb0\x9d\x35\x7a\x62\x2d\x86\xd0\xe9\xb4\xc2\xf4\x1a\xdf\xd4\x4f\x43\x8c\x58\x2c\xec\x41\xcd\x49\x2e\x4a\xaf\x1f\x4f\xea\x9f\xb2\x0f\x83\xc1\x00\xf0\x33\x06\xb1\xc5\x82\x95\xd3\x23\x2a\x7c\x08\xb6\xf0\x42\xa8\x15\x0f\x4a\xd8\xae\x2a\x37\x90\x48\xeb\x2c\x28\x29\xf6\x80\x9f\xb9\xb1\x86\xea\xa6\x2b\x23\x55\xb2\x26\x2b\x19\x45\xd2\x54\x99\x43\x85\x46\x7e\x6b\x21\x54\xc0\xe4\xde\xae\xa9\xde\x6c\x90\x49\x2e\x57\x51\x2c\x60\x89\x86\x87\x68\x60\xcd\x1e\xe8\x0d\x83\x08\x77\x10\xf2\x28\x42\x8d\xd2\x3a\x27\x34\xc5\x5a\x55\x61\x1d\x41\xe3\x2a\x16\x69\x6d\x31\xc6\xbb\xad\xab\x7a\x7b\x60\xdb\x2d\x52\x71\xaf\x72\x3b\xb9\x72\x82\xac\xb1\xb9\x22\x01\xd3\x9a\xed\xab\x09\xa6\xb5\x1c\x8c\xbd\xee\x7a\xb9\x51\x28\x0d\xd5\x35\xda\xe5\xe1\x10\x7c\xa9\x3b\x05\x72\xc0\x62\x42\xaa\x66\xef\xc1\x00\x6e\x14\x29\xda\x69\xbb\xc8\xdc\x8e\x0b\x41\xea\x42\x30\x2e\xef\x3b\x52\x55\x5c\xbb\x56\xf1\x6a\x5d\x46\x0c\x98\xa4\xe6\x2c\x35\x55\x08\x3b\x6e\xd7\x64\x14\x47\x01\xec\x7e\x5b\xae\x11\x3c\xf2\xa5\x89\x59\xe6\x2b\x0c\x15\x09\x82\x64\xe6\x75\x52\x73\x1a\x92\xe5\x60\x00\x5c\x5a\x94\xbe\x45\x83\x88\x71\x11\x6b\x4c\x9a\x0f\x66\xb0\x86\xc0\xa3\xd2\x15\x17\xd0\x21\x9c\x4e\x03\x69\x7a\xb6\x4c\xf2\xa0\xdb\x29\x3a\xea\xaf\x5d\x1e\xf6\xdc\x45\x18\x76\xea\x21\xf7\x08\x28\x0c\x36\x5c\x13\x30\x19\x60\xeb\x45\x24\xfa\x86\x49\xb6\x42\x0d\x17\x47\xa5\xd4\xa5\xd2\x5a\xed\x6e\x3c\x4e\xb7\x39\x23\xa4\xf1\x96\x74\xcc\x4c\xec\xd8\xde\x38\xde\x61\x89\x01\x8b\x0d\x3a\xb7\x0c\x98\x10\x4b\x16\x7c\x22\x9b\x7d\x4b\x7a\x23\x4e\x81\x5b\xca\x77\x10\xc6\x4e\x04\xef\x60\xc5\x6a\x5d\x7c\x42\x34\x56\xab\x3d\xbc\x3c\x4b\x84\xe8\x7b\x22\xce\x09\x79\xd8\xaa\xa6\x66\x65\x0c\x06\x30\x12\x02\x94\x5d\xa3\xce\x22\x2e\x33\x69\x26\x4e\x12\x4d\x8d\x24\x8e\xd1\x60\x63\x34\xf6\x7d\x14\x77\x1b\x99\x3e\x69\xb6\x74\xea\xb7\x97\x6c\x5b\x74\xda\x4b\xb6\xf5\x5d\xfd\xfe\x25\x8b\xed\xfa\x88\x90\xfe\xe6\xf7\x2f\xe8\x02\x1f\x5f\x35\xc7\xc3\x22\x69\xb3\x93\xc8\xf3\x79\xae\x98\x8f\x7c\x20\x16\x8f\x6a\x64\x9e\xdf\x1d\x53\xbf\x48\xf3\x41\x73\x01\x5c\x7b\x6a\x97\x6c\x3b\x4c\x35\xda\x5c\x64\x7d\x42\xeb\x38\xa7\xa0\xcf\x9d\x66\x30\xcb\x37\x68\x2c\xdb\x6c\x87\xd4\x3c\x5e\xc6\x9a\xd2\xfc\x4f\x42\x05\x9f\xba\xbd\x7e\xf6\x12\xfe\x02\xdf\x9f\xf7\xcf\x9b\x49\x6c\x35\x57\x34\xa7\x1d\xe8\xd0\x6f\x13\x90\xfe\x1b\xbe\x5a\x37\x53\xc9\xc2\x67\x1c\x45\x4a\xdb\x34\x33\x77\xbf\x3f\x3f\x3f\xef\x35\xa3\x44\x88\x66\x08\x2f\xcf\x8e\xd1\xfe\x0a\xed\x35\xe2\xb5\x56\x9b\x77\x54\xf0\xbb\x6c\xa3\x62\x69\x87\xf0\x7d\xff\xbc\x6e\x8a\x5e\x93\x1b\xd7\x5d\x29\xcd\x7d\x47\x5c\x5f\xaf\x3e\x43\x98\xc8\x07\x26\x78\x98\x67\x7b\x5f\x57\xab\x7e\xc8\x43\x9f\x51\xfb\xae\xf9\xa3\x29\xf2\xd7\x2e\xa1\x90\x48\x74\xd2\xed\xf5\x79\x48\xf9\x3d\xe2\xa8\x7b\x95\x84\x5b\xed\x55\x1e\xeb\x33\x1d\x15\xc7\x40\x23\xb3\x98\x30\x4b\xc3\xc3\xdf\xca\x83\x23\xe4\xdd\xd0\xcb\xb3\x04\x3a\x1d\xcc\x92\x49\xc8\xa9\xa1\xea\x01\x29\x95\x79\x32\xcc\x75\x2a\x93\x52\x67\x9c\xf6\x45\x8c\x06\x52\x8e\x76\x0f\x2a\x2a\x97\x49\x52\x4a\xde\x80\xe4\x49\x2e\x91\x34\x91\x68\xf0\xdd\x77\x70\xf6\xdc\x8f\x97\x7d\x8e\x48\x93\xb6\xb4\x69\xf0\x95\x66\x75\xeb\x7a\x1e\x0f\x11\xec\x61\xab\x95\x45\x6f\x3d\x2e\xdb\x03\x22\x0b\xf2\xc4\x5c\xcf\xce\x3a\x7c\x37\x68\x1e\xdf\x33\x69\xfe\xc8\x1c\x5f\x45\x6e\x19\xe8\xbd\x45\x92\x86\x30\x4f\x22\x6e\xe9\x53\xd4\x97\xcd\x9a\x1f\x60\xd6\xe2\x66\x6b\xc1\x32\xbd\x42\x6b\xfa\x19\x89\x11\x04\x4a\x1a\x4b\xc0\x11\xd3\x67\x51\x6c\x5d\xfb\x92\x11\xe5\xc6\xaf\xb7\x8c\xef\x84\x7d\x5b\x95\x91\xa5\xdb\x54\x6c\x51\xd7\x92\xb9\xeb\x87\x3d\x3b\xf8\x99\x05\x16\x0c\x8d\xe6\x46\x28\xeb\xc2\x4e\xc5\x16\x42\xa4\x42\x47\x46\x56\xd2\x01\x2e\x29\x2f\xba\xbb\xfb\x8d\xea\xc9\xe4\x4a\x0d\xbd\xc8\xb3\xeb\xfd\x35\xff\xfc\xe3\x0f\x65\xd5\x64\x39\x0f\xd0\x25\x3d\x2f\x89\xf3\xae\x83\x5a\x3a\xee\xf2\x72\x22\xcd\x6f\xfe\x65\x8d\xae\x6f\x68\xb9\x63\xcd\x0c\x30\xa1\x91\x85\x7b\x58\x22\xca\xf4\x52\x0c\x73\xa3\xdc\x13\x9f\x56\xf9\x51\x24\x33\x5d\x33\x3d\x25\x03\x24\x58\xf6\xa0\x78\x08\x5c\x46\x5c\x72\x4b\x90\x41\xac\x0d\x57\xb2\xae\xca\x07\xa6\xeb\xd2\x8c\x52\x2e\x86\xf0\x93\x52\x22\x17\xa7\xea\x93\x7e\xcf\xb6\x61\x82\x07\x5c\xc5\x26\x0b\xda\x38\xe1\x39\x99\x97\x58\xce\x62\xb7\x47\x4e\x23\x95\x85\x25\x66\x64\x1d\x03\xa8\x3d\xce\x72\xbf\x65\xc6\xcf\x2d\x5b\xd4\x67\xce\x4b\x12\x93\x09\xbe\xe1\x36\xd7\xcc\xc4\x9a\xd2\xc6\xa3\x97\xaa\xc7\xcd\x6a\xb9\x21\xa5\xef\xdd\xd6\x7c\xb5\x3e\x4b\x0b\x68\x29\xf7\x27\x5e\x90\x11\x2e\xb8\xe7\x9a\x97\x3c\xbb\x24\x86\x10\x24\xbf\xb1\x34\x94\x70\x09\x91\xe0\xab\xb5\xad\xab\x38\xdb\xae\x55\xb5\xf7\x2c\x6b\xb6\x3f\x6d\x1f\xf5\xff\xb6\x70\x3a\x90\x8c\xbf\x6e\xf3\x44\xe3\x6a\x16\x01\x4b\xa4\x69\x5e\xe9\x61\x1e\xaa\x2a\xf5\xef\xa2\xf7\x54\x1c\xab\x8d\x70\xd5\xdf\x7c\x5a\xd7\x7c\xb5\xf2\xe1\x42\xf3\x54\x39\x04\xda\x3c\xb2\x81\xf8\xac\x98\x51\x8e\x4b\x1d\xd5\x87\x47\xf0\xe2\x18\xe5\xb7\xa7\x98\x96\xa9\x0b\x8e\x1c\x9b\x0e\x10\xbe\x00\xab\xe3\xfa\xcc\xfd\x25\xd4\x13\xe5\xcc\xab\x97\xb4\x4c\xb8\x8f\xcf\xed\xa3\x07\x56\x94\x5f\xbb\x4d\xfb\xe2\x15\xcf\x33\x6f\x78\x16\xa5\x0a\x66\xcb\x2b\x28\x04\x89\x0f\xa8\xdd\xb2\xcc\x45\xd0\x32\x2d\xe0\xfe\xcb\x35\xd7\x7d\x7c\x49\xbb\x5d\x55\xac\xeb\xbb\x6b\xd5\xec\x40\x03\x5e\x27\x50\xea\x88\xa9\x79\x6a\xac\x3d\x4f\x57\x9d\x42\xb5\x61\xa6\x54\x86\x9a\x4b\x8e\x4b\x01\xdc\x40\xe0\xe7\x47\xb1\xaf\x46\xba\xeb\x3b\x68\x9a\xa9\xb4\xcb\x11\xff\xec\xbe\x98\x14\x2c\xc0\xd3\x42\x4d\x73\x54\x8b\x9d\x88\x46\x83\xfa\x81\x34\x6d\x8d\x63\x2c\x23\x1b\xb0\x2d\x0b\xb8\xdd\x9f\xba\x16\x90\x92\x8f\x44\x43\x36\xc9\x04\x8e\x18\x17\xbe\x78\x27\x35\x9b\x5a\xf7\x0d\x69\x90\xc9\xd0\x4f\x71\xa6\x54\x22\xd3\xaf\xd6\xbc\xb5\xda\x03\xae\x60\x9c\xf2\x3e\xc0\xed\xc1\xab\x03\x7f\x61\x55\x0a\xd7\x5c\x86\x9e\x23\x69\xb9\x15\x18\x3a\x31\xfc\x2e\xa4\xa1\x01\x4c\x7a\x98\x8c\x02\x75\x48\xc5\x4d\xc0\x9f\xbe\x48\x79\x0d\x17\xa5\xc8\x25\x16\x9d\x96\x94\x70\x2d\x97\xf4\x22\xb3\x20\xa0\xb1\xba\x9f\x09\xc3\xd1\xf4\x8d\x2f\x6a\x34\xaf\x5e\x66\x28\xa6\x1b\x29\xed\x07\x07\x87\x79\xa0\x12\x56\x03\x35\xd9\x27\x05\x6e\x97\x94\x33\xd1\x2f\x68\xf0\x7f\xb5\x5c\xca\x6d\x42\xac\xb1\x6d\x0d\x60\xa9\x91\x7d\x7a\x3a\xff\x1d\xde\x05\x15\x2d\x5f\xb8\xf1\xf5\xeb\x74\x23\x71\xe9\xb6\x7f\xd4\xd0\x46\xe4\x67\x4c\x1e\xe9\x66\xc5\x4c\x90\x10\xee\x54\x76\x2f\x3e\x8b\x4a\x2e\xca\xc7\x85\x35\x92\x33\x67\xfb\x24\x54\xc6\xfb\xba\xdd\x51\x6d\x67\xd4\x7c\xb7\x7f\x5b\x46\x4d\x77\x47\x0e\xa3\x75\x39\x74\x5e\xdc\x0e\xf5\x0e\xa5\xf4\x15\xda\xbb\xa6\x6d\xa9\xeb\x7c\x93\xef\xd3\xeb\xb9\xdc\xdd\xde\xb8\x65\x6d\xb8\xab\x9c\x95\x2a\xd9\x65\x08\x07\x9d\xdc\xfd\x5e\xa5\x3f\xdb\x49\xd4\x87\xfc\xdc\x83\x25\x44\x1f\x5b\xf8\x4d\x83\x3c\x8d\x6b\xcf\xc9\xe1\x20\xfb\x43\xf7\xbf\xea\x46\x5a\x6d\xda\x5d\xc3\x43\x27\xb1\x52\x4c\x18\x25\x4b\x17\xc2\x22\xdd\xe2\xa7\x89\x9a\x22\xc4\xa0\xed\x34\x59\xb6\xac\xed\x36\x0f\xf1\x53\x3d\x15\xee\xec\x27\x40\x7d\x07\x51\xd0\xdd\x60\x90\x18\x0b\x18\x68\x74\x7b\x2c\xdf\xd6\x52\xf0\x3d\x38\x60\x57\x3f\xdd\x17\x49\xe9\xaf\x75\x28\x38\xc9\x47\x4b\x05\xc6\x03\x5f\x1c\x61\x86\xe2\x8f\x8f\xfa\x54\x78\x43\xcd\x76\x3d\xf8\xa6\xc2\x65\xaa\xe1\x41\x42\x64\x10\xa5\xef\xdd\xeb\x56\x45\x66\xf9\x25\x11\x2c\x23\xeb\x59\x2c\x2c\x21\x1b\xba\x17\x07\xd2\xdf\x25\x4c\x65\xaa\xf4\xff\xf7\x80\x99\x17\x35\x6d\xb6\x45\x9e\xfb\x25\x46\xb1\x3e\xb4\x07\x14\x5c\xc0\x87\x8f\x27\x65\xc0\x7a\xad\x81\x8b\x5c\x17\xd4\xd0\xd5\xf3\x7e\x23\x85\xbc\x27\x26\x02\x5b\xf7\x57\x1b\x7e\x99\xc0\x81\xba\x57\xe5\x25\x03\x7d\x8a\xa9\xf6\x8e\xbd\xc2\xdd\xd1\x14\xdb\xd3\x39\x5c\xc0\x0f\xbf\x9d\x9f\x9f\xa7\xff\xfa\xe7\x4f\x60\xfa\x64\x0c\x17\xf0\x57\x02\x7f\x02\xb8\x38\x38\x45\x4c\x24\xdf\x56\x3e\x9e\x3c\xc2\x7f\x03\x00\x00\xff\xff\x61\x23\xc2\x0c\xc3\x27\x00\x00"
-
-func testcontractsTestflowscheduledtransactionhandlerCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_testcontractsTestflowscheduledtransactionhandlerCdc,
-		"testContracts/TestFlowScheduledTransactionHandler.cdc",
-	)
+	return root
 }
 
-func testcontractsTestflowscheduledtransactionhandlerCdc() (*asset, error) {
-	bytes, err := testcontractsTestflowscheduledtransactionhandlerCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "testContracts/TestFlowScheduledTransactionHandler.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x20, 0xf8, 0x57, 0x87, 0x68, 0xd4, 0xee, 0x4b, 0x51, 0x44, 0x64, 0x2, 0x74, 0x21, 0xf7, 0x9b, 0x4c, 0xd5, 0xc0, 0xc9, 0xe7, 0x6a, 0x2a, 0xf4, 0x27, 0x4e, 0x55, 0x6c, 0xec, 0xd9, 0x33, 0xfd}}
-	return a, nil
+// validPath reports whether the given name is a valid path for the file system.
+// Backslashes are rejected, so that a name cannot be read as a Windows path by an overlay.
+func validPath(name string) bool {
+	return fs.ValidPath(name) && !strings.Contains(name, `\`)
 }
 
-// Asset loads and returns the asset for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
+// Asset returns the asset with the given name.
 func Asset(name string) ([]byte, error) {
-	canonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[canonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
-		}
-		return a.bytes, nil
-	}
-	return nil, fmt.Errorf("Asset %s not found", name)
+	return Read(nil, name)
 }
 
-// AssetString returns the asset contents as a string (instead of a []byte).
-func AssetString(name string) (string, error) {
-	data, err := Asset(name)
-	return string(data), err
-}
-
-// MustAsset is like Asset but panics when Asset would return an error.
-// It simplifies safe initialization of global variables.
+// MustAsset is like Asset, but panics if there is no asset with the given name.
+// It is only used with the names of assets that are known to be embedded.
 func MustAsset(name string) []byte {
-	a, err := Asset(name)
+	data, err := Asset(name)
 	if err != nil {
-		panic("asset: Asset(" + name + "): " + err.Error())
+		panic(err)
 	}
-
-	return a
+	return data
 }
 
-// MustAssetString is like AssetString but panics when Asset would return an
-// error. It simplifies safe initialization of global variables.
+// MustAssetString is like MustAsset, but returns the asset as a string.
 func MustAssetString(name string) string {
 	return string(MustAsset(name))
 }
 
-// AssetInfo loads and returns the asset info for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func AssetInfo(name string) (os.FileInfo, error) {
-	canonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[canonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
-		}
-		return a.info, nil
-	}
-	return nil, fmt.Errorf("AssetInfo %s not found", name)
-}
+// AssetNames returns the names of all the assets, sorted.
+func AssetNames() []string {
+	var names []string
 
-// AssetDigest returns the digest of the file with the given name. It returns an
-// error if the asset could not be found or the digest could not be loaded.
-func AssetDigest(name string) ([sha256.Size]byte, error) {
-	canonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[canonicalName]; ok {
-		a, err := f()
+	err := fs.WalkDir(FS(), ".", func(name string, entry fs.DirEntry, err error) error {
 		if err != nil {
-			return [sha256.Size]byte{}, fmt.Errorf("AssetDigest %s can't read by error: %v", name, err)
+			return err
 		}
-		return a.digest, nil
-	}
-	return [sha256.Size]byte{}, fmt.Errorf("AssetDigest %s not found", name)
-}
-
-// Digests returns a map of all known files and their checksums.
-func Digests() (map[string][sha256.Size]byte, error) {
-	mp := make(map[string][sha256.Size]byte, len(_bindata))
-	for name := range _bindata {
-		a, err := _bindata[name]()
-		if err != nil {
-			return nil, err
+		if !entry.IsDir() {
+			names = append(names, name)
 		}
-		mp[name] = a.digest
+		return nil
+	})
+	if err != nil {
+		panic(err)
 	}
-	return mp, nil
-}
 
-// AssetNames returns the names of the assets.
-func AssetNames() []string {
-	names := make([]string, 0, len(_bindata))
-	for name := range _bindata {
-		names = append(names, name)
-	}
 	return names
 }
 
-// _bindata is a table, holding each asset generator, mapped to its name.
-var _bindata = map[string]func() (*asset, error){
-	"Crypto.cdc":                                            cryptoCdc,
-	"FlowExecutionParameters.cdc":                           flowexecutionparametersCdc,
-	"FlowFees.cdc":                                          flowfeesCdc,
-	"FlowIDTableStaking.cdc":                                flowidtablestakingCdc,
-	"FlowServiceAccount.cdc":                                flowserviceaccountCdc,
-	"FlowStakingCollection.cdc":                             flowstakingcollectionCdc,
-	"FlowStorageFees.cdc":                                   flowstoragefeesCdc,
-	"FlowToken.cdc":                                         flowtokenCdc,
-	"FlowTransactionScheduler.cdc":                          flowtransactionschedulerCdc,
-	"FlowTransactionSchedulerUtils.cdc":                     flowtransactionschedulerutilsCdc,
-	"LinearCodeAddressGenerator.cdc":                        linearcodeaddressgeneratorCdc,
-	"LockedTokens.cdc":                                      lockedtokensCdc,
-	"NodeVersionBeacon.cdc":                                 nodeversionbeaconCdc,
-	"RandomBeaconHistory.cdc":                               randombeaconhistoryCdc,
-	"StakingProxy.cdc":                                      stakingproxyCdc,
-	"epochs/FlowClusterQC.cdc":                              epochsFlowclusterqcCdc,
-	"epochs/FlowDKG.cdc":                                    epochsFlowdkgCdc,
-	"epochs/FlowEpoch.cdc":                                  epochsFlowepochCdc,
-	"testContracts/RetrieveFraudulentTokensEvents.cdc":      testcontractsRetrievefraudulenttokenseventsCdc,
-	"testContracts/TestFlowIDTableStaking.cdc":              testcontractsTestflowidtablestakingCdc,
-	"testContracts/TestFlowScheduledTransactionHandler.cdc": testcontractsTestflowscheduledtransactionhandlerCdc,
-}
-
-// AssetDebug is true if the assets were built with the debug flag enabled.
-const AssetDebug = false
-
-// AssetDir returns the file names below a certain
-// directory embedded in the file by go-bindata.
-// For example if you run go-bindata on data/... and data contains the
-// following hierarchy:
-//
-//	data/
-//	  foo.txt
-//	  img/
-//	    a.png
-//	    b.png
-//
-// then AssetDir("data") would return []string{"foo.txt", "img"},
-// AssetDir("data/img") would return []string{"a.png", "b.png"},
-// AssetDir("foo.txt") and AssetDir("notexist") would return an error, and
-// AssetDir("") will return []string{"data"}.
-func AssetDir(name string) ([]string, error) {
-	node := _bintree
-	if len(name) != 0 {
-		canonicalName := strings.Replace(name, "\\", "/", -1)
-		pathList := strings.Split(canonicalName, "/")
-		for _, p := range pathList {
-			node = node.Children[p]
-			if node == nil {
-				return nil, fmt.Errorf("Asset %s not found", name)
-			}
-		}
-	}
-	if node.Func != nil {
-		return nil, fmt.Errorf("Asset %s not found", name)
-	}
-	rv := make([]string, 0, len(node.Children))
-	for childName := range node.Children {
-		rv = append(rv, childName)
+// Read returns the asset with the given name from the given overlay if it has one,
+// and from the embedded assets otherwise.
+// A nil overlay reads the embedded assets.
+func Read(overlay fs.FS, name string) ([]byte, error) {
+	if !validPath(name) {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrInvalid}
 	}
-	return rv, nil
-}
-
-type bintree struct {
-	Func     func() (*asset, error)
-	Children map[string]*bintree
-}
-
-var _bintree = &bintree{nil, map[string]*bintree{
-	"Crypto.cdc": {cryptoCdc, map[string]*bintree{}},
-	"FlowExecutionParameters.cdc": {flowexecutionparametersCdc, map[string]*bintree{}},
-	"FlowFees.cdc": {flowfeesCdc, map[string]*bintree{}},
-	"FlowIDTableStaking.cdc": {flowidtablestakingCdc, map[string]*bintree{}},
-	"FlowServiceAccount.cdc": {flowserviceaccountCdc, map[string]*bintree{}},
-	"FlowStakingCollection.cdc": {flowstakingcollectionCdc, map[string]*bintree{}},
-	"FlowStorageFees.cdc": {flowstoragefeesCdc, map[string]*bintree{}},
-	"FlowToken.cdc": {flowtokenCdc, map[string]*bintree{}},
-	"FlowTransactionScheduler.cdc": {flowtransactionschedulerCdc, map[string]*bintree{}},
-	"FlowTransactionSchedulerUtils.cdc": {flowtransactionschedulerutilsCdc, map[string]*bintree{}},
-	"LinearCodeAddressGenerator.cdc": {linearcodeaddressgeneratorCdc, map[string]*bintree{}},
-	"LockedTokens.cdc": {lockedtokensCdc, map[string]*bintree{}},
-	"NodeVersionBeacon.cdc": {nodeversionbeaconCdc, map[string]*bintree{}},
-	"RandomBeaconHistory.cdc": {randombeaconhistoryCdc, map[string]*bintree{}},
-	"StakingProxy.cdc": {stakingproxyCdc, map[string]*bintree{}},
-	"epochs": {nil, map[string]*bintree{
-		"FlowClusterQC.cdc": {epochsFlowclusterqcCdc, map[string]*bintree{}},
-		"FlowDKG.cdc": {epochsFlowdkgCdc, map[string]*bintree{}},
-		"FlowEpoch.cdc": {epochsFlowepochCdc, map[string]*bintree{}},
-	}},
-	"testContracts": {nil, map[string]*bintree{
-		"RetrieveFraudulentTokensEvents.cdc": {testcontractsRetrievefraudulenttokenseventsCdc, map[string]*bintree{}},
-		"TestFlowIDTableStaking.cdc": {testcontractsTestflowidtablestakingCdc, map[string]*bintree{}},
-		"TestFlowScheduledTransactionHandler.cdc": {testcontractsTestflowscheduledtransactionhandlerCdc, map[string]*bintree{}},
-	}},
-}}
 
-// RestoreAsset restores an asset under the given directory.
-func RestoreAsset(dir, name string) error {
-	data, err := Asset(name)
-	if err != nil {
-		return err
-	}
-	info, err := AssetInfo(name)
-	if err != nil {
-		return err
-	}
-	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
-	if err != nil {
-		return err
-	}
-	err = os.WriteFile(_filePath(dir, name), data, info.Mode())
-	if err != nil {
-		return err
-	}
-	return os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
-}
-
-// RestoreAssets restores an asset under the given directory recursively.
-func RestoreAssets(dir, name string) error {
-	children, err := AssetDir(name)
-	// File
-	if err != nil {
-		return RestoreAsset(dir, name)
-	}
-	// Dir
-	for _, child := range children {
-		err = RestoreAssets(dir, filepath.Join(name, child))
-		if err != nil {
-			return err
+	if overlay != nil {
+		data, err := fs.ReadFile(overlay, name)
+		if err == nil {
+			return data, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("cannot read overlay for %s: %w", name, err)
 		}
 	}
-	return nil
-}
 
-func _filePath(dir, name string) string {
-	canonicalName := strings.Replace(name, "\\", "/", -1)
-	return filepath.Join(append([]string{dir}, strings.Split(canonicalName, "/")...)...)
+	return fs.ReadFile(FS(), name)
 }
@@ -0,0 +1,85 @@
+package contracts_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+
+	"github.com/onflow/flow-core-contracts/lib/go/contracts"
+)
+
+const patchedFlowFees = `import "FungibleToken"
+
+access(all) contract FlowFees {
+    init() {
+        let admin <- create Administrator()
+        self.account.storage.save(<-admin, to: /storage/flowFeesAdmin)
+    }
+}
+`
+
+func TestOverlay(t *testing.T) {
+	env := templates.Environment{}
+	SetAllAddresses(&env)
+
+	embedded := contracts.FlowFees(env)
+	epoch := contracts.FlowEpoch(env)
+
+	sources := contracts.NewSources(fstest.MapFS{
+		"FlowFees.cdc": {Data: []byte(patchedFlowFees)},
+	})
+
+	// the overlay goes through the same import replacement and rewrites
+	expected := []byte(`import FungibleToken from 0x0A
+
+access(all) contract FlowFees {
+    init(adminAccount: auth(SaveValue) &Account) {
+        let admin <- create Administrator()
+        adminAccount.storage.save(<-admin, to: /storage/flowFeesAdmin)
+    }
+}
+`)
+
+	code, err := sources.Get("FlowFees", env)
+	require.NoError(t, err)
+	assert.Equal(t, expected, code)
+
+	source, err := sources.Source("FlowFees")
+	require.NoError(t, err)
+	assert.Equal(t, []byte(patchedFlowFees), source)
+
+	// the dependencies and the catalog describe the overlay
+	dependencies, err := sources.Dependencies("FlowFees")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"FungibleToken"}, dependencies.Imports)
+	assert.Equal(t, []string{"FungibleTokenAddress"}, dependencies.Fields)
+
+	order, err := sources.DeployOrder("FlowFees")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"FlowFees"}, order)
+
+	entry, err := sources.CatalogEntryFor("FlowFees", env)
+	require.NoError(t, err)
+	assert.Equal(t, expected, entry.Code)
+	assert.Equal(t, dependencies.Fields, entry.Fields)
+
+	// contracts that are not in the overlay are not affected
+	code, err = sources.Get("FlowEpoch", env)
+	require.NoError(t, err)
+	assert.Equal(t, epoch, code)
+
+	// the embedded contracts are not affected
+	assert.Equal(t, embedded, contracts.FlowFees(env))
+
+	code, err = contracts.Get("FlowFees", env)
+	require.NoError(t, err)
+	assert.Equal(t, embedded, code)
+
+	order, err = contracts.DeployOrder("FlowFees")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"FlowToken", "FlowStorageFees", "FlowFees"}, order)
+}
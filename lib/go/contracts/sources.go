@@ -0,0 +1,181 @@
+package contracts
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"sync"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+
+	"github.com/onflow/flow-core-contracts/lib/go/contracts/internal/assets"
+)
+
+// generators maps the name of every contract that can be retrieved with Get
+// and is not a core contract to the function that returns its code.
+var generators = map[string]func(env templates.Environment) ([]byte, error){
+	"FungibleToken":              withoutError(FungibleToken),
+	"FungibleTokenMetadataViews": withoutError(FungibleTokenMetadataViews),
+	"FungibleTokenSwitchboard":   withoutError(FungibleTokenSwitchboard),
+	"NonFungibleToken":           withoutError(NonFungibleToken),
+	"ViewResolver":               withoutEnvironment(ViewResolver),
+	"Burner":                     withoutEnvironment(Burner),
+	"MetadataViews":              withoutError(MetadataViews),
+	"CrossVMMetadataViews":       withoutError(CrossVMMetadataViews),
+}
+
+// coreGenerators maps the name of every core contract to the function that generates its code
+// from its source, the same way as the function of this package for the contract.
+// Only the contracts that are rewritten can fail to generate.
+var coreGenerators = map[string]func(code string, env templates.Environment) ([]byte, error){
+	"FlowFees":                      flowFees,
+	"FlowStorageFees":               replaceAddresses,
+	"FlowExecutionParameters":       replaceAddresses,
+	"FlowServiceAccount":            flowServiceAccount,
+	"FlowToken":                     flowToken,
+	"FlowIDTableStaking":            replaceAddresses,
+	"FlowClusterQC":                 unchanged,
+	"FlowDKG":                       unchanged,
+	"FlowEpoch":                     replaceAddresses,
+	"LockedTokens":                  replaceAddresses,
+	"StakingProxy":                  unchanged,
+	"FlowStakingCollection":         replaceAddresses,
+	"NodeVersionBeacon":             unchanged,
+	"RandomBeaconHistory":           unchanged,
+	"Crypto":                        unchanged,
+	"LinearCodeAddressGenerator":    unchanged,
+	"FlowTransactionScheduler":      replaceAddresses,
+	"FlowTransactionSchedulerUtils": replaceAddresses,
+}
+
+// withoutError adapts a contract function that cannot fail to the generator signature.
+func withoutError(generate func(env templates.Environment) []byte) func(env templates.Environment) ([]byte, error) {
+	return func(env templates.Environment) ([]byte, error) {
+		return generate(env), nil
+	}
+}
+
+// withoutEnvironment adapts a contract function without imports to the generator signature.
+func withoutEnvironment(generate func() []byte) func(env templates.Environment) ([]byte, error) {
+	return func(templates.Environment) ([]byte, error) {
+		return generate(), nil
+	}
+}
+
+// replaceAddresses generates a core contract by replacing the imports of its source.
+func replaceAddresses(code string, env templates.Environment) ([]byte, error) {
+	return []byte(templates.ReplaceAddresses(code, env)), nil
+}
+
+// unchanged generates a core contract without placeholder imports, which is its source.
+func unchanged(code string, _ templates.Environment) ([]byte, error) {
+	return []byte(code), nil
+}
+
+// FS returns a read-only file system holding the raw contracts embedded in this package,
+// laid out like the contracts/ directory of this repository, e.g. epochs/FlowEpoch.cdc.
+func FS() fs.FS {
+	return assets.FS()
+}
+
+// Sources reads the core contracts, either the ones embedded in this package,
+// or the files of an overlay that shadow the embedded contracts with the same path,
+// e.g. FlowIDTableStaking.cdc or epochs/FlowEpoch.cdc.
+//
+// An overlay lets patched contracts be tried out, e.g. in an integration test suite,
+// without regenerating the embedded assets.
+// The overlay files go through the same import replacement and rewrites as the embedded contracts,
+// and the dependencies, deploy order and catalog of the sources describe the overlay files.
+// The contract functions of this package always return the embedded contracts.
+type Sources struct {
+	overlay      fs.FS
+	dependencies func() (map[string]ContractDependencies, error)
+}
+
+// embedded are the sources of the contracts embedded in this package,
+// used by Source, Get, Dependencies, DeployOrder and Catalog.
+var embedded = NewSources(nil)
+
+// NewSources returns the sources of the core contracts in which the files of the given file system
+// shadow the embedded contracts with the same path.
+// Contracts that are not in the overlay are still read from the embedded contracts.
+// A nil file system returns the sources of the embedded contracts.
+func NewSources(overlay fs.FS) *Sources {
+	s := &Sources{overlay: overlay}
+
+	// the contracts are parsed once, so calling Dependencies repeatedly is cheap
+	s.dependencies = sync.OnceValues(func() (map[string]ContractDependencies, error) {
+		return loadDependencies(s.Source)
+	})
+
+	return s
+}
+
+// NewSourcesDir is like NewSources with the files of the given directory,
+// which is laid out like the contracts/ directory of this repository.
+func NewSourcesDir(dir string) *Sources {
+	return NewSources(os.DirFS(dir))
+}
+
+// Source returns the source of the core contract with the given name, e.g. FlowEpoch,
+// with its placeholder imports and without any rewrites.
+//
+// It returns an error wrapping fs.ErrNotExist if there is no core contract with the given name.
+func (s *Sources) Source(name string) ([]byte, error) {
+	filename, ok := contractFilenames[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown contract %q: %w", name, fs.ErrNotExist)
+	}
+
+	code, err := assets.Read(s.overlay, filename)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read contract %q: %w", name, err)
+	}
+
+	return code, nil
+}
+
+// Get returns the code of the contract with the given name, e.g. FlowEpoch,
+// the same way as the function of this package for the contract.
+// Besides the core contracts, the fungible and non-fungible token standard contracts can be retrieved,
+// which are never read from the overlay.
+//
+// Unlike the contract functions, which panic on a missing contract,
+// Get returns an error wrapping fs.ErrNotExist if there is no contract with the given name,
+// and a *TransformError if the rewrites of the contract do not apply to its source.
+func (s *Sources) Get(name string, env templates.Environment) ([]byte, error) {
+	if generate, ok := generators[name]; ok {
+		return generate(env)
+	}
+
+	generate, ok := coreGenerators[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown contract %q: %w", name, fs.ErrNotExist)
+	}
+
+	code, err := s.Source(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return generate(string(code), env)
+}
+
+// Source returns the source of the embedded core contract with the given name, e.g. FlowEpoch,
+// with its placeholder imports and without any rewrites.
+//
+// It returns an error wrapping fs.ErrNotExist if there is no core contract with the given name.
+func Source(name string) ([]byte, error) {
+	return embedded.Source(name)
+}
+
+// Get returns the code of the embedded contract with the given name, e.g. FlowEpoch,
+// the same way as the function of this package for the contract.
+// Besides the core contracts, the fungible and non-fungible token standard contracts can be retrieved.
+//
+// Unlike the contract functions, which panic on a missing contract,
+// Get returns an error wrapping fs.ErrNotExist if there is no contract with the given name,
+// and a *TransformError if the rewrites of the contract do not apply to its source.
+func Get(name string, env templates.Environment) ([]byte, error) {
+	return embedded.Get(name, env)
+}
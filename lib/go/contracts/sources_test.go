@@ -0,0 +1,82 @@
+package contracts_test
+
+import (
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+
+	"github.com/onflow/flow-core-contracts/lib/go/contracts"
+)
+
+func TestFS(t *testing.T) {
+	fsys := contracts.FS()
+
+	require.NoError(t, fstest.TestFS(fsys, "FlowToken.cdc", "epochs/FlowEpoch.cdc", "testContracts/TestFlowIDTableStaking.cdc"))
+
+	code, err := fs.ReadFile(fsys, "epochs/FlowEpoch.cdc")
+	require.NoError(t, err)
+	assert.Contains(t, string(code), `import "FlowIDTableStaking"`)
+}
+
+func TestGet(t *testing.T) {
+	env := templates.Environment{}
+	SetAllAddresses(&env)
+
+	for _, name := range contracts.ContractNames() {
+		code, err := contracts.Get(name, env)
+		require.NoError(t, err, name)
+		GetCadenceContractShouldSucceed(t, string(code))
+	}
+
+	// Get generates the core contracts the same way as their functions
+	functions := map[string][]byte{
+		"FlowFees":                      contracts.FlowFees(env),
+		"FlowStorageFees":               contracts.FlowStorageFees(env),
+		"FlowExecutionParameters":       contracts.FlowExecutionParameters(env),
+		"FlowServiceAccount":            contracts.FlowServiceAccount(env),
+		"FlowToken":                     contracts.FlowToken(env),
+		"FlowIDTableStaking":            contracts.FlowIDTableStaking(env),
+		"FlowClusterQC":                 contracts.FlowQC(),
+		"FlowDKG":                       contracts.FlowDKG(),
+		"FlowEpoch":                     contracts.FlowEpoch(env),
+		"LockedTokens":                  contracts.FlowLockedTokens(env),
+		"StakingProxy":                  contracts.FlowStakingProxy(),
+		"FlowStakingCollection":         contracts.FlowStakingCollection(env),
+		"NodeVersionBeacon":             contracts.NodeVersionBeacon(),
+		"RandomBeaconHistory":           contracts.RandomBeaconHistory(),
+		"Crypto":                        contracts.Crypto(),
+		"LinearCodeAddressGenerator":    contracts.LinearCodeAddressGenerator(),
+		"FlowTransactionScheduler":      contracts.FlowTransactionScheduler(env),
+		"FlowTransactionSchedulerUtils": contracts.FlowTransactionSchedulerUtils(env),
+	}
+	require.Len(t, functions, len(contracts.ContractNames()))
+
+	for name, expected := range functions {
+		code, err := contracts.Get(name, env)
+		require.NoError(t, err, name)
+		assert.Equal(t, expected, code, name)
+	}
+
+	code, err := contracts.Get("FungibleToken", env)
+	require.NoError(t, err)
+	assert.Equal(t, contracts.FungibleToken(env), code)
+
+	_, err = contracts.Get("FlowContractAudits", env)
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	assert.ErrorContains(t, err, `unknown contract "FlowContractAudits"`)
+}
+
+func TestSource(t *testing.T) {
+	code, err := contracts.Source("FlowToken")
+	require.NoError(t, err)
+	assert.Contains(t, string(code), "init()")
+	assert.Contains(t, string(code), `import "FungibleToken"`)
+
+	_, err = contracts.Source("FungibleToken")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
@@ -0,0 +1,179 @@
+package contracts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+// Replacement replaces every occurrence of an exact substring of a contract source.
+type Replacement struct {
+	// Old is the substring that is replaced
+	Old string
+	// New is the substring Old is replaced with
+	New string
+	// Count is the number of times Old is expected to occur in the source
+	Count int
+}
+
+// Transform is a patch of a contract source, made of replacements that are applied in order.
+type Transform struct {
+	// Name describes what the transform does, e.g. "FlowToken admin account"
+	Name string
+	// Replacements are the replacements of the transform
+	Replacements []Replacement
+}
+
+// TransformError is returned when a replacement of a transform
+// does not occur in the source the expected number of times.
+type TransformError struct {
+	// Transform is the name of the transform
+	Transform string
+	// Old is the substring of the replacement that did not match
+	Old string
+	// Expected is the number of times Old was expected to occur
+	Expected int
+	// Actual is the number of times Old occurred
+	Actual int
+}
+
+func (e *TransformError) Error() string {
+	return fmt.Sprintf(
+		"transform %q: expected %d occurrences of %q, found %d",
+		e.Transform,
+		e.Expected,
+		e.Old,
+		e.Actual,
+	)
+}
+
+// Apply applies the replacements of the transform to the given code, in order.
+//
+// Each replacement is checked against the code as left by the replacements before it.
+// If any replacement does not occur the expected number of times,
+// a *TransformError is returned and none of the code is returned,
+// so a partially patched contract can never be used.
+func (t Transform) Apply(code string) (string, error) {
+	for _, replacement := range t.Replacements {
+		count := strings.Count(code, replacement.Old)
+		if count != replacement.Count {
+			return "", &TransformError{
+				Transform: t.Name,
+				Old:       replacement.Old,
+				Expected:  replacement.Count,
+				Actual:    count,
+			}
+		}
+
+		code = strings.ReplaceAll(code, replacement.Old, replacement.New)
+	}
+
+	return code, nil
+}
+
+// ContractTransforms returns the transforms that are applied to the source of the core contract
+// with the given name when it is generated for the given environment, in order.
+// It returns nil for the contracts that are only rendered with their imports replaced.
+func ContractTransforms(name string, env templates.Environment) []Transform {
+	switch name {
+	case "FlowToken":
+		return []Transform{flowTokenAdminAccount}
+	case "FlowFees":
+		return []Transform{flowFeesAdminAccount}
+	case "FlowServiceAccount":
+		if env.FlowExecutionParametersAddress == "" {
+			return []Transform{flowServiceAccountWithoutExecutionParameters}
+		}
+	}
+	return nil
+}
+
+// flowTokenAdminAccount makes the FlowToken initializer store the admin resources
+// in an admin account passed as an argument, instead of the contract account.
+var flowTokenAdminAccount = Transform{
+	Name: "FlowToken admin account",
+	Replacements: []Replacement{
+		// Replace the init method storage operations
+		{
+			Old:   "self.account.",
+			New:   "adminAccount.",
+			Count: 6,
+		},
+		// Replace the init method admin account parameter
+		{
+			Old:   "init()",
+			New:   "init(adminAccount: auth(Storage, Capabilities) &Account)",
+			Count: 1,
+		},
+	},
+}
+
+// flowFeesAdminAccount makes the FlowFees initializer store the admin resource
+// in an admin account passed as an argument, instead of the contract account.
+var flowFeesAdminAccount = Transform{
+	Name: "FlowFees admin account",
+	Replacements: []Replacement{
+		// Replace the init method storage operations
+		{
+			Old:   "self.account.storage.save(<-admin, to: /storage/flowFeesAdmin)",
+			New:   "adminAccount.storage.save(<-admin, to: /storage/flowFeesAdmin)",
+			Count: 1,
+		},
+		// Replace the init method admin account parameter
+		{
+			Old:   "init()",
+			New:   "init(adminAccount: auth(SaveValue) &Account)",
+			Count: 1,
+		},
+	},
+}
+
+// flowServiceAccountWithoutExecutionParameters makes the FlowServiceAccount contract
+// read the metering parameters from its own storage, for networks
+// that do not have the FlowExecutionParameters contract.
+var flowServiceAccountWithoutExecutionParameters = Transform{
+	Name: "FlowServiceAccount FlowExecutionParameters fallback",
+	Replacements: []Replacement{
+		// Remove the import of FlowExecutionParameters
+		{
+			Old:   "import \"FlowExecutionParameters\"",
+			New:   "//import \"FlowExecutionParameters\"",
+			Count: 1,
+		},
+		// Replace the metering getter functions
+		{
+			Old:   "return FlowExecutionParameters.getExecutionEffortWeights()",
+			New:   "return self.account.storage.copy<{UInt64: UInt64}>(from: /storage/executionEffortWeights) ?? panic(\"execution effort weights not set yet\")",
+			Count: 1,
+		},
+		{
+			Old:   "return FlowExecutionParameters.getExecutionMemoryWeights()",
+			New:   "return self.account.storage.copy<{UInt64: UInt64}>(from: /storage/executionMemoryWeights) ?? panic(\"execution memory weights not set yet\")",
+			Count: 1,
+		},
+		{
+			Old:   "return FlowExecutionParameters.getExecutionMemoryLimit()",
+			New:   "return self.account.storage.copy<UInt64>(from: /storage/executionMemoryLimit) ?? panic(\"execution memory limit not set yet\")",
+			Count: 1,
+		},
+	},
+}
+
+// testFlowStakingCollectionPublic makes the private token functions
+// of the FlowStakingCollection contract public, for tests.
+var testFlowStakingCollectionPublic = Transform{
+	Name: "FlowStakingCollection public token functions",
+	Replacements: []Replacement{
+		{
+			Old:   "access(self) fun getTokens",
+			New:   "access(all) fun getTokens",
+			Count: 1,
+		},
+		{
+			Old:   "access(self) fun depositTokens",
+			New:   "access(all) fun depositTokens",
+			Count: 1,
+		},
+	},
+}
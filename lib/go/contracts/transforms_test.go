@@ -0,0 +1,103 @@
+package contracts_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+
+	"github.com/onflow/flow-core-contracts/lib/go/contracts"
+)
+
+func TestTransform(t *testing.T) {
+	transform := contracts.Transform{
+		Name: "test",
+		Replacements: []contracts.Replacement{
+			{Old: "self.account.", New: "adminAccount.", Count: 2},
+			{Old: "init()", New: "init(adminAccount: &Account)", Count: 1},
+		},
+	}
+
+	code, err := transform.Apply("init() { self.account.a(); self.account.b() }")
+	require.NoError(t, err)
+	assert.Equal(t, "init(adminAccount: &Account) { adminAccount.a(); adminAccount.b() }", code)
+
+	t.Run("count mismatch", func(t *testing.T) {
+		code, err := transform.Apply("init() { self.account.a() }")
+
+		var transformErr *contracts.TransformError
+		require.ErrorAs(t, err, &transformErr)
+		assert.Equal(t,
+			&contracts.TransformError{Transform: "test", Old: "self.account.", Expected: 2, Actual: 1},
+			transformErr,
+		)
+		assert.EqualError(t, err, `transform "test": expected 2 occurrences of "self.account.", found 1`)
+		assert.Empty(t, code)
+	})
+
+	t.Run("later replacement mismatch", func(t *testing.T) {
+		code, err := transform.Apply("init(a: Int) { self.account.a(); self.account.b() }")
+		require.ErrorContains(t, err, `expected 1 occurrences of "init()", found 0`)
+		assert.Empty(t, code)
+	})
+}
+
+func TestContractTransforms(t *testing.T) {
+	env := templates.Environment{}
+	SetAllAddresses(&env)
+
+	// the transforms apply to the embedded contracts
+	for _, name := range []string{"FlowToken", "FlowFees", "FlowServiceAccount"} {
+		_, err := contracts.Get(name, env)
+		require.NoError(t, err, name)
+	}
+
+	withoutExecutionParameters := env
+	withoutExecutionParameters.FlowExecutionParametersAddress = ""
+
+	code, err := contracts.Get("FlowServiceAccount", withoutExecutionParameters)
+	require.NoError(t, err)
+	assert.Contains(t, string(code), "//import \"FlowExecutionParameters\"")
+
+	// the transforms of each contract are the ones applied when it is generated
+	for _, environment := range []templates.Environment{env, withoutExecutionParameters} {
+		for _, name := range []string{"FlowToken", "FlowFees", "FlowServiceAccount", "FlowEpoch"} {
+			source, err := contracts.Source(name)
+			require.NoError(t, err, name)
+
+			transformed := templates.ReplaceAddresses(string(source), environment)
+			for _, transform := range contracts.ContractTransforms(name, environment) {
+				transformed, err = transform.Apply(transformed)
+				require.NoError(t, err, name)
+			}
+
+			code, err := contracts.Get(name, environment)
+			require.NoError(t, err, name)
+			assert.Equal(t, string(code), transformed, name)
+		}
+	}
+
+	assert.Empty(t, contracts.ContractTransforms("FlowServiceAccount", env))
+
+	assert.NotPanics(t, func() {
+		contracts.TESTFlowStakingCollection(fakeAddr, fakeAddr, fakeAddr, fakeAddr, fakeAddr, fakeAddr, fakeAddr, fakeAddr, fakeAddr)
+	})
+
+	t.Run("drifted source", func(t *testing.T) {
+		sources := contracts.NewSources(fstest.MapFS{
+			"FlowToken.cdc": {Data: []byte("access(all) contract FlowToken {\n    init(admin: &Account) {}\n}\n")},
+		})
+
+		_, err := sources.Get("FlowToken", env)
+
+		var transformErr *contracts.TransformError
+		require.ErrorAs(t, err, &transformErr)
+		assert.Equal(t, "FlowToken admin account", transformErr.Transform)
+
+		_, err = sources.Catalog(env)
+		require.ErrorAs(t, err, &transformErr)
+	})
+}
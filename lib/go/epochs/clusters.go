@@ -0,0 +1,159 @@
+package epochs
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+
+	"github.com/onflow/flow-core-contracts/lib/go/events"
+)
+
+// ErrTooFewCollectors is returned when there are less collector nodes than clusters.
+var ErrTooFewCollectors = errors.New("cannot have less collector nodes than clusters")
+
+// Rand is a source of random numbers, like revertibleRandom<UInt64> in Cadence.
+// A *rand.Rand of math/rand/v2 is a Rand.
+//
+// The contract draws from the random generator of the transaction that ends the staking auction,
+// which cannot be reproduced offline, so a preview with another source only shows
+// one of the possible cluster assignments.
+type Rand interface {
+	Uint64() uint64
+}
+
+// Shuffle returns a random permutation of the node IDs, like FlowEpoch.randomize:
+// a Fisher-Yates shuffle that draws one number from the source for every swap.
+// The given slice is not changed.
+func Shuffle(nodeIDs []string, source Rand) []string {
+	shuffled := make([]string, len(nodeIDs))
+	copy(shuffled, nodeIDs)
+
+	for i := len(shuffled) - 1; i > 0; i-- {
+		j := source.Uint64() % uint64(i+1)
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	}
+
+	return shuffled
+}
+
+// CollectorClusters splits the collector nodes into clusters like FlowEpoch.createCollectorClusters:
+// the node IDs are shuffled with the source and assigned to the clusters in round robin.
+// The weights are the initial weights of the nodes, and must include all the node IDs.
+func CollectorClusters(nodeIDs []string, weights map[string]uint64, numClusters uint16, source Rand) ([]events.Cluster, error) {
+	if len(nodeIDs) > math.MaxUint16 {
+		return nil, fmt.Errorf("%d collector nodes overflow the number of clusters", len(nodeIDs))
+	}
+
+	if len(nodeIDs) < int(numClusters) {
+		return nil, fmt.Errorf("%d collector nodes for %d clusters: %w", len(nodeIDs), numClusters, ErrTooFewCollectors)
+	}
+
+	return AssignClusters(Shuffle(nodeIDs, source), weights, numClusters)
+}
+
+// AssignClusters assigns the node IDs in the given order to the clusters in round robin,
+// like FlowEpoch.createCollectorClusters does after shuffling them.
+func AssignClusters(nodeIDs []string, weights map[string]uint64, numClusters uint16) ([]events.Cluster, error) {
+	if numClusters == 0 && len(nodeIDs) > 0 {
+		return nil, errors.New("cannot assign collector nodes to zero clusters")
+	}
+
+	clusters := make([]events.Cluster, numClusters)
+	for i := range clusters {
+		clusters[i] = events.Cluster{
+			Index:       uint16(i),
+			NodeWeights: map[string]uint64{},
+		}
+	}
+
+	for i, nodeID := range nodeIDs {
+		weight, ok := weights[nodeID]
+		if !ok {
+			return nil, fmt.Errorf("no weight for collector node %s", nodeID)
+		}
+
+		cluster := &clusters[i%int(numClusters)]
+
+		// a duplicate node ID replaces the weight it was first assigned with, like in the contract
+		if previous, ok := cluster.NodeWeights[nodeID]; ok {
+			cluster.TotalWeight -= previous
+		}
+
+		if weight > math.MaxUint64-cluster.TotalWeight {
+			return nil, fmt.Errorf("total weight of cluster %d overflows", cluster.Index)
+		}
+
+		cluster.NodeWeights[nodeID] = weight
+		cluster.TotalWeight += weight
+	}
+
+	return clusters, nil
+}
+
+// AuditClusters checks that the clusters, e.g. of an EpochSetup event, are a round robin assignment
+// of the given collector nodes with their initial weights, as created by FlowEpoch.createCollectorClusters
+// for any random order of the nodes: every node is in exactly one cluster, the clusters are indexed in order,
+// and the sizes of the clusters differ by at most one, with the larger clusters first.
+func AuditClusters(clusters []events.Cluster, weights map[string]uint64, numClusters uint16) error {
+	if len(weights) < int(numClusters) {
+		return fmt.Errorf("%d collector nodes for %d clusters: %w", len(weights), numClusters, ErrTooFewCollectors)
+	}
+
+	if len(clusters) != int(numClusters) {
+		return fmt.Errorf("%d clusters instead of %d", len(clusters), numClusters)
+	}
+
+	assigned := make(map[string]uint16, len(weights))
+
+	// the first clusters have one more node if the nodes cannot be split evenly
+	size := len(weights) / int(numClusters)
+	larger := len(weights) % int(numClusters)
+
+	for i, cluster := range clusters {
+		if int(cluster.Index) != i {
+			return fmt.Errorf("cluster %d has index %d", i, cluster.Index)
+		}
+
+		expectedSize := size
+		if i < larger {
+			expectedSize++
+		}
+
+		if len(cluster.NodeWeights) != expectedSize {
+			return fmt.Errorf("cluster %d has %d nodes instead of %d", i, len(cluster.NodeWeights), expectedSize)
+		}
+
+		var totalWeight uint64
+		for _, nodeID := range sortedNodeIDs(cluster.NodeWeights) {
+			weight := cluster.NodeWeights[nodeID]
+
+			expectedWeight, ok := weights[nodeID]
+			if !ok {
+				return fmt.Errorf("cluster %d has unknown node %s", i, nodeID)
+			}
+
+			if other, ok := assigned[nodeID]; ok {
+				return fmt.Errorf("node %s is in clusters %d and %d", nodeID, other, i)
+			}
+			assigned[nodeID] = cluster.Index
+
+			if weight != expectedWeight {
+				return fmt.Errorf("node %s has weight %d in cluster %d instead of %d", nodeID, weight, i, expectedWeight)
+			}
+
+			totalWeight += weight
+		}
+
+		if cluster.TotalWeight != totalWeight {
+			return fmt.Errorf("cluster %d has total weight %d instead of %d", i, cluster.TotalWeight, totalWeight)
+		}
+	}
+
+	return nil
+}
+
+func sortedNodeIDs(nodeWeights map[string]uint64) []string {
+	return slices.Sorted(maps.Keys(nodeWeights))
+}
@@ -0,0 +1,102 @@
+package epochs
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/events"
+)
+
+// sequence returns the given numbers in order, like a recorded revertibleRandom<UInt64>
+type sequence []uint64
+
+func (s *sequence) Uint64() uint64 {
+	n := (*s)[0]
+	*s = (*s)[1:]
+	return n
+}
+
+func TestShuffle(t *testing.T) {
+	nodeIDs := []string{"a", "b", "c", "d"}
+
+	// i = 3 swaps with 7 % 4 = 3, i = 2 swaps with 4 % 3 = 1, i = 1 swaps with 10 % 2 = 0
+	shuffled := Shuffle(nodeIDs, &sequence{7, 4, 10})
+	assert.Equal(t, []string{"c", "a", "b", "d"}, shuffled)
+	assert.Equal(t, []string{"a", "b", "c", "d"}, nodeIDs)
+
+	assert.Empty(t, Shuffle(nil, &sequence{}))
+	assert.Equal(t, []string{"a"}, Shuffle([]string{"a"}, &sequence{}))
+}
+
+func TestCollectorClusters(t *testing.T) {
+	nodeIDs := []string{"a", "b", "c", "d", "e"}
+	weights := map[string]uint64{"a": 100, "b": 100, "c": 50, "d": 100, "e": 20}
+
+	clusters, err := CollectorClusters(nodeIDs, weights, 2, &sequence{0, 0, 0, 0})
+	require.NoError(t, err)
+
+	// every draw swaps with the first node: b, c, d, e, a
+	assert.Equal(t, []events.Cluster{
+		{Index: 0, NodeWeights: map[string]uint64{"b": 100, "d": 100, "a": 100}, TotalWeight: 300},
+		{Index: 1, NodeWeights: map[string]uint64{"c": 50, "e": 20}, TotalWeight: 70},
+	}, clusters)
+	require.NoError(t, AuditClusters(clusters, weights, 2))
+
+	// any order is a valid assignment
+	clusters, err = CollectorClusters(nodeIDs, weights, 3, rand.New(rand.NewPCG(1, 2)))
+	require.NoError(t, err)
+	assert.NoError(t, AuditClusters(clusters, weights, 3))
+
+	_, err = CollectorClusters(nodeIDs, weights, 6, &sequence{})
+	assert.ErrorIs(t, err, ErrTooFewCollectors)
+
+	_, err = CollectorClusters([]string{"a", "x"}, weights, 1, &sequence{1})
+	assert.Error(t, err)
+
+	_, err = AssignClusters([]string{"a"}, weights, 0)
+	assert.Error(t, err)
+}
+
+func TestAuditClusters(t *testing.T) {
+	weights := map[string]uint64{"a": 100, "b": 100, "c": 50}
+
+	valid := func() []events.Cluster {
+		return []events.Cluster{
+			{Index: 0, NodeWeights: map[string]uint64{"a": 100, "c": 50}, TotalWeight: 150},
+			{Index: 1, NodeWeights: map[string]uint64{"b": 100}, TotalWeight: 100},
+		}
+	}
+	require.NoError(t, AuditClusters(valid(), weights, 2))
+
+	assert.Error(t, AuditClusters(valid(), weights, 3))
+	assert.ErrorIs(t, AuditClusters(nil, map[string]uint64{}, 1), ErrTooFewCollectors)
+
+	clusters := valid()
+	clusters[0], clusters[1] = clusters[1], clusters[0]
+	assert.Error(t, AuditClusters(clusters, weights, 2))
+
+	// the larger clusters come first
+	clusters = valid()
+	clusters[0].Index, clusters[1].Index = 1, 0
+	clusters[0], clusters[1] = clusters[1], clusters[0]
+	assert.Error(t, AuditClusters(clusters, weights, 2))
+
+	clusters = valid()
+	clusters[1].NodeWeights = map[string]uint64{"a": 100}
+	assert.Error(t, AuditClusters(clusters, weights, 2))
+
+	clusters = valid()
+	clusters[1].NodeWeights = map[string]uint64{"x": 100}
+	assert.Error(t, AuditClusters(clusters, weights, 2))
+
+	clusters = valid()
+	clusters[1].NodeWeights["b"] = 10
+	assert.Error(t, AuditClusters(clusters, weights, 2))
+
+	clusters = valid()
+	clusters[0].TotalWeight = 100
+	assert.Error(t, AuditClusters(clusters, weights, 2))
+}
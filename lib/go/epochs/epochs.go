@@ -0,0 +1,108 @@
+// Package epochs provides Go types and offline computations for the FlowEpoch contract.
+//
+// The views and target end times of upcoming epochs can be projected
+// from the config metadata and the timing config of the contract:
+//
+//	config, err := epochs.DecodeConfigMetadata(configValue)
+//	timing, err := epochs.DecodeTimingConfig(timingValue)
+//
+//	next, err := epochs.ProjectEpochs(current, config, timing, 1)
+//
+// Decoding never panics. A missing field or a field of an unexpected
+// Cadence type is reported as an error.
+package epochs
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-core-contracts/lib/go/events"
+)
+
+// Phase is a phase of an epoch, as stored in the FlowEpoch.EpochPhase enum.
+type Phase uint8
+
+// The epoch phases.
+const (
+	StakingAuctionPhase Phase = 0
+	EpochSetupPhase     Phase = 1
+	EpochCommitPhase    Phase = 2
+)
+
+func (p Phase) String() string {
+	switch p {
+	case StakingAuctionPhase:
+		return "STAKINGAUCTION"
+	case EpochSetupPhase:
+		return "EPOCHSETUP"
+	case EpochCommitPhase:
+		return "EPOCHCOMMIT"
+	default:
+		return fmt.Sprintf("Phase(%d)", uint8(p))
+	}
+}
+
+// ConfigMetadata is the FlowEpoch.Config struct, the configurable metadata of the epochs.
+type ConfigMetadata struct {
+	NumViewsInEpoch              uint64         `cadence:"numViewsInEpoch"`
+	NumViewsInStakingAuction     uint64         `cadence:"numViewsInStakingAuction"`
+	NumViewsInDKGPhase           uint64         `cadence:"numViewsInDKGPhase"`
+	NumCollectorClusters         uint16         `cadence:"numCollectorClusters"`
+	FLOWsupplyIncreasePercentage cadence.UFix64 `cadence:"FLOWsupplyIncreasePercentage"`
+}
+
+// TimingConfig is the FlowEpoch.EpochTimingConfig struct.
+// It defines the target end times of all the epochs from a reference epoch.
+type TimingConfig struct {
+	// Duration is the duration of each epoch, in seconds
+	Duration uint64 `cadence:"duration"`
+	// RefCounter is the counter of the reference epoch
+	RefCounter uint64 `cadence:"refCounter"`
+	// RefTimestamp is the end time of the reference epoch, in second-precision Unix time
+	RefTimestamp uint64 `cadence:"refTimestamp"`
+}
+
+// Metadata are the views of an epoch, as in the FlowEpoch.EpochMetadata struct.
+type Metadata struct {
+	Counter        uint64 `cadence:"counter"`
+	StartView      uint64 `cadence:"startView"`
+	EndView        uint64 `cadence:"endView"`
+	StakingEndView uint64 `cadence:"stakingEndView"`
+}
+
+// DecodeConfigMetadata decodes a Cadence FlowEpoch.Config struct.
+func DecodeConfigMetadata(value cadence.Value) (ConfigMetadata, error) {
+	var config ConfigMetadata
+
+	err := events.DecodeValue(value, &config)
+	if err != nil {
+		return config, fmt.Errorf("cannot decode epoch config metadata: %w", err)
+	}
+
+	return config, nil
+}
+
+// DecodeTimingConfig decodes a Cadence FlowEpoch.EpochTimingConfig struct.
+func DecodeTimingConfig(value cadence.Value) (TimingConfig, error) {
+	var config TimingConfig
+
+	err := events.DecodeValue(value, &config)
+	if err != nil {
+		return config, fmt.Errorf("cannot decode epoch timing config: %w", err)
+	}
+
+	return config, nil
+}
+
+// DecodeMetadata decodes the views of a Cadence FlowEpoch.EpochMetadata struct.
+func DecodeMetadata(value cadence.Value) (Metadata, error) {
+	var metadata Metadata
+
+	err := events.DecodeValue(value, &metadata)
+	if err != nil {
+		return metadata, fmt.Errorf("cannot decode epoch metadata: %w", err)
+	}
+
+	return metadata, nil
+}
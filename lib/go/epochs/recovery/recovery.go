@@ -0,0 +1,367 @@
+// Package recovery builds the arguments of the recover_epoch.cdc transaction,
+// which ends Epoch Fallback Mode with a recovery epoch, see FlowEpoch.Admin.recoverNewEpoch
+// and FlowEpoch.Admin.recoverCurrentEpoch.
+//
+// Build checks the input against the state of the contracts before encoding it,
+// so that an inconsistent recovery epoch is refused before the governance transaction is signed:
+//
+//	arguments, err := recovery.Build(env, input, state)
+//	tx.SetScript(templates.GenerateRecoverEpochScript(env))
+package recovery
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+
+	"github.com/onflow/flow-core-contracts/lib/go/epochs"
+	"github.com/onflow/flow-core-contracts/lib/go/events"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+// Input are the arguments of the recover_epoch.cdc transaction.
+type Input struct {
+	// EpochCounter is the counter of the recovery epoch: the current epoch counter + 1
+	// to start a new epoch, or the current epoch counter to overwrite the current epoch
+	EpochCounter   uint64
+	StartView      uint64
+	StakingEndView uint64
+	EndView        uint64
+	// TargetDuration is the target duration of the recovery epoch, in seconds
+	TargetDuration uint64
+	// TargetEndTime is the target end time of the recovery epoch, in second-precision Unix time
+	TargetEndTime uint64
+	// ClusterAssignments are the node IDs of each collector cluster
+	ClusterAssignments [][]string
+	// ClusterQCVoteData are the root QC votes of each collector cluster, in the order of the clusters
+	ClusterQCVoteData []events.ClusterQCVoteData
+	// DKGPubKeys are the public keys of the DKG participants, in the order of their DKG indexes
+	DKGPubKeys  []string
+	DKGGroupKey string
+	// DKGIDMapping maps the node IDs of the DKG participants to their DKG indexes
+	DKGIDMapping map[string]int
+	// NodeIDs are the IDs of all the nodes of the recovery epoch
+	NodeIDs []string
+	// UnsafeAllowOverwrite must be true to overwrite the current epoch
+	UnsafeAllowOverwrite bool
+}
+
+// State is the state of the contracts the recovery is checked against.
+type State struct {
+	// CurrentEpochCounter is the current counter of FlowEpoch
+	CurrentEpochCounter uint64
+	// Config is the config metadata of FlowEpoch, which defines the length of the DKG phases
+	Config epochs.ConfigMetadata
+	// Nodes are the staking info of the staked nodes in FlowIDTableStaking, by ID,
+	// of which Check uses the roles and initial weights
+	Nodes map[string]events.NodeInfo
+}
+
+// DKGKeyLength is the length of a hex-encoded DKG public key: a BLS public key of 96 bytes.
+const DKGKeyLength = 2 * 96
+
+// Build checks the input against the state with Check and returns the arguments of the transaction.
+func Build(env templates.Environment, input Input, state State) ([]cadence.Value, error) {
+	err := Check(input, state)
+	if err != nil {
+		return nil, err
+	}
+
+	return input.Arguments(env)
+}
+
+// Check returns the errors of all the checks the input fails.
+//
+// It runs the checks of the transaction and of FlowEpoch.Admin.recoverEpochPreChecks,
+// which abort the transaction if they fail, and refuses inputs that the contract accepts
+// but that are inconsistent: duplicate or unknown node IDs, clusters with nodes
+// that are not collector nodes of the recovery epoch, QC votes from nodes outside of their cluster,
+// signatures and DKG keys that are not hex-encoded, and a DKG ID mapping that does not index
+// the DKG public keys with consensus nodes of the recovery epoch.
+func Check(input Input, state State) error {
+	var errs []error
+	fail := func(format string, a ...any) {
+		errs = append(errs, fmt.Errorf(format, a...))
+	}
+
+	switch {
+	case input.EpochCounter == state.CurrentEpochCounter+1:
+	case input.EpochCounter == state.CurrentEpochCounter:
+		if !input.UnsafeAllowOverwrite {
+			fail("recovery epoch %d overwrites the current epoch, which must be explicitly allowed", input.EpochCounter)
+		}
+	default:
+		fail("recovery epoch %d is neither the current epoch %d nor the next one", input.EpochCounter, state.CurrentEpochCounter)
+	}
+
+	// recoverEpochPreChecks
+	err := epochs.ValidateEpochViews(input.StartView, input.StakingEndView, input.EndView, state.Config.NumViewsInDKGPhase)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	nodes := make(map[string]bool, len(input.NodeIDs))
+	for _, nodeID := range input.NodeIDs {
+		if nodes[nodeID] {
+			fail("node %s is listed more than once", nodeID)
+		}
+		nodes[nodeID] = true
+
+		node, ok := state.Nodes[nodeID]
+		if !ok {
+			fail("node %s is not staked", nodeID)
+		} else if node.InitialWeight == 0 {
+			fail("node %s has a weight of 0", nodeID)
+		}
+	}
+
+	if len(input.ClusterAssignments) != len(input.ClusterQCVoteData) {
+		fail("%d cluster assignments for %d cluster QC vote data", len(input.ClusterAssignments), len(input.ClusterQCVoteData))
+	}
+
+	// clusters
+	clusterOf := make(map[string]int)
+	for i, cluster := range input.ClusterAssignments {
+		if len(cluster) == 0 {
+			fail("cluster %d has no nodes", i)
+		}
+
+		for _, nodeID := range cluster {
+			if other, ok := clusterOf[nodeID]; ok {
+				fail("node %s is assigned to clusters %d and %d", nodeID, other, i)
+				continue
+			}
+			clusterOf[nodeID] = i
+
+			if !nodes[nodeID] {
+				fail("node %s of cluster %d is not a node of the recovery epoch", nodeID, i)
+			} else if node, ok := state.Nodes[nodeID]; ok && node.Role != events.CollectionRole {
+				fail("node %s of cluster %d has role %d instead of collector", nodeID, i, node.Role)
+			}
+		}
+	}
+
+	for i, voteData := range input.ClusterQCVoteData {
+		if voteData.AggregatedSignature == "" {
+			fail("cluster QC vote data %d has no aggregated signature", i)
+		} else if !isHex(voteData.AggregatedSignature) {
+			fail("the aggregated signature of cluster QC vote data %d is not hex-encoded", i)
+		}
+
+		voters := make(map[string]bool, len(voteData.VoterIDs))
+		for _, nodeID := range voteData.VoterIDs {
+			if voters[nodeID] {
+				fail("node %s voted more than once for the QC of cluster %d", nodeID, i)
+			}
+			voters[nodeID] = true
+
+			if cluster, ok := clusterOf[nodeID]; !ok || cluster != i {
+				fail("node %s voted for the QC of cluster %d without being assigned to it", nodeID, i)
+			}
+		}
+	}
+
+	// DKG
+	if input.DKGGroupKey == "" {
+		fail("the DKG group key is empty")
+	} else if !isDKGKey(input.DKGGroupKey) {
+		fail("the DKG group key is not %d hex characters", DKGKeyLength)
+	}
+
+	for i, key := range input.DKGPubKeys {
+		if !isDKGKey(key) {
+			fail("DKG public key %d is not %d hex characters", i, DKGKeyLength)
+		}
+	}
+
+	if len(input.DKGIDMapping) != len(input.DKGPubKeys) {
+		fail("%d DKG ID mappings for %d DKG public keys", len(input.DKGIDMapping), len(input.DKGPubKeys))
+	}
+
+	indexes := make(map[int]string, len(input.DKGIDMapping))
+	for _, nodeID := range slices.Sorted(maps.Keys(input.DKGIDMapping)) {
+		index := input.DKGIDMapping[nodeID]
+
+		if index < 0 || index >= len(input.DKGPubKeys) {
+			fail("node %s has DKG index %d out of the %d DKG public keys", nodeID, index, len(input.DKGPubKeys))
+		}
+
+		if other, ok := indexes[index]; ok {
+			fail("nodes %s and %s have the same DKG index %d", other, nodeID, index)
+		}
+		indexes[index] = nodeID
+
+		if !nodes[nodeID] {
+			fail("DKG participant %s is not a node of the recovery epoch", nodeID)
+		} else if node, ok := state.Nodes[nodeID]; ok && node.Role != events.ConsensusRole {
+			fail("DKG participant %s has role %d instead of consensus", nodeID, node.Role)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func isHex(s string) bool {
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
+
+func isDKGKey(key string) bool {
+	return len(key) == DKGKeyLength && isHex(key)
+}
+
+// Event returns the EpochRecover event the transaction emits for the input, e.g. to validate it with epochs.ValidateRecover.
+// The nodes are the staking info of the nodes of the input, by ID, and the random source is generated by the contract,
+// or is the random source of the current epoch if it is overwritten.
+func (input Input) Event(config epochs.ConfigMetadata, nodes map[string]events.NodeInfo, randomSource string) (events.EpochRecoverEvent, error) {
+	nodeInfo := make([]events.NodeInfo, len(input.NodeIDs))
+	for i, nodeID := range input.NodeIDs {
+		node, ok := nodes[nodeID]
+		if !ok {
+			return events.EpochRecoverEvent{}, fmt.Errorf("no staking info for node %s", nodeID)
+		}
+		nodeInfo[i] = node
+	}
+
+	dkgPhase1FinalView := input.StakingEndView + config.NumViewsInDKGPhase
+	dkgPhase2FinalView := dkgPhase1FinalView + config.NumViewsInDKGPhase
+	dkgPhase3FinalView := dkgPhase2FinalView + config.NumViewsInDKGPhase
+
+	return events.EpochRecoverEvent{
+		Counter:            input.EpochCounter,
+		FirstView:          input.StartView,
+		FinalView:          input.EndView,
+		ClusterAssignments: input.ClusterAssignments,
+		RandomSource:       randomSource,
+		DKGPhase1FinalView: dkgPhase1FinalView,
+		DKGPhase2FinalView: dkgPhase2FinalView,
+		DKGPhase3FinalView: dkgPhase3FinalView,
+		TargetDuration:     input.TargetDuration,
+		TargetEndTime:      input.TargetEndTime,
+		ClusterQCVoteData:  input.ClusterQCVoteData,
+		DKGPubKeys:         input.DKGPubKeys,
+		DKGGroupKey:        input.DKGGroupKey,
+		NodeInfo:           nodeInfo,
+		DKGIDMapping:       input.DKGIDMapping,
+	}, nil
+}
+
+// Arguments returns the arguments of the recover_epoch.cdc transaction, in order.
+// The input is not checked.
+func (input Input) Arguments(env templates.Environment) ([]cadence.Value, error) {
+	clusterQCAddress, err := common.HexToAddress(env.QuorumCertificateAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid FlowClusterQC address %q: %w", env.QuorumCertificateAddress, err)
+	}
+
+	stringArrayType := cadence.NewVariableSizedArrayType(cadence.StringType)
+
+	voteDataType := cadence.NewStructType(
+		common.NewAddressLocation(nil, clusterQCAddress, "FlowClusterQC"),
+		"FlowClusterQC.ClusterQCVoteData",
+		[]cadence.Field{
+			{Identifier: "aggregatedSignature", Type: cadence.StringType},
+			{Identifier: "voterIDs", Type: stringArrayType},
+		},
+		nil,
+	)
+
+	clusterAssignments := make([]cadence.Value, len(input.ClusterAssignments))
+	for i, cluster := range input.ClusterAssignments {
+		clusterAssignments[i], err = stringArray(cluster)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	voteData := make([]cadence.Value, len(input.ClusterQCVoteData))
+	for i, data := range input.ClusterQCVoteData {
+		signature, err := cadence.NewString(data.AggregatedSignature)
+		if err != nil {
+			return nil, err
+		}
+
+		voterIDs, err := stringArray(data.VoterIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		voteData[i] = cadence.NewStruct([]cadence.Value{signature, voterIDs}).WithType(voteDataType)
+	}
+
+	dkgPubKeys, err := stringArray(input.DKGPubKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	dkgGroupKey, err := cadence.NewString(input.DKGGroupKey)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := make([]cadence.KeyValuePair, 0, len(input.DKGIDMapping))
+	for _, nodeID := range slices.Sorted(maps.Keys(input.DKGIDMapping)) {
+		key, err := cadence.NewString(nodeID)
+		if err != nil {
+			return nil, err
+		}
+
+		pairs = append(pairs, cadence.KeyValuePair{Key: key, Value: cadence.NewInt(input.DKGIDMapping[nodeID])})
+	}
+
+	nodeIDs, err := stringArray(input.NodeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return []cadence.Value{
+		cadence.NewUInt64(input.EpochCounter),
+		cadence.NewUInt64(input.StartView),
+		cadence.NewUInt64(input.StakingEndView),
+		cadence.NewUInt64(input.EndView),
+		cadence.NewUInt64(input.TargetDuration),
+		cadence.NewUInt64(input.TargetEndTime),
+		cadence.NewArray(clusterAssignments).WithType(cadence.NewVariableSizedArrayType(stringArrayType)),
+		cadence.NewArray(voteData).WithType(cadence.NewVariableSizedArrayType(voteDataType)),
+		dkgPubKeys,
+		dkgGroupKey,
+		cadence.NewDictionary(pairs).WithType(cadence.NewDictionaryType(cadence.StringType, cadence.IntType)),
+		nodeIDs,
+		cadence.NewBool(input.UnsafeAllowOverwrite),
+	}, nil
+}
+
+// EncodeArguments encodes the arguments as a JSON array of JSON-Cadence values,
+// the format of the --args-json flag of the Flow CLI.
+func EncodeArguments(arguments []cadence.Value) ([]byte, error) {
+	encoded := make([]json.RawMessage, len(arguments))
+	for i, argument := range arguments {
+		value, err := jsoncdc.Encode(argument)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode argument %d: %w", i, err)
+		}
+		encoded[i] = value
+	}
+
+	return json.Marshal(encoded)
+}
+
+func stringArray(values []string) (cadence.Array, error) {
+	elements := make([]cadence.Value, len(values))
+	for i, value := range values {
+		element, err := cadence.NewString(value)
+		if err != nil {
+			return cadence.Array{}, err
+		}
+		elements[i] = element
+	}
+
+	return cadence.NewArray(elements).WithType(cadence.NewVariableSizedArrayType(cadence.StringType)), nil
+}
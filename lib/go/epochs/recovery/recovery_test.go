@@ -0,0 +1,227 @@
+package recovery
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/epochs"
+	"github.com/onflow/flow-core-contracts/lib/go/events"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+func testInput() Input {
+	return Input{
+		EpochCounter:   5,
+		StartView:      100,
+		StakingEndView: 149,
+		EndView:        169,
+		TargetDuration: 70,
+		TargetEndTime:  2000,
+		ClusterAssignments: [][]string{
+			{"c1", "c2"},
+			{"c3"},
+		},
+		ClusterQCVoteData: []events.ClusterQCVoteData{
+			{AggregatedSignature: "0a", VoterIDs: []string{"c1", "c2"}},
+			{AggregatedSignature: "0b", VoterIDs: []string{"c3"}},
+		},
+		DKGPubKeys:   []string{dkgKey("01"), dkgKey("02")},
+		DKGGroupKey:  dkgKey("03"),
+		DKGIDMapping: map[string]int{"s2": 1, "s1": 0},
+		NodeIDs:      []string{"c1", "c2", "c3", "s1", "s2"},
+	}
+}
+
+func testState() State {
+	return State{
+		CurrentEpochCounter: 4,
+		Config:              epochs.ConfigMetadata{NumViewsInDKGPhase: 2},
+		Nodes: map[string]events.NodeInfo{
+			"c1": {ID: "c1", Role: events.CollectionRole, InitialWeight: 100},
+			"c2": {ID: "c2", Role: events.CollectionRole, InitialWeight: 100},
+			"c3": {ID: "c3", Role: events.CollectionRole, InitialWeight: 100},
+			"s1": {ID: "s1", Role: events.ConsensusRole, InitialWeight: 100},
+			"s2": {ID: "s2", Role: events.ConsensusRole, InitialWeight: 100},
+			"e1": {ID: "e1", Role: 3, InitialWeight: 100},
+			"x":  {ID: "x", Role: events.CollectionRole, InitialWeight: 0},
+		},
+	}
+}
+
+// dkgKey returns a DKG public key of the repeated hex-encoded byte
+func dkgKey(b string) string {
+	return strings.Repeat(b, DKGKeyLength/2)
+}
+
+func TestBuild(t *testing.T) {
+	env := templates.Environment{QuorumCertificateAddress: "0x01"}
+
+	arguments, err := Build(env, testInput(), testState())
+	require.NoError(t, err)
+	require.Len(t, arguments, 13)
+
+	assert.Equal(t, cadence.NewUInt64(5), arguments[0])
+	assert.Equal(t, cadence.NewUInt64(2000), arguments[5])
+	assert.Len(t, arguments[6].(cadence.Array).Values, 2)
+
+	voteData := arguments[7].(cadence.Array).Values[0].(cadence.Struct)
+	assert.Equal(t, "A.0000000000000001.FlowClusterQC.ClusterQCVoteData", voteData.Type().ID())
+
+	var decoded events.ClusterQCVoteData
+	require.NoError(t, events.DecodeValue(voteData, &decoded))
+	assert.Equal(t, testInput().ClusterQCVoteData[0], decoded)
+
+	// the DKG ID mapping is sorted by node ID
+	mapping := arguments[10].(cadence.Dictionary)
+	assert.Equal(t, cadence.String("s1"), mapping.Pairs[0].Key)
+	assert.Equal(t, cadence.NewInt(1), mapping.Pairs[1].Value)
+
+	assert.Equal(t, cadence.NewBool(false), arguments[12])
+
+	encoded, err := EncodeArguments(arguments)
+	require.NoError(t, err)
+
+	for _, argument := range arguments {
+		_, err := jsoncdc.Encode(argument)
+		require.NoError(t, err)
+	}
+	assert.Contains(t, string(encoded), `{"value":"5","type":"UInt64"}`)
+
+	_, err = testInput().Arguments(templates.Environment{QuorumCertificateAddress: "not an address"})
+	assert.Error(t, err)
+}
+
+func TestCheck(t *testing.T) {
+	require.NoError(t, Check(testInput(), testState()))
+
+	// overwriting the current epoch must be allowed explicitly
+	input := testInput()
+	input.EpochCounter = 4
+	assert.Error(t, Check(input, testState()))
+
+	input.UnsafeAllowOverwrite = true
+	assert.NoError(t, Check(input, testState()))
+
+	input.EpochCounter = 7
+	assert.Error(t, Check(input, testState()))
+
+	tests := map[string]func(input *Input){
+		"invalid phases": func(input *Input) {
+			input.EndView = 150
+		},
+		"views out of order": func(input *Input) {
+			input.StakingEndView = 99
+		},
+		"unknown node": func(input *Input) {
+			input.NodeIDs = append(input.NodeIDs, "unknown")
+		},
+		"zero weight": func(input *Input) {
+			input.NodeIDs = append(input.NodeIDs, "x")
+		},
+		"duplicate node": func(input *Input) {
+			input.NodeIDs = append(input.NodeIDs, "c1")
+		},
+		"missing vote data": func(input *Input) {
+			input.ClusterQCVoteData = input.ClusterQCVoteData[:1]
+		},
+		"empty cluster": func(input *Input) {
+			input.ClusterAssignments[1] = nil
+		},
+		"node in two clusters": func(input *Input) {
+			input.ClusterAssignments[1] = append(input.ClusterAssignments[1], "c1")
+		},
+		"cluster node not in epoch": func(input *Input) {
+			input.ClusterAssignments[1] = []string{"x"}
+		},
+		"vote from another cluster": func(input *Input) {
+			input.ClusterQCVoteData[1].VoterIDs = []string{"c1"}
+		},
+		"duplicate vote": func(input *Input) {
+			input.ClusterQCVoteData[1].VoterIDs = []string{"c3", "c3"}
+		},
+		"missing signature": func(input *Input) {
+			input.ClusterQCVoteData[0].AggregatedSignature = ""
+		},
+		"signature not hex": func(input *Input) {
+			input.ClusterQCVoteData[0].AggregatedSignature = "signature_0"
+		},
+		"cluster node not a collector": func(input *Input) {
+			input.NodeIDs = append(input.NodeIDs, "e1")
+			input.ClusterAssignments[1] = append(input.ClusterAssignments[1], "e1")
+		},
+		"missing group key": func(input *Input) {
+			input.DKGGroupKey = ""
+		},
+		"group key too short": func(input *Input) {
+			input.DKGGroupKey = "03"
+		},
+		"DKG key not hex": func(input *Input) {
+			input.DKGPubKeys[1] = strings.Repeat("k", DKGKeyLength)
+		},
+		"DKG participant not consensus": func(input *Input) {
+			input.DKGIDMapping = map[string]int{"s1": 0, "c1": 1}
+		},
+		"missing DKG key": func(input *Input) {
+			input.DKGPubKeys = input.DKGPubKeys[:1]
+		},
+		"duplicate DKG index": func(input *Input) {
+			input.DKGIDMapping["s2"] = 0
+		},
+		"DKG participant not in epoch": func(input *Input) {
+			input.DKGIDMapping = map[string]int{"s1": 0, "y": 1}
+		},
+	}
+
+	for name, change := range tests {
+		t.Run(name, func(t *testing.T) {
+			input := testInput()
+			change(&input)
+
+			assert.Error(t, Check(input, testState()))
+
+			_, err := Build(templates.Environment{QuorumCertificateAddress: "0x01"}, input, testState())
+			assert.Error(t, err)
+		})
+	}
+
+	// all the errors are reported
+	input = testInput()
+	input.DKGGroupKey = ""
+	input.NodeIDs = append(input.NodeIDs, "x")
+
+	err := Check(input, testState())
+	assert.ErrorContains(t, err, "group key")
+	assert.ErrorContains(t, err, "weight of 0")
+}
+
+func TestEvent(t *testing.T) {
+	nodes := testState().Nodes
+
+	input := testInput()
+	event, err := input.Event(testState().Config, nodes, "0123456789abcdef0123456789abcdef")
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(151), event.DKGPhase1FinalView)
+	assert.Equal(t, uint64(155), event.DKGPhase3FinalView)
+	assert.Len(t, event.NodeInfo, 5)
+	assert.Empty(t, epochs.ValidateRecover(event))
+
+	// the event of an input that fails the checks violates the invariants as well
+	invalid := testInput()
+	invalid.ClusterQCVoteData[0].AggregatedSignature = "signature_0"
+	invalid.DKGIDMapping = map[string]int{"s1": 0, "c1": 1}
+	assert.Error(t, Check(invalid, testState()))
+
+	event, err = invalid.Event(testState().Config, nodes, "0123456789abcdef0123456789abcdef")
+	require.NoError(t, err)
+	assert.Len(t, epochs.ValidateRecover(event), 2)
+
+	delete(nodes, "s2")
+	_, err = input.Event(testState().Config, nodes, "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,223 @@
+package epochs
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// ErrInvalidPhaseConfiguration is returned when the staking auction and the DKG phases
+// do not fit in an epoch.
+var ErrInvalidPhaseConfiguration = errors.New("epoch views must be greater than the sum of the staking auction and DKG phase views")
+
+// IsValidPhaseConfiguration returns true if the staking auction and the three DKG phases
+// are shorter than the epoch, like FlowEpoch.isValidPhaseConfiguration.
+// All the lengths are numbers of views.
+func IsValidPhaseConfiguration(auctionLen, dkgPhaseLen, epochLen uint64) bool {
+	// the contract aborts on overflow, so a configuration that overflows is never valid
+	if dkgPhaseLen > math.MaxUint64/3 || auctionLen > math.MaxUint64-3*dkgPhaseLen {
+		return false
+	}
+
+	return auctionLen+3*dkgPhaseLen < epochLen
+}
+
+// Validate checks the phase views of the config metadata like FlowEpoch does
+// when they are set or updated.
+func (c ConfigMetadata) Validate() error {
+	if !IsValidPhaseConfiguration(c.NumViewsInStakingAuction, c.NumViewsInDKGPhase, c.NumViewsInEpoch) {
+		return fmt.Errorf(
+			"%d staking auction views and 3 DKG phases of %d views in %d epoch views: %w",
+			c.NumViewsInStakingAuction,
+			c.NumViewsInDKGPhase,
+			c.NumViewsInEpoch,
+			ErrInvalidPhaseConfiguration,
+		)
+	}
+
+	return nil
+}
+
+// ValidateEpochViews checks the views of an epoch like the FlowEpoch functions that reset or recover an epoch:
+// the views must be ordered, and the staking auction and the DKG phases must fit in the epoch.
+func ValidateEpochViews(startView, stakingEndView, endView, numViewsInDKGPhase uint64) error {
+	if stakingEndView < startView || endView < stakingEndView {
+		return fmt.Errorf("start view %d, staking end view %d and end view %d are not ordered", startView, stakingEndView, endView)
+	}
+
+	auctionLen := stakingEndView - startView + 1
+	epochLen := endView - startView + 1
+
+	if !IsValidPhaseConfiguration(auctionLen, numViewsInDKGPhase, epochLen) {
+		return fmt.Errorf(
+			"%d staking auction views and 3 DKG phases of %d views in %d epoch views: %w",
+			auctionLen,
+			numViewsInDKGPhase,
+			epochLen,
+			ErrInvalidPhaseConfiguration,
+		)
+	}
+
+	return nil
+}
+
+// TargetEndTime returns the target end time of the epoch with the given counter,
+// in second-precision Unix time, like FlowEpoch.EpochTimingConfig.getTargetEndTimeForEpoch.
+// It returns an error for epochs before the reference epoch, for which the contract aborts.
+func (c TimingConfig) TargetEndTime(counter uint64) (uint64, error) {
+	if counter < c.RefCounter {
+		return 0, fmt.Errorf("epoch %d is before the reference epoch %d of the timing config", counter, c.RefCounter)
+	}
+
+	epochs := counter - c.RefCounter
+	if c.Duration != 0 && epochs > (math.MaxUint64-c.RefTimestamp)/c.Duration {
+		return 0, fmt.Errorf("target end time of epoch %d overflows", counter)
+	}
+
+	return c.RefTimestamp + c.Duration*epochs, nil
+}
+
+// TargetEnd returns the target end time of the epoch with the given counter.
+func (c TimingConfig) TargetEnd(counter uint64) (time.Time, error) {
+	timestamp, err := c.TargetEndTime(counter)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if timestamp > math.MaxInt64 {
+		return time.Time{}, fmt.Errorf("target end time %d of epoch %d is out of range", timestamp, counter)
+	}
+
+	return time.Unix(int64(timestamp), 0).UTC(), nil
+}
+
+// Validate checks the timing config like FlowEpoch.Admin.updateEpochTimingConfig:
+// the reference epoch cannot be after the current epoch.
+func (c TimingConfig) Validate(currentCounter uint64) error {
+	if c.RefCounter > currentCounter {
+		return fmt.Errorf("reference epoch %d is after the current epoch %d", c.RefCounter, currentCounter)
+	}
+
+	return nil
+}
+
+// Schedule are the views of the phases of an epoch and its target end time,
+// as in the EpochSetup event of the epoch.
+type Schedule struct {
+	Counter            uint64
+	FirstView          uint64
+	StakingEndView     uint64
+	DKGPhase1FinalView uint64
+	DKGPhase2FinalView uint64
+	DKGPhase3FinalView uint64
+	FinalView          uint64
+	// TargetDuration is the target duration of the epoch, in seconds
+	TargetDuration uint64
+	// TargetEndTime is the target end time of the epoch, in second-precision Unix time
+	TargetEndTime uint64
+}
+
+// TargetStart returns the target start time of the epoch, the target end time of the previous epoch.
+func (s Schedule) TargetStart() time.Time {
+	return time.Unix(int64(s.TargetEndTime)-int64(s.TargetDuration), 0).UTC()
+}
+
+// TargetEnd returns the target end time of the epoch.
+func (s Schedule) TargetEnd() time.Time {
+	return time.Unix(int64(s.TargetEndTime), 0).UTC()
+}
+
+// ViewTime estimates the time the given view starts at,
+// assuming that the views of the epoch progress at a constant rate from its target start to its target end.
+// Views outside of the epoch are extrapolated at the same rate.
+func (s Schedule) ViewTime(view uint64) time.Time {
+	views := float64(s.FinalView - s.FirstView + 1)
+	offset := float64(int64(view) - int64(s.FirstView))
+	seconds := offset * float64(s.TargetDuration) / views
+
+	return s.TargetStart().Add(time.Duration(seconds * float64(time.Second)))
+}
+
+// ScheduleFor returns the schedule of an epoch with the given metadata,
+// e.g. of the current epoch, with the DKG phases of the given config metadata.
+func ScheduleFor(metadata Metadata, config ConfigMetadata, timing TimingConfig) (Schedule, error) {
+	err := ValidateEpochViews(metadata.StartView, metadata.StakingEndView, metadata.EndView, config.NumViewsInDKGPhase)
+	if err != nil {
+		return Schedule{}, fmt.Errorf("epoch %d: %w", metadata.Counter, err)
+	}
+
+	targetEndTime, err := timing.TargetEndTime(metadata.Counter)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	dkgPhase1FinalView := metadata.StakingEndView + config.NumViewsInDKGPhase
+	dkgPhase2FinalView := dkgPhase1FinalView + config.NumViewsInDKGPhase
+	dkgPhase3FinalView := dkgPhase2FinalView + config.NumViewsInDKGPhase
+
+	return Schedule{
+		Counter:            metadata.Counter,
+		FirstView:          metadata.StartView,
+		StakingEndView:     metadata.StakingEndView,
+		DKGPhase1FinalView: dkgPhase1FinalView,
+		DKGPhase2FinalView: dkgPhase2FinalView,
+		DKGPhase3FinalView: dkgPhase3FinalView,
+		FinalView:          metadata.EndView,
+		TargetDuration:     timing.Duration,
+		TargetEndTime:      targetEndTime,
+	}, nil
+}
+
+// ProjectEpochs returns the schedules of the given number of epochs following the current epoch,
+// as FlowEpoch sets them up if the config metadata and the timing config do not change:
+// each epoch starts after the final view of the previous epoch.
+func ProjectEpochs(current Metadata, config ConfigMetadata, timing TimingConfig, count int) ([]Schedule, error) {
+	err := config.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	schedules := make([]Schedule, 0, count)
+
+	previous := current
+	for range count {
+		metadata := Metadata{
+			Counter:        previous.Counter + 1,
+			StartView:      previous.EndView + 1,
+			EndView:        previous.EndView + config.NumViewsInEpoch,
+			StakingEndView: previous.EndView + config.NumViewsInStakingAuction,
+		}
+
+		schedule, err := ScheduleFor(metadata, config, timing)
+		if err != nil {
+			return nil, err
+		}
+
+		schedules = append(schedules, schedule)
+		previous = metadata
+	}
+
+	return schedules, nil
+}
+
+// UnstakingWithdrawableEpoch returns the counter of the epoch at the end of which
+// the staked tokens requested to unstake during the given epoch and phase can be withdrawn.
+//
+// Unstaking can only be requested during the staking auction,
+// so a request after the staking auction is made in the staking auction of the next epoch.
+// The requested tokens are unstaking during the epoch after the request,
+// and are unstaked when it ends. Committed tokens are unstaked immediately.
+func UnstakingWithdrawableEpoch(counter uint64, phase Phase) uint64 {
+	if phase != StakingAuctionPhase {
+		counter++
+	}
+
+	return counter + 1
+}
+
+// UnstakingWithdrawableTime returns the target time at which the staked tokens
+// requested to unstake during the given epoch and phase can be withdrawn.
+func (c TimingConfig) UnstakingWithdrawableTime(counter uint64, phase Phase) (time.Time, error) {
+	return c.TargetEnd(UnstakingWithdrawableEpoch(counter, phase))
+}
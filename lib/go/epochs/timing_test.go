@@ -0,0 +1,162 @@
+package epochs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testConfig() ConfigMetadata {
+	return ConfigMetadata{
+		NumViewsInEpoch:          70,
+		NumViewsInStakingAuction: 50,
+		NumViewsInDKGPhase:       2,
+		NumCollectorClusters:     1,
+	}
+}
+
+func TestDecodeTimingConfig(t *testing.T) {
+	value := cadence.NewStruct([]cadence.Value{
+		cadence.UInt64(604800),
+		cadence.UInt64(10),
+		cadence.UInt64(1700000000),
+	}).WithType(cadence.NewStructType(
+		nil,
+		"FlowEpoch.EpochTimingConfig",
+		[]cadence.Field{
+			{Identifier: "duration", Type: cadence.UInt64Type},
+			{Identifier: "refCounter", Type: cadence.UInt64Type},
+			{Identifier: "refTimestamp", Type: cadence.UInt64Type},
+		},
+		nil,
+	))
+
+	config, err := DecodeTimingConfig(value)
+	require.NoError(t, err)
+	assert.Equal(t, TimingConfig{Duration: 604800, RefCounter: 10, RefTimestamp: 1700000000}, config)
+
+	_, err = DecodeTimingConfig(cadence.UInt64(1))
+	assert.Error(t, err)
+}
+
+func TestIsValidPhaseConfiguration(t *testing.T) {
+	assert.True(t, IsValidPhaseConfiguration(50, 2, 70))
+	assert.False(t, IsValidPhaseConfiguration(50, 2, 56))
+	assert.True(t, IsValidPhaseConfiguration(50, 2, 57))
+	assert.False(t, IsValidPhaseConfiguration(1, 1<<63, 10))
+
+	config := testConfig()
+	require.NoError(t, config.Validate())
+
+	config.NumViewsInDKGPhase = 10
+	assert.ErrorIs(t, config.Validate(), ErrInvalidPhaseConfiguration)
+
+	assert.NoError(t, ValidateEpochViews(100, 149, 169, 2))
+	assert.ErrorIs(t, ValidateEpochViews(100, 149, 155, 2), ErrInvalidPhaseConfiguration)
+	assert.Error(t, ValidateEpochViews(100, 99, 169, 2))
+	assert.Error(t, ValidateEpochViews(100, 149, 148, 2))
+}
+
+func TestTimingConfig(t *testing.T) {
+	timing := TimingConfig{Duration: 100, RefCounter: 5, RefTimestamp: 1000}
+
+	end, err := timing.TargetEndTime(5)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1000), end)
+
+	end, err = timing.TargetEndTime(8)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1300), end)
+
+	// the contract aborts for epochs before the reference epoch
+	_, err = timing.TargetEndTime(4)
+	assert.Error(t, err)
+
+	_, err = TimingConfig{Duration: 1 << 63, RefTimestamp: 1 << 63}.TargetEndTime(2)
+	assert.Error(t, err)
+
+	endTime, err := timing.TargetEnd(6)
+	require.NoError(t, err)
+	assert.Equal(t, time.Unix(1100, 0).UTC(), endTime)
+
+	assert.NoError(t, timing.Validate(5))
+	assert.Error(t, timing.Validate(4))
+}
+
+func TestProjectEpochs(t *testing.T) {
+	current := Metadata{Counter: 1, StartView: 0, EndView: 69, StakingEndView: 49}
+	timing := TimingConfig{Duration: 70, RefCounter: 1, RefTimestamp: 1070}
+
+	schedules, err := ProjectEpochs(current, testConfig(), timing, 2)
+	require.NoError(t, err)
+
+	assert.Equal(t, []Schedule{
+		{
+			Counter:            2,
+			FirstView:          70,
+			StakingEndView:     119,
+			DKGPhase1FinalView: 121,
+			DKGPhase2FinalView: 123,
+			DKGPhase3FinalView: 125,
+			FinalView:          139,
+			TargetDuration:     70,
+			TargetEndTime:      1140,
+		},
+		{
+			Counter:            3,
+			FirstView:          140,
+			StakingEndView:     189,
+			DKGPhase1FinalView: 191,
+			DKGPhase2FinalView: 193,
+			DKGPhase3FinalView: 195,
+			FinalView:          209,
+			TargetDuration:     70,
+			TargetEndTime:      1210,
+		},
+	}, schedules)
+
+	next := schedules[0]
+	assert.Equal(t, time.Unix(1070, 0).UTC(), next.TargetStart())
+	assert.Equal(t, time.Unix(1140, 0).UTC(), next.TargetEnd())
+
+	// one second per view
+	assert.Equal(t, time.Unix(1070, 0).UTC(), next.ViewTime(70))
+	assert.Equal(t, time.Unix(1120, 0).UTC(), next.ViewTime(120))
+	assert.Equal(t, time.Unix(1140, 0).UTC(), next.ViewTime(140))
+	assert.Equal(t, time.Unix(1060, 0).UTC(), next.ViewTime(60))
+
+	// the current epoch
+	schedule, err := ScheduleFor(current, testConfig(), timing)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(55), schedule.DKGPhase3FinalView)
+	assert.Equal(t, uint64(1070), schedule.TargetEndTime)
+
+	// invalid phase configurations are rejected
+	config := testConfig()
+	config.NumViewsInStakingAuction = 70
+
+	_, err = ProjectEpochs(current, config, timing, 1)
+	assert.ErrorIs(t, err, ErrInvalidPhaseConfiguration)
+
+	// epochs before the reference epoch have no target end time
+	_, err = ProjectEpochs(current, testConfig(), TimingConfig{Duration: 70, RefCounter: 3}, 1)
+	assert.Error(t, err)
+}
+
+func TestUnstakingWithdrawable(t *testing.T) {
+	assert.Equal(t, uint64(6), UnstakingWithdrawableEpoch(5, StakingAuctionPhase))
+	assert.Equal(t, uint64(7), UnstakingWithdrawableEpoch(5, EpochSetupPhase))
+	assert.Equal(t, uint64(7), UnstakingWithdrawableEpoch(5, EpochCommitPhase))
+
+	timing := TimingConfig{Duration: 100, RefCounter: 5, RefTimestamp: 1000}
+
+	withdrawable, err := timing.UnstakingWithdrawableTime(5, EpochSetupPhase)
+	require.NoError(t, err)
+	assert.Equal(t, time.Unix(1200, 0).UTC(), withdrawable)
+
+	assert.Equal(t, "EPOCHCOMMIT", EpochCommitPhase.String())
+	assert.Equal(t, "Phase(3)", Phase(3).String())
+}
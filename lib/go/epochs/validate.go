@@ -0,0 +1,330 @@
+package epochs
+
+import (
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/onflow/flow-core-contracts/lib/go/events"
+)
+
+// RandomSourceLength is the length of the hex-encoded random source of an epoch,
+// see FlowEpoch.generateRandomSource.
+const RandomSourceLength = 32
+
+// Violation is a protocol invariant that an epoch service event violates.
+type Violation struct {
+	// Field is the field of the event that violates the invariant, e.g. collectorClusters[1]
+	Field   string
+	Message string
+}
+
+func (v Violation) String() string {
+	return v.Field + ": " + v.Message
+}
+
+// Violations are the protocol invariants that an epoch service event violates.
+// An event that violates any invariant can halt the collector clusters or consensus
+// once it is processed by the protocol state.
+type Violations []Violation
+
+func (v Violations) Error() string {
+	messages := make([]string, len(v))
+	for i, violation := range v {
+		messages[i] = violation.String()
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// Err returns the violations as an error, or nil if there are none.
+func (v Violations) Err() error {
+	if len(v) == 0 {
+		return nil
+	}
+
+	return v
+}
+
+func (v *Violations) add(field string, format string, a ...any) {
+	*v = append(*v, Violation{Field: field, Message: fmt.Sprintf(format, a...)})
+}
+
+// ValidateSetup returns the violations of an EpochSetup event:
+// the DKG phases must be within the epoch and of equal length, every collector node
+// must be assigned to exactly one non-empty cluster with its weight,
+// and the random source must be well-formed.
+func ValidateSetup(setup events.EpochSetupEvent) Violations {
+	var violations Violations
+
+	violations.validateViews(setup.FirstView, setup.FinalView, setup.DKGPhase1FinalView, setup.DKGPhase2FinalView, setup.DKGPhase3FinalView)
+	violations.validateRandomSource(setup.RandomSource)
+	nodes := violations.validateNodes(setup.NodeInfo)
+
+	assignments := make([][]string, len(setup.CollectorClusters))
+	for i, cluster := range setup.CollectorClusters {
+		field := fmt.Sprintf("collectorClusters[%d]", i)
+
+		if int(cluster.Index) != i {
+			violations.add(field, "has index %d", cluster.Index)
+		}
+
+		var totalWeight uint64
+		for _, nodeID := range sortedNodeIDs(cluster.NodeWeights) {
+			weight := cluster.NodeWeights[nodeID]
+			totalWeight += weight
+
+			if node, ok := nodes[nodeID]; ok && weight != node.InitialWeight {
+				violations.add(field, "node %s has weight %d instead of its initial weight %d", nodeID, weight, node.InitialWeight)
+			}
+		}
+
+		if cluster.TotalWeight != totalWeight {
+			violations.add(field, "total weight %d is not the sum %d of the node weights", cluster.TotalWeight, totalWeight)
+		}
+
+		assignments[i] = sortedNodeIDs(cluster.NodeWeights)
+	}
+
+	violations.validateClusters("collectorClusters", assignments, nodes)
+
+	return violations
+}
+
+// ValidateCommit returns the violations of an EpochCommit event for the EpochSetup event of the same epoch:
+// there must be a QC for each cluster, voted by nodes of the cluster, and the DKG ID mapping
+// must index the DKG participant keys with the consensus nodes of the epoch.
+func ValidateCommit(commit events.EpochCommitEvent, setup events.EpochSetupEvent) Violations {
+	var violations Violations
+
+	if commit.Counter != setup.Counter {
+		violations.add("counter", "is %d instead of the counter %d of the epoch setup", commit.Counter, setup.Counter)
+	}
+
+	if len(commit.ClusterQCs) != len(setup.CollectorClusters) {
+		violations.add("clusterQCs", "has %d QCs for %d clusters", len(commit.ClusterQCs), len(setup.CollectorClusters))
+	}
+
+	for i, qc := range commit.ClusterQCs {
+		field := fmt.Sprintf("clusterQCs[%d]", i)
+
+		if int(qc.Index) != i {
+			violations.add(field, "has index %d", qc.Index)
+		}
+
+		if len(qc.VoteSignatures) != len(qc.VoterIDs) {
+			violations.add(field, "has %d vote signatures for %d voters", len(qc.VoteSignatures), len(qc.VoterIDs))
+		}
+
+		var cluster map[string]uint64
+		if i < len(setup.CollectorClusters) {
+			cluster = setup.CollectorClusters[i].NodeWeights
+		}
+
+		violations.validateVoters(field, qc.VoterIDs, func(nodeID string) bool {
+			_, ok := cluster[nodeID]
+			return ok
+		})
+	}
+
+	nodes := make(map[string]events.NodeInfo, len(setup.NodeInfo))
+	for _, node := range setup.NodeInfo {
+		nodes[node.ID] = node
+	}
+
+	violations.validateDKG(commit.DKGGroupKey, commit.DKGPubKeys, commit.DKGIDMapping, nodes)
+
+	return violations
+}
+
+// ValidateRecover returns the violations of an EpochRecover event:
+// the invariants of both the EpochSetup and the EpochCommit events of the recovery epoch.
+func ValidateRecover(event events.EpochRecoverEvent) Violations {
+	var violations Violations
+
+	violations.validateViews(event.FirstView, event.FinalView, event.DKGPhase1FinalView, event.DKGPhase2FinalView, event.DKGPhase3FinalView)
+	violations.validateRandomSource(event.RandomSource)
+	nodes := violations.validateNodes(event.NodeInfo)
+
+	violations.validateClusters("clusterAssignments", event.ClusterAssignments, nodes)
+
+	if len(event.ClusterQCVoteData) != len(event.ClusterAssignments) {
+		violations.add("clusterQCVoteData", "has %d vote data for %d clusters", len(event.ClusterQCVoteData), len(event.ClusterAssignments))
+	}
+
+	for i, voteData := range event.ClusterQCVoteData {
+		field := fmt.Sprintf("clusterQCVoteData[%d]", i)
+
+		if !isHex(voteData.AggregatedSignature) {
+			violations.add(field, "aggregated signature is not hex-encoded")
+		}
+
+		var cluster []string
+		if i < len(event.ClusterAssignments) {
+			cluster = event.ClusterAssignments[i]
+		}
+
+		violations.validateVoters(field, voteData.VoterIDs, func(nodeID string) bool {
+			return slices.Contains(cluster, nodeID)
+		})
+	}
+
+	violations.validateDKG(event.DKGGroupKey, event.DKGPubKeys, event.DKGIDMapping, nodes)
+
+	return violations
+}
+
+// validateViews checks that the DKG phases are within the epoch and of equal length,
+// as they are derived from the staking end view and the length of the DKG phases.
+func (v *Violations) validateViews(firstView, finalView, dkgPhase1FinalView, dkgPhase2FinalView, dkgPhase3FinalView uint64) {
+	if finalView <= firstView {
+		v.add("finalView", "%d is not after the first view %d", finalView, firstView)
+	}
+
+	if dkgPhase1FinalView <= firstView {
+		v.add("DKGPhase1FinalView", "%d is not after the first view %d", dkgPhase1FinalView, firstView)
+	}
+
+	if dkgPhase3FinalView >= finalView {
+		v.add("DKGPhase3FinalView", "%d is not before the final view %d", dkgPhase3FinalView, finalView)
+	}
+
+	if dkgPhase2FinalView <= dkgPhase1FinalView || dkgPhase3FinalView <= dkgPhase2FinalView {
+		v.add("DKGPhase2FinalView", "DKG phase final views %d, %d and %d are not increasing", dkgPhase1FinalView, dkgPhase2FinalView, dkgPhase3FinalView)
+	} else if dkgPhase2FinalView-dkgPhase1FinalView != dkgPhase3FinalView-dkgPhase2FinalView {
+		v.add("DKGPhase3FinalView", "DKG phases 2 and 3 have %d and %d views", dkgPhase2FinalView-dkgPhase1FinalView, dkgPhase3FinalView-dkgPhase2FinalView)
+	}
+}
+
+func (v *Violations) validateRandomSource(randomSource string) {
+	if len(randomSource) != RandomSourceLength || !isHex(randomSource) {
+		v.add("randomSource", "%q is not %d hex characters", randomSource, RandomSourceLength)
+	}
+}
+
+// validateNodes checks that the nodes are unique and have a weight, and returns them by ID.
+func (v *Violations) validateNodes(nodeInfo []events.NodeInfo) map[string]events.NodeInfo {
+	nodes := make(map[string]events.NodeInfo, len(nodeInfo))
+
+	for i, node := range nodeInfo {
+		field := fmt.Sprintf("nodeInfo[%d]", i)
+
+		if _, ok := nodes[node.ID]; ok {
+			v.add(field, "node %s is listed more than once", node.ID)
+		}
+		nodes[node.ID] = node
+
+		if node.InitialWeight == 0 {
+			v.add(field, "node %s has a weight of 0", node.ID)
+		}
+	}
+
+	return nodes
+}
+
+// validateClusters checks that every collector node is assigned to exactly one non-empty cluster.
+func (v *Violations) validateClusters(field string, assignments [][]string, nodes map[string]events.NodeInfo) {
+	if len(assignments) == 0 {
+		v.add(field, "has no clusters")
+	}
+
+	clusterOf := make(map[string]int)
+
+	for i, cluster := range assignments {
+		clusterField := fmt.Sprintf("%s[%d]", field, i)
+
+		if len(cluster) == 0 {
+			v.add(clusterField, "has no nodes")
+		}
+
+		for _, nodeID := range cluster {
+			if other, ok := clusterOf[nodeID]; ok {
+				v.add(clusterField, "node %s is also assigned to cluster %d", nodeID, other)
+				continue
+			}
+			clusterOf[nodeID] = i
+
+			node, ok := nodes[nodeID]
+			if !ok {
+				v.add(clusterField, "node %s is not a node of the epoch", nodeID)
+			} else if node.Role != events.CollectionRole {
+				v.add(clusterField, "node %s has role %d instead of collector", nodeID, node.Role)
+			}
+		}
+	}
+
+	for _, nodeID := range slices.Sorted(maps.Keys(nodes)) {
+		if _, ok := clusterOf[nodeID]; !ok && nodes[nodeID].Role == events.CollectionRole {
+			v.add(field, "collector node %s is not assigned to a cluster", nodeID)
+		}
+	}
+}
+
+// validateVoters checks that the voters of a cluster QC are unique members of the cluster.
+func (v *Violations) validateVoters(field string, voterIDs []string, inCluster func(nodeID string) bool) {
+	if len(voterIDs) == 0 {
+		v.add(field, "has no voters")
+	}
+
+	voters := make(map[string]bool, len(voterIDs))
+	for _, nodeID := range voterIDs {
+		if voters[nodeID] {
+			v.add(field, "node %s voted more than once", nodeID)
+		}
+		voters[nodeID] = true
+
+		if !inCluster(nodeID) {
+			v.add(field, "node %s voted without being assigned to the cluster", nodeID)
+		}
+	}
+}
+
+// validateDKG checks that the DKG keys are hex-encoded and that the DKG ID mapping
+// indexes all the participant keys with consensus nodes of the epoch.
+func (v *Violations) validateDKG(groupKey string, pubKeys []string, idMapping map[string]int, nodes map[string]events.NodeInfo) {
+	if !isHex(groupKey) {
+		v.add("dkgGroupKey", "is not hex-encoded")
+	}
+
+	for i, key := range pubKeys {
+		if !isHex(key) {
+			v.add(fmt.Sprintf("dkgPubKeys[%d]", i), "is not hex-encoded")
+		}
+	}
+
+	if len(idMapping) != len(pubKeys) {
+		v.add("dkgIdMapping", "has %d participants for %d DKG public keys", len(idMapping), len(pubKeys))
+	}
+
+	indexes := make(map[int]string, len(idMapping))
+	for _, nodeID := range slices.Sorted(maps.Keys(idMapping)) {
+		index := idMapping[nodeID]
+
+		if index < 0 || index >= len(pubKeys) {
+			v.add("dkgIdMapping", "node %s has index %d out of the %d DKG public keys", nodeID, index, len(pubKeys))
+		}
+
+		if other, ok := indexes[index]; ok {
+			v.add("dkgIdMapping", "nodes %s and %s have the same index %d", other, nodeID, index)
+		}
+		indexes[index] = nodeID
+
+		node, ok := nodes[nodeID]
+		if !ok {
+			v.add("dkgIdMapping", "node %s is not a node of the epoch", nodeID)
+		} else if node.Role != events.ConsensusRole {
+			v.add("dkgIdMapping", "node %s has role %d instead of consensus", nodeID, node.Role)
+		}
+	}
+}
+
+func isHex(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
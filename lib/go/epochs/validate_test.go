@@ -0,0 +1,308 @@
+package epochs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/events"
+)
+
+const testRandomSource = "0123456789abcdef0123456789abcdef"
+
+func testNodes() []events.NodeInfo {
+	return []events.NodeInfo{
+		{ID: "c1", Role: events.CollectionRole, InitialWeight: 100},
+		{ID: "c2", Role: events.CollectionRole, InitialWeight: 100},
+		{ID: "c3", Role: events.CollectionRole, InitialWeight: 50},
+		{ID: "s1", Role: events.ConsensusRole, InitialWeight: 100},
+		{ID: "s2", Role: events.ConsensusRole, InitialWeight: 100},
+		{ID: "e1", Role: 3, InitialWeight: 100},
+	}
+}
+
+func testSetup() events.EpochSetupEvent {
+	return events.EpochSetupEvent{
+		Counter:   2,
+		FirstView: 70,
+		FinalView: 139,
+		CollectorClusters: []events.Cluster{
+			{Index: 0, NodeWeights: map[string]uint64{"c1": 100, "c3": 50}, TotalWeight: 150},
+			{Index: 1, NodeWeights: map[string]uint64{"c2": 100}, TotalWeight: 100},
+		},
+		RandomSource:       testRandomSource,
+		DKGPhase1FinalView: 121,
+		DKGPhase2FinalView: 123,
+		DKGPhase3FinalView: 125,
+		TargetDuration:     70,
+		TargetEndTime:      1140,
+		NodeInfo:           testNodes(),
+	}
+}
+
+func testCommit() events.EpochCommitEvent {
+	return events.EpochCommitEvent{
+		Counter: 2,
+		ClusterQCs: []events.ClusterQC{
+			{Index: 0, VoteSignatures: []string{"aa", "bb"}, VoteMessage: "cc", VoterIDs: []string{"c1", "c3"}},
+			{Index: 1, VoteSignatures: []string{"dd"}, VoteMessage: "cc", VoterIDs: []string{"c2"}},
+		},
+		DKGPubKeys:   []string{"0a", "0b"},
+		DKGGroupKey:  "0c",
+		DKGIDMapping: map[string]int{"s1": 0, "s2": 1},
+	}
+}
+
+func testRecover() events.EpochRecoverEvent {
+	return events.EpochRecoverEvent{
+		Counter:            2,
+		FirstView:          70,
+		FinalView:          139,
+		ClusterAssignments: [][]string{{"c1", "c3"}, {"c2"}},
+		RandomSource:       testRandomSource,
+		DKGPhase1FinalView: 121,
+		DKGPhase2FinalView: 123,
+		DKGPhase3FinalView: 125,
+		ClusterQCVoteData: []events.ClusterQCVoteData{
+			{AggregatedSignature: "aa", VoterIDs: []string{"c1", "c3"}},
+			{AggregatedSignature: "bb", VoterIDs: []string{"c2"}},
+		},
+		DKGPubKeys:   []string{"0a", "0b"},
+		DKGGroupKey:  "0c",
+		NodeInfo:     testNodes(),
+		DKGIDMapping: map[string]int{"s1": 0, "s2": 1},
+	}
+}
+
+// fields returns the fields of the violations
+func fields(violations Violations) []string {
+	fields := make([]string, len(violations))
+	for i, violation := range violations {
+		fields[i] = violation.Field
+	}
+	return fields
+}
+
+func TestValidateSetup(t *testing.T) {
+	require.Empty(t, ValidateSetup(testSetup()))
+	require.NoError(t, ValidateSetup(testSetup()).Err())
+
+	tests := map[string]struct {
+		change func(setup *events.EpochSetupEvent)
+		fields []string
+	}{
+		"DKG after the epoch": {
+			func(setup *events.EpochSetupEvent) { setup.FinalView = 125 },
+			[]string{"DKGPhase3FinalView"},
+		},
+		"DKG before the epoch": {
+			func(setup *events.EpochSetupEvent) { setup.FirstView = 121 },
+			[]string{"DKGPhase1FinalView"},
+		},
+		"unequal DKG phases": {
+			func(setup *events.EpochSetupEvent) { setup.DKGPhase3FinalView = 126 },
+			[]string{"DKGPhase3FinalView"},
+		},
+		"DKG phases out of order": {
+			func(setup *events.EpochSetupEvent) { setup.DKGPhase2FinalView = 121 },
+			[]string{"DKGPhase2FinalView"},
+		},
+		"short random source": {
+			func(setup *events.EpochSetupEvent) { setup.RandomSource = "lolsoRandom" },
+			[]string{"randomSource"},
+		},
+		"random source not hex": {
+			func(setup *events.EpochSetupEvent) { setup.RandomSource = "0123456789abcdef0123456789abcdeg" },
+			[]string{"randomSource"},
+		},
+		"no clusters": {
+			func(setup *events.EpochSetupEvent) { setup.CollectorClusters = nil },
+			[]string{"collectorClusters", "collectorClusters", "collectorClusters", "collectorClusters"},
+		},
+		"empty cluster": {
+			func(setup *events.EpochSetupEvent) {
+				setup.CollectorClusters = append(setup.CollectorClusters, events.Cluster{Index: 2, NodeWeights: map[string]uint64{}})
+			},
+			[]string{"collectorClusters[2]"},
+		},
+		"unassigned collector": {
+			func(setup *events.EpochSetupEvent) { setup.CollectorClusters = setup.CollectorClusters[:1] },
+			[]string{"collectorClusters"},
+		},
+		"collector in two clusters": {
+			func(setup *events.EpochSetupEvent) {
+				setup.CollectorClusters[1].NodeWeights["c1"] = 100
+				setup.CollectorClusters[1].TotalWeight = 200
+			},
+			[]string{"collectorClusters[1]"},
+		},
+		"consensus node in a cluster": {
+			func(setup *events.EpochSetupEvent) {
+				setup.CollectorClusters[1].NodeWeights["s1"] = 100
+				setup.CollectorClusters[1].TotalWeight = 200
+			},
+			[]string{"collectorClusters[1]"},
+		},
+		"wrong weight": {
+			func(setup *events.EpochSetupEvent) {
+				setup.CollectorClusters[1].NodeWeights["c2"] = 10
+				setup.CollectorClusters[1].TotalWeight = 10
+			},
+			[]string{"collectorClusters[1]"},
+		},
+		"wrong total weight": {
+			func(setup *events.EpochSetupEvent) { setup.CollectorClusters[1].TotalWeight = 10 },
+			[]string{"collectorClusters[1]"},
+		},
+		"wrong index": {
+			func(setup *events.EpochSetupEvent) { setup.CollectorClusters[1].Index = 0 },
+			[]string{"collectorClusters[1]"},
+		},
+		"node without weight": {
+			func(setup *events.EpochSetupEvent) { setup.NodeInfo[5].InitialWeight = 0 },
+			[]string{"nodeInfo[5]"},
+		},
+		"duplicate node": {
+			func(setup *events.EpochSetupEvent) { setup.NodeInfo = append(setup.NodeInfo, setup.NodeInfo[5]) },
+			[]string{"nodeInfo[6]"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			setup := testSetup()
+			test.change(&setup)
+
+			violations := ValidateSetup(setup)
+			assert.Equal(t, test.fields, fields(violations), violations.Error())
+			assert.Error(t, violations.Err())
+		})
+	}
+}
+
+func TestValidateCommit(t *testing.T) {
+	require.Empty(t, ValidateCommit(testCommit(), testSetup()))
+
+	tests := map[string]struct {
+		change func(commit *events.EpochCommitEvent)
+		fields []string
+	}{
+		"wrong counter": {
+			func(commit *events.EpochCommitEvent) { commit.Counter = 3 },
+			[]string{"counter"},
+		},
+		"missing QC": {
+			func(commit *events.EpochCommitEvent) { commit.ClusterQCs = commit.ClusterQCs[:1] },
+			[]string{"clusterQCs"},
+		},
+		"wrong QC index": {
+			func(commit *events.EpochCommitEvent) { commit.ClusterQCs[1].Index = 3 },
+			[]string{"clusterQCs[1]"},
+		},
+		"missing vote signature": {
+			func(commit *events.EpochCommitEvent) { commit.ClusterQCs[0].VoteSignatures = []string{"aa"} },
+			[]string{"clusterQCs[0]"},
+		},
+		"vote from another cluster": {
+			func(commit *events.EpochCommitEvent) { commit.ClusterQCs[1].VoterIDs = []string{"c1"} },
+			[]string{"clusterQCs[1]"},
+		},
+		"no voters": {
+			func(commit *events.EpochCommitEvent) {
+				commit.ClusterQCs[1].VoterIDs = nil
+				commit.ClusterQCs[1].VoteSignatures = nil
+			},
+			[]string{"clusterQCs[1]"},
+		},
+		"group key not hex": {
+			func(commit *events.EpochCommitEvent) { commit.DKGGroupKey = "" },
+			[]string{"dkgGroupKey"},
+		},
+		"participant key not hex": {
+			func(commit *events.EpochCommitEvent) { commit.DKGPubKeys[1] = "zz" },
+			[]string{"dkgPubKeys[1]"},
+		},
+		"missing participant key": {
+			func(commit *events.EpochCommitEvent) { commit.DKGPubKeys = commit.DKGPubKeys[:1] },
+			[]string{"dkgIdMapping", "dkgIdMapping"},
+		},
+		"duplicate DKG index": {
+			func(commit *events.EpochCommitEvent) { commit.DKGIDMapping["s2"] = 0 },
+			[]string{"dkgIdMapping"},
+		},
+		"collector in the DKG": {
+			func(commit *events.EpochCommitEvent) { commit.DKGIDMapping = map[string]int{"s1": 0, "c1": 1} },
+			[]string{"dkgIdMapping"},
+		},
+		"unknown DKG participant": {
+			func(commit *events.EpochCommitEvent) { commit.DKGIDMapping = map[string]int{"s1": 0, "x": 1} },
+			[]string{"dkgIdMapping"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			commit := testCommit()
+			test.change(&commit)
+
+			violations := ValidateCommit(commit, testSetup())
+			assert.Equal(t, test.fields, fields(violations), violations.Error())
+		})
+	}
+}
+
+func TestValidateRecover(t *testing.T) {
+	require.Empty(t, ValidateRecover(testRecover()))
+
+	tests := map[string]struct {
+		change func(event *events.EpochRecoverEvent)
+		fields []string
+	}{
+		"DKG after the epoch": {
+			func(event *events.EpochRecoverEvent) { event.FinalView = 100 },
+			[]string{"DKGPhase3FinalView"},
+		},
+		"missing random source": {
+			func(event *events.EpochRecoverEvent) { event.RandomSource = "" },
+			[]string{"randomSource"},
+		},
+		"empty cluster": {
+			func(event *events.EpochRecoverEvent) {
+				event.ClusterAssignments[1] = nil
+				event.ClusterQCVoteData[1].VoterIDs = nil
+			},
+			[]string{"clusterAssignments[1]", "clusterAssignments", "clusterQCVoteData[1]"},
+		},
+		"collector in two clusters": {
+			func(event *events.EpochRecoverEvent) { event.ClusterAssignments[1] = []string{"c2", "c1"} },
+			[]string{"clusterAssignments[1]"},
+		},
+		"missing vote data": {
+			func(event *events.EpochRecoverEvent) { event.ClusterQCVoteData = event.ClusterQCVoteData[:1] },
+			[]string{"clusterQCVoteData"},
+		},
+		"signature not hex": {
+			func(event *events.EpochRecoverEvent) { event.ClusterQCVoteData[0].AggregatedSignature = "signature_0" },
+			[]string{"clusterQCVoteData[0]"},
+		},
+		"duplicate vote": {
+			func(event *events.EpochRecoverEvent) { event.ClusterQCVoteData[1].VoterIDs = []string{"c2", "c2"} },
+			[]string{"clusterQCVoteData[1]"},
+		},
+		"DKG index out of range": {
+			func(event *events.EpochRecoverEvent) { event.DKGIDMapping["s2"] = 2 },
+			[]string{"dkgIdMapping"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			event := testRecover()
+			test.change(&event)
+
+			violations := ValidateRecover(event)
+			assert.Equal(t, test.fields, fields(violations), violations.Error())
+		})
+	}
+}
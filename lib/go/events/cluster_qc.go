@@ -0,0 +1,28 @@
+package events
+
+// FlowClusterQC does not emit events of its own,
+// but its structs are part of the FlowEpoch service events.
+
+// Cluster is the FlowClusterQC.Cluster struct,
+// as included in the EpochSetup event.
+type Cluster struct {
+	Index       uint16            `cadence:"index"`
+	NodeWeights map[string]uint64 `cadence:"nodeWeights"`
+	TotalWeight uint64            `cadence:"totalWeight"`
+}
+
+// ClusterQC is the FlowClusterQC.ClusterQC struct,
+// as included in the EpochCommit event.
+type ClusterQC struct {
+	Index          uint16   `cadence:"index"`
+	VoteSignatures []string `cadence:"voteSignatures"`
+	VoteMessage    string   `cadence:"voteMessage"`
+	VoterIDs       []string `cadence:"voterIDs"`
+}
+
+// ClusterQCVoteData is the FlowClusterQC.ClusterQCVoteData struct,
+// as included in the EpochRecover event.
+type ClusterQCVoteData struct {
+	AggregatedSignature string   `cadence:"aggregatedSignature"`
+	VoterIDs            []string `cadence:"voterIDs"`
+}
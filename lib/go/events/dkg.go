@@ -0,0 +1,85 @@
+package events
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+)
+
+// FlowDKG events -----------------------------------------------------------
+
+var (
+	StartDKG         = Type[StartDKGEvent]{FlowDKG, "StartDKG"}
+	EndDKG           = Type[EndDKGEvent]{FlowDKG, "EndDKG"}
+	BroadcastMessage = Type[BroadcastMessageEvent]{FlowDKG, "BroadcastMessage"}
+)
+
+// StartDKGEvent is emitted when the admin enables the DKG.
+type StartDKGEvent struct{}
+
+// EndDKGEvent is emitted when the admin ends the DKG.
+type EndDKGEvent struct {
+	// FinalSubmission is the canonical result of the DKG,
+	// or nil if the DKG did not complete successfully.
+	FinalSubmission *ResultSubmission
+}
+
+func (e *EndDKGEvent) decodeCadence(composite cadence.Composite) error {
+	optional, ok := composite.FieldsMappedByName()["finalSubmission"].(cadence.Optional)
+	if !ok {
+		return fmt.Errorf("finalSubmission field not found")
+	}
+
+	if optional.Value == nil {
+		return nil
+	}
+
+	submission := &ResultSubmission{}
+	err := DecodeValue(optional.Value, submission)
+	if err != nil {
+		return fmt.Errorf("cannot decode final submission: %w", err)
+	}
+
+	e.FinalSubmission = submission
+	return nil
+}
+
+// BroadcastMessageEvent is emitted when a DKG participant posts a whiteboard message.
+type BroadcastMessageEvent struct {
+	NodeID  string `cadence:"nodeID"`
+	Content string `cadence:"content"`
+}
+
+// ResultSubmission is the FlowDKG.ResultSubmission struct.
+// All fields are either set or empty (the empty submission).
+type ResultSubmission struct {
+	GroupPubKey *string   `cadence:"groupPubKey"`
+	PubKeys     *[]string `cadence:"pubKeys"`
+
+	IDMapping map[string]int
+}
+
+func (s *ResultSubmission) decodeCadence(composite cadence.Composite) error {
+	optional, ok := composite.FieldsMappedByName()["idMapping"].(cadence.Optional)
+	if !ok {
+		return fmt.Errorf("idMapping field not found")
+	}
+
+	if optional.Value == nil {
+		return nil
+	}
+
+	mapping, err := decodeIDMapping(optional.Value)
+	if err != nil {
+		return err
+	}
+
+	s.IDMapping = mapping
+	return nil
+}
+
+// IsEmpty reports whether the submission is the empty submission,
+// sent by participants which failed to complete the DKG locally.
+func (s ResultSubmission) IsEmpty() bool {
+	return s.GroupPubKey == nil
+}
@@ -0,0 +1,128 @@
+package events
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+)
+
+// FlowEpoch service events -------------------------------------------------
+
+var (
+	EpochStart   = Type[EpochStartEvent]{FlowEpoch, "EpochStart"}
+	EpochSetup   = Type[EpochSetupEvent]{FlowEpoch, "EpochSetup"}
+	EpochCommit  = Type[EpochCommitEvent]{FlowEpoch, "EpochCommit"}
+	EpochRecover = Type[EpochRecoverEvent]{FlowEpoch, "EpochRecover"}
+)
+
+// EpochStartEvent is emitted when the contract transitions
+// to a new epoch in the staking auction phase.
+type EpochStartEvent struct {
+	Counter               uint64         `cadence:"counter"`
+	FirstView             uint64         `cadence:"firstView"`
+	StakingAuctionEndView uint64         `cadence:"stakingAuctionEndView"`
+	FinalView             uint64         `cadence:"finalView"`
+	TotalStaked           cadence.UFix64 `cadence:"totalStaked"`
+	TotalFlowSupply       cadence.UFix64 `cadence:"totalFlowSupply"`
+	TotalRewards          cadence.UFix64 `cadence:"totalRewards"`
+}
+
+// EpochSetupEvent is emitted when the contract transitions to the Epoch Setup phase.
+// It contains the finalized identity table for the upcoming epoch.
+type EpochSetupEvent struct {
+	Counter            uint64    `cadence:"counter"`
+	FirstView          uint64    `cadence:"firstView"`
+	FinalView          uint64    `cadence:"finalView"`
+	CollectorClusters  []Cluster `cadence:"collectorClusters"`
+	RandomSource       string    `cadence:"randomSource"`
+	DKGPhase1FinalView uint64    `cadence:"DKGPhase1FinalView"`
+	DKGPhase2FinalView uint64    `cadence:"DKGPhase2FinalView"`
+	DKGPhase3FinalView uint64    `cadence:"DKGPhase3FinalView"`
+	TargetDuration     uint64    `cadence:"targetDuration"`
+	TargetEndTime      uint64    `cadence:"targetEndTime"`
+
+	NodeInfo []NodeInfo
+}
+
+func (e *EpochSetupEvent) decodeCadence(composite cadence.Composite) (err error) {
+	e.NodeInfo, err = decodeNodeInfos(composite.FieldsMappedByName()["nodeInfo"])
+	return err
+}
+
+// EpochCommitEvent is emitted when the contract transitions to the Epoch Committed phase,
+// once all preparation for the upcoming epoch has been completed.
+type EpochCommitEvent struct {
+	Counter     uint64      `cadence:"counter"`
+	ClusterQCs  []ClusterQC `cadence:"clusterQCs"`
+	DKGPubKeys  []string    `cadence:"dkgPubKeys"`
+	DKGGroupKey string      `cadence:"dkgGroupKey"`
+
+	DKGIDMapping map[string]int
+}
+
+func (e *EpochCommitEvent) decodeCadence(composite cadence.Composite) (err error) {
+	e.DKGIDMapping, err = decodeIDMapping(composite.FieldsMappedByName()["dkgIdMapping"])
+	return err
+}
+
+// EpochRecoverEvent is emitted by the recoverEpoch governance transaction.
+// It fully specifies the recovery epoch the network transitions into.
+type EpochRecoverEvent struct {
+	Counter            uint64              `cadence:"counter"`
+	FirstView          uint64              `cadence:"firstView"`
+	FinalView          uint64              `cadence:"finalView"`
+	ClusterAssignments [][]string          `cadence:"clusterAssignments"`
+	RandomSource       string              `cadence:"randomSource"`
+	DKGPhase1FinalView uint64              `cadence:"DKGPhase1FinalView"`
+	DKGPhase2FinalView uint64              `cadence:"DKGPhase2FinalView"`
+	DKGPhase3FinalView uint64              `cadence:"DKGPhase3FinalView"`
+	TargetDuration     uint64              `cadence:"targetDuration"`
+	TargetEndTime      uint64              `cadence:"targetEndTime"`
+	ClusterQCVoteData  []ClusterQCVoteData `cadence:"clusterQCVoteData"`
+	DKGPubKeys         []string            `cadence:"dkgPubKeys"`
+	DKGGroupKey        string              `cadence:"dkgGroupKey"`
+
+	NodeInfo     []NodeInfo
+	DKGIDMapping map[string]int
+}
+
+func (e *EpochRecoverEvent) decodeCadence(composite cadence.Composite) (err error) {
+	fields := composite.FieldsMappedByName()
+
+	e.NodeInfo, err = decodeNodeInfos(fields["nodeInfo"])
+	if err != nil {
+		return err
+	}
+
+	e.DKGIDMapping, err = decodeIDMapping(fields["dkgIdMapping"])
+	return err
+}
+
+// decodeIDMapping decodes a DKG ID mapping, a Cadence {String: Int} dictionary
+// from node ID to the node's index in the DKG committee.
+func decodeIDMapping(value cadence.Value) (map[string]int, error) {
+	dictionary, ok := value.(cadence.Dictionary)
+	if !ok {
+		return nil, fmt.Errorf("expected a Cadence dictionary for the DKG ID mapping, got %T", value)
+	}
+
+	mapping := make(map[string]int, len(dictionary.Pairs))
+	for _, pair := range dictionary.Pairs {
+		nodeID, ok := pair.Key.(cadence.String)
+		if !ok {
+			return nil, fmt.Errorf("cannot decode DKG ID mapping key of type %T", pair.Key)
+		}
+
+		index, ok := pair.Value.(cadence.Int)
+		if !ok {
+			return nil, fmt.Errorf("cannot decode DKG ID mapping value of type %T", pair.Value)
+		}
+		if !index.Big().IsInt64() {
+			return nil, fmt.Errorf("DKG index %s of node %s is out of range", index, nodeID)
+		}
+
+		mapping[string(nodeID)] = int(index.Big().Int64())
+	}
+
+	return mapping, nil
+}
@@ -0,0 +1,208 @@
+// Package events provides Go types and decoders for the events
+// emitted by the Flow core contracts.
+//
+// Every event is described by a Type value, which knows the contract
+// that declares the event, can build the fully qualified event type ID
+// for a given Environment and decodes a flow.Event into its Go struct:
+//
+//	env := templates.Environment{IDTableAddress: "8624b52f9ddcd04a"}
+//
+//	eventType := events.RewardsPaid.ID(env)
+//	// A.8624b52f9ddcd04a.FlowIDTableStaking.RewardsPaid
+//
+//	rewards, err := events.RewardsPaid.Decode(env, event)
+//
+// Decode only accepts events emitted by the contract deployed at its address in the Environment,
+// so that events of a contract with the same name in another account are not mistaken for them.
+//
+// Decoding never panics. A mismatched event type, a missing field or a
+// field of an unexpected Cadence type is reported as an error.
+package events
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+// Contract is the name of a core contract that declares events.
+type Contract string
+
+const (
+	FlowIDTableStaking       Contract = "FlowIDTableStaking"
+	FlowEpoch                Contract = "FlowEpoch"
+	FlowDKG                  Contract = "FlowDKG"
+	FlowClusterQC            Contract = "FlowClusterQC"
+	FlowFees                 Contract = "FlowFees"
+	FlowToken                Contract = "FlowToken"
+	LockedTokens             Contract = "LockedTokens"
+	FlowStakingCollection    Contract = "FlowStakingCollection"
+	NodeVersionBeacon        Contract = "NodeVersionBeacon"
+	RandomBeaconHistory      Contract = "RandomBeaconHistory"
+	FlowTransactionScheduler Contract = "FlowTransactionScheduler"
+)
+
+// Address returns the address the contract is deployed to in the given environment,
+// without a 0x prefix. It returns an empty string if the address is not set.
+func (c Contract) Address(env templates.Environment) string {
+	var address string
+
+	switch c {
+	case FlowIDTableStaking:
+		address = env.IDTableAddress
+	case FlowEpoch:
+		address = env.EpochAddress
+	case FlowDKG:
+		address = env.DkgAddress
+	case FlowClusterQC:
+		address = env.QuorumCertificateAddress
+	case FlowFees:
+		address = env.FlowFeesAddress
+	case FlowToken:
+		address = env.FlowTokenAddress
+	case LockedTokens:
+		address = env.LockedTokensAddress
+	case FlowStakingCollection:
+		address = env.StakingCollectionAddress
+	case NodeVersionBeacon:
+		address = env.NodeVersionBeaconAddress
+	case RandomBeaconHistory:
+		address = env.RandomBeaconHistoryAddress
+	case FlowTransactionScheduler:
+		address = env.FlowTransactionSchedulerAddress
+	}
+
+	return strings.TrimPrefix(address, "0x")
+}
+
+// TypeID returns the fully qualified type ID of an event declared in the given contract,
+// e.g. A.8624b52f9ddcd04a.FlowIDTableStaking.RewardsPaid
+func TypeID(env templates.Environment, contract Contract, eventName string) string {
+	return fmt.Sprintf("A.%s.%s.%s", contract.Address(env), contract, eventName)
+}
+
+// Type describes an event declared in a core contract
+// and decodes it into the Go struct T.
+//
+// The fields of T are mapped to the event fields with `cadence` struct tags.
+type Type[T any] struct {
+	Contract Contract
+	Name     string
+}
+
+// QualifiedIdentifier returns the contract-qualified name of the event,
+// e.g. FlowIDTableStaking.RewardsPaid
+func (t Type[T]) QualifiedIdentifier() string {
+	return fmt.Sprintf("%s.%s", t.Contract, t.Name)
+}
+
+// ID returns the fully qualified type ID of the event in the given environment.
+func (t Type[T]) ID(env templates.Environment) string {
+	return TypeID(env, t.Contract, t.Name)
+}
+
+// Matches reports whether the given event is an instance of this event type,
+// emitted by the contract deployed at its address in the given environment.
+// It never matches if the address of the contract is not set.
+func (t Type[T]) Matches(env templates.Environment, event flow.Event) bool {
+	return t.Contract.Address(env) != "" && event.Type == t.ID(env)
+}
+
+// MatchesAnyAddress reports whether the given event is an instance of this event type,
+// regardless of the address of the contract that emitted it.
+//
+// Any account can deploy a contract with the same name that emits an event with the same name,
+// so only use it for events that are known to be emitted by the core contracts.
+func (t Type[T]) MatchesAnyAddress(event flow.Event) bool {
+	return strings.HasSuffix(event.Type, "."+t.QualifiedIdentifier())
+}
+
+// Decode decodes the given event into T.
+//
+// It returns an error if the event is not of this type, emitted by the contract
+// deployed at its address in the given environment, or if any of its fields cannot be decoded.
+func (t Type[T]) Decode(env templates.Environment, event flow.Event) (T, error) {
+	if !t.Matches(env, event) {
+		var decoded T
+		return decoded, fmt.Errorf(
+			"cannot decode %s event: unexpected event type %q, expected %q",
+			t.QualifiedIdentifier(),
+			event.Type,
+			t.ID(env),
+		)
+	}
+
+	return t.decode(event)
+}
+
+// DecodeAnyAddress decodes the given event into T,
+// regardless of the address of the contract that emitted it, see MatchesAnyAddress.
+//
+// It returns an error if the event is not of this type or if
+// any of its fields cannot be decoded.
+func (t Type[T]) DecodeAnyAddress(event flow.Event) (T, error) {
+	if !t.MatchesAnyAddress(event) {
+		var decoded T
+		return decoded, fmt.Errorf(
+			"cannot decode %s event: unexpected event type %q",
+			t.QualifiedIdentifier(),
+			event.Type,
+		)
+	}
+
+	return t.decode(event)
+}
+
+// decode decodes the fields of the given event into T.
+func (t Type[T]) decode(event flow.Event) (T, error) {
+	var decoded T
+
+	err := DecodeValue(event.Value, &decoded)
+	if err != nil {
+		return decoded, fmt.Errorf("cannot decode %s event: %w", t.QualifiedIdentifier(), err)
+	}
+
+	return decoded, nil
+}
+
+// DecodeValue decodes a Cadence composite value (an event or a struct)
+// into the Go struct pointed to by target.
+//
+// It behaves like cadence.DecodeFields, but returns an error instead of
+// panicking when the value is not a composite, and runs the custom
+// decoding of types implementing the decoder interface.
+func DecodeValue(value cadence.Value, target any) (err error) {
+	// cadence.DecodeFields panics on malformed composites,
+	// e.g. when there are more field values than declared fields
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("malformed Cadence value: %v", r)
+		}
+	}()
+
+	composite, ok := value.(cadence.Composite)
+	if !ok || composite == nil {
+		return fmt.Errorf("expected a Cadence composite value, got %T", value)
+	}
+
+	err = cadence.DecodeFields(composite, target)
+	if err != nil {
+		return err
+	}
+
+	if d, ok := target.(decoder); ok {
+		return d.decodeCadence(composite)
+	}
+
+	return nil
+}
+
+// decoder is implemented by event structs that need to decode
+// fields which cadence.DecodeFields cannot handle on its own.
+type decoder interface {
+	decodeCadence(composite cadence.Composite) error
+}
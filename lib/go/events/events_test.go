@@ -0,0 +1,380 @@
+package events_test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+
+	"github.com/onflow/flow-core-contracts/lib/go/events"
+)
+
+const (
+	idTableAddress = "8624b52f9ddcd04a"
+	epochAddress   = "8624b52f9ddcd04a"
+	dkgAddress     = "8624b52f9ddcd04a"
+)
+
+var env = templates.Environment{
+	IDTableAddress: idTableAddress,
+	EpochAddress:   epochAddress,
+	DkgAddress:     dkgAddress,
+}
+
+// newEvent builds a flow.Event with the given fields,
+// as it would be emitted by the given contract
+func newEvent(t *testing.T, address string, contract events.Contract, name string, fields []cadence.Field, values []cadence.Value) flow.Event {
+	addr, err := common.HexToAddress(address)
+	require.NoError(t, err)
+
+	location := common.NewAddressLocation(nil, addr, string(contract))
+	eventType := cadence.NewEventType(location, string(contract)+"."+name, fields, nil)
+
+	return flow.Event{
+		Type:  string(location.TypeID(nil, string(contract)+"."+name)),
+		Value: cadence.NewEvent(values).WithType(eventType),
+	}
+}
+
+func ufix64(t *testing.T, value string) cadence.UFix64 {
+	v, err := cadence.NewUFix64(value)
+	require.NoError(t, err)
+	return v
+}
+
+func TestTypeID(t *testing.T) {
+	env := templates.Environment{
+		IDTableAddress: idTableAddress,
+		EpochAddress:   "0x" + epochAddress,
+	}
+
+	assert.Equal(t, "A.8624b52f9ddcd04a.FlowIDTableStaking.RewardsPaid", events.RewardsPaid.ID(env))
+	assert.Equal(t, "A.8624b52f9ddcd04a.FlowEpoch.EpochSetup", events.EpochSetup.ID(env))
+	assert.Equal(t, "A.8624b52f9ddcd04a.FlowIDTableStaking.NewEpoch", events.TypeID(env, events.FlowIDTableStaking, "NewEpoch"))
+}
+
+func TestDecodeRewardsPaid(t *testing.T) {
+	event := newEvent(t, idTableAddress, events.FlowIDTableStaking, "RewardsPaid",
+		[]cadence.Field{
+			cadence.NewField("nodeID", cadence.StringType),
+			cadence.NewField("amount", cadence.UFix64Type),
+			cadence.NewField("epochCounter", cadence.UInt64Type),
+		},
+		[]cadence.Value{
+			cadence.String("0001"),
+			ufix64(t, "1250.5"),
+			cadence.NewUInt64(42),
+		},
+	)
+
+	decoded, err := events.RewardsPaid.Decode(env, event)
+	require.NoError(t, err)
+
+	assert.Equal(t, "0001", decoded.NodeID)
+	assert.Equal(t, ufix64(t, "1250.5"), decoded.Amount)
+	assert.Equal(t, uint64(42), decoded.EpochCounter)
+}
+
+func TestDecodeWrongType(t *testing.T) {
+	event := newEvent(t, idTableAddress, events.FlowIDTableStaking, "TokensStaked",
+		[]cadence.Field{
+			cadence.NewField("nodeID", cadence.StringType),
+			cadence.NewField("amount", cadence.UFix64Type),
+		},
+		[]cadence.Value{
+			cadence.String("0001"),
+			ufix64(t, "1.0"),
+		},
+	)
+
+	_, err := events.RewardsPaid.Decode(env, event)
+	require.Error(t, err)
+
+	// events sharing a shape only decode from their own type
+	_, err = events.TokensCommitted.Decode(env, event)
+	require.Error(t, err)
+
+	staked, err := events.TokensStaked.Decode(env, event)
+	require.NoError(t, err)
+	assert.Equal(t, "0001", staked.NodeID)
+}
+
+func TestDecodeOtherAddress(t *testing.T) {
+	// an event of a contract with the same name, deployed to another account
+	event := newEvent(t, "0000000000000001", events.FlowIDTableStaking, "RewardsPaid",
+		[]cadence.Field{
+			cadence.NewField("nodeID", cadence.StringType),
+			cadence.NewField("amount", cadence.UFix64Type),
+			cadence.NewField("epochCounter", cadence.UInt64Type),
+		},
+		[]cadence.Value{
+			cadence.String("0001"),
+			ufix64(t, "1250.5"),
+			cadence.NewUInt64(42),
+		},
+	)
+
+	assert.False(t, events.RewardsPaid.Matches(env, event))
+
+	_, err := events.RewardsPaid.Decode(env, event)
+	require.ErrorContains(t, err, "unexpected event type")
+
+	// the address of the contract is required
+	_, err = events.RewardsPaid.Decode(templates.Environment{}, event)
+	require.Error(t, err)
+
+	// the address is only ignored when explicitly requested
+	assert.True(t, events.RewardsPaid.MatchesAnyAddress(event))
+
+	decoded, err := events.RewardsPaid.DecodeAnyAddress(event)
+	require.NoError(t, err)
+	assert.Equal(t, "0001", decoded.NodeID)
+}
+
+func TestDecodeMalformedEvent(t *testing.T) {
+
+	t.Run("missing field", func(t *testing.T) {
+		event := newEvent(t, idTableAddress, events.FlowIDTableStaking, "RewardsPaid",
+			[]cadence.Field{
+				cadence.NewField("nodeID", cadence.StringType),
+			},
+			[]cadence.Value{
+				cadence.String("0001"),
+			},
+		)
+
+		_, err := events.RewardsPaid.Decode(env, event)
+		require.Error(t, err)
+	})
+
+	t.Run("wrong field type", func(t *testing.T) {
+		event := newEvent(t, idTableAddress, events.FlowIDTableStaking, "RewardsPaid",
+			[]cadence.Field{
+				cadence.NewField("nodeID", cadence.StringType),
+				cadence.NewField("amount", cadence.StringType),
+				cadence.NewField("epochCounter", cadence.UInt64Type),
+			},
+			[]cadence.Value{
+				cadence.String("0001"),
+				cadence.String("1.0"),
+				cadence.NewUInt64(42),
+			},
+		)
+
+		_, err := events.RewardsPaid.Decode(env, event)
+		require.Error(t, err)
+	})
+
+	t.Run("missing event type", func(t *testing.T) {
+		event := flow.Event{
+			Type:  "A.8624b52f9ddcd04a.FlowIDTableStaking.RewardsPaid",
+			Value: cadence.NewEvent([]cadence.Value{cadence.String("0001")}),
+		}
+
+		_, err := events.RewardsPaid.Decode(env, event)
+		require.Error(t, err)
+	})
+}
+
+func TestDecodeEpochSetup(t *testing.T) {
+
+	nodeInfoType := cadence.NewStructType(
+		common.NewAddressLocation(nil, common.MustBytesToAddress([]byte{0x1}), "FlowIDTableStaking"),
+		"FlowIDTableStaking.NodeInfo",
+		[]cadence.Field{
+			cadence.NewField("id", cadence.StringType),
+			cadence.NewField("role", cadence.UInt8Type),
+			cadence.NewField("networkingAddress", cadence.StringType),
+			cadence.NewField("networkingKey", cadence.StringType),
+			cadence.NewField("stakingKey", cadence.StringType),
+			cadence.NewField("tokensStaked", cadence.UFix64Type),
+			cadence.NewField("tokensCommitted", cadence.UFix64Type),
+			cadence.NewField("tokensUnstaking", cadence.UFix64Type),
+			cadence.NewField("tokensUnstaked", cadence.UFix64Type),
+			cadence.NewField("tokensRewarded", cadence.UFix64Type),
+			cadence.NewField("delegators", cadence.NewVariableSizedArrayType(cadence.UInt32Type)),
+			cadence.NewField("delegatorIDCounter", cadence.UInt32Type),
+			cadence.NewField("tokensRequestedToUnstake", cadence.UFix64Type),
+			cadence.NewField("initialWeight", cadence.UInt64Type),
+		},
+		nil,
+	)
+
+	nodeInfo := cadence.NewStruct([]cadence.Value{
+		cadence.String("0001"),
+		cadence.NewUInt8(1),
+		cadence.String("collection.flow:3569"),
+		cadence.String("networkingKey"),
+		cadence.String("stakingKey"),
+		ufix64(t, "250000.0"),
+		ufix64(t, "0.0"),
+		ufix64(t, "0.0"),
+		ufix64(t, "0.0"),
+		ufix64(t, "10.0"),
+		cadence.NewArray([]cadence.Value{cadence.NewUInt32(1), cadence.NewUInt32(2)}),
+		cadence.NewUInt32(2),
+		ufix64(t, "0.0"),
+		cadence.NewUInt64(100),
+	}).WithType(nodeInfoType)
+
+	clusterType := cadence.NewStructType(
+		common.NewAddressLocation(nil, common.MustBytesToAddress([]byte{0x1}), "FlowClusterQC"),
+		"FlowClusterQC.Cluster",
+		[]cadence.Field{
+			cadence.NewField("index", cadence.UInt16Type),
+			cadence.NewField("nodeWeights", cadence.NewDictionaryType(cadence.StringType, cadence.UInt64Type)),
+			cadence.NewField("totalWeight", cadence.UInt64Type),
+		},
+		nil,
+	)
+
+	cluster := cadence.NewStruct([]cadence.Value{
+		cadence.NewUInt16(0),
+		cadence.NewDictionary([]cadence.KeyValuePair{
+			{Key: cadence.String("0001"), Value: cadence.NewUInt64(100)},
+		}),
+		cadence.NewUInt64(100),
+	}).WithType(clusterType)
+
+	event := newEvent(t, epochAddress, events.FlowEpoch, "EpochSetup",
+		[]cadence.Field{
+			cadence.NewField("counter", cadence.UInt64Type),
+			cadence.NewField("nodeInfo", cadence.NewVariableSizedArrayType(nodeInfoType)),
+			cadence.NewField("firstView", cadence.UInt64Type),
+			cadence.NewField("finalView", cadence.UInt64Type),
+			cadence.NewField("collectorClusters", cadence.NewVariableSizedArrayType(clusterType)),
+			cadence.NewField("randomSource", cadence.StringType),
+			cadence.NewField("DKGPhase1FinalView", cadence.UInt64Type),
+			cadence.NewField("DKGPhase2FinalView", cadence.UInt64Type),
+			cadence.NewField("DKGPhase3FinalView", cadence.UInt64Type),
+			cadence.NewField("targetDuration", cadence.UInt64Type),
+			cadence.NewField("targetEndTime", cadence.UInt64Type),
+		},
+		[]cadence.Value{
+			cadence.NewUInt64(2),
+			cadence.NewArray([]cadence.Value{nodeInfo}),
+			cadence.NewUInt64(100),
+			cadence.NewUInt64(199),
+			cadence.NewArray([]cadence.Value{cluster}),
+			cadence.String("01020304"),
+			cadence.NewUInt64(120),
+			cadence.NewUInt64(140),
+			cadence.NewUInt64(160),
+			cadence.NewUInt64(3600),
+			cadence.NewUInt64(1700000000),
+		},
+	)
+
+	setup, err := events.EpochSetup.Decode(env, event)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(2), setup.Counter)
+	assert.Equal(t, uint64(100), setup.FirstView)
+	assert.Equal(t, uint64(199), setup.FinalView)
+	assert.Equal(t, "01020304", setup.RandomSource)
+	assert.Equal(t, uint64(160), setup.DKGPhase3FinalView)
+	assert.Equal(t, uint64(1700000000), setup.TargetEndTime)
+
+	require.Len(t, setup.NodeInfo, 1)
+	assert.Equal(t, "0001", setup.NodeInfo[0].ID)
+	assert.Equal(t, uint8(1), setup.NodeInfo[0].Role)
+	assert.Equal(t, ufix64(t, "250000.0"), setup.NodeInfo[0].TokensStaked)
+	assert.Equal(t, []uint32{1, 2}, setup.NodeInfo[0].Delegators)
+	assert.Equal(t, uint64(100), setup.NodeInfo[0].InitialWeight)
+
+	require.Len(t, setup.CollectorClusters, 1)
+	assert.Equal(t, map[string]uint64{"0001": 100}, setup.CollectorClusters[0].NodeWeights)
+	assert.Equal(t, uint64(100), setup.CollectorClusters[0].TotalWeight)
+}
+
+func TestDecodeEpochCommit(t *testing.T) {
+	event := newEvent(t, epochAddress, events.FlowEpoch, "EpochCommit",
+		[]cadence.Field{
+			cadence.NewField("counter", cadence.UInt64Type),
+			cadence.NewField("clusterQCs", cadence.NewVariableSizedArrayType(cadence.AnyStructType)),
+			cadence.NewField("dkgPubKeys", cadence.NewVariableSizedArrayType(cadence.StringType)),
+			cadence.NewField("dkgGroupKey", cadence.StringType),
+			cadence.NewField("dkgIdMapping", cadence.NewDictionaryType(cadence.StringType, cadence.IntType)),
+		},
+		[]cadence.Value{
+			cadence.NewUInt64(2),
+			cadence.NewArray(nil),
+			cadence.NewArray([]cadence.Value{cadence.String("key1"), cadence.String("key2")}),
+			cadence.String("groupKey"),
+			cadence.NewDictionary([]cadence.KeyValuePair{
+				{Key: cadence.String("0001"), Value: cadence.NewInt(0)},
+				{Key: cadence.String("0002"), Value: cadence.NewInt(1)},
+			}),
+		},
+	)
+
+	commit, err := events.EpochCommit.Decode(env, event)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(2), commit.Counter)
+	assert.Empty(t, commit.ClusterQCs)
+	assert.Equal(t, []string{"key1", "key2"}, commit.DKGPubKeys)
+	assert.Equal(t, "groupKey", commit.DKGGroupKey)
+	assert.Equal(t, map[string]int{"0001": 0, "0002": 1}, commit.DKGIDMapping)
+}
+
+func TestDecodeEndDKG(t *testing.T) {
+	submissionType := cadence.NewStructType(
+		common.NewAddressLocation(nil, common.MustBytesToAddress([]byte{0x1}), "FlowDKG"),
+		"FlowDKG.ResultSubmission",
+		[]cadence.Field{
+			cadence.NewField("groupPubKey", cadence.NewOptionalType(cadence.StringType)),
+			cadence.NewField("pubKeys", cadence.NewOptionalType(cadence.NewVariableSizedArrayType(cadence.StringType))),
+			cadence.NewField("idMapping", cadence.NewOptionalType(cadence.NewDictionaryType(cadence.StringType, cadence.IntType))),
+		},
+		nil,
+	)
+
+	fields := []cadence.Field{
+		cadence.NewField("finalSubmission", cadence.NewOptionalType(submissionType)),
+	}
+
+	t.Run("no result", func(t *testing.T) {
+		event := newEvent(t, dkgAddress, events.FlowDKG, "EndDKG", fields,
+			[]cadence.Value{cadence.NewOptional(nil)},
+		)
+
+		endDKG, err := events.EndDKG.Decode(env, event)
+		require.NoError(t, err)
+		assert.Nil(t, endDKG.FinalSubmission)
+	})
+
+	t.Run("result", func(t *testing.T) {
+		submission := cadence.NewStruct([]cadence.Value{
+			cadence.NewOptional(cadence.String("groupKey")),
+			cadence.NewOptional(cadence.NewArray([]cadence.Value{cadence.String("key1")})),
+			cadence.NewOptional(cadence.NewDictionary([]cadence.KeyValuePair{
+				{Key: cadence.String("0001"), Value: cadence.NewInt(0)},
+			})),
+		}).WithType(submissionType)
+
+		event := newEvent(t, dkgAddress, events.FlowDKG, "EndDKG", fields,
+			[]cadence.Value{cadence.NewOptional(submission)},
+		)
+
+		endDKG, err := events.EndDKG.Decode(env, event)
+		require.NoError(t, err)
+		require.NotNil(t, endDKG.FinalSubmission)
+		assert.False(t, endDKG.FinalSubmission.IsEmpty())
+		assert.Equal(t, "groupKey", *endDKG.FinalSubmission.GroupPubKey)
+		assert.Equal(t, []string{"key1"}, *endDKG.FinalSubmission.PubKeys)
+		assert.Equal(t, map[string]int{"0001": 0}, endDKG.FinalSubmission.IDMapping)
+	})
+}
+
+func TestSemverString(t *testing.T) {
+	preRelease := "rc.1"
+
+	assert.Equal(t, "1.2.3", events.Semver{Major: 1, Minor: 2, Patch: 3}.String())
+	assert.Equal(t, "1.2.3-rc.1", events.Semver{Major: 1, Minor: 2, Patch: 3, PreRelease: &preRelease}.String())
+}
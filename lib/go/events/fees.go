@@ -0,0 +1,41 @@
+package events
+
+import (
+	"github.com/onflow/cadence"
+)
+
+// FlowFees events ----------------------------------------------------------
+
+var (
+	FeesTokensDeposited     = Type[FeesTokensEvent]{FlowFees, "TokensDeposited"}
+	FeesTokensWithdrawn     = Type[FeesTokensEvent]{FlowFees, "TokensWithdrawn"}
+	FeesDeducted            = Type[FeesDeductedEvent]{FlowFees, "FeesDeducted"}
+	FeeParametersChanged    = Type[FeeParametersChangedEvent]{FlowFees, "FeeParametersChanged"}
+	ChildFeeAccountsChanged = Type[ChildFeeAccountsChangedEvent]{FlowFees, "ChildFeeAccountsChanged"}
+)
+
+// FeesTokensEvent is emitted when fees are deposited into
+// or withdrawn from the fee vault.
+type FeesTokensEvent struct {
+	Amount cadence.UFix64 `cadence:"amount"`
+}
+
+// FeesDeductedEvent is emitted when the fees for a transaction are deducted.
+type FeesDeductedEvent struct {
+	Amount          cadence.UFix64 `cadence:"amount"`
+	InclusionEffort cadence.UFix64 `cadence:"inclusionEffort"`
+	ExecutionEffort cadence.UFix64 `cadence:"executionEffort"`
+}
+
+// FeeParametersChangedEvent is emitted when the transaction fee parameters change.
+type FeeParametersChangedEvent struct {
+	SurgeFactor         cadence.UFix64 `cadence:"surgeFactor"`
+	InclusionEffortCost cadence.UFix64 `cadence:"inclusionEffortCost"`
+	ExecutionEffortCost cadence.UFix64 `cadence:"executionEffortCost"`
+}
+
+// ChildFeeAccountsChangedEvent is emitted when the set of accounts
+// that receive a share of the fees changes.
+type ChildFeeAccountsChangedEvent struct {
+	Addresses []cadence.Address `cadence:"addresses"`
+}
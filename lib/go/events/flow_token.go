@@ -0,0 +1,40 @@
+package events
+
+import (
+	"github.com/onflow/cadence"
+)
+
+// FlowToken events ---------------------------------------------------------
+
+var (
+	FlowTokensWithdrawn    = Type[FlowTokensWithdrawnEvent]{FlowToken, "TokensWithdrawn"}
+	FlowTokensDeposited    = Type[FlowTokensDepositedEvent]{FlowToken, "TokensDeposited"}
+	FlowTokensMinted       = Type[FlowTokensMintedEvent]{FlowToken, "TokensMinted"}
+	FlowTokenMinterCreated = Type[FlowTokenMinterCreatedEvent]{FlowToken, "MinterCreated"}
+	FlowTokenBurnerCreated = Type[FlowTokenBurnerCreatedEvent]{FlowToken, "BurnerCreated"}
+)
+
+// FlowTokensWithdrawnEvent is emitted when tokens are withdrawn from a FlowToken vault.
+type FlowTokensWithdrawnEvent struct {
+	Amount cadence.UFix64   `cadence:"amount"`
+	From   *cadence.Address `cadence:"from"`
+}
+
+// FlowTokensDepositedEvent is emitted when tokens are deposited into a FlowToken vault.
+type FlowTokensDepositedEvent struct {
+	Amount cadence.UFix64   `cadence:"amount"`
+	To     *cadence.Address `cadence:"to"`
+}
+
+// FlowTokensMintedEvent is emitted when new tokens are minted.
+type FlowTokensMintedEvent struct {
+	Amount cadence.UFix64 `cadence:"amount"`
+}
+
+// FlowTokenMinterCreatedEvent is emitted when a new minter resource is created.
+type FlowTokenMinterCreatedEvent struct {
+	AllowedAmount cadence.UFix64 `cadence:"allowedAmount"`
+}
+
+// FlowTokenBurnerCreatedEvent is emitted when a new burner resource is created.
+type FlowTokenBurnerCreatedEvent struct{}
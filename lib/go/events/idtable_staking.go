@@ -0,0 +1,212 @@
+package events
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+)
+
+// The node roles, as in the role field of the FlowIDTableStaking events and of FlowIDTableStaking.NodeInfo.
+// FlowEpoch uses the same values for its NodeRole enum.
+const (
+	CollectionRole   uint8 = 1
+	ConsensusRole    uint8 = 2
+	ExecutionRole    uint8 = 3
+	VerificationRole uint8 = 4
+	AccessRole       uint8 = 5
+)
+
+// Roles are all the node roles, in order.
+var Roles = []uint8{
+	CollectionRole,
+	ConsensusRole,
+	ExecutionRole,
+	VerificationRole,
+	AccessRole,
+}
+
+// FlowIDTableStaking events ------------------------------------------------
+
+var (
+	NewEpoch              = Type[NewEpochEvent]{FlowIDTableStaking, "NewEpoch"}
+	EpochTotalRewardsPaid = Type[EpochTotalRewardsPaidEvent]{FlowIDTableStaking, "EpochTotalRewardsPaid"}
+
+	NewNodeCreated               = Type[NewNodeCreatedEvent]{FlowIDTableStaking, "NewNodeCreated"}
+	TokensCommitted              = Type[NodeTokensEvent]{FlowIDTableStaking, "TokensCommitted"}
+	TokensStaked                 = Type[NodeTokensEvent]{FlowIDTableStaking, "TokensStaked"}
+	NodeTokensRequestedToUnstake = Type[NodeTokensEvent]{FlowIDTableStaking, "NodeTokensRequestedToUnstake"}
+	TokensUnstaking              = Type[NodeTokensEvent]{FlowIDTableStaking, "TokensUnstaking"}
+	TokensUnstaked               = Type[NodeTokensEvent]{FlowIDTableStaking, "TokensUnstaked"}
+	NodeRemovedAndRefunded       = Type[NodeTokensEvent]{FlowIDTableStaking, "NodeRemovedAndRefunded"}
+	RewardsPaid                  = Type[RewardsPaidEvent]{FlowIDTableStaking, "RewardsPaid"}
+	UnstakedTokensWithdrawn      = Type[NodeTokensEvent]{FlowIDTableStaking, "UnstakedTokensWithdrawn"}
+	RewardTokensWithdrawn        = Type[NodeTokensEvent]{FlowIDTableStaking, "RewardTokensWithdrawn"}
+	NetworkingAddressUpdated     = Type[NetworkingAddressUpdatedEvent]{FlowIDTableStaking, "NetworkingAddressUpdated"}
+	NodeWeightChanged            = Type[NodeWeightChangedEvent]{FlowIDTableStaking, "NodeWeightChanged"}
+
+	NewDelegatorCreated               = Type[NewDelegatorCreatedEvent]{FlowIDTableStaking, "NewDelegatorCreated"}
+	DelegatorTokensCommitted          = Type[DelegatorTokensEvent]{FlowIDTableStaking, "DelegatorTokensCommitted"}
+	DelegatorTokensStaked             = Type[DelegatorTokensEvent]{FlowIDTableStaking, "DelegatorTokensStaked"}
+	DelegatorTokensRequestedToUnstake = Type[DelegatorTokensEvent]{FlowIDTableStaking, "DelegatorTokensRequestedToUnstake"}
+	DelegatorTokensUnstaking          = Type[DelegatorTokensEvent]{FlowIDTableStaking, "DelegatorTokensUnstaking"}
+	DelegatorTokensUnstaked           = Type[DelegatorTokensEvent]{FlowIDTableStaking, "DelegatorTokensUnstaked"}
+	DelegatorRewardsPaid              = Type[DelegatorRewardsPaidEvent]{FlowIDTableStaking, "DelegatorRewardsPaid"}
+	DelegatorUnstakedTokensWithdrawn  = Type[DelegatorTokensEvent]{FlowIDTableStaking, "DelegatorUnstakedTokensWithdrawn"}
+	DelegatorRewardTokensWithdrawn    = Type[DelegatorTokensEvent]{FlowIDTableStaking, "DelegatorRewardTokensWithdrawn"}
+
+	NewDelegatorCutPercentage  = Type[NewDelegatorCutPercentageEvent]{FlowIDTableStaking, "NewDelegatorCutPercentage"}
+	NewWeeklyPayout            = Type[NewWeeklyPayoutEvent]{FlowIDTableStaking, "NewWeeklyPayout"}
+	NewStakingMinimums         = Type[NewStakingMinimumsEvent]{FlowIDTableStaking, "NewStakingMinimums"}
+	NewDelegatorStakingMinimum = Type[NewDelegatorStakingMinimumEvent]{FlowIDTableStaking, "NewDelegatorStakingMinimum"}
+)
+
+// NewEpochEvent is emitted by FlowIDTableStaking when the staking auction
+// moves tokens between buckets at the end of an epoch.
+type NewEpochEvent struct {
+	TotalStaked       cadence.UFix64 `cadence:"totalStaked"`
+	TotalRewardPayout cadence.UFix64 `cadence:"totalRewardPayout"`
+	NewEpochCounter   uint64         `cadence:"newEpochCounter"`
+}
+
+// EpochTotalRewardsPaidEvent is emitted when the rewards for an epoch are paid out.
+type EpochTotalRewardsPaidEvent struct {
+	Total                  cadence.UFix64 `cadence:"total"`
+	FromFees               cadence.UFix64 `cadence:"fromFees"`
+	Minted                 cadence.UFix64 `cadence:"minted"`
+	FeesBurned             cadence.UFix64 `cadence:"feesBurned"`
+	EpochCounterForRewards uint64         `cadence:"epochCounterForRewards"`
+}
+
+// NewNodeCreatedEvent is emitted when a new node is registered.
+type NewNodeCreatedEvent struct {
+	NodeID          string         `cadence:"nodeID"`
+	Role            uint8          `cadence:"role"`
+	AmountCommitted cadence.UFix64 `cadence:"amountCommitted"`
+}
+
+// NodeTokensEvent is the shape shared by all node events that move
+// an amount of tokens in or out of one of the node's buckets.
+type NodeTokensEvent struct {
+	NodeID string         `cadence:"nodeID"`
+	Amount cadence.UFix64 `cadence:"amount"`
+}
+
+// RewardsPaidEvent is emitted when a node operator is paid rewards for an epoch.
+type RewardsPaidEvent struct {
+	NodeID       string         `cadence:"nodeID"`
+	Amount       cadence.UFix64 `cadence:"amount"`
+	EpochCounter uint64         `cadence:"epochCounter"`
+}
+
+// NetworkingAddressUpdatedEvent is emitted when a node changes its networking address.
+type NetworkingAddressUpdatedEvent struct {
+	NodeID     string `cadence:"nodeID"`
+	NewAddress string `cadence:"newAddress"`
+}
+
+// NodeWeightChangedEvent is emitted when the admin changes the weight of a node.
+type NodeWeightChangedEvent struct {
+	NodeID    string `cadence:"nodeID"`
+	NewWeight uint64 `cadence:"newWeight"`
+}
+
+// NewDelegatorCreatedEvent is emitted when a new delegator is registered for a node.
+type NewDelegatorCreatedEvent struct {
+	NodeID      string `cadence:"nodeID"`
+	DelegatorID uint32 `cadence:"delegatorID"`
+}
+
+// DelegatorTokensEvent is the shape shared by all delegator events that move
+// an amount of tokens in or out of one of the delegator's buckets.
+type DelegatorTokensEvent struct {
+	NodeID      string         `cadence:"nodeID"`
+	DelegatorID uint32         `cadence:"delegatorID"`
+	Amount      cadence.UFix64 `cadence:"amount"`
+}
+
+// DelegatorRewardsPaidEvent is emitted when a delegator is paid rewards for an epoch.
+type DelegatorRewardsPaidEvent struct {
+	NodeID       string         `cadence:"nodeID"`
+	DelegatorID  uint32         `cadence:"delegatorID"`
+	Amount       cadence.UFix64 `cadence:"amount"`
+	EpochCounter uint64         `cadence:"epochCounter"`
+}
+
+// NewDelegatorCutPercentageEvent is emitted when the delegator cut percentage changes.
+type NewDelegatorCutPercentageEvent struct {
+	NewCutPercentage cadence.UFix64 `cadence:"newCutPercentage"`
+}
+
+// NewWeeklyPayoutEvent is emitted when the weekly reward payout changes.
+type NewWeeklyPayoutEvent struct {
+	NewPayout cadence.UFix64 `cadence:"newPayout"`
+}
+
+// NewStakingMinimumsEvent is emitted when the minimum stake per node role changes.
+type NewStakingMinimumsEvent struct {
+	NewMinimums map[uint8]cadence.UFix64 `cadence:"newMinimums"`
+}
+
+// NewDelegatorStakingMinimumEvent is emitted when the minimum delegator stake changes.
+type NewDelegatorStakingMinimumEvent struct {
+	NewMinimum cadence.UFix64 `cadence:"newMinimum"`
+}
+
+// NodeInfo is the FlowIDTableStaking.NodeInfo struct, as included
+// in the node lists of the EpochSetup and EpochRecover events.
+type NodeInfo struct {
+	ID                       string         `cadence:"id"`
+	Role                     uint8          `cadence:"role"`
+	NetworkingAddress        string         `cadence:"networkingAddress"`
+	NetworkingKey            string         `cadence:"networkingKey"`
+	StakingKey               string         `cadence:"stakingKey"`
+	TokensStaked             cadence.UFix64 `cadence:"tokensStaked"`
+	TokensCommitted          cadence.UFix64 `cadence:"tokensCommitted"`
+	TokensUnstaking          cadence.UFix64 `cadence:"tokensUnstaking"`
+	TokensUnstaked           cadence.UFix64 `cadence:"tokensUnstaked"`
+	TokensRewarded           cadence.UFix64 `cadence:"tokensRewarded"`
+	DelegatorIDCounter       uint32         `cadence:"delegatorIDCounter"`
+	TokensRequestedToUnstake cadence.UFix64 `cadence:"tokensRequestedToUnstake"`
+	InitialWeight            uint64         `cadence:"initialWeight"`
+
+	// Delegators holds the IDs of the node's delegators.
+	// It is decoded separately, because the field is declared as a reference
+	// and is not guaranteed to be present in exported values.
+	Delegators []uint32
+}
+
+func (n *NodeInfo) decodeCadence(composite cadence.Composite) error {
+	delegators, ok := composite.FieldsMappedByName()["delegators"].(cadence.Array)
+	if !ok {
+		return nil
+	}
+
+	n.Delegators = make([]uint32, 0, len(delegators.Values))
+	for _, value := range delegators.Values {
+		id, ok := value.(cadence.UInt32)
+		if !ok {
+			return fmt.Errorf("cannot decode delegator ID of type %T", value)
+		}
+		n.Delegators = append(n.Delegators, uint32(id))
+	}
+
+	return nil
+}
+
+// decodeNodeInfos decodes a Cadence array of FlowIDTableStaking.NodeInfo structs.
+func decodeNodeInfos(value cadence.Value) ([]NodeInfo, error) {
+	array, ok := value.(cadence.Array)
+	if !ok {
+		return nil, fmt.Errorf("expected a Cadence array of node info, got %T", value)
+	}
+
+	nodes := make([]NodeInfo, len(array.Values))
+	for i, element := range array.Values {
+		err := DecodeValue(element, &nodes[i])
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode node info %d: %w", i, err)
+		}
+	}
+
+	return nodes, nil
+}
@@ -0,0 +1,41 @@
+package events
+
+import (
+	"github.com/onflow/cadence"
+)
+
+// LockedTokens events ------------------------------------------------------
+
+var (
+	SharedAccountRegistered            = Type[LockedAccountEvent]{LockedTokens, "SharedAccountRegistered"}
+	UnlockedAccountRegistered          = Type[LockedAccountEvent]{LockedTokens, "UnlockedAccountRegistered"}
+	UnlockLimitIncreased               = Type[UnlockLimitIncreasedEvent]{LockedTokens, "UnlockLimitIncreased"}
+	LockedAccountRegisteredAsNode      = Type[LockedAccountRegisteredEvent]{LockedTokens, "LockedAccountRegisteredAsNode"}
+	LockedAccountRegisteredAsDelegator = Type[LockedAccountRegisteredEvent]{LockedTokens, "LockedAccountRegisteredAsDelegator"}
+	LockedTokensDeposited              = Type[LockedTokensDepositedEvent]{LockedTokens, "LockedTokensDeposited"}
+)
+
+// LockedAccountEvent is emitted when a shared or unlocked account is registered.
+type LockedAccountEvent struct {
+	Address cadence.Address `cadence:"address"`
+}
+
+// UnlockLimitIncreasedEvent is emitted when the unlock limit of a locked account increases.
+type UnlockLimitIncreasedEvent struct {
+	Address        cadence.Address `cadence:"address"`
+	IncreaseAmount cadence.UFix64  `cadence:"increaseAmount"`
+	NewLimit       cadence.UFix64  `cadence:"newLimit"`
+}
+
+// LockedAccountRegisteredEvent is emitted when a locked account
+// registers a node or a delegator.
+type LockedAccountRegisteredEvent struct {
+	Address cadence.Address `cadence:"address"`
+	NodeID  string          `cadence:"nodeID"`
+}
+
+// LockedTokensDepositedEvent is emitted when tokens are deposited into a locked account.
+type LockedTokensDepositedEvent struct {
+	Address cadence.Address `cadence:"address"`
+	Amount  cadence.UFix64  `cadence:"amount"`
+}
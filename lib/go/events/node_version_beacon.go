@@ -0,0 +1,54 @@
+package events
+
+import (
+	"fmt"
+)
+
+// NodeVersionBeacon events -------------------------------------------------
+
+var (
+	VersionBeacon                          = Type[VersionBeaconEvent]{NodeVersionBeacon, "VersionBeacon"}
+	NodeVersionBoundaryFreezePeriodChanged = Type[NodeVersionBoundaryFreezePeriodChangedEvent]{NodeVersionBeacon, "NodeVersionBoundaryFreezePeriodChanged"}
+	ProtocolStateVersionUpgrade            = Type[ProtocolStateVersionUpgradeEvent]{NodeVersionBeacon, "ProtocolStateVersionUpgrade"}
+)
+
+// VersionBeaconEvent is the service event emitted when the version table is updated.
+type VersionBeaconEvent struct {
+	VersionBoundaries []VersionBoundary `cadence:"versionBoundaries"`
+	Sequence          uint64            `cadence:"sequence"`
+}
+
+// VersionBoundary is the NodeVersionBeacon.VersionBoundary struct.
+type VersionBoundary struct {
+	BlockHeight uint64 `cadence:"blockHeight"`
+	Version     Semver `cadence:"version"`
+}
+
+// Semver is the NodeVersionBeacon.Semver struct.
+type Semver struct {
+	Major      uint8   `cadence:"major"`
+	Minor      uint8   `cadence:"minor"`
+	Patch      uint8   `cadence:"patch"`
+	PreRelease *string `cadence:"preRelease"`
+}
+
+// String returns the version in the same format as Semver.toString() in the contract.
+func (s Semver) String() string {
+	version := fmt.Sprintf("%d.%d.%d", s.Major, s.Minor, s.Patch)
+	if s.PreRelease != nil {
+		version += "-" + *s.PreRelease
+	}
+	return version
+}
+
+// NodeVersionBoundaryFreezePeriodChangedEvent is emitted when the version boundary freeze period changes.
+type NodeVersionBoundaryFreezePeriodChangedEvent struct {
+	FreezePeriod uint64 `cadence:"freezePeriod"`
+}
+
+// ProtocolStateVersionUpgradeEvent is the service event emitted
+// to schedule a protocol state version upgrade.
+type ProtocolStateVersionUpgradeEvent struct {
+	NewProtocolVersion uint64 `cadence:"newProtocolVersion"`
+	ActiveView         uint64 `cadence:"activeView"`
+}
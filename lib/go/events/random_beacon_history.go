@@ -0,0 +1,21 @@
+package events
+
+// RandomBeaconHistory events -----------------------------------------------
+
+var (
+	RandomHistoryMissing    = Type[RandomHistoryMissingEvent]{RandomBeaconHistory, "RandomHistoryMissing"}
+	RandomHistoryBackfilled = Type[RandomHistoryBackfilledEvent]{RandomBeaconHistory, "RandomHistoryBackfilled"}
+)
+
+// RandomHistoryMissingEvent is emitted when a gap in the random source history is detected.
+type RandomHistoryMissingEvent struct {
+	BlockHeight    uint64 `cadence:"blockHeight"`
+	GapStartHeight uint64 `cadence:"gapStartHeight"`
+}
+
+// RandomHistoryBackfilledEvent is emitted when missing random sources are backfilled.
+type RandomHistoryBackfilledEvent struct {
+	BlockHeight    uint64 `cadence:"blockHeight"`
+	GapStartHeight uint64 `cadence:"gapStartHeight"`
+	Count          uint64 `cadence:"count"`
+}
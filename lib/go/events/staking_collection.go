@@ -0,0 +1,53 @@
+package events
+
+import (
+	"github.com/onflow/cadence"
+)
+
+// FlowStakingCollection events ---------------------------------------------
+
+var (
+	NodeAddedToStakingCollection          = Type[NodeAddedToStakingCollectionEvent]{FlowStakingCollection, "NodeAddedToStakingCollection"}
+	DelegatorAddedToStakingCollection     = Type[DelegatorAddedToStakingCollectionEvent]{FlowStakingCollection, "DelegatorAddedToStakingCollection"}
+	NodeRemovedFromStakingCollection      = Type[NodeRemovedFromStakingCollectionEvent]{FlowStakingCollection, "NodeRemovedFromStakingCollection"}
+	DelegatorRemovedFromStakingCollection = Type[DelegatorRemovedFromStakingCollectionEvent]{FlowStakingCollection, "DelegatorRemovedFromStakingCollection"}
+	MachineAccountCreated                 = Type[MachineAccountCreatedEvent]{FlowStakingCollection, "MachineAccountCreated"}
+)
+
+// NodeAddedToStakingCollectionEvent is emitted when a node is added to a staking collection.
+type NodeAddedToStakingCollectionEvent struct {
+	NodeID          string           `cadence:"nodeID"`
+	Role            uint8            `cadence:"role"`
+	AmountCommitted cadence.UFix64   `cadence:"amountCommitted"`
+	Address         *cadence.Address `cadence:"address"`
+}
+
+// DelegatorAddedToStakingCollectionEvent is emitted when a delegator is added to a staking collection.
+type DelegatorAddedToStakingCollectionEvent struct {
+	NodeID          string           `cadence:"nodeID"`
+	DelegatorID     uint32           `cadence:"delegatorID"`
+	AmountCommitted cadence.UFix64   `cadence:"amountCommitted"`
+	Address         *cadence.Address `cadence:"address"`
+}
+
+// NodeRemovedFromStakingCollectionEvent is emitted when a node is removed from a staking collection.
+type NodeRemovedFromStakingCollectionEvent struct {
+	NodeID  string           `cadence:"nodeID"`
+	Role    uint8            `cadence:"role"`
+	Address *cadence.Address `cadence:"address"`
+}
+
+// DelegatorRemovedFromStakingCollectionEvent is emitted when a delegator is removed from a staking collection.
+type DelegatorRemovedFromStakingCollectionEvent struct {
+	NodeID      string           `cadence:"nodeID"`
+	DelegatorID uint32           `cadence:"delegatorID"`
+	Address     *cadence.Address `cadence:"address"`
+}
+
+// MachineAccountCreatedEvent is emitted when a machine account
+// is created for a collection or consensus node.
+type MachineAccountCreatedEvent struct {
+	NodeID  string          `cadence:"nodeID"`
+	Role    uint8           `cadence:"role"`
+	Address cadence.Address `cadence:"address"`
+}
@@ -0,0 +1,93 @@
+package events
+
+import (
+	"github.com/onflow/cadence"
+)
+
+// FlowTransactionScheduler events ------------------------------------------
+
+var (
+	TransactionScheduled        = Type[TransactionScheduledEvent]{FlowTransactionScheduler, "Scheduled"}
+	TransactionPendingExecution = Type[TransactionPendingExecutionEvent]{FlowTransactionScheduler, "PendingExecution"}
+	TransactionExecuted         = Type[TransactionExecutedEvent]{FlowTransactionScheduler, "Executed"}
+	TransactionCanceled         = Type[TransactionCanceledEvent]{FlowTransactionScheduler, "Canceled"}
+	CollectionLimitReached      = Type[CollectionLimitReachedEvent]{FlowTransactionScheduler, "CollectionLimitReached"}
+	RemovalLimitReached         = Type[RemovalLimitReachedEvent]{FlowTransactionScheduler, "RemovalLimitReached"}
+	SchedulerConfigUpdated      = Type[SchedulerConfigUpdatedEvent]{FlowTransactionScheduler, "ConfigUpdated"}
+	SchedulerCriticalIssue      = Type[SchedulerCriticalIssueEvent]{FlowTransactionScheduler, "CriticalIssue"}
+
+	// ScheduledTransactionDestroyed is the default destroy event
+	// of the FlowTransactionScheduler.ScheduledTransaction resource.
+	ScheduledTransactionDestroyed = Type[ScheduledTransactionDestroyedEvent]{FlowTransactionScheduler, "ScheduledTransaction.ResourceDestroyed"}
+)
+
+// TransactionScheduledEvent is emitted when a transaction is scheduled.
+type TransactionScheduledEvent struct {
+	ID                               uint64          `cadence:"id"`
+	Priority                         uint8           `cadence:"priority"`
+	Timestamp                        cadence.UFix64  `cadence:"timestamp"`
+	ExecutionEffort                  uint64          `cadence:"executionEffort"`
+	Fees                             cadence.UFix64  `cadence:"fees"`
+	TransactionHandlerOwner          cadence.Address `cadence:"transactionHandlerOwner"`
+	TransactionHandlerTypeIdentifier string          `cadence:"transactionHandlerTypeIdentifier"`
+	TransactionHandlerUUID           uint64          `cadence:"transactionHandlerUUID"`
+	TransactionHandlerPublicPath     *cadence.Path   `cadence:"transactionHandlerPublicPath"`
+}
+
+// TransactionPendingExecutionEvent is emitted when the scheduled timestamp
+// of a transaction is reached and it is ready for execution.
+type TransactionPendingExecutionEvent struct {
+	ID                               uint64          `cadence:"id"`
+	Priority                         uint8           `cadence:"priority"`
+	ExecutionEffort                  uint64          `cadence:"executionEffort"`
+	Fees                             cadence.UFix64  `cadence:"fees"`
+	TransactionHandlerOwner          cadence.Address `cadence:"transactionHandlerOwner"`
+	TransactionHandlerTypeIdentifier string          `cadence:"transactionHandlerTypeIdentifier"`
+}
+
+// TransactionExecutedEvent is emitted when a scheduled transaction is executed.
+type TransactionExecutedEvent struct {
+	ID                               uint64          `cadence:"id"`
+	Priority                         uint8           `cadence:"priority"`
+	ExecutionEffort                  uint64          `cadence:"executionEffort"`
+	TransactionHandlerOwner          cadence.Address `cadence:"transactionHandlerOwner"`
+	TransactionHandlerTypeIdentifier string          `cadence:"transactionHandlerTypeIdentifier"`
+	TransactionHandlerUUID           uint64          `cadence:"transactionHandlerUUID"`
+	TransactionHandlerPublicPath     *cadence.Path   `cadence:"transactionHandlerPublicPath"`
+}
+
+// TransactionCanceledEvent is emitted when a scheduled transaction is canceled by its creator.
+type TransactionCanceledEvent struct {
+	ID                               uint64          `cadence:"id"`
+	Priority                         uint8           `cadence:"priority"`
+	FeesReturned                     cadence.UFix64  `cadence:"feesReturned"`
+	FeesDeducted                     cadence.UFix64  `cadence:"feesDeducted"`
+	TransactionHandlerOwner          cadence.Address `cadence:"transactionHandlerOwner"`
+	TransactionHandlerTypeIdentifier string          `cadence:"transactionHandlerTypeIdentifier"`
+}
+
+// CollectionLimitReachedEvent is emitted when a collection limit is reached.
+// Only the limit that was reached is set.
+type CollectionLimitReachedEvent struct {
+	CollectionEffortLimit       *uint64      `cadence:"collectionEffortLimit"`
+	CollectionTransactionsLimit *cadence.Int `cadence:"collectionTransactionsLimit"`
+}
+
+// RemovalLimitReachedEvent is emitted when the limit on the number
+// of transactions removed while processing is reached.
+type RemovalLimitReachedEvent struct{}
+
+// SchedulerConfigUpdatedEvent is emitted when the scheduler configuration changes.
+type SchedulerConfigUpdatedEvent struct{}
+
+// SchedulerCriticalIssueEvent is emitted when the scheduler encounters a critical issue.
+type SchedulerCriticalIssueEvent struct {
+	Message string `cadence:"message"`
+}
+
+// ScheduledTransactionDestroyedEvent is emitted when a ScheduledTransaction resource is destroyed.
+type ScheduledTransactionDestroyedEvent struct {
+	ID                    uint64         `cadence:"id"`
+	Timestamp             cadence.UFix64 `cadence:"timestamp"`
+	HandlerTypeIdentifier string         `cadence:"handlerTypeIdentifier"`
+}
@@ -0,0 +1,52 @@
+package staking
+
+import (
+	"context"
+
+	"github.com/onflow/cadence"
+)
+
+// ScriptExecutor executes Cadence scripts against the chain.
+//
+// It is implemented by NewAccessScriptExecutor for the access API.
+// Other backends, e.g. the emulator or a mock, can be adapted with ScriptExecutorFunc:
+//
+//	executor := staking.ScriptExecutorFunc(func(_ context.Context, script []byte, arguments []cadence.Value) (cadence.Value, error) {
+//		encoded := make([][]byte, len(arguments))
+//		for i, argument := range arguments {
+//			encoded[i] = jsoncdc.MustEncode(argument)
+//		}
+//
+//		result, err := blockchain.ExecuteScript(script, encoded)
+//		if err != nil {
+//			return nil, err
+//		}
+//		if !result.Succeeded() {
+//			return nil, result.Error
+//		}
+//		return result.Value, nil
+//	})
+type ScriptExecutor interface {
+	ExecuteScript(ctx context.Context, script []byte, arguments []cadence.Value) (cadence.Value, error)
+}
+
+// ScriptExecutorFunc is a function that implements ScriptExecutor.
+type ScriptExecutorFunc func(ctx context.Context, script []byte, arguments []cadence.Value) (cadence.Value, error)
+
+// ExecuteScript calls f.
+func (f ScriptExecutorFunc) ExecuteScript(ctx context.Context, script []byte, arguments []cadence.Value) (cadence.Value, error) {
+	return f(ctx, script, arguments)
+}
+
+// AccessScriptClient is the part of the Flow access API the access script executor needs.
+// It is implemented by the access clients of the Flow Go SDK,
+// e.g. github.com/onflow/flow-go-sdk/access/grpc.Client.
+type AccessScriptClient interface {
+	ExecuteScriptAtLatestBlock(ctx context.Context, script []byte, arguments []cadence.Value) (cadence.Value, error)
+}
+
+// NewAccessScriptExecutor returns a ScriptExecutor that executes scripts
+// at the latest sealed block through the given access API client.
+func NewAccessScriptExecutor(client AccessScriptClient) ScriptExecutor {
+	return ScriptExecutorFunc(client.ExecuteScriptAtLatestBlock)
+}
@@ -0,0 +1,1485 @@
+package staking
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"math/big"
+	"slices"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-core-contracts/lib/go/events"
+)
+
+// Errors returned by the operations of a Model where the contract would abort the transaction.
+var (
+	ErrStakingDisabled    = errors.New("the staking auction is not in progress")
+	ErrStakingEnabled     = errors.New("the staking auction is in progress")
+	ErrUnknownNode        = errors.New("unknown node")
+	ErrUnknownDelegator   = errors.New("unknown delegator")
+	ErrNodeExists         = errors.New("node already exists")
+	ErrInsufficientTokens = errors.New("not enough tokens")
+	ErrBelowMinimum       = errors.New("below the minimum stake requirement")
+	ErrInvalidRole        = errors.New("invalid node role")
+	ErrNoStakedTokens     = errors.New("no tokens staked by the participants")
+)
+
+// ArithmeticError is returned when an operation of a Model overflows, underflows or divides by zero,
+// where the same UFix64 operation aborts the transaction in the contract.
+type ArithmeticError struct {
+	Operator string
+	Left     cadence.UFix64
+	Right    cadence.UFix64
+}
+
+func (e *ArithmeticError) Error() string {
+	return fmt.Sprintf("invalid UFix64 operation: %s %s %s", e.Left, e.Operator, e.Right)
+}
+
+// Buckets are the token buckets of a node or a delegator.
+type Buckets struct {
+	// Committed are the tokens committed for the next epoch
+	Committed cadence.UFix64
+	// Staked are the tokens staked for the current epoch
+	Staked cadence.UFix64
+	// Unstaking are the tokens that are unstaking during the current epoch
+	Unstaking cadence.UFix64
+	// Unstaked are the tokens that can be withdrawn
+	Unstaked cadence.UFix64
+	// Rewarded are the rewards that can be withdrawn
+	Rewarded cadence.UFix64
+	// RequestedToUnstake is the amount of staked tokens that start unstaking at the end of the epoch
+	RequestedToUnstake cadence.UFix64
+}
+
+// FullCommittedBalance returns the tokens committed for the next epoch,
+// including the staked tokens that are not requested to unstake.
+func (b Buckets) FullCommittedBalance() cadence.UFix64 {
+	total := b.Committed + b.Staked
+	if total < b.RequestedToUnstake {
+		return 0
+	}
+	return total - b.RequestedToUnstake
+}
+
+// NodeRecord is the FlowIDTableStaking.NodeRecord resource of a Model.
+type NodeRecord struct {
+	ID   string
+	Role uint8
+	Buckets
+	InitialWeight      uint64
+	DelegatorIDCounter uint32
+	// Delegators are the buckets of the node's delegators, by delegator ID
+	Delegators map[uint32]*Buckets
+}
+
+// RewardsBreakdown is the FlowIDTableStaking.RewardsBreakdown struct:
+// the rewards of a node and its delegators for an epoch.
+type RewardsBreakdown struct {
+	NodeID           string
+	NodeRewards      cadence.UFix64
+	DelegatorRewards map[uint32]cadence.UFix64
+}
+
+// EpochRewardsSummary is the FlowIDTableStaking.EpochRewardsSummary struct:
+// the rewards of all the nodes and delegators for an epoch.
+type EpochRewardsSummary struct {
+	TotalRewards cadence.UFix64
+	Breakdown    []RewardsBreakdown
+}
+
+// Model is a Go reference implementation of the token movements of the FlowIDTableStaking contract.
+// It can be used to predict balances offline and to differentially test changes to the contract.
+//
+// Every operation mirrors the contract function of the same name, with the same UFix64 arithmetic.
+// An operation that would abort the transaction returns an error and leaves the model unchanged.
+//
+// Node keys, networking addresses, candidate node limits and role slot limits are not modelled:
+// every candidate node is selected at the end of the staking auction,
+// as if the slot limits of its role were not reached.
+//
+// Cadence dictionaries have no defined iteration order, so nodes and delegators are processed
+// in the order of their IDs. The results of the contract do not depend on that order.
+type Model struct {
+	// Nodes are all the nodes that ever registered, by node ID
+	Nodes map[string]*NodeRecord
+	// ApprovedList are the IDs of the approved nodes
+	ApprovedList map[string]bool
+	// Participants are the IDs of the nodes participating in the current epoch
+	Participants map[string]bool
+	// Candidates are the IDs of the new nodes that are proposed for the next epoch
+	Candidates map[string]bool
+	// MovesPending are the IDs of the nodes and delegators with tokens that move at the end of the epoch,
+	// by node ID
+	MovesPending map[string]map[uint32]bool
+	// NonOperationalNodes are the percentages of their rewards that non-operational nodes receive, by node ID
+	NonOperationalNodes map[string]cadence.UFix64
+	// TotalStakedByRole are the tokens staked by the nodes of each role and their delegators
+	TotalStakedByRole map[uint8]cadence.UFix64
+	// Requirements are the minimum stakes of nodes and delegators
+	Requirements StakingRequirements
+	// EpochTokenPayout is the amount of rewards paid for an epoch
+	EpochTokenPayout cadence.UFix64
+	// CutPercentage is the percentage of delegator rewards paid to the node operator
+	CutPercentage cadence.UFix64
+	// StakingEnabled is true during the staking auction
+	StakingEnabled bool
+}
+
+// NewModel returns a model without nodes, with the staking auction in progress,
+// like the contract after it is deployed.
+func NewModel(requirements StakingRequirements, epochTokenPayout, cutPercentage cadence.UFix64) *Model {
+	totalStakedByRole := make(map[uint8]cadence.UFix64, len(events.Roles))
+	for _, role := range events.Roles {
+		totalStakedByRole[role] = 0
+	}
+
+	return &Model{
+		Nodes:               map[string]*NodeRecord{},
+		ApprovedList:        map[string]bool{},
+		Participants:        map[string]bool{},
+		Candidates:          map[string]bool{},
+		MovesPending:        map[string]map[uint32]bool{},
+		NonOperationalNodes: map[string]cadence.UFix64{},
+		TotalStakedByRole:   totalStakedByRole,
+		Requirements:        requirements,
+		EpochTokenPayout:    epochTokenPayout,
+		CutPercentage:       cutPercentage,
+		StakingEnabled:      true,
+	}
+}
+
+// LoadNode adds a node and its delegators read from the chain, e.g. with a Reader,
+// with their buckets as they are.
+// The lists of the model, e.g. the participants, are not changed.
+func (m *Model) LoadNode(info NodeInfo, delegators []DelegatorInfo) error {
+	if _, ok := m.Nodes[info.ID]; ok {
+		return fmt.Errorf("node %s: %w", info.ID, ErrNodeExists)
+	}
+
+	node := &NodeRecord{
+		ID:   info.ID,
+		Role: info.Role,
+		Buckets: Buckets{
+			Committed:          info.TokensCommitted,
+			Staked:             info.TokensStaked,
+			Unstaking:          info.TokensUnstaking,
+			Unstaked:           info.TokensUnstaked,
+			Rewarded:           info.TokensRewarded,
+			RequestedToUnstake: info.TokensRequestedToUnstake,
+		},
+		InitialWeight:      info.InitialWeight,
+		DelegatorIDCounter: info.DelegatorIDCounter,
+		Delegators:         make(map[uint32]*Buckets, len(delegators)),
+	}
+
+	for _, delegator := range delegators {
+		if delegator.NodeID != info.ID {
+			return fmt.Errorf("delegator %d of node %s loaded for node %s", delegator.ID, delegator.NodeID, info.ID)
+		}
+
+		node.Delegators[delegator.ID] = &Buckets{
+			Committed:          delegator.TokensCommitted,
+			Staked:             delegator.TokensStaked,
+			Unstaking:          delegator.TokensUnstaking,
+			Unstaked:           delegator.TokensUnstaked,
+			Rewarded:           delegator.TokensRewarded,
+			RequestedToUnstake: delegator.TokensRequestedToUnstake,
+		}
+	}
+
+	m.Nodes[info.ID] = node
+
+	return nil
+}
+
+// Snapshot is the staking table as read from the chain, e.g. with Reader.GetSnapshot.
+type Snapshot struct {
+	// Nodes are all the nodes in the staking table
+	Nodes []NodeInfo
+	// Delegators are the delegators of the nodes, by node ID
+	Delegators map[string][]DelegatorInfo
+	// ParticipantIDs are the IDs of the nodes participating in the current epoch
+	ParticipantIDs []string
+	// Requirements are the minimum stakes of nodes and delegators
+	Requirements StakingRequirements
+	// EpochTokenPayout is the amount of rewards paid for an epoch
+	EpochTokenPayout cadence.UFix64
+	// CutPercentage is the percentage of delegator rewards paid to the node operator
+	CutPercentage cadence.UFix64
+}
+
+// NewModelFromSnapshot returns a model of the staking table of a snapshot,
+// with the staking auction in progress, like the contract between the end of an epoch
+// and the end of the next staking auction.
+//
+// The tokens staked by each role are the staked tokens of the nodes of the role and of their delegators.
+// Non-operational nodes are not read from the chain and can be added to the model.
+func NewModelFromSnapshot(snapshot Snapshot) (*Model, error) {
+	m := NewModel(snapshot.Requirements, snapshot.EpochTokenPayout, snapshot.CutPercentage)
+
+	for _, info := range snapshot.Nodes {
+		err := m.LoadNode(info, snapshot.Delegators[info.ID])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for nodeID := range snapshot.Delegators {
+		if _, ok := m.Nodes[nodeID]; !ok {
+			return nil, fmt.Errorf("delegators of node %s: %w", nodeID, ErrUnknownNode)
+		}
+	}
+
+	for _, nodeID := range snapshot.ParticipantIDs {
+		if _, ok := m.Nodes[nodeID]; !ok {
+			return nil, fmt.Errorf("participant %s: %w", nodeID, ErrUnknownNode)
+		}
+		m.Participants[nodeID] = true
+	}
+
+	var err error
+	for _, nodeID := range sortedKeys(m.Nodes) {
+		node := m.Nodes[nodeID]
+
+		staked := node.Staked
+		for _, delegatorID := range sortedKeys(node.Delegators) {
+			staked, err = add(staked, node.Delegators[delegatorID].Staked)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		m.TotalStakedByRole[node.Role], err = add(m.TotalStakedByRole[node.Role], staked)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// NodeInfo returns the staking information of a node, like the FlowIDTableStaking.NodeInfo struct.
+// The keys and the networking address of the node are not modelled and are left empty.
+func (m *Model) NodeInfo(nodeID string) (NodeInfo, error) {
+	node, ok := m.Nodes[nodeID]
+	if !ok {
+		return NodeInfo{}, fmt.Errorf("node %s: %w", nodeID, ErrUnknownNode)
+	}
+
+	return NodeInfo{
+		ID:                       node.ID,
+		Role:                     node.Role,
+		TokensStaked:             node.Staked,
+		TokensCommitted:          node.Committed,
+		TokensUnstaking:          node.Unstaking,
+		TokensUnstaked:           node.Unstaked,
+		TokensRewarded:           node.Rewarded,
+		DelegatorIDCounter:       node.DelegatorIDCounter,
+		TokensRequestedToUnstake: node.RequestedToUnstake,
+		InitialWeight:            node.InitialWeight,
+		Delegators:               slices.Sorted(maps.Keys(node.Delegators)),
+	}, nil
+}
+
+// DelegatorInfo returns the staking information of a delegator, like the FlowIDTableStaking.DelegatorInfo struct.
+func (m *Model) DelegatorInfo(nodeID string, delegatorID uint32) (DelegatorInfo, error) {
+	node, ok := m.Nodes[nodeID]
+	if !ok {
+		return DelegatorInfo{}, fmt.Errorf("node %s: %w", nodeID, ErrUnknownNode)
+	}
+
+	delegator, ok := node.Delegators[delegatorID]
+	if !ok {
+		return DelegatorInfo{}, fmt.Errorf("delegator %d of node %s: %w", delegatorID, nodeID, ErrUnknownDelegator)
+	}
+
+	return DelegatorInfo{
+		ID:                       delegatorID,
+		NodeID:                   nodeID,
+		TokensCommitted:          delegator.Committed,
+		TokensStaked:             delegator.Staked,
+		TokensUnstaking:          delegator.Unstaking,
+		TokensRewarded:           delegator.Rewarded,
+		TokensUnstaked:           delegator.Unstaked,
+		TokensRequestedToUnstake: delegator.RequestedToUnstake,
+	}, nil
+}
+
+// TotalStaked returns the tokens staked for the current epoch by all the nodes and delegators,
+// except by access nodes.
+func (m *Model) TotalStaked() (cadence.UFix64, error) {
+	var total cadence.UFix64
+	for _, role := range sortedKeys(m.TotalStakedByRole) {
+		if role == events.AccessRole {
+			continue
+		}
+
+		var err error
+		total, err = add(total, m.TotalStakedByRole[role])
+		if err != nil {
+			return 0, err
+		}
+	}
+	return total, nil
+}
+
+// Clone returns a deep copy of the model.
+func (m *Model) Clone() *Model {
+	clone := *m
+
+	clone.Nodes = make(map[string]*NodeRecord, len(m.Nodes))
+	for id, node := range m.Nodes {
+		nodeClone := *node
+		nodeClone.Delegators = make(map[uint32]*Buckets, len(node.Delegators))
+		for delegatorID, delegator := range node.Delegators {
+			delegatorClone := *delegator
+			nodeClone.Delegators[delegatorID] = &delegatorClone
+		}
+		clone.Nodes[id] = &nodeClone
+	}
+
+	clone.MovesPending = make(map[string]map[uint32]bool, len(m.MovesPending))
+	for id, delegators := range m.MovesPending {
+		clone.MovesPending[id] = maps.Clone(delegators)
+	}
+
+	clone.ApprovedList = maps.Clone(m.ApprovedList)
+	clone.Participants = maps.Clone(m.Participants)
+	clone.Candidates = maps.Clone(m.Candidates)
+	clone.NonOperationalNodes = maps.Clone(m.NonOperationalNodes)
+	clone.TotalStakedByRole = maps.Clone(m.TotalStakedByRole)
+	clone.Requirements.NodeMinimums = maps.Clone(m.Requirements.NodeMinimums)
+
+	return &clone
+}
+
+// AddNode registers a new node with the given tokens committed, like addNodeRecord.
+func (m *Model) AddNode(nodeID string, role uint8, tokensCommitted cadence.UFix64) error {
+	if err := m.requireStakingEnabled(); err != nil {
+		return err
+	}
+
+	if _, ok := m.Nodes[nodeID]; ok {
+		return fmt.Errorf("node %s: %w", nodeID, ErrNodeExists)
+	}
+
+	ok, err := m.greaterThanMinimumForRole(tokensCommitted, role)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("node %s commits %s: %w", nodeID, tokensCommitted, ErrBelowMinimum)
+	}
+
+	node := &NodeRecord{
+		ID:         nodeID,
+		Role:       role,
+		Delegators: map[uint32]*Buckets{},
+	}
+
+	err = m.commitNodeTokens(node, Buckets{Committed: tokensCommitted})
+	if err != nil {
+		return err
+	}
+
+	m.Nodes[nodeID] = node
+
+	return nil
+}
+
+// RegisterDelegator registers a new delegator of a node with the given tokens committed,
+// like registerNewDelegator, and returns the ID of the delegator.
+func (m *Model) RegisterDelegator(nodeID string, tokensCommitted cadence.UFix64) (uint32, error) {
+	if err := m.requireStakingEnabled(); err != nil {
+		return 0, err
+	}
+
+	node, err := m.node(nodeID)
+	if err != nil {
+		return 0, err
+	}
+
+	if node.Role == events.AccessRole {
+		return 0, fmt.Errorf("node %s: cannot register a delegator for an access node", nodeID)
+	}
+
+	if tokensCommitted < m.Requirements.DelegatorMinimum {
+		return 0, fmt.Errorf("delegator commits %s: %w", tokensCommitted, ErrBelowMinimum)
+	}
+
+	ok, err := m.greaterThanMinimumForRole(node.FullCommittedBalance(), node.Role)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, fmt.Errorf("node %s: %w", nodeID, ErrBelowMinimum)
+	}
+
+	node.DelegatorIDCounter++
+	delegatorID := node.DelegatorIDCounter
+
+	node.Delegators[delegatorID] = &Buckets{Committed: tokensCommitted}
+	m.addMovesPending(nodeID, &delegatorID)
+
+	return delegatorID, nil
+}
+
+// StakeNewTokens commits new tokens of a node for the next epoch.
+func (m *Model) StakeNewTokens(nodeID string, amount cadence.UFix64) error {
+	if err := m.requireStakingEnabled(); err != nil {
+		return err
+	}
+
+	node, err := m.node(nodeID)
+	if err != nil {
+		return err
+	}
+
+	buckets := node.Buckets
+
+	buckets.Committed, err = add(buckets.Committed, amount)
+	if err != nil {
+		return err
+	}
+
+	return m.commitNodeTokens(node, buckets)
+}
+
+// StakeUnstakedTokens commits unstaked tokens of a node for the next epoch,
+// cancelling the request to unstake first.
+func (m *Model) StakeUnstakedTokens(nodeID string, amount cadence.UFix64) error {
+	if err := m.requireStakingEnabled(); err != nil {
+		return err
+	}
+
+	node, err := m.node(nodeID)
+	if err != nil {
+		return err
+	}
+
+	buckets := node.Buckets
+
+	remaining := cancelUnstakingRequest(&buckets, amount)
+	err = move(&buckets.Unstaked, &buckets.Committed, remaining)
+	if err != nil {
+		return err
+	}
+
+	return m.commitNodeTokens(node, buckets)
+}
+
+// StakeRewardedTokens commits rewarded tokens of a node for the next epoch.
+func (m *Model) StakeRewardedTokens(nodeID string, amount cadence.UFix64) error {
+	if err := m.requireStakingEnabled(); err != nil {
+		return err
+	}
+
+	node, err := m.node(nodeID)
+	if err != nil {
+		return err
+	}
+
+	buckets := node.Buckets
+
+	err = move(&buckets.Rewarded, &buckets.Committed, amount)
+	if err != nil {
+		return err
+	}
+
+	return m.commitNodeTokens(node, buckets)
+}
+
+// RequestUnstaking requests to unstake tokens of a node:
+// the committed tokens are unstaked immediately, and the staked tokens at the end of the epoch.
+func (m *Model) RequestUnstaking(nodeID string, amount cadence.UFix64) error {
+	if err := m.requireStakingEnabled(); err != nil {
+		return err
+	}
+
+	node, err := m.node(nodeID)
+	if err != nil {
+		return err
+	}
+
+	buckets := node.Buckets
+
+	err = requireUnstakable(buckets, amount)
+	if err != nil {
+		return fmt.Errorf("node %s: %w", nodeID, err)
+	}
+
+	// node operators who have delegators must stay above the minimum without them
+	if len(node.Delegators) > 0 {
+		remaining, err := sub(buckets.FullCommittedBalance(), amount)
+		if err != nil {
+			return err
+		}
+
+		ok, err := m.greaterThanMinimumForRole(remaining, node.Role)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("node %s has delegators: %w", nodeID, ErrBelowMinimum)
+		}
+	}
+
+	movesPending, err := requestUnstaking(&buckets, amount)
+	if err != nil {
+		return err
+	}
+
+	eligible, err := m.isEligibleForCandidateNodeStatus(node, buckets)
+	if err != nil {
+		return err
+	}
+
+	node.Buckets = buckets
+
+	if movesPending {
+		m.addMovesPending(nodeID, nil)
+	}
+
+	if !eligible {
+		delete(m.Candidates, nodeID)
+	}
+
+	return nil
+}
+
+// UnstakeAll requests to unstake all the committed and staked tokens of a node.
+func (m *Model) UnstakeAll(nodeID string) error {
+	if err := m.requireStakingEnabled(); err != nil {
+		return err
+	}
+
+	node, err := m.node(nodeID)
+	if err != nil {
+		return err
+	}
+
+	err = move(&node.Committed, &node.Unstaked, node.Committed)
+	if err != nil {
+		return err
+	}
+
+	if node.Staked > 0 {
+		node.RequestedToUnstake = node.Staked
+		m.addMovesPending(nodeID, nil)
+	}
+
+	delete(m.Candidates, nodeID)
+
+	return nil
+}
+
+// WithdrawUnstakedTokens withdraws unstaked tokens of a node.
+func (m *Model) WithdrawUnstakedTokens(nodeID string, amount cadence.UFix64) error {
+	node, err := m.node(nodeID)
+	if err != nil {
+		return err
+	}
+	return withdraw(&node.Unstaked, amount)
+}
+
+// WithdrawRewardedTokens withdraws rewarded tokens of a node.
+func (m *Model) WithdrawRewardedTokens(nodeID string, amount cadence.UFix64) error {
+	node, err := m.node(nodeID)
+	if err != nil {
+		return err
+	}
+	return withdraw(&node.Rewarded, amount)
+}
+
+// DelegateNewTokens commits new tokens of a delegator for the next epoch.
+func (m *Model) DelegateNewTokens(nodeID string, delegatorID uint32, amount cadence.UFix64) error {
+	if err := m.requireStakingEnabled(); err != nil {
+		return err
+	}
+
+	delegator, err := m.delegator(nodeID, delegatorID)
+	if err != nil {
+		return err
+	}
+
+	delegator.Committed, err = add(delegator.Committed, amount)
+	if err != nil {
+		return err
+	}
+
+	m.addMovesPending(nodeID, &delegatorID)
+
+	return nil
+}
+
+// DelegateUnstakedTokens commits unstaked tokens of a delegator for the next epoch,
+// cancelling the request to unstake first.
+func (m *Model) DelegateUnstakedTokens(nodeID string, delegatorID uint32, amount cadence.UFix64) error {
+	if err := m.requireStakingEnabled(); err != nil {
+		return err
+	}
+
+	delegator, err := m.delegator(nodeID, delegatorID)
+	if err != nil {
+		return err
+	}
+
+	buckets := *delegator
+
+	remaining := cancelUnstakingRequest(&buckets, amount)
+	err = move(&buckets.Unstaked, &buckets.Committed, remaining)
+	if err != nil {
+		return err
+	}
+
+	*delegator = buckets
+	m.addMovesPending(nodeID, &delegatorID)
+
+	return nil
+}
+
+// DelegateRewardedTokens commits rewarded tokens of a delegator for the next epoch.
+func (m *Model) DelegateRewardedTokens(nodeID string, delegatorID uint32, amount cadence.UFix64) error {
+	if err := m.requireStakingEnabled(); err != nil {
+		return err
+	}
+
+	delegator, err := m.delegator(nodeID, delegatorID)
+	if err != nil {
+		return err
+	}
+
+	err = move(&delegator.Rewarded, &delegator.Committed, amount)
+	if err != nil {
+		return err
+	}
+
+	m.addMovesPending(nodeID, &delegatorID)
+
+	return nil
+}
+
+// RequestDelegatorUnstaking requests to unstake tokens of a delegator:
+// the committed tokens are unstaked immediately, and the staked tokens at the end of the epoch.
+func (m *Model) RequestDelegatorUnstaking(nodeID string, delegatorID uint32, amount cadence.UFix64) error {
+	if err := m.requireStakingEnabled(); err != nil {
+		return err
+	}
+
+	delegator, err := m.delegator(nodeID, delegatorID)
+	if err != nil {
+		return err
+	}
+
+	buckets := *delegator
+
+	err = requireUnstakable(buckets, amount)
+	if err != nil {
+		return fmt.Errorf("delegator %d of node %s: %w", delegatorID, nodeID, err)
+	}
+
+	movesPending, err := requestUnstaking(&buckets, amount)
+	if err != nil {
+		return err
+	}
+
+	*delegator = buckets
+
+	if movesPending {
+		m.addMovesPending(nodeID, &delegatorID)
+	}
+
+	return nil
+}
+
+// WithdrawDelegatorUnstakedTokens withdraws unstaked tokens of a delegator.
+func (m *Model) WithdrawDelegatorUnstakedTokens(nodeID string, delegatorID uint32, amount cadence.UFix64) error {
+	delegator, err := m.delegator(nodeID, delegatorID)
+	if err != nil {
+		return err
+	}
+	return withdraw(&delegator.Unstaked, amount)
+}
+
+// WithdrawDelegatorRewardedTokens withdraws rewarded tokens of a delegator.
+func (m *Model) WithdrawDelegatorRewardedTokens(nodeID string, delegatorID uint32, amount cadence.UFix64) error {
+	delegator, err := m.delegator(nodeID, delegatorID)
+	if err != nil {
+		return err
+	}
+	return withdraw(&delegator.Rewarded, amount)
+}
+
+// SetApprovedList replaces the approved list.
+// Nodes removed from the list are removed at the end of the staking auction,
+// or immediately if the staking auction is not in progress.
+func (m *Model) SetApprovedList(nodeIDs map[string]bool) error {
+	for _, nodeID := range sortedKeys(nodeIDs) {
+		if _, err := m.node(nodeID); err != nil {
+			return err
+		}
+	}
+
+	var removed []string
+	var refunds []refund
+
+	for _, nodeID := range sortedKeys(m.ApprovedList) {
+		if _, ok := nodeIDs[nodeID]; ok {
+			continue
+		}
+
+		if m.StakingEnabled {
+			removed = append(removed, nodeID)
+			continue
+		}
+
+		node, err := m.node(nodeID)
+		if err != nil {
+			return err
+		}
+
+		r, err := m.refund(node)
+		if err != nil {
+			return err
+		}
+		refunds = append(refunds, r)
+	}
+
+	for _, nodeID := range removed {
+		m.addMovesPending(nodeID, nil)
+	}
+
+	for _, r := range refunds {
+		m.applyRefund(r)
+	}
+
+	m.ApprovedList = maps.Clone(nodeIDs)
+
+	return nil
+}
+
+// RemoveAndRefundNode removes a node from the approved list
+// and unstakes all the tokens of the node and its delegators.
+func (m *Model) RemoveAndRefundNode(nodeID string) error {
+	node, err := m.node(nodeID)
+	if err != nil {
+		return err
+	}
+
+	r, err := m.refund(node)
+	if err != nil {
+		return err
+	}
+
+	delete(m.ApprovedList, nodeID)
+	m.applyRefund(r)
+
+	return nil
+}
+
+// StartStakingAuction starts the staking auction.
+func (m *Model) StartStakingAuction() {
+	m.StakingEnabled = true
+}
+
+// EndStakingAuction ends the staking auction, like endStakingAuction:
+// the nodes with pending moves that are not approved or are below the minimum are removed and refunded,
+// and the weight of the other nodes with pending moves is set to 100.
+// It returns the sorted IDs of the nodes proposed for the next epoch.
+func (m *Model) EndStakingAuction() ([]string, error) {
+	proposedNodes := maps.Clone(m.Participants)
+
+	var kept []*NodeRecord
+	var refunds []refund
+
+	for _, nodeID := range sortedKeys(m.MovesPending) {
+		node, err := m.node(nodeID)
+		if err != nil {
+			return nil, err
+		}
+
+		greaterThanMinimum, err := m.greaterThanMinimumForRole(node.FullCommittedBalance(), node.Role)
+		if err != nil {
+			return nil, err
+		}
+		isApproved := m.ApprovedList[nodeID]
+
+		// access nodes are removed only if they are neither approved nor above the minimum,
+		// the nodes of the other roles if they are either not approved or below the minimum
+		remove := !greaterThanMinimum || !isApproved
+		if node.Role == events.AccessRole {
+			remove = !greaterThanMinimum && !isApproved
+		}
+
+		if !remove {
+			kept = append(kept, node)
+			continue
+		}
+
+		r, err := m.refund(node)
+		if err != nil {
+			return nil, err
+		}
+		refunds = append(refunds, r)
+
+		delete(proposedNodes, nodeID)
+	}
+
+	for _, r := range refunds {
+		delete(m.ApprovedList, r.node.ID)
+		m.applyRefund(r)
+	}
+
+	for _, node := range kept {
+		node.InitialWeight = 100
+	}
+
+	// all the candidate nodes are selected, see the documentation of Model
+	for nodeID := range m.Candidates {
+		proposedNodes[nodeID] = true
+	}
+	m.Candidates = map[string]bool{}
+
+	m.StakingEnabled = false
+
+	return sortedKeys(proposedNodes), nil
+}
+
+// CalculateRewards calculates the rewards of all the participating nodes and their delegators,
+// like calculateRewards.
+//
+// The breakdowns of the non-operational nodes come first, then the breakdowns of the other nodes,
+// each ordered by node ID.
+func (m *Model) CalculateRewards() (EpochRewardsSummary, error) {
+	totalStaked, err := m.TotalStaked()
+	if err != nil {
+		return EpochRewardsSummary{}, err
+	}
+	if totalStaked == 0 {
+		return EpochRewardsSummary{}, nil
+	}
+
+	totalRewardScale, err := div(m.EpochTokenPayout, totalStaked)
+	if err != nil {
+		return EpochRewardsSummary{}, err
+	}
+
+	var breakdowns []RewardsBreakdown
+
+	var sumRewardsWithheld cadence.UFix64
+	var sumStakeFromNonOperationalStakers cadence.UFix64
+
+	for _, nodeID := range sortedKeys(m.NonOperationalNodes) {
+		node, err := m.node(nodeID)
+		if err != nil {
+			return EpochRewardsSummary{}, err
+		}
+		percentage := m.NonOperationalNodes[nodeID]
+
+		breakdown := RewardsBreakdown{
+			NodeID:           nodeID,
+			DelegatorRewards: map[uint32]cadence.UFix64{},
+		}
+
+		stakes := []cadence.UFix64{node.Staked}
+		for _, delegatorID := range sortedKeys(node.Delegators) {
+			stakes = append(stakes, node.Delegators[delegatorID].Staked)
+		}
+
+		// the rewards of the node and of each delegator, after withholding
+		rewards := make([]cadence.UFix64, len(stakes))
+
+		for i, staked := range stakes {
+			sumStakeFromNonOperationalStakers, err = add(sumStakeFromNonOperationalStakers, staked)
+			if err != nil {
+				return EpochRewardsSummary{}, err
+			}
+
+			fullRewards, err := mul(staked, totalRewardScale)
+			if err != nil {
+				return EpochRewardsSummary{}, err
+			}
+
+			rewards[i], err = mul(fullRewards, percentage)
+			if err != nil {
+				return EpochRewardsSummary{}, err
+			}
+
+			withheld, err := sub(fullRewards, rewards[i])
+			if err != nil {
+				return EpochRewardsSummary{}, err
+			}
+
+			sumRewardsWithheld, err = add(sumRewardsWithheld, withheld)
+			if err != nil {
+				return EpochRewardsSummary{}, err
+			}
+		}
+
+		breakdown.NodeRewards, err = m.addDelegatorRewards(&breakdown, rewards[0], sortedKeys(node.Delegators), rewards[1:])
+		if err != nil {
+			return EpochRewardsSummary{}, err
+		}
+
+		breakdowns = append(breakdowns, breakdown)
+	}
+
+	// the rewards withheld from non-operational nodes are redistributed to the operational nodes
+	var withheldRewardsScale cadence.UFix64
+	operationalStake, err := sub(totalStaked, sumStakeFromNonOperationalStakers)
+	if err != nil {
+		return EpochRewardsSummary{}, err
+	}
+	if operationalStake > 0 {
+		withheldRewardsScale, err = div(sumRewardsWithheld, operationalStake)
+		if err != nil {
+			return EpochRewardsSummary{}, err
+		}
+	}
+	totalRewardsPlusWithheld, err := add(totalRewardScale, withheldRewardsScale)
+	if err != nil {
+		return EpochRewardsSummary{}, err
+	}
+
+	for _, nodeID := range sortedKeys(m.Participants) {
+		if _, ok := m.NonOperationalNodes[nodeID]; ok {
+			continue
+		}
+
+		node, err := m.node(nodeID)
+		if err != nil {
+			return EpochRewardsSummary{}, err
+		}
+
+		nodeRewards, err := mul(node.Staked, totalRewardsPlusWithheld)
+		if err != nil {
+			return EpochRewardsSummary{}, err
+		}
+		if nodeRewards == 0 || node.Role == events.AccessRole {
+			continue
+		}
+
+		breakdown := RewardsBreakdown{
+			NodeID:           nodeID,
+			DelegatorRewards: map[uint32]cadence.UFix64{},
+		}
+
+		delegatorIDs := sortedKeys(node.Delegators)
+		delegatorRewards := make([]cadence.UFix64, len(delegatorIDs))
+		for i, delegatorID := range delegatorIDs {
+			delegatorRewards[i], err = mul(node.Delegators[delegatorID].Staked, totalRewardsPlusWithheld)
+			if err != nil {
+				return EpochRewardsSummary{}, err
+			}
+		}
+
+		breakdown.NodeRewards, err = m.addDelegatorRewards(&breakdown, nodeRewards, delegatorIDs, delegatorRewards)
+		if err != nil {
+			return EpochRewardsSummary{}, err
+		}
+
+		breakdowns = append(breakdowns, breakdown)
+	}
+
+	return EpochRewardsSummary{
+		TotalRewards: m.EpochTokenPayout,
+		Breakdown:    breakdowns,
+	}, nil
+}
+
+// addDelegatorRewards adds the rewards of the delegators of a node to its breakdown,
+// after the cut of the node operator, and returns the rewards of the node including the cuts.
+// Delegators without rewards are left out.
+func (m *Model) addDelegatorRewards(
+	breakdown *RewardsBreakdown,
+	nodeRewards cadence.UFix64,
+	delegatorIDs []uint32,
+	delegatorRewards []cadence.UFix64,
+) (cadence.UFix64, error) {
+	for i, delegatorID := range delegatorIDs {
+		rewards := delegatorRewards[i]
+		if rewards == 0 {
+			continue
+		}
+
+		cut, err := mul(rewards, m.CutPercentage)
+		if err != nil {
+			return 0, err
+		}
+
+		nodeRewards, err = add(nodeRewards, cut)
+		if err != nil {
+			return 0, err
+		}
+
+		breakdown.DelegatorRewards[delegatorID], err = sub(rewards, cut)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return nodeRewards, nil
+}
+
+// PayRewards pays the rewards of a summary to the rewarded buckets of the nodes and delegators,
+// like payRewards, and clears the non-operational nodes.
+func (m *Model) PayRewards(summary EpochRewardsSummary) error {
+	// the rewarded tokens of the buckets after the payment
+	rewarded := map[*cadence.UFix64]cadence.UFix64{}
+
+	pay := func(bucket *cadence.UFix64, amount cadence.UFix64) error {
+		current, ok := rewarded[bucket]
+		if !ok {
+			current = *bucket
+		}
+
+		var err error
+		rewarded[bucket], err = add(current, amount)
+		return err
+	}
+
+	for _, breakdown := range summary.Breakdown {
+		node, err := m.node(breakdown.NodeID)
+		if err != nil {
+			return err
+		}
+
+		err = pay(&node.Rewarded, breakdown.NodeRewards)
+		if err != nil {
+			return err
+		}
+
+		for _, delegatorID := range sortedKeys(breakdown.DelegatorRewards) {
+			delegator, err := m.delegator(breakdown.NodeID, delegatorID)
+			if err != nil {
+				return err
+			}
+
+			err = pay(&delegator.Rewarded, breakdown.DelegatorRewards[delegatorID])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for bucket, amount := range rewarded {
+		*bucket = amount
+	}
+
+	m.NonOperationalNodes = map[string]cadence.UFix64{}
+
+	return nil
+}
+
+// MoveTokens moves the tokens of the nodes and delegators with pending moves between buckets
+// at the end of the epoch, like moveTokens, and starts the staking auction of the next epoch:
+// committed tokens are staked, unstaking tokens are unstaked,
+// and tokens requested to unstake start unstaking.
+// Nodes left below the minimum stop participating,
+// and delegators left below the minimum unstake all their tokens.
+func (m *Model) MoveTokens() error {
+	if m.StakingEnabled {
+		return ErrStakingEnabled
+	}
+
+	// the moves are computed first and applied only if none of them fails
+	totalStakedByRole := maps.Clone(m.TotalStakedByRole)
+	participants := maps.Clone(m.Participants)
+	movesPending := map[string]map[uint32]bool{}
+	nodeBuckets := map[*NodeRecord]Buckets{}
+	delegatorBuckets := map[*Buckets]Buckets{}
+
+	for _, nodeID := range sortedKeys(m.MovesPending) {
+		node, err := m.node(nodeID)
+		if err != nil {
+			return err
+		}
+		buckets := node.Buckets
+
+		if buckets.Committed > 0 || m.ApprovedList[nodeID] {
+			totalStakedByRole[node.Role], err = add(totalStakedByRole[node.Role], buckets.Committed)
+			if err != nil {
+				return err
+			}
+
+			err = move(&buckets.Committed, &buckets.Staked, buckets.Committed)
+			if err != nil {
+				return err
+			}
+
+			participants[nodeID] = true
+		}
+
+		err = move(&buckets.Unstaking, &buckets.Unstaked, buckets.Unstaking)
+		if err != nil {
+			return err
+		}
+
+		if buckets.RequestedToUnstake > 0 {
+			err = move(&buckets.Staked, &buckets.Unstaking, buckets.RequestedToUnstake)
+			if err != nil {
+				return err
+			}
+
+			ok, err := m.greaterThanMinimumForRole(buckets.Staked, node.Role)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				delete(participants, nodeID)
+			}
+
+			// unstaking tokens move again at the end of the next epoch
+			addMovesPending(movesPending, nodeID, nil)
+		}
+
+		for _, delegatorID := range sortedKeys(m.MovesPending[nodeID]) {
+			delegator, err := m.delegator(nodeID, delegatorID)
+			if err != nil {
+				return err
+			}
+			next := *delegator
+
+			// delegators left below the minimum unstake all their tokens
+			committedForNextEpoch, err := add(next.Committed, next.Staked)
+			if err != nil {
+				return err
+			}
+			committedForNextEpoch, err = sub(committedForNextEpoch, next.RequestedToUnstake)
+			if err != nil {
+				return err
+			}
+			if committedForNextEpoch < m.Requirements.DelegatorMinimum {
+				err = move(&next.Committed, &next.Unstaked, next.Committed)
+				if err != nil {
+					return err
+				}
+				next.RequestedToUnstake = next.Staked
+			}
+
+			totalStakedByRole[node.Role], err = add(totalStakedByRole[node.Role], next.Committed)
+			if err != nil {
+				return err
+			}
+
+			err = move(&next.Committed, &next.Staked, next.Committed)
+			if err != nil {
+				return err
+			}
+			err = move(&next.Unstaking, &next.Unstaked, next.Unstaking)
+			if err != nil {
+				return err
+			}
+
+			if next.RequestedToUnstake > 0 {
+				err = move(&next.Staked, &next.Unstaking, next.RequestedToUnstake)
+				if err != nil {
+					return err
+				}
+				addMovesPending(movesPending, nodeID, &delegatorID)
+			}
+
+			totalStakedByRole[node.Role], err = sub(totalStakedByRole[node.Role], next.RequestedToUnstake)
+			if err != nil {
+				return err
+			}
+			next.RequestedToUnstake = 0
+
+			delegatorBuckets[delegator] = next
+		}
+
+		totalStakedByRole[node.Role], err = sub(totalStakedByRole[node.Role], buckets.RequestedToUnstake)
+		if err != nil {
+			return err
+		}
+		buckets.RequestedToUnstake = 0
+
+		nodeBuckets[node] = buckets
+	}
+
+	for node, buckets := range nodeBuckets {
+		node.Buckets = buckets
+	}
+	for delegator, buckets := range delegatorBuckets {
+		*delegator = buckets
+	}
+
+	m.TotalStakedByRole = totalStakedByRole
+	m.Participants = participants
+	m.MovesPending = movesPending
+	m.StakingEnabled = true
+
+	return nil
+}
+
+// commitNodeTokens sets the buckets of a node after it committed tokens,
+// adds it to the candidates if it is eligible and marks its tokens to move at the end of the epoch.
+func (m *Model) commitNodeTokens(node *NodeRecord, buckets Buckets) error {
+	eligible, err := m.isEligibleForCandidateNodeStatus(node, buckets)
+	if err != nil {
+		return err
+	}
+
+	node.Buckets = buckets
+
+	if eligible {
+		m.Candidates[node.ID] = true
+	}
+	m.addMovesPending(node.ID, nil)
+
+	return nil
+}
+
+// refund holds the buckets of a node and its delegators after all their tokens are unstaked,
+// until they are applied with applyRefund.
+type refund struct {
+	node       *NodeRecord
+	buckets    Buckets
+	delegators map[uint32]Buckets
+}
+
+// refund unstakes all the tokens of a node and its delegators,
+// like unsafeRemoveAndRefundNodeRecord, without changing the model.
+func (m *Model) refund(node *NodeRecord) (refund, error) {
+	r := refund{
+		node:       node,
+		buckets:    node.Buckets,
+		delegators: make(map[uint32]Buckets, len(node.Delegators)),
+	}
+
+	err := move(&r.buckets.Committed, &r.buckets.Unstaked, r.buckets.Committed)
+	if err != nil {
+		return refund{}, err
+	}
+
+	// after the staking auction, the node is also removed if it only committed tokens
+	if r.buckets.Staked > 0 || !m.StakingEnabled {
+		r.buckets.RequestedToUnstake = r.buckets.Staked
+	}
+
+	for delegatorID, delegator := range node.Delegators {
+		buckets := *delegator
+
+		err := move(&buckets.Committed, &buckets.Unstaked, buckets.Committed)
+		if err != nil {
+			return refund{}, err
+		}
+
+		if buckets.Staked > 0 {
+			buckets.RequestedToUnstake = buckets.Staked
+		}
+
+		r.delegators[delegatorID] = buckets
+	}
+
+	return r, nil
+}
+
+// applyRefund applies the buckets of a refund to the model.
+func (m *Model) applyRefund(r refund) {
+	r.node.Buckets = r.buckets
+
+	for _, delegatorID := range sortedKeys(r.delegators) {
+		buckets := r.delegators[delegatorID]
+		*r.node.Delegators[delegatorID] = buckets
+
+		if buckets.Staked > 0 {
+			m.addMovesPending(r.node.ID, &delegatorID)
+		}
+	}
+
+	m.addMovesPending(r.node.ID, nil)
+	delete(m.Candidates, r.node.ID)
+
+	r.node.InitialWeight = 0
+}
+
+// isEligibleForCandidateNodeStatus returns true if a node with the given buckets is not participating
+// and has committed enough tokens for its role.
+func (m *Model) isEligibleForCandidateNodeStatus(node *NodeRecord, buckets Buckets) (bool, error) {
+	if m.Participants[node.ID] || buckets.Staked > 0 {
+		return false, nil
+	}
+	return m.greaterThanMinimumForRole(buckets.Committed, node.Role)
+}
+
+func (m *Model) greaterThanMinimumForRole(tokens cadence.UFix64, role uint8) (bool, error) {
+	minimum, ok := m.Requirements.NodeMinimum(role)
+	if !ok {
+		return false, fmt.Errorf("role %d: %w", role, ErrInvalidRole)
+	}
+	return tokens >= minimum, nil
+}
+
+func (m *Model) addMovesPending(nodeID string, delegatorID *uint32) {
+	addMovesPending(m.MovesPending, nodeID, delegatorID)
+}
+
+func addMovesPending(movesPending map[string]map[uint32]bool, nodeID string, delegatorID *uint32) {
+	delegators, ok := movesPending[nodeID]
+	if !ok {
+		delegators = map[uint32]bool{}
+		movesPending[nodeID] = delegators
+	}
+	if delegatorID != nil {
+		delegators[*delegatorID] = true
+	}
+}
+
+func (m *Model) requireStakingEnabled() error {
+	if !m.StakingEnabled {
+		return ErrStakingDisabled
+	}
+	return nil
+}
+
+func (m *Model) node(nodeID string) (*NodeRecord, error) {
+	node, ok := m.Nodes[nodeID]
+	if !ok {
+		return nil, fmt.Errorf("node %s: %w", nodeID, ErrUnknownNode)
+	}
+	return node, nil
+}
+
+func (m *Model) delegator(nodeID string, delegatorID uint32) (*Buckets, error) {
+	node, err := m.node(nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	delegator, ok := node.Delegators[delegatorID]
+	if !ok {
+		return nil, fmt.Errorf("delegator %d of node %s: %w", delegatorID, nodeID, ErrUnknownDelegator)
+	}
+	return delegator, nil
+}
+
+// cancelUnstakingRequest cancels the request to unstake up to the given amount,
+// and returns the amount left to commit.
+func cancelUnstakingRequest(buckets *Buckets, amount cadence.UFix64) cadence.UFix64 {
+	if amount <= buckets.RequestedToUnstake {
+		buckets.RequestedToUnstake -= amount
+		return 0
+	}
+
+	remaining := amount - buckets.RequestedToUnstake
+	buckets.RequestedToUnstake = 0
+	return remaining
+}
+
+// requireUnstakable returns an error if the amount is more than
+// the committed and staked tokens that are not requested to unstake yet.
+func requireUnstakable(buckets Buckets, amount cadence.UFix64) error {
+	available, err := add(buckets.Staked, buckets.Committed)
+	if err != nil {
+		return err
+	}
+
+	requested, err := add(amount, buckets.RequestedToUnstake)
+	if err != nil {
+		return err
+	}
+
+	if available < requested {
+		return fmt.Errorf("requests to unstake %s: %w", amount, ErrInsufficientTokens)
+	}
+	return nil
+}
+
+// requestUnstaking unstakes the committed tokens up to the given amount
+// and requests to unstake the rest from the staked tokens.
+// It returns true if tokens were requested to unstake, which move at the end of the epoch.
+func requestUnstaking(buckets *Buckets, amount cadence.UFix64) (bool, error) {
+	if buckets.Committed >= amount {
+		return false, move(&buckets.Committed, &buckets.Unstaked, amount)
+	}
+
+	committed := buckets.Committed
+	requested, err := add(buckets.RequestedToUnstake, amount-committed)
+	if err != nil {
+		return false, err
+	}
+
+	err = move(&buckets.Committed, &buckets.Unstaked, committed)
+	if err != nil {
+		return false, err
+	}
+	buckets.RequestedToUnstake = requested
+
+	return true, nil
+}
+
+// withdraw removes an amount of tokens from a bucket.
+// The bucket is unchanged if it does not hold the amount.
+func withdraw(bucket *cadence.UFix64, amount cadence.UFix64) error {
+	if amount > *bucket {
+		return fmt.Errorf("cannot withdraw %s from %s: %w", amount, *bucket, ErrInsufficientTokens)
+	}
+	*bucket -= amount
+	return nil
+}
+
+// move moves an amount of tokens from a bucket to another.
+// Both buckets are unchanged if the move fails.
+func move(from, to *cadence.UFix64, amount cadence.UFix64) error {
+	if amount > *from {
+		return fmt.Errorf("cannot withdraw %s from %s: %w", amount, *from, ErrInsufficientTokens)
+	}
+
+	sum, err := add(*to, amount)
+	if err != nil {
+		return err
+	}
+
+	*from -= amount
+	*to = sum
+
+	return nil
+}
+
+// UFix64 arithmetic, with the same truncation and the same overflow and underflow checks as Cadence.
+
+var fix64Factor = big.NewInt(100_000_000)
+
+func add(a, b cadence.UFix64) (cadence.UFix64, error) {
+	sum := a + b
+	if sum < a {
+		return 0, &ArithmeticError{Operator: "+", Left: a, Right: b}
+	}
+	return sum, nil
+}
+
+func sub(a, b cadence.UFix64) (cadence.UFix64, error) {
+	if b > a {
+		return 0, &ArithmeticError{Operator: "-", Left: a, Right: b}
+	}
+	return a - b, nil
+}
+
+func mul(a, b cadence.UFix64) (cadence.UFix64, error) {
+	result := new(big.Int).Mul(new(big.Int).SetUint64(uint64(a)), new(big.Int).SetUint64(uint64(b)))
+	result.Quo(result, fix64Factor)
+	if !result.IsUint64() {
+		return 0, &ArithmeticError{Operator: "*", Left: a, Right: b}
+	}
+	return cadence.UFix64(result.Uint64()), nil
+}
+
+func div(a, b cadence.UFix64) (cadence.UFix64, error) {
+	if b == 0 {
+		return 0, &ArithmeticError{Operator: "/", Left: a, Right: b}
+	}
+	result := new(big.Int).Mul(new(big.Int).SetUint64(uint64(a)), fix64Factor)
+	result.Quo(result, new(big.Int).SetUint64(uint64(b)))
+	if !result.IsUint64() {
+		return 0, &ArithmeticError{Operator: "/", Left: a, Right: b}
+	}
+	return cadence.UFix64(result.Uint64()), nil
+}
+
+func sortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	return slices.Sorted(maps.Keys(m))
+}
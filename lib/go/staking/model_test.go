@@ -0,0 +1,296 @@
+package staking
+
+import (
+	"math"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/events"
+)
+
+func testRequirements(t *testing.T) StakingRequirements {
+	return StakingRequirements{
+		NodeMinimums: map[uint8]cadence.UFix64{
+			events.CollectionRole:   ufix64(t, "250000.0"),
+			events.ConsensusRole:    ufix64(t, "500000.0"),
+			events.ExecutionRole:    ufix64(t, "1250000.0"),
+			events.VerificationRole: ufix64(t, "135000.0"),
+			events.AccessRole:       ufix64(t, "100.0"),
+		},
+		DelegatorMinimum: ufix64(t, "50.0"),
+	}
+}
+
+func requireBuckets(t *testing.T, expected, actual Buckets) {
+	t.Helper()
+	assert.Equal(t, expected.Committed.String(), actual.Committed.String(), "committed")
+	assert.Equal(t, expected.Staked.String(), actual.Staked.String(), "staked")
+	assert.Equal(t, expected.Unstaking.String(), actual.Unstaking.String(), "unstaking")
+	assert.Equal(t, expected.Unstaked.String(), actual.Unstaked.String(), "unstaked")
+	assert.Equal(t, expected.Rewarded.String(), actual.Rewarded.String(), "rewarded")
+	assert.Equal(t, expected.RequestedToUnstake.String(), actual.RequestedToUnstake.String(), "requested to unstake")
+}
+
+func TestModelEpochs(t *testing.T) {
+	model := NewModel(testRequirements(t), ufix64(t, "15001.0"), ufix64(t, "0.08"))
+
+	require.NoError(t, model.AddNode("c", events.CollectionRole, ufix64(t, "250000.0")))
+	require.NoError(t, model.AddNode("x", events.ExecutionRole, ufix64(t, "1250000.0")))
+	require.NoError(t, model.SetApprovedList(map[string]bool{"c": true, "x": true}))
+
+	delegatorID, err := model.RegisterDelegator("x", ufix64(t, "100.0"))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), delegatorID)
+
+	proposed, err := model.EndStakingAuction()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c", "x"}, proposed)
+	assert.Equal(t, uint64(100), model.Nodes["x"].InitialWeight)
+
+	require.NoError(t, model.MoveTokens())
+
+	requireBuckets(t, Buckets{Staked: ufix64(t, "1250000.0")}, model.Nodes["x"].Buckets)
+	requireBuckets(t, Buckets{Staked: ufix64(t, "100.0")}, *model.Nodes["x"].Delegators[1])
+	assert.Equal(t, map[string]bool{"c": true, "x": true}, model.Participants)
+
+	totalStaked, err := model.TotalStaked()
+	require.NoError(t, err)
+	assert.Equal(t, "1500100.00000000", totalStaked.String())
+
+	// the payout makes the reward scale exactly 0.01
+	summary, err := model.CalculateRewards()
+	require.NoError(t, err)
+	assert.Equal(t, EpochRewardsSummary{
+		TotalRewards: ufix64(t, "15001.0"),
+		Breakdown: []RewardsBreakdown{
+			{NodeID: "c", NodeRewards: ufix64(t, "2500.0"), DelegatorRewards: map[uint32]cadence.UFix64{}},
+			{NodeID: "x", NodeRewards: ufix64(t, "12500.08"), DelegatorRewards: map[uint32]cadence.UFix64{1: ufix64(t, "0.92")}},
+		},
+	}, summary)
+
+	require.NoError(t, model.PayRewards(summary))
+	requireBuckets(t, Buckets{Staked: ufix64(t, "1250000.0"), Rewarded: ufix64(t, "12500.08")}, model.Nodes["x"].Buckets)
+
+	// the delegator unstakes everything, the collection node goes below the minimum
+	require.NoError(t, model.RequestDelegatorUnstaking("x", 1, ufix64(t, "100.0")))
+	require.NoError(t, model.RequestUnstaking("c", ufix64(t, "1000.0")))
+	require.NoError(t, model.WithdrawRewardedTokens("x", ufix64(t, "0.08")))
+
+	proposed, err = model.EndStakingAuction()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"x"}, proposed)
+	assert.Equal(t, map[string]bool{"x": true}, model.ApprovedList)
+	assert.Equal(t, uint64(0), model.Nodes["c"].InitialWeight)
+
+	require.NoError(t, model.MoveTokens())
+
+	requireBuckets(t, Buckets{Unstaking: ufix64(t, "250000.0"), Rewarded: ufix64(t, "2500.0")}, model.Nodes["c"].Buckets)
+	requireBuckets(t, Buckets{Unstaking: ufix64(t, "100.0"), Rewarded: ufix64(t, "0.92")}, *model.Nodes["x"].Delegators[1])
+	assert.Equal(t, map[string]bool{"x": true}, model.Participants)
+	assert.Equal(t, "0.00000000", model.TotalStakedByRole[events.CollectionRole].String())
+	assert.Equal(t, "1250000.00000000", model.TotalStakedByRole[events.ExecutionRole].String())
+
+	_, err = model.EndStakingAuction()
+	require.NoError(t, err)
+	require.NoError(t, model.MoveTokens())
+
+	requireBuckets(t, Buckets{Unstaked: ufix64(t, "250000.0"), Rewarded: ufix64(t, "2500.0")}, model.Nodes["c"].Buckets)
+	requireBuckets(t, Buckets{Unstaked: ufix64(t, "100.0"), Rewarded: ufix64(t, "0.92")}, *model.Nodes["x"].Delegators[1])
+}
+
+func TestModelUnstakingRequests(t *testing.T) {
+	model := NewModel(testRequirements(t), ufix64(t, "1250000.0"), ufix64(t, "0.08"))
+
+	require.NoError(t, model.AddNode("v", events.VerificationRole, ufix64(t, "200000.0")))
+	require.NoError(t, model.SetApprovedList(map[string]bool{"v": true}))
+	_, err := model.EndStakingAuction()
+	require.NoError(t, err)
+	require.NoError(t, model.MoveTokens())
+
+	// the committed tokens are unstaked first, the rest is requested
+	require.NoError(t, model.StakeNewTokens("v", ufix64(t, "10.0")))
+	require.NoError(t, model.RequestUnstaking("v", ufix64(t, "30.0")))
+	requireBuckets(t, Buckets{
+		Staked:             ufix64(t, "200000.0"),
+		Unstaked:           ufix64(t, "10.0"),
+		RequestedToUnstake: ufix64(t, "20.0"),
+	}, model.Nodes["v"].Buckets)
+
+	// staking unstaked tokens cancels the request first
+	require.NoError(t, model.StakeUnstakedTokens("v", ufix64(t, "25.0")))
+	requireBuckets(t, Buckets{
+		Committed: ufix64(t, "5.0"),
+		Staked:    ufix64(t, "200000.0"),
+		Unstaked:  ufix64(t, "5.0"),
+	}, model.Nodes["v"].Buckets)
+
+	require.NoError(t, model.UnstakeAll("v"))
+	requireBuckets(t, Buckets{
+		Staked:             ufix64(t, "200000.0"),
+		Unstaked:           ufix64(t, "10.0"),
+		RequestedToUnstake: ufix64(t, "200000.0"),
+	}, model.Nodes["v"].Buckets)
+}
+
+func TestModelErrors(t *testing.T) {
+	model := NewModel(testRequirements(t), ufix64(t, "1250000.0"), ufix64(t, "0.08"))
+
+	require.NoError(t, model.AddNode("x", events.ExecutionRole, ufix64(t, "1250000.0")))
+	_, err := model.RegisterDelegator("x", ufix64(t, "50.0"))
+	require.NoError(t, err)
+
+	before := model.Clone()
+
+	// a failed operation leaves the model unchanged
+	err = model.RequestUnstaking("x", ufix64(t, "1250000.1"))
+	assert.ErrorIs(t, err, ErrInsufficientTokens)
+	assert.Equal(t, before, model)
+
+	// operators with delegators cannot go below the minimum
+	err = model.RequestUnstaking("x", ufix64(t, "1.0"))
+	assert.ErrorIs(t, err, ErrBelowMinimum)
+	assert.Equal(t, before, model)
+
+	err = model.WithdrawDelegatorUnstakedTokens("x", 1, ufix64(t, "1.0"))
+	assert.ErrorIs(t, err, ErrInsufficientTokens)
+
+	_, err = model.RegisterDelegator("x", ufix64(t, "49.0"))
+	assert.ErrorIs(t, err, ErrBelowMinimum)
+
+	err = model.AddNode("c", events.CollectionRole, ufix64(t, "249999.99999999"))
+	assert.ErrorIs(t, err, ErrBelowMinimum)
+
+	err = model.AddNode("x", events.ExecutionRole, ufix64(t, "1250000.0"))
+	assert.ErrorIs(t, err, ErrNodeExists)
+
+	err = model.StakeNewTokens("unknown", ufix64(t, "1.0"))
+	assert.ErrorIs(t, err, ErrUnknownNode)
+
+	err = model.DelegateNewTokens("x", 2, ufix64(t, "1.0"))
+	assert.ErrorIs(t, err, ErrUnknownDelegator)
+
+	err = model.MoveTokens()
+	assert.ErrorIs(t, err, ErrStakingEnabled)
+
+	assert.Equal(t, before, model)
+
+	_, err = model.EndStakingAuction()
+	require.NoError(t, err)
+
+	err = model.StakeNewTokens("x", ufix64(t, "1.0"))
+	assert.ErrorIs(t, err, ErrStakingDisabled)
+}
+
+func TestModelMoveTokensOverflow(t *testing.T) {
+	model := NewModel(testRequirements(t), ufix64(t, "1250000.0"), ufix64(t, "0.08"))
+
+	require.NoError(t, model.LoadNode(NodeInfo{ID: "a", Role: events.CollectionRole, TokensCommitted: ufix64(t, "250000.0")}, nil))
+	require.NoError(t, model.LoadNode(NodeInfo{ID: "b", Role: events.ConsensusRole, TokensCommitted: ufix64(t, "500000.0")}, nil))
+	model.MovesPending["a"] = map[uint32]bool{}
+	model.MovesPending["b"] = map[uint32]bool{}
+	model.TotalStakedByRole[events.ConsensusRole] = math.MaxUint64
+	model.StakingEnabled = false
+
+	before := model.Clone()
+
+	// the tokens of node a move before the total of node b overflows,
+	// but the failed operation leaves the model unchanged
+	err := model.MoveTokens()
+	var arithmeticErr *ArithmeticError
+	require.ErrorAs(t, err, &arithmeticErr)
+	assert.Equal(t, "+", arithmeticErr.Operator)
+	assert.Equal(t, before, model)
+}
+
+func TestModelRemoveInvalidNodes(t *testing.T) {
+	model := NewModel(testRequirements(t), ufix64(t, "1250000.0"), ufix64(t, "0.08"))
+
+	require.NoError(t, model.AddNode("a1", events.AccessRole, ufix64(t, "100.0")))
+	require.NoError(t, model.AddNode("a2", events.AccessRole, ufix64(t, "100.0")))
+	require.NoError(t, model.AddNode("a3", events.AccessRole, ufix64(t, "100.0")))
+	require.NoError(t, model.AddNode("c", events.CollectionRole, ufix64(t, "250000.0")))
+
+	// access nodes below the minimum stay if they are approved
+	require.NoError(t, model.RequestUnstaking("a2", ufix64(t, "50.0")))
+	require.NoError(t, model.RequestUnstaking("a3", ufix64(t, "50.0")))
+	require.NoError(t, model.SetApprovedList(map[string]bool{"a3": true}))
+
+	proposed, err := model.EndStakingAuction()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a1"}, proposed)
+
+	assert.Equal(t, uint64(100), model.Nodes["a1"].InitialWeight)
+	assert.Equal(t, uint64(100), model.Nodes["a3"].InitialWeight)
+	assert.Equal(t, uint64(0), model.Nodes["a2"].InitialWeight)
+	assert.Equal(t, uint64(0), model.Nodes["c"].InitialWeight)
+
+	requireBuckets(t, Buckets{Unstaked: ufix64(t, "250000.0")}, model.Nodes["c"].Buckets)
+	requireBuckets(t, Buckets{Unstaked: ufix64(t, "100.0")}, model.Nodes["a2"].Buckets)
+
+	require.NoError(t, model.MoveTokens())
+
+	// access nodes do not count towards the total stake
+	totalStaked, err := model.TotalStaked()
+	require.NoError(t, err)
+	assert.Equal(t, cadence.UFix64(0), totalStaked)
+	assert.Equal(t, "150.00000000", model.TotalStakedByRole[events.AccessRole].String())
+}
+
+func TestModelNonOperationalRewards(t *testing.T) {
+	model := NewModel(testRequirements(t), ufix64(t, "10000.0"), ufix64(t, "0.1"))
+
+	for _, nodeID := range []string{"n1", "n2"} {
+		require.NoError(t, model.LoadNode(NodeInfo{
+			ID:           nodeID,
+			Role:         events.ConsensusRole,
+			TokensStaked: ufix64(t, "400000.0"),
+		}, []DelegatorInfo{{
+			ID:           1,
+			NodeID:       nodeID,
+			TokensStaked: ufix64(t, "100000.0"),
+		}}))
+		model.Participants[nodeID] = true
+	}
+	model.TotalStakedByRole[events.ConsensusRole] = ufix64(t, "1000000.0")
+	model.NonOperationalNodes["n1"] = ufix64(t, "0.5")
+
+	// half of the rewards of n1 and its delegator are redistributed to n2 and its delegator
+	summary, err := model.CalculateRewards()
+	require.NoError(t, err)
+	assert.Equal(t, []RewardsBreakdown{
+		{NodeID: "n1", NodeRewards: ufix64(t, "2050.0"), DelegatorRewards: map[uint32]cadence.UFix64{1: ufix64(t, "450.0")}},
+		{NodeID: "n2", NodeRewards: ufix64(t, "6150.0"), DelegatorRewards: map[uint32]cadence.UFix64{1: ufix64(t, "1350.0")}},
+	}, summary.Breakdown)
+
+	require.NoError(t, model.PayRewards(summary))
+	assert.Empty(t, model.NonOperationalNodes)
+
+	info, err := model.DelegatorInfo("n2", 1)
+	require.NoError(t, err)
+	assert.Equal(t, "1350.00000000", info.TokensRewarded.String())
+
+	err = model.LoadNode(NodeInfo{ID: "n1"}, nil)
+	assert.ErrorIs(t, err, ErrNodeExists)
+}
+
+func TestModelArithmetic(t *testing.T) {
+	// UFix64 multiplication and division truncate like Cadence
+	product, err := mul(ufix64(t, "0.00000003"), ufix64(t, "0.5"))
+	require.NoError(t, err)
+	assert.Equal(t, "0.00000001", product.String())
+
+	quotient, err := div(ufix64(t, "1.0"), ufix64(t, "3.0"))
+	require.NoError(t, err)
+	assert.Equal(t, "0.33333333", quotient.String())
+
+	_, err = sub(ufix64(t, "1.0"), ufix64(t, "2.0"))
+	var arithmeticErr *ArithmeticError
+	require.ErrorAs(t, err, &arithmeticErr)
+	assert.Equal(t, "-", arithmeticErr.Operator)
+
+	_, err = div(ufix64(t, "1.0"), 0)
+	require.ErrorAs(t, err, &arithmeticErr)
+}
@@ -0,0 +1,214 @@
+package staking
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-core-contracts/lib/go/events"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+// Reader reads the staking information of the FlowIDTableStaking contract
+// by executing the idTableStaking scripts.
+type Reader struct {
+	env      templates.Environment
+	executor ScriptExecutor
+}
+
+// NewReader returns a reader that executes scripts with the given executor,
+// using the contract addresses of the given environment.
+func NewReader(env templates.Environment, executor ScriptExecutor) *Reader {
+	return &Reader{
+		env:      env,
+		executor: executor,
+	}
+}
+
+// GetNodeIDs returns the IDs of all the nodes in the staking table.
+func (r *Reader) GetNodeIDs(ctx context.Context) ([]string, error) {
+	return r.getNodeIDs(ctx, templates.GenerateReturnTableScript(r.env))
+}
+
+// GetParticipantNodeIDs returns the IDs of the nodes participating in the current epoch.
+func (r *Reader) GetParticipantNodeIDs(ctx context.Context) ([]string, error) {
+	return r.getNodeIDs(ctx, templates.GenerateReturnCurrentTableScript(r.env))
+}
+
+// GetNodeInfo returns the staking information of the given node.
+func (r *Reader) GetNodeInfo(ctx context.Context, nodeID string) (NodeInfo, error) {
+	id, err := cadence.NewString(nodeID)
+	if err != nil {
+		return NodeInfo{}, err
+	}
+
+	value, err := r.executeScript(ctx, templates.GenerateGetNodeInfoScript(r.env), id)
+	if err != nil {
+		return NodeInfo{}, err
+	}
+
+	return DecodeNodeInfo(value)
+}
+
+// GetDelegatorInfo returns the staking information of the given delegator of the given node.
+func (r *Reader) GetDelegatorInfo(ctx context.Context, nodeID string, delegatorID uint32) (DelegatorInfo, error) {
+	id, err := cadence.NewString(nodeID)
+	if err != nil {
+		return DelegatorInfo{}, err
+	}
+
+	value, err := r.executeScript(
+		ctx,
+		templates.GenerateGetDelegatorInfoScript(r.env),
+		id,
+		cadence.NewUInt32(delegatorID),
+	)
+	if err != nil {
+		return DelegatorInfo{}, err
+	}
+
+	return DecodeDelegatorInfo(value)
+}
+
+// GetStakingRequirements returns the minimum stakes of all node roles and of delegators.
+func (r *Reader) GetStakingRequirements(ctx context.Context) (StakingRequirements, error) {
+	requirements := StakingRequirements{
+		NodeMinimums: make(map[uint8]cadence.UFix64, len(events.Roles)),
+	}
+
+	for _, role := range events.Roles {
+		value, err := r.executeScript(ctx, templates.GenerateGetStakeRequirementsScript(r.env), cadence.NewUInt8(role))
+		if err != nil {
+			return requirements, err
+		}
+
+		minimum, ok := value.(cadence.UFix64)
+		if !ok {
+			return requirements, fmt.Errorf("expected a Cadence UFix64 minimum stake for role %d, got %T", role, value)
+		}
+
+		requirements.NodeMinimums[role] = minimum
+	}
+
+	value, err := r.executeScript(ctx, templates.GenerateGetDelegatorStakeRequirementScript(r.env))
+	if err != nil {
+		return requirements, err
+	}
+
+	minimum, ok := value.(cadence.UFix64)
+	if !ok {
+		return requirements, fmt.Errorf("expected a Cadence UFix64 delegator minimum stake, got %T", value)
+	}
+
+	requirements.DelegatorMinimum = minimum
+
+	return requirements, nil
+}
+
+// GetEpochTokenPayout returns the amount of rewards paid for an epoch.
+func (r *Reader) GetEpochTokenPayout(ctx context.Context) (cadence.UFix64, error) {
+	return r.getUFix64(ctx, templates.GenerateGetWeeklyPayoutScript(r.env), "epoch token payout")
+}
+
+// GetCutPercentage returns the percentage of delegator rewards paid to the node operator.
+func (r *Reader) GetCutPercentage(ctx context.Context) (cadence.UFix64, error) {
+	return r.getUFix64(ctx, templates.GenerateGetCutPercentageScript(r.env), "cut percentage")
+}
+
+// GetSnapshot reads the whole staking table, e.g. to load it into a model with NewModelFromSnapshot.
+// It executes one script for every node and delegator.
+func (r *Reader) GetSnapshot(ctx context.Context) (Snapshot, error) {
+	var snapshot Snapshot
+
+	nodeIDs, err := r.GetNodeIDs(ctx)
+	if err != nil {
+		return snapshot, err
+	}
+
+	snapshot.Nodes = make([]NodeInfo, len(nodeIDs))
+	snapshot.Delegators = make(map[string][]DelegatorInfo)
+
+	for i, nodeID := range nodeIDs {
+		snapshot.Nodes[i], err = r.GetNodeInfo(ctx, nodeID)
+		if err != nil {
+			return snapshot, err
+		}
+
+		for _, delegatorID := range snapshot.Nodes[i].Delegators {
+			delegator, err := r.GetDelegatorInfo(ctx, nodeID, delegatorID)
+			if err != nil {
+				return snapshot, err
+			}
+			snapshot.Delegators[nodeID] = append(snapshot.Delegators[nodeID], delegator)
+		}
+	}
+
+	snapshot.ParticipantIDs, err = r.GetParticipantNodeIDs(ctx)
+	if err != nil {
+		return snapshot, err
+	}
+
+	snapshot.Requirements, err = r.GetStakingRequirements(ctx)
+	if err != nil {
+		return snapshot, err
+	}
+
+	snapshot.EpochTokenPayout, err = r.GetEpochTokenPayout(ctx)
+	if err != nil {
+		return snapshot, err
+	}
+
+	snapshot.CutPercentage, err = r.GetCutPercentage(ctx)
+	if err != nil {
+		return snapshot, err
+	}
+
+	return snapshot, nil
+}
+
+func (r *Reader) getNodeIDs(ctx context.Context, script []byte) ([]string, error) {
+	value, err := r.executeScript(ctx, script)
+	if err != nil {
+		return nil, err
+	}
+
+	array, ok := value.(cadence.Array)
+	if !ok {
+		return nil, fmt.Errorf("expected a Cadence array of node IDs, got %T", value)
+	}
+
+	ids := make([]string, len(array.Values))
+	for i, element := range array.Values {
+		id, ok := element.(cadence.String)
+		if !ok {
+			return nil, fmt.Errorf("cannot decode node ID %d of type %T", i, element)
+		}
+		ids[i] = string(id)
+	}
+
+	return ids, nil
+}
+
+func (r *Reader) getUFix64(ctx context.Context, script []byte, name string) (cadence.UFix64, error) {
+	value, err := r.executeScript(ctx, script)
+	if err != nil {
+		return 0, err
+	}
+
+	result, ok := value.(cadence.UFix64)
+	if !ok {
+		return 0, fmt.Errorf("expected a Cadence UFix64 %s, got %T", name, value)
+	}
+
+	return result, nil
+}
+
+func (r *Reader) executeScript(ctx context.Context, script []byte, arguments ...cadence.Value) (cadence.Value, error) {
+	value, err := r.executor.ExecuteScript(ctx, script, arguments)
+	if err != nil {
+		return nil, fmt.Errorf("cannot execute script: %w", err)
+	}
+
+	return value, nil
+}
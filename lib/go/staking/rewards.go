@@ -0,0 +1,146 @@
+package staking
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-core-contracts/lib/go/events"
+)
+
+// RewardsInput are the values the rewards of an epoch depend on, besides the staking table.
+type RewardsInput struct {
+	// EpochCounter is the counter of the epoch the rewards are calculated for
+	EpochCounter uint64
+	// TotalSupply is the total supply of FLOW, see FlowToken.totalSupply
+	TotalSupply cadence.UFix64
+	// FeeBalance is the balance of the fee vault, see FlowFees.getFeeBalance.
+	// The same balance is used to set the next payout and to pay the rewards,
+	// so a forecast made before the end of the epoch does not include the fees collected later.
+	FeeBalance cadence.UFix64
+	// BonusTokens are the tokens that are not included in the supply, see FlowEpoch.getBonusTokens
+	BonusTokens cadence.UFix64
+	// SupplyIncreasePercentage is the FLOWsupplyIncreasePercentage of the FlowEpoch config metadata
+	SupplyIncreasePercentage cadence.UFix64
+	// AutomaticRewardsEnabled is true if the payout of the next epoch is calculated from the supply,
+	// see FlowEpoch.automaticRewardsEnabled
+	AutomaticRewardsEnabled bool
+}
+
+// EpochRewards are the rewards of an epoch, as calculated by FlowEpoch.calculateAndSetRewards
+// and paid by FlowIDTableStaking.payRewards.
+type EpochRewards struct {
+	// Summary is the rewards breakdown of all the nodes and delegators
+	Summary EpochRewardsSummary
+	// TotalRewardsPaid are the totals of the EpochTotalRewardsPaid event emitted when the rewards are paid
+	TotalRewardsPaid events.EpochTotalRewardsPaidEvent
+	// NextEpochTokenPayout is the payout set for the next epoch.
+	// It is the payout of the model if automatic rewards are disabled.
+	NextEpochTokenPayout cadence.UFix64
+}
+
+// CalculateEpochRewards calculates the rewards of the current epoch of the model
+// and the payout of the next epoch, like FlowEpoch.calculateAndSetRewards.
+// The model is not changed; the rewards can be paid with PayRewards.
+//
+// The contract pays no rewards if no tokens are staked, so an error is returned
+// if the model has participants but no staked tokens, e.g. because its totals were not loaded.
+func (m *Model) CalculateEpochRewards(input RewardsInput) (EpochRewards, error) {
+	var rewards EpochRewards
+
+	totalStaked, err := m.TotalStaked()
+	if err != nil {
+		return EpochRewards{}, err
+	}
+
+	if len(m.Participants) > 0 && totalStaked == 0 {
+		return EpochRewards{}, fmt.Errorf("%d participants: %w", len(m.Participants), ErrNoStakedTokens)
+	}
+
+	rewards.Summary, err = m.CalculateRewards()
+	if err != nil {
+		return EpochRewards{}, err
+	}
+
+	rewards.TotalRewardsPaid, err = TotalRewardsPaid(rewards.Summary, input.FeeBalance, input.EpochCounter)
+	if err != nil {
+		return EpochRewards{}, err
+	}
+
+	rewards.NextEpochTokenPayout = m.EpochTokenPayout
+	if input.AutomaticRewardsEnabled {
+		rewards.NextEpochTokenPayout, err = nextEpochTokenPayout(m.EpochTokenPayout, input)
+		if err != nil {
+			return EpochRewards{}, err
+		}
+	}
+
+	return rewards, nil
+}
+
+// TotalRewardsPaid returns the totals of the EpochTotalRewardsPaid event emitted
+// when the rewards of a summary are paid with the given fee balance, like FlowIDTableStaking.payRewards:
+// the rewards are paid from the fees first, the rest is minted, and the fees left are burned.
+func TotalRewardsPaid(summary EpochRewardsSummary, feeBalance cadence.UFix64, epochCounter uint64) (events.EpochTotalRewardsPaidEvent, error) {
+	paid := events.EpochTotalRewardsPaidEvent{
+		Total:                  summary.TotalRewards,
+		EpochCounterForRewards: epochCounter,
+	}
+
+	// no tokens are minted if there are no nodes to pay
+	if len(summary.Breakdown) == 0 {
+		return paid, nil
+	}
+
+	if feeBalance < summary.TotalRewards {
+		paid.Minted = summary.TotalRewards - feeBalance
+	}
+
+	vault, err := add(feeBalance, paid.Minted)
+	if err != nil {
+		return events.EpochTotalRewardsPaidEvent{}, err
+	}
+
+	for _, breakdown := range summary.Breakdown {
+		err = withdraw(&vault, breakdown.NodeRewards)
+		if err != nil {
+			return events.EpochTotalRewardsPaidEvent{}, err
+		}
+
+		for _, delegatorID := range sortedKeys(breakdown.DelegatorRewards) {
+			err = withdraw(&vault, breakdown.DelegatorRewards[delegatorID])
+			if err != nil {
+				return events.EpochTotalRewardsPaidEvent{}, err
+			}
+		}
+	}
+
+	paid.FromFees = feeBalance
+	if feeBalance >= summary.TotalRewards {
+		paid.FromFees = summary.TotalRewards
+	}
+
+	// the rewards are truncated, so some fees or minted tokens can be left
+	paid.FeesBurned = vault
+
+	return paid, nil
+}
+
+// nextEpochTokenPayout returns the payout of the next epoch:
+// a percentage of the supply after the current payout, without the bonus tokens.
+func nextEpochTokenPayout(currentPayout cadence.UFix64, input RewardsInput) (cadence.UFix64, error) {
+	supply := input.TotalSupply
+	if input.FeeBalance < currentPayout {
+		var err error
+		supply, err = add(supply, currentPayout-input.FeeBalance)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if input.BonusTokens < supply {
+		supply -= input.BonusTokens
+	}
+
+	return mul(supply, input.SupplyIncreasePercentage)
+}
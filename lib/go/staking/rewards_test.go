@@ -0,0 +1,167 @@
+package staking
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/events"
+)
+
+func rewardsModel(t *testing.T, payout string, staked ...string) *Model {
+	snapshot := Snapshot{
+		Requirements:     testRequirements(t),
+		EpochTokenPayout: ufix64(t, payout),
+		CutPercentage:    ufix64(t, "0.08"),
+	}
+
+	for i, amount := range staked {
+		nodeID := fmt.Sprintf("n%d", i+1)
+
+		snapshot.Nodes = append(snapshot.Nodes, NodeInfo{
+			ID:           nodeID,
+			Role:         events.CollectionRole,
+			TokensStaked: ufix64(t, amount),
+		})
+		snapshot.ParticipantIDs = append(snapshot.ParticipantIDs, nodeID)
+	}
+
+	model, err := NewModelFromSnapshot(snapshot)
+	require.NoError(t, err)
+
+	return model
+}
+
+func TestCalculateEpochRewards(t *testing.T) {
+	model := rewardsModel(t, "100.0", "500000.0")
+
+	input := RewardsInput{
+		EpochCounter:             7,
+		TotalSupply:              ufix64(t, "1000000000.0"),
+		FeeBalance:               ufix64(t, "30.0"),
+		BonusTokens:              ufix64(t, "1000000.0"),
+		SupplyIncreasePercentage: ufix64(t, "0.0001"),
+		AutomaticRewardsEnabled:  true,
+	}
+
+	rewards, err := model.CalculateEpochRewards(input)
+	require.NoError(t, err)
+
+	assert.Equal(t, []RewardsBreakdown{
+		{NodeID: "n1", NodeRewards: ufix64(t, "100.0"), DelegatorRewards: map[uint32]cadence.UFix64{}},
+	}, rewards.Summary.Breakdown)
+
+	assert.Equal(t, events.EpochTotalRewardsPaidEvent{
+		Total:                  ufix64(t, "100.0"),
+		FromFees:               ufix64(t, "30.0"),
+		Minted:                 ufix64(t, "70.0"),
+		EpochCounterForRewards: 7,
+	}, rewards.TotalRewardsPaid)
+
+	// the tokens minted for the current payout are included in the supply, the bonus tokens are not
+	assert.Equal(t, "99900.00700000", rewards.NextEpochTokenPayout.String())
+
+	// the fees cover the payout
+	input.FeeBalance = ufix64(t, "150.0")
+
+	rewards, err = model.CalculateEpochRewards(input)
+	require.NoError(t, err)
+
+	assert.Equal(t, events.EpochTotalRewardsPaidEvent{
+		Total:                  ufix64(t, "100.0"),
+		FromFees:               ufix64(t, "100.0"),
+		FeesBurned:             ufix64(t, "50.0"),
+		EpochCounterForRewards: 7,
+	}, rewards.TotalRewardsPaid)
+	assert.Equal(t, "99900.00000000", rewards.NextEpochTokenPayout.String())
+
+	// the payout is not changed if automatic rewards are disabled
+	input.AutomaticRewardsEnabled = false
+
+	rewards, err = model.CalculateEpochRewards(input)
+	require.NoError(t, err)
+	assert.Equal(t, ufix64(t, "100.0"), rewards.NextEpochTokenPayout)
+}
+
+func TestCalculateEpochRewardsTruncation(t *testing.T) {
+	// the reward scale 100 / 750000 is truncated to 0.00013333
+	model := rewardsModel(t, "100.0", "250000.0", "250000.0", "250000.0")
+
+	rewards, err := model.CalculateEpochRewards(RewardsInput{EpochCounter: 1})
+	require.NoError(t, err)
+
+	require.Len(t, rewards.Summary.Breakdown, 3)
+	for _, breakdown := range rewards.Summary.Breakdown {
+		assert.Equal(t, "33.33250000", breakdown.NodeRewards.String())
+	}
+
+	assert.Equal(t, events.EpochTotalRewardsPaidEvent{
+		Total:                  ufix64(t, "100.0"),
+		Minted:                 ufix64(t, "100.0"),
+		FeesBurned:             ufix64(t, "0.0025"),
+		EpochCounterForRewards: 1,
+	}, rewards.TotalRewardsPaid)
+}
+
+func TestCalculateEpochRewardsWithoutStake(t *testing.T) {
+	// the participants of a model loaded node by node have no staked tokens
+	model := NewModel(testRequirements(t), ufix64(t, "100.0"), ufix64(t, "0.08"))
+	require.NoError(t, model.LoadNode(NodeInfo{ID: "n1", Role: events.CollectionRole, TokensStaked: ufix64(t, "1000.0")}, nil))
+	model.Participants["n1"] = true
+
+	_, err := model.CalculateEpochRewards(RewardsInput{EpochCounter: 1})
+	assert.ErrorIs(t, err, ErrNoStakedTokens)
+
+	// a model without participants pays no rewards
+	model = NewModel(testRequirements(t), ufix64(t, "100.0"), ufix64(t, "0.08"))
+
+	rewards, err := model.CalculateEpochRewards(RewardsInput{EpochCounter: 1})
+	require.NoError(t, err)
+	assert.Empty(t, rewards.Summary.Breakdown)
+}
+
+func TestNewModelFromSnapshot(t *testing.T) {
+	snapshot := Snapshot{
+		Nodes: []NodeInfo{
+			{ID: "n1", Role: events.CollectionRole, TokensStaked: ufix64(t, "1000.0")},
+			{ID: "n2", Role: events.AccessRole, TokensStaked: ufix64(t, "100.0")},
+		},
+		Delegators: map[string][]DelegatorInfo{
+			"n1": {{ID: 1, NodeID: "n1", TokensStaked: ufix64(t, "50.0")}},
+		},
+		ParticipantIDs: []string{"n1", "n2"},
+		Requirements:   testRequirements(t),
+	}
+
+	model, err := NewModelFromSnapshot(snapshot)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]bool{"n1": true, "n2": true}, model.Participants)
+	assert.Equal(t, ufix64(t, "1050.0"), model.TotalStakedByRole[events.CollectionRole])
+	assert.Equal(t, ufix64(t, "100.0"), model.TotalStakedByRole[events.AccessRole])
+
+	// the participants must be nodes of the snapshot
+	snapshot.ParticipantIDs = append(snapshot.ParticipantIDs, "n3")
+	_, err = NewModelFromSnapshot(snapshot)
+	assert.ErrorIs(t, err, ErrUnknownNode)
+}
+
+func TestTotalRewardsPaid(t *testing.T) {
+	// nothing is minted if there are no nodes to pay
+	paid, err := TotalRewardsPaid(EpochRewardsSummary{TotalRewards: ufix64(t, "100.0")}, ufix64(t, "10.0"), 3)
+	require.NoError(t, err)
+	assert.Equal(t, events.EpochTotalRewardsPaidEvent{
+		Total:                  ufix64(t, "100.0"),
+		EpochCounterForRewards: 3,
+	}, paid)
+
+	// the rewards of a summary cannot exceed its total
+	_, err = TotalRewardsPaid(EpochRewardsSummary{
+		TotalRewards: ufix64(t, "1.0"),
+		Breakdown:    []RewardsBreakdown{{NodeID: "n1", NodeRewards: ufix64(t, "2.0")}},
+	}, 0, 3)
+	assert.ErrorIs(t, err, ErrInsufficientTokens)
+}
@@ -0,0 +1,81 @@
+// Package staking provides Go types for the FlowIDTableStaking contract
+// and reads them from the chain through a ScriptExecutor.
+//
+// The executor can be backed by the access API, the emulator or a mock:
+//
+//	reader := staking.NewReader(templates.MainnetEnvironment(), staking.NewAccessScriptExecutor(accessClient))
+//
+//	info, err := reader.GetNodeInfo(ctx, nodeID)
+//	requirements, err := reader.GetStakingRequirements(ctx)
+//
+// Decoding never panics. A missing field or a field of an unexpected
+// Cadence type is reported as an error.
+//
+// Model is a Go reference implementation of the token movements of the contract,
+// to predict balances offline and to differentially test changes to the contract.
+package staking
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-core-contracts/lib/go/events"
+)
+
+// NodeInfo is the FlowIDTableStaking.NodeInfo struct.
+//
+// It is the same type as the node info included in the epoch events,
+// so nodes read through a Reader and nodes decoded from events can be compared directly.
+type NodeInfo = events.NodeInfo
+
+// DelegatorInfo is the FlowIDTableStaking.DelegatorInfo struct.
+type DelegatorInfo struct {
+	ID                       uint32         `cadence:"id"`
+	NodeID                   string         `cadence:"nodeID"`
+	TokensCommitted          cadence.UFix64 `cadence:"tokensCommitted"`
+	TokensStaked             cadence.UFix64 `cadence:"tokensStaked"`
+	TokensUnstaking          cadence.UFix64 `cadence:"tokensUnstaking"`
+	TokensRewarded           cadence.UFix64 `cadence:"tokensRewarded"`
+	TokensUnstaked           cadence.UFix64 `cadence:"tokensUnstaked"`
+	TokensRequestedToUnstake cadence.UFix64 `cadence:"tokensRequestedToUnstake"`
+}
+
+// StakingRequirements are the minimum amounts of FLOW that nodes and delegators must stake.
+type StakingRequirements struct {
+	// NodeMinimums is the minimum stake of a node, by role
+	NodeMinimums map[uint8]cadence.UFix64
+	// DelegatorMinimum is the minimum stake of a delegator
+	DelegatorMinimum cadence.UFix64
+}
+
+// NodeMinimum returns the minimum stake of a node with the given role,
+// and whether the role has a minimum.
+func (r StakingRequirements) NodeMinimum(role uint8) (cadence.UFix64, bool) {
+	minimum, ok := r.NodeMinimums[role]
+	return minimum, ok
+}
+
+// DecodeNodeInfo decodes a Cadence FlowIDTableStaking.NodeInfo struct.
+func DecodeNodeInfo(value cadence.Value) (NodeInfo, error) {
+	var info NodeInfo
+
+	err := events.DecodeValue(value, &info)
+	if err != nil {
+		return info, fmt.Errorf("cannot decode node info: %w", err)
+	}
+
+	return info, nil
+}
+
+// DecodeDelegatorInfo decodes a Cadence FlowIDTableStaking.DelegatorInfo struct.
+func DecodeDelegatorInfo(value cadence.Value) (DelegatorInfo, error) {
+	var info DelegatorInfo
+
+	err := events.DecodeValue(value, &info)
+	if err != nil {
+		return info, fmt.Errorf("cannot decode delegator info: %w", err)
+	}
+
+	return info, nil
+}
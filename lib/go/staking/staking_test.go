@@ -0,0 +1,226 @@
+package staking
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/events"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+const nodeID = "0000000000000000000000000000000000000000000000000000000000000001"
+
+var location = common.NewAddressLocation(nil, common.MustBytesToAddress([]byte{0x1}), "FlowIDTableStaking")
+
+func ufix64(t *testing.T, s string) cadence.UFix64 {
+	value, err := cadence.NewUFix64(s)
+	require.NoError(t, err)
+	return value
+}
+
+func nodeInfoValue(t *testing.T) cadence.Value {
+	return cadence.NewStruct([]cadence.Value{
+		cadence.String(nodeID),
+		cadence.NewUInt8(2),
+		cadence.String("flow-node.test:3569"),
+		cadence.String("aa"),
+		cadence.String("bb"),
+		ufix64(t, "500000.0"),
+		ufix64(t, "10.0"),
+		ufix64(t, "0.0"),
+		ufix64(t, "0.0"),
+		ufix64(t, "1.5"),
+		cadence.NewArray([]cadence.Value{cadence.NewUInt32(1), cadence.NewUInt32(2)}),
+		cadence.NewUInt32(2),
+		ufix64(t, "0.0"),
+		cadence.NewUInt64(100),
+	}).WithType(cadence.NewStructType(
+		location,
+		"FlowIDTableStaking.NodeInfo",
+		[]cadence.Field{
+			{Identifier: "id", Type: cadence.StringType},
+			{Identifier: "role", Type: cadence.UInt8Type},
+			{Identifier: "networkingAddress", Type: cadence.StringType},
+			{Identifier: "networkingKey", Type: cadence.StringType},
+			{Identifier: "stakingKey", Type: cadence.StringType},
+			{Identifier: "tokensStaked", Type: cadence.UFix64Type},
+			{Identifier: "tokensCommitted", Type: cadence.UFix64Type},
+			{Identifier: "tokensUnstaking", Type: cadence.UFix64Type},
+			{Identifier: "tokensUnstaked", Type: cadence.UFix64Type},
+			{Identifier: "tokensRewarded", Type: cadence.UFix64Type},
+			{Identifier: "delegators", Type: cadence.NewVariableSizedArrayType(cadence.UInt32Type)},
+			{Identifier: "delegatorIDCounter", Type: cadence.UInt32Type},
+			{Identifier: "tokensRequestedToUnstake", Type: cadence.UFix64Type},
+			{Identifier: "initialWeight", Type: cadence.UInt64Type},
+		},
+		nil,
+	))
+}
+
+func delegatorInfoValue(t *testing.T, id uint32) cadence.Value {
+	return cadence.NewStruct([]cadence.Value{
+		cadence.NewUInt32(id),
+		cadence.String(nodeID),
+		ufix64(t, "1.0"),
+		ufix64(t, "50.0"),
+		ufix64(t, "0.0"),
+		ufix64(t, "0.25"),
+		ufix64(t, "0.0"),
+		ufix64(t, "5.0"),
+	}).WithType(cadence.NewStructType(
+		location,
+		"FlowIDTableStaking.DelegatorInfo",
+		[]cadence.Field{
+			{Identifier: "id", Type: cadence.UInt32Type},
+			{Identifier: "nodeID", Type: cadence.StringType},
+			{Identifier: "tokensCommitted", Type: cadence.UFix64Type},
+			{Identifier: "tokensStaked", Type: cadence.UFix64Type},
+			{Identifier: "tokensUnstaking", Type: cadence.UFix64Type},
+			{Identifier: "tokensRewarded", Type: cadence.UFix64Type},
+			{Identifier: "tokensUnstaked", Type: cadence.UFix64Type},
+			{Identifier: "tokensRequestedToUnstake", Type: cadence.UFix64Type},
+		},
+		nil,
+	))
+}
+
+func TestDecode(t *testing.T) {
+	t.Run("node info", func(t *testing.T) {
+		info, err := DecodeNodeInfo(nodeInfoValue(t))
+		require.NoError(t, err)
+
+		assert.Equal(t, nodeID, info.ID)
+		assert.Equal(t, events.ConsensusRole, info.Role)
+		assert.Equal(t, ufix64(t, "500000.0"), info.TokensStaked)
+		assert.Equal(t, ufix64(t, "1.5"), info.TokensRewarded)
+		assert.Equal(t, []uint32{1, 2}, info.Delegators)
+		assert.Equal(t, uint64(100), info.InitialWeight)
+	})
+
+	t.Run("delegator info", func(t *testing.T) {
+		info, err := DecodeDelegatorInfo(delegatorInfoValue(t, 2))
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			DelegatorInfo{
+				ID:                       2,
+				NodeID:                   nodeID,
+				TokensCommitted:          ufix64(t, "1.0"),
+				TokensStaked:             ufix64(t, "50.0"),
+				TokensRewarded:           ufix64(t, "0.25"),
+				TokensRequestedToUnstake: ufix64(t, "5.0"),
+			},
+			info,
+		)
+	})
+
+	t.Run("not a struct", func(t *testing.T) {
+		_, err := DecodeDelegatorInfo(cadence.String(nodeID))
+		require.ErrorContains(t, err, "cannot decode delegator info")
+	})
+
+	t.Run("wrong struct", func(t *testing.T) {
+		_, err := DecodeDelegatorInfo(nodeInfoValue(t))
+		require.Error(t, err)
+	})
+}
+
+func TestReader(t *testing.T) {
+	ctx := context.Background()
+	env := templates.MainnetEnvironment()
+
+	executor := ScriptExecutorFunc(func(_ context.Context, script []byte, arguments []cadence.Value) (cadence.Value, error) {
+		switch {
+		case bytes.Equal(script, templates.GenerateReturnTableScript(env)):
+			return cadence.NewArray([]cadence.Value{cadence.String(nodeID)}), nil
+
+		case bytes.Equal(script, templates.GenerateGetNodeInfoScript(env)):
+			if !assert.Equal(t, []cadence.Value{cadence.String(nodeID)}, arguments) {
+				return nil, fmt.Errorf("unexpected arguments")
+			}
+			return nodeInfoValue(t), nil
+
+		case bytes.Equal(script, templates.GenerateGetDelegatorInfoScript(env)):
+			if !assert.Equal(t, cadence.String(nodeID), arguments[0]) {
+				return nil, fmt.Errorf("unexpected arguments")
+			}
+			return delegatorInfoValue(t, uint32(arguments[1].(cadence.UInt32))), nil
+
+		case bytes.Equal(script, templates.GenerateReturnCurrentTableScript(env)):
+			return cadence.NewArray([]cadence.Value{cadence.String(nodeID)}), nil
+
+		case bytes.Equal(script, templates.GenerateGetWeeklyPayoutScript(env)):
+			return ufix64(t, "1250000.0"), nil
+
+		case bytes.Equal(script, templates.GenerateGetCutPercentageScript(env)):
+			return ufix64(t, "0.08"), nil
+
+		case bytes.Equal(script, templates.GenerateGetStakeRequirementsScript(env)):
+			role := arguments[0].(cadence.UInt8)
+			return cadence.UFix64(uint64(role) * 100_000_000), nil
+
+		case bytes.Equal(script, templates.GenerateGetDelegatorStakeRequirementScript(env)):
+			return ufix64(t, "50.0"), nil
+		}
+
+		return nil, fmt.Errorf("unexpected script")
+	})
+
+	reader := NewReader(env, executor)
+
+	ids, err := reader.GetNodeIDs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{nodeID}, ids)
+
+	node, err := reader.GetNodeInfo(ctx, nodeID)
+	require.NoError(t, err)
+	assert.Equal(t, nodeID, node.ID)
+
+	delegator, err := reader.GetDelegatorInfo(ctx, nodeID, 2)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), delegator.ID)
+
+	requirements, err := reader.GetStakingRequirements(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, ufix64(t, "50.0"), requirements.DelegatorMinimum)
+	assert.Len(t, requirements.NodeMinimums, 5)
+
+	minimum, ok := requirements.NodeMinimum(events.AccessRole)
+	assert.True(t, ok)
+	assert.Equal(t, ufix64(t, "5.0"), minimum)
+
+	_, ok = requirements.NodeMinimum(6)
+	assert.False(t, ok)
+
+	snapshot, err := reader.GetSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Len(t, snapshot.Nodes, 1)
+	assert.Len(t, snapshot.Delegators[nodeID], 2)
+	assert.Equal(t, []string{nodeID}, snapshot.ParticipantIDs)
+	assert.Equal(t, ufix64(t, "1250000.0"), snapshot.EpochTokenPayout)
+	assert.Equal(t, ufix64(t, "0.08"), snapshot.CutPercentage)
+
+	model, err := NewModelFromSnapshot(snapshot)
+	require.NoError(t, err)
+
+	// the node and both delegators stake for the consensus role
+	totalStaked, err := model.TotalStaked()
+	require.NoError(t, err)
+	assert.Equal(t, ufix64(t, "500100.0"), totalStaked)
+
+	t.Run("script error", func(t *testing.T) {
+		reader := NewReader(env, ScriptExecutorFunc(func(context.Context, []byte, []cadence.Value) (cadence.Value, error) {
+			return nil, fmt.Errorf("unavailable")
+		}))
+
+		_, err := reader.GetNodeInfo(ctx, nodeID)
+		require.ErrorContains(t, err, "cannot execute script: unavailable")
+	})
+}
@@ -0,0 +1,275 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var errBreakingChanges = errors.New("manifest has breaking changes")
+
+var diffCmd = &cobra.Command{
+	Use:   "diff <old> <new>",
+	Short: "Compare two manifests and report changes that break hardware wallet allowlists",
+	Long: `Compare two manifests and report added, removed and changed templates.
+
+Hardware wallets allowlist templates by ID and hash, so removing a template,
+changing its source or arguments, or reusing its ID for another template is breaking.
+The command exits with a non-zero status if there are breaking changes.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		oldManifest, err := readManifest(args[0])
+		if err != nil {
+			exit(err)
+		}
+
+		newManifest, err := readManifest(args[1])
+		if err != nil {
+			exit(err)
+		}
+
+		if oldManifest.Network != newManifest.Network {
+			exit(fmt.Errorf("cannot compare manifests for different networks: %s and %s", oldManifest.Network, newManifest.Network))
+		}
+
+		changes := diffManifests(oldManifest, newManifest)
+
+		printChanges(cmd.OutOrStdout(), changes)
+
+		for _, c := range changes {
+			if c.Breaking {
+				exit(errBreakingChanges)
+			}
+		}
+	},
+}
+
+func init() {
+	cmd.AddCommand(diffCmd)
+}
+
+func readManifest(filename string) (*manifest, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var m manifest
+
+	err = json.Unmarshal(b, &m)
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest %s: %w", filename, err)
+	}
+
+	return &m, nil
+}
+
+type changeKind string
+
+const (
+	templateAdded   changeKind = "added"
+	templateRemoved changeKind = "removed"
+	templateChanged changeKind = "changed"
+	templateReused  changeKind = "reused"
+)
+
+// change is a change of the template with the given ID between two manifests.
+type change struct {
+	ID       string
+	Name     string
+	Kind     changeKind
+	Breaking bool
+	Details  []string
+}
+
+// diffManifests compares the templates of two manifests by ID.
+//
+// Changes are returned in the order of the old manifest, followed by the added templates.
+func diffManifests(oldManifest, newManifest *manifest) []change {
+	var changes []change
+
+	newTemplates := make(map[string]template, len(newManifest.Templates))
+	for _, t := range newManifest.Templates {
+		newTemplates[t.ID] = t
+	}
+
+	oldTemplates := make(map[string]template, len(oldManifest.Templates))
+	oldHashes := make(map[string]string, len(oldManifest.Templates))
+	for _, t := range oldManifest.Templates {
+		oldTemplates[t.ID] = t
+		oldHashes[t.Hash] = t.ID
+	}
+
+	for _, oldTemplate := range oldManifest.Templates {
+		newTemplate, ok := newTemplates[oldTemplate.ID]
+		if !ok {
+			changes = append(changes, change{
+				ID:       oldTemplate.ID,
+				Name:     oldTemplate.Name,
+				Kind:     templateRemoved,
+				Breaking: true,
+			})
+			continue
+		}
+
+		c, ok := diffTemplates(oldTemplate, newTemplate)
+		if ok {
+			changes = append(changes, c)
+		}
+	}
+
+	for _, newTemplate := range newManifest.Templates {
+		if _, ok := oldTemplates[newTemplate.ID]; ok {
+			continue
+		}
+
+		c := change{
+			ID:   newTemplate.ID,
+			Name: newTemplate.Name,
+			Kind: templateAdded,
+		}
+
+		if id, ok := oldHashes[newTemplate.Hash]; ok {
+			c.Details = append(c.Details, fmt.Sprintf("same source as %s in the old manifest", id))
+		}
+
+		changes = append(changes, c)
+	}
+
+	return changes
+}
+
+// diffTemplates compares two versions of the template with the same ID
+// and returns false if they are equal.
+func diffTemplates(oldTemplate, newTemplate template) (change, bool) {
+	c := change{
+		ID:   newTemplate.ID,
+		Name: newTemplate.Name,
+		Kind: templateChanged,
+	}
+
+	if oldTemplate.Hash != newTemplate.Hash {
+		c.Breaking = true
+		c.Details = append(c.Details, fmt.Sprintf("hash changed: %s -> %s", oldTemplate.Hash, newTemplate.Hash))
+	}
+
+	if oldTemplate.Name != newTemplate.Name {
+		c.Details = append(c.Details, fmt.Sprintf("name changed: %q -> %q", oldTemplate.Name, newTemplate.Name))
+
+		// a different name for a different source means the ID now refers to another template
+		if oldTemplate.Hash != newTemplate.Hash {
+			c.Kind = templateReused
+		}
+	}
+
+	argumentDetails, argumentsBreaking := diffArguments(oldTemplate.Arguments, newTemplate.Arguments)
+	c.Details = append(c.Details, argumentDetails...)
+	c.Breaking = c.Breaking || argumentsBreaking
+
+	if len(c.Details) == 0 {
+		return change{}, false
+	}
+
+	return c, true
+}
+
+// diffArguments compares the arguments of two versions of a template.
+//
+// Arguments are passed by position, so added, removed and reordered arguments
+// and type changes are breaking, while renaming or relabeling an argument is not.
+func diffArguments(oldArguments, newArguments []argument) (details []string, breaking bool) {
+	oldOrder := argumentNames(oldArguments)
+	newOrder := argumentNames(newArguments)
+
+	if sameNames(oldOrder, newOrder) && strings.Join(oldOrder, ",") != strings.Join(newOrder, ",") {
+		details = append(details, fmt.Sprintf(
+			"argument order changed: %s -> %s",
+			strings.Join(oldOrder, ", "),
+			strings.Join(newOrder, ", "),
+		))
+		return details, true
+	}
+
+	for i, oldArgument := range oldArguments {
+		if i >= len(newArguments) {
+			breaking = true
+			details = append(details, fmt.Sprintf("argument %d (%s) removed", i, oldArgument.Name))
+			continue
+		}
+
+		newArgument := newArguments[i]
+
+		if oldArgument.Type != newArgument.Type {
+			breaking = true
+			details = append(details, fmt.Sprintf("argument %d (%s) type changed: %s -> %s", i, newArgument.Name, oldArgument.Type, newArgument.Type))
+		}
+
+		if oldArgument.Name != newArgument.Name {
+			details = append(details, fmt.Sprintf("argument %d renamed: %s -> %s", i, oldArgument.Name, newArgument.Name))
+		}
+
+		if oldArgument.Label != newArgument.Label {
+			details = append(details, fmt.Sprintf("argument %d (%s) label changed: %q -> %q", i, newArgument.Name, oldArgument.Label, newArgument.Label))
+		}
+	}
+
+	for i := len(oldArguments); i < len(newArguments); i++ {
+		breaking = true
+		details = append(details, fmt.Sprintf("argument %d (%s) added", i, newArguments[i].Name))
+	}
+
+	return details, breaking
+}
+
+// sameNames returns true if both lists contain the same names, in any order.
+func sameNames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	counts := make(map[string]int, len(a))
+	for _, name := range a {
+		counts[name]++
+	}
+	for _, name := range b {
+		counts[name]--
+		if counts[name] < 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func argumentNames(arguments []argument) []string {
+	names := make([]string, len(arguments))
+	for i, a := range arguments {
+		names[i] = a.Name
+	}
+	return names
+}
+
+func printChanges(w io.Writer, changes []change) {
+	if len(changes) == 0 {
+		fmt.Fprintln(w, "no changes")
+		return
+	}
+
+	for _, c := range changes {
+		breaking := ""
+		if c.Breaking {
+			breaking = " (breaking)"
+		}
+
+		fmt.Fprintf(w, "%-8s %s %s%s\n", c.Kind, c.ID, c.Name, breaking)
+
+		for _, detail := range c.Details {
+			fmt.Fprintf(w, "           %s\n", detail)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffManifests(t *testing.T) {
+	nodeID := argument{Type: "String", Name: "nodeID", Label: "Node ID"}
+	amount := argument{Type: "UFix64", Name: "amount", Label: "Amount"}
+
+	oldManifest := &manifest{
+		Network: "mainnet",
+		Templates: []template{
+			{ID: "SCO.09", Name: "Unstake All", Hash: "a", Arguments: []argument{nodeID}},
+			{ID: "SCO.10", Name: "Withdraw Rewarded Tokens", Hash: "b", Arguments: []argument{nodeID, amount}},
+			{ID: "SCO.11", Name: "Withdraw Unstaked Tokens", Hash: "c", Arguments: []argument{nodeID, amount}},
+			{ID: "SCO.12", Name: "Close Stake", Hash: "d", Arguments: []argument{nodeID}},
+		},
+	}
+
+	t.Run("no changes", func(t *testing.T) {
+		assert.Empty(t, diffManifests(oldManifest, oldManifest))
+	})
+
+	t.Run("added and removed", func(t *testing.T) {
+		newManifest := &manifest{
+			Network: "mainnet",
+			Templates: []template{
+				oldManifest.Templates[0],
+				oldManifest.Templates[1],
+				oldManifest.Templates[2],
+				{ID: "SCO.13", Name: "Close Stake", Hash: "d", Arguments: []argument{nodeID}},
+			},
+		}
+
+		assert.Equal(t,
+			[]change{
+				{ID: "SCO.12", Name: "Close Stake", Kind: templateRemoved, Breaking: true},
+				{ID: "SCO.13", Name: "Close Stake", Kind: templateAdded, Details: []string{"same source as SCO.12 in the old manifest"}},
+			},
+			diffManifests(oldManifest, newManifest),
+		)
+	})
+
+	t.Run("arguments", func(t *testing.T) {
+		renamed := nodeID
+		renamed.Name = "id"
+
+		retyped := amount
+		retyped.Type = "UFix64?"
+
+		newManifest := &manifest{
+			Network: "mainnet",
+			Templates: []template{
+				{ID: "SCO.09", Name: "Unstake All", Hash: "a", Arguments: []argument{renamed}},
+				{ID: "SCO.10", Name: "Withdraw Rewarded Tokens", Hash: "e", Arguments: []argument{amount, nodeID}},
+				{ID: "SCO.11", Name: "Withdraw Unstaked Tokens", Hash: "f", Arguments: []argument{nodeID, retyped}},
+				{ID: "SCO.12", Name: "Close Stake", Hash: "g", Arguments: []argument{nodeID, amount}},
+			},
+		}
+
+		assert.Equal(t,
+			[]change{
+				{
+					ID: "SCO.09", Name: "Unstake All", Kind: templateChanged,
+					Details: []string{"argument 0 renamed: nodeID -> id"},
+				},
+				{
+					ID: "SCO.10", Name: "Withdraw Rewarded Tokens", Kind: templateChanged, Breaking: true,
+					Details: []string{
+						"hash changed: b -> e",
+						"argument order changed: nodeID, amount -> amount, nodeID",
+					},
+				},
+				{
+					ID: "SCO.11", Name: "Withdraw Unstaked Tokens", Kind: templateChanged, Breaking: true,
+					Details: []string{
+						"hash changed: c -> f",
+						"argument 1 (amount) type changed: UFix64 -> UFix64?",
+					},
+				},
+				{
+					ID: "SCO.12", Name: "Close Stake", Kind: templateChanged, Breaking: true,
+					Details: []string{
+						"hash changed: d -> g",
+						"argument 1 (amount) added",
+					},
+				},
+			},
+			diffManifests(oldManifest, newManifest),
+		)
+	})
+
+	t.Run("ID reuse", func(t *testing.T) {
+		newManifest := &manifest{
+			Network: "mainnet",
+			Templates: []template{
+				oldManifest.Templates[0],
+				oldManifest.Templates[1],
+				oldManifest.Templates[2],
+				{ID: "SCO.12", Name: "Transfer Node", Hash: "h", Arguments: []argument{nodeID}},
+			},
+		}
+
+		changes := diffManifests(oldManifest, newManifest)
+		assert.Len(t, changes, 1)
+		assert.Equal(t, templateReused, changes[0].Kind)
+		assert.True(t, changes[0].Breaking)
+	})
+}
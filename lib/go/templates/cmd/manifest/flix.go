@@ -0,0 +1,578 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/parser"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/templates/cmd/manifest/metadata"
+)
+
+const (
+	flixType    = "InteractionTemplate"
+	flixVersion = "1.1.0"
+
+	flixLanguageTag = "en-US"
+)
+
+const (
+	mainnetRESTHost = "https://rest-mainnet.onflow.org"
+	testnetRESTHost = "https://rest-testnet.onflow.org"
+)
+
+var flixConf struct {
+	MainnetHost string
+	TestnetHost string
+}
+
+var flixCmd = &cobra.Command{
+	Use:   "flix <outdir>",
+	Short: "Generate Flow Interaction Templates (FLIX v1.1) for all published core transactions",
+	Long: `Generate Flow Interaction Templates (FLIX v1.1) for all published core transactions.
+
+One file named <id>.json is written to the output directory for each template
+of the manifest metadata that is part of the core contracts.
+The dependency pins are computed from the contracts deployed at the addresses
+of the mainnet and testnet environments, which are fetched from the REST API of the access nodes.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		err := runFLIX(context.Background(), args[0])
+		if err != nil {
+			exit(err)
+		}
+	},
+}
+
+func init() {
+	flixCmd.Flags().StringVar(&flixConf.MainnetHost, "mainnet-host", mainnetRESTHost, "Mainnet access node REST API to fetch dependencies from")
+	flixCmd.Flags().StringVar(&flixConf.TestnetHost, "testnet-host", testnetRESTHost, "Testnet access node REST API to fetch dependencies from")
+
+	cmd.AddCommand(flixCmd)
+}
+
+// runFLIX writes the interaction templates of all published core transactions to the output directory.
+func runFLIX(ctx context.Context, outdir string) error {
+	meta, err := metadata.Embedded()
+	if err != nil {
+		return err
+	}
+
+	var networks []flixNetwork
+
+	for _, n := range []struct {
+		network string
+		host    string
+	}{
+		{templates.MainnetNetwork, flixConf.MainnetHost},
+		{templates.TestnetNetwork, flixConf.TestnetHost},
+	} {
+		env, err := templates.EnvironmentForNetwork(n.network)
+		if err != nil {
+			return err
+		}
+
+		pins, err := newPinResolver(ctx, newRESTClient(n.host))
+		if err != nil {
+			return fmt.Errorf("%s: %w", n.network, err)
+		}
+
+		networks = append(networks, flixNetwork{Env: env, Pins: pins})
+	}
+
+	flixes, err := generateFLIX(ctx, meta, networks)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(outdir, 0755)
+	if err != nil {
+		return err
+	}
+
+	for id, flix := range flixes {
+		b, err := json.MarshalIndent(flix, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		err = os.WriteFile(filepath.Join(outdir, id+".json"), b, 0644)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type flixTemplate struct {
+	FType    string   `json:"f_type"`
+	FVersion string   `json:"f_version"`
+	ID       string   `json:"id"`
+	Data     flixData `json:"data"`
+}
+
+type flixData struct {
+	Type string `json:"type"`
+	// Interface is empty, as the core transactions do not implement interaction template interfaces.
+	Interface    string           `json:"interface"`
+	Messages     []flixMessage    `json:"messages"`
+	Cadence      flixCadence      `json:"cadence"`
+	Dependencies []flixDependency `json:"dependencies"`
+	Parameters   []flixParameter  `json:"parameters"`
+}
+
+type flixMessage struct {
+	Key  string     `json:"key"`
+	I18n []flixI18n `json:"i18n"`
+}
+
+type flixI18n struct {
+	Tag         string `json:"tag"`
+	Translation string `json:"translation"`
+}
+
+type flixCadence struct {
+	Body        string           `json:"body"`
+	NetworkPins []flixNetworkPin `json:"network_pins"`
+}
+
+type flixNetworkPin struct {
+	Network string `json:"network"`
+	PinSelf string `json:"pin_self"`
+}
+
+type flixDependency struct {
+	Contracts []flixContract `json:"contracts"`
+}
+
+type flixContract struct {
+	Contract string                `json:"contract"`
+	Networks []flixContractNetwork `json:"networks"`
+}
+
+type flixContractNetwork struct {
+	Network                  string  `json:"network"`
+	Address                  string  `json:"address"`
+	DependencyPinBlockHeight uint64  `json:"dependency_pin_block_height"`
+	DependencyPin            flixPin `json:"dependency_pin"`
+}
+
+type flixPin struct {
+	Pin                string    `json:"pin"`
+	PinSelf            string    `json:"pin_self"`
+	PinContractName    string    `json:"pin_contract_name"`
+	PinContractAddress string    `json:"pin_contract_address"`
+	Imports            []flixPin `json:"imports"`
+}
+
+type flixParameter struct {
+	Label    string        `json:"label"`
+	Index    int           `json:"index"`
+	Type     string        `json:"type"`
+	Messages []flixMessage `json:"messages"`
+}
+
+// flixNetwork is a network the interaction templates are pinned for.
+type flixNetwork struct {
+	Env  templates.Environment
+	Pins *pinResolver
+}
+
+// generateFLIX generates the interaction templates of all published core transactions,
+// by manifest ID. Templates that are not part of the template registry are skipped.
+func generateFLIX(ctx context.Context, meta *metadata.Metadata, networks []flixNetwork) (map[string]*flixTemplate, error) {
+	registry, err := templates.NewRegistry()
+	if err != nil {
+		return nil, err
+	}
+
+	flixes := make(map[string]*flixTemplate)
+
+	for _, t := range meta.Templates {
+		template, ok := registry.Get(t.Template)
+		if !ok {
+			continue
+		}
+
+		flix, err := generateTemplateFLIX(ctx, t, template, networks)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", t.ID, err)
+		}
+
+		flixes[t.ID] = flix
+	}
+
+	return flixes, nil
+}
+
+func generateTemplateFLIX(
+	ctx context.Context,
+	meta metadata.Template,
+	template templates.Template,
+	networks []flixNetwork,
+) (*flixTemplate, error) {
+	source := template.Source()
+
+	program, err := parser.ParseProgram(nil, source, parser.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	data := flixData{
+		Type:     string(template.Kind),
+		Messages: flixMessages(meta.Name),
+		Cadence: flixCadence{
+			Body: string(source),
+		},
+		Dependencies: []flixDependency{},
+		Parameters:   []flixParameter{},
+	}
+
+	for _, network := range networks {
+		data.Cadence.NetworkPins = append(data.Cadence.NetworkPins, flixNetworkPin{
+			Network: network.Env.Network,
+			PinSelf: shaHex(template.Generate(network.Env)),
+		})
+	}
+
+	for _, declaration := range program.ImportDeclarations() {
+		location, ok := declaration.Location.(common.StringLocation)
+		if !ok {
+			// built-in contracts, e.g. import Crypto, are not pinned
+			continue
+		}
+
+		name := string(location)
+		contract := flixContract{Contract: name}
+
+		for _, network := range networks {
+			address, ok := network.Env.ContractAddress(name)
+			if !ok {
+				return nil, fmt.Errorf("no %s address for %s", network.Env.Network, name)
+			}
+
+			pin, err := network.Pins.pin(ctx, name, flow.HexToAddress(address))
+			if err != nil {
+				return nil, err
+			}
+
+			contract.Networks = append(contract.Networks, flixContractNetwork{
+				Network:                  network.Env.Network,
+				Address:                  address,
+				DependencyPinBlockHeight: network.Pins.height,
+				DependencyPin:            pin,
+			})
+		}
+
+		data.Dependencies = append(data.Dependencies, flixDependency{
+			Contracts: []flixContract{contract},
+		})
+	}
+
+	for i, parameter := range template.Parameters {
+		data.Parameters = append(data.Parameters, flixParameter{
+			Label:    parameter.Name,
+			Index:    i,
+			Type:     parameter.Type,
+			Messages: flixMessages(meta.Arguments[parameter.Name].Label),
+		})
+	}
+
+	flix := &flixTemplate{
+		FType:    flixType,
+		FVersion: flixVersion,
+		Data:     data,
+	}
+
+	flix.ID, err = flix.generateID()
+	if err != nil {
+		return nil, err
+	}
+
+	return flix, nil
+}
+
+func flixMessages(title string) []flixMessage {
+	if title == "" {
+		return []flixMessage{}
+	}
+
+	return []flixMessage{
+		{
+			Key: "title",
+			I18n: []flixI18n{
+				{Tag: flixLanguageTag, Translation: title},
+			},
+		},
+	}
+}
+
+// restClient fetches blocks and accounts from the REST API of an access node.
+type restClient struct {
+	host   string
+	client *http.Client
+}
+
+func newRESTClient(host string) *restClient {
+	return &restClient{
+		host:   strings.TrimSuffix(host, "/"),
+		client: http.DefaultClient,
+	}
+}
+
+// get decodes the JSON response of the GET request for the given path and query into v.
+func (c *restClient) get(ctx context.Context, path string, query url.Values, v any) error {
+	u := c.host + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("GET %s: %s: %s", u, res.Status, strings.TrimSpace(string(body)))
+	}
+
+	return json.NewDecoder(res.Body).Decode(v)
+}
+
+// latestSealedHeight returns the height of the latest sealed block.
+func (c *restClient) latestSealedHeight(ctx context.Context) (uint64, error) {
+	var blocks []struct {
+		Header struct {
+			Height uint64 `json:"height,string"`
+		} `json:"header"`
+	}
+
+	err := c.get(ctx, "/v1/blocks", url.Values{"height": {"sealed"}}, &blocks)
+	if err != nil {
+		return 0, err
+	}
+	if len(blocks) != 1 {
+		return 0, fmt.Errorf("expected 1 sealed block, got %d", len(blocks))
+	}
+
+	return blocks[0].Header.Height, nil
+}
+
+// contracts returns the code of the contracts deployed to the given account at the given height, by name.
+func (c *restClient) contracts(ctx context.Context, address flow.Address, height uint64) (map[string][]byte, error) {
+	var account struct {
+		// the REST API encodes the code of the contracts in base64, which is how []byte is decoded
+		Contracts map[string][]byte `json:"contracts"`
+	}
+
+	err := c.get(ctx, "/v1/accounts/"+address.Hex(), url.Values{
+		"block_height": {strconv.FormatUint(height, 10)},
+		"expand":       {"contracts"},
+	}, &account)
+	if err != nil {
+		return nil, err
+	}
+
+	return account.Contracts, nil
+}
+
+// pinResolver computes the dependency pins of contracts deployed on a network,
+// at the latest sealed block when the resolver was created.
+type pinResolver struct {
+	client *restClient
+	height uint64
+	pins   map[string]flixPin
+}
+
+func newPinResolver(ctx context.Context, client *restClient) (*pinResolver, error) {
+	height, err := client.latestSealedHeight(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get latest sealed block: %w", err)
+	}
+
+	return &pinResolver{
+		client: client,
+		height: height,
+		pins:   make(map[string]flixPin),
+	}, nil
+}
+
+// pin returns the dependency pin of the given contract.
+//
+// pin_self is the SHA3-256 hash of the deployed code of the contract,
+// and pin is the SHA3-256 hash of the pin_self values of the contract
+// and all its imports, in depth-first order.
+func (r *pinResolver) pin(ctx context.Context, name string, address flow.Address) (flixPin, error) {
+	key := address.Hex() + "." + name
+	if pin, ok := r.pins[key]; ok {
+		return pin, nil
+	}
+
+	contracts, err := r.client.contracts(ctx, address, r.height)
+	if err != nil {
+		return flixPin{}, fmt.Errorf("cannot get account %s: %w", address.HexWithPrefix(), err)
+	}
+
+	code, ok := contracts[name]
+	if !ok {
+		return flixPin{}, fmt.Errorf("contract %s is not deployed to %s", name, address.HexWithPrefix())
+	}
+
+	program, err := parser.ParseProgram(nil, code, parser.Config{})
+	if err != nil {
+		return flixPin{}, fmt.Errorf("cannot parse contract %s: %w", name, err)
+	}
+
+	pin := flixPin{
+		PinSelf:            shaHex(code),
+		PinContractName:    name,
+		PinContractAddress: address.HexWithPrefix(),
+		Imports:            []flixPin{},
+	}
+
+	for _, declaration := range program.ImportDeclarations() {
+		location, ok := declaration.Location.(common.AddressLocation)
+		if !ok {
+			continue
+		}
+
+		for _, imported := range declaration.Imports {
+			importPin, err := r.pin(ctx, imported.Identifier.Identifier, flow.Address(location.Address))
+			if err != nil {
+				return flixPin{}, err
+			}
+
+			pin.Imports = append(pin.Imports, importPin)
+		}
+	}
+
+	var pinSelfs []byte
+	var collect func(p flixPin)
+	collect = func(p flixPin) {
+		pinSelfs = append(pinSelfs, p.PinSelf...)
+		for _, i := range p.Imports {
+			collect(i)
+		}
+	}
+	collect(pin)
+
+	pin.Pin = shaHex(pinSelfs)
+
+	r.pins[key] = pin
+
+	return pin, nil
+}
+
+// generateID returns the ID of the interaction template,
+// the SHA3-256 hash of the RLP encoding of the hashed template fields.
+func (f *flixTemplate) generateID() (string, error) {
+	var messages []any
+	for _, m := range f.Data.Messages {
+		messages = append(messages, rlpMessage(m))
+	}
+
+	var networkPins []any
+	for _, p := range f.Data.Cadence.NetworkPins {
+		networkPins = append(networkPins, []any{shaHex(p.Network), shaHex(p.PinSelf)})
+	}
+
+	var dependencies []any
+	for _, d := range f.Data.Dependencies {
+		for _, c := range d.Contracts {
+			var networks []any
+			for _, n := range c.Networks {
+				networks = append(networks, []any{
+					shaHex(n.Network),
+					shaHex(n.Address),
+					shaHex(strconv.FormatUint(n.DependencyPinBlockHeight, 10)),
+					rlpPin(n.DependencyPin),
+				})
+			}
+			dependencies = append(dependencies, []any{shaHex(c.Contract), networks})
+		}
+	}
+
+	var parameters []any
+	for _, p := range f.Data.Parameters {
+		var parameterMessages []any
+		for _, m := range p.Messages {
+			parameterMessages = append(parameterMessages, rlpMessage(m))
+		}
+		parameters = append(parameters, []any{
+			shaHex(p.Label),
+			shaHex(strconv.Itoa(p.Index)),
+			shaHex(p.Type),
+			parameterMessages,
+		})
+	}
+
+	return rlpHash([]any{
+		shaHex(f.FType),
+		shaHex(f.FVersion),
+		shaHex(f.Data.Type),
+		shaHex(f.Data.Interface),
+		messages,
+		shaHex(f.Data.Cadence.Body),
+		networkPins,
+		dependencies,
+		parameters,
+	})
+}
+
+func rlpMessage(m flixMessage) []any {
+	var i18n []any
+	for _, t := range m.I18n {
+		i18n = append(i18n, []any{shaHex(t.Tag), shaHex(t.Translation)})
+	}
+	return []any{shaHex(m.Key), i18n}
+}
+
+func rlpPin(p flixPin) []any {
+	var imports []any
+	for _, i := range p.Imports {
+		imports = append(imports, rlpPin(i))
+	}
+	return []any{
+		shaHex(p.Pin),
+		shaHex(p.PinSelf),
+		shaHex(p.PinContractName),
+		shaHex(p.PinContractAddress),
+		imports,
+	}
+}
+
+func rlpHash(value any) (string, error) {
+	b, err := rlp.EncodeToBytes(value)
+	if err != nil {
+		return "", err
+	}
+	return shaHex(b), nil
+}
+
+func shaHex[T string | []byte](value T) string {
+	hash := sha3.Sum256([]byte(value))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/templates/cmd/manifest/metadata"
+)
+
+// newTestRESTClient returns a client for a REST API that serves the given contracts at height 42.
+func newTestRESTClient(t *testing.T, contracts map[flow.Address]map[string][]byte) *restClient {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /v1/blocks", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "sealed", r.URL.Query().Get("height"))
+		fmt.Fprint(w, `[{"header": {"id": "abc", "height": "42"}}]`)
+	})
+
+	mux.HandleFunc("GET /v1/accounts/{address}", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "42", r.URL.Query().Get("block_height"))
+		assert.Equal(t, "contracts", r.URL.Query().Get("expand"))
+
+		account, ok := contracts[flow.HexToAddress(r.PathValue("address"))]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		err := json.NewEncoder(w).Encode(map[string]any{"contracts": account})
+		assert.NoError(t, err)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return newRESTClient(server.URL)
+}
+
+func TestGenerateFLIX(t *testing.T) {
+	ctx := context.Background()
+	env := templates.MainnetEnvironment()
+
+	idTableAddress := flow.HexToAddress(env.IDTableAddress)
+
+	stakingCode := []byte(`access(all) contract FlowIDTableStaking {}`)
+	qcCode := []byte(`access(all) contract FlowClusterQC {}`)
+	dkgCode := []byte(`access(all) contract FlowDKG {}`)
+	epochCode := []byte(`
+		import FlowIDTableStaking from 0x8624b52f9ddcd04a
+		import FlowClusterQC from 0x8624b52f9ddcd04a
+
+		access(all) contract FlowEpoch {}
+	`)
+
+	pins, err := newPinResolver(ctx, newTestRESTClient(t, map[flow.Address]map[string][]byte{
+		idTableAddress: {
+			"FlowIDTableStaking": stakingCode,
+			"FlowClusterQC":      qcCode,
+			"FlowDKG":            dkgCode,
+			"FlowEpoch":          epochCode,
+		},
+	}))
+	require.NoError(t, err)
+
+	meta, err := metadata.Load([]byte(`{
+		"templates": [
+			{"id": "EP.01", "name": "Register QC Voter", "template": "epoch/node/register_qc_voter"},
+			{"id": "EP.02", "name": "Register DKG Participant", "template": "epoch/node/register_dkg_participant"},
+			{"id": "FT.02", "name": "Transfer Fungible Token with Paths", "template": "flow-ft/transfer_generic_vault_with_paths"}
+		]
+	}`))
+	require.NoError(t, err)
+
+	flixes, err := generateFLIX(ctx, meta, []flixNetwork{{Env: env, Pins: pins}})
+	require.NoError(t, err)
+
+	// templates outside of the registry are skipped
+	require.Len(t, flixes, 2)
+
+	flix := flixes["EP.01"]
+	require.NotNil(t, flix)
+
+	assert.Equal(t, "InteractionTemplate", flix.FType)
+	assert.Equal(t, "1.1.0", flix.FVersion)
+	assert.Len(t, flix.ID, 64)
+	assert.Equal(t, "transaction", flix.Data.Type)
+	assert.Equal(t, "Register QC Voter", flix.Data.Messages[0].I18n[0].Translation)
+	assert.Contains(t, flix.Data.Cadence.Body, `import "FlowEpoch"`)
+	assert.Equal(t,
+		[]flixNetworkPin{{
+			Network: "mainnet",
+			PinSelf: shaHex(templates.GenerateEpochRegisterQCVoterScript(env)),
+		}},
+		flix.Data.Cadence.NetworkPins,
+	)
+
+	// the epoch contract is pinned together with its imports, depth-first
+	require.Len(t, flix.Data.Dependencies, 3)
+
+	epoch := flix.Data.Dependencies[0].Contracts[0]
+	assert.Equal(t, "FlowEpoch", epoch.Contract)
+	require.Len(t, epoch.Networks, 1)
+	assert.Equal(t, "0x8624b52f9ddcd04a", epoch.Networks[0].Address)
+	assert.Equal(t, uint64(42), epoch.Networks[0].DependencyPinBlockHeight)
+
+	pin := epoch.Networks[0].DependencyPin
+	assert.Equal(t, shaHex(epochCode), pin.PinSelf)
+	assert.Equal(t, shaHex(shaHex(epochCode)+shaHex(stakingCode)+shaHex(qcCode)), pin.Pin)
+	require.Len(t, pin.Imports, 2)
+	assert.Equal(t, "FlowIDTableStaking", pin.Imports[0].PinContractName)
+
+	assert.Empty(t, flix.Data.Interface)
+	assert.NotEqual(t, flix.ID, flixes["EP.02"].ID)
+
+	// the ID is deterministic
+	id, err := flix.generateID()
+	require.NoError(t, err)
+	assert.Equal(t, flix.ID, id)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/templates/cmd/manifest/metadata"
 )
 
 type Config struct {
@@ -19,33 +20,6 @@ type Config struct {
 
 const envPrefix = "FLOW"
 
-const (
-	testnet = "testnet"
-	mainnet = "mainnet"
-)
-
-const (
-	testnetFungibleTokenAddress              = "9a0766d93b6608b7"
-	testnetFungibleTokenMetadataViewsAddress = "9a0766d93b6608b7"
-	testnetNFTAddress                        = "631e88ae7f1d7c20"
-	testnetMetadataViewsAddress              = "631e88ae7f1d7c20"
-	testnetFlowTokenAddress                  = "7e60df042a9c0868"
-	testnetIDTableAddress                    = "9eca2b38b18b5dfe"
-	testnetStakingProxyAddress               = "7aad92e5a0715d21"
-	testnetLockedTokensAddress               = "95e019a17d0e23d7"
-)
-
-const (
-	mainnetFungibleTokenAddress              = "f233dcee88fe0abe"
-	mainnetFungibleTokenMetadataViewsAddress = "f233dcee88fe0abe"
-	mainnetNFTAddress                        = "1d7e57aa55817448"
-	mainnetMetadataViewsAddress              = "1d7e57aa55817448"
-	mainnetFlowTokenAddress                  = "1654653399040a61"
-	mainnetIDTableAddress                    = "8624b52f9ddcd04a"
-	mainnetStakingProxyAddress               = "62430cf28c26d095"
-	mainnetLockedTokensAddress               = "8d0e87b65159ae63"
-)
-
 var conf Config
 
 var cmd = &cobra.Command{
@@ -58,7 +32,15 @@ var cmd = &cobra.Command{
 			exit(err)
 		}
 
-		manifest := generateManifest(env)
+		meta, err := metadata.Embedded()
+		if err != nil {
+			exit(err)
+		}
+
+		manifest, err := generateManifest(env, meta)
+		if err != nil {
+			exit(err)
+		}
 
 		b, err := json.MarshalIndent(manifest, "", "  ")
 		if err != nil {
@@ -75,36 +57,7 @@ var cmd = &cobra.Command{
 }
 
 func getEnv(conf Config) (templates.Environment, error) {
-
-	if conf.Network == testnet {
-		return templates.Environment{
-			Network:                           testnet,
-			FungibleTokenAddress:              testnetFungibleTokenAddress,
-			NonFungibleTokenAddress:           testnetNFTAddress,
-			MetadataViewsAddress:              testnetMetadataViewsAddress,
-			FungibleTokenMetadataViewsAddress: testnetFungibleTokenMetadataViewsAddress,
-			FlowTokenAddress:                  testnetFlowTokenAddress,
-			IDTableAddress:                    testnetIDTableAddress,
-			StakingProxyAddress:               testnetStakingProxyAddress,
-			LockedTokensAddress:               testnetLockedTokensAddress,
-		}, nil
-	}
-
-	if conf.Network == mainnet {
-		return templates.Environment{
-			Network:                           mainnet,
-			FungibleTokenAddress:              mainnetFungibleTokenAddress,
-			NonFungibleTokenAddress:           mainnetNFTAddress,
-			MetadataViewsAddress:              mainnetMetadataViewsAddress,
-			FungibleTokenMetadataViewsAddress: mainnetFungibleTokenMetadataViewsAddress,
-			FlowTokenAddress:                  mainnetFlowTokenAddress,
-			IDTableAddress:                    mainnetIDTableAddress,
-			StakingProxyAddress:               mainnetStakingProxyAddress,
-			LockedTokensAddress:               mainnetLockedTokensAddress,
-		}, nil
-	}
-
-	return templates.Environment{}, fmt.Errorf("invalid network %s", conf.Network)
+	return templates.EnvironmentForNetwork(conf.Network)
 }
 
 func init() {
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/ast"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/parser"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/templates/cmd/manifest/metadata"
+)
+
+// addressSample is the name of the sample that resolves to
+// the first account address of the network the manifest is generated for.
+const addressSample = "address"
+
+// arguments returns the manifest arguments for the given parameters of the template.
+//
+// It returns an error if an argument lacks a label or samples,
+// or if the metadata declares arguments the template does not have.
+func arguments(meta *metadata.Metadata, t metadata.Template, parameters []templates.Parameter, network string) ([]argument, error) {
+	arguments := []argument{}
+
+	var missing []string
+	declared := make(map[string]bool, len(parameters))
+
+	for _, parameter := range parameters {
+		declared[parameter.Name] = true
+
+		argumentMeta, ok := t.Arguments[parameter.Name]
+		if !ok || argumentMeta.Label == "" || len(argumentMeta.Samples) == 0 {
+			missing = append(missing, parameter.Name)
+			continue
+		}
+
+		samples := make([]cadenceValue, len(argumentMeta.Samples))
+		for i, name := range argumentMeta.Samples {
+			value, err := sample(meta, name, network)
+			if err != nil {
+				return nil, fmt.Errorf("argument %s: %w", parameter.Name, err)
+			}
+
+			err = checkSampleType(value.Value, parameter.Type)
+			if err != nil {
+				return nil, fmt.Errorf("argument %s: sample %s: %w", parameter.Name, name, err)
+			}
+
+			samples[i] = value
+		}
+
+		arguments = append(arguments, argument{
+			Type:         parameter.Type,
+			Name:         parameter.Name,
+			Label:        argumentMeta.Label,
+			SampleValues: samples,
+		})
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing label or samples for arguments: %s", strings.Join(missing, ", "))
+	}
+
+	var unknown []string
+	for name := range t.Arguments {
+		if !declared[name] {
+			unknown = append(unknown, name)
+		}
+	}
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("metadata for unknown arguments: %s", strings.Join(unknown, ", "))
+	}
+
+	return arguments, nil
+}
+
+// sample returns the sample value with the given name for the given network.
+func sample(meta *metadata.Metadata, name string, network string) (cadenceValue, error) {
+	if name == addressSample {
+		return sampleAddress(network), nil
+	}
+
+	raw, ok := meta.Samples[name]
+	if !ok {
+		return cadenceValue{}, fmt.Errorf("unknown sample %s", name)
+	}
+
+	value, err := jsoncdc.Decode(nil, raw)
+	if err != nil {
+		return cadenceValue{}, fmt.Errorf("invalid sample %s: %w", name, err)
+	}
+
+	return cadenceValue{value}, nil
+}
+
+// checkSampleType returns an error if the given sample value is not a value of the given Cadence type.
+func checkSampleType(value cadence.Value, typ string) error {
+	t, errs := parser.ParseType(nil, []byte(typ), parser.Config{})
+	if len(errs) > 0 {
+		return fmt.Errorf("cannot parse type %s: %w", typ, errors.Join(errs...))
+	}
+
+	if !hasType(value, t) {
+		return fmt.Errorf("%s is not a value of type %s", value, typ)
+	}
+
+	return nil
+}
+
+func hasType(value cadence.Value, typ ast.Type) bool {
+	switch typ := typ.(type) {
+	case *ast.OptionalType:
+		optional, ok := value.(cadence.Optional)
+		return ok && (optional.Value == nil || hasType(optional.Value, typ.Type))
+
+	case *ast.VariableSizedType:
+		array, ok := value.(cadence.Array)
+		return ok && allHaveType(array.Values, typ.Type)
+
+	case *ast.ConstantSizedType:
+		array, ok := value.(cadence.Array)
+		return ok && uint64(len(array.Values)) == typ.Size.Value.Uint64() && allHaveType(array.Values, typ.Type)
+
+	case *ast.DictionaryType:
+		dictionary, ok := value.(cadence.Dictionary)
+		if !ok {
+			return false
+		}
+		for _, pair := range dictionary.Pairs {
+			if !hasType(pair.Key, typ.KeyType) || !hasType(pair.Value, typ.ValueType) {
+				return false
+			}
+		}
+		return true
+
+	case *ast.NominalType:
+		name := typ.String()
+		if name == "AnyStruct" {
+			return true
+		}
+		if _, ok := value.(cadence.Optional); ok {
+			return false
+		}
+		if value.Type() == nil {
+			return false
+		}
+
+		// composite types are identified by their location, e.g. I.Crypto.Crypto.KeyListEntry
+		id := value.Type().ID()
+		return id == name || strings.HasSuffix(id, "."+name)
+	}
+
+	return false
+}
+
+func allHaveType(values []cadence.Value, typ ast.Type) bool {
+	for _, value := range values {
+		if !hasType(value, typ) {
+			return false
+		}
+	}
+	return true
+}
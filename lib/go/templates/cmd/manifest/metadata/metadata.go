@@ -0,0 +1,130 @@
+// Package metadata declares the templates published in the manifest of the core transactions,
+// with labels and sample values for their arguments.
+//
+// The declarations are read from metadata.json, which is embedded in this package,
+// so that the manifest command and the FLIX exporter publish the same templates.
+package metadata
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+//go:embed metadata.json
+var metadataJSON []byte
+
+// Metadata declares the templates published in the manifest,
+// with labels and sample values for their arguments.
+type Metadata struct {
+	// Samples are the sample values referenced by the arguments, encoded as JSON-Cadence
+	Samples map[string]json.RawMessage `json:"samples"`
+	// Templates are the published templates, in the order of the manifest
+	Templates []Template `json:"templates"`
+	// Published declares the registry templates that must be published
+	Published Published `json:"published"`
+}
+
+// Published declares the registry categories whose transactions must all be published,
+// except for the excluded ones.
+type Published struct {
+	// Categories are the registry categories, e.g. stakingCollection
+	Categories []string `json:"categories"`
+	// Excluded maps template IDs, or ID prefixes ending in a slash, to the reason they are not published
+	Excluded map[string]string `json:"excluded"`
+}
+
+// IsExcluded returns whether the template with the given registry ID is excluded from publishing.
+func (p Published) IsExcluded(id string) bool {
+	for prefix := range p.Excluded {
+		if id == prefix || (strings.HasSuffix(prefix, "/") && strings.HasPrefix(id, prefix)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Template is a published template.
+type Template struct {
+	// ID is the manifest ID of the template, e.g. SCO.01
+	ID string `json:"id"`
+	// Name is the human-readable name of the template
+	Name string `json:"name"`
+	// Template is the registry ID of the template, e.g. stakingCollection/close_stake
+	Template string `json:"template"`
+	// Arguments are the labels and samples of the arguments, by parameter name
+	Arguments map[string]Argument `json:"arguments"`
+}
+
+// Argument is the label and the names of the sample values of an argument.
+type Argument struct {
+	Label   string   `json:"label"`
+	Samples []string `json:"samples"`
+}
+
+// Load decodes and checks the given metadata.
+func Load(data []byte) (*Metadata, error) {
+	var meta Metadata
+
+	err := json.Unmarshal(data, &meta)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template metadata: %w", err)
+	}
+
+	ids := make(map[string]bool, len(meta.Templates))
+	for _, t := range meta.Templates {
+		if t.ID == "" || t.Name == "" || t.Template == "" {
+			return nil, fmt.Errorf("invalid template metadata: id, name and template are required (%s)", t.ID)
+		}
+		if ids[t.ID] {
+			return nil, fmt.Errorf("invalid template metadata: duplicate id %s", t.ID)
+		}
+		ids[t.ID] = true
+	}
+
+	for id, reason := range meta.Published.Excluded {
+		if reason == "" {
+			return nil, fmt.Errorf("invalid template metadata: missing reason for excluding %s", id)
+		}
+	}
+
+	return &meta, nil
+}
+
+// Embedded returns the metadata of the published core transactions, read from metadata.json.
+func Embedded() (*Metadata, error) {
+	return Load(metadataJSON)
+}
+
+// CheckPublished returns an error listing the transactions of the published categories
+// of the registry that are neither declared nor excluded in the metadata.
+func (m *Metadata) CheckPublished(registry *templates.Registry) error {
+	declared := make(map[string]bool, len(m.Templates))
+	for _, t := range m.Templates {
+		declared[t.Template] = true
+	}
+
+	var missing []string
+
+	for _, category := range m.Published.Categories {
+		published := registry.Category(category)
+		if len(published) == 0 {
+			return fmt.Errorf("unknown published category %s", category)
+		}
+
+		for _, t := range published {
+			if t.Kind == templates.TransactionKind && !declared[t.ID] && !m.Published.IsExcluded(t.ID) {
+				missing = append(missing, t.ID)
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("missing metadata for published templates: %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
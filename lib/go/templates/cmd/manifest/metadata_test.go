@@ -0,0 +1,135 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/templates/cmd/manifest/metadata"
+)
+
+func TestGenerateManifest(t *testing.T) {
+	meta, err := metadata.Embedded()
+	require.NoError(t, err)
+
+	for _, network := range []string{templates.MainnetNetwork, templates.TestnetNetwork} {
+		env, err := templates.EnvironmentForNetwork(network)
+		require.NoError(t, err)
+
+		m, err := generateManifest(env, meta)
+		require.NoError(t, err)
+		assert.Len(t, m.Templates, len(meta.Templates))
+	}
+}
+
+func TestGenerateManifestMissingMetadata(t *testing.T) {
+	meta, err := metadata.Load([]byte(`{
+		"samples": {
+			"nodeID": {"type": "String", "value": "88549335e1db7b5b46c2ad58ddb70b7a45e770cc5fe779650ba26f10e6bae5e6"},
+			"noDelegatorID": {"type": "Optional", "value": null},
+			"someDelegatorID": {"type": "Optional", "value": {"type": "UInt32", "value": "42"}},
+			"delegatorID": {"type": "UInt32", "value": "42"}
+		},
+		"templates": [
+			{
+				"id": "SCO.12",
+				"name": "Close Stake",
+				"template": "stakingCollection/close_stake",
+				"arguments": {
+					"nodeID": {"label": "Node ID", "samples": ["nodeID"]}
+				}
+			}
+		]
+	}`))
+	require.NoError(t, err)
+
+	_, err = generateManifest(templates.MainnetEnvironment(), meta)
+	require.EqualError(t, err, "SCO.12: missing label or samples for arguments: delegatorID")
+
+	// samples must have the type of the parameter
+	meta.Templates[0].Arguments["delegatorID"] = metadata.Argument{Label: "Delegator ID", Samples: []string{"someDelegatorID", "nodeID"}}
+
+	_, err = generateManifest(templates.MainnetEnvironment(), meta)
+	require.ErrorContains(t, err, "SCO.12: argument delegatorID: sample nodeID:")
+
+	meta.Templates[0].Arguments["delegatorID"] = metadata.Argument{Label: "Delegator ID", Samples: []string{"someDelegatorID", "delegatorID"}}
+
+	_, err = generateManifest(templates.MainnetEnvironment(), meta)
+	require.ErrorContains(t, err, "SCO.12: argument delegatorID: sample delegatorID:")
+
+	meta.Templates[0].Arguments["delegatorID"] = metadata.Argument{Label: "Delegator ID", Samples: []string{"someDelegatorID", "noDelegatorID"}}
+	meta.Templates[0].Arguments["amount"] = metadata.Argument{Label: "Amount", Samples: []string{"nodeID"}}
+
+	_, err = generateManifest(templates.MainnetEnvironment(), meta)
+	require.EqualError(t, err, "SCO.12: metadata for unknown arguments: amount")
+}
+
+func TestGenerateManifestUnpublished(t *testing.T) {
+	meta, err := metadata.Load([]byte(`{
+		"templates": [
+			{
+				"id": "EP.01",
+				"name": "Register QC Voter",
+				"template": "epoch/node/register_qc_voter",
+				"arguments": {}
+			}
+		],
+		"published": {
+			"categories": ["epoch"],
+			"excluded": {
+				"epoch/admin/": "signed by the service account"
+			}
+		}
+	}`))
+	require.NoError(t, err)
+
+	_, err = generateManifest(templates.MainnetEnvironment(), meta)
+	require.EqualError(t, err, "missing metadata for published templates: epoch/node/register_dkg_participant, epoch/node/register_node")
+
+	meta.Published.Categories = append(meta.Published.Categories, "unknown")
+	meta.Published.Excluded["epoch/node/"] = "not published"
+
+	_, err = generateManifest(templates.MainnetEnvironment(), meta)
+	require.EqualError(t, err, "unknown published category unknown")
+
+	_, err = metadata.Load([]byte(`{"published": {"excluded": {"epoch/admin/": ""}}}`))
+	require.Error(t, err)
+}
+
+func TestSampleType(t *testing.T) {
+	meta, err := metadata.Embedded()
+	require.NoError(t, err)
+
+	tests := []struct {
+		sample string
+		typ    string
+		valid  bool
+	}{
+		{"nodeID", "String", true},
+		{"nodeID", "String?", false},
+		{"someDelegatorID", "UInt32?", true},
+		{"noDelegatorID", "UInt32?", true},
+		{"delegatorID", "UInt32?", false},
+		{"nodeIDs", "[String]", true},
+		{"amounts", "[String]", false},
+		{"publicKeys", "[Crypto.KeyListEntry]", true},
+		{"noPublicKeys", "[[Crypto.KeyListEntry]?]", true},
+		{"coaCalls", "[{String: AnyStruct}]", true},
+		{"none", "AnyStruct?", true},
+		{"address", "Address", true},
+	}
+
+	for _, test := range tests {
+		value, err := sample(meta, test.sample, templates.MainnetNetwork)
+		require.NoError(t, err)
+
+		err = checkSampleType(value.Value, test.typ)
+		if test.valid {
+			assert.NoError(t, err, "%s: %s", test.sample, test.typ)
+		} else {
+			assert.Error(t, err, "%s: %s", test.sample, test.typ)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package templates
+
+import (
+	"fmt"
+)
+
+const (
+	MainnetNetwork  = "mainnet"
+	TestnetNetwork  = "testnet"
+	EmulatorNetwork = "emulator"
+)
+
+const (
+	mainnetServiceAccountAddress = "e467b9dd11fa00df"
+	mainnetFungibleTokenAddress  = "f233dcee88fe0abe"
+	mainnetNFTAddress            = "1d7e57aa55817448"
+	mainnetFlowTokenAddress      = "1654653399040a61"
+	mainnetFlowFeesAddress       = "f919ee77447b7497"
+	mainnetIDTableAddress        = "8624b52f9ddcd04a"
+	mainnetLockedTokensAddress   = "8d0e87b65159ae63"
+	mainnetStakingProxyAddress   = "62430cf28c26d095"
+	mainnetExecutionParameters   = "f426ff57ee8f6110"
+)
+
+const (
+	testnetServiceAccountAddress = "8c5303eaa26202d6"
+	testnetFungibleTokenAddress  = "9a0766d93b6608b7"
+	testnetNFTAddress            = "631e88ae7f1d7c20"
+	testnetFlowTokenAddress      = "7e60df042a9c0868"
+	testnetFlowFeesAddress       = "912d5440f7e3769e"
+	testnetIDTableAddress        = "9eca2b38b18b5dfe"
+	testnetLockedTokensAddress   = "95e019a17d0e23d7"
+	testnetStakingProxyAddress   = "7aad92e5a0715d21"
+	testnetExecutionParameters   = "6997a2f2cf57b73a"
+)
+
+const (
+	emulatorServiceAccountAddress = "f8d6e0586b0a20c7"
+	emulatorFungibleTokenAddress  = "ee82856bf20e2aa6"
+	emulatorFlowTokenAddress      = "0ae53cb6e3f42a79"
+	emulatorFlowFeesAddress       = "e5a8b7f23e8b548f"
+)
+
+// MainnetEnvironment returns the Environment with the addresses
+// of all core contracts and their dependencies on Flow Mainnet.
+func MainnetEnvironment() Environment {
+	return Environment{
+		Network:                              MainnetNetwork,
+		ViewResolverAddress:                  mainnetNFTAddress,
+		BurnerAddress:                        mainnetFungibleTokenAddress,
+		CryptoAddress:                        mainnetServiceAccountAddress,
+		FungibleTokenAddress:                 mainnetFungibleTokenAddress,
+		NonFungibleTokenAddress:              mainnetNFTAddress,
+		EVMAddress:                           mainnetServiceAccountAddress,
+		MetadataViewsAddress:                 mainnetNFTAddress,
+		CrossVMMetadataViewsAddress:          mainnetNFTAddress,
+		FungibleTokenMetadataViewsAddress:    mainnetFungibleTokenAddress,
+		FungibleTokenSwitchboardAddress:      mainnetFungibleTokenAddress,
+		FlowTokenAddress:                     mainnetFlowTokenAddress,
+		IDTableAddress:                       mainnetIDTableAddress,
+		LockedTokensAddress:                  mainnetLockedTokensAddress,
+		StakingProxyAddress:                  mainnetStakingProxyAddress,
+		QuorumCertificateAddress:             mainnetIDTableAddress,
+		DkgAddress:                           mainnetIDTableAddress,
+		EpochAddress:                         mainnetIDTableAddress,
+		StorageFeesAddress:                   mainnetServiceAccountAddress,
+		FlowFeesAddress:                      mainnetFlowFeesAddress,
+		StakingCollectionAddress:             mainnetLockedTokensAddress,
+		FlowExecutionParametersAddress:       mainnetExecutionParameters,
+		ServiceAccountAddress:                mainnetServiceAccountAddress,
+		NodeVersionBeaconAddress:             mainnetServiceAccountAddress,
+		RandomBeaconHistoryAddress:           mainnetServiceAccountAddress,
+		LinearCodeAddressGeneratorAddress:    mainnetServiceAccountAddress,
+		FlowTransactionSchedulerAddress:      mainnetServiceAccountAddress,
+		FlowTransactionSchedulerUtilsAddress: mainnetServiceAccountAddress,
+	}
+}
+
+// TestnetEnvironment returns the Environment with the addresses
+// of all core contracts and their dependencies on Flow Testnet.
+func TestnetEnvironment() Environment {
+	return Environment{
+		Network:                              TestnetNetwork,
+		ViewResolverAddress:                  testnetNFTAddress,
+		BurnerAddress:                        testnetFungibleTokenAddress,
+		CryptoAddress:                        testnetServiceAccountAddress,
+		FungibleTokenAddress:                 testnetFungibleTokenAddress,
+		NonFungibleTokenAddress:              testnetNFTAddress,
+		EVMAddress:                           testnetServiceAccountAddress,
+		MetadataViewsAddress:                 testnetNFTAddress,
+		CrossVMMetadataViewsAddress:          testnetNFTAddress,
+		FungibleTokenMetadataViewsAddress:    testnetFungibleTokenAddress,
+		FungibleTokenSwitchboardAddress:      testnetFungibleTokenAddress,
+		FlowTokenAddress:                     testnetFlowTokenAddress,
+		IDTableAddress:                       testnetIDTableAddress,
+		LockedTokensAddress:                  testnetLockedTokensAddress,
+		StakingProxyAddress:                  testnetStakingProxyAddress,
+		QuorumCertificateAddress:             testnetIDTableAddress,
+		DkgAddress:                           testnetIDTableAddress,
+		EpochAddress:                         testnetIDTableAddress,
+		StorageFeesAddress:                   testnetServiceAccountAddress,
+		FlowFeesAddress:                      testnetFlowFeesAddress,
+		StakingCollectionAddress:             testnetLockedTokensAddress,
+		FlowExecutionParametersAddress:       testnetExecutionParameters,
+		ServiceAccountAddress:                testnetServiceAccountAddress,
+		NodeVersionBeaconAddress:             testnetServiceAccountAddress,
+		RandomBeaconHistoryAddress:           testnetServiceAccountAddress,
+		LinearCodeAddressGeneratorAddress:    testnetServiceAccountAddress,
+		FlowTransactionSchedulerAddress:      testnetServiceAccountAddress,
+		FlowTransactionSchedulerUtilsAddress: testnetServiceAccountAddress,
+	}
+}
+
+// EmulatorEnvironment returns the Environment with the addresses
+// of all core contracts and their dependencies on the Flow Emulator.
+func EmulatorEnvironment() Environment {
+	return Environment{
+		Network:                              EmulatorNetwork,
+		ViewResolverAddress:                  emulatorServiceAccountAddress,
+		BurnerAddress:                        emulatorFungibleTokenAddress,
+		CryptoAddress:                        emulatorServiceAccountAddress,
+		FungibleTokenAddress:                 emulatorFungibleTokenAddress,
+		NonFungibleTokenAddress:              emulatorServiceAccountAddress,
+		EVMAddress:                           emulatorServiceAccountAddress,
+		MetadataViewsAddress:                 emulatorServiceAccountAddress,
+		CrossVMMetadataViewsAddress:          emulatorServiceAccountAddress,
+		FungibleTokenMetadataViewsAddress:    emulatorFungibleTokenAddress,
+		FungibleTokenSwitchboardAddress:      emulatorFungibleTokenAddress,
+		FlowTokenAddress:                     emulatorFlowTokenAddress,
+		IDTableAddress:                       emulatorServiceAccountAddress,
+		LockedTokensAddress:                  emulatorServiceAccountAddress,
+		StakingProxyAddress:                  emulatorServiceAccountAddress,
+		QuorumCertificateAddress:             emulatorServiceAccountAddress,
+		DkgAddress:                           emulatorServiceAccountAddress,
+		EpochAddress:                         emulatorServiceAccountAddress,
+		StorageFeesAddress:                   emulatorServiceAccountAddress,
+		FlowFeesAddress:                      emulatorFlowFeesAddress,
+		StakingCollectionAddress:             emulatorServiceAccountAddress,
+		FlowExecutionParametersAddress:       emulatorServiceAccountAddress,
+		ServiceAccountAddress:                emulatorServiceAccountAddress,
+		NodeVersionBeaconAddress:             emulatorServiceAccountAddress,
+		RandomBeaconHistoryAddress:           emulatorServiceAccountAddress,
+		LinearCodeAddressGeneratorAddress:    emulatorServiceAccountAddress,
+		FlowTransactionSchedulerAddress:      emulatorServiceAccountAddress,
+		FlowTransactionSchedulerUtilsAddress: emulatorServiceAccountAddress,
+	}
+}
+
+// EnvironmentForNetwork returns the built-in Environment for the given network name
+// (mainnet, testnet or emulator).
+func EnvironmentForNetwork(network string) (Environment, error) {
+	switch network {
+	case MainnetNetwork:
+		return MainnetEnvironment(), nil
+	case TestnetNetwork:
+		return TestnetEnvironment(), nil
+	case EmulatorNetwork:
+		return EmulatorEnvironment(), nil
+	}
+
+	return Environment{}, fmt.Errorf("invalid network %s", network)
+}
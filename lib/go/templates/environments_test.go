@@ -0,0 +1,69 @@
+package templates_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/templates/internal/assets"
+)
+
+func TestNetworkEnvironments(t *testing.T) {
+
+	for _, network := range []string{
+		templates.MainnetNetwork,
+		templates.TestnetNetwork,
+		templates.EmulatorNetwork,
+	} {
+		t.Run(network, func(t *testing.T) {
+			env, err := templates.EnvironmentForNetwork(network)
+			require.NoError(t, err)
+			assert.Equal(t, network, env.Network)
+
+			// every address of the preset is set
+			value := reflect.ValueOf(env)
+			for i := 0; i < value.NumField(); i++ {
+				assert.NotEmpty(t, value.Field(i).String(), value.Type().Field(i).Name)
+			}
+
+			// every embedded template can be resolved with the preset
+			for _, name := range assets.AssetNames() {
+				assert.NoError(t, env.Validate(assets.MustAsset(name)), name)
+			}
+		})
+	}
+
+	_, err := templates.EnvironmentForNetwork("previewnet")
+	require.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	code := []byte(`
+		import "FlowToken"
+		import "FlowEpoch"
+		import "FlowStakingCollection"
+
+		transaction {}
+	`)
+
+	assert.Equal(t,
+		[]string{"FlowTokenAddress", "EpochAddress", "LockedTokensAddress"},
+		templates.RequiredFields(code),
+	)
+
+	env := templates.Environment{
+		FlowTokenAddress: "0x0ae53cb6e3f42a79",
+	}
+
+	err := env.Validate(code)
+	require.Error(t, err)
+
+	var missingErr *templates.MissingFieldsError
+	require.ErrorAs(t, err, &missingErr)
+	assert.Equal(t, []string{"EpochAddress", "LockedTokensAddress"}, missingErr.Fields)
+
+	assert.NoError(t, templates.EmulatorEnvironment().Validate(code))
+}
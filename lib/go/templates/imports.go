@@ -0,0 +1,156 @@
+package templates
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/parser"
+	"github.com/onflow/cadence/parser/lexer"
+)
+
+// parseImports parses the import declarations of the given Cadence code.
+//
+// Only the import section at the top of the code is parsed,
+// so the rest of the code does not need to be valid Cadence.
+func parseImports(code []byte) ([]*ast.ImportDeclaration, error) {
+	header, err := importSection(code)
+	if err != nil {
+		return nil, err
+	}
+
+	program, err := parser.ParseProgram(nil, header, parser.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse imports: %w", err)
+	}
+
+	return program.ImportDeclarations(), nil
+}
+
+// importSection returns the prefix of the given code
+// that holds the import declarations, including leading comments.
+func importSection(code []byte) ([]byte, error) {
+	tokens, err := lexer.Lex(code, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse imports: %w", err)
+	}
+	defer tokens.Reclaim()
+
+	// next returns the next token that is not whitespace or a comment
+	next := func() lexer.Token {
+		for {
+			token := tokens.Next()
+			switch token.Type {
+			case lexer.TokenSpace,
+				lexer.TokenLineComment,
+				lexer.TokenBlockCommentStart,
+				lexer.TokenBlockCommentContent,
+				lexer.TokenBlockCommentEnd:
+				continue
+			}
+			return token
+		}
+	}
+
+	text := func(token lexer.Token) string {
+		return string(code[token.StartPos.Offset : token.EndPos.Offset+1])
+	}
+
+	isIdentifier := func(token lexer.Token, identifier string) bool {
+		return token.Is(lexer.TokenIdentifier) && text(token) == identifier
+	}
+
+	end := 0
+	token := next()
+
+	for isIdentifier(token, "import") {
+		token = next()
+
+		switch token.Type {
+		case lexer.TokenString, lexer.TokenHexadecimalIntegerLiteral:
+			// import "Name" / import 0x1
+			end = token.EndPos.Offset + 1
+			token = next()
+			continue
+
+		case lexer.TokenIdentifier:
+			// import Name
+			// import Name1, Name2 as Alias from <location>
+
+		default:
+			return nil, fmt.Errorf("cannot parse imports: invalid import declaration at line %d", token.StartPos.Line)
+		}
+
+		end = token.EndPos.Offset + 1
+		token = next()
+
+		for token.Is(lexer.TokenComma) || isIdentifier(token, "as") || isIdentifier(token, "from") {
+			isFrom := isIdentifier(token, "from")
+
+			token = next()
+			end = token.EndPos.Offset + 1
+
+			if isFrom {
+				token = next()
+				break
+			}
+
+			token = next()
+		}
+	}
+
+	return code[:end], nil
+}
+
+// replaceImports rewrites the placeholder imports of the given code with imports
+// from the address returned by resolve for the placeholder name:
+//
+//	import "FlowToken"                      -> import FlowToken from 0x1654653399040a61
+//	import FlowToken from "FlowToken"       -> import FlowToken from 0x1654653399040a61
+//	import FlowToken as FT from "FlowToken" -> import FlowToken as FT from 0x1654653399040a61
+//
+// Placeholders for which resolve returns an empty address are left unchanged.
+// If the import section cannot be parsed, the code is returned unchanged with the parse error.
+func replaceImports(code string, resolve func(name string) string) (string, error) {
+	imports, err := parseImports([]byte(code))
+	if err != nil {
+		return code, err
+	}
+
+	var builder strings.Builder
+	last := 0
+
+	for _, declaration := range imports {
+		location, ok := declaration.Location.(common.StringLocation)
+		if !ok {
+			continue
+		}
+
+		address := resolve(string(location))
+		if address == "" {
+			continue
+		}
+
+		var start int
+		var replacement string
+
+		if len(declaration.Imports) == 0 {
+			// import "Name" imports the contract with the name of the placeholder
+			start = declaration.StartPos.Offset
+			replacement = fmt.Sprintf("import %s from %s", location, withHexPrefix(address))
+		} else {
+			// only replace the location, keeping the imported names and aliases
+			start = declaration.LocationPos.Offset
+			replacement = withHexPrefix(address)
+		}
+
+		builder.WriteString(code[last:start])
+		builder.WriteString(replacement)
+		last = declaration.EndPos.Offset + 1
+	}
+
+	builder.WriteString(code[last:])
+
+	return builder.String(), nil
+}
@@ -0,0 +1,135 @@
+package templates_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/templates/internal/assets"
+)
+
+func TestReplaceAddressImportForms(t *testing.T) {
+
+	t.Run("placeholder import", func(t *testing.T) {
+		code := templates.ReplaceAddress(`import "FlowToken"`, `"FlowToken"`, "0ae53cb6e3f42a79")
+		assert.Equal(t, "import FlowToken from 0x0ae53cb6e3f42a79", code)
+	})
+
+	t.Run("explicit import", func(t *testing.T) {
+		code := templates.ReplaceAddress(`import FlowToken from "FlowToken"`, `"FlowToken"`, "0x0ae53cb6e3f42a79")
+		assert.Equal(t, "import FlowToken from 0x0ae53cb6e3f42a79", code)
+	})
+
+	t.Run("aliased import", func(t *testing.T) {
+		code := templates.ReplaceAddress(`import FlowToken as FT from "FlowToken"`, `"FlowToken"`, "0ae53cb6e3f42a79")
+		assert.Equal(t, "import FlowToken as FT from 0x0ae53cb6e3f42a79", code)
+	})
+
+	t.Run("multiple names", func(t *testing.T) {
+		code := templates.ReplaceAddress(
+			`import FlowIDTableStaking, FlowEpoch as Epoch from "FlowIDTableStaking"`,
+			`"FlowIDTableStaking"`,
+			"8624b52f9ddcd04a",
+		)
+		assert.Equal(t, "import FlowIDTableStaking, FlowEpoch as Epoch from 0x8624b52f9ddcd04a", code)
+	})
+
+	t.Run("string literals are not replaced", func(t *testing.T) {
+		code := templates.ReplaceAddress(`import "FlowToken"
+			transaction {
+				prepare(acct: auth(UpdateContract) &Account) {
+					acct.contracts.update(name: "FlowToken", code: [])
+				}
+			}`,
+			`"FlowToken"`,
+			"0ae53cb6e3f42a79",
+		)
+		assert.True(t, strings.HasPrefix(code, "import FlowToken from 0x0ae53cb6e3f42a79\n"))
+		assert.Contains(t, code, `name: "FlowToken"`)
+	})
+
+	t.Run("empty replacement", func(t *testing.T) {
+		code := templates.ReplaceAddress(`import "FlowToken"`, `"FlowToken"`, "")
+		assert.Equal(t, `import "FlowToken"`, code)
+	})
+
+	t.Run("other placeholders", func(t *testing.T) {
+		code := templates.ReplaceAddress(`import "FlowToken"
+			import "FungibleToken"`,
+			`"FlowToken"`,
+			"0ae53cb6e3f42a79",
+		)
+		assert.Contains(t, code, "import FlowToken from 0x0ae53cb6e3f42a79")
+		assert.Contains(t, code, `import "FungibleToken"`)
+	})
+
+	t.Run("unparsable imports", func(t *testing.T) {
+		// the placeholders are replaced everywhere, like before imports were parsed
+		code := templates.ReplaceAddress(`import "FlowToken"
+			import 42
+			transaction {}`,
+			`"FlowToken"`,
+			"0ae53cb6e3f42a79",
+		)
+		assert.Equal(t, `import FlowToken from 0x0ae53cb6e3f42a79
+			import 42
+			transaction {}`, code)
+	})
+}
+
+func TestReplaceAddressesUpgradeSetClaimed(t *testing.T) {
+	code := templates.ReplaceAddresses(
+		assets.MustAssetString("idTableStaking/admin/upgrade_set_claimed.cdc"),
+		templates.MainnetEnvironment(),
+	)
+
+	assert.True(t, strings.HasPrefix(code, "import FlowIDTableStaking from 0x8624b52f9ddcd04a\n"))
+	assert.Contains(t, code, `acct.contracts.update(name: "FlowIDTableStaking", code: code)`)
+}
+
+// TestReplaceAddressesAllTemplates checks that replacing the addresses
+// of every embedded template only rewrites its import declarations.
+func TestReplaceAddressesAllTemplates(t *testing.T) {
+	env := templates.MainnetEnvironment()
+
+	for _, name := range assets.AssetNames() {
+		original := assets.MustAssetString(name)
+		replaced := templates.ReplaceAddresses(original, env)
+
+		// the code after the imports is unchanged
+		assert.True(t, strings.HasSuffix(replaced, bodyAfterImports(original)), name)
+
+		// replacing again is a no-op
+		assert.Equal(t, replaced, templates.ReplaceAddresses(replaced, env), name)
+
+		// the replaced code only imports from addresses,
+		// except for contracts that are not part of the core contracts
+		err := templates.CheckImports([]byte(replaced))
+		if err == nil {
+			continue
+		}
+
+		var unresolvedErr *templates.UnresolvedImportsError
+		require.ErrorAs(t, err, &unresolvedErr, name)
+		for _, unresolved := range unresolvedErr.Imports {
+			assert.Empty(t, unresolved.Field, name)
+		}
+	}
+}
+
+// bodyAfterImports returns the code following the last import line.
+func bodyAfterImports(code string) string {
+	lines := strings.SplitAfter(code, "\n")
+
+	last := -1
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "import ") {
+			last = i
+		}
+	}
+
+	return strings.Join(lines[last+1:], "")
+}
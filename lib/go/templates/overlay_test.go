@@ -0,0 +1,86 @@
+package templates_test
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+const patchedCloseStake = `import "FlowStakingCollection"
+
+transaction(nodeID: String, delegatorID: UInt32?) {
+    prepare(account: auth(BorrowValue) &Account) {}
+}
+`
+
+func TestOverlay(t *testing.T) {
+	env := templates.MainnetEnvironment()
+
+	embedded := templates.GenerateCollectionCloseStake(env)
+	withdraw := templates.GenerateCollectionWithdrawRewardedTokens(env)
+
+	sources := templates.NewSources(fstest.MapFS{
+		"stakingCollection/close_stake.cdc": {Data: []byte(patchedCloseStake)},
+	})
+
+	expected := []byte(`import FlowStakingCollection from 0x8d0e87b65159ae63
+
+transaction(nodeID: String, delegatorID: UInt32?) {
+    prepare(account: auth(BorrowValue) &Account) {}
+}
+`)
+
+	code, err := sources.Get("stakingCollection/close_stake", env)
+	require.NoError(t, err)
+	assert.Equal(t, expected, code)
+
+	source, err := sources.Source("stakingCollection/close_stake")
+	require.NoError(t, err)
+	assert.Equal(t, []byte(patchedCloseStake), source)
+
+	registry, err := sources.Registry()
+	require.NoError(t, err)
+	template, ok := registry.Get("stakingCollection/close_stake")
+	require.True(t, ok)
+	assert.Equal(t, []byte(patchedCloseStake), template.Source())
+	assert.Equal(t, expected, template.Generate(env))
+	assert.Equal(t, []string{"FlowStakingCollection"}, template.Imports)
+
+	// templates that are not in the overlay are not affected
+	code, err = sources.Get("stakingCollection/withdraw_rewarded_tokens", env)
+	require.NoError(t, err)
+	assert.Equal(t, withdraw, code)
+
+	_, err = sources.Get("stakingCollection/unknown", env)
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+
+	// the embedded templates are not affected
+	assert.Equal(t, embedded, templates.GenerateCollectionCloseStake(env))
+
+	code, err = templates.Get("stakingCollection/close_stake", env)
+	require.NoError(t, err)
+	assert.Equal(t, embedded, code)
+
+	embeddedRegistry, err := templates.NewRegistry()
+	require.NoError(t, err)
+	template, ok = embeddedRegistry.Get("stakingCollection/close_stake")
+	require.True(t, ok)
+	assert.Equal(t, embedded, template.Generate(env))
+
+	t.Run("directory", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "stakingCollection"), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "stakingCollection", "close_stake.cdc"), []byte(patchedCloseStake), 0644))
+
+		code, err := templates.NewSourcesDir(dir).Get("stakingCollection/close_stake", env)
+		require.NoError(t, err)
+		assert.Equal(t, expected, code)
+	})
+}
@@ -0,0 +1,343 @@
+package templates
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/parser"
+	"github.com/onflow/cadence/parser/lexer"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates/internal/assets"
+)
+
+// Kind is the kind of a template, either a transaction or a script.
+type Kind string
+
+const (
+	TransactionKind Kind = "transaction"
+	ScriptKind      Kind = "script"
+)
+
+// Parameter is a parameter of a transaction or of the main function of a script.
+type Parameter struct {
+	// Label is the argument label, which is empty for most templates
+	Label string
+	// Name is the parameter name, e.g. amount
+	Name string
+	// Type is the Cadence type of the parameter, e.g. UFix64 or [String]
+	Type string
+}
+
+// Template describes a transaction or script template of a registry.
+type Template struct {
+	// ID is the path of the template below transactions/ without the .cdc extension,
+	// e.g. idTableStaking/node/register_node
+	ID string
+	// Category is the top-level directory of the template, e.g. idTableStaking
+	Category string
+	// Kind is the kind of the template, either a transaction or a script
+	Kind Kind
+	// Imports are the names of the contracts imported by the template
+	Imports []string
+	// Parameters are the parameters of the transaction or of the main function of the script
+	Parameters []Parameter
+
+	source []byte
+}
+
+// Filename returns the path of the template below transactions/, e.g. idTableStaking/node/register_node.cdc
+func (t Template) Filename() string {
+	return t.ID + ".cdc"
+}
+
+// Source returns the code of the template with its placeholder imports,
+// which its imports and parameters were parsed from.
+func (t Template) Source() []byte {
+	return t.source
+}
+
+// Generate returns the code of the template with its imports
+// replaced with the addresses of the given Environment.
+func (t Template) Generate(env Environment) []byte {
+	return []byte(ReplaceAddresses(string(t.Source()), env))
+}
+
+// Registry lists every transaction and script template, e.g. the ones embedded in this package.
+type Registry struct {
+	templates []Template
+	index     map[string]int
+}
+
+// newRegistry parses the templates with the names of the embedded templates,
+// read with the given function.
+func newRegistry(read func(name string) ([]byte, error)) (*Registry, error) {
+	names := assets.AssetNames()
+	sort.Strings(names)
+
+	registry := &Registry{
+		templates: make([]Template, 0, len(names)),
+		index:     make(map[string]int, len(names)),
+	}
+
+	for _, name := range names {
+		code, err := read(name)
+		if err != nil {
+			return nil, err
+		}
+
+		template, err := parseTemplate(name, code)
+		if err != nil {
+			return nil, err
+		}
+
+		registry.index[template.ID] = len(registry.templates)
+		registry.templates = append(registry.templates, template)
+	}
+
+	return registry, nil
+}
+
+// NewRegistry returns the registry of all embedded templates.
+//
+// The templates are parsed once, so calling NewRegistry repeatedly is cheap.
+// Use Sources.Registry for the registry of templates read from an overlay.
+func NewRegistry() (*Registry, error) {
+	return embedded.Registry()
+}
+
+// All returns all templates, sorted by ID.
+func (r *Registry) All() []Template {
+	return append([]Template(nil), r.templates...)
+}
+
+// Get returns the template with the given ID, e.g. idTableStaking/node/register_node.
+func (r *Registry) Get(id string) (Template, bool) {
+	i, ok := r.index[id]
+	if !ok {
+		return Template{}, false
+	}
+	return r.templates[i], true
+}
+
+// Categories returns the categories of all templates, sorted by name.
+func (r *Registry) Categories() []string {
+	var categories []string
+
+	for i, template := range r.templates {
+		if i == 0 || r.templates[i-1].Category != template.Category {
+			categories = append(categories, template.Category)
+		}
+	}
+
+	sort.Strings(categories)
+
+	return categories
+}
+
+// Category returns the templates of the given category, e.g. lockedTokens.
+func (r *Registry) Category(category string) []Template {
+	return r.Filter(func(t Template) bool {
+		return t.Category == category
+	})
+}
+
+// Search returns the templates whose ID contains the given query, ignoring case.
+func (r *Registry) Search(query string) []Template {
+	query = strings.ToLower(query)
+
+	return r.Filter(func(t Template) bool {
+		return strings.Contains(strings.ToLower(t.ID), query)
+	})
+}
+
+// Filter returns the templates for which the given function returns true.
+func (r *Registry) Filter(include func(Template) bool) []Template {
+	var templates []Template
+
+	for _, template := range r.templates {
+		if include(template) {
+			templates = append(templates, template)
+		}
+	}
+
+	return templates
+}
+
+// parseTemplate extracts the metadata of the template
+// with the given asset name from its source code.
+func parseTemplate(name string, code []byte) (Template, error) {
+	id := strings.TrimSuffix(name, path.Ext(name))
+
+	template := Template{
+		ID:       id,
+		Category: strings.SplitN(id, "/", 2)[0],
+		source:   code,
+	}
+
+	imports, err := parseImports(code)
+	if err != nil {
+		return Template{}, fmt.Errorf("template %s: %w", id, err)
+	}
+
+	template.Imports = importedContracts(imports)
+
+	template.Kind, template.Parameters, err = ParseSignature(code)
+	if err != nil {
+		return Template{}, fmt.Errorf("template %s: %w", id, err)
+	}
+
+	return template, nil
+}
+
+// importedContracts returns the names of the contracts imported by the given declarations.
+func importedContracts(imports []*ast.ImportDeclaration) []string {
+	var names []string
+
+	for _, declaration := range imports {
+		if len(declaration.Imports) == 0 {
+			switch location := declaration.Location.(type) {
+			case common.StringLocation:
+				// import "Name"
+				names = append(names, string(location))
+			case common.IdentifierLocation:
+				// import Name
+				names = append(names, string(location))
+			}
+			continue
+		}
+
+		for _, imported := range declaration.Imports {
+			names = append(names, imported.Identifier.Identifier)
+		}
+	}
+
+	return names
+}
+
+// ParseSignature returns the kind and the parameters of the given transaction or script code.
+//
+// Templates that are not valid Cadence (e.g. because they still use pre-1.0 syntax)
+// are handled by only parsing the parameter list of the transaction or main function.
+func ParseSignature(code []byte) (Kind, []Parameter, error) {
+	program, err := parser.ParseProgram(nil, code, parser.Config{})
+	if err != nil {
+		return parseSignatureOnly(code)
+	}
+
+	if transactions := program.TransactionDeclarations(); len(transactions) > 0 {
+		return TransactionKind, parameters(transactions[0].ParameterList), nil
+	}
+
+	for _, function := range program.FunctionDeclarations() {
+		if function.Identifier.Identifier == "main" {
+			return ScriptKind, parameters(function.ParameterList), nil
+		}
+	}
+
+	return "", nil, fmt.Errorf("no transaction or main function declared")
+}
+
+// parseSignatureOnly finds the parameter list of the top-level transaction
+// or main function of the given code and parses it on its own.
+func parseSignatureOnly(code []byte) (Kind, []Parameter, error) {
+	tokens, err := lexer.Lex(code, nil)
+	if err != nil {
+		return "", nil, fmt.Errorf("cannot parse template: %w", err)
+	}
+	defer tokens.Reclaim()
+
+	text := func(token lexer.Token) string {
+		return string(code[token.StartPos.Offset : token.EndPos.Offset+1])
+	}
+
+	var kind Kind
+	var previous string
+	depth := 0
+
+	for kind == "" {
+		token := tokens.Next()
+
+		switch token.Type {
+		case lexer.TokenEOF:
+			return "", nil, fmt.Errorf("no transaction or main function declared")
+		case lexer.TokenBraceOpen:
+			depth++
+		case lexer.TokenBraceClose:
+			depth--
+		case lexer.TokenIdentifier:
+			identifier := text(token)
+			if depth == 0 && identifier == "transaction" {
+				kind = TransactionKind
+			} else if depth == 0 && identifier == "main" && previous == "fun" {
+				kind = ScriptKind
+			}
+			previous = identifier
+		}
+	}
+
+	// collect the parameter list, up to the matching closing parenthesis
+	start := -1
+	depth = 0
+
+	for {
+		token := tokens.Next()
+
+		switch token.Type {
+		case lexer.TokenEOF:
+			return "", nil, fmt.Errorf("unterminated parameter list")
+		case lexer.TokenSpace,
+			lexer.TokenLineComment,
+			lexer.TokenBlockCommentStart,
+			lexer.TokenBlockCommentContent,
+			lexer.TokenBlockCommentEnd:
+			continue
+		case lexer.TokenParenOpen:
+			if start < 0 {
+				start = token.EndPos.Offset + 1
+			}
+			depth++
+			continue
+		case lexer.TokenParenClose:
+			depth--
+			if depth > 0 {
+				continue
+			}
+
+			list := code[start:token.StartPos.Offset]
+
+			program, err := parser.ParseProgram(nil, []byte("transaction("+string(list)+") {}"), parser.Config{})
+			if err != nil {
+				return "", nil, fmt.Errorf("cannot parse parameters: %w", err)
+			}
+
+			return kind, parameters(program.TransactionDeclarations()[0].ParameterList), nil
+		}
+
+		if start < 0 {
+			// a transaction without parameters
+			return kind, nil, nil
+		}
+	}
+}
+
+func parameters(list *ast.ParameterList) []Parameter {
+	if list == nil {
+		return nil
+	}
+
+	params := make([]Parameter, len(list.Parameters))
+
+	for i, parameter := range list.Parameters {
+		params[i] = Parameter{
+			Label: parameter.Label,
+			Name:  parameter.Identifier.Identifier,
+			Type:  parameter.TypeAnnotation.Type.String(),
+		}
+	}
+
+	return params
+}
@@ -0,0 +1,87 @@
+package templates_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/templates/internal/assets"
+)
+
+func TestRegistry(t *testing.T) {
+	registry, err := templates.NewRegistry()
+	require.NoError(t, err)
+
+	t.Run("all assets", func(t *testing.T) {
+		all := registry.All()
+		require.Len(t, all, len(assets.AssetNames()))
+
+		for _, template := range all {
+			assert.NotEmpty(t, template.Category, template.ID)
+			assert.Contains(t, []templates.Kind{templates.TransactionKind, templates.ScriptKind}, template.Kind, template.ID)
+			assert.Equal(t, assets.MustAsset(template.Filename()), template.Source(), template.ID)
+		}
+	})
+
+	t.Run("transaction", func(t *testing.T) {
+		template, ok := registry.Get("stakingCollection/close_stake")
+		require.True(t, ok)
+
+		assert.Equal(t, "stakingCollection", template.Category)
+		assert.Equal(t, templates.TransactionKind, template.Kind)
+		assert.Equal(t, []string{"FlowStakingCollection"}, template.Imports)
+		assert.Equal(t,
+			[]templates.Parameter{
+				{Name: "nodeID", Type: "String"},
+				{Name: "delegatorID", Type: "UInt32?"},
+			},
+			template.Parameters,
+		)
+	})
+
+	t.Run("script", func(t *testing.T) {
+		template, ok := registry.Get("randomBeaconHistory/scripts/get_source_of_randomness")
+		require.True(t, ok)
+
+		assert.Equal(t, "randomBeaconHistory", template.Category)
+		assert.Equal(t, templates.ScriptKind, template.Kind)
+		assert.Equal(t, []string{"RandomBeaconHistory"}, template.Imports)
+		assert.Equal(t, []templates.Parameter{{Name: "atBlockHeight", Type: "UInt64"}}, template.Parameters)
+	})
+
+	t.Run("pre-1.0 syntax", func(t *testing.T) {
+		template, ok := registry.Get("stakingProxy/get_node_info")
+		require.True(t, ok)
+
+		assert.Equal(t, templates.ScriptKind, template.Kind)
+		assert.Equal(t,
+			[]templates.Parameter{
+				{Name: "account", Type: "Address"},
+				{Name: "nodeID", Type: "String"},
+			},
+			template.Parameters,
+		)
+	})
+
+	t.Run("generate", func(t *testing.T) {
+		env := templates.TestnetEnvironment()
+
+		template, ok := registry.Get("idTableStaking/node/register_node")
+		require.True(t, ok)
+		assert.Equal(t, templates.GenerateRegisterNodeScript(env), template.Generate(env))
+	})
+
+	t.Run("lookup", func(t *testing.T) {
+		_, ok := registry.Get("idTableStaking/node/not_a_template")
+		assert.False(t, ok)
+
+		assert.Contains(t, registry.Categories(), "transactionScheduler")
+		assert.Len(t, registry.Category("randomBeaconHistory"), 5)
+
+		for _, template := range registry.Search("SOURCE_OF_RANDOMNESS") {
+			assert.Equal(t, "randomBeaconHistory", template.Category)
+		}
+	})
+}
@@ -0,0 +1,105 @@
+package templates
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sync"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates/internal/assets"
+)
+
+// FS returns a read-only file system holding the raw transaction and script templates
+// embedded in this package, laid out like the transactions/ directory of this repository,
+// e.g. idTableStaking/node/register_node.cdc.
+func FS() fs.FS {
+	return assets.FS()
+}
+
+// Sources reads the transaction and script templates, either the ones embedded in this package,
+// or the files of an overlay that shadow the embedded templates with the same path,
+// e.g. idTableStaking/node/register_node.cdc.
+//
+// An overlay lets patched templates be tried out, e.g. in an integration test suite,
+// without regenerating the embedded assets.
+// The imports of the overlay files are replaced like the ones of the embedded templates,
+// and the registry of the sources describes the overlay files.
+// The Generate functions always return the embedded templates.
+type Sources struct {
+	overlay  fs.FS
+	registry func() (*Registry, error)
+}
+
+// embedded are the sources of the templates embedded in this package,
+// used by Get and NewRegistry.
+var embedded = NewSources(nil)
+
+// NewSources returns the sources of the templates in which the files of the given file system
+// shadow the embedded templates with the same path.
+// Templates that are not in the overlay are still read from the embedded templates.
+// A nil file system returns the sources of the embedded templates.
+func NewSources(overlay fs.FS) *Sources {
+	s := &Sources{overlay: overlay}
+
+	// the templates are parsed once, so calling Registry repeatedly is cheap
+	s.registry = sync.OnceValues(func() (*Registry, error) {
+		return newRegistry(s.read)
+	})
+
+	return s
+}
+
+// NewSourcesDir is like NewSources with the files of the given directory,
+// which is laid out like the transactions/ directory of this repository.
+func NewSourcesDir(dir string) *Sources {
+	return NewSources(os.DirFS(dir))
+}
+
+func (s *Sources) read(name string) ([]byte, error) {
+	return assets.Read(s.overlay, name)
+}
+
+// Source returns the code of the template with the given ID, e.g. idTableStaking/node/register_node,
+// with its placeholder imports.
+//
+// It returns an error wrapping fs.ErrNotExist if there is no template with the given ID.
+func (s *Sources) Source(id string) ([]byte, error) {
+	code, err := s.read(id + ".cdc")
+	if errors.Is(err, fs.ErrNotExist) || errors.Is(err, fs.ErrInvalid) {
+		return nil, fmt.Errorf("unknown template %q: %w", id, fs.ErrNotExist)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read template %q: %w", id, err)
+	}
+
+	return code, nil
+}
+
+// Get returns the code of the template with the given ID, e.g. idTableStaking/node/register_node,
+// with its imports replaced with the addresses of the given Environment.
+//
+// It returns an error wrapping fs.ErrNotExist if there is no template with the given ID.
+func (s *Sources) Get(id string, env Environment) ([]byte, error) {
+	code, err := s.Source(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(ReplaceAddresses(string(code), env)), nil
+}
+
+// Registry returns the registry of the templates,
+// with the imports and parameters of the templates parsed from these sources.
+func (s *Sources) Registry() (*Registry, error) {
+	return s.registry()
+}
+
+// Get returns the code of the embedded template with the given ID, e.g. idTableStaking/node/register_node,
+// with its imports replaced with the addresses of the given Environment.
+//
+// Unlike the Generate functions, which panic on a missing template,
+// Get returns an error wrapping fs.ErrNotExist if there is no template with the given ID.
+func Get(id string, env Environment) ([]byte, error) {
+	return embedded.Get(id, env)
+}
@@ -0,0 +1,55 @@
+package templates_test
+
+import (
+	"io/fs"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/templates/internal/assets"
+)
+
+func TestFS(t *testing.T) {
+	fsys := templates.FS()
+
+	require.NoError(t, fstest.TestFS(fsys, "idTableStaking/node/register_node.cdc", "stakingCollection/close_stake.cdc"))
+
+	var names []string
+	err := fs.WalkDir(fsys, ".", func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() {
+			names = append(names, path)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, assets.AssetNames(), names)
+
+	code, err := fs.ReadFile(fsys, "stakingCollection/close_stake.cdc")
+	require.NoError(t, err)
+	assert.Contains(t, string(code), `import "FlowStakingCollection"`)
+
+	_, err = fs.ReadFile(fsys, "stakingCollection/missing.cdc")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+func TestGet(t *testing.T) {
+	env := templates.MainnetEnvironment()
+
+	code, err := templates.Get("stakingCollection/close_stake", env)
+	require.NoError(t, err)
+	assert.Equal(t, templates.GenerateCollectionCloseStake(env), code)
+
+	_, err = templates.Get("stakingCollection/missing", env)
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	assert.ErrorContains(t, err, `unknown template "stakingCollection/missing"`)
+
+	_, err = templates.Get(strings.Repeat("../", 3)+"contracts/FlowToken", env)
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
@@ -1,7 +1,6 @@
 package templates
 
 import (
-	_ "github.com/kevinburke/go-bindata"
 	"github.com/onflow/flow-core-contracts/lib/go/templates/internal/assets"
 )
 
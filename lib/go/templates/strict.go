@@ -0,0 +1,107 @@
+package templates
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onflow/cadence/common"
+)
+
+// UnresolvedImport is an import of the form `import "Name"`
+// that is still present after the placeholders have been replaced.
+type UnresolvedImport struct {
+	// Name is the name of the placeholder, e.g. FlowEpoch
+	Name string
+	// Field is the Environment field that resolves the placeholder,
+	// or empty if the placeholder matches none of the known placeholders
+	Field string
+	// Line is the line of the import declaration, starting at 1
+	Line int
+}
+
+func (i UnresolvedImport) String() string {
+	if i.Field == "" {
+		return fmt.Sprintf("%q (line %d): unknown placeholder", i.Name, i.Line)
+	}
+	return fmt.Sprintf("%q (line %d): %s is not set", i.Name, i.Line, i.Field)
+}
+
+// UnresolvedImportsError is returned in strict mode
+// when a generated script still contains placeholder imports.
+type UnresolvedImportsError struct {
+	Imports []UnresolvedImport
+}
+
+func (e *UnresolvedImportsError) Error() string {
+	imports := make([]string, len(e.Imports))
+	for i, unresolved := range e.Imports {
+		imports[i] = unresolved.String()
+	}
+
+	return fmt.Sprintf("unresolved imports: %s", strings.Join(imports, ", "))
+}
+
+// CheckImports parses the import declarations of the given code and returns
+// an *UnresolvedImportsError listing every import that still refers to a placeholder.
+func CheckImports(code []byte) error {
+	imports, err := parseImports(code)
+	if err != nil {
+		return err
+	}
+
+	var unresolved []UnresolvedImport
+
+	for _, declaration := range imports {
+		location, ok := declaration.Location.(common.StringLocation)
+		if !ok {
+			continue
+		}
+
+		p, _ := lookupPlaceholder(string(location))
+
+		unresolved = append(unresolved, UnresolvedImport{
+			Name:  string(location),
+			Field: p.field,
+			Line:  declaration.StartPos.Line,
+		})
+	}
+
+	if len(unresolved) > 0 {
+		return &UnresolvedImportsError{Imports: unresolved}
+	}
+
+	return nil
+}
+
+// ReplaceAddressesStrict replaces the placeholder imports of the given code
+// like ReplaceAddresses, but returns an error if the imports cannot be parsed,
+// and an *UnresolvedImportsError if any import could not be resolved with the given Environment.
+func ReplaceAddressesStrict(code string, env Environment) (string, error) {
+	code, err := replaceImports(code, env.placeholderAddress)
+	if err != nil {
+		return "", err
+	}
+
+	err = CheckImports([]byte(code))
+	if err != nil {
+		return "", err
+	}
+
+	return code, nil
+}
+
+// GenerateStrict runs the given template generator, e.g. GenerateRegisterNodeScript,
+// and returns an *UnresolvedImportsError if the generated script
+// still contains placeholder imports.
+//
+//	code, err := templates.GenerateStrict(env, templates.GenerateRegisterNodeScript)
+func GenerateStrict(env Environment, generate func(env Environment) []byte) ([]byte, error) {
+	code := generate(env)
+
+	err := CheckImports(code)
+	if err != nil {
+		return nil, err
+	}
+
+	return code, nil
+}
@@ -0,0 +1,104 @@
+package templates_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/templates/internal/assets"
+)
+
+func TestReplaceAddressesStrict(t *testing.T) {
+	code := `
+		import "FlowToken"
+		import "FlowEpoch"
+		import "NotACoreContract"
+		import Crypto
+
+		transaction {
+			prepare(signer: &Account) {
+				panic("FlowEpoch is not deployed")
+			}
+		}
+	`
+
+	t.Run("unresolved", func(t *testing.T) {
+		env := templates.Environment{
+			FlowTokenAddress: "0ae53cb6e3f42a79",
+		}
+
+		_, err := templates.ReplaceAddressesStrict(code, env)
+		require.Error(t, err)
+
+		var unresolvedErr *templates.UnresolvedImportsError
+		require.ErrorAs(t, err, &unresolvedErr)
+		assert.Equal(t,
+			[]templates.UnresolvedImport{
+				{Name: "FlowEpoch", Field: "EpochAddress", Line: 3},
+				{Name: "NotACoreContract", Field: "", Line: 4},
+			},
+			unresolvedErr.Imports,
+		)
+	})
+
+	t.Run("resolved", func(t *testing.T) {
+		resolved, err := templates.ReplaceAddressesStrict(
+			`import "FlowToken"
+			transaction {}`,
+			templates.EmulatorEnvironment(),
+		)
+		require.NoError(t, err)
+		assert.Contains(t, resolved, "import FlowToken from 0x0ae53cb6e3f42a79")
+	})
+
+	t.Run("invalid imports", func(t *testing.T) {
+		invalid := `import "FlowToken"
+			import 42
+			transaction {}`
+
+		// the lenient replacement falls back to replacing the placeholders everywhere
+		assert.Equal(t, `import FlowToken from 0x0ae53cb6e3f42a79
+			import 42
+			transaction {}`, templates.ReplaceAddresses(invalid, templates.EmulatorEnvironment()))
+
+		_, err := templates.ReplaceAddressesStrict(invalid, templates.EmulatorEnvironment())
+		assert.ErrorContains(t, err, "cannot parse imports")
+	})
+}
+
+func TestGenerateStrict(t *testing.T) {
+	_, err := templates.GenerateStrict(templates.Environment{}, templates.GenerateRegisterNodeScript)
+	require.Error(t, err)
+
+	code, err := templates.GenerateStrict(templates.TestnetEnvironment(), templates.GenerateRegisterNodeScript)
+	require.NoError(t, err)
+	assert.Equal(t, templates.GenerateRegisterNodeScript(templates.TestnetEnvironment()), code)
+}
+
+func TestStrictAllTemplates(t *testing.T) {
+	env := templates.MainnetEnvironment()
+
+	// contracts that are imported by some templates,
+	// but are not part of the core contracts
+	nonCoreImports := map[string]bool{
+		"TestFlowScheduledTransactionHandler": true,
+		"TokenForwarding":                     true,
+	}
+
+	for _, name := range assets.AssetNames() {
+		_, err := templates.ReplaceAddressesStrict(assets.MustAssetString(name), env)
+		if err == nil {
+			continue
+		}
+
+		var unresolvedErr *templates.UnresolvedImportsError
+		require.ErrorAs(t, err, &unresolvedErr, name)
+
+		for _, unresolved := range unresolvedErr.Imports {
+			assert.Empty(t, unresolved.Field, name)
+			assert.True(t, nonCoreImports[unresolved.Name], name)
+		}
+	}
+}
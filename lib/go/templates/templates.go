@@ -1,14 +1,14 @@
 package templates
 
-//go:generate go run github.com/kevinburke/go-bindata/go-bindata -prefix ../../../transactions -o internal/assets/assets.go -pkg assets -nometadata -nomemcopy ../../../transactions/...
-//go:generate go run ./cmd/manifest/main.go ./cmd/manifest/manifest.go manifest.testnet.json --network testnet
-//go:generate go run ./cmd/manifest/main.go ./cmd/manifest/manifest.go manifest.mainnet.json --network mainnet
+//go:generate sh -c "rm -rf internal/assets/files && mkdir internal/assets/files && tar -cf - -C ../../../transactions . | tar -xf - -C internal/assets/files"
+//go:generate cp ../contracts/internal/assets/assets.go internal/assets/assets.go
+//go:generate go run ./cmd/manifest manifest.testnet.json --network testnet
+//go:generate go run ./cmd/manifest manifest.mainnet.json --network mainnet
 
 import (
 	"fmt"
 	"strings"
 
-	_ "github.com/kevinburke/go-bindata"
 	_ "github.com/psiemens/sconfig"
 	_ "github.com/spf13/cobra"
 )
@@ -73,6 +73,17 @@ type Environment struct {
 	FlowTransactionSchedulerUtilsAddress string
 }
 
+// fieldValue returns the value of the Environment field with the given name,
+// or an empty string if no placeholder is resolved by the field
+func (env Environment) fieldValue(field string) string {
+	for _, p := range placeholderFields {
+		if p.field == field {
+			return p.address(env)
+		}
+	}
+	return ""
+}
+
 func withHexPrefix(address string) string {
 	if address == "" {
 		return ""
@@ -85,184 +96,135 @@ func withHexPrefix(address string) string {
 	return fmt.Sprintf("0x%s", address)
 }
 
+// ReplaceAddress replaces the imports of the given placeholder, e.g. "FlowToken",
+// with an import from the replacement address.
+//
+// Only import declarations are rewritten, so string literals in the code
+// that happen to equal the placeholder are left untouched.
+// The code is returned unchanged if the replacement is empty.
+// If the imports cannot be parsed, every occurrence of the placeholder is replaced instead,
+// see ReplaceAddressesStrict.
 func ReplaceAddress(code, placeholder, replacement string) string {
-	placeholderWithoutQuotes := placeholder[1 : len(placeholder)-1]
-
-	if len(replacement) > 0 {
-		if strings.Contains(code, placeholderWithoutQuotes+" from "+placeholder) {
-			code = strings.ReplaceAll(
-				code,
-				placeholder,
-				withHexPrefix(replacement),
-			)
-		} else {
-			code = strings.ReplaceAll(
-				code,
-				placeholder,
-				placeholderWithoutQuotes+" from "+withHexPrefix(replacement),
-			)
+	replaced, err := replaceImports(code, func(name string) string {
+		if fmt.Sprintf("%q", name) != placeholder {
+			return ""
 		}
+		return replacement
+	})
+	if err != nil {
+		return replacePlaceholder(code, placeholder, replacement)
 	}
-	return code
+	return replaced
 }
 
-func ReplaceAddresses(code string, env Environment) string {
-
-	code = ReplaceAddress(
-		code,
-		placeholderFungibleTokenMVAddress,
-		env.FungibleTokenMetadataViewsAddress,
-	)
-
-	code = ReplaceAddress(
-		code,
-		placeholderMetadataViewsAddress,
-		env.MetadataViewsAddress,
-	)
-
-	code = ReplaceAddress(
-		code,
-		placeholderCrossVMMetadataViewsAddress,
-		env.CrossVMMetadataViewsAddress,
-	)
-
-	code = ReplaceAddress(
-		code,
-		placeholderBurnerAddress,
-		env.BurnerAddress,
-	)
-
-	code = ReplaceAddress(
-		code,
-		placeholderCryptoAddress,
-		env.CryptoAddress,
-	)
-
-	code = ReplaceAddress(
-		code,
-		placeholderViewResolverAddress,
-		env.ViewResolverAddress,
-	)
-
-	code = ReplaceAddress(
-		code,
-		placeholderFungibleTokenAddress,
-		env.FungibleTokenAddress,
-	)
-
-	code = ReplaceAddress(
-		code,
-		placeholderNonFungibleTokenAddress,
-		env.NonFungibleTokenAddress,
-	)
-
-	code = ReplaceAddress(
-		code,
-		placeholderEVMAddress,
-		env.EVMAddress,
-	)
-
-	code = ReplaceAddress(
-		code,
-		placeholderFlowTokenAddress,
-		env.FlowTokenAddress,
-	)
-
-	code = ReplaceAddress(
-		code,
-		placeholderIDTableAddress,
-		env.IDTableAddress,
-	)
-
-	code = ReplaceAddress(
-		code,
-		placeholderLockedTokensAddress,
-		env.LockedTokensAddress,
-	)
-
-	code = ReplaceAddress(
-		code,
-		placeholderStakingProxyAddress,
-		env.StakingProxyAddress,
-	)
-
-	code = ReplaceAddress(
-		code,
-		placeholderQuorumCertificateAddress,
-		env.QuorumCertificateAddress,
-	)
-
-	code = ReplaceAddress(
-		code,
-		placeholderDKGAddress,
-		env.DkgAddress,
-	)
-
-	code = ReplaceAddress(
-		code,
-		placeholderEpochAddress,
-		env.EpochAddress,
-	)
+// replacePlaceholder replaces every occurrence of the placeholder in the code with an import
+// from the replacement address, whether it is in an import declaration or not.
+// It is the fallback for code whose imports cannot be parsed.
+func replacePlaceholder(code, placeholder, replacement string) string {
+	if len(replacement) == 0 {
+		return code
+	}
 
-	code = ReplaceAddress(
-		code,
-		placeholderStorageFeesAddress,
-		env.StorageFeesAddress,
-	)
+	placeholderWithoutQuotes := placeholder[1 : len(placeholder)-1]
 
-	code = ReplaceAddress(
-		code,
-		placeholderFlowFeesAddress,
-		env.FlowFeesAddress,
-	)
+	if strings.Contains(code, placeholderWithoutQuotes+" from "+placeholder) {
+		return strings.ReplaceAll(code, placeholder, withHexPrefix(replacement))
+	}
 
-	code = ReplaceAddress(
-		code,
-		placeholderStakingCollectionAddress,
-		env.LockedTokensAddress,
-	)
+	return strings.ReplaceAll(code, placeholder, placeholderWithoutQuotes+" from "+withHexPrefix(replacement))
+}
 
-	code = ReplaceAddress(
-		code,
-		placeholderExecutionParametersAddress,
-		env.FlowExecutionParametersAddress,
-	)
+// placeholderField is an import placeholder and the Environment field
+// holding the address it is replaced with.
+type placeholderField struct {
+	placeholder string
+	field       string
+	address     func(env Environment) string
+}
 
-	code = ReplaceAddress(
-		code,
-		placeholderServiceAccountAddress,
-		env.ServiceAccountAddress,
-	)
+// placeholderFields maps every import placeholder to the Environment field
+// holding the address it is replaced with.
+var placeholderFields = []placeholderField{
+	{placeholderFungibleTokenMVAddress, "FungibleTokenMetadataViewsAddress", func(env Environment) string { return env.FungibleTokenMetadataViewsAddress }},
+	{placeholderMetadataViewsAddress, "MetadataViewsAddress", func(env Environment) string { return env.MetadataViewsAddress }},
+	{placeholderCrossVMMetadataViewsAddress, "CrossVMMetadataViewsAddress", func(env Environment) string { return env.CrossVMMetadataViewsAddress }},
+	{placeholderBurnerAddress, "BurnerAddress", func(env Environment) string { return env.BurnerAddress }},
+	{placeholderCryptoAddress, "CryptoAddress", func(env Environment) string { return env.CryptoAddress }},
+	{placeholderViewResolverAddress, "ViewResolverAddress", func(env Environment) string { return env.ViewResolverAddress }},
+	{placeholderFungibleTokenAddress, "FungibleTokenAddress", func(env Environment) string { return env.FungibleTokenAddress }},
+	{placeholderNonFungibleTokenAddress, "NonFungibleTokenAddress", func(env Environment) string { return env.NonFungibleTokenAddress }},
+	{placeholderEVMAddress, "EVMAddress", func(env Environment) string { return env.EVMAddress }},
+	{placeholderFlowTokenAddress, "FlowTokenAddress", func(env Environment) string { return env.FlowTokenAddress }},
+	{placeholderIDTableAddress, "IDTableAddress", func(env Environment) string { return env.IDTableAddress }},
+	{placeholderLockedTokensAddress, "LockedTokensAddress", func(env Environment) string { return env.LockedTokensAddress }},
+	{placeholderStakingProxyAddress, "StakingProxyAddress", func(env Environment) string { return env.StakingProxyAddress }},
+	{placeholderQuorumCertificateAddress, "QuorumCertificateAddress", func(env Environment) string { return env.QuorumCertificateAddress }},
+	{placeholderDKGAddress, "DkgAddress", func(env Environment) string { return env.DkgAddress }},
+	{placeholderEpochAddress, "EpochAddress", func(env Environment) string { return env.EpochAddress }},
+	{placeholderStorageFeesAddress, "StorageFeesAddress", func(env Environment) string { return env.StorageFeesAddress }},
+	{placeholderFlowFeesAddress, "FlowFeesAddress", func(env Environment) string { return env.FlowFeesAddress }},
+	{placeholderStakingCollectionAddress, "LockedTokensAddress", func(env Environment) string { return env.LockedTokensAddress }},
+	{placeholderExecutionParametersAddress, "FlowExecutionParametersAddress", func(env Environment) string { return env.FlowExecutionParametersAddress }},
+	{placeholderServiceAccountAddress, "ServiceAccountAddress", func(env Environment) string { return env.ServiceAccountAddress }},
+	{placeholderNodeVersionBeaconAddress, "NodeVersionBeaconAddress", func(env Environment) string { return env.NodeVersionBeaconAddress }},
+	{placeholderRandomBeaconHistoryAddress, "RandomBeaconHistoryAddress", func(env Environment) string { return env.RandomBeaconHistoryAddress }},
+	{placeholderLinearCodeAddressGeneratorAddress, "LinearCodeAddressGeneratorAddress", func(env Environment) string { return env.LinearCodeAddressGeneratorAddress }},
+	{placeholderFlowTransactionSchedulerAddress, "FlowTransactionSchedulerAddress", func(env Environment) string { return env.FlowTransactionSchedulerAddress }},
+	{placeholderFlowTransactionSchedulerUtilsAddress, "FlowTransactionSchedulerUtilsAddress", func(env Environment) string { return env.FlowTransactionSchedulerUtilsAddress }},
+}
 
-	code = ReplaceAddress(
-		code,
-		placeholderNodeVersionBeaconAddress,
-		env.NodeVersionBeaconAddress,
-	)
+// lookupPlaceholder returns the placeholder with the given name (without quotes),
+// or false if the name matches none of the known placeholders.
+func lookupPlaceholder(name string) (placeholderField, bool) {
+	quoted := fmt.Sprintf("%q", name)
+	for _, p := range placeholderFields {
+		if p.placeholder == quoted {
+			return p, true
+		}
+	}
+	return placeholderField{}, false
+}
 
-	code = ReplaceAddress(
-		code,
-		placeholderRandomBeaconHistoryAddress,
-		env.RandomBeaconHistoryAddress,
-	)
+// ContractAddress returns the address, with 0x prefix, the contract with the given name
+// is imported from, e.g. ContractAddress("FlowToken").
+// It returns false if the name matches none of the known placeholders or the address is not set.
+func (env Environment) ContractAddress(name string) (string, bool) {
+	p, ok := lookupPlaceholder(name)
+	if !ok {
+		return "", false
+	}
 
-	code = ReplaceAddress(
-		code,
-		placeholderLinearCodeAddressGeneratorAddress,
-		env.LinearCodeAddressGeneratorAddress,
-	)
+	address := p.address(env)
+	if address == "" {
+		return "", false
+	}
 
-	code = ReplaceAddress(
-		code,
-		placeholderFlowTransactionSchedulerAddress,
-		env.FlowTransactionSchedulerAddress,
-	)
+	return withHexPrefix(address), true
+}
 
-	code = ReplaceAddress(
-		code,
-		placeholderFlowTransactionSchedulerUtilsAddress,
-		env.FlowTransactionSchedulerUtilsAddress,
-	)
+// ReplaceAddresses replaces all placeholder imports of the given code
+// with imports from the addresses of the given Environment.
+// Placeholders whose address is not set are left unchanged.
+// If the imports cannot be parsed, every occurrence of the placeholders is replaced instead,
+// use ReplaceAddressesStrict to get the error.
+func ReplaceAddresses(code string, env Environment) string {
+	replaced, err := replaceImports(code, env.placeholderAddress)
+	if err == nil {
+		return replaced
+	}
 
+	for _, p := range placeholderFields {
+		code = replacePlaceholder(code, p.placeholder, p.address(env))
+	}
 	return code
 }
+
+// placeholderAddress returns the address of the Environment that resolves the placeholder
+// with the given name, or an empty string if the name matches none of the known placeholders.
+func (env Environment) placeholderAddress(name string) string {
+	p, ok := lookupPlaceholder(name)
+	if !ok {
+		return ""
+	}
+	return p.address(env)
+}
@@ -0,0 +1,26 @@
+package templates
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlaceholderFields(t *testing.T) {
+	for _, p := range placeholderFields {
+		t.Run(p.placeholder, func(t *testing.T) {
+			// the field must name an existing string field of the Environment
+			field, ok := reflect.TypeOf(Environment{}).FieldByName(p.field)
+			require.True(t, ok, "Environment has no field %s", p.field)
+			require.Equal(t, reflect.String, field.Type.Kind())
+
+			// and the accessor must read that field
+			var env Environment
+			reflect.ValueOf(&env).Elem().FieldByName(p.field).SetString("0x01")
+			assert.Equal(t, "0x01", p.address(env))
+			assert.Equal(t, "0x01", env.fieldValue(p.field))
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package templates
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onflow/cadence/common"
+)
+
+// MissingFieldsError is returned by Environment.Validate when a contract or template
+// imports contracts whose addresses are not set in the Environment.
+type MissingFieldsError struct {
+	// Fields are the names of the Environment fields that need to be set
+	Fields []string
+}
+
+func (e *MissingFieldsError) Error() string {
+	return fmt.Sprintf("environment is missing addresses for fields: %s", strings.Join(e.Fields, ", "))
+}
+
+// RequiredFields returns the names of the Environment fields
+// that are needed to resolve the imports of the given contract or template code.
+//
+// Only import declarations are considered, placeholders in string literals are ignored.
+// If the imports cannot be parsed, every placeholder contained in the code is reported.
+func RequiredFields(code []byte) []string {
+	var fields []string
+
+	seen := make(map[string]bool)
+
+	required := func(p string) bool {
+		return strings.Contains(string(code), p)
+	}
+
+	imports, err := parseImports(code)
+	if err == nil {
+		placeholders := make(map[string]bool)
+		for _, declaration := range imports {
+			if location, ok := declaration.Location.(common.StringLocation); ok {
+				placeholders[fmt.Sprintf("%q", string(location))] = true
+			}
+		}
+
+		required = func(p string) bool {
+			return placeholders[p]
+		}
+	}
+
+	for _, p := range placeholderFields {
+		if seen[p.field] || !required(p.placeholder) {
+			continue
+		}
+
+		seen[p.field] = true
+		fields = append(fields, p.field)
+	}
+
+	return fields
+}
+
+// Validate checks that the Environment sets every field that is needed
+// to resolve the imports of the given contract or template code.
+//
+// It returns a *MissingFieldsError listing the fields that still need to be set.
+func (env Environment) Validate(code []byte) error {
+	var missing []string
+
+	for _, field := range RequiredFields(code) {
+		if env.fieldValue(field) == "" {
+			missing = append(missing, field)
+		}
+	}
+
+	if len(missing) > 0 {
+		return &MissingFieldsError{Fields: missing}
+	}
+
+	return nil
+}
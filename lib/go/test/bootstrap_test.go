@@ -0,0 +1,60 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+func TestBootstrapDeploy(t *testing.T) {
+	b, _, accountKeys, env := newTestSetup(t)
+
+	// Create new keys for the contract accounts
+	_, adminSigner := accountKeys.NewWithSigner()
+
+	// Deploys all the core contracts with the default configuration
+	deployment := deployCoreContracts(t, b, newBootstrapConfig(t, b, env, adminSigner))
+	env = deployment.Env
+
+	t.Run("Should have initialized the staking and epoch contracts", func(t *testing.T) {
+		result := executeScriptAndCheck(t, b, templates.GenerateGetWeeklyPayoutScript(env), nil)
+		assertEqual(t, CadenceUFix64("1250000.0"), result)
+
+		result = executeScriptAndCheck(t, b, templates.GenerateGetCutPercentageScript(env), nil)
+		assertEqual(t, CadenceUFix64("0.08"), result)
+
+		result = executeScriptAndCheck(t, b, templates.GenerateGetCurrentEpochCounterScript(env), nil)
+		assertEqual(t, cadence.NewUInt64(0), result)
+
+		result = executeScriptAndCheck(t, b, templates.GenerateGetFeesBalanceScript(env), nil)
+		assertEqual(t, CadenceUFix64("0.0"), result)
+	})
+
+	t.Run("Should be able to advance the epoch with the staking account", func(t *testing.T) {
+		advanceView(t, b, env, deployment.IDTable.Address, adminSigner, 1, "BLOCK", false)
+	})
+
+	t.Run("Should be able to set up a staking collection", func(t *testing.T) {
+		address, _, signer := newAccountWithAddress(b, accountKeys)
+
+		tx := createTxWithTemplateAndAuthorizer(b, templates.GenerateCollectionSetup(env), address)
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{address},
+			[]crypto.Signer{signer},
+			false,
+		)
+
+		result := executeScriptAndCheck(t, b,
+			templates.GenerateCollectionGetNodeIDsScript(env),
+			[][]byte{jsoncdc.MustEncode(cadence.NewAddress(address))},
+		)
+		assert.Empty(t, result.(cadence.Array).Values)
+	})
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
-	"fmt"
 	"testing"
 
 	"github.com/onflow/cadence"
@@ -891,58 +890,6 @@ func getCurrentEpochCounter(t *testing.T, b emulator.Emulator, env templates.Env
 	return result.(cadence.UInt64)
 }
 
-// newClusterQCVoteDataCdcType returns a new (empty) FlowClusterQC cadence struct type.
-func newClusterQCVoteDataCdcType(clusterQcAddress string) *cadence.StructType {
-	// FlowClusterQC.ClusterQCVoteData
-	address, _ := common.HexToAddress(clusterQcAddress)
-	location := common.NewAddressLocation(nil, address, "FlowClusterQC")
-
-	return cadence.NewStructType(location, "FlowClusterQC.ClusterQCVoteData",
-		[]cadence.Field{
-			{
-				Identifier: "aggregatedSignature",
-				Type:       cadence.StringType,
-			},
-			{
-				Identifier: "voterIDs",
-				Type:       cadence.NewVariableSizedArrayType(cadence.StringType),
-			},
-		},
-		nil)
-}
-
-// convertClusterQcsCdc expects a list of collection clusters where each entry in the list is a
-// list of collector cluster voter ids. This func will create a full collection cluster fixture
-// for each of the clusters in the list, creating a aggregate signature fixture for each of the
-// clusters.
-// Args:
-//
-//	env: templates environment
-//	clusters: list of collection clusters, each cluster being a list of voter ids i.e: cadence.NewArray([]cadence.Value{CadenceString("node_1"), CadenceString("node_2"), CadenceString("node_3")})
-//
-// Returns:
-//
-//	[]cadence.Value: array of cluster qc vote data fixtures for each collection cluster.
-func convertClusterQcsCdc(env templates.Environment, clusters []cadence.Value) []cadence.Value {
-	voteDataType := newClusterQCVoteDataCdcType(env.QuorumCertificateAddress)
-	qcVoteData := make([]cadence.Value, len(clusters))
-	for i, cluster := range clusters {
-		clusterCdc := cluster.(cadence.Array)
-		cdcVoterIds := make([]cadence.Value, len(clusterCdc.Values))
-		for i, id := range clusterCdc.Values {
-			cdcVoterIds[i] = cadence.String(id.String())
-		}
-		qcVoteData[i] = cadence.NewStruct([]cadence.Value{
-			// aggregatedSignature
-			cadence.String(fmt.Sprintf("signature_%d", i)),
-			// Node IDs of signers
-			cadence.NewArray(cdcVoterIds).WithType(cadence.NewVariableSizedArrayType(cadence.StringType)),
-		}).WithType(voteDataType)
-	}
-
-	return qcVoteData
-}
-
 type testEpochConfig struct {
 	startEpochCounter    uint64 // start epoch counter
 	numEpochViews        uint64 // num views per epoch
@@ -1014,6 +961,17 @@ func DKGPubKeyFixture() string {
 	return hex.EncodeToString(key)
 }
 
+// AggregatedSignatureFixture constructs a fixture for the aggregated signature of a cluster QC
+// (any hex-encoded 48-byte bytes, the length of a BLS signature).
+func AggregatedSignatureFixture() string {
+	signature := make([]byte, 48)
+	_, err := rand.Read(signature)
+	if err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(signature)
+}
+
 // DKGPubKeysFixture constructs a fixture for a DKG public key string list as accepted by FlowDKG
 // (any list of hex-encoded 96-byte bytes).
 func DKGPubKeysFixture(n int) []string {
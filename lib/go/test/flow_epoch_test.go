@@ -23,6 +23,9 @@ import (
 	"github.com/onflow/flow-go-sdk"
 	sdkcrypto "github.com/onflow/flow-go-sdk/crypto"
 
+	"github.com/onflow/flow-core-contracts/lib/go/epochs"
+	"github.com/onflow/flow-core-contracts/lib/go/epochs/recovery"
+	"github.com/onflow/flow-core-contracts/lib/go/events"
 	"github.com/onflow/flow-core-contracts/lib/go/templates"
 )
 
@@ -145,10 +148,81 @@ func TestEpochClusters(t *testing.T) {
 		result := executeScriptAndCheck(t, b, templates.GenerateGetCreateClustersScript(env), [][]byte{jsoncdc.MustEncode(idArray)})
 		assertEqual(t, 2, len(result.(cadence.Array).Values))
 
+		// the clusters are a round robin assignment of the shuffled nodes, like in the Go implementation
+		clusters := make([]events.Cluster, len(result.(cadence.Array).Values))
+		for i, value := range result.(cadence.Array).Values {
+			require.NoError(t, events.DecodeValue(value, &clusters[i]))
+		}
+
+		// the auction has not ended, so the nodes have no weight yet
+		weights := map[string]uint64{ids[0]: 0, ids[1]: 0, ids[2]: 0, ids[3]: 0}
+		require.NoError(t, epochs.AuditClusters(clusters, weights, numClusters))
+	})
+
+	t.Run("Should create the same clusters as the Go implementation from the same random numbers", func(t *testing.T) {
+		script := []byte(templates.ReplaceAddresses(createClustersOrDrawScript, env))
+
+		idArray := make([]cadence.Value, len(ids))
+		weights := make(map[string]uint64, len(ids))
+		for i, id := range ids {
+			idArray[i] = CadenceString(id)
+			// the auction has not ended, so the nodes have no weight yet
+			weights[id] = 0
+		}
+		nodeIDs := jsoncdc.MustEncode(cadence.NewArray(idArray))
+
+		// the contract draws one random number for every swap of the shuffle
+		draws := jsoncdc.MustEncode(cadence.NewInt(len(ids) - 1))
+		numbers := executeScriptAndCheck(t, b, script, [][]byte{nodeIDs, draws}).(cadence.Array).Values
+
+		// the random numbers do not change between executions of the script in the same block
+		assertEqual(t, numbers, executeScriptAndCheck(t, b, script, [][]byte{nodeIDs, draws}).(cadence.Array).Values)
+
+		result := executeScriptAndCheck(t, b, script, [][]byte{nodeIDs, jsoncdc.MustEncode(cadence.NewInt(0))})
+
+		clusters := make([]events.Cluster, len(result.(cadence.Array).Values))
+		for i, value := range result.(cadence.Array).Values {
+			require.NoError(t, events.DecodeValue(value, &clusters[i]))
+		}
+
+		expected, err := epochs.CollectorClusters(ids, weights, numClusters, &drawnNumbers{numbers: numbers})
+		require.NoError(t, err)
+		assert.Equal(t, expected, clusters)
 	})
 
 }
 
+// createClustersOrDrawScript returns the clusters that FlowEpoch creates for the node IDs if draws is zero,
+// and otherwise the first random numbers the script draws. The random numbers of a script depend on its block
+// and its code, but not on its arguments, so both executions in the same block draw the same numbers.
+const createClustersOrDrawScript = `
+import "FlowEpoch"
+import "FlowClusterQC"
+
+access(all) fun main(nodeIDs: [String], draws: Int): AnyStruct {
+    if draws == 0 {
+        return FlowEpoch.createCollectorClusters(nodeIDs: nodeIDs)
+    }
+
+    let numbers: [UInt64] = []
+    while numbers.length < draws {
+        numbers.append(revertibleRandom<UInt64>())
+    }
+    return numbers
+}
+`
+
+// drawnNumbers is an epochs.Rand that returns the random numbers drawn by a script, in order
+type drawnNumbers struct {
+	numbers []cadence.Value
+}
+
+func (d *drawnNumbers) Uint64() uint64 {
+	number := d.numbers[0]
+	d.numbers = d.numbers[1:]
+	return uint64(number.(cadence.UInt64))
+}
+
 func TestEpochPhaseMetadataChange(t *testing.T) {
 	b, _, accountKeys, env := newTestSetup(t)
 
@@ -1581,7 +1655,7 @@ func testEpochRecoverSuccess(t *testing.T, enterEpochSetupPhase bool, overrideCu
 			targetDuration uint64 = numEpochViews
 			targetEndTime  uint64 = expectedTargetEndTime(epochTimingConfigResult, recoveryEpochCounter)
 		)
-		args := getRecoveryTxArgs(env, ids, startView, stakingEndView, endView, targetDuration, targetEndTime, recoveryEpochCounter)
+		args := getRecoveryTxArgs(t, env, ids, startView, stakingEndView, endView, targetDuration, targetEndTime, recoveryEpochCounter)
 		// If test case configuration specifies overriding the current epoch, set argument accordingly
 		args.SetUnsafeAllowOverwrite(overrideCurrentEpoch)
 
@@ -1656,6 +1730,113 @@ func TestEpochRecover(t *testing.T) {
 	})
 }
 
+// TestEpochRecover_CheckedInput tests EFM recovery with arguments built by recovery.Build,
+// from fixtures that are consistent with the registered nodes, so that they pass recovery.Check.
+func TestEpochRecover_CheckedInput(t *testing.T) {
+	epochConfig := &testEpochConfig{
+		startEpochCounter:    startEpochCounter,
+		numEpochViews:        numEpochViews,
+		numStakingViews:      numStakingViews,
+		numDKGViews:          numDKGViews,
+		numClusters:          numClusters,
+		numEpochAccounts:     numEpochAccounts,
+		randomSource:         randomSource,
+		rewardIncreaseFactor: rewardIncreaseFactor,
+	}
+
+	runWithDefaultContracts(t, epochConfig, func(b emulator.Emulator, env templates.Environment, ids []string, idTableAddress flow.Address, IDTableSigner sdkcrypto.Signer, adapter *adapters.SDKAdapter) {
+		// the nodes get their initial weights at the end of the staking auction
+		advanceView(t, b, env, idTableAddress, IDTableSigner, 1, "EPOCHSETUP", false)
+
+		result := executeScriptAndCheck(t, b, templates.GenerateGetEpochConfigMetadataScript(env), nil)
+		config, err := epochs.DecodeConfigMetadata(result)
+		require.NoError(t, err)
+
+		nodes := make(map[string]events.NodeInfo, len(ids))
+		for _, id := range ids {
+			result := executeScriptAndCheck(t, b, templates.GenerateGetNodeInfoScript(env), [][]byte{jsoncdc.MustEncode(cadence.String(id))})
+
+			var node events.NodeInfo
+			require.NoError(t, events.DecodeValue(result, &node))
+			nodes[id] = node
+		}
+
+		epochTimingConfigResult := executeScriptAndCheck(t, b, templates.GenerateGetEpochTimingConfigScript(env), nil)
+		var (
+			startView      uint64 = 100
+			stakingEndView uint64 = 120
+			endView        uint64 = 160
+			targetDuration uint64 = numEpochViews
+			epochCounter   uint64 = startEpochCounter + 1
+			targetEndTime  uint64 = expectedTargetEndTime(epochTimingConfigResult, epochCounter)
+		)
+
+		// the nodes are registered with round-robin roles:
+		// ids[0] and ids[5] are collector nodes, and ids[1] is the only consensus node
+		clusterAssignments := [][]string{{ids[0]}, {ids[5]}}
+
+		clusterQCVoteData := make([]events.ClusterQCVoteData, len(clusterAssignments))
+		for i, cluster := range clusterAssignments {
+			clusterQCVoteData[i] = events.ClusterQCVoteData{
+				AggregatedSignature: AggregatedSignatureFixture(),
+				VoterIDs:            cluster,
+			}
+		}
+
+		input := recovery.Input{
+			EpochCounter:       epochCounter,
+			StartView:          startView,
+			StakingEndView:     stakingEndView,
+			EndView:            endView,
+			TargetDuration:     targetDuration,
+			TargetEndTime:      targetEndTime,
+			ClusterAssignments: clusterAssignments,
+			ClusterQCVoteData:  clusterQCVoteData,
+			DKGPubKeys:         DKGPubKeysFixture(1),
+			DKGGroupKey:        DKGPubKeyFixture(),
+			DKGIDMapping:       map[string]int{ids[1]: 0},
+			NodeIDs:            ids,
+		}
+
+		state := recovery.State{
+			CurrentEpochCounter: startEpochCounter,
+			Config:              config,
+			Nodes:               nodes,
+		}
+
+		args, err := recovery.Build(env, input, state)
+		require.NoError(t, err)
+
+		tx := createTxWithTemplateAndAuthorizer(b, templates.GenerateRecoverEpochScript(env), idTableAddress)
+		for _, arg := range args {
+			tx.AddArgument(arg)
+		}
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{idTableAddress},
+			[]sdkcrypto.Signer{IDTableSigner},
+			false,
+		)
+
+		advanceView(t, b, env, idTableAddress, IDTableSigner, 1, "BLOCK", false)
+
+		verifyEpochRecoverGovernanceTx(t, b, env, ids,
+			startView,
+			stakingEndView,
+			endView,
+			targetDuration,
+			targetEndTime,
+			epochCounter,
+			// auto-reward is disabled in this test
+			"0.0",
+			idTableAddress,
+			adapter,
+			EpochRecoveryTxArgs(args),
+		)
+	})
+}
+
 // TestEpochRecover_NewEpoch_Failure tests EFM recovery safety checks when unsafeAllowOverwrite=false.
 // It attempts to submit EFM recovery transactions for a collection of invalid epoch counters and
 // asserts that these attempts panic with an expected error.
@@ -1688,7 +1869,7 @@ func TestEpochRecover_NewEpoch_Failure(t *testing.T) {
 					epochCounter  uint64 = epochConfig.startEpochCounter
 					targetEndTime uint64 = expectedTargetEndTime(epochTimingConfigResult, epochCounter)
 				)
-				args := getRecoveryTxArgs(env, ids, startView, stakingEndView, endView, targetDuration, targetEndTime, epochCounter)
+				args := getRecoveryTxArgs(t, env, ids, startView, stakingEndView, endView, targetDuration, targetEndTime, epochCounter)
 				args.SetUnsafeAllowOverwrite(false)
 
 				code := static.RecoverNewEpochUnchecked
@@ -1741,7 +1922,7 @@ func TestEpochRecover_OverwriteEpoch_Failure(t *testing.T) {
 					epochCounter  uint64 = epochConfig.startEpochCounter
 					targetEndTime uint64 = expectedTargetEndTime(epochTimingConfigResult, epochCounter)
 				)
-				args := getRecoveryTxArgs(env, ids, startView, stakingEndView, endView, targetDuration, targetEndTime, epochCounter)
+				args := getRecoveryTxArgs(t, env, ids, startView, stakingEndView, endView, targetDuration, targetEndTime, epochCounter)
 				args.SetUnsafeAllowOverwrite(false)
 
 				code := static.RecoverNewEpochUnchecked
@@ -1798,7 +1979,7 @@ func TestEpochRecover_Rewards(t *testing.T) {
 			epochCounter   uint64 = startEpochCounter + 1
 			targetEndTime  uint64 = expectedTargetEndTime(epochTimingConfigResult, epochCounter)
 		)
-		args := getRecoveryTxArgs(env, ids, startView, stakingEndView, endView, targetDuration, targetEndTime, epochCounter)
+		args := getRecoveryTxArgs(t, env, ids, startView, stakingEndView, endView, targetDuration, targetEndTime, epochCounter)
 
 		tx = createTxWithTemplateAndAuthorizer(b, templates.GenerateRecoverEpochScript(env), idTableAddress)
 		for _, arg := range args {
@@ -1831,7 +2012,7 @@ func TestEpochRecover_Rewards(t *testing.T) {
 			args,
 		)
 
-		args = getRecoveryTxArgs(env, ids, startView, stakingEndView, endView, targetDuration, targetEndTime, epochCounter+1)
+		args = getRecoveryTxArgs(t, env, ids, startView, stakingEndView, endView, targetDuration, targetEndTime, epochCounter+1)
 		tx = createTxWithTemplateAndAuthorizer(b, templates.GenerateRecoverEpochScript(env), idTableAddress)
 		for _, arg := range args {
 			tx.AddArgument(arg)
@@ -1881,7 +2062,7 @@ func TestEpochRecover_Rewards(t *testing.T) {
 		assertEqual(t, CadenceUFix64("1314428.67450000"), result)
 
 		// overwrite current epoch with a recover transaction, rewards should not be paid out
-		args = getRecoveryTxArgs(env, ids, startView, stakingEndView, endView, targetDuration, targetEndTime, epochCounter+1)
+		args = getRecoveryTxArgs(t, env, ids, startView, stakingEndView, endView, targetDuration, targetEndTime, epochCounter+1)
 		// set unsafe overwrite to true
 		args[len(args)-1] = cadence.NewBool(true)
 		tx = createTxWithTemplateAndAuthorizer(b, templates.GenerateRecoverEpochScript(env), idTableAddress)
@@ -1935,6 +2116,7 @@ func (args EpochRecoveryTxArgs) SetUnsafeAllowOverwrite(val bool) {
 }
 
 func getRecoveryTxArgs(
+	t *testing.T,
 	env templates.Environment,
 	nodeIds []string,
 	startView uint64,
@@ -1946,32 +2128,42 @@ func getRecoveryTxArgs(
 ) EpochRecoveryTxArgs {
 	// TODO: values here are disconnected from registered IDs - it would be better if test
 	//       fixtures were consistent in which roles and IDs were used throughout the tests
-	collectorClusters := make([]cadence.Value, 3)
-	collectorClusters[0] = cadence.NewArray([]cadence.Value{CadenceString("node_1"), CadenceString("node_2"), CadenceString("node_3")})
-	collectorClusters[1] = cadence.NewArray([]cadence.Value{CadenceString("node_4"), CadenceString("node_5"), CadenceString("node_6")})
-	collectorClusters[2] = cadence.NewArray([]cadence.Value{CadenceString("node_7"), CadenceString("node_8"), CadenceString("node_9")})
-
-	dkgGroupKeyCDC := DKGPubKeyFixtureCDC()
-	dkgPubKeysCDC := DKGPubKeysFixtureCDC(2)
-	dkgIDMappingCDC := DKGIDMappingToCDC(map[string]int{"tmp1": 0, "tmp2": 1})
-
-	nodeIDsCDC := CadenceArrayFrom(nodeIds, StringToCDC)
-	clusterQcVoteData := convertClusterQcsCdc(env, collectorClusters)
-	return []cadence.Value{
-		cadence.NewUInt64(epochCounter),
-		cadence.NewUInt64(startView),
-		cadence.NewUInt64(stakingEndView),
-		cadence.NewUInt64(endView),
-		cadence.NewUInt64(targetDuration),
-		cadence.NewUInt64(targetEndTime),
-		cadence.NewArray(collectorClusters),
-		cadence.NewArray(clusterQcVoteData),
-		dkgPubKeysCDC,
-		dkgGroupKeyCDC,
-		dkgIDMappingCDC,
-		nodeIDsCDC,
-		cadence.NewBool(false), // recover EFM with a new epoch, set unsafeAllowOverwrite to false
+	clusterAssignments := [][]string{
+		{"node_1", "node_2", "node_3"},
+		{"node_4", "node_5", "node_6"},
+		{"node_7", "node_8", "node_9"},
+	}
+
+	clusterQCVoteData := make([]events.ClusterQCVoteData, len(clusterAssignments))
+	for i, cluster := range clusterAssignments {
+		clusterQCVoteData[i] = events.ClusterQCVoteData{
+			AggregatedSignature: fmt.Sprintf("signature_%d", i),
+			VoterIDs:            cluster,
+		}
 	}
+
+	input := recovery.Input{
+		EpochCounter:       epochCounter,
+		StartView:          startView,
+		StakingEndView:     stakingEndView,
+		EndView:            endView,
+		TargetDuration:     targetDuration,
+		TargetEndTime:      targetEndTime,
+		ClusterAssignments: clusterAssignments,
+		ClusterQCVoteData:  clusterQCVoteData,
+		DKGPubKeys:         DKGPubKeysFixture(2),
+		DKGGroupKey:        DKGPubKeyFixture(),
+		DKGIDMapping:       map[string]int{"tmp1": 0, "tmp2": 1},
+		NodeIDs:            nodeIds,
+		// recover EFM with a new epoch, set unsafeAllowOverwrite to false
+		UnsafeAllowOverwrite: false,
+	}
+
+	// the fixtures are not consistent with the registered nodes, so the input is not checked
+	args, err := input.Arguments(env)
+	require.NoError(t, err)
+
+	return args
 }
 
 // verifyEpochRecoverGovernanceTx ensures that epoch metadata is updated with
@@ -220,14 +220,34 @@ require (
 )
 
 require (
+	// replaced by module version in this repo - disregard pinned version
+	github.com/onflow/flow-core-contracts/lib/go/bootstrap v0.0.0-00010101000000-000000000000
+	// replaced by module version in this repo - disregard pinned version
+	github.com/onflow/flow-core-contracts/lib/go/client v0.0.0-00010101000000-000000000000 // indirect
 	// replaced by module version in this repo - disregard pinned version
 	github.com/onflow/flow-core-contracts/lib/go/contracts v1.9.4-0.20260407151750-6e8621db576c
 	// replaced by module version in this repo - disregard pinned version
+	github.com/onflow/flow-core-contracts/lib/go/epochs v0.0.0-00010101000000-000000000000
+	// replaced by module version in this repo - disregard pinned version
+	github.com/onflow/flow-core-contracts/lib/go/events v0.0.0-00010101000000-000000000000
+	// replaced by module version in this repo - disregard pinned version
+	github.com/onflow/flow-core-contracts/lib/go/staking v0.0.0-00010101000000-000000000000 // indirect
+	// replaced by module version in this repo - disregard pinned version
 	github.com/onflow/flow-core-contracts/lib/go/templates v1.10.2-0.20260416131955-9c14ad685211
 )
 
+replace github.com/onflow/flow-core-contracts/lib/go/bootstrap => ../bootstrap
+
+replace github.com/onflow/flow-core-contracts/lib/go/client => ../client
+
 replace github.com/onflow/flow-core-contracts/lib/go/contracts => ../contracts
 
+replace github.com/onflow/flow-core-contracts/lib/go/epochs => ../epochs
+
+replace github.com/onflow/flow-core-contracts/lib/go/events => ../events
+
+replace github.com/onflow/flow-core-contracts/lib/go/staking => ../staking
+
 replace github.com/onflow/flow-core-contracts/lib/go/templates => ../templates
 
 replace github.com/ethereum/go-ethereum => github.com/ethereum/go-ethereum v1.16.8
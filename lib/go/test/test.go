@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/flow-core-contracts/lib/go/bootstrap"
 	"github.com/onflow/flow-core-contracts/lib/go/templates"
 )
 
@@ -96,6 +97,58 @@ func newBlockchain(opts ...emulator.Option) (emulator.Emulator, *adapters.SDKAda
 	return b, adapter
 }
 
+// emulatorBackend adapts the SDK adapter of the emulator to the backend used by bootstrap.Deploy
+type emulatorBackend struct {
+	*adapters.SDKAdapter
+}
+
+func (b emulatorBackend) GetLatestBlockHeader(ctx context.Context, isSealed bool) (*flow.BlockHeader, error) {
+	header, _, err := b.SDKAdapter.GetLatestBlockHeader(ctx, isSealed)
+	return header, err
+}
+
+// newBootstrapConfig returns the default bootstrap configuration for the emulator,
+// paid for by the emulator service account.
+//
+// The accounts created for the contracts get the key of the given admin signer,
+// which must be an in-memory signer, e.g. one created by test.AccountKeyGenerator.
+func newBootstrapConfig(t *testing.T, b emulator.Emulator, env templates.Environment, adminSigner sdkcrypto.Signer) bootstrap.Config {
+	serviceSigner, err := b.ServiceKey().Signer()
+	require.NoError(t, err)
+
+	adminKey, ok := adminSigner.(sdkcrypto.InMemorySigner)
+	require.True(t, ok, "admin signer must be an in-memory signer")
+
+	// the staking collection and transaction scheduler import contracts
+	// that are only deployed to the emulator service account
+	env.EVMAddress = emulatorServiceAccount
+	env.ViewResolverAddress = emulatorServiceAccount
+	env.MetadataViewsAddress = emulatorServiceAccount
+
+	serviceAccount := bootstrap.Account{
+		Address:  b.ServiceKey().Address,
+		KeyIndex: b.ServiceKey().Index,
+		Signer:   serviceSigner,
+	}
+
+	return bootstrap.DefaultConfig(env, serviceAccount, adminKey.PrivateKey)
+}
+
+// deployCoreContracts deploys the core contracts to the emulator with bootstrap.Deploy.
+// Every deployment transaction is committed in its own block.
+func deployCoreContracts(t *testing.T, b emulator.Emulator, config bootstrap.Config) *bootstrap.Deployment {
+	logger := zerolog.Nop()
+	adapter := adapters.NewSDKAdapter(&logger, b)
+
+	adapter.EnableAutoMine()
+	defer adapter.DisableAutoMine()
+
+	deployment, err := bootstrap.Deploy(context.Background(), emulatorBackend{adapter}, config)
+	require.NoError(t, err)
+
+	return deployment
+}
+
 // Create a new, empty account for testing
 // and return the address, public keys, and signer objects
 func newAccountWithAddress(b emulator.Emulator, accountKeys *test.AccountKeys) (flow.Address, *flow.AccountKey, sdkcrypto.Signer) {